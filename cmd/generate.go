@@ -26,6 +26,9 @@ type generateCmd struct {
 	classicMode       bool
 	noPrettyPrint     bool
 	parametersOnly    bool
+	emitHelmValues    bool
+	helmChartName     string
+	helmChartVersion  string
 
 	// derived
 	containerService *api.ContainerService
@@ -53,6 +56,9 @@ func newGenerateCmd() *cobra.Command {
 	f.BoolVar(&gc.classicMode, "classic-mode", false, "enable classic parameters and outputs")
 	f.BoolVar(&gc.noPrettyPrint, "no-pretty-print", false, "skip pretty printing the output")
 	f.BoolVar(&gc.parametersOnly, "parameters-only", false, "only output parameters files")
+	f.BoolVar(&gc.emitHelmValues, "emit-helm-values", false, "output the values of a helm umbrella chart deploying the Kubernetes addons; heapster and the dashboard are then left to the chart, the addons the cluster needs to boot stay with the addon-manager")
+	f.StringVar(&gc.helmChartName, "helm-chart-name", "", "name of the umbrella chart the helm values are for (required with --emit-helm-values)")
+	f.StringVar(&gc.helmChartVersion, "helm-chart-version", "", "version of the umbrella chart the helm values are for (required with --emit-helm-values)")
 
 	return generateCmd
 }
@@ -87,6 +93,10 @@ func (gc *generateCmd) validate(cmd *cobra.Command, args []string) {
 		gc.outputDirectory = path.Join("_output", gc.containerService.Properties.MasterProfile.DNSPrefix)
 	}
 
+	if gc.emitHelmValues && (gc.helmChartName == "" || gc.helmChartVersion == "") {
+		log.Fatal("--helm-chart-name and --helm-chart-version must be specified with --emit-helm-values")
+	}
+
 	// consume gc.caCertificatePath and gc.caPrivateKeyPath
 
 	if (gc.caCertificatePath != "" && gc.caPrivateKeyPath == "") || (gc.caCertificatePath == "" && gc.caPrivateKeyPath != "") {
@@ -116,6 +126,14 @@ func (gc *generateCmd) run() error {
 	if err != nil {
 		log.Fatalln("failed to initialize template generator: %s", err.Error())
 	}
+	templateGenerator.EmitHelmValues = gc.emitHelmValues
+
+	for _, warning := range acsengine.GetStorageWarnings(gc.containerService) {
+		log.Warnln(warning)
+	}
+	for _, warning := range acsengine.GetPreloadImagesWarnings(gc.containerService) {
+		log.Warnln(warning)
+	}
 
 	certsGenerated := false
 	template, parameters, certsGenerated, err := templateGenerator.GenerateTemplate(gc.containerService)
@@ -137,5 +155,11 @@ func (gc *generateCmd) run() error {
 		log.Fatalf("error writing artifacts: %s \n", err.Error())
 	}
 
+	if gc.emitHelmValues {
+		if err = acsengine.WriteHelmValues(gc.containerService, acsengine.HelmChartReference{Name: gc.helmChartName, Version: gc.helmChartVersion}, gc.outputDirectory); err != nil {
+			log.Fatalf("error writing helm values: %s \n", err.Error())
+		}
+	}
+
 	return nil
 }
@@ -80,11 +80,7 @@
       "type": "string"
     },
     "masterCount": {
-      "allowedValues": [
-        1,
-        3,
-        5
-      ],
+      "allowedValues": {{GetAllowedMasterCounts}},
       "defaultValue": 1,
       "metadata": {
         "description": "The number of Mesos masters for the cluster."
@@ -22,7 +22,7 @@
       "location": "[variables('location')]",
       "name": "[concat(variables('storageAccountPrefixes')[mod(add(copyIndex(),variables('{{.Name}}StorageAccountOffset')),variables('storageAccountPrefixesCount'))],variables('storageAccountPrefixes')[div(add(copyIndex(),variables('{{.Name}}StorageAccountOffset')),variables('storageAccountPrefixesCount'))],variables('{{.Name}}AccountName'))]",
       "properties": {
-        "accountType": "[variables('vmSizesMap')[variables('{{.Name}}VMSize')].storageAccountType]"
+        "accountType": "{{GetStorageAccountType .}}"
       },
       "type": "Microsoft.Storage/storageAccounts"
     },
@@ -142,7 +142,7 @@
           },
           "osProfile": {
             "adminUsername": "[variables('adminUsername')]",
-            "computerNamePrefix": "[variables('{{.Name}}VMNamePrefix')]",
+            "computerNamePrefix": "[variables('{{.Name}}ComputerNamePrefix')]",
             {{GetDCOSAgentCustomData .}}
             "linuxConfiguration": {
               "disablePasswordAuthentication": "true",
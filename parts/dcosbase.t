@@ -11,9 +11,9 @@
         {{template "dcosagentvars.t" .}}
         {{if .IsStorageAccount}}
           "{{.Name}}StorageAccountOffset": "[mul(variables('maxStorageAccountsPerAgent'),{{$index}})]",
-          "{{.Name}}AccountName": "[concat(variables('storageAccountBaseName'), 'agnt{{$index}}')]",
+          "{{.Name}}AccountName": "{{GetStorageAccountName "agnt" $index}}",
           {{if .HasDisks}}
-            "{{.Name}}DataAccountName": "[concat(variables('storageAccountBaseName'), 'data{{$index}}')]",
+            "{{.Name}}DataAccountName": "{{GetStorageAccountName "data" $index}}",
           {{end}}
         {{end}}
     {{end}}
@@ -99,7 +99,7 @@ runcmd:
   - start
   - dcos-config-writer.service
 write_files:
-- content: 'https://az837203.vo.msecnd.net/dcos/testing
+- content: '{{{dcosRepositoryURL}}}
 
     '
   owner: root
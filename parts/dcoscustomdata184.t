@@ -103,7 +103,7 @@ runcmd:
   - start
   - dcos-setup.service
 write_files:
-- content: 'https://dcosio.azureedge.net/dcos/testing
+- content: '{{{dcosRepositoryURL}}}
 
     '
   owner: root
@@ -119,7 +119,7 @@ runcmd:
   - start
   - dcos-setup.service
 write_files:
-- content: 'https://dcosio.azureedge.net/dcos/stable
+- content: '{{{dcosRepositoryURL}}}
 
 '
   owner: root
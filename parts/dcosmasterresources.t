@@ -180,7 +180,7 @@
                     "sourceAddressPrefix": "*"
                 },
                 "name": "ssh"
-            }
+            }{{GetAdditionalSecurityRules}}
         ]
       },
       "type": "Microsoft.Network/networkSecurityGroups"
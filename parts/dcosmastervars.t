@@ -35,8 +35,12 @@
     "masterNSGName": "[concat(variables('orchestratorName'), '-master-nsg-', variables('nameSuffix'))]", 
     "masterPublicIPAddressName": "[concat(variables('orchestratorName'), '-master-ip-', variables('masterEndpointDNSNamePrefix'), '-', variables('nameSuffix'))]", 
     "apiVersionStorage": "2015-06-15",
+{{if .HasUniqueStorageNames}}
+    "storageAccountBaseName": "[uniqueString(concat(subscription().subscriptionId,resourceGroup().name,variables('masterEndpointDNSNamePrefix'),variables('location'),variables('orchestratorName')))]",
+{{else}}
     "storageAccountBaseName": "[uniqueString(concat(variables('masterEndpointDNSNamePrefix'),variables('location'),variables('orchestratorName')))]", 
-    "masterStorageAccountExhibitorName": "[concat(variables('storageAccountBaseName'), 'exhb0')]", 
+{{end}}
+    "masterStorageAccountExhibitorName": "{{GetStorageAccountName "exhb" 0}}", 
     "storageAccountType": "Standard_LRS",
 {{if .HasStorageAccountDisks}}
     "maxVMsPerStorageAccount": 20,
@@ -52,7 +56,7 @@
     "apiVersionStorageManagedDisks": "2016-04-30-preview",
 {{end}}
 {{if .MasterProfile.IsStorageAccount}}
-    "masterStorageAccountName": "[concat(variables('storageAccountBaseName'), 'mstr0')]",
+    "masterStorageAccountName": "{{GetStorageAccountName "mstr" 0}}",
 {{end}}
 {{if .MasterProfile.IsCustomVNET}}
     "masterVnetSubnetID": "[parameters('masterVnetSubnetID')]",
@@ -125,5 +129,6 @@
             ]
         ],
 {{end}}
-    "dcosBootstrapURL": "[parameters('dcosBootstrapURL')]"
+    "dcosBootstrapURL": "[parameters('dcosBootstrapURL')]",
+    "dcosRepositoryURL": "[parameters('dcosRepositoryURL')]"
 
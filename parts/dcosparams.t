@@ -4,4 +4,11 @@
         "description": "The default mesosphere bootstrap package."
       }, 
       "type": "string"
+    },
+    "dcosRepositoryURL": {
+      "defaultValue": "https://dcosio.azureedge.net/dcos/stable",
+      "metadata": {
+        "description": "The mesosphere package repository."
+      }, 
+      "type": "string"
     },
\ No newline at end of file
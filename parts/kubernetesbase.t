@@ -29,9 +29,9 @@
         {{template "kubernetesagentvars.t" .}}
         {{if .IsStorageAccount}}
           {{if .HasDisks}}
-            "{{.Name}}DataAccountName": "[concat(variables('storageAccountBaseName'), 'data{{$index}}')]",
+            "{{.Name}}DataAccountName": "{{GetStorageAccountName "data" $index}}",
           {{end}}
-          "{{.Name}}AccountName": "[concat(variables('storageAccountBaseName'), 'agnt{{$index}}')]", 
+          "{{.Name}}AccountName": "{{GetStorageAccountName "agnt" $index}}", 
         {{end}}
     {{end}}
     {{template "kubernetesmastervars.t" .}}
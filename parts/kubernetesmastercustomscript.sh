@@ -19,15 +19,25 @@ SERVICE_PRINCIPAL_CLIENT_SECRET="${11}"
 KUBELET_PRIVATE_KEY="${12}"
 TARGET_ENVIRONMENT="${13}"
 NETWORK_POLICY="${14}"
+# zero when the Kubernetes version does not support rate limiting
+CLOUDPROVIDER_RATELIMIT_QPS="${15}"
+CLOUDPROVIDER_RATELIMIT_BUCKET="${16}"
 
 # Master only secrets
-APISERVER_PRIVATE_KEY="${15}"
-CA_CERTIFICATE="${16}"
-CA_PRIVATE_KEY="${17}"
-MASTER_FQDN="${18}"
-KUBECONFIG_CERTIFICATE="${19}"
-KUBECONFIG_KEY="${20}"
-ADMINUSER="${21}"
+APISERVER_PRIVATE_KEY="${17}"
+CA_CERTIFICATE="${18}"
+CA_PRIVATE_KEY="${19}"
+MASTER_FQDN="${20}"
+KUBECONFIG_CERTIFICATE="${21}"
+KUBECONFIG_KEY="${22}"
+ADMINUSER="${23}"
+# empty unless a dedicated service account signing key is configured
+SERVICE_ACCOUNT_PRIVATE_KEY="${24}"
+
+# Private registry credentials, only passed when a private registry is configured. The username and
+# password are passed base64 encoded as username:password, so no character of theirs reaches the shell.
+PRIVATE_REGISTRY_SERVER="${25}"
+PRIVATE_REGISTRY_AUTH="${26}"
 
 # cloudinit runcmd and the extension will run in parallel, this is to ensure
 # runcmd finishes
@@ -79,12 +89,38 @@ else
     echo "CA_PRIVATE_KEY is empty, assuming worker node"
 fi
 
+# If APISERVER_PRIVATE_KEY is empty, then we are not on the master
+if [[ ! -z "${APISERVER_PRIVATE_KEY}" ]]; then
+    SERVICE_ACCOUNT_PRIVATE_KEY_PATH="/etc/kubernetes/certs/serviceaccount.key"
+    if [[ ! -z "${SERVICE_ACCOUNT_PRIVATE_KEY}" ]]; then
+        touch "${SERVICE_ACCOUNT_PRIVATE_KEY_PATH}"
+        chmod 0600 "${SERVICE_ACCOUNT_PRIVATE_KEY_PATH}"
+        chown root:root "${SERVICE_ACCOUNT_PRIVATE_KEY_PATH}"
+        echo "${SERVICE_ACCOUNT_PRIVATE_KEY}" | base64 --decode > "${SERVICE_ACCOUNT_PRIVATE_KEY_PATH}"
+    else
+        # without a dedicated key, service account tokens are signed with the apiserver key
+        ln -sf apiserver.key "${SERVICE_ACCOUNT_PRIVATE_KEY_PATH}"
+    fi
+
+    # the apiserver verifies tokens with the public key of the signing key and any verification keys
+    SERVICE_ACCOUNT_PUBLIC_KEYS_PATH="/etc/kubernetes/certs/serviceaccount.pub"
+    openssl rsa -in "${SERVICE_ACCOUNT_PRIVATE_KEY_PATH}" -pubout > "${SERVICE_ACCOUNT_PUBLIC_KEYS_PATH}"
+    if [ -f /etc/kubernetes/certs/serviceaccount-verification.pub ]; then
+        cat /etc/kubernetes/certs/serviceaccount-verification.pub >> "${SERVICE_ACCOUNT_PUBLIC_KEYS_PATH}"
+    fi
+fi
+
 KUBELET_PRIVATE_KEY_PATH="/etc/kubernetes/certs/client.key"
 touch "${KUBELET_PRIVATE_KEY_PATH}"
 chmod 0600 "${KUBELET_PRIVATE_KEY_PATH}"
 chown root:root "${KUBELET_PRIVATE_KEY_PATH}"
 echo "${KUBELET_PRIVATE_KEY}" | base64 --decode > "${KUBELET_PRIVATE_KEY_PATH}"
 
+CLOUDPROVIDER_RATELIMIT=true
+if [[ "${CLOUDPROVIDER_RATELIMIT_QPS}" = "0" ]]; then
+    CLOUDPROVIDER_RATELIMIT=false
+fi
+
 AZURE_JSON_PATH="/etc/kubernetes/azure.json"
 touch "${AZURE_JSON_PATH}"
 chmod 0600 "${AZURE_JSON_PATH}"
@@ -102,7 +138,10 @@ cat << EOF > "${AZURE_JSON_PATH}"
     "securityGroupName": "${NETWORK_SECURITY_GROUP}",
     "vnetName": "${VIRTUAL_NETWORK}",
     "routeTableName": "${ROUTE_TABLE}",
-    "primaryAvailabilitySetName": "${PRIMARY_AVAILABILITY_SET}"
+    "primaryAvailabilitySetName": "${PRIMARY_AVAILABILITY_SET}",
+    "cloudProviderRateLimit": ${CLOUDPROVIDER_RATELIMIT},
+    "cloudProviderRateLimitQPS": ${CLOUDPROVIDER_RATELIMIT_QPS},
+    "cloudProviderRateLimitBucket": ${CLOUDPROVIDER_RATELIMIT_BUCKET}
 }
 EOF
 
@@ -139,7 +178,17 @@ function ensureKubectl() {
 function downloadUrl () {
 	# Wrapper around curl to download blobs more reliably.
 	# Workaround the --retry issues with a for loop and set a max timeout.
-	for i in 1 2 3 4 5; do curl --max-time 60 -fsSL ${1}; [ $? -eq 0 ] && break || sleep 10; done
+	for i in $(seq 1 PROVISIONRETRYCOUNT); do curl --max-time PROVISIONTIMEOUTINSECONDS -fsSL ${1}; [ $? -eq 0 ] && break || sleep 10; done
+}
+
+function downloadVerifiedUrl () {
+	# Downloads ${1} to the file ${2} and aborts provisioning if the file
+	# does not match the SHA256 checksum ${3}. An empty checksum skips the check.
+	downloadUrl ${1} > ${2}
+	if [[ -n "${3}" ]] && ! echo "${3}  ${2}" | sha256sum -c -; then
+		echo "SHA256 checksum mismatch for ${1}"
+		exit 1
+	fi
 }
 
 function setNetworkPlugin () {
@@ -162,9 +211,11 @@ function configAzureNetworkPolicy() {
     mkdir -p $CNI_BIN_DIR
 
     # Mirror from https://github.com/Azure/azure-container-networking/releases/tag/$AZURE_PLUGIN_VER/azure-vnet-cni-linux-amd64-$AZURE_PLUGIN_VER.tgz
-    downloadUrl https://acs-mirror.azureedge.net/cni/azure-vnet-cni-linux-amd64-latest.tgz | tar -xz -C $CNI_BIN_DIR
+    downloadVerifiedUrl https://acs-mirror.azureedge.net/cni/azure-vnet-cni-linux-amd64-AZURECNIVERSION.tgz /tmp/azure-vnet-cni.tgz "AZURECNICHECKSUM"
+    tar -xzf /tmp/azure-vnet-cni.tgz -C $CNI_BIN_DIR
     # Mirror from https://github.com/containernetworking/cni/releases/download/$CNI_RELEASE_VER/cni-amd64-$CNI_RELEASE_VERSION.tgz
     downloadUrl https://acs-mirror.azureedge.net/cni/cni-amd64-latest.tgz | tar -xz -C $CNI_BIN_DIR ./loopback
+    rm -f /tmp/azure-vnet-cni.tgz
     chown -R root:root $CNI_BIN_DIR
     chmod -R 755 $CNI_BIN_DIR
 
@@ -225,6 +276,25 @@ function ensureDocker() {
     fi
 }
 
+function ensurePreloadImages() {
+    # pulls the images listed in preloadimages before the kubelet starts, failing provisioning when
+    # an image still cannot be pulled after PROVISIONRETRYCOUNT attempts
+    PRELOAD_IMAGES_PATH="/opt/azure/containers/preloadimages"
+    if [ ! -f "${PRELOAD_IMAGES_PATH}" ]; then
+        return
+    fi
+    for image in $(cat "${PRELOAD_IMAGES_PATH}"); do
+        for i in $(seq 1 PROVISIONRETRYCOUNT); do
+            /usr/bin/docker pull "${image}" && break
+            if [ $i -eq PROVISIONRETRYCOUNT ]; then
+                echo "failed to pull the preloaded image ${image} after PROVISIONRETRYCOUNT attempts"
+                exit 1
+            fi
+            sleep 5
+        done
+    done
+}
+
 function ensureKubelet() {
     systemctl enable kubelet
     # only start if a reboot is not required
@@ -283,9 +353,38 @@ function ensureApiserver() {
     fi
 }
 
+# write the docker config the image pull secrets of the private registry are created from by the
+# ensure-cluster-config service, which removes it once the secrets exist
+function writePrivateRegistryConfig() {
+    if [[ -z "${PRIVATE_REGISTRY_SERVER}" ]]; then
+        return
+    fi
+    mkdir -p -m 0700 /etc/kubernetes/private-registry
+    # disable logging while writing the credentials
+    set +x
+    (umask 077; echo "{\"auths\":{\"${PRIVATE_REGISTRY_SERVER}\":{\"auth\":\"${PRIVATE_REGISTRY_AUTH}\"}}}" > /etc/kubernetes/private-registry/config.json)
+    # renable logging after secrets
+    set -x
+}
+
+# apply the configuration that needs the apiserver, the image pull secrets of the private registry and
+# the default deny network policies. When a reboot is required the service applies it after the reboot.
+function ensureClusterConfig() {
+    if [ ! -f /etc/systemd/system/ensure-cluster-config.service ]; then
+        return
+    fi
+    systemctl enable ensure-cluster-config
+    if ! $REBOOTREQUIRED; then
+        if ! systemctl restart ensure-cluster-config; then
+            echo "the cluster configuration could not be applied"
+            exit 5
+        fi
+    fi
+}
+
 function ensureEtcd() {
     for i in {1..600}; do
-        curl --max-time 60 http://127.0.0.1:2379/v2/machines;
+        curl --max-time PROVISIONTIMEOUTINSECONDS http://127.0.0.1:2379/v2/machines;
         if [ $? -eq 0 ]
         then
             echo "Etcd setup successfully"
@@ -363,6 +462,7 @@ users:
 # master and node
 ensureDocker
 configNetworkPolicy
+ensurePreloadImages
 ensureKubelet
 extractKubectl
 ensureJournal
@@ -374,6 +474,8 @@ if [[ ! -z "${APISERVER_PRIVATE_KEY}" ]]; then
     ensureEtcdDataDir
     ensureEtcd
     ensureApiserver
+    writePrivateRegistryConfig
+    ensureClusterConfig
 fi
 
 # mitigation for bug https://bugs.launchpad.net/ubuntu/+source/linux/+bug/1676635
@@ -113,14 +113,14 @@
               "access": "Allow",
               "description": "Allow kube-apiserver (tls) traffic to master",
               "destinationAddressPrefix": "*",
-              "destinationPortRange": "443-443",
+              "destinationPortRange": "{{GetKubernetesAPIServerPort}}-{{GetKubernetesAPIServerPort}}",
               "direction": "Inbound",
               "priority": 100,
               "protocol": "Tcp",
               "sourceAddressPrefix": "*",
               "sourcePortRange": "*"
             }
-          }
+          }{{GetAdditionalSecurityRules}}
         ]
       },
       "type": "Microsoft.Network/networkSecurityGroups"
@@ -167,8 +167,8 @@
                 "id": "[concat(variables('masterLbID'), '/backendAddressPools/', variables('masterLbBackendPoolName'))]"
               },
               "protocol": "tcp",
-              "frontendPort": 443,
-              "backendPort": 443,
+              "frontendPort": {{GetKubernetesAPIServerPort}},
+              "backendPort": {{GetKubernetesAPIServerPort}},
               "enableFloatingIP": false,
               "idleTimeoutInMinutes": 5,
               "loadDistribution": "Default",
@@ -183,9 +183,9 @@
             "name": "tcpHTTPSProbe",
             "properties": {
               "protocol": "tcp",
-              "port": 443,
-              "intervalInSeconds": "5",
-              "numberOfProbes": "2"
+              "port": {{GetKubernetesAPIServerPort}},
+              "intervalInSeconds": {{.OrchestratorProfile.KubernetesConfig.MasterLBProbeIntervalInSeconds}},
+              "numberOfProbes": {{.OrchestratorProfile.KubernetesConfig.MasterLBProbeNumberOfProbes}}
             }
           }
         ]
@@ -234,7 +234,7 @@
               "frontendIPConfiguration": {
                 "id": "[variables('masterInternalLbIPConfigID')]"
               },
-              "frontendPort": 443,
+              "frontendPort": {{GetKubernetesAPIServerPort}},
               "idleTimeoutInMinutes": 5,
               "protocol": "tcp"
             }
@@ -244,8 +244,8 @@
           {
             "name": "tcpHTTPSProbe",
             "properties": {
-              "intervalInSeconds": "5",
-              "numberOfProbes": "2",
+              "intervalInSeconds": {{.OrchestratorProfile.KubernetesConfig.MasterLBProbeIntervalInSeconds}},
+              "numberOfProbes": {{.OrchestratorProfile.KubernetesConfig.MasterLBProbeNumberOfProbes}},
               "port": 4443,
               "protocol": "tcp"
             }
@@ -386,7 +386,9 @@
       {
         "creationSource" : "[concat('acsengine-', variables('masterVMNamePrefix'), copyIndex(variables('masterOffset')))]",
         "resourceNameSuffix" : "[variables('nameSuffix')]",
-        "orchestrator" : "[variables('orchestratorNameVersionTag')]"
+        "orchestrator" : "[variables('orchestratorNameVersionTag')]",
+        "apimodelHash" : "[variables('apimodelHash')]",
+        "generatedAt" : "[variables('generatedAt')]"
       },
       "location": "[variables('location')]",
       "name": "[concat(variables('masterVMNamePrefix'), copyIndex(variables('masterOffset')))]",
@@ -428,7 +430,7 @@
           "dataDisks": [
             {
               "createOption": "Empty"
-              ,"diskSizeGB": "128"
+              ,"diskSizeGB": "{{GetEtcdDiskSizeGB}}"
               ,"lun": 0
           {{if .MasterProfile.IsStorageAccount}}
               ,"name": "[concat(variables('masterVMNamePrefix'), copyIndex(variables('masterOffset')),'-etcddisk')]"
@@ -481,7 +483,7 @@
         "autoUpgradeMinorVersion": true,
         "settings": {},
         "protectedSettings": {
-          "commandToExecute": "[concat('/usr/bin/nohup /bin/bash -c \"/bin/bash /opt/azure/containers/provision.sh ',variables('tenantID'),' ',variables('subscriptionId'),' ',variables('resourceGroup'),' ',variables('location'),' ',variables('subnetName'),' ',variables('nsgName'),' ',variables('virtualNetworkName'),' ',variables('routeTableName'),' ',variables('primaryAvailablitySetName'),' ',variables('servicePrincipalClientId'),' ',variables('servicePrincipalClientSecret'),' ',variables('clientPrivateKey'),' ',variables('targetEnvironment'),' ',variables('networkPolicy'),' ',variables('apiServerPrivateKey'),' ',variables('caCertificate'),' ',variables('caPrivateKey'),' ',variables('masterFqdnPrefix'),' ',variables('kubeConfigCertificate'),' ',variables('kubeConfigPrivateKey'),' ',variables('username'),' >> /var/log/azure/cluster-provision.log 2>&1\"')]"
+          "commandToExecute": "[concat('/usr/bin/nohup /bin/bash -c \"/bin/bash /opt/azure/containers/provision.sh ',variables('tenantID'),' ',variables('subscriptionId'),' ',variables('resourceGroup'),' ',variables('location'),' ',variables('subnetName'),' ',variables('nsgName'),' ',variables('virtualNetworkName'),' ',variables('routeTableName'),' ',variables('primaryAvailablitySetName'),' ',variables('servicePrincipalClientId'),' ',variables('servicePrincipalClientSecret'),' ',variables('clientPrivateKey'),' ',variables('targetEnvironment'),' ',variables('networkPolicy'),' ',variables('cloudProviderRateLimitQPS'),' ',variables('cloudProviderRateLimitBucket'),' ',variables('apiServerPrivateKey'),' ',variables('caCertificate'),' ',variables('caPrivateKey'),' ',variables('masterFqdnPrefix'),' ',variables('kubeConfigCertificate'),' ',variables('kubeConfigPrivateKey'),' ',variables('username'),' ''',variables('serviceAccountPrivateKey'),'''',{{if .HasPrivateRegistry}}' ',variables('privateRegistryServer'),' ',base64(concat(variables('privateRegistryUsername'),':',variables('privateRegistryPassword'))),{{end}}' >> /var/log/azure/cluster-provision.log 2>&1\"')]"
         }
       }
     }
@@ -7,18 +7,42 @@
     "clientPrivateKey": "[parameters('clientPrivateKey')]",
     "kubeConfigCertificate": "[parameters('kubeConfigCertificate')]",
     "kubeConfigPrivateKey": "[parameters('kubeConfigPrivateKey')]",
+{{if .CertificateProfile.HasServiceAccountPrivateKey}}
+    "serviceAccountPrivateKey": "[parameters('serviceAccountPrivateKey')]",
+{{else}}
+    "serviceAccountPrivateKey": "",
+{{end}}
+{{if .CertificateProfile.HasServiceAccountVerificationKeys}}
+    "serviceAccountVerificationKeys": "[parameters('serviceAccountVerificationKeys')]",
+{{end}}
     "kubernetesHyperkubeSpec": "[parameters('kubernetesHyperkubeSpec')]",
     "kubernetesAddonManagerSpec": "[parameters('kubernetesAddonManagerSpec')]",
     "kubernetesAddonResizerSpec": "[parameters('kubernetesAddonResizerSpec')]",
     "kubernetesDashboardSpec": "[parameters('kubernetesDashboardSpec')]",
     "kubernetesExecHealthzSpec": "[parameters('kubernetesExecHealthzSpec')]",
     "kubernetesHeapsterSpec": "[parameters('kubernetesHeapsterSpec')]",
+{{if .OrchestratorProfile.KubernetesConfig.PodInfraContainerImage}}
+    "kubernetesPodInfraContainerSpec": "{{.OrchestratorProfile.KubernetesConfig.PodInfraContainerImage}}",
+{{else}}
     "kubernetesPodInfraContainerSpec": "[parameters('kubernetesPodInfraContainerSpec')]",
+{{end}}
     "kubernetesKubeDNSSpec": "[parameters('kubernetesKubeDNSSpec')]",
     "kubernetesDNSMasqSpec": "[parameters('kubernetesDNSMasqSpec')]",
+{{if .OrchestratorProfile.KubernetesConfig.KubeProxyImage}}
+    "kubernetesKubeProxySpec": "{{.OrchestratorProfile.KubernetesConfig.KubeProxyImage}}",
+{{else}}
+    "kubernetesKubeProxySpec": "[parameters('kubernetesHyperkubeSpec')]",
+{{end}}
     "networkPolicy": "[parameters('networkPolicy')]",
+    "cloudProviderRateLimitQPS": "{{GetCloudProviderRateLimitQPS}}",
+    "cloudProviderRateLimitBucket": "{{GetCloudProviderRateLimitBucket}}",
     "servicePrincipalClientId": "[parameters('servicePrincipalClientId')]",
     "servicePrincipalClientSecret": "[parameters('servicePrincipalClientSecret')]",
+{{if .HasPrivateRegistry}}
+    "privateRegistryServer": "[parameters('privateRegistryServer')]",
+    "privateRegistryUsername": "[parameters('privateRegistryUsername')]",
+    "privateRegistryPassword": "[parameters('privateRegistryPassword')]",
+{{end}}
     "username": "[parameters('linuxAdminUsername')]",
     "masterFqdnPrefix": "[tolower(parameters('masterEndpointDNSNamePrefix'))]",
     "masterPrivateIp": "[parameters('firstConsecutiveStaticIP')]",
@@ -57,7 +81,11 @@
     "storageAccountPrefixes": [ "0", "6", "c", "i", "o", "u", "1", "7", "d", "j", "p", "v", "2", "8", "e", "k", "q", "w", "3", "9", "f", "l", "r", "x", "4", "a", "g", "m", "s", "y", "5", "b", "h", "n", "t", "z" ],
     "storageAccountPrefixesCount": "[length(variables('storageAccountPrefixes'))]",
     "vmsPerStorageAccount": 20,
+{{if .HasUniqueStorageNames}}
+    "storageAccountBaseName": "[uniqueString(concat(subscription().subscriptionId,resourceGroup().name,variables('masterFqdnPrefix'),variables('location')))]",
+{{else}}
     "storageAccountBaseName": "[uniqueString(concat(variables('masterFqdnPrefix'),variables('location')))]",
+{{end}}
     {{GetSizeMap}},
 {{else}}
     "storageAccountPrefixes": [],
@@ -67,10 +95,12 @@
     "apiVersionStorageManagedDisks": "2016-04-30-preview",
 {{end}}
 {{if .MasterProfile.IsStorageAccount}}
-    "masterStorageAccountName": "[concat(variables('storageAccountBaseName'), 'mstr0')]",
+    "masterStorageAccountName": "{{GetStorageAccountName "mstr" 0}}",
 {{end}}
     "provisionScript": "{{GetKubernetesB64Provision}}",
     "orchestratorNameVersionTag": "{{.OrchestratorProfile.OrchestratorType}}:{{.OrchestratorProfile.OrchestratorVersion}}",
+    "apimodelHash": "{{GetAPIModelHash}}",
+    "generatedAt": "{{GetGeneratedAt}}",
 {{if IsVNETIntegrated}}
     "allocateNodeCidrs": false,
 {{else}}
@@ -93,6 +123,15 @@
     "kubeDnsServiceIp": "10.0.0.10",
     "kubeServiceCidr": "10.0.0.0/16",
     "kubeClusterCidr": "[parameters('kubeClusterCidr')]",
+    "kubernetesAPIServerPort": "{{GetKubernetesAPIServerPort}}",
+    "leaderElectLeaseDuration": "{{.OrchestratorProfile.KubernetesConfig.LeaderElectLeaseDuration}}",
+    "leaderElectRenewDeadline": "{{.OrchestratorProfile.KubernetesConfig.LeaderElectRenewDeadline}}",
+    "leaderElectRetryPeriod": "{{.OrchestratorProfile.KubernetesConfig.LeaderElectRetryPeriod}}",
+{{if .OrchestratorProfile.KubernetesConfig.IsAnonymousAuthDisabled}}
+    "anonymousAuth": "false",
+{{else}}
+    "anonymousAuth": "true",
+{{end}}
     "dockerBridgeCidr": "[parameters('dockerBridgeCidr')]",
 {{if HasLinuxAgents}}
     "registerSchedulable": "false",
@@ -189,10 +228,17 @@
     "windowsAdminPassword": "[parameters('windowsAdminPassword')]",
     "kubeBinariesSASURL": "[parameters('kubeBinariesSASURL')]",
     "kubeBinariesVersion": "[parameters('kubeBinariesVersion')]",
+{{if .WindowsProfile.HasCustomImage}}
+    "agentWindowsPublisher": "{{.WindowsProfile.ImagePublisher}}",
+    "agentWindowsOffer": "{{.WindowsProfile.ImageOffer}}",
+    "agentWindowsSku": "{{.WindowsProfile.ImageSku}}",
+    "agentWindowsVersion": "{{.WindowsProfile.GetImageVersion}}",
+{{else}}
     "agentWindowsPublisher": "MicrosoftWindowsServer",
     "agentWindowsOffer": "WindowsServer",
     "agentWindowsSku": "2016-Datacenter-with-Containers",
     "agentWindowsVersion": "latest",
+{{end}}
     "singleQuote": "'",
     "windowsCustomScriptSuffix": " $inputFile = '%SYSTEMDRIVE%\\AzureData\\CustomData.bin' ; $outputFile = '%SYSTEMDRIVE%\\AzureData\\CustomDataSetupScript.ps1' ; Copy-Item $inputFile $outputFile ; Invoke-Expression('{0} {1}' -f $outputFile, $arguments) ; "
 {{end}}
\ No newline at end of file
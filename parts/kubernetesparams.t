@@ -155,6 +155,42 @@
       },
       "type": "securestring"
     },
+{{if .CertificateProfile.HasServiceAccountPrivateKey}}
+    "serviceAccountPrivateKey": {
+      "metadata": {
+        "description": "The base 64 private key service account tokens are signed with."
+      },
+      "type": "securestring"
+    },
+{{end}}
+{{if .CertificateProfile.HasServiceAccountVerificationKeys}}
+    "serviceAccountVerificationKeys": {
+      "metadata": {
+        "description": "The base 64 public keys service account tokens are verified with besides the signing key."
+      },
+      "type": "string"
+    },
+{{end}}
+{{if .HasPrivateRegistry}}
+    "privateRegistryServer": {
+      "metadata": {
+        "description": "The private registry the image pull secret is created for."
+      },
+      "type": "string"
+    },
+    "privateRegistryUsername": {
+      "metadata": {
+        "description": "The user name of the private registry."
+      },
+      "type": "string"
+    },
+    "privateRegistryPassword": {
+      "metadata": {
+        "description": "The password of the private registry."
+      },
+      "type": "securestring"
+    },
+{{end}}
     "masterOffset": {
       "defaultValue": 0,
       "allowedValues": [
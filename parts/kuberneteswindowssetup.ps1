@@ -47,6 +47,7 @@ $global:RRASServiceName = "RemoteAccess"
 $global:KubeDir = "c:\k"
 $global:KubeBinariesSASURL = "{{WrapAsVariable "kubeBinariesSASURL"}}"
 $global:KubeBinariesVersion = "{{WrapAsVariable "kubeBinariesVersion"}}"
+$global:KubeBinariesChecksum = "{{GetKubernetesArtifactChecksum "windows-binaries"}}"
 $global:KubeletStartFile = $global:KubeDir + "\kubeletstart.ps1"
 $global:KubeProxyStartFile = $global:KubeDir + "\kubeproxystart.ps1"
 $global:NatNetworkName="nat"
@@ -87,6 +88,10 @@ Get-KubeBinaries()
 {
     $zipfile = "c:\k.zip"
     Invoke-WebRequest -Uri $global:KubeBinariesSASURL -OutFile $zipfile
+    if ($global:KubeBinariesChecksum -ne "" -and (Get-FileHash -Algorithm SHA256 -Path $zipfile).Hash -ne $global:KubeBinariesChecksum)
+    {
+        throw "SHA256 checksum mismatch for $global:KubeBinariesSASURL"
+    }
     Expand-ZIPFile -File $zipfile -Destination C:\
 }
 
@@ -141,7 +146,7 @@ apiVersion: v1
 clusters:
 - cluster:
     certificate-authority-data: "$global:CACertificate"
-    server: https://${MasterIP}:443
+    server: https://${MasterIP}:{{GetKubernetesAPIServerPort}}
   name: "$MasterFQDNPrefix"
 contexts:
 - context:
@@ -170,9 +175,9 @@ New-InfraContainer()
 function
 Write-KubernetesStartFiles($podCIDR)
 {
-    $KubeletArgList = @("--hostname-override=`$global:AzureHostname","--pod-infra-container-image=kubletwin/pause","--resolv-conf=""""""""","--api-servers=https://`${global:MasterIP}:443","--kubeconfig=c:\k\config")
+    $KubeletArgList = @("--hostname-override=`$global:AzureHostname","--pod-infra-container-image=kubletwin/pause","--resolv-conf=""""""""","--api-servers=https://`${global:MasterIP}:{{GetKubernetesAPIServerPort}}","--kubeconfig=c:\k\config","--node-labels={{GetClusterNameLabel}}")
     $KubeletCommandLine = @"
-c:\k\kubelet.exe --hostname-override=`$global:AzureHostname --pod-infra-container-image=kubletwin/pause --resolv-conf="" --allow-privileged=true --enable-debugging-handlers --api-servers=https://`${global:MasterIP}:443 --cluster-dns=`$global:KubeDnsServiceIp --cluster-domain=cluster.local  --kubeconfig=c:\k\config --hairpin-mode=promiscuous-bridge --v=2 --azure-container-registry-config=c:\k\azure.json
+c:\k\kubelet.exe --hostname-override=`$global:AzureHostname --pod-infra-container-image=kubletwin/pause --resolv-conf="" --allow-privileged=true --enable-debugging-handlers --api-servers=https://`${global:MasterIP}:{{GetKubernetesAPIServerPort}} --cluster-dns=`$global:KubeDnsServiceIp --cluster-domain=cluster.local  --kubeconfig=c:\k\config --hairpin-mode=promiscuous-bridge --v=2 --azure-container-registry-config=c:\k\azure.json --node-labels={{GetClusterNameLabel}}
 "@
 
     if ($global:KubeBinariesVersion -ne "1.5.3" -and $global:KubeBinariesVersion -ne "1.5.7")
@@ -339,6 +344,15 @@ New-NSSMService
     }
 }
 
+function
+Write-HostAliases()
+{
+    $hostsFile = "$env:SystemRoot\System32\drivers\etc\hosts"
+{{range GetHostsFileEntries}}
+    Add-Content -Path $hostsFile -Value "{{.}}"
+{{end}}
+}
+
 function
 Set-Explorer
 {
@@ -359,6 +373,11 @@ try
     if ($true) {
         Write-Log "Provisioning $global:DockerServiceName... with IP $MasterIP"
 
+{{if HasHostAliases}}
+        Write-Log "Write host aliases"
+        Write-HostAliases
+{{end}}
+
         Write-Log "download kubelet binaries and unzip"
         Get-KubeBinaries
 
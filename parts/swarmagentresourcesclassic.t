@@ -11,7 +11,7 @@
       "location": "[variables('location')]", 
       "name": "[concat(variables('storageAccountPrefixes')[mod(copyIndex(),variables('storageAccountPrefixesCount'))],variables('storageAccountPrefixes')[div(copyIndex(),variables('storageAccountPrefixesCount'))],variables('storageAccountBaseClassicName'),copyIndex(1))]", 
       "properties": {
-        "accountType": "[variables('vmSizesMap')[variables('{{.Name}}VMSize')].storageAccountType]"
+        "accountType": "{{GetStorageAccountType .}}"
       }, 
       "type": "Microsoft.Storage/storageAccounts"
     },
@@ -126,7 +126,7 @@
           }, 
           "osProfile": {
             "adminUsername": "[variables('adminUsername')]", 
-            "computerNamePrefix": "[variables('{{.Name}}VMNamePrefix')]", 
+            "computerNamePrefix": "[variables('{{.Name}}ComputerNamePrefix')]", 
 {{if IsSwarmMode}}
             {{GetAgentSwarmModeCustomData}} 
 {{else}}
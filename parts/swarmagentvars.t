@@ -1,10 +1,11 @@
     "{{.Name}}Count": "[parameters('{{.Name}}Count')]", 
     "{{.Name}}VMNamePrefix": "[concat(variables('orchestratorName'), '-{{.Name}}-', variables('nameSuffix'))]", 
+    "{{.Name}}ComputerNamePrefix": "{{GetAgentComputerNamePrefix .}}",
     "{{.Name}}VMSize": "[parameters('{{.Name}}VMSize')]", 
     "{{.Name}}VMSizeTier": "[split(parameters('{{.Name}}VMSize'),'_')[0]]",
 {{if .IsAvailabilitySets}}
     {{if .IsStorageAccount}}
-    "{{.Name}}StorageAccountsCount": "[add(div(variables('{{.Name}}Count'), variables('maxVMsPerStorageAccount')), mod(add(mod(variables('{{.Name}}Count'), variables('maxVMsPerStorageAccount')),2), add(mod(variables('{{.Name}}Count'), variables('maxVMsPerStorageAccount')),1)))]",
+    "{{.Name}}StorageAccountsCount": "{{GetStorageAccountsCount .}}",
     "{{.Name}}StorageAccountOffset": "[mul(variables('maxStorageAccountsPerAgent'),variables('{{.Name}}Index'))]",
     {{end}}
     "{{.Name}}AvailabilitySet": "[concat('{{.Name}}-availabilitySet-', variables('nameSuffix'))]",
@@ -14,9 +14,9 @@
         {{template "swarmagentvars.t" .}}
         {{if .IsStorageAccount}}
           "{{.Name}}StorageAccountOffset": "[mul(variables('maxStorageAccountsPerAgent'),{{$index}})]",
-          "{{.Name}}AccountName": "[concat(variables('storageAccountBaseName'), 'agnt{{$index}}')]",
+          "{{.Name}}AccountName": "{{GetStorageAccountName "agnt" $index}}",
           {{if .HasDisks}}
-            "{{.Name}}DataAccountName": "[concat(variables('storageAccountBaseName'), 'data{{$index}}')]",
+            "{{.Name}}DataAccountName": "{{GetStorageAccountName "data" $index}}",
           {{end}}
         {{end}}
     {{end}}
@@ -92,6 +92,16 @@
         {
           "id": "[concat(variables('masterSshInboundNatRuleIdPrefix'),'4')]"
         }
+      ],
+      [
+        {
+          "id": "[concat(variables('masterSshInboundNatRuleIdPrefix'),'5')]"
+        }
+      ],
+      [
+        {
+          "id": "[concat(variables('masterSshInboundNatRuleIdPrefix'),'6')]"
+        }
       ]
     ],
     "osImageOffer": "UbuntuServer", 
@@ -120,7 +130,11 @@
     "storageAccountPrefixes": [ "0", "6", "c", "i", "o", "u", "1", "7", "d", "j", "p", "v", "2", "8", "e", "k", "q", "w", "3", "9", "f", "l", "r", "x", "4", "a", "g", "m", "s", "y", "5", "b", "h", "n", "t", "z" ],
     "storageAccountPrefixesCount": "[length(variables('storageAccountPrefixes'))]", 
     "vmsPerStorageAccount": 20,
+{{if .HasUniqueStorageNames}}
+    "storageAccountBaseName": "[uniqueString(concat(subscription().subscriptionId,resourceGroup().name,variables('masterEndpointDNSNamePrefix'),variables('location')))]",
+{{else}}
     "storageAccountBaseName": "[uniqueString(concat(variables('masterEndpointDNSNamePrefix'),variables('location')))]",
+{{end}}
     {{GetSizeMap}},
 {{else}}
     "storageAccountPrefixes": [],
@@ -130,16 +144,23 @@
     "apiVersionStorageManagedDisks": "2016-04-30-preview",
 {{end}}
 {{if .MasterProfile.IsStorageAccount}}
-    "masterStorageAccountName": "[concat(variables('storageAccountBaseName'), '0')]",
+    "masterStorageAccountName": "{{GetStorageAccountName "" 0}}",
 {{end}}
     "sshRSAPublicKey": "[parameters('sshRSAPublicKey')]"
 {{if .HasWindows}}
     ,"windowsAdminUsername": "[parameters('windowsAdminUsername')]",
     "windowsAdminPassword": "[parameters('windowsAdminPassword')]",
+{{if .WindowsProfile.HasCustomImage}}
+    "agentWindowsPublisher": "{{.WindowsProfile.ImagePublisher}}",
+    "agentWindowsOffer": "{{.WindowsProfile.ImageOffer}}",
+    "agentWindowsSku": "{{.WindowsProfile.ImageSku}}",
+    "agentWindowsVersion": "{{.WindowsProfile.GetImageVersion}}",
+{{else}}
     "agentWindowsPublisher": "MicrosoftWindowsServer",
     "agentWindowsOffer": "WindowsServer",
     "agentWindowsSku": "2016-Datacenter-with-Containers",
     "agentWindowsVersion": "latest",
+{{end}}
     "singleQuote": "'",
     "windowsCustomScriptArguments": "[concat('$arguments = ', variables('singleQuote'),'-SwarmMasterIP ', variables('masterFirstAddrPrefix'), variables('masterFirstAddrOctet4'), variables('singleQuote'), ' ; ')]",
     "windowsCustomScriptSuffix": " $inputFile = '%SYSTEMDRIVE%\\AzureData\\CustomData.bin' ; $outputFile = '%SYSTEMDRIVE%\\AzureData\\CustomDataSetupScript.ps1' ; $inputStream = New-Object System.IO.FileStream $inputFile, ([IO.FileMode]::Open), ([IO.FileAccess]::Read), ([IO.FileShare]::Read) ; $sr = New-Object System.IO.StreamReader(New-Object System.IO.Compression.GZipStream($inputStream, [System.IO.Compression.CompressionMode]::Decompress)) ; $sr.ReadToEnd() | Out-File($outputFile) ; Invoke-Expression('{0} {1}' -f $outputFile, $arguments) ; ",
@@ -11,7 +11,7 @@
       "location": "[variables('location')]", 
       "name": "[concat(variables('storageAccountPrefixes')[mod(add(copyIndex(),variables('{{.Name}}StorageAccountOffset')),variables('storageAccountPrefixesCount'))],variables('storageAccountPrefixes')[div(add(copyIndex(),variables('{{.Name}}StorageAccountOffset')),variables('storageAccountPrefixesCount'))],variables('{{.Name}}AccountName'))]", 
       "properties": {
-        "accountType": "[variables('vmSizesMap')[variables('{{.Name}}VMSize')].storageAccountType]"
+        "accountType": "{{GetStorageAccountType .}}"
       }, 
       "type": "Microsoft.Storage/storageAccounts"
     },
@@ -143,7 +143,7 @@
             ]
           }, 
           "osProfile": {
-            "computerNamePrefix": "[concat(substring(variables('nameSuffix'), 0, 5), 'acs')]",
+            "computerNamePrefix": "[variables('{{.Name}}ComputerNamePrefix')]",
             "adminUsername": "[variables('windowsAdminUsername')]",
             "adminPassword": "[variables('windowsAdminPassword')]",
             {{if IsSwarmMode}}
@@ -161,7 +161,7 @@
               "publisher": "[variables('agentWindowsPublisher')]",
               "offer": "[variables('agentWindowsOffer')]",
               "sku": "[variables('agentWindowsSku')]",
-              "version": "latest"
+              "version": "[variables('agentWindowsVersion')]"
             }, 
             "osDisk": {
               "caching": "ReadWrite"
@@ -144,17 +144,10 @@ Get-FileContents() {
 	$text += @"
 
 
-import "fmt"
+import "github.com/Azure/acs-engine/pkg/api/common"
 
 // AUTOGENERATED FILE - last generated $(Get-Date -format 'u')
 
-const (
-	// AzureProdFQDNFormat specifies the format for a prod dns name
-	AzurePublicProdFQDNFormat = "%s.%s.cloudapp.azure.com"
-	//AzureChinaProdFQDNFormat specify the endpoint of Azure China Cloud
-	AzureChinaProdFQDNFormat = "%s.%s.cloudapp.chinacloudapi.cn"
-)
-
 // AzureLocations provides all azure regions in prod.
 // Related powershell to refresh this list:
 //   Get-AzureRmLocation | Select-Object -Property Location
@@ -178,11 +171,7 @@ func FormatAzureProdFQDNs(fqdnPrefix string) []string {
 
 // FormatAzureProdFQDN constructs an Azure prod fqdn
 func FormatAzureProdFQDN(fqdnPrefix string, location string) string {
-	FQDNFormat := AzurePublicProdFQDNFormat
-	if location == "chinaeast" || location == "chinanorth" {
-		FQDNFormat = AzureChinaProdFQDNFormat
-	}
-	return fmt.Sprintf(FQDNFormat, fqdnPrefix, location)
+	return common.FormatAzureProdFQDN(fqdnPrefix, location)
 }
 
 // GetDCOSMasterAllowedSizes returns the master allowed sizes
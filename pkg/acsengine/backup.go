@@ -0,0 +1,132 @@
+package acsengine
+
+import (
+	"fmt"
+
+	"github.com/Azure/acs-engine/pkg/api"
+	"github.com/Azure/acs-engine/pkg/api/common"
+)
+
+// BackupDescriptor lists the stateful components of a cluster that have to be captured to
+// restore it, computed from the resolved model. It does not perform any backup itself.
+type BackupDescriptor struct {
+	OrchestratorType   api.OrchestratorType  `json:"orchestratorType"`
+	Etcd               *EtcdBackupDescriptor `json:"etcd,omitempty"`
+	PKIPaths           []string              `json:"pkiPaths,omitempty"`
+	Identity           IdentityDescriptor    `json:"identity"`
+	KeyVaultReferences []string              `json:"keyVaultReferences,omitempty"`
+}
+
+// EtcdBackupDescriptor locates the etcd data of the masters
+type EtcdBackupDescriptor struct {
+	DataDir        string   `json:"dataDir"`
+	DiskSizeGB     int      `json:"diskSizeGB"`
+	DiskLun        int      `json:"diskLun"`
+	StorageProfile string   `json:"storageProfile"`
+	Encryption     string   `json:"encryption"`
+	Members        []string `json:"members"`
+}
+
+// IdentityDescriptor describes the identity the cluster uses to call Azure
+type IdentityDescriptor struct {
+	Type     string `json:"type"`
+	ClientID string `json:"clientID,omitempty"`
+}
+
+const (
+	// servicePrincipalIdentity is the only identity clusters generated by this tree use to call Azure
+	servicePrincipalIdentity = "servicePrincipal"
+	// platformManagedEncryption is the encryption Azure storage applies to the etcd disk, no customer key is used
+	platformManagedEncryption = "platformManagedKey"
+)
+
+// kubernetesMasterPKIPaths are the files the master provisioning script writes the cluster PKI,
+// service account keys and cloud provider credentials to. The keys of the agents are signed by
+// the same CA.
+var kubernetesMasterPKIPaths = []string{
+	"/etc/kubernetes/certs/ca.crt",
+	"/etc/kubernetes/certs/ca.key",
+	"/etc/kubernetes/certs/apiserver.crt",
+	"/etc/kubernetes/certs/apiserver.key",
+	"/etc/kubernetes/certs/client.crt",
+	"/etc/kubernetes/certs/client.key",
+	"/etc/kubernetes/certs/serviceaccount.key",
+	"/etc/kubernetes/certs/serviceaccount.pub",
+	"/etc/kubernetes/azure.json",
+}
+
+// serviceAccountVerificationKeysPath is where the masters keep the extra service account verification keys
+const serviceAccountVerificationKeysPath = "/etc/kubernetes/certs/serviceaccount-verification.pub"
+
+// GetBackupDescriptor returns the backup descriptor of the cluster described by the container service
+func GetBackupDescriptor(cs *api.ContainerService) *BackupDescriptor {
+	properties := cs.Properties
+	descriptor := &BackupDescriptor{
+		Identity: IdentityDescriptor{Type: servicePrincipalIdentity},
+	}
+	if properties.OrchestratorProfile != nil {
+		descriptor.OrchestratorType = properties.OrchestratorProfile.OrchestratorType
+	}
+	if properties.ServicePrincipalProfile != nil {
+		descriptor.Identity.ClientID = properties.ServicePrincipalProfile.ClientID
+	}
+
+	if descriptor.OrchestratorType == api.Kubernetes && properties.MasterProfile != nil {
+		storageProfile := properties.MasterProfile.StorageProfile
+		if storageProfile == "" {
+			storageProfile = api.StorageAccount
+		}
+		etcd := &EtcdBackupDescriptor{
+			DataDir:        EtcdDataDir,
+			DiskSizeGB:     EtcdDiskSizeGB,
+			DiskLun:        EtcdDiskLun,
+			StorageProfile: storageProfile,
+			Encryption:     platformManagedEncryption,
+			Members:        []string{},
+		}
+		clusterID := GenerateClusterID(properties)
+		for i := 0; i < properties.MasterProfile.Count; i++ {
+			etcd.Members = append(etcd.Members, fmt.Sprintf("k8s-master-%s-%d", clusterID, i))
+		}
+		descriptor.Etcd = etcd
+		descriptor.PKIPaths = append(descriptor.PKIPaths, kubernetesMasterPKIPaths...)
+		if properties.CertificateProfile != nil && properties.CertificateProfile.HasServiceAccountVerificationKeys() {
+			descriptor.PKIPaths = append(descriptor.PKIPaths, serviceAccountVerificationKeysPath)
+		}
+	}
+
+	descriptor.KeyVaultReferences = getKeyVaultReferences(properties)
+	return descriptor
+}
+
+// getKeyVaultReferences returns the keyvaults and keyvault secrets the model refers to,
+// which are not captured by a backup of the cluster itself
+func getKeyVaultReferences(properties *api.Properties) []string {
+	references := []string{}
+	addSecrets := func(secrets []api.KeyVaultSecrets) {
+		for _, secret := range secrets {
+			if secret.SourceVault != nil && secret.SourceVault.ID != "" {
+				references = append(references, secret.SourceVault.ID)
+			}
+		}
+	}
+	addSecretReference := func(value string) {
+		if common.KeyVaultSecretRefRegex.MatchString(value) {
+			references = append(references, value)
+		}
+	}
+	if properties.ServicePrincipalProfile != nil {
+		addSecretReference(properties.ServicePrincipalProfile.Secret)
+	}
+	if properties.LinuxProfile != nil {
+		addSecrets(properties.LinuxProfile.Secrets)
+	}
+	if properties.WindowsProfile != nil {
+		addSecretReference(properties.WindowsProfile.AdminPassword)
+		addSecrets(properties.WindowsProfile.Secrets)
+	}
+	if properties.PrivateRegistryProfile != nil {
+		addSecretReference(properties.PrivateRegistryProfile.Password)
+	}
+	return references
+}
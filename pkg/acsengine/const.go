@@ -19,6 +19,8 @@ const (
 	DefaultKubernetesClusterSubnet = "10.244.0.0/16"
 	// DefaultDockerBridgeSubnet specifies the default subnet for the docker bridge network for masters and agents.
 	DefaultDockerBridgeSubnet = "172.17.0.1/16"
+	// DefaultKubernetesServiceCIDR specifies the subnet of Kubernetes service cluster IPs
+	DefaultKubernetesServiceCIDR = "10.0.0.0/16"
 	// DefaultFirstConsecutiveKubernetesStaticIP specifies the static IP address on Kubernetes master 0
 	DefaultFirstConsecutiveKubernetesStaticIP = "10.240.255.5"
 	// DefaultAgentSubnetTemplate specifies a default agent subnet
@@ -36,13 +38,66 @@ const (
 	DefaultAgentMultiIPAddressCount = 128
 	// DefaultKubernetesClusterDomain is the dns suffix used in the cluster (used as a SAN in the PKI generation)
 	DefaultKubernetesClusterDomain = "cluster.local"
-	// DefaultInternalLbStaticIPOffset specifies the offset of the internal LoadBalancer's IP
-	// address relative to the first consecutive Kubernetes static IP
-	DefaultInternalLbStaticIPOffset = 10
 	// DefaultNetworkPolicy is disabling network policy enforcement
 	DefaultNetworkPolicy = "none"
+	// DefaultPrivateRegistryNamespace is the namespace the private registry image pull secret is added to by default
+	DefaultPrivateRegistryNamespace = "default"
+	// DefaultMasterLBProbeIntervalInSeconds is the interval between probes of the apiserver by the master load balancers
+	DefaultMasterLBProbeIntervalInSeconds = 5
+	// DefaultMasterLBProbeNumberOfProbes is the number of failed probes after which a master is taken out of rotation
+	DefaultMasterLBProbeNumberOfProbes = 2
+	// DefaultProvisionRetryCount is the number of attempts for each download during provisioning
+	DefaultProvisionRetryCount = 5
+	// DefaultProvisionTimeoutInSeconds is the timeout of each download attempt during provisioning
+	DefaultProvisionTimeoutInSeconds = 60
+	// DefaultLeaderElectLeaseDuration is the leader election lease duration of the controller manager and scheduler.
+	// It is twice the Kubernetes default so that leadership survives brief network disruptions.
+	DefaultLeaderElectLeaseDuration = "30s"
+	// DefaultLeaderElectRenewDeadline is the time the leading controller manager or scheduler has to renew its lease
+	DefaultLeaderElectRenewDeadline = "20s"
+	// DefaultLeaderElectRetryPeriod is the time between leader election attempts of the controller manager and scheduler
+	DefaultLeaderElectRetryPeriod = "5s"
+	// MaxStorageAccountNameLength is the longest storage account name Azure accepts
+	MaxStorageAccountNameLength = 24
+	// StorageAccountBaseNameLength is the length of the uniqueString hash storage account names are built on
+	StorageAccountBaseNameLength = 13
+	// StorageAccountPrefixLength is the length of the prefix that spreads agent storage accounts across storage partitions
+	StorageAccountPrefixLength = 2
+	// ClusterNameLabel is the node label identifying the cluster a Kubernetes node belongs to
+	ClusterNameLabel = "cluster-name"
+	// NvidiaGPUTaint is the taint registered by the nodes of GPU agent pools
+	NvidiaGPUTaint = "nvidia.com/gpu=true:NoSchedule"
+	// EtcdDataDir is where the etcd disk of the Kubernetes masters is mounted
+	EtcdDataDir = "/var/lib/etcddisk"
+	// EtcdDiskSizeGB is the size of the etcd disk of the Kubernetes masters
+	EtcdDiskSizeGB = 128
+	// EtcdDiskLun is the LUN the etcd disk is attached to the Kubernetes masters at
+	EtcdDiskLun = 0
+	// IMDSAddress is the address of the Azure instance metadata service
+	IMDSAddress = "169.254.169.254"
+	// PreloadImagesWarningCount is the number of images an agent pool may preload before a warning is logged
+	PreloadImagesWarningCount = 5
+	// HoursPerMonth is the number of hours a month of VMs and public IP addresses is billed for in cost estimates
+	HoursPerMonth = 730
+	// DefaultLinuxOSDiskSizeGB is the size of the OS disk of the Linux images when OSDiskSizeGB is not set
+	DefaultLinuxOSDiskSizeGB = 30
+	// DefaultWindowsOSDiskSizeGB is the size of the OS disk of the Windows images when OSDiskSizeGB is not set
+	DefaultWindowsOSDiskSizeGB = 128
 )
 
+// DockerBridgeSubnetCandidates are the docker bridge subnets tried, in order, when the docker
+// bridge subnet is not specified. The first that does not overlap the cluster's address ranges is used.
+var DockerBridgeSubnetCandidates = []string{
+	DefaultDockerBridgeSubnet,
+	"172.18.0.1/16",
+	"172.19.0.1/16",
+	"172.20.0.1/16",
+	"172.21.0.1/16",
+	"172.22.0.1/16",
+	"172.23.0.1/16",
+	"192.168.0.1/16",
+}
+
 const (
 	// DCOSMaster represents the master node type
 	DCOSMaster DCOSNodeType = "DCOSMaster"
@@ -52,6 +107,18 @@ const (
 	DCOSPublicAgent DCOSNodeType = "DCOSPublicAgent"
 )
 
+// CalicoImages are the calico/node and calico/cni images of each supported Calico release
+var CalicoImages = map[string]map[string]string{
+	"v2.2.1": {
+		"node": "quay.io/calico/node:v1.2.1",
+		"cni":  "quay.io/calico/cni:v1.8.3",
+	},
+	"v2.3.0": {
+		"node": "quay.io/calico/node:v1.3.0",
+		"cni":  "quay.io/calico/cni:v1.9.1",
+	},
+}
+
 // KubeImages represents Docker images used for Kubernetes components based on Kubernetes version
 var KubeImages = map[api.OrchestratorVersion]map[string]string{
 	api.Kubernetes166: {
@@ -64,6 +131,7 @@ var KubeImages = map[api.OrchestratorVersion]map[string]string{
 		"addonmanager": "kube-addon-manager-amd64:v6.4",
 		"dnsmasq":      "k8s-dns-dnsmasq-amd64:1.13.0",
 		"pause":        "pause-amd64:3.0",
+		"etcd":         "etcd-amd64:2.2.5",
 		"windowszip":   "v1.6.6intwinnat.zip",
 	},
 	api.Kubernetes162: {
@@ -76,6 +144,7 @@ var KubeImages = map[api.OrchestratorVersion]map[string]string{
 		"addonmanager": "kube-addon-manager-amd64:v6.4",
 		"dnsmasq":      "k8s-dns-dnsmasq-amd64:1.13.0",
 		"pause":        "pause-amd64:3.0",
+		"etcd":         "etcd-amd64:2.2.5",
 		"windowszip":   "v1.6.2intwinnat.zip",
 	},
 
@@ -89,6 +158,7 @@ var KubeImages = map[api.OrchestratorVersion]map[string]string{
 		"addonmanager": "kube-addon-manager-amd64:v6.4",
 		"dnsmasq":      "k8s-dns-dnsmasq-amd64:1.13.0",
 		"pause":        "pause-amd64:3.0",
+		"etcd":         "etcd-amd64:2.2.5",
 		"windowszip":   "v1.6.0intwinnat.zip",
 	},
 
@@ -102,6 +172,7 @@ var KubeImages = map[api.OrchestratorVersion]map[string]string{
 		"addonmanager": "kube-addon-manager-amd64:v6.2",
 		"dnsmasq":      "kube-dnsmasq-amd64:1.3",
 		"pause":        "pause-amd64:3.0",
+		"etcd":         "etcd-amd64:2.2.5",
 		"windowszip":   "v1.5.7intwinnat.zip",
 	},
 
@@ -115,6 +186,7 @@ var KubeImages = map[api.OrchestratorVersion]map[string]string{
 		"addonmanager": "kube-addon-manager-amd64:v6.2",
 		"dnsmasq":      "kube-dnsmasq-amd64:1.3",
 		"pause":        "pause-amd64:3.0",
+		"etcd":         "etcd-amd64:2.2.5",
 		"windowszip":   "v1.5.3intwinnat.zip",
 	},
 }
@@ -124,6 +196,10 @@ const (
 	MsecndDCOSBootstrapDownloadURL = "https://az837203.vo.msecnd.net/dcos/%s/bootstrap/%s.bootstrap.tar.xz"
 	//AzureEdgeDCOSBootstrapDownloadURL is the azure edge CDN download url
 	AzureEdgeDCOSBootstrapDownloadURL = "https://dcosio.azureedge.net/dcos/%s/bootstrap/%s.bootstrap.tar.xz"
+	//MsecndDCOSRepositoryURL is the Azure CDN DCOS1.7.3 package repository
+	MsecndDCOSRepositoryURL = "https://az837203.vo.msecnd.net/dcos/%s"
+	//AzureEdgeDCOSRepositoryURL is the azure edge CDN package repository
+	AzureEdgeDCOSRepositoryURL = "https://dcosio.azureedge.net/dcos/%s"
 	//AzureChinaCloudDCOSBootstrapDownloadURL is the China specific DCOS package download url.
 	AzureChinaCloudDCOSBootstrapDownloadURL = "https://acsengine.blob.core.chinacloudapi.cn/dcos/%s.bootstrap.tar.xz"
 )
@@ -0,0 +1,107 @@
+package acsengine
+
+import (
+	"fmt"
+
+	"github.com/Azure/acs-engine/pkg/api"
+)
+
+// CostPrices are the prices, in the currency of the caller's choice, the monthly cost of a cluster is
+// estimated from. No pricing data is shipped with acs-engine.
+type CostPrices struct {
+	// VMSizeHourly is the hourly price of each VM size, such as Standard_D2_v2
+	VMSizeHourly map[string]float64 `json:"vmSizeHourly"`
+	// DiskGBMonthly is the monthly price of a GB of OS or data disk
+	DiskGBMonthly float64 `json:"diskGBMonthly"`
+	// PublicIPHourly is the hourly price of a public IP address
+	PublicIPHourly float64 `json:"publicIPHourly"`
+}
+
+// CostEstimate is the estimated monthly cost of a cluster, broken down per pool
+type CostEstimate struct {
+	Pools         []PoolCost `json:"pools"`
+	PublicIPCount int        `json:"publicIPCount"`
+	PublicIPs     float64    `json:"publicIPs"`
+	Total         float64    `json:"total"`
+}
+
+// PoolCost is the estimated monthly cost of the masters or of an agent pool
+type PoolCost struct {
+	Name    string  `json:"name"`
+	VMSize  string  `json:"vmSize"`
+	Count   int     `json:"count"`
+	Compute float64 `json:"compute"`
+	DiskGB  int     `json:"diskGB"`
+	Disks   float64 `json:"disks"`
+	Total   float64 `json:"total"`
+}
+
+// GetCostEstimate estimates the monthly cost of the cluster described by the resolved container service from
+// the prices supplied by the caller. Each VM is billed for HoursPerMonth hours, its OS and data disks for their
+// size, and the cluster for the public IP addresses of the masters and of the public agent pools.
+func GetCostEstimate(cs *api.ContainerService, prices *CostPrices) (*CostEstimate, error) {
+	properties := cs.Properties
+	estimate := &CostEstimate{Pools: []PoolCost{}}
+	if properties.MasterProfile != nil {
+		diskGB := getOSDiskSizeGB(properties.MasterProfile.OSDiskSizeGB, api.Linux)
+		if properties.OrchestratorProfile != nil && properties.OrchestratorProfile.IsKubernetes() {
+			diskGB += EtcdDiskSizeGB
+		}
+		pool, err := getPoolCost("master", properties.MasterProfile.VMSize, properties.MasterProfile.Count, diskGB, prices)
+		if err != nil {
+			return nil, err
+		}
+		estimate.Pools = append(estimate.Pools, *pool)
+		estimate.PublicIPCount++
+	}
+	for _, profile := range properties.AgentPoolProfiles {
+		diskGB := getOSDiskSizeGB(profile.OSDiskSizeGB, profile.OSType)
+		for _, size := range profile.DiskSizesGB {
+			diskGB += size
+		}
+		pool, err := getPoolCost(profile.Name, profile.VMSize, profile.Count, diskGB, prices)
+		if err != nil {
+			return nil, err
+		}
+		estimate.Pools = append(estimate.Pools, *pool)
+		if len(profile.Ports) > 0 {
+			estimate.PublicIPCount++
+		}
+	}
+
+	estimate.PublicIPs = float64(estimate.PublicIPCount) * prices.PublicIPHourly * HoursPerMonth
+	estimate.Total = estimate.PublicIPs
+	for _, pool := range estimate.Pools {
+		estimate.Total += pool.Total
+	}
+	return estimate, nil
+}
+
+// getPoolCost returns the monthly cost of count VMs of the given size, each with diskGB of disks
+func getPoolCost(name, vmSize string, count, diskGB int, prices *CostPrices) (*PoolCost, error) {
+	hourly, ok := prices.VMSizeHourly[vmSize]
+	if !ok {
+		return nil, fmt.Errorf("no price is given for VM size %s of pool '%s'", vmSize, name)
+	}
+	pool := &PoolCost{
+		Name:    name,
+		VMSize:  vmSize,
+		Count:   count,
+		Compute: hourly * float64(count) * HoursPerMonth,
+		DiskGB:  diskGB * count,
+	}
+	pool.Disks = float64(pool.DiskGB) * prices.DiskGBMonthly
+	pool.Total = pool.Compute + pool.Disks
+	return pool, nil
+}
+
+// getOSDiskSizeGB returns the size of the OS disk of a VM, which is the size of the image's when not set
+func getOSDiskSizeGB(osDiskSizeGB int, osType api.OSType) int {
+	if osDiskSizeGB != 0 {
+		return osDiskSizeGB
+	}
+	if osType == api.Windows {
+		return DefaultWindowsOSDiskSizeGB
+	}
+	return DefaultLinuxOSDiskSizeGB
+}
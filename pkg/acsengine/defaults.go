@@ -3,8 +3,10 @@ package acsengine
 import (
 	"fmt"
 	"net"
+	"strings"
 
 	"github.com/Azure/acs-engine/pkg/api"
+	"github.com/Azure/acs-engine/pkg/api/common"
 )
 
 var (
@@ -53,6 +55,8 @@ var (
 func SetPropertiesDefaults(cs *api.ContainerService) (bool, error) {
 	properties := cs.Properties
 
+	properties.SetDefaults()
+
 	setOrchestratorDefaults(cs)
 
 	setMasterNetworkDefaults(properties)
@@ -61,6 +65,16 @@ func SetPropertiesDefaults(cs *api.ContainerService) (bool, error) {
 
 	setStorageDefaults(properties)
 
+	setSecurityRuleDefaults(properties)
+
+	setPrivateRegistryDefaults(properties)
+
+	setGPUTaintDefaults(properties)
+
+	if e := setDockerBridgeDefaults(properties); e != nil {
+		return false, e
+	}
+
 	certsGenerated, e := setDefaultCerts(properties)
 	if e != nil {
 		return false, e
@@ -90,12 +104,127 @@ func setOrchestratorDefaults(cs *api.ContainerService) {
 				a.OrchestratorProfile.KubernetesConfig.ClusterSubnet = DefaultKubernetesClusterSubnet
 			}
 		}
-		if a.OrchestratorProfile.KubernetesConfig.DockerBridgeSubnet == "" {
-			a.OrchestratorProfile.KubernetesConfig.DockerBridgeSubnet = DefaultDockerBridgeSubnet
+		if a.OrchestratorProfile.KubernetesConfig.MasterLBProbeIntervalInSeconds == 0 {
+			a.OrchestratorProfile.KubernetesConfig.MasterLBProbeIntervalInSeconds = DefaultMasterLBProbeIntervalInSeconds
+		}
+		if a.OrchestratorProfile.KubernetesConfig.MasterLBProbeNumberOfProbes == 0 {
+			a.OrchestratorProfile.KubernetesConfig.MasterLBProbeNumberOfProbes = DefaultMasterLBProbeNumberOfProbes
+		}
+		if a.OrchestratorProfile.KubernetesConfig.ProvisionRetryCount == 0 {
+			a.OrchestratorProfile.KubernetesConfig.ProvisionRetryCount = DefaultProvisionRetryCount
+		}
+		if a.OrchestratorProfile.KubernetesConfig.ProvisionTimeoutInSeconds == 0 {
+			a.OrchestratorProfile.KubernetesConfig.ProvisionTimeoutInSeconds = DefaultProvisionTimeoutInSeconds
+		}
+		if a.OrchestratorProfile.KubernetesConfig.LeaderElectLeaseDuration == "" {
+			a.OrchestratorProfile.KubernetesConfig.LeaderElectLeaseDuration = DefaultLeaderElectLeaseDuration
+			a.OrchestratorProfile.KubernetesConfig.LeaderElectRenewDeadline = DefaultLeaderElectRenewDeadline
+			a.OrchestratorProfile.KubernetesConfig.LeaderElectRetryPeriod = DefaultLeaderElectRetryPeriod
+		}
+	}
+}
+
+// setSecurityRuleDefaults matches any address and port the security rules leave unspecified
+func setSecurityRuleDefaults(a *api.Properties) {
+	for i := range a.SecurityRules {
+		rule := &a.SecurityRules[i]
+		if rule.SourceAddressPrefix == "" {
+			rule.SourceAddressPrefix = "*"
+		}
+		if rule.SourcePortRange == "" {
+			rule.SourcePortRange = "*"
+		}
+		if rule.DestinationAddressPrefix == "" {
+			rule.DestinationAddressPrefix = "*"
+		}
+		if rule.DestinationPortRange == "" {
+			rule.DestinationPortRange = "*"
+		}
+	}
+}
+
+// setPrivateRegistryDefaults adds the image pull secret of the private registry to the default namespace unless told otherwise
+func setPrivateRegistryDefaults(a *api.Properties) {
+	if a.PrivateRegistryProfile != nil && len(a.PrivateRegistryProfile.Namespaces) == 0 {
+		a.PrivateRegistryProfile.Namespaces = []string{DefaultPrivateRegistryNamespace}
+	}
+}
+
+// setGPUTaintDefaults taints the nodes of Linux GPU agent pools unless told otherwise, so that only pods
+// tolerating the taint are scheduled onto them. Kubernetes 1.5 kubelets cannot register with taints.
+func setGPUTaintDefaults(a *api.Properties) {
+	if a.OrchestratorProfile.OrchestratorType != api.Kubernetes ||
+		VersionOrdinal(a.OrchestratorProfile.OrchestratorVersion) < VersionOrdinal(api.Kubernetes160) {
+		return
+	}
+	for _, profile := range a.AgentPoolProfiles {
+		if profile.TaintGPUNodes == nil && !profile.IsWindows() && profile.IsNSeriesVMSize() {
+			taintGPUNodes := true
+			profile.TaintGPUNodes = &taintGPUNodes
 		}
 	}
 }
 
+// setDockerBridgeDefaults picks a docker bridge subnet that does not overlap the other address
+// ranges of a Kubernetes cluster, and checks that a docker bridge subnet specified by the user
+// does not overlap them either. It must run after the master and agent network defaults are set.
+func setDockerBridgeDefaults(a *api.Properties) error {
+	if a.OrchestratorProfile.OrchestratorType != api.Kubernetes {
+		return nil
+	}
+	kubernetesConfig := a.OrchestratorProfile.KubernetesConfig
+	if kubernetesConfig.DockerBridgeSubnet == "" {
+		kubernetesConfig.DockerBridgeSubnet = DefaultDockerBridgeSubnet
+		for _, candidate := range DockerBridgeSubnetCandidates {
+			if len(getDockerBridgeOverlaps(a, candidate)) == 0 {
+				kubernetesConfig.DockerBridgeSubnet = candidate
+				break
+			}
+		}
+	}
+
+	overlaps := getDockerBridgeOverlaps(a, kubernetesConfig.DockerBridgeSubnet)
+	if len(overlaps) > 0 {
+		return fmt.Errorf("DockerBridgeSubnet '%s' overlaps %s", kubernetesConfig.DockerBridgeSubnet, strings.Join(overlaps, ", "))
+	}
+	return nil
+}
+
+// getDockerBridgeOverlaps returns a description of each address range of the cluster that
+// overlaps the docker bridge subnet
+func getDockerBridgeOverlaps(a *api.Properties, dockerBridgeSubnet string) []string {
+	overlaps := []string{}
+	_, bridge, err := net.ParseCIDR(dockerBridgeSubnet)
+	if err != nil {
+		return overlaps
+	}
+
+	ranges := [][]string{
+		{"clusterSubnet", a.OrchestratorProfile.KubernetesConfig.ClusterSubnet},
+		{"the service CIDR", DefaultKubernetesServiceCIDR},
+	}
+	if a.MasterProfile.IsCustomVNET() {
+		// the address ranges of custom VNET subnets are not known, but the master addresses are
+		ranges = append(ranges, []string{"MasterProfile.FirstConsecutiveStaticIP", a.MasterProfile.FirstConsecutiveStaticIP + "/32"})
+	} else {
+		ranges = append(ranges, []string{"the master subnet", a.MasterProfile.Subnet})
+		for _, profile := range a.AgentPoolProfiles {
+			ranges = append(ranges, []string{fmt.Sprintf("the subnet of agent pool '%s'", profile.Name), profile.Subnet})
+		}
+	}
+
+	for _, r := range ranges {
+		_, subnet, err := net.ParseCIDR(r[1])
+		if err != nil {
+			continue
+		}
+		if bridge.Contains(subnet.IP) || subnet.Contains(bridge.IP) {
+			overlaps = append(overlaps, fmt.Sprintf("%s '%s'", r[0], r[1]))
+		}
+	}
+	return overlaps
+}
+
 // SetMasterNetworkDefaults for masters
 func setMasterNetworkDefaults(a *api.Properties) {
 	if !a.MasterProfile.IsCustomVNET() {
@@ -145,11 +274,6 @@ func setAgentNetworkDefaults(a *api.Properties) {
 	}
 
 	for _, profile := range a.AgentPoolProfiles {
-		// set default OSType to Linux
-		if profile.OSType == "" {
-			profile.OSType = api.Linux
-		}
-
 		// Allocate IP addresses for containers if VNET integration is enabled.
 		// A custom count specified by the user overrides this value.
 		if profile.IPAddressCount == 0 {
@@ -168,9 +292,6 @@ func setStorageDefaults(a *api.Properties) {
 		a.MasterProfile.StorageProfile = api.StorageAccount
 	}
 	for _, profile := range a.AgentPoolProfiles {
-		if len(profile.StorageProfile) == 0 {
-			profile.StorageProfile = api.StorageAccount
-		}
 		if len(profile.AvailabilityProfile) == 0 {
 			profile.AvailabilityProfile = api.VirtualMachineScaleSets
 		}
@@ -192,7 +313,7 @@ func setDefaultCerts(a *api.Properties) (bool, error) {
 	ips := []net.IP{firstMasterIP}
 
 	// Add the Internal Loadbalancer IP which is always at at a known offset from the firstMasterIP
-	ips = append(ips, net.IP{firstMasterIP[0], firstMasterIP[1], firstMasterIP[2], firstMasterIP[3] + byte(DefaultInternalLbStaticIPOffset)})
+	ips = append(ips, net.IP{firstMasterIP[0], firstMasterIP[1], firstMasterIP[2], firstMasterIP[3] + byte(common.DefaultInternalLbStaticIPOffset)})
 
 	// Include the Internal load balancer as well
 	for i := 1; i < a.MasterProfile.Count; i++ {
@@ -9,11 +9,16 @@ import (
 	"fmt"
 	"hash/fnv"
 	"math/rand"
+	"path"
 	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 	"text/template"
+	"time"
 
 	"github.com/Azure/acs-engine/pkg/api"
+	"github.com/Azure/acs-engine/pkg/api/common"
 	"github.com/ghodss/yaml"
 )
 
@@ -87,6 +92,7 @@ var kubernetesManifestYamls = map[string]string{
 	"MASTER_KUBERNETES_CONTROLLER_MANAGER_B64_GZIP_STR": "kubernetesmaster-kube-controller-manager.yaml",
 	"MASTER_KUBERNETES_APISERVER_B64_GZIP_STR":          "kubernetesmaster-kube-apiserver.yaml",
 	"MASTER_KUBERNETES_ADDON_MANAGER_B64_GZIP_STR":      "kubernetesmaster-kube-addon-manager.yaml",
+	"MASTER_KUBERNETES_ETCD_B64_GZIP_STR":               "kubernetesmaster-etcd.yaml",
 }
 
 var kubernetesAritfacts = map[string]string{
@@ -169,12 +175,6 @@ type KeyVaultRef struct {
 	SecretVersion string     `json:"secretVersion,omitempty"`
 }
 
-var keyvaultSecretPathRe *regexp.Regexp
-
-func init() {
-	keyvaultSecretPathRe = regexp.MustCompile(`^(/subscriptions/\S+/resourceGroups/\S+/providers/Microsoft.KeyVault/vaults/\S+)/secrets/([^/\s]+)(/(\S+))?$`)
-}
-
 func (t *TemplateGenerator) verifyFiles() error {
 	allFiles := append(commonTemplateFiles, dcosTemplateFiles...)
 	allFiles = append(allFiles, kubernetesTemplateFiles...)
@@ -190,12 +190,18 @@ func (t *TemplateGenerator) verifyFiles() error {
 // TemplateGenerator represents the object that performs the template generation.
 type TemplateGenerator struct {
 	ClassicMode bool
+	// EmitHelmValues leaves the addons described by the helm values out of the master custom data,
+	// so they are deployed by the umbrella chart instead of the addon-manager
+	EmitHelmValues bool
+	// generatedAt is stamped on the resources of every template the generator generates
+	generatedAt time.Time
 }
 
 // InitializeTemplateGenerator creates a new template generator object
 func InitializeTemplateGenerator(classicMode bool) (*TemplateGenerator, error) {
 	t := &TemplateGenerator{
 		ClassicMode: classicMode,
+		generatedAt: time.Now().UTC(),
 	}
 
 	if err := t.verifyFiles(); err != nil {
@@ -268,6 +274,17 @@ func (t *TemplateGenerator) GenerateTemplate(containerService *api.ContainerServ
 	return templateRaw, parametersRaw, certsGenerated, err
 }
 
+// GetStorageAccountName returns the template expression of the name of a storage account, the storage
+// account base name followed by the role and index of the account. Agent storage account names are
+// prefixed further in the resources. It returns an error if the name could exceed the length Azure accepts.
+func GetStorageAccountName(role string, index int) (string, error) {
+	suffix := fmt.Sprintf("%s%d", role, index)
+	if StorageAccountPrefixLength+StorageAccountBaseNameLength+len(suffix) > MaxStorageAccountNameLength {
+		return "", fmt.Errorf("storage account name suffix '%s' is too long, storage account names are limited to %d characters", suffix, MaxStorageAccountNameLength)
+	}
+	return fmt.Sprintf("[concat(variables('storageAccountBaseName'), '%s')]", suffix), nil
+}
+
 // GenerateClusterID creates a unique 8 string cluster ID
 func GenerateClusterID(properties *api.Properties) string {
 	uniqueNameSuffixSize := 8
@@ -288,7 +305,7 @@ func GenerateKubeConfig(properties *api.Properties, location string) (string, er
 	kubeconfig := string(b)
 	// variable replacement
 	kubeconfig = strings.Replace(kubeconfig, "{{WrapAsVerbatim \"variables('caCertificate')\"}}", base64.StdEncoding.EncodeToString([]byte(properties.CertificateProfile.CaCertificate)), -1)
-	kubeconfig = strings.Replace(kubeconfig, "{{WrapAsVerbatim \"reference(concat('Microsoft.Network/publicIPAddresses/', variables('masterPublicIPAddressName'))).dnsSettings.fqdn\"}}", FormatAzureProdFQDN(properties.MasterProfile.DNSPrefix, location), -1)
+	kubeconfig = strings.Replace(kubeconfig, "{{WrapAsVerbatim \"reference(concat('Microsoft.Network/publicIPAddresses/', variables('masterPublicIPAddressName'))).dnsSettings.fqdn\"}}", getKubeConfigServer(properties, location), -1)
 	kubeconfig = strings.Replace(kubeconfig, "{{WrapAsVariable \"resourceGroup\"}}", properties.MasterProfile.DNSPrefix, -1)
 	kubeconfig = strings.Replace(kubeconfig, "{{WrapAsVerbatim \"variables('kubeConfigCertificate')\"}}", base64.StdEncoding.EncodeToString([]byte(properties.CertificateProfile.KubeConfigCertificate)), -1)
 	kubeconfig = strings.Replace(kubeconfig, "{{WrapAsVerbatim \"variables('kubeConfigPrivateKey')\"}}", base64.StdEncoding.EncodeToString([]byte(properties.CertificateProfile.KubeConfigPrivateKey)), -1)
@@ -296,19 +313,30 @@ func GenerateKubeConfig(properties *api.Properties, location string) (string, er
 	return kubeconfig, nil
 }
 
+// getKubeConfigServer returns the host, and the port unless it is the default, of the apiserver
+func getKubeConfigServer(properties *api.Properties, location string) string {
+	server := FormatAzureProdFQDN(properties.MasterProfile.DNSPrefix, location)
+	if properties.OrchestratorProfile.KubernetesConfig != nil {
+		if port := properties.OrchestratorProfile.KubernetesConfig.GetAPIServerPort(); port != api.DefaultAPIServerPort {
+			server = fmt.Sprintf("%s:%d", server, port)
+		}
+	}
+	return server
+}
+
 func prepareTemplateFiles(properties *api.Properties) ([]string, string, error) {
 	var files []string
 	var baseFile string
-	if properties.OrchestratorProfile.OrchestratorType == api.DCOS {
+	if properties.OrchestratorProfile.IsDCOS() {
 		files = append(commonTemplateFiles, dcosTemplateFiles...)
 		baseFile = dcosBaseFile
-	} else if properties.OrchestratorProfile.OrchestratorType == api.Swarm {
+	} else if properties.OrchestratorProfile.IsSwarm() {
 		files = append(commonTemplateFiles, swarmTemplateFiles...)
 		baseFile = swarmBaseFile
-	} else if properties.OrchestratorProfile.OrchestratorType == api.Kubernetes {
+	} else if properties.OrchestratorProfile.IsKubernetes() {
 		files = append(commonTemplateFiles, kubernetesTemplateFiles...)
 		baseFile = kubernetesBaseFile
-	} else if properties.OrchestratorProfile.OrchestratorType == api.SwarmMode {
+	} else if properties.OrchestratorProfile.IsSwarmMode() {
 		files = append(commonTemplateFiles, swarmModeTemplateFiles...)
 		baseFile = swarmBaseFile
 	} else {
@@ -375,7 +403,7 @@ func getParameters(cs *api.ContainerService, isClassicMode bool) (map[string]int
 
 	cloudSpecConfig := GetCloudSpecConfig(location)
 	// Kubernetes Parameters
-	if properties.OrchestratorProfile.OrchestratorType == api.Kubernetes {
+	if properties.OrchestratorProfile.IsKubernetes() {
 		KubernetesVersion := properties.OrchestratorProfile.OrchestratorVersion
 		addSecret(parametersMap, "apiServerCertificate", properties.CertificateProfile.APIServerCertificate, true)
 		addSecret(parametersMap, "apiServerPrivateKey", properties.CertificateProfile.APIServerPrivateKey, true)
@@ -385,6 +413,13 @@ func getParameters(cs *api.ContainerService, isClassicMode bool) (map[string]int
 		addSecret(parametersMap, "clientPrivateKey", properties.CertificateProfile.ClientPrivateKey, true)
 		addSecret(parametersMap, "kubeConfigCertificate", properties.CertificateProfile.KubeConfigCertificate, true)
 		addSecret(parametersMap, "kubeConfigPrivateKey", properties.CertificateProfile.KubeConfigPrivateKey, true)
+		if properties.CertificateProfile.HasServiceAccountPrivateKey() {
+			addSecret(parametersMap, "serviceAccountPrivateKey", properties.CertificateProfile.ServiceAccountPrivateKey, true)
+		}
+		if properties.CertificateProfile.HasServiceAccountVerificationKeys() {
+			verificationKeys := strings.Join(properties.CertificateProfile.ServiceAccountVerificationKeys, "\n")
+			addValue(parametersMap, "serviceAccountVerificationKeys", base64.StdEncoding.EncodeToString([]byte(verificationKeys)))
+		}
 		addValue(parametersMap, "dockerEngineDownloadRepo", cloudSpecConfig.DockerSpecConfig.DockerEngineRepo)
 		addValue(parametersMap, "kubernetesHyperkubeSpec", properties.OrchestratorProfile.KubernetesConfig.KubernetesImageBase+KubeImages[KubernetesVersion]["hyperkube"])
 		addValue(parametersMap, "kubernetesAddonManagerSpec", cloudSpecConfig.KubernetesSpecConfig.KubernetesImageBase+KubeImages[KubernetesVersion]["addonmanager"])
@@ -400,17 +435,25 @@ func getParameters(cs *api.ContainerService, isClassicMode bool) (map[string]int
 		addValue(parametersMap, "networkPolicy", properties.OrchestratorProfile.KubernetesConfig.NetworkPolicy)
 		addValue(parametersMap, "servicePrincipalClientId", properties.ServicePrincipalProfile.ClientID)
 		addSecret(parametersMap, "servicePrincipalClientSecret", properties.ServicePrincipalProfile.Secret, false)
+		if properties.HasPrivateRegistry() {
+			addValue(parametersMap, "privateRegistryServer", properties.PrivateRegistryProfile.Server)
+			addValue(parametersMap, "privateRegistryUsername", properties.PrivateRegistryProfile.Username)
+			addSecret(parametersMap, "privateRegistryPassword", properties.PrivateRegistryProfile.Password, false)
+		}
 	}
 
 	if strings.HasPrefix(string(properties.OrchestratorProfile.OrchestratorType), string(api.DCOS)) {
 		dcosBootstrapURL := cloudSpecConfig.DCOSSpecConfig.DCOS188BootstrapDownloadURL
+		dcosRepositoryURL := fmt.Sprintf(AzureEdgeDCOSRepositoryURL, "stable")
 		switch properties.OrchestratorProfile.OrchestratorType {
 		case api.DCOS:
 			switch properties.OrchestratorProfile.OrchestratorVersion {
 			case api.DCOS173:
 				dcosBootstrapURL = cloudSpecConfig.DCOSSpecConfig.DCOS173BootstrapDownloadURL
+				dcosRepositoryURL = fmt.Sprintf(MsecndDCOSRepositoryURL, "testing")
 			case api.DCOS184:
 				dcosBootstrapURL = cloudSpecConfig.DCOSSpecConfig.DCOS184BootstrapDownloadURL
+				dcosRepositoryURL = fmt.Sprintf(AzureEdgeDCOSRepositoryURL, "testing")
 			case api.DCOS187:
 				dcosBootstrapURL = cloudSpecConfig.DCOSSpecConfig.DCOS187BootstrapDownloadURL
 			case api.DCOS188:
@@ -419,7 +462,13 @@ func getParameters(cs *api.ContainerService, isClassicMode bool) (map[string]int
 				dcosBootstrapURL = cloudSpecConfig.DCOSSpecConfig.DCOS190BootstrapDownloadURL
 			}
 		}
+		if properties.CustomProfile != nil && properties.CustomProfile.BootstrapRepositoryURL != "" {
+			// the bootstrap of the version and its packages are downloaded from the custom repository instead
+			dcosRepositoryURL = strings.TrimSuffix(properties.CustomProfile.BootstrapRepositoryURL, "/")
+			dcosBootstrapURL = dcosRepositoryURL + "/bootstrap/" + path.Base(dcosBootstrapURL)
+		}
 		addValue(parametersMap, "dcosBootstrapURL", dcosBootstrapURL)
+		addValue(parametersMap, "dcosRepositoryURL", dcosRepositoryURL)
 	}
 
 	// Agent parameters
@@ -440,7 +489,7 @@ func getParameters(cs *api.ContainerService, isClassicMode bool) (map[string]int
 	if properties.HasWindows() {
 		addValue(parametersMap, "windowsAdminUsername", properties.WindowsProfile.AdminUsername)
 		addSecret(parametersMap, "windowsAdminPassword", properties.WindowsProfile.AdminPassword, false)
-		if properties.OrchestratorProfile.OrchestratorType == api.Kubernetes {
+		if properties.OrchestratorProfile.IsKubernetes() {
 			KubernetesVersion := properties.OrchestratorProfile.OrchestratorVersion
 			addValue(parametersMap, "kubeBinariesSASURL", cloudSpecConfig.KubernetesSpecConfig.KubeBinariesSASURLBase+KubeImages[KubernetesVersion]["windowszip"])
 			addValue(parametersMap, "kubeBinariesVersion", KubernetesVersion)
@@ -469,8 +518,8 @@ func addSecret(m map[string]interface{}, k string, v interface{}, encode bool) {
 		addValue(m, k, v)
 		return
 	}
-	parts := keyvaultSecretPathRe.FindStringSubmatch(str)
-	if parts == nil || len(parts) != 5 {
+	parts := common.KeyVaultSecretRefRegex.FindStringSubmatch(str)
+	if parts == nil {
 		if encode {
 			addValue(m, k, base64.StdEncoding.EncodeToString([]byte(str)))
 		} else {
@@ -485,7 +534,7 @@ func addSecret(m map[string]interface{}, k string, v interface{}, encode bool) {
 				ID: parts[1],
 			},
 			SecretName:    parts[2],
-			SecretVersion: parts[4],
+			SecretVersion: parts[3],
 		},
 	}
 }
@@ -524,43 +573,49 @@ func VersionOrdinal(version api.OrchestratorVersion) string {
 func (t *TemplateGenerator) getTemplateFuncMap(cs *api.ContainerService) map[string]interface{} {
 	return template.FuncMap{
 		"IsDCOS173": func() bool {
-			return cs.Properties.OrchestratorProfile.OrchestratorType == api.DCOS &&
+			return cs.Properties.OrchestratorProfile.IsDCOS() &&
 				cs.Properties.OrchestratorProfile.OrchestratorVersion == api.DCOS173
 		},
 		"IsDCOS184": func() bool {
-			return cs.Properties.OrchestratorProfile.OrchestratorType == api.DCOS &&
+			return cs.Properties.OrchestratorProfile.IsDCOS() &&
 				cs.Properties.OrchestratorProfile.OrchestratorVersion == api.DCOS184
 		},
 		"IsDCOS187": func() bool {
-			return cs.Properties.OrchestratorProfile.OrchestratorType == api.DCOS &&
+			return cs.Properties.OrchestratorProfile.IsDCOS() &&
 				cs.Properties.OrchestratorProfile.OrchestratorVersion == api.DCOS187
 		},
 		"IsDCOS188": func() bool {
-			return cs.Properties.OrchestratorProfile.OrchestratorType == api.DCOS &&
+			return cs.Properties.OrchestratorProfile.IsDCOS() &&
 				cs.Properties.OrchestratorProfile.OrchestratorVersion == api.DCOS188
 		},
 		"IsDCOS190": func() bool {
-			return cs.Properties.OrchestratorProfile.OrchestratorType == api.DCOS &&
+			return cs.Properties.OrchestratorProfile.IsDCOS() &&
 				cs.Properties.OrchestratorProfile.OrchestratorVersion == api.DCOS190
 		},
 		"IsKubernetesVersionGe": func(version string) bool {
 			targetVersion := api.OrchestratorVersion(version)
 			targetVersionOrdinal := VersionOrdinal(targetVersion)
 			orchestratorVersionOrdinal := VersionOrdinal(cs.Properties.OrchestratorProfile.OrchestratorVersion)
-			return cs.Properties.OrchestratorProfile.OrchestratorType == api.Kubernetes &&
+			return cs.Properties.OrchestratorProfile.IsKubernetes() &&
 				orchestratorVersionOrdinal >= targetVersionOrdinal
 		},
 		"GetKubernetesLabels": func(profile *api.AgentPoolProfile) string {
 			var buf bytes.Buffer
-			buf.WriteString(fmt.Sprintf("role=agent,agentpool=%s", profile.Name))
+			buf.WriteString(fmt.Sprintf("role=agent,agentpool=%s,%s=%s", profile.Name, ClusterNameLabel, cs.Properties.GetClusterName()))
 			for k, v := range profile.CustomNodeLabels {
 				buf.WriteString(fmt.Sprintf(",%s=%s", k, v))
 			}
 
 			return buf.String()
 		},
+		"GetMasterKubernetesLabels": func() string {
+			return fmt.Sprintf("role=master,%s=%s", ClusterNameLabel, cs.Properties.GetClusterName())
+		},
+		"GetClusterNameLabel": func() string {
+			return fmt.Sprintf("%s=%s", ClusterNameLabel, cs.Properties.GetClusterName())
+		},
 		"RequiresFakeAgentOutput": func() bool {
-			return cs.Properties.OrchestratorProfile.OrchestratorType == api.Kubernetes
+			return cs.Properties.OrchestratorProfile.IsKubernetes()
 		},
 		"IsSwarmMode": func() bool {
 			return cs.Properties.OrchestratorProfile.IsSwarmMode()
@@ -584,7 +639,10 @@ func (t *TemplateGenerator) getTemplateFuncMap(cs *api.ContainerService) map[str
 			return getProbes(ports)
 		},
 		"GetSecurityRules": func(ports []int) string {
-			return getSecurityRules(ports)
+			return getSecurityRules(ports, cs.Properties.SecurityRules)
+		},
+		"GetAdditionalSecurityRules": func() string {
+			return getAdditionalSecurityRules(cs.Properties.SecurityRules)
 		},
 		"GetUniqueNameSuffix": func() string {
 			return GenerateClusterID(cs.Properties)
@@ -595,6 +653,18 @@ func (t *TemplateGenerator) getTemplateFuncMap(cs *api.ContainerService) map[str
 		"GetVNETSubnets": func(addNSG bool) string {
 			return getVNETSubnets(cs.Properties, addNSG)
 		},
+		"GetStorageAccountType": func(profile *api.AgentPoolProfile) string {
+			return getStorageAccountType(profile)
+		},
+		"GetStorageAccountsCount": func(profile *api.AgentPoolProfile) string {
+			return getStorageAccountsCount(profile)
+		},
+		"GetStorageAccountIndex": func(profile *api.AgentPoolProfile) string {
+			return getStorageAccountIndex(profile, fmt.Sprintf("copyIndex(variables('%sOffset'))", profile.Name))
+		},
+		"GetAgentComputerNamePrefix": func(profile *api.AgentPoolProfile) string {
+			return getAgentComputerNamePrefix(profile)
+		},
 		"GetDataDisks": func(profile *api.AgentPoolProfile) string {
 			return getDataDisks(profile)
 		},
@@ -615,7 +685,7 @@ func (t *TemplateGenerator) getTemplateFuncMap(cs *api.ContainerService) map[str
 		"GetMasterAllowedSizes": func() string {
 			if t.ClassicMode {
 				return GetClassicAllowedSizes()
-			} else if cs.Properties.OrchestratorProfile.OrchestratorType == api.DCOS {
+			} else if cs.Properties.OrchestratorProfile.IsDCOS() {
 				return GetDCOSMasterAllowedSizes()
 			}
 			return GetMasterAgentAllowedSizes()
@@ -623,11 +693,19 @@ func (t *TemplateGenerator) getTemplateFuncMap(cs *api.ContainerService) map[str
 		"GetAgentAllowedSizes": func() string {
 			if t.ClassicMode {
 				return GetClassicAllowedSizes()
-			} else if cs.Properties.OrchestratorProfile.OrchestratorType == api.Kubernetes {
+			} else if cs.Properties.OrchestratorProfile.IsKubernetes() {
 				return GetKubernetesAgentAllowedSizes()
 			}
 			return GetMasterAgentAllowedSizes()
 		},
+		"GetAllowedMasterCounts": func() string {
+			counts := common.AllowedMasterCounts
+			if cs.Properties.OrchestratorProfile.OrchestratorType == api.Swarm || cs.Properties.OrchestratorProfile.OrchestratorType == api.SwarmMode {
+				counts = common.AllowedSwarmMasterCounts
+			}
+			b, _ := json.Marshal(counts)
+			return string(b)
+		},
 		"GetSizeMap": func() string {
 			if t.ClassicMode {
 				return GetClassicSizeMap()
@@ -637,14 +715,17 @@ func (t *TemplateGenerator) getTemplateFuncMap(cs *api.ContainerService) map[str
 		"GetClassicMode": func() bool {
 			return t.ClassicMode
 		},
+		"HasHelmManagedAddons": func() bool {
+			return t.EmitHelmValues
+		},
 		"Base64": func(s string) string {
 			return base64.StdEncoding.EncodeToString([]byte(s))
 		},
 		"GetDefaultInternalLbStaticIPOffset": func() int {
-			return DefaultInternalLbStaticIPOffset
+			return common.DefaultInternalLbStaticIPOffset
 		},
 		"GetKubernetesMasterCustomScript": func() string {
-			return getBase64CustomScript(kubernetesMasterCustomScript)
+			return getBase64CustomScriptFromStr(getKubernetesProvisionScript(cs.Properties.OrchestratorProfile.KubernetesConfig))
 		},
 		"GetKubernetesMasterCustomData": func(profile *api.Properties) string {
 			str, e := t.getSingleLineForTemplate(kubernetesMasterCustomDataYaml, cs, profile)
@@ -671,7 +752,7 @@ func (t *TemplateGenerator) getTemplateFuncMap(cs *api.ContainerService) map[str
 				addonYamls = kubernetesAddonYamls
 			}
 			for placeholder, filename := range addonYamls {
-				addonTextContents := getBase64CustomScript(filename)
+				addonTextContents := getBase64CustomScriptFromStr(getKubernetesAddonYaml(filename, profile.OrchestratorProfile.KubernetesConfig))
 				str = strings.Replace(str, placeholder, addonTextContents, -1)
 			}
 
@@ -701,7 +782,129 @@ func (t *TemplateGenerator) getTemplateFuncMap(cs *api.ContainerService) map[str
 			return fmt.Sprintf("\"customData\": \"[base64(concat('%s'))]\",", str)
 		},
 		"GetKubernetesB64Provision": func() string {
-			return getBase64CustomScript(kubernetesMasterCustomScript)
+			return getBase64CustomScriptFromStr(getKubernetesProvisionScript(cs.Properties.OrchestratorProfile.KubernetesConfig))
+		},
+		"GetMasterKubeletEvictionFlags": func() string {
+			return getKubeletEvictionFlags(cs.Properties.OrchestratorProfile.KubernetesConfig, nil)
+		},
+		"GetAgentKubeletEvictionFlags": func(profile *api.AgentPoolProfile) string {
+			return getKubeletEvictionFlags(cs.Properties.OrchestratorProfile.KubernetesConfig, profile)
+		},
+		"GetMasterRuntimeReservedMilliCPU": func() int {
+			return cs.Properties.OrchestratorProfile.KubernetesConfig.GetRuntimeReservedMilliCPU(nil)
+		},
+		"GetAgentRuntimeReservedMilliCPU": func(profile *api.AgentPoolProfile) int {
+			return cs.Properties.OrchestratorProfile.KubernetesConfig.GetRuntimeReservedMilliCPU(profile)
+		},
+		"GetRuntimeCPUQuota": func(milliCPU int) string {
+			return getRuntimeCPUQuota(milliCPU)
+		},
+		"GetMasterKubeletReservedFlags": func() string {
+			return getKubeletReservedFlags(cs.Properties.OrchestratorProfile.KubernetesConfig.GetRuntimeReservedMilliCPU(nil))
+		},
+		"GetAgentKubeletReservedFlags": func(profile *api.AgentPoolProfile) string {
+			return getKubeletReservedFlags(cs.Properties.OrchestratorProfile.KubernetesConfig.GetRuntimeReservedMilliCPU(profile))
+		},
+		"GetAgentKubeletTaintFlags": func(profile *api.AgentPoolProfile) string {
+			if profile.IsGPUTainted() {
+				return fmt.Sprintf("--register-with-taints=%s", NvidiaGPUTaint)
+			}
+			return ""
+		},
+		"GetAPIModelHash": func() string {
+			return cs.GetAPIModelHash()
+		},
+		"GetGeneratedAt": func() string {
+			return t.generatedAt.Format(time.RFC3339)
+		},
+		"GetStorageAccountName": func(role string, index int) (string, error) {
+			return GetStorageAccountName(role, index)
+		},
+		"GetIMDSAddress": func() string {
+			return IMDSAddress
+		},
+		"IsTopologyLabelsEnabled": func() bool {
+			return cs.Properties.OrchestratorProfile.KubernetesConfig.IsTopologyLabelsEnabled()
+		},
+		"GetTopologyNodeLabels": func() string {
+			// the region and fault domain are read from the instance metadata service when the node boots
+			labels := api.GetTopologyLabels("${REGION}", "${FAULT_DOMAIN}")
+			keys := []string{}
+			for k := range labels {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			pairs := []string{}
+			for _, k := range keys {
+				pairs = append(pairs, fmt.Sprintf("%s=%s", k, labels[k]))
+			}
+			return strings.Join(pairs, ",")
+		},
+		"IsPodIMDSBlocked": func() bool {
+			return cs.Properties.OrchestratorProfile.KubernetesConfig.IsPodIMDSBlocked()
+		},
+		"GetCloudProviderRateLimitQPS": func() string {
+			qps, _ := cs.Properties.GetCloudProviderRateLimit()
+			return strconv.FormatFloat(qps, 'f', -1, 64)
+		},
+		"GetCloudProviderRateLimitBucket": func() int {
+			_, bucket := cs.Properties.GetCloudProviderRateLimit()
+			return bucket
+		},
+		"GetCalicoImage": func(component string) string {
+			return CalicoImages[cs.Properties.OrchestratorProfile.KubernetesConfig.GetCalicoVersion()][component]
+		},
+		"GetEtcdImage": func() string {
+			cloudSpecConfig := GetCloudSpecConfig(cs.Location)
+			return cloudSpecConfig.KubernetesSpecConfig.KubernetesImageBase + KubeImages[cs.Properties.OrchestratorProfile.OrchestratorVersion]["etcd"]
+		},
+		"GetDataDiskMountCommands": func(profile *api.AgentPoolProfile) []string {
+			commands := []string{}
+			for lun := range profile.DiskSizesGB {
+				if mount, ok := profile.GetDataDiskMount(lun); ok {
+					commands = append(commands, fmt.Sprintf("mountDataDisk %d %s %s %s", lun, mount.FSType, strings.Join(mount.MountOptions, ","), mount.MountPoint))
+				}
+			}
+			return commands
+		},
+		"GetKubeProxyArgs": func() string {
+			// each flag is inserted by sed as an item of the kube-proxy command in the daemonset
+			args := ""
+			for _, arg := range cs.Properties.OrchestratorProfile.KubernetesConfig.GetKubeProxyArgs() {
+				args += "\\n        - " + arg
+			}
+			return args
+		},
+		"HasHostAliases": func() bool {
+			return cs.Properties.LinuxProfile.HasHostAliases()
+		},
+		"GetHostsFileEntries": func() []string {
+			entries := []string{}
+			for _, alias := range cs.Properties.LinuxProfile.HostAliases {
+				entries = append(entries, fmt.Sprintf("%s %s", alias.IP, strings.Join(alias.Hostnames, " ")))
+			}
+			return entries
+		},
+		"HasDefaultDenyNamespaces": func() bool {
+			return cs.Properties.OrchestratorProfile.KubernetesConfig.HasDefaultDenyNamespaces()
+		},
+		"GetEtcdDiskSizeGB": func() int {
+			return EtcdDiskSizeGB
+		},
+		"GetKubernetesAPIServerPort": func() int {
+			return cs.Properties.OrchestratorProfile.KubernetesConfig.GetAPIServerPort()
+		},
+		"GetPrivateRegistryNamespaces": func() string {
+			return strings.Join(cs.Properties.PrivateRegistryProfile.Namespaces, ",")
+		},
+		"GetKubernetesArtifactChecksum": func(artifact string) string {
+			return cs.Properties.OrchestratorProfile.KubernetesConfig.GetArtifactChecksums()[artifact]
+		},
+		"GetKubernetesProvisionRetryCount": func() int {
+			return cs.Properties.OrchestratorProfile.KubernetesConfig.ProvisionRetryCount
+		},
+		"GetKubernetesProvisionTimeoutInSeconds": func() int {
+			return cs.Properties.OrchestratorProfile.KubernetesConfig.ProvisionTimeoutInSeconds
 		},
 		"GetMasterSwarmCustomData": func() string {
 			files := []string{swarmProvision}
@@ -1065,6 +1268,47 @@ func getSecurityRule(port int, portIndex int) string {
           }`, port, port, port, BaseLBPriority+portIndex)
 }
 
+// getStorageAccountType returns the storage account type of the agent pool storage accounts.
+// Unless specified on the agent pool, the type is derived from the VM size.
+func getStorageAccountType(a *api.AgentPoolProfile) string {
+	if len(a.StorageAccountType) > 0 {
+		return a.StorageAccountType
+	}
+	return fmt.Sprintf("[variables('vmSizesMap')[variables('%sVMSize')].storageAccountType]", a.Name)
+}
+
+// getStorageAccountsCount returns the number of storage accounts created for the agent pool: enough to
+// hold maxVMsPerStorageAccount VMs each, or the number the agent pool specifies once it has as many VMs
+func getStorageAccountsCount(a *api.AgentPoolProfile) string {
+	if a.StorageAccountsPerPool > 0 {
+		return fmt.Sprintf("[min(variables('%sCount'), %d)]", a.Name, a.StorageAccountsPerPool)
+	}
+	return fmt.Sprintf("[add(div(variables('%[1]sCount'), variables('maxVMsPerStorageAccount')), mod(add(mod(variables('%[1]sCount'), variables('maxVMsPerStorageAccount')),2), add(mod(variables('%[1]sCount'), variables('maxVMsPerStorageAccount')),1)))]", a.Name)
+}
+
+// getStorageAccountIndex returns the expression of the index, within the storage accounts of the agent pool,
+// of the storage account of the VM at the copy index. VMs fill accounts of maxVMsPerStorageAccount VMs, or
+// are dealt round-robin across the storage accounts the agent pool specifies. Neither depends on the agent
+// count, so scaling the pool leaves the disks of its existing VMs in their storage accounts.
+func getStorageAccountIndex(a *api.AgentPoolProfile, copyIndex string) string {
+	if a.StorageAccountsPerPool > 0 {
+		return fmt.Sprintf("mod(%s,%d)", copyIndex, a.StorageAccountsPerPool)
+	}
+	return fmt.Sprintf("div(%s,variables('maxVMsPerStorageAccount'))", copyIndex)
+}
+
+// getAgentComputerNamePrefix returns the prefix of the computer names of the agent pool VMs.
+// Unless a hostname prefix is specified, the VM name prefix is used for Linux pools.
+func getAgentComputerNamePrefix(a *api.AgentPoolProfile) string {
+	if len(a.HostnamePrefix) > 0 {
+		return a.HostnamePrefix
+	}
+	if a.IsWindows() {
+		return "[concat(substring(variables('nameSuffix'), 0, 5), 'acs')]"
+	}
+	return fmt.Sprintf("[variables('%sVMNamePrefix')]", a.Name)
+}
+
 func getDataDisks(a *api.AgentPoolProfile) string {
 	if !a.HasDisks() {
 		return ""
@@ -1077,7 +1321,7 @@ func getDataDisks(a *api.AgentPoolProfile) string {
               "lun": %d,
               "name": "[concat(variables('%sVMNamePrefix'), copyIndex(),'-datadisk%d')]",
               "vhd": {
-                "uri": "[concat('http://',variables('storageAccountPrefixes')[mod(add(add(div(copyIndex(),variables('maxVMsPerStorageAccount')),variables('%sStorageAccountOffset')),variables('dataStorageAccountPrefixSeed')),variables('storageAccountPrefixesCount'))],variables('storageAccountPrefixes')[div(add(add(div(copyIndex(),variables('maxVMsPerStorageAccount')),variables('%sStorageAccountOffset')),variables('dataStorageAccountPrefixSeed')),variables('storageAccountPrefixesCount'))],variables('%sDataAccountName'),'.blob.core.windows.net/vhds/',variables('%sVMNamePrefix'),copyIndex(), '--datadisk%d.vhd')]"
+                "uri": "[concat('http://',variables('storageAccountPrefixes')[mod(add(add(%s,variables('%sStorageAccountOffset')),variables('dataStorageAccountPrefixSeed')),variables('storageAccountPrefixesCount'))],variables('storageAccountPrefixes')[div(add(add(%s,variables('%sStorageAccountOffset')),variables('dataStorageAccountPrefixSeed')),variables('storageAccountPrefixesCount'))],variables('%sDataAccountName'),'.blob.core.windows.net/vhds/',variables('%sVMNamePrefix'),copyIndex(), '--datadisk%d.vhd')]"
               }
             }`
 	managedDataDisks := `            {
@@ -1090,7 +1334,8 @@ func getDataDisks(a *api.AgentPoolProfile) string {
 			buf.WriteString(",\n")
 		}
 		if a.StorageProfile == api.StorageAccount {
-			buf.WriteString(fmt.Sprintf(dataDisks, diskSize, i, a.Name, i, a.Name, a.Name, a.Name, a.Name, i))
+			accountIndex := getStorageAccountIndex(a, "copyIndex()")
+			buf.WriteString(fmt.Sprintf(dataDisks, diskSize, i, a.Name, i, accountIndex, a.Name, accountIndex, a.Name, a.Name, a.Name, i))
 		} else if a.StorageProfile == api.ManagedDisks {
 			buf.WriteString(fmt.Sprintf(managedDataDisks, diskSize, i))
 		}
@@ -1099,17 +1344,48 @@ func getDataDisks(a *api.AgentPoolProfile) string {
 	return buf.String()
 }
 
-func getSecurityRules(ports []int) string {
-	var buf bytes.Buffer
+// getSecurityRules returns the rules of an agent pool network security group, which are the
+// rules opening the pool's ports merged with the security rules specified for the cluster
+func getSecurityRules(ports []int, securityRules []api.SecurityRule) string {
+	rules := []string{}
 	for index, port := range ports {
-		if index > 0 {
-			buf.WriteString(",\n")
-		}
-		buf.WriteString(getSecurityRule(port, index))
+		rules = append(rules, getSecurityRule(port, index))
+	}
+	for _, rule := range securityRules {
+		rules = append(rules, getCustomSecurityRule(rule))
+	}
+	return strings.Join(rules, ",\n")
+}
+
+// getAdditionalSecurityRules returns the security rules specified for the cluster, each prefixed
+// by a comma, for merging after the rules already present in a network security group
+func getAdditionalSecurityRules(securityRules []api.SecurityRule) string {
+	var buf bytes.Buffer
+	for _, rule := range securityRules {
+		buf.WriteString(",\n")
+		buf.WriteString(getCustomSecurityRule(rule))
 	}
 	return buf.String()
 }
 
+func getCustomSecurityRule(rule api.SecurityRule) string {
+	return fmt.Sprintf(`          {
+            "name": "%s",
+            "properties": {
+              "access": "%s",
+              "description": "%s",
+              "destinationAddressPrefix": "%s",
+              "destinationPortRange": "%s",
+              "direction": "%s",
+              "priority": %d,
+              "protocol": "%s",
+              "sourceAddressPrefix": "%s",
+              "sourcePortRange": "%s"
+            }
+          }`, rule.Name, rule.Access, escapeSingleLine(rule.Description), rule.DestinationAddressPrefix, rule.DestinationPortRange,
+		rule.Direction, rule.Priority, rule.Protocol, rule.SourceAddressPrefix, rule.SourcePortRange)
+}
+
 // getSingleLineForTemplate returns the file as a single line for embedding in an arm template
 func (t *TemplateGenerator) getSingleLineForTemplate(textFilename string, cs *api.ContainerService, profile interface{}) (string, error) {
 	b, err := Asset(textFilename)
@@ -1165,6 +1441,84 @@ func getBase64CustomScriptFromStr(str string) string {
 	return base64.StdEncoding.EncodeToString(gzipB.Bytes())
 }
 
+// getKubeletEvictionFlags returns the kubelet eviction flags of a node. The agent pool's hard
+// threshold overrides the cluster's, and its soft thresholds and grace periods together override
+// the cluster's. Masters, passed a nil profile, use the cluster thresholds.
+func getKubeletEvictionFlags(kubernetesConfig *api.KubernetesConfig, profile *api.AgentPoolProfile) string {
+	hard := kubernetesConfig.EvictionHard
+	soft := kubernetesConfig.EvictionSoft
+	softGracePeriod := kubernetesConfig.EvictionSoftGracePeriod
+	if profile != nil {
+		if profile.EvictionHard != "" {
+			hard = profile.EvictionHard
+		}
+		if profile.EvictionSoft != "" {
+			soft = profile.EvictionSoft
+			softGracePeriod = profile.EvictionSoftGracePeriod
+		}
+	}
+
+	flags := []string{}
+	if hard != "" {
+		flags = append(flags, "--eviction-hard="+hard)
+	}
+	if soft != "" {
+		flags = append(flags, "--eviction-soft="+soft, "--eviction-soft-grace-period="+softGracePeriod)
+	}
+	return strings.Join(flags, " ")
+}
+
+// getKubeletReservedFlags returns the kubelet flags that keep the CPU reserved for the
+// container runtime out of the node allocatable, so pods are not scheduled onto it
+func getKubeletReservedFlags(runtimeReservedMilliCPU int) string {
+	if runtimeReservedMilliCPU == 0 {
+		return ""
+	}
+	return fmt.Sprintf("--kube-reserved=cpu=%dm", runtimeReservedMilliCPU)
+}
+
+// getRuntimeCPUQuota returns the systemd CPUQuota of the container runtime for the CPU reserved
+// for it. systemd counts one CPU as 100%, and the quota is rounded up to a whole percent.
+func getRuntimeCPUQuota(milliCPU int) string {
+	return fmt.Sprintf("%d%%", (milliCPU+9)/10)
+}
+
+// getKubernetesProvisionScript returns the Kubernetes provision script with the
+// download retry count, timeout and artifact checksums baked in
+func getKubernetesProvisionScript(kubernetesConfig *api.KubernetesConfig) string {
+	bp, err := Asset(kubernetesMasterCustomScript)
+	if err != nil {
+		panic(fmt.Sprintf("BUG: %s", err.Error()))
+	}
+
+	provisionScript := string(bp)
+	provisionScript = strings.Replace(provisionScript, "PROVISIONRETRYCOUNT", strconv.Itoa(kubernetesConfig.ProvisionRetryCount), -1)
+	provisionScript = strings.Replace(provisionScript, "PROVISIONTIMEOUTINSECONDS", strconv.Itoa(kubernetesConfig.ProvisionTimeoutInSeconds), -1)
+	checksums := kubernetesConfig.GetArtifactChecksums()
+	provisionScript = strings.Replace(provisionScript, "AZURECNIVERSION", kubernetesConfig.GetAzureCNIVersion(), -1)
+	provisionScript = strings.Replace(provisionScript, "AZURECNICHECKSUM", checksums[api.AzureCNIArtifact], -1)
+
+	return provisionScript
+}
+
+// getKubernetesAddonYaml returns the addon manifest rendered with the Kubernetes settings, which
+// decide for instance whether the addon containers get a read-only root filesystem
+func getKubernetesAddonYaml(filename string, kubernetesConfig *api.KubernetesConfig) string {
+	b, err := Asset(filename)
+	if err != nil {
+		panic(fmt.Sprintf("BUG: %s", err.Error()))
+	}
+	templ, err := template.New(filename).Parse(strings.Replace(string(b), "\r\n", "\n", -1))
+	if err != nil {
+		panic(fmt.Sprintf("BUG: %s", err.Error()))
+	}
+	var buffer bytes.Buffer
+	if err := templ.Execute(&buffer, kubernetesConfig); err != nil {
+		panic(fmt.Sprintf("BUG: %s", err.Error()))
+	}
+	return buffer.String()
+}
+
 func getDCOSAgentProvisionScript(profile *api.AgentPoolProfile) string {
 	// add the provision script
 	bp, err1 := Asset(dcosProvision)
@@ -1179,7 +1533,7 @@ func getDCOSAgentProvisionScript(profile *api.AgentPoolProfile) string {
 
 	// the embedded roleFileContents
 	roleFileContents := ""
-	if len(profile.Ports) > 0 {
+	if profile.IsDCOSPublicPool() {
 		// public agents
 		roleFileContents = "touch /etc/mesosphere/roles/slave_public"
 	} else {
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"io/ioutil"
+	"os"
 	"path/filepath"
 	"strings"
 	"testing"
@@ -11,6 +12,7 @@ import (
 	"github.com/Azure/acs-engine/pkg/api"
 	"github.com/Azure/acs-engine/pkg/api/v20160330"
 	"github.com/Azure/acs-engine/pkg/api/vlabs"
+	"github.com/ghodss/yaml"
 	. "github.com/onsi/gomega"
 )
 
@@ -216,3 +218,329 @@ func TestVersionOrdinal(t *testing.T) {
 	Expect(v16 > v153).To(BeTrue())
 
 }
+
+func TestGetStorageWarnings(t *testing.T) {
+	RegisterTestingT(t)
+	cs := &api.ContainerService{
+		Properties: &api.Properties{
+			OrchestratorProfile: &api.OrchestratorProfile{OrchestratorType: api.Kubernetes},
+			MasterProfile:       &api.MasterProfile{VMSize: "Standard_D2_v2"},
+			AgentPoolProfiles: []*api.AgentPoolProfile{
+				{Name: "agentpool1", VMSize: "Standard_D2_v2"},
+			},
+		},
+	}
+	Expect(GetStorageWarnings(cs)).To(BeEmpty())
+
+	cs.Properties.AgentPoolProfiles[0].VMSize = "Standard_DS2_v2"
+	warnings := GetStorageWarnings(cs)
+	Expect(warnings).To(HaveLen(1))
+	Expect(warnings[0]).To(ContainSubstring("MasterProfile"))
+	Expect(warnings[0]).To(ContainSubstring("agentpool1"))
+
+	cs.Properties.MasterProfile.VMSize = "Standard_DS2_v2"
+	Expect(GetStorageWarnings(cs)).To(BeEmpty())
+}
+
+func TestGetClusterSummary(t *testing.T) {
+	RegisterTestingT(t)
+	cs := &api.ContainerService{
+		Location: "westus2",
+		Properties: &api.Properties{
+			OrchestratorProfile: &api.OrchestratorProfile{
+				OrchestratorType:    api.Kubernetes,
+				OrchestratorVersion: api.Kubernetes166,
+				KubernetesConfig:    &api.KubernetesConfig{NetworkPolicy: "calico"},
+			},
+			MasterProfile: &api.MasterProfile{Count: 3, VMSize: "Standard_D2_v2"},
+			AgentPoolProfiles: []*api.AgentPoolProfile{
+				{Name: "linuxpool", Count: 2, VMSize: "Standard_D2_v2"},
+				{Name: "windowspool", Count: 3, VMSize: "Standard_D2_v2", OSType: api.Windows},
+			},
+		},
+	}
+
+	summary := GetClusterSummary(cs)
+	Expect(summary.OrchestratorType).To(Equal(api.Kubernetes))
+	Expect(summary.OrchestratorVersion).To(Equal(api.Kubernetes166))
+	Expect(summary.Location).To(Equal("westus2"))
+	Expect(summary.NetworkPolicy).To(Equal("calico"))
+	Expect(summary.MasterCount).To(Equal(3))
+	Expect(summary.AgentNodeCount).To(Equal(5))
+	Expect(summary.AgentPools).To(HaveLen(2))
+	Expect(summary.AgentPools[0].OSType).To(Equal(api.Linux))
+	Expect(summary.AgentPools[1].OSType).To(Equal(api.Windows))
+}
+
+func TestGetCostEstimate(t *testing.T) {
+	RegisterTestingT(t)
+	cs := &api.ContainerService{
+		Properties: &api.Properties{
+			OrchestratorProfile: &api.OrchestratorProfile{OrchestratorType: api.DCOS},
+			MasterProfile:       &api.MasterProfile{Count: 3, VMSize: "Standard_D2_v2", OSDiskSizeGB: 50},
+			AgentPoolProfiles: []*api.AgentPoolProfile{
+				{Name: "private", Count: 2, VMSize: "Standard_D4_v2", DiskSizesGB: []int{100, 100}},
+				{Name: "public", Count: 1, VMSize: "Standard_D2_v2", OSType: api.Windows, Ports: []int{80}},
+			},
+		},
+	}
+	prices := &CostPrices{
+		VMSizeHourly:   map[string]float64{"Standard_D2_v2": 0.1, "Standard_D4_v2": 0.5},
+		DiskGBMonthly:  0.05,
+		PublicIPHourly: 0.004,
+	}
+
+	estimate, err := GetCostEstimate(cs, prices)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(estimate.Pools).To(HaveLen(3))
+	Expect(estimate.Pools[0].Name).To(Equal("master"))
+	Expect(estimate.Pools[0].Compute).To(BeNumerically("~", 0.1*3*HoursPerMonth))
+	Expect(estimate.Pools[0].DiskGB).To(Equal(150))
+	Expect(estimate.Pools[1].Compute).To(BeNumerically("~", 0.5*2*HoursPerMonth))
+	Expect(estimate.Pools[1].DiskGB).To(Equal(2 * (DefaultLinuxOSDiskSizeGB + 200)))
+	Expect(estimate.Pools[2].DiskGB).To(Equal(DefaultWindowsOSDiskSizeGB))
+	Expect(estimate.PublicIPCount).To(Equal(2))
+	total := estimate.PublicIPs
+	for _, pool := range estimate.Pools {
+		Expect(pool.Total).To(BeNumerically("~", pool.Compute+pool.Disks))
+		total += pool.Total
+	}
+	Expect(estimate.Total).To(BeNumerically("~", total))
+
+	delete(prices.VMSizeHourly, "Standard_D4_v2")
+	_, err = GetCostEstimate(cs, prices)
+	Expect(err).To(HaveOccurred())
+}
+
+func TestGetBackupDescriptor(t *testing.T) {
+	RegisterTestingT(t)
+	cs := &api.ContainerService{
+		Properties: &api.Properties{
+			OrchestratorProfile: &api.OrchestratorProfile{OrchestratorType: api.Kubernetes},
+			MasterProfile: &api.MasterProfile{
+				Count:          3,
+				DNSPrefix:      "backuptest",
+				StorageProfile: api.ManagedDisks,
+			},
+			ServicePrincipalProfile: &api.ServicePrincipalProfile{
+				ClientID: "client",
+				Secret:   "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.KeyVault/vaults/kv/secrets/spsecret",
+			},
+			LinuxProfile: &api.LinuxProfile{},
+			WindowsProfile: &api.WindowsProfile{
+				AdminPassword: "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.KeyVault/vaults/kv/secrets/winpassword",
+			},
+			PrivateRegistryProfile: &api.PrivateRegistryProfile{
+				Password: "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.KeyVault/vaults/kv/secrets/registry",
+			},
+			CertificateProfile: &api.CertificateProfile{ServiceAccountVerificationKeys: []string{"key"}},
+		},
+	}
+	cs.Properties.LinuxProfile.Secrets = []api.KeyVaultSecrets{{SourceVault: &api.KeyVaultID{ID: "vaultid"}}}
+
+	descriptor := GetBackupDescriptor(cs)
+	Expect(descriptor.Etcd).NotTo(BeNil())
+	Expect(descriptor.Etcd.DataDir).To(Equal("/var/lib/etcddisk"))
+	Expect(descriptor.Etcd.StorageProfile).To(Equal(api.ManagedDisks))
+	Expect(descriptor.Etcd.Members).To(HaveLen(3))
+	Expect(descriptor.Etcd.Members[0]).To(Equal(fmt.Sprintf("k8s-master-%s-0", GenerateClusterID(cs.Properties))))
+	Expect(descriptor.PKIPaths).To(ContainElement("/etc/kubernetes/certs/ca.key"))
+	Expect(descriptor.PKIPaths).To(ContainElement("/etc/kubernetes/certs/serviceaccount.key"))
+	Expect(descriptor.PKIPaths).To(ContainElement("/etc/kubernetes/certs/serviceaccount-verification.pub"))
+	Expect(descriptor.Identity.ClientID).To(Equal("client"))
+	Expect(descriptor.KeyVaultReferences).To(ConsistOf("vaultid", cs.Properties.ServicePrincipalProfile.Secret, cs.Properties.WindowsProfile.AdminPassword, cs.Properties.PrivateRegistryProfile.Password))
+
+	cs.Properties.OrchestratorProfile.OrchestratorType = api.DCOS
+	Expect(GetBackupDescriptor(cs).Etcd).To(BeNil())
+}
+
+func TestSetDockerBridgeDefaults(t *testing.T) {
+	RegisterTestingT(t)
+	properties := &api.Properties{
+		OrchestratorProfile: &api.OrchestratorProfile{
+			OrchestratorType: api.Kubernetes,
+			KubernetesConfig: &api.KubernetesConfig{ClusterSubnet: "172.16.0.0/15"},
+		},
+		MasterProfile: &api.MasterProfile{Subnet: DefaultKubernetesMasterSubnet},
+		AgentPoolProfiles: []*api.AgentPoolProfile{
+			{Name: "agentpool", Subnet: DefaultKubernetesMasterSubnet},
+		},
+	}
+
+	Expect(setDockerBridgeDefaults(properties)).To(Succeed())
+	Expect(properties.OrchestratorProfile.KubernetesConfig.DockerBridgeSubnet).To(Equal("172.18.0.1/16"))
+
+	properties.OrchestratorProfile.KubernetesConfig.DockerBridgeSubnet = "10.240.0.1/24"
+	err := setDockerBridgeDefaults(properties)
+	Expect(err).To(HaveOccurred())
+	Expect(err.Error()).To(ContainSubstring("the master subnet '10.240.0.0/16'"))
+	Expect(err.Error()).To(ContainSubstring("the subnet of agent pool 'agentpool' '10.240.0.0/16'"))
+}
+
+func TestGetStorageAccountIndexOnScaleUp(t *testing.T) {
+	RegisterTestingT(t)
+	for _, storageAccountsPerPool := range []int{0, 3} {
+		a := &api.AgentPoolProfile{Name: "agentpool", Count: 10, StorageAccountsPerPool: storageAccountsPerPool}
+		index := getStorageAccountIndex(a, "copyIndex()")
+		a.Count = 50
+		Expect(getStorageAccountIndex(a, "copyIndex()")).To(Equal(index), "scaling up must not move the VMs to other storage accounts")
+		Expect(index).NotTo(ContainSubstring("Count"))
+	}
+	Expect(getStorageAccountIndex(&api.AgentPoolProfile{Name: "agentpool", StorageAccountsPerPool: 3}, "copyIndex()")).To(Equal("mod(copyIndex(),3)"))
+	Expect(getStorageAccountsCount(&api.AgentPoolProfile{Name: "agentpool", StorageAccountsPerPool: 3})).To(Equal("[min(variables('agentpoolCount'), 3)]"))
+}
+
+func TestGetAgentComputerNamePrefix(t *testing.T) {
+	RegisterTestingT(t)
+	cases := []struct {
+		profile  *api.AgentPoolProfile
+		expected string
+	}{
+		{&api.AgentPoolProfile{Name: "agentpool"}, "[variables('agentpoolVMNamePrefix')]"},
+		{&api.AgentPoolProfile{Name: "agentpool", HostnamePrefix: "web"}, "web"},
+		{&api.AgentPoolProfile{Name: "winpool", OSType: api.Windows}, "[concat(substring(variables('nameSuffix'), 0, 5), 'acs')]"},
+		{&api.AgentPoolProfile{Name: "winpool", OSType: api.Windows, HostnamePrefix: "win"}, "win"},
+	}
+	for _, c := range cases {
+		Expect(getAgentComputerNamePrefix(c.profile)).To(Equal(c.expected), "agent pool %s with hostname prefix '%s'", c.profile.Name, c.profile.HostnamePrefix)
+	}
+}
+
+func TestGetKubernetesAddonYaml(t *testing.T) {
+	RegisterTestingT(t)
+	readOnly := true
+	for _, filename := range []string{
+		"kubernetesmasteraddons-heapster-deployment.yaml",
+		"kubernetesmasteraddons-kube-dns-deployment.yaml",
+		"kubernetesmasteraddons-kube-dns-deployment1.5.yaml",
+		"kubernetesmasteraddons-kubernetes-dashboard-deployment.yaml",
+	} {
+		k := &api.KubernetesConfig{}
+		addon := getKubernetesAddonYaml(filename, k)
+		Expect(addon).NotTo(ContainSubstring("readOnlyRootFilesystem"), filename)
+		Expect(addon).NotTo(ContainSubstring("emptyDir"), filename)
+
+		k.AddonsReadOnlyRootFilesystem = &readOnly
+		addon = getKubernetesAddonYaml(filename, k)
+		Expect(addon).To(ContainSubstring("readOnlyRootFilesystem: true"), filename)
+		Expect(addon).To(ContainSubstring("emptyDir: {}"), filename)
+		Expect(addon).NotTo(ContainSubstring("{{"), filename)
+	}
+}
+
+func TestGetKubernetesProvisionScript(t *testing.T) {
+	RegisterTestingT(t)
+	script := getKubernetesProvisionScript(&api.KubernetesConfig{ProvisionRetryCount: 7, ProvisionTimeoutInSeconds: 90})
+	Expect(script).NotTo(ContainSubstring("PROVISIONRETRYCOUNT"))
+	Expect(script).NotTo(ContainSubstring("PROVISIONTIMEOUTINSECONDS"))
+	Expect(script).To(ContainSubstring("for i in $(seq 1 7); do curl --max-time 90 -fsSL ${1}"))
+	Expect(script).To(ContainSubstring("curl --max-time 90 http://127.0.0.1:2379/v2/machines"))
+	Expect(script).NotTo(ContainSubstring("--max-time 60"))
+}
+
+func TestGetStorageAccountName(t *testing.T) {
+	RegisterTestingT(t)
+	name, err := GetStorageAccountName("agnt", 3)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(name).To(Equal("[concat(variables('storageAccountBaseName'), 'agnt3')]"))
+
+	name, err = GetStorageAccountName("", 0)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(name).To(Equal("[concat(variables('storageAccountBaseName'), '0')]"))
+
+	name, err = GetStorageAccountName("toolongrole", 0)
+	Expect(err).To(HaveOccurred())
+	Expect(name).To(BeEmpty())
+}
+
+type memoryArtifactWriter struct {
+	files map[string][]byte
+}
+
+func (w *memoryArtifactWriter) WriteFile(name string, data []byte, perm os.FileMode) error {
+	w.files[name] = data
+	return nil
+}
+
+func TestWriteArtifactsTo(t *testing.T) {
+	RegisterTestingT(t)
+	containerService, _, err := api.LoadContainerServiceFromFile(filepath.Join(TestDataDir, "simple", "kubernetes.json"))
+	Expect(err).NotTo(HaveOccurred())
+	containerService.Location = "westus2"
+	_, err = SetPropertiesDefaults(containerService)
+	Expect(err).NotTo(HaveOccurred())
+
+	w := &memoryArtifactWriter{files: map[string][]byte{}}
+	Expect(WriteArtifactsTo(w, containerService, vlabs.APIVersion, "template", "parameters", "out", true, false)).To(Succeed())
+	Expect(w.files).To(HaveKey("out/apimodel.json"))
+	Expect(string(w.files["out/azuredeploy.json"])).To(Equal("template"))
+	Expect(string(w.files["out/azuredeploy.parameters.json"])).To(Equal("parameters"))
+	Expect(w.files).To(HaveKey("out/kubeconfig/kubeconfig.westus2.json"))
+	Expect(string(w.files["out/ca.crt"])).To(Equal(containerService.Properties.CertificateProfile.CaCertificate))
+	Expect(w.files).To(HaveLen(12))
+
+	w = &memoryArtifactWriter{files: map[string][]byte{}}
+	Expect(WriteArtifactsTo(w, containerService, vlabs.APIVersion, "template", "parameters", "out", false, true)).To(Succeed())
+	Expect(w.files).To(HaveLen(1))
+}
+
+func TestGetHelmValues(t *testing.T) {
+	RegisterTestingT(t)
+	containerService, _, err := api.LoadContainerServiceFromFile(filepath.Join(TestDataDir, "simple", "kubernetes.json"))
+	Expect(err).NotTo(HaveOccurred())
+	containerService.Location = "westus2"
+	_, err = SetPropertiesDefaults(containerService)
+	Expect(err).NotTo(HaveOccurred())
+
+	values, err := GetHelmValues(containerService)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(values).To(HaveKey("kube-dns"))
+	Expect(values).To(HaveKey("kube-proxy"))
+	Expect(values["kube-proxy"].Kind).To(Equal("DaemonSet"))
+	Expect(values["kube-proxy"].Enabled).To(BeFalse())
+	Expect(values["kube-dns"].Enabled).To(BeFalse())
+	Expect(values["heapster"].Enabled).To(BeTrue())
+	Expect(values["kube-proxy"].Containers["kube-proxy"].Command).To(ContainElement("--cluster-cidr=" + containerService.Properties.OrchestratorProfile.KubernetesConfig.ClusterSubnet))
+	kubernetesImages := KubeImages[containerService.Properties.OrchestratorProfile.OrchestratorVersion]
+	Expect(values["kube-proxy"].Containers["kube-proxy"].Image).To(Equal(containerService.Properties.OrchestratorProfile.KubernetesConfig.KubernetesImageBase + kubernetesImages["hyperkube"]))
+	for _, addon := range values {
+		for _, container := range addon.Containers {
+			Expect(container.Image).NotTo(HavePrefix("<"))
+		}
+	}
+
+	chart := HelmChartReference{Name: "cluster-addons", Version: "0.1.0"}
+	w := &memoryArtifactWriter{files: map[string][]byte{}}
+	Expect(WriteHelmValuesTo(w, containerService, chart, "out")).To(Succeed())
+	Expect(w.files).To(HaveKey("out/helm-values.yaml"))
+	var file map[string]interface{}
+	Expect(yaml.Unmarshal(w.files["out/helm-values.yaml"], &file)).To(Succeed())
+	Expect(file["global"]).To(Equal(map[string]interface{}{
+		"chart":       map[string]interface{}{"name": "cluster-addons", "version": "0.1.0"},
+		"clusterCIDR": containerService.Properties.OrchestratorProfile.KubernetesConfig.ClusterSubnet,
+	}))
+	Expect(file).To(HaveKey("kube-dns"))
+	Expect(WriteHelmValuesTo(w, containerService, HelmChartReference{Name: "cluster-addons"}, "out")).NotTo(Succeed())
+
+	templateGenerator, err := InitializeTemplateGenerator(false)
+	Expect(err).NotTo(HaveOccurred())
+	templateGenerator.EmitHelmValues = true
+	armTemplate, _, _, err := templateGenerator.GenerateTemplate(containerService)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(armTemplate).NotTo(ContainSubstring("/etc/kubernetes/addons/kube-heapster-deployment.yaml"))
+	Expect(armTemplate).NotTo(ContainSubstring("/etc/kubernetes/addons/kubernetes-dashboard-deployment.yaml"))
+	Expect(armTemplate).To(ContainSubstring("/etc/kubernetes/addons/kube-dns-deployment.yaml"))
+	Expect(armTemplate).To(ContainSubstring("/etc/kubernetes/addons/kube-proxy-daemonset.yaml"))
+
+	containerService.Properties.OrchestratorProfile.OrchestratorType = api.DCOS
+	_, err = GetHelmValues(containerService)
+	Expect(err).To(HaveOccurred())
+}
+
+func TestCalicoImages(t *testing.T) {
+	RegisterTestingT(t)
+	Expect(CalicoImages).To(HaveKey(api.DefaultCalicoVersion))
+	for release := range vlabs.CalicoKubernetesVersions {
+		Expect(CalicoImages).To(HaveKey(release))
+	}
+}
@@ -0,0 +1,214 @@
+package acsengine
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Azure/acs-engine/pkg/api"
+	"github.com/ghodss/yaml"
+)
+
+// HelmChartReference is the umbrella chart the helm values are generated for. It is written to the global
+// values, so the chart and its subcharts can check they are given values meant for them.
+type HelmChartReference struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// HelmAddonValues are the values of the subchart of an umbrella chart deploying an addon. They hold the
+// replicas and the images, resources and commands of the containers the generated addon manifest deploys.
+// Addons the cluster needs before tiller can run stay with the addon-manager and are not enabled.
+type HelmAddonValues struct {
+	Enabled    bool                           `json:"enabled"`
+	Kind       string                         `json:"kind"`
+	Replicas   int                            `json:"replicas,omitempty"`
+	Containers map[string]HelmContainerValues `json:"containers"`
+}
+
+// HelmContainerValues are the image, resources, command and arguments of a container of an addon
+type HelmContainerValues struct {
+	Image     string                 `json:"image"`
+	Resources map[string]interface{} `json:"resources,omitempty"`
+	Command   []string               `json:"command,omitempty"`
+	Args      []string               `json:"args,omitempty"`
+}
+
+// helmManagedAddonYamls are the manifests of the optional addons the umbrella chart deploys instead of the
+// addon-manager when helm values are emitted. kube-dns, kube-proxy and calico stay with the addon-manager,
+// since tiller cannot run, let alone install the chart, without them.
+var helmManagedAddonYamls = map[string]bool{
+	"kubernetesmasteraddons-heapster-deployment.yaml":             true,
+	"kubernetesmasteraddons-heapster-service.yaml":                true,
+	"kubernetesmasteraddons-kubernetes-dashboard-deployment.yaml": true,
+	"kubernetesmasteraddons-kubernetes-dashboard-service.yaml":    true,
+}
+
+// addonWorkloadKinds are the kinds of the addon manifest documents that run containers
+var addonWorkloadKinds = map[string]bool{
+	"DaemonSet":             true,
+	"Deployment":            true,
+	"ReplicationController": true,
+}
+
+// GetHelmValues returns the values of an umbrella chart deploying the addons of the Kubernetes cluster
+// described by the container service, keyed by subchart. The subchart of an addon is named after its
+// k8s-app label, or the name of its workload. The container service must have its defaults set.
+// Only the optional addons are enabled; a template generated with EmitHelmValues leaves them out of the
+// master custom data.
+func GetHelmValues(cs *api.ContainerService) (map[string]HelmAddonValues, error) {
+	properties := cs.Properties
+	if properties.OrchestratorProfile == nil || !properties.OrchestratorProfile.IsKubernetes() {
+		return nil, fmt.Errorf("helm values can only be generated for Orchestrator %s", api.Kubernetes)
+	}
+
+	images := getAddonImages(cs)
+	settings := getAddonSettingsReplacer(cs)
+	addonYamls := kubernetesAddonYamls
+	if properties.OrchestratorProfile.OrchestratorVersion == api.Kubernetes153 ||
+		properties.OrchestratorProfile.OrchestratorVersion == api.Kubernetes157 {
+		addonYamls = kubernetesAddonYamls15
+	}
+	filenames := []string{}
+	for _, filename := range addonYamls {
+		filenames = append(filenames, filename)
+	}
+	if properties.OrchestratorProfile.KubernetesConfig.NetworkPolicy == "calico" {
+		for _, filename := range calicoAddonYamls {
+			filenames = append(filenames, filename)
+		}
+	}
+	sort.Strings(filenames)
+
+	values := map[string]HelmAddonValues{}
+	for _, filename := range filenames {
+		manifest := settings.Replace(getKubernetesAddonYaml(filename, properties.OrchestratorProfile.KubernetesConfig))
+		for _, document := range strings.Split(manifest, "\n---") {
+			name, addon, err := getHelmAddonValues(document, images)
+			if err != nil {
+				return nil, fmt.Errorf("error reading addon manifest %s: %s", filename, err.Error())
+			}
+			if addon != nil {
+				addon.Enabled = helmManagedAddonYamls[filename]
+				values[name] = *addon
+			}
+		}
+	}
+	return values, nil
+}
+
+// getHelmValuesFile returns the contents of the values file of the umbrella chart, the addon values keyed by
+// subchart alongside the chart reference in the global values
+func getHelmValuesFile(cs *api.ContainerService, chart HelmChartReference) ([]byte, error) {
+	if chart.Name == "" || chart.Version == "" {
+		return nil, fmt.Errorf("the name and version of the umbrella chart the helm values are for must be given")
+	}
+	addons, err := GetHelmValues(cs)
+	if err != nil {
+		return nil, err
+	}
+	values := map[string]interface{}{
+		"global": map[string]interface{}{
+			"chart":       chart,
+			"clusterCIDR": cs.Properties.OrchestratorProfile.KubernetesConfig.ClusterSubnet,
+		},
+	}
+	for name, addon := range addons {
+		values[name] = addon
+	}
+	return yaml.Marshal(values)
+}
+
+// getAddonImages returns the images the placeholders of the addon manifests are replaced with
+func getAddonImages(cs *api.ContainerService) map[string]string {
+	properties := cs.Properties
+	kubernetesConfig := properties.OrchestratorProfile.KubernetesConfig
+	kubernetesImages := KubeImages[properties.OrchestratorProfile.OrchestratorVersion]
+	imageBase := GetCloudSpecConfig(cs.Location).KubernetesSpecConfig.KubernetesImageBase
+	images := map[string]string{
+		"<kubernetesAddonResizerSpec>": imageBase + kubernetesImages["addonresizer"],
+		"<kubernetesDashboardSpec>":    imageBase + kubernetesImages["dashboard"],
+		"<kubernetesDNSMasqSpec>":      imageBase + kubernetesImages["dnsmasq"],
+		"<kubernetesExecHealthzSpec>":  imageBase + kubernetesImages["exechealthz"],
+		"<kubernetesHeapsterSpec>":     imageBase + kubernetesImages["heapster"],
+		"<kubernetesKubeDNSSpec>":      imageBase + kubernetesImages["dns"],
+		"<kubernetesKubeProxySpec>":    kubernetesConfig.KubernetesImageBase + kubernetesImages["hyperkube"],
+		"<calicoNodeSpec>":             CalicoImages[kubernetesConfig.GetCalicoVersion()]["node"],
+		"<calicoCNISpec>":              CalicoImages[kubernetesConfig.GetCalicoVersion()]["cni"],
+	}
+	if kubernetesConfig.KubeProxyImage != "" {
+		images["<kubernetesKubeProxySpec>"] = kubernetesConfig.KubeProxyImage
+	}
+	return images
+}
+
+// getAddonSettingsReplacer returns the replacer of the placeholders of the addon manifests that are not
+// images, the way the master custom data substitutes them
+func getAddonSettingsReplacer(cs *api.ContainerService) *strings.Replacer {
+	kubernetesConfig := cs.Properties.OrchestratorProfile.KubernetesConfig
+	// the kube-proxy arguments are inserted as items of the kube-proxy command
+	kubeProxyArgs := ""
+	for _, arg := range kubernetesConfig.GetKubeProxyArgs() {
+		kubeProxyArgs += "\n        - " + strconv.Quote(arg)
+	}
+	return strings.NewReplacer(
+		"<kubeClusterCidr>", kubernetesConfig.ClusterSubnet,
+		"<kubeProxyArgs>", kubeProxyArgs,
+	)
+}
+
+// getHelmAddonValues returns the subchart name and values of a manifest document, or nil values if
+// the document does not run containers
+func getHelmAddonValues(document string, images map[string]string) (string, *HelmAddonValues, error) {
+	var workload struct {
+		Kind     string `json:"kind"`
+		Metadata struct {
+			Name   string            `json:"name"`
+			Labels map[string]string `json:"labels"`
+		} `json:"metadata"`
+		Spec struct {
+			Replicas int `json:"replicas"`
+			Template struct {
+				Spec struct {
+					Containers []struct {
+						Name      string                 `json:"name"`
+						Image     string                 `json:"image"`
+						Resources map[string]interface{} `json:"resources"`
+						Command   []string               `json:"command"`
+						Args      []string               `json:"args"`
+					} `json:"containers"`
+				} `json:"spec"`
+			} `json:"template"`
+		} `json:"spec"`
+	}
+	if err := yaml.Unmarshal([]byte(document), &workload); err != nil {
+		return "", nil, err
+	}
+	if !addonWorkloadKinds[workload.Kind] {
+		return "", nil, nil
+	}
+
+	name := workload.Metadata.Labels["k8s-app"]
+	if name == "" {
+		name = workload.Metadata.Name
+	}
+	addon := &HelmAddonValues{
+		Kind:       workload.Kind,
+		Replicas:   workload.Spec.Replicas,
+		Containers: map[string]HelmContainerValues{},
+	}
+	for _, container := range workload.Spec.Template.Spec.Containers {
+		image := container.Image
+		if resolved, ok := images[image]; ok {
+			image = resolved
+		}
+		addon.Containers[container.Name] = HelmContainerValues{
+			Image:     image,
+			Resources: container.Resources,
+			Command:   container.Command,
+			Args:      container.Args,
+		}
+	}
+	return name, addon, nil
+}
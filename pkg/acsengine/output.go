@@ -5,11 +5,44 @@ import (
 	"io/ioutil"
 	"os"
 	"path"
+	"path/filepath"
 
 	"github.com/Azure/acs-engine/pkg/api"
 )
 
+// ArtifactWriter writes the generated artifacts, including the certificates, keys and kubeconfigs
+type ArtifactWriter interface {
+	WriteFile(name string, data []byte, perm os.FileMode) error
+}
+
+// FileSystemWriter is the ArtifactWriter that writes artifacts to the local filesystem
+type FileSystemWriter struct{}
+
+// WriteFile writes the file, creating its parent directories as needed
+func (w *FileSystemWriter) WriteFile(name string, data []byte, perm os.FileMode) error {
+	dir := filepath.Dir(name)
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		if e := os.MkdirAll(dir, 0700); e != nil {
+			return fmt.Errorf("error creating directory '%s': %s", dir, e.Error())
+		}
+	}
+
+	if err := ioutil.WriteFile(name, data, perm); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "wrote %s\n", name)
+
+	return nil
+}
+
+// WriteArtifacts writes the generated artifacts to the local filesystem
 func WriteArtifacts(containerService *api.ContainerService, apiVersion, template, parameters, artifactsDir string, certsGenerated bool, parametersOnly bool) error {
+	return WriteArtifactsTo(&FileSystemWriter{}, containerService, apiVersion, template, parameters, artifactsDir, certsGenerated, parametersOnly)
+}
+
+// WriteArtifactsTo writes the generated artifacts through the given ArtifactWriter
+func WriteArtifactsTo(w ArtifactWriter, containerService *api.ContainerService, apiVersion, template, parameters, artifactsDir string, certsGenerated bool, parametersOnly bool) error {
 	if len(artifactsDir) == 0 {
 		artifactsDir = fmt.Sprintf("%s-%s", containerService.Properties.OrchestratorProfile.OrchestratorType, GenerateClusterID(containerService.Properties))
 		artifactsDir = path.Join("_output", artifactsDir)
@@ -25,16 +58,16 @@ func WriteArtifacts(containerService *api.ContainerService, apiVersion, template
 			return err
 		}
 
-		if e := saveFile(artifactsDir, "apimodel.json", b); e != nil {
+		if e := saveFile(w, artifactsDir, "apimodel.json", b); e != nil {
 			return e
 		}
 
-		if e := saveFileString(artifactsDir, "azuredeploy.json", template); e != nil {
+		if e := saveFileString(w, artifactsDir, "azuredeploy.json", template); e != nil {
 			return e
 		}
 	}
 
-	if e := saveFileString(artifactsDir, "azuredeploy.parameters.json", parameters); e != nil {
+	if e := saveFileString(w, artifactsDir, "azuredeploy.parameters.json", parameters); e != nil {
 		return e
 	}
 
@@ -54,35 +87,35 @@ func WriteArtifacts(containerService *api.ContainerService, apiVersion, template
 				if gkcerr != nil {
 					return gkcerr
 				}
-				if e := saveFileString(directory, fmt.Sprintf("kubeconfig.%s.json", location), b); e != nil {
+				if e := saveFileString(w, directory, fmt.Sprintf("kubeconfig.%s.json", location), b); e != nil {
 					return e
 				}
 			}
 
 		}
 
-		if e := saveFileString(artifactsDir, "ca.key", properties.CertificateProfile.CaPrivateKey); e != nil {
+		if e := saveFileString(w, artifactsDir, "ca.key", properties.CertificateProfile.CaPrivateKey); e != nil {
 			return e
 		}
-		if e := saveFileString(artifactsDir, "ca.crt", properties.CertificateProfile.CaCertificate); e != nil {
+		if e := saveFileString(w, artifactsDir, "ca.crt", properties.CertificateProfile.CaCertificate); e != nil {
 			return e
 		}
-		if e := saveFileString(artifactsDir, "apiserver.key", properties.CertificateProfile.APIServerPrivateKey); e != nil {
+		if e := saveFileString(w, artifactsDir, "apiserver.key", properties.CertificateProfile.APIServerPrivateKey); e != nil {
 			return e
 		}
-		if e := saveFileString(artifactsDir, "apiserver.crt", properties.CertificateProfile.APIServerCertificate); e != nil {
+		if e := saveFileString(w, artifactsDir, "apiserver.crt", properties.CertificateProfile.APIServerCertificate); e != nil {
 			return e
 		}
-		if e := saveFileString(artifactsDir, "client.key", properties.CertificateProfile.ClientPrivateKey); e != nil {
+		if e := saveFileString(w, artifactsDir, "client.key", properties.CertificateProfile.ClientPrivateKey); e != nil {
 			return e
 		}
-		if e := saveFileString(artifactsDir, "client.crt", properties.CertificateProfile.ClientCertificate); e != nil {
+		if e := saveFileString(w, artifactsDir, "client.crt", properties.CertificateProfile.ClientCertificate); e != nil {
 			return e
 		}
-		if e := saveFileString(artifactsDir, "kubectlClient.key", properties.CertificateProfile.KubeConfigPrivateKey); e != nil {
+		if e := saveFileString(w, artifactsDir, "kubectlClient.key", properties.CertificateProfile.KubeConfigPrivateKey); e != nil {
 			return e
 		}
-		if e := saveFileString(artifactsDir, "kubectlClient.crt", properties.CertificateProfile.KubeConfigCertificate); e != nil {
+		if e := saveFileString(w, artifactsDir, "kubectlClient.crt", properties.CertificateProfile.KubeConfigCertificate); e != nil {
 			return e
 		}
 	}
@@ -90,23 +123,24 @@ func WriteArtifacts(containerService *api.ContainerService, apiVersion, template
 	return nil
 }
 
-func saveFileString(dir string, file string, data string) error {
-	return saveFile(dir, file, []byte(data))
+// WriteHelmValues writes the values of an umbrella chart deploying the Kubernetes addons to the local filesystem
+func WriteHelmValues(containerService *api.ContainerService, chart HelmChartReference, artifactsDir string) error {
+	return WriteHelmValuesTo(&FileSystemWriter{}, containerService, chart, artifactsDir)
 }
 
-func saveFile(dir string, file string, data []byte) error {
-	if _, err := os.Stat(dir); os.IsNotExist(err) {
-		if e := os.MkdirAll(dir, 0700); e != nil {
-			return fmt.Errorf("error creating directory '%s': %s", dir, e.Error())
-		}
-	}
-
-	path := path.Join(dir, file)
-	if err := ioutil.WriteFile(path, []byte(data), 0600); err != nil {
+// WriteHelmValuesTo writes the values of an umbrella chart deploying the Kubernetes addons through the given ArtifactWriter
+func WriteHelmValuesTo(w ArtifactWriter, containerService *api.ContainerService, chart HelmChartReference, artifactsDir string) error {
+	b, err := getHelmValuesFile(containerService, chart)
+	if err != nil {
 		return err
 	}
+	return saveFile(w, artifactsDir, "helm-values.yaml", b)
+}
 
-	fmt.Fprintf(os.Stderr, "wrote %s\n", path)
+func saveFileString(w ArtifactWriter, dir string, file string, data string) error {
+	return saveFile(w, dir, file, []byte(data))
+}
 
-	return nil
+func saveFile(w ArtifactWriter, dir string, file string, data []byte) error {
+	return w.WriteFile(path.Join(dir, file), data, 0600)
 }
@@ -0,0 +1,65 @@
+package acsengine
+
+import (
+	"github.com/Azure/acs-engine/pkg/api"
+)
+
+// ClusterSummary is a machine readable summary of a cluster, computed from the resolved model
+type ClusterSummary struct {
+	OrchestratorType    api.OrchestratorType    `json:"orchestratorType"`
+	OrchestratorVersion api.OrchestratorVersion `json:"orchestratorVersion,omitempty"`
+	Location            string                  `json:"location,omitempty"`
+	NetworkPolicy       string                  `json:"networkPolicy,omitempty"`
+	MasterCount         int                     `json:"masterCount"`
+	MasterVMSize        string                  `json:"masterVMSize,omitempty"`
+	AgentNodeCount      int                     `json:"agentNodeCount"`
+	AgentPools          []AgentPoolSummary      `json:"agentPools"`
+}
+
+// AgentPoolSummary summarizes a single agent pool of a cluster
+type AgentPoolSummary struct {
+	Name                string     `json:"name"`
+	Count               int        `json:"count"`
+	VMSize              string     `json:"vmSize"`
+	OSType              api.OSType `json:"osType"`
+	AvailabilityProfile string     `json:"availabilityProfile,omitempty"`
+	StorageProfile      string     `json:"storageProfile,omitempty"`
+	Public              bool       `json:"public"`
+}
+
+// GetClusterSummary returns a summary of the cluster described by the container service
+func GetClusterSummary(cs *api.ContainerService) *ClusterSummary {
+	properties := cs.Properties
+	summary := &ClusterSummary{
+		Location:   cs.Location,
+		AgentPools: []AgentPoolSummary{},
+	}
+	if properties.OrchestratorProfile != nil {
+		summary.OrchestratorType = properties.OrchestratorProfile.OrchestratorType
+		summary.OrchestratorVersion = properties.OrchestratorProfile.OrchestratorVersion
+		if properties.OrchestratorProfile.KubernetesConfig != nil {
+			summary.NetworkPolicy = properties.OrchestratorProfile.KubernetesConfig.NetworkPolicy
+		}
+	}
+	if properties.MasterProfile != nil {
+		summary.MasterCount = properties.MasterProfile.Count
+		summary.MasterVMSize = properties.MasterProfile.VMSize
+	}
+	for _, profile := range properties.AgentPoolProfiles {
+		osType := profile.OSType
+		if len(osType) == 0 {
+			osType = api.Linux
+		}
+		summary.AgentPools = append(summary.AgentPools, AgentPoolSummary{
+			Name:                profile.Name,
+			Count:               profile.Count,
+			VMSize:              profile.VMSize,
+			OSType:              osType,
+			AvailabilityProfile: profile.AvailabilityProfile,
+			StorageProfile:      profile.StorageProfile,
+			Public:              len(profile.Ports) > 0,
+		})
+		summary.AgentNodeCount += profile.Count
+	}
+	return summary
+}
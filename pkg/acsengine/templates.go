@@ -26,6 +26,7 @@
 // ../../parts/kubernetesagentvars.t
 // ../../parts/kubernetesbase.t
 // ../../parts/kuberneteskubelet.service
+// ../../parts/kubernetesmaster-etcd.yaml
 // ../../parts/kubernetesmaster-kube-addon-manager.yaml
 // ../../parts/kubernetesmaster-kube-apiserver.yaml
 // ../../parts/kubernetesmaster-kube-controller-manager.yaml
@@ -207,7 +208,7 @@ func agentparamsT() (*asset, error) {
 	return a, nil
 }
 
-var _classicparamsT = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x57\x4f\x53\x23\xb7\x13\xbd\xff\x3e\x45\xd7\x1c\x7e\x9b\xd4\xba\x9c\xa5\x52\x7b\xd9\x9b\x0b\x93\x5a\x92\xd8\xb8\x30\x38\x87\x54\x0e\x9a\x51\x8f\x47\x41\x23\xcd\xaa\x5b\x18\x2f\xc5\x77\x4f\x49\x9a\x31\x36\x04\xc3\x10\xb3\xb5\x9c\x6c\x64\xa9\xdf\x7b\xea\x3f\x4f\x00\x90\x89\x25\x1a\x3e\x31\xb2\xb1\xca\xf0\x78\x3a\x9f\x8a\x1a\x67\x0e\x4b\x75\x93\x7d\x82\xdb\xff\x41\xfc\xcb\x24\x96\xc2\x6b\x5e\x08\xed\x31\xfb\x04\xd9\xe5\xf4\x72\x7e\x32\xce\x06\xdd\x7a\x8d\x2c\xa4\x60\xb1\xb5\x27\xee\xa2\xc2\xa9\x86\x95\x35\x61\xd3\x1c\x99\x80\x2b\x84\xb1\xad\x85\x32\x60\x44\x8d\xa0\x45\x8e\x1a\x4a\xeb\xe2\x4a\x44\x03\x8d\xb5\x1a\x4e\x67\x30\x92\xd2\x21\xd1\x10\xe0\xa2\x42\x28\xac\x29\x04\xa3\x11\xe1\x3c\xb0\x65\xdc\x20\x1f\x1d\x25\x8c\x8c\x2b\x0e\x97\xca\x1a\xa1\x61\x3c\x9d\xc3\x57\x6b\x10\x6a\x71\x85\xe0\x9b\xb8\x5a\x7a\xad\xd7\xf0\xc5\x0b\xad\x4a\x85\x72\xe7\x1c\x41\x64\x0b\x25\x18\x25\xac\x14\x57\xf1\xf7\x8d\xcf\xb5\x2a\x02\x28\xd1\x82\xca\x5a\x9e\x77\x1b\x11\x78\xdd\x44\x71\x88\x9d\x32\xcb\xb4\x9e\x56\x33\xa9\x48\xe4\x1a\x67\x82\x68\x65\x9d\x1c\x79\xae\xd0\xb0\x2a\x44\xab\xcd\x53\x42\xb3\xf3\xd8\x4f\xe4\x8b\x4a\x11\x10\x32\x2b\xb3\x0c\x92\xb1\xb3\x9a\x60\x55\x21\x57\xe8\xa0\x69\x01\x80\xf0\x5c\x81\x22\x68\x81\xc9\x78\x03\xbf\x2b\xe3\x6f\x60\x31\x21\x68\x9c\xbd\x56\xa4\xac\x41\x09\xf9\x1a\x38\x1c\xca\x58\x37\x5a\x30\x0e\x61\x9c\x40\x86\xfd\x01\x20\xac\x2a\x55\x54\xdd\x51\xb4\x15\xc4\xc8\x28\x3a\xc1\x7c\xfe\x19\xae\x70\x0d\x0e\xbf\x78\xe5\x50\xee\x51\x2f\xb7\x56\x6f\x6b\x07\x19\x9a\x70\xee\x14\x57\x73\xb6\x4e\x2c\x71\x54\x14\xd6\x1b\x9e\x8a\x3a\xc8\x7c\x48\xf1\x4e\xcb\xb8\xe9\x13\x78\x42\x8a\x89\x13\x13\xa2\x89\xf5\x00\xef\xe1\xcc\x15\x15\x12\x3b\xc1\xd6\xa5\xa5\xf7\x70\x1e\xf3\x0c\xd8\x42\xe1\x50\x30\x02\x25\x94\x20\x12\xcc\xf4\x3b\xb6\xe0\x50\xfa\x02\xd3\xd7\xc2\x6a\x1d\xf5\x0d\x4a\xe7\x22\x57\x5a\xf1\x7a\x08\xa7\x25\x94\x42\x53\x1f\x00\xcf\xc7\x0d\x89\xcd\x21\xb9\xbb\x0b\x04\x25\xb1\x6e\x2c\xa3\x29\xd6\xaf\xb8\x88\xc5\x64\xac\xc4\xd2\x58\x62\x55\xd0\x41\xe5\x1f\x69\x6d\x57\x14\xb8\xbb\x00\x3c\x85\xfb\xa9\xcd\x2b\xc8\xad\x65\xf8\x3f\x5c\xd7\x20\xef\xe3\xf7\xc2\xaf\x68\x21\xb4\x92\x8f\x8a\x4e\x84\xb8\x28\x23\xf0\xc0\xe8\xcf\x0d\xc2\x0f\x83\xcd\xc7\xa3\xf6\xd3\x5f\x83\x27\xf8\x7e\x78\x45\xa1\xc6\xb2\xa2\x58\xab\xe1\x82\x2a\x84\xeb\x0d\xc2\xb6\x83\x3d\x4d\x50\x19\xde\xe5\xf7\xb7\xaf\x9b\xdc\xde\x7c\x37\xdd\xbc\xc5\xf3\xdf\xfa\xb7\xfa\x8a\xf2\x7b\xe8\xe1\x90\xe9\xd0\x1e\x47\xb2\x56\xa6\xeb\xe3\x07\x17\xb5\x3b\x78\x23\x61\xdb\x92\x95\x63\x2f\x34\x4c\x44\x51\x29\x83\x43\x80\xa9\x65\x38\xef\x9a\x29\x84\xc6\x61\x2c\x87\xbe\x3f\x80\xb5\xf5\x50\x7b\xe2\xd4\xc4\x25\x82\xe8\xda\xef\x3e\xbe\x58\x78\x87\xff\x32\xb9\x22\xe9\xb3\xb2\x44\x77\x70\xb2\x9b\x0a\xa8\x10\x6c\x88\xd0\x25\x85\xaa\x43\x1f\xf3\xd4\x0d\x1f\x84\x88\x02\x0a\xed\x89\xd1\xbd\xe2\xd6\x66\xe1\xea\xa9\x7a\x63\x12\x4d\x17\xe5\xed\x88\xcc\xaf\xfc\x9b\x52\x48\xf8\xe8\xca\x1f\x14\xf5\x02\x1d\xed\x37\x3a\x87\x42\x7e\x9d\x22\x1d\x0a\x7d\x2d\xc2\x9e\xe3\x30\x4f\x5f\x36\x30\x8e\xee\x07\xc6\xcf\xf7\x1f\x3f\x3e\x37\x3b\x8e\xfa\xf2\x46\x30\xbe\xce\x53\xa6\x4d\x90\x2c\x41\x82\x4a\x9b\xce\xd1\xf2\xdd\x53\xf3\x0f\xe6\x47\x66\x83\x29\x3c\x89\x43\x57\xbe\x8c\x6d\x16\x66\x7c\x76\xcf\x33\x8b\xee\x25\x7b\x8e\x6d\xfb\xb3\x7e\x9c\x13\x30\x38\x0b\xde\x39\xda\xd7\x2d\x03\xdd\xeb\x4e\x1b\x4b\x7c\x6a\x88\x85\xd6\xf3\x18\xe0\xf2\xfc\x74\x5f\x66\x76\x16\xb9\x27\xde\x51\xc9\xe8\x40\xa5\x40\x11\xe6\x20\xf9\x68\x6a\xb0\x08\x03\x8b\x40\x40\xda\x11\xcc\x8e\xb4\x2b\xa3\xad\x48\xa6\xb9\xdd\x15\x06\xa7\xdd\x58\xf4\x41\x68\xef\xc1\x1f\xf8\x68\xeb\xde\x75\xff\x7f\xd7\xef\x29\x42\xc8\x71\xa8\x1c\x5b\x53\xaa\xa5\x77\x11\xda\x2f\xd6\x2d\x26\xc7\xd1\x47\x1e\xde\x18\xf5\x4e\xee\x7d\x2f\x98\x34\x10\x8b\x6d\xf0\x69\xc2\x87\x19\xf7\x1b\xae\x43\x3b\x08\x0f\x10\x0c\x2a\xc2\x62\x02\xb3\xcb\x0b\x98\x89\x75\xd0\x76\x08\xdd\xd3\x85\x82\x82\x47\x69\x70\x6e\x6d\xcc\xb5\x30\x57\xed\x35\xc5\x11\x9a\x63\x14\x9d\x2d\x7c\xe8\x51\x48\xd7\x35\xcd\xd0\xed\xbe\x56\xf6\x64\xd8\xc7\xd7\xe8\xb3\x49\x22\xde\xe9\x05\xf1\xf1\x86\xee\xe1\x63\x80\x5e\x06\xfe\xf6\x56\x25\x23\x31\xfc\x2c\xe8\x0f\x65\xa4\x5d\xd1\xdd\x5d\x2a\x9b\x55\xfa\xfa\x8d\xfd\x4f\x0b\xe2\x91\x03\xea\xef\x64\x76\x19\x5c\x12\xba\x60\x14\x0f\xce\x20\x1c\x9c\x2c\xe8\x33\x14\xe0\x87\x0d\xd9\x33\xa3\xd7\x30\xf7\x4d\x63\x1d\xa3\xfc\xf1\xe5\xf5\x7c\x7b\x8b\x46\x3e\xb8\xa0\x5f\x93\xe9\x7e\x7b\xcb\xd6\xc6\x6b\xad\xdb\xf6\xc0\x6d\x57\xfa\xb5\xe5\x5d\xf8\xdf\xc6\xb0\x75\x14\xee\x8d\xdb\x81\x69\xbc\xb5\x5d\xeb\x08\x3c\x34\x6c\xfd\xa0\xff\x13\x00\x00\xff\xff\xaf\xed\x2c\xb7\x08\x14\x00\x00")
+var _classicparamsT = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x57\x4f\x73\xdb\xb6\x13\xbd\xff\x3e\xc5\x0e\x0f\xbf\xb4\x13\x8d\x1a\x1f\x7a\xc9\x4d\x13\xa5\x8d\xdb\x4a\xd6\x44\xb1\x7a\xe8\xf4\x00\x12\x4b\x11\x35\x08\x30\xd8\x85\x14\x45\xe3\xef\xde\x01\x40\xca\x92\xff\xc8\xa6\x2b\x67\x92\x53\x64\x10\xd8\xf7\x1e\xf6\xcf\x03\x00\x64\x62\x89\x86\xdf\x1b\xd9\x58\x65\x78\x3c\x9d\x4f\x45\x8d\x33\x87\xa5\xfa\x92\xbd\x85\xed\xff\x20\xfe\xcb\x24\x96\xc2\x6b\x5e\x08\xed\x31\x7b\x0b\xd9\xe5\xf4\x72\xfe\x7e\x9c\x0d\xba\xf5\x1a\x59\x48\xc1\x62\x6f\x0f\x40\x26\x91\x0a\xa7\x1a\x56\xd6\x84\x4d\x73\x64\x02\xae\x10\xc6\xb6\x16\xca\x80\x11\x35\x82\x16\x39\x6a\x28\xad\x8b\x2b\x11\x0d\x34\xd6\x6a\x38\x9f\xc1\x48\x4a\x87\x44\x43\x80\x4f\x15\x42\x61\x4d\x21\x18\x8d\x08\xe7\x81\x2d\xe3\x06\x79\xe7\x28\x61\x64\x5c\x71\xb8\x54\xd6\x08\x0d\xe3\xe9\x1c\xbe\x5a\x83\x50\x8b\x2b\x04\xdf\xc4\xd5\xd2\x6b\xbd\x81\xcf\x5e\x68\x55\x2a\x94\x07\xe7\x08\x22\x5b\x28\xc1\x28\x61\xad\xb8\x8a\xdf\x37\x3e\xd7\xaa\x08\xa0\x44\x0b\x2a\x6b\x79\x5e\xef\x44\xe0\x4d\x13\xc5\x21\x76\xca\x2c\xd3\x7a\x5a\xcd\xa4\x22\x91\x6b\x9c\x09\xa2\xb5\x75\x72\xe4\xb9\x42\xc3\xaa\x10\xad\x36\x0f\x09\xcd\xce\x63\x3f\x91\x3f\x55\x8a\x80\x90\x59\x99\x65\x90\x8c\x9d\xd5\x04\xeb\x0a\xb9\x42\x07\x4d\x0b\x00\x84\xe7\x0a\x14\x41\x0b\x4c\xc6\x1b\xf8\x43\x19\xff\x05\x16\x13\x82\xc6\xd9\x95\x22\x65\x0d\x4a\xc8\x37\xc0\xe1\x50\xc6\xba\xd1\x82\x71\x08\xe3\x04\x32\xec\x0f\x00\x61\x5d\xa9\xa2\xea\x8e\xa2\xbd\x20\x46\x46\xd1\x09\xe6\xf3\x0f\x70\x85\x1b\x70\xf8\xd9\x2b\x87\xf2\x88\x7a\xb9\xb5\x7a\x5f\x3b\xc8\xd0\x84\x73\xa7\xb8\x9e\xb3\x75\x62\x89\xa3\xa2\xb0\xde\xf0\x54\xd4\x41\xe6\x53\x8a\x77\x5e\xc6\x4d\x6f\xc1\x13\x52\x4c\x9c\x98\x58\x4d\xac\x07\x78\x0d\x17\xae\xa8\x90\xd8\x09\xb6\x2e\xe5\xca\x6b\xf8\x18\xf3\x0c\xd8\x42\xe1\x50\x30\x02\x25\x94\x20\x12\xcc\xf4\x1d\x5b\x70\x28\x7d\x81\xe9\x67\x61\xb5\x8e\xfa\x06\xa5\x73\x91\x2b\xad\x78\x33\x84\xf3\x12\x4a\xa1\xa9\x0f\x80\xc7\xe3\x86\xc4\xe6\x50\x24\xdd\x05\x82\x92\x58\x37\x96\xd1\x14\x9b\x67\x5c\xc4\x62\x32\x56\x62\x69\x2c\xb1\x2a\xe8\xa4\xf2\x8f\xb4\xb6\x6b\x0a\xdc\x5d\x00\x9e\xee\xfd\xa7\x36\xaf\x20\xb7\x96\xe1\xff\xb0\xaa\x41\xde\xc4\xef\x85\x5f\xd1\x42\x68\x25\xef\x14\x9d\x08\x71\x51\xc6\xbc\x09\x8c\xfe\x6a\x17\x00\xde\x74\xa7\x02\x9c\xb5\xff\xfb\x7b\xf0\x00\xdf\x37\xfd\xc8\xc6\x42\x0d\x15\x84\x14\x6b\x35\x5c\x50\x85\xb0\xda\x21\x6c\x3b\xd8\xc3\x04\x95\xe1\x43\x7e\xff\xf8\xba\xc9\xed\x97\xef\xa6\x9b\xb7\x78\xfe\x5b\xff\x56\x5f\x51\x7e\x0f\x3d\x1c\x32\x1d\xda\xe3\x48\xd6\xca\x74\x7d\xfc\xe4\xa2\x76\x07\xef\x24\x6c\x5b\xb2\x72\xec\x85\x86\x89\x28\x2a\x65\x70\x08\x30\xb5\x0c\x1f\xbb\x66\x0a\xa1\x71\x18\xcb\xa1\xef\x0f\x60\x63\x3d\xd4\x9e\x38\xb4\x96\x95\x92\x08\xa2\x6b\xbf\xc7\xf8\x62\xe1\x1d\xde\x33\xb9\x22\xe9\x8b\xb2\x44\x77\x72\xb2\xbb\x0a\xa8\x10\x6c\x88\xd0\x25\x85\xaa\x43\x1f\xf3\xd4\x0d\x1f\x84\x88\x02\x0a\xed\x89\xd1\x3d\xe3\xd6\x66\x61\x7c\x53\xf5\xc2\x24\x9a\x2e\xca\xcb\x11\x99\x5f\xf9\x17\xa5\x90\xf0\xd1\x95\x3f\x29\xea\x05\x3a\x3a\x6e\x74\x4e\x85\x7c\x95\x22\x9d\x0a\x7d\x2d\xc2\x9e\x77\x61\x8e\x1f\x1b\x18\xdb\xed\xaf\xc8\x71\x7a\xa1\x9c\xdc\x6c\xa1\xeb\xeb\xc1\x03\x7c\xcf\xfa\x12\x45\x30\xbe\xce\x53\x8d\x4c\x90\x2c\x41\xc2\x46\xbb\x56\xd1\x12\x1c\x3e\x79\x60\x64\x36\xb8\xc0\xf7\x71\xca\xca\x63\xf4\x6e\xe6\x61\x16\xec\xd1\xee\x92\x00\xb2\x68\x57\xb2\xc7\x46\x63\xfb\x59\x3f\xce\x09\x18\x5c\x04\xb3\x0c\xe2\xd0\x31\x3f\x48\xf1\xbe\x4b\x6c\x2c\xf1\xb9\x21\x16\x5a\xcf\x63\x80\xcb\x8f\xe7\xfb\x74\x6f\x83\x6d\x0d\x87\xec\x99\x8c\xa3\x92\xd1\x81\x4a\x81\x22\xcc\x41\x32\xce\xd4\x60\x11\x26\x14\x81\x80\xb4\x23\xb8\x1b\x69\xd7\x46\x5b\x91\x5c\x72\xbb\x2b\x4c\x4a\xbb\xf3\xe4\x83\xd0\xcf\x83\x21\xf0\xd1\xe6\xbd\xea\xfe\xfe\x6a\xd8\x87\x7f\x46\xc8\x71\x8a\xbc\xb3\xa6\x54\x4b\xef\x22\xb4\x5f\xac\x5b\x4c\xde\x45\xe3\xf8\xb4\x9b\xef\xe3\x84\xce\x4e\xf9\x64\x89\xd8\xa1\xd8\x07\x9f\x46\x7a\x18\x6a\xbf\xe3\x26\xd4\x7f\x78\x71\x60\x50\x11\x16\x13\x98\x5d\x7e\x82\x99\xd8\x04\x6d\x87\xd0\xbd\x55\x28\x28\x78\x96\x26\xe5\xde\xc6\x5c\x0b\x73\xd5\x5e\x53\x9c\x99\x39\x46\xd1\xd9\xc2\x9b\x1e\x85\xb4\xaa\x69\x86\xee\xf0\x79\x72\x24\xc3\x7e\x7e\x8e\x3e\xbb\x24\xe2\x83\x5e\x10\x5f\x6b\xe8\x6e\xbb\x7f\x7a\x1a\xf8\xed\x56\x25\xe7\x30\xfc\x20\xe8\x4f\x65\xa4\x5d\xd3\xf5\x75\x5c\xcd\xd6\xe9\xe7\x37\x36\x3c\x2d\x88\x3b\x96\xa7\xbf\x75\x39\x64\x70\x49\xe8\x82\x45\x3d\x39\x83\x70\x70\xf2\x9c\x8f\x50\x80\x1f\x76\x64\x2f\x8c\xde\xc0\xdc\x37\x8d\x75\x8c\xf2\xc7\xa7\xd7\xf3\x76\x8b\x46\xde\xba\xa0\xdf\x92\xcb\x7e\x79\x8f\xd6\xc6\x6b\xbd\xda\xfe\x84\x6d\x57\x9e\x4c\xe3\x1e\xf8\xdf\xc6\xa1\xb5\x31\xf7\x9c\xda\x89\x69\xbc\xb4\x3f\x6b\xa3\xdd\x71\x68\xfd\xa0\xff\x3b\x00\x28\x72\xbb\x90\xf9\x13\x00\x00")
 
 func classicparamsTBytes() ([]byte, error) {
 	return bindataRead(
@@ -267,7 +268,7 @@ func configureSwarmmodeClusterSh() (*asset, error) {
 	return a, nil
 }
 
-var _dcosagentresourcesvmasT = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5a\xcd\x6e\xdb\x3e\x12\x3f\x27\x4f\x21\xe8\x22\x1b\x50\xed\xc5\xee\xad\xb7\x24\x6e\x53\xa3\x71\x62\x44\x6d\x2e\x81\x0f\xb4\x38\xb6\x89\x48\xa4\x40\x52\x6e\xbc\x86\xdf\x7d\x41\x7d\x53\xa2\x14\xb9\x71\xd2\x2c\xfa\xcf\xa5\xb6\xc9\x21\x87\xbf\xf9\x9e\xa9\x65\x59\xd6\xfe\xdc\x4a\xfe\x6c\x14\x91\x07\xe0\x82\x30\x6a\x7f\xb6\xec\xc7\x2d\xe2\x04\x2d\x03\x10\x03\xa7\x5c\x99\xc0\x0a\xc5\x81\x74\x86\x0b\xdb\xcd\xe9\x02\xe6\x23\x69\xa0\xca\x7f\xd7\x36\x53\x14\x42\x7d\xe3\x7e\x3f\xba\x45\x21\x1c\x0e\xb7\xde\xb5\xfa\xa0\x11\x44\x9c\x45\xc0\x25\x01\x61\x7f\x2e\x78\xb5\x2c\x5b\x80\x1f\x73\x22\x77\xf7\x71\x90\x2c\x3d\x16\x4b\xc9\xa3\xf6\xd7\x20\xbd\xea\x16\x6b\x34\x67\x5c\x8a\xc3\xa1\xd8\xb7\xc8\x3e\x1d\x8a\xbb\xe4\x2e\x4a\x98\x9b\x11\x9f\x33\xc1\x56\x72\x74\x0b\xf2\x17\xe3\x4f\x63\x9a\xfe\x9b\x9f\x78\xcd\x59\x1c\x09\xfb\xbc\x42\xfe\x6a\x18\x7d\x16\xed\xf4\x27\xfa\x2c\xa6\x32\x39\x44\xc4\xcb\x81\x09\xb0\x2b\xb5\xc3\x19\xba\x96\x69\xf1\x6e\xb5\x12\x20\x9d\x61\xe5\x92\x8a\x00\x02\xc6\x22\xbb\x81\x00\x86\x08\x28\x16\x77\x34\x41\x74\xbf\x27\x2b\x6b\x34\x15\x57\xb1\x90\x2c\x7c\xb8\xfd\xf2\xa3\x80\xcf\x7e\xf4\x19\xf5\x91\x1c\x38\x3d\xc1\x1a\x3b\x66\x2e\x0b\x99\x0f\x17\xf6\xf9\x7e\x0f\x81\x80\xca\x25\x15\x8a\x2d\x05\x39\x9d\x38\xd9\x36\x8a\x0f\x87\x94\xbf\xa9\x98\xc7\xcb\x80\xf8\xa5\x80\xcf\x2c\xcb\x35\x2b\xd8\xcd\xb2\x76\x42\xa6\x09\xaf\xd4\xe5\x0c\x0a\xd3\x8d\x0f\x33\xf5\xef\x9c\xc3\x8a\x3c\x2b\x41\x39\x94\xf8\x9f\x1c\xd7\x52\xd2\x9e\x52\x0c\xcf\x46\xaa\x42\x74\x1d\x96\x60\x14\xce\x59\xca\x98\x41\x00\x09\xcd\xd9\x99\x65\xd9\x04\x27\x4c\x73\x10\x2c\xe6\x3e\x4c\xf1\x69\x65\x78\x96\x69\x94\x0e\xb1\xba\x37\xba\x62\x74\x45\xd6\x31\x4f\xa0\xac\x1b\xed\x5e\x33\xe0\x02\xdc\x9c\xea\x96\x61\xa8\xe8\x71\x2b\x22\x4d\x75\xb0\xb4\x3f\x3b\x60\x08\x5f\xa2\x00\x51\x1f\xf8\x25\xf2\x9f\x80\xe2\x0b\x8c\x39\x08\x31\x67\x2c\x48\xb9\x3a\x3b\x2b\xb9\xca\x3f\x57\xa0\xcb\x55\x7f\x2c\xe2\xa5\xf0\x39\x89\x92\xf7\x28\x74\xaa\x3f\x0c\x86\xa3\xea\xd7\x29\x76\x9d\x71\x0e\x7a\x89\xa7\xf6\xcb\x60\x38\x52\xef\x76\x2d\x67\x1c\x71\xb6\x25\x18\xb8\x18\x37\x85\x53\x7d\x42\xab\x50\x6e\x96\xa9\x4c\xd4\x61\xcb\xe6\x3b\xc7\x8e\x6b\xa6\xca\x30\x51\x9b\x2a\x42\x2d\x00\x39\x14\x9f\x17\x4d\x19\x17\x72\x21\x5b\x24\x61\x3a\xbf\x08\x72\xc3\x99\x81\xdc\xb0\x04\xbd\xc9\x8e\xa2\x90\xf8\x35\x59\x2a\x8f\x1e\x2f\x29\x48\xcd\x05\x96\xba\x83\x5b\x83\xc6\x03\x05\xe9\x25\xa4\x99\x6d\x6b\x94\x3a\x6f\xd5\x6f\xaf\x0a\x04\x53\x2a\x81\xaf\x90\x0f\x65\x10\xc8\xed\x71\x86\x28\x5a\x03\x9e\x10\xf1\x94\x6b\xdf\x51\xb1\xc1\x93\x8c\xa3\x35\x54\x8f\x79\xa3\x70\x7b\xb1\x45\x24\x40\x4b\x12\x10\xb9\xf3\x40\xf6\x0a\xbb\x51\x80\xe4\x8a\xf1\xf0\xab\x8a\x5f\x13\x16\x22\x42\xaf\xf2\x30\xf5\xef\x6a\x9c\xc9\x37\xfe\x8c\x30\x92\x50\xdb\xf9\x9f\xea\xce\x30\x7d\xa9\xfa\x5d\xf2\x18\x9a\x41\xa9\x29\x8d\x2b\x16\x46\xb1\x84\x31\xd2\x5f\x50\x15\x86\x0a\x24\x56\x2a\x91\x0c\xd1\x0b\x3f\x09\xa8\xaf\x90\x49\xef\x78\x6d\x42\x5b\xe7\x42\x64\xa1\xfb\xb7\x63\x73\x41\xd4\x11\x88\xa3\xc4\x13\x4e\xe7\x99\xdd\x43\xdd\x57\x84\x48\x48\xe0\x73\x7d\x57\x69\xf4\x99\x75\x9c\x3c\x38\x0a\x0d\x89\x34\x36\x82\x70\x86\x8f\x21\xc3\x03\x84\xf1\xa0\x0c\x8e\x43\xa3\x93\xd2\xa1\x2c\x82\xa5\xfb\xe2\x1d\x19\xe8\xc3\xc5\xcb\x5b\x9d\xe1\x23\x26\xdb\x3f\xc0\x4e\x69\x9e\xe9\xe6\x42\x1e\x2f\xda\x26\x4a\x09\x7e\x64\xe6\xa2\x25\x4f\xa1\x47\xfe\x0b\x62\x86\x22\x67\x68\xf6\xa2\x33\xb5\xc1\x19\x2e\x46\x3a\xab\xea\xb0\x45\x1f\x93\xcc\x40\x18\xeb\xe4\xb5\x1c\x39\x71\x91\xdf\x90\xa8\x3a\x47\xab\x16\xfb\x7f\xd7\x20\xcd\x46\x79\x12\xc3\xd4\x14\x1a\x23\x89\x30\x11\x4f\x37\x15\x23\xd5\xc0\xe9\x30\xd6\x77\x31\x58\xcd\x68\x8f\x36\xdc\x13\x1a\x6f\x85\x4a\x81\xe6\x19\x28\x3d\x00\x5c\x33\x95\x3f\x6f\xe5\x1f\x8a\xef\xe2\xd8\x09\x92\xa8\xcd\x25\x74\xb9\x85\xf7\x71\x0d\x4d\x0b\x38\xd2\x45\x68\x6e\xa2\xcc\x2a\x3f\x50\x77\xa2\x7f\xba\xf4\xda\xd4\xa5\xab\xb8\xfd\x60\xa0\xd4\xbc\x50\x8f\x28\x85\xa9\xf0\x40\x4a\x42\xd7\x75\x3d\xb5\x71\x92\x25\xaa\x93\x6e\xd0\x12\x82\xd6\x4b\xbf\x50\x1c\x31\x42\xe5\xe4\xd6\xab\x56\xd7\x8b\x86\x26\xa5\x8c\xe4\xee\xb2\xa3\x18\x39\xa2\x02\x68\xf8\xe8\xd3\xb6\x81\x4e\x9f\xe5\xb5\xc9\xea\x74\x29\x5e\x57\xf9\xd9\x43\x23\x0c\xd5\x69\xbf\xde\x40\xcb\xc5\x8d\x0a\x56\xf7\x52\x65\xdd\x57\x51\x92\x15\x67\x54\x02\xc5\xd3\xf9\xef\x34\x29\xda\x9a\x4d\xd9\x61\x75\x24\xba\xf1\xa8\xab\x6d\x0a\x4b\x67\x5d\xdc\xdd\xcb\x69\x28\x88\xb9\xf4\xef\x4c\x28\xac\xbe\xb5\x74\x05\x53\x42\x97\x2c\xa6\xf8\x16\xc9\xa2\x49\x5b\x5d\x2e\xbb\x18\x84\xae\xdb\xda\xb8\x83\x6b\x90\x37\x97\x59\x07\x57\x31\x96\x79\xc2\xe1\xc1\x7c\x67\xc4\xd9\xb2\xf5\xa0\x79\xb2\x68\x3a\xe1\x08\xfb\xd7\x9a\x2f\x0d\xaf\x9d\x2a\x49\x47\x2f\xe0\xb5\x5d\x80\x7a\x8b\xf4\x68\x3f\xa3\xf7\x6c\xde\xbb\xed\xbc\x0d\x55\xde\x9c\x34\xf3\x7a\x36\x9f\x8d\xd5\xbb\xd5\xe2\x13\x5b\x52\x0c\xbd\xd9\xf5\x62\x0a\xab\x52\xc2\x9e\x0d\x5a\x57\xcb\xce\x9f\x1f\x66\x62\x0e\x5c\x67\xf9\x83\xe4\xb7\xff\x8f\x8f\xea\xac\x85\x5b\xab\xc9\xb7\x53\x8c\x0f\x85\xe3\x11\xb5\xc9\x29\xf5\xe8\x2f\xc0\xe0\xc5\x9a\xab\xcc\xd1\x6b\x33\x8e\x97\xa7\x62\x65\xe7\xb8\x2d\x4d\x3b\xdd\xc8\xc8\xcc\x50\x5b\x05\xd2\xc6\x4f\xa3\xee\x31\x25\x8e\x12\xa9\x74\x3e\xfb\x56\x0d\x26\x1c\x92\x44\xca\x4b\xf2\x13\xdb\xaa\x8e\x4f\x90\x2f\x80\xae\x09\x85\x4f\x3d\x91\xe8\x8f\x40\x23\xb6\xbc\xf9\x60\xef\xf5\x03\xbd\x02\xb3\x9a\x60\xea\x55\x52\x67\xce\xd7\x26\x5c\x73\xca\xd7\x21\x5a\xbd\x86\xda\x20\x8e\x7f\x21\x0e\x73\xce\x56\x24\x80\x3a\x4b\x69\x0f\xa1\x7d\x44\x93\x77\x10\xcc\x87\x67\x96\xd1\x72\x76\x73\xe2\xd2\x48\xee\xea\xa9\xf3\x31\x13\xce\xf2\x5c\xc7\x7d\xc3\x39\x6e\x3d\x9b\xae\x66\xcf\x0b\x23\x2a\x4c\xb4\x00\x82\x70\x48\xe8\x4f\x01\xdc\x54\x7d\x68\x8b\x86\xf6\x68\xa2\x1d\xfc\x7d\x14\xdc\xca\xff\x23\xc6\xe4\xea\xce\xbb\x58\x03\x95\xe9\xac\x5a\x79\x55\x6b\xa4\x8d\x0d\xed\x80\xd0\xf8\x59\x2b\xbd\x4c\x35\x11\x26\x42\x5d\x3c\x47\x42\xfc\x62\x1c\x5f\xc4\x72\x03\x54\x92\xd2\xb4\x93\x01\x52\x73\xb2\x28\x36\xe6\xf2\x29\xad\x8c\xbe\xc3\xae\xa9\x54\x66\xd5\xca\xe8\x9e\x60\xa7\x1e\x91\x40\x18\x21\x8e\x42\x90\xc0\x55\x8c\x11\x9b\x7b\xef\x62\x9e\x9f\xda\xac\xf9\xca\x9b\x91\xdc\xd4\x65\x27\xc4\xe6\x3b\xec\xe6\x48\x6e\x9a\x73\xcc\xe4\xaf\x3e\x68\xad\xaa\x8e\x69\xc7\xa1\x56\x03\x91\x95\xf5\x0d\x89\x1b\x05\xb5\x07\x3e\x07\xc3\x78\xbc\x89\x5d\xba\xb1\xe1\x37\xd5\x21\x99\x82\x66\x67\x35\x98\xae\x47\xc7\xaa\x76\x67\xd1\xd8\xac\xe2\xa9\xd2\x20\x89\x92\xcc\xae\xae\x2a\x24\x44\x6b\xb8\x87\x15\x70\xa0\x7e\x9d\x54\x19\xce\x6a\x05\xbc\xce\x2f\x13\x53\x45\x76\xa7\xd6\x4c\xa5\xb8\x12\x99\xd8\xb4\xd2\xcd\xf3\x75\x03\xad\x78\x8a\x5b\xa8\xbc\xef\x3f\x0d\xfb\xb7\xe6\x72\x2d\xa3\xc9\x4a\xb6\xd6\xa6\x85\x65\xb9\x36\x4b\x52\x5e\xc3\x9c\x03\xf9\x1b\x42\xd7\xea\xe4\x7b\x40\xf8\x8e\x06\x3b\x5d\x22\x6e\x1a\x8f\xe1\x2e\xca\x0d\xe6\x2b\x67\x61\x72\xaf\xfd\x72\xc1\x95\x9e\xf0\x86\xbe\xc3\x75\x3e\x31\x81\x89\x78\x6a\xa8\x92\x6b\x6f\x37\xd8\xe4\x11\x62\x4e\xaa\xcc\xf0\x5c\x2d\x06\xff\xd4\x86\x7f\x67\x6d\xe8\x76\xcf\x0b\x87\xa3\x88\x93\x10\xf1\x5d\xde\x43\x16\xa3\x65\xc0\x96\xae\x93\x2a\x5e\xdf\x9c\xbc\x2f\x58\x56\xae\xd1\xa3\xed\x06\x37\xb4\xfa\xa0\x37\xfb\x29\x58\xa3\x3b\x4f\x99\xb6\x4a\x97\xae\x2f\xad\x7f\x35\x8c\x0f\x17\x8b\xca\x18\xf6\xda\xf6\xb2\x1e\x49\x36\x6b\xde\xe3\xbc\xee\x48\xfa\x8c\x28\xb6\x84\xcb\x18\x05\xb3\xc4\xab\x14\x7d\xee\xf3\xff\x05\x00\x00\xff\xff\x52\xc6\xf1\x09\x35\x2a\x00\x00")
+var _dcosagentresourcesvmasT = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x5a\x4b\x6f\xe3\x38\x12\x3e\xc7\xbf\x82\xe0\x45\x36\xa0\x76\x16\xbb\xb7\xb9\xa5\x93\x99\x8c\xd1\x79\x18\xed\xe9\x5c\x8c\x1c\x68\xb1\x6c\x13\x91\x49\x81\xa4\xdc\xed\x35\xfc\xdf\x17\xd4\x93\x94\x28\xd9\xee\x38\xd3\xb3\xe9\x00\xb1\xcc\xaa\x62\xf1\xab\x37\xd5\x08\x21\xb4\x1f\xa0\xec\x07\x93\x84\xbd\x80\x54\x4c\x70\xfc\x1b\xc2\xf3\x2d\x91\x8c\x2c\x62\x50\xc3\xa0\x5e\xb9\x83\x25\x49\x63\x1d\x8c\x5e\x71\x58\xf2\xc5\x22\x22\xda\xc3\x55\x7e\xef\x10\x73\xb2\x81\x26\xe1\x7e\x3f\x7e\x22\x1b\x38\x1c\x9e\x66\xf7\xe6\x83\xc3\x90\x48\x91\x80\xd4\x0c\x14\xfe\xad\xd2\x15\x21\xac\x20\x4a\x25\xd3\xbb\xaf\x69\x9c\x2d\xcd\xab\x25\xf3\xbb\xdf\xdf\x83\x9e\xd9\x24\x68\x3c\x15\x52\xab\xc3\xa1\xa2\x7b\x2d\x3e\x1d\xaa\xbd\xf4\x2e\xc9\x94\x7b\x64\x91\x14\x4a\x2c\xf5\xf8\x09\xf4\x77\x21\xdf\xae\x79\xfe\xb7\x94\x78\x2f\x45\x9a\x28\x3c\xb0\xd8\xdf\x0d\x63\x24\x92\x9d\x7b\xc4\x48\xa4\x5c\x1b\x7d\xe6\x2a\x5d\x0c\x7d\x80\xdd\x1a\x8a\x60\x14\x22\xdf\xe2\xf3\x72\xa9\x40\x07\x23\x6b\x13\xcb\x00\xb1\x10\x09\x6e\x21\x40\x21\x01\x4e\xd5\xb3\xd1\x7d\x3e\xd8\xef\xd9\x12\x8d\x27\xea\x36\x55\x5a\x6c\x5e\x9e\x7e\xff\xab\x82\x0f\xcf\x23\xc1\x23\xa2\x87\xc1\x89\x60\x5d\x07\x21\xea\xb5\xf9\xe8\x15\x0f\xf6\x7b\x88\x15\x58\x9b\x58\x1c\x5b\x0e\x7a\x72\x17\x14\x64\x9c\x1e\x0e\xb9\x7e\x13\x35\x4d\x17\x31\x8b\x6a\x03\x5f\x21\x14\xe2\xb9\x6f\xb3\x87\x45\x43\x42\xe1\x09\xef\xf4\xe5\x02\x0a\xdf\x8e\x2f\x8f\xe6\xef\x54\xc2\x92\xfd\x30\x86\x0a\x38\x8b\x3e\x05\x21\x32\xd6\x9e\x70\x0a\x3f\x86\xbd\xa6\xeb\x89\x04\xaf\x71\xae\x0c\x29\xf6\x19\x20\xe3\xb9\xba\x42\x08\x33\x9a\x29\x2d\x41\x89\x54\x46\x30\xa1\x97\xb5\xe1\x55\xe1\x51\x2e\xc4\x66\xdf\xe4\x56\xf0\x25\x5b\xa5\x32\x83\xb2\x19\xb4\xb5\xe3\x3b\xe0\x96\x5c\x4f\x82\x02\x0e\x5d\x1a\x1f\x22\x6d\x77\x40\xc8\x61\x8a\x05\xa1\x9f\x49\x4c\x78\x04\xf2\x33\x89\xde\x80\xd3\x1b\x4a\x25\x28\x35\x15\x22\xce\xb5\xba\xba\xaa\xb5\x2a\x3f\x5b\xd0\x95\xae\x7f\xad\xd2\x85\x8a\x24\x4b\xb2\xf3\x18\x0f\xb7\xbf\x18\x8e\xc6\xf6\xe3\x84\x86\xc1\x75\x09\x7a\x8d\xa7\xf3\xcd\x70\x34\x36\x4e\x15\xa2\xe0\x3a\x91\x62\xcb\x28\x48\x75\xdd\x36\x8e\x7d\x84\x4e\xa3\x3c\x2c\x72\x9b\x18\x61\x8b\xf6\x39\xaf\x83\xd0\xcf\x55\x60\x62\xc0\xb0\x8c\x5a\x01\x72\xa8\x3e\xbf\xb6\x6d\x5c\xd9\x85\x6d\x89\x86\xc9\xf4\x26\x2e\x03\xe7\x11\xf4\x5a\x64\x8e\x77\xb7\xe3\x64\xc3\xa2\x86\x2d\x4d\x46\x4f\x17\x1c\xb4\x93\x02\xcb\x9f\x12\x78\x9f\xc6\x2f\x1c\xf4\x2c\x5d\xd4\xd9\xa1\x64\x2a\xd4\xed\x7a\x7a\x57\x21\x98\x70\x0d\x72\x49\x22\xa8\x8b\x40\x19\x8f\x8f\x84\x93\x15\xd0\x3b\xa6\xde\x4a\xef\x3b\xab\x36\xcc\xb4\x90\x64\x05\xb6\x18\x27\xeb\x94\x88\x36\x25\xf4\xa7\x28\x1f\x72\x37\x5b\xc2\x62\xb2\x60\x31\xd3\xbb\x19\xe8\xa0\x27\xd9\x94\x50\xe1\x24\x26\x7a\x29\xe4\xe6\x0f\x53\xbf\xee\xc4\x86\x30\x7e\x5b\x96\xa9\x7f\xe3\xb0\x4d\xf8\x2d\xa1\x44\x43\x83\xf2\x3f\x36\xe5\x26\x3f\xa9\x39\x8f\x96\x29\xe0\x13\xac\x71\x2b\x36\x49\xaa\xe1\x9a\xb8\x27\xb0\x8d\x61\x0a\x09\xca\x2d\x52\x20\x7a\x13\x65\x05\xf5\x1d\x36\x39\xb9\x5e\xfb\xd0\x76\xb5\x50\x45\xe9\xae\x05\x9e\x59\x9b\x11\x3a\x5e\x88\x93\xac\x30\x4e\xa6\x45\xdc\x43\x33\x57\x6c\x88\xd2\x20\xa7\x2e\x55\x1d\xf4\x55\x98\xbf\xcb\xf3\x0a\xf5\x2c\x7a\xe5\x20\x91\xd7\x46\x50\xc1\x68\xbe\x11\x74\x48\x28\x1d\xd6\xc5\x71\x14\x1e\x87\xb2\x2a\x96\xe1\xd1\x3d\x0a\xd0\x47\xaf\xc7\x49\x83\xd1\x9c\xb2\xed\x2f\x50\xa7\x12\x5b\x10\x57\xf6\x38\x1a\x9b\x24\x67\xf8\xab\x08\x97\xbc\x07\x76\x36\x36\x4b\x68\x7c\x38\x9c\x12\x63\x05\xe7\xb5\xab\x7a\x1d\x62\x86\x2f\xcf\x79\x7f\x12\x65\x67\x3b\x3b\xba\xde\x13\x61\xfe\x28\xbb\x48\xa4\x39\x1e\x4a\x89\x26\x94\xa9\xb7\x07\x2b\xea\x1c\x70\x7a\xa2\xef\x6f\x89\x40\x27\x0a\xcf\x8e\xc4\x0b\x46\xa3\xc5\x65\x40\x73\x71\xce\x39\x67\x00\xb4\xe1\xfb\x1f\x14\x27\x67\x84\xed\x3f\x4a\xef\x4a\xec\x1d\xd1\xa4\x2b\xc6\xfb\xe2\xfc\x27\x63\xbd\xed\xd2\x67\xc6\xbc\x25\xc0\xee\xfb\xf6\x83\x33\xc2\xfc\x63\xef\x0f\x4e\x6f\x68\xfa\x12\xdf\x29\xcd\x45\xdf\xf8\xf9\x0f\x03\xa5\x91\x56\x3a\x21\x29\xbe\x35\xa9\x8e\xab\x19\x68\xcd\xf8\xaa\xe9\x78\x98\x66\x7d\x9c\x41\xfb\x81\x2c\x20\xee\xdc\xf4\x77\x4e\x13\xc1\xb8\xbe\x7b\x9a\xd9\xf3\xef\x6b\xcb\x93\xcc\x3f\x5c\x65\xc9\x9e\x71\x61\xd0\x60\xf3\x18\xae\x33\xe9\xba\x35\xeb\xbd\xa6\xb9\x7c\x1f\xd6\x65\xab\xcb\x35\x61\x7d\x03\xe2\x09\x1e\xe1\x99\x1f\x1b\x65\xb0\x26\x3e\x65\xe3\xd6\x8c\xf9\x8a\xfd\x93\x59\xa9\x19\x42\x78\x29\x05\xd7\xc0\xe9\x64\xfa\x33\xd7\x08\x1d\x8a\x94\xc2\x9a\x48\xf4\xe3\x51\xae\xba\x66\xed\x9d\x5c\xfb\x6f\x5b\x5a\x0e\xe2\x1f\xce\x3b\xdd\xa3\x8d\x5c\xf3\xc9\x8f\x29\xe3\x0b\x91\x72\xfa\x44\x74\x75\x8d\x6a\x2f\xd7\xf7\x0c\x8c\xaf\xba\x2e\x5a\x87\xf7\xa0\x1f\x3e\x17\x77\xac\x46\xcf\x22\x13\x8e\x0e\xfe\x3d\x13\x29\x16\x9d\x82\xa6\xd9\xa2\x4f\xc2\x19\xf1\xef\x5c\x8f\xb4\xb2\xb6\x79\xdc\xf7\x4d\xeb\x27\xa6\x86\xce\x39\xbd\x79\x89\x79\x76\x9e\xb1\x35\xfd\xfb\x2f\x86\xb7\x1b\xd3\x08\x67\xd7\x6d\x2d\xcc\xdd\xd4\x57\x62\x38\x73\xba\x85\xc3\xa1\x37\x27\x76\xb4\x18\xee\x75\x94\xbf\x9d\xb2\x7a\x52\x4f\xbf\x93\x0d\x8c\x66\xb8\xf1\x86\xce\x65\x7b\xb8\xa3\xbd\xe7\x2f\xd6\xaf\x12\x5b\x10\x57\xf9\x22\x1c\xf4\x0c\x6d\x1f\x67\xae\x8f\x82\xe4\x8c\x6e\xfe\x0c\xf4\x8e\x5a\xf7\xff\xeb\x38\x47\x07\x8e\x32\xdf\xb8\x79\xc7\xef\x0f\x9d\xf7\xa0\x5d\x2d\xcd\xe5\x5e\x80\xf8\x15\xea\xea\xd6\xbb\xf4\x69\xcd\x08\xbe\x26\x4b\x13\xd3\xfa\x16\x4f\x76\xe2\x95\x90\x35\x1d\xb3\xec\xcd\x09\x46\xd6\x44\x1d\x90\x48\x01\x5f\x31\x0e\x9f\x4e\x44\xe2\x74\x04\x5a\x79\xf8\xe7\x9a\xc0\x42\xd3\xcb\xea\x16\x0e\xfa\x7b\x25\xdc\x30\x8c\xb3\x78\xac\x3f\xea\x32\x6e\x10\xfa\xd4\xea\x31\xad\x05\x1e\x42\x78\x4d\x24\xfd\x4e\x24\x4c\xa5\x58\xb2\x18\x9a\x2a\x6d\x37\x33\xf6\xdf\xee\xae\xf1\xe5\xd1\x2c\x77\x0e\x33\x45\x64\x74\xc8\x6e\xc5\x4d\xbb\x11\x1a\xfc\x4c\x07\xd9\x92\x1b\x84\x1f\xf8\x56\xd2\x3e\x7b\xb3\xd3\x7c\xf5\xa2\x22\x54\x07\x20\x84\x6e\x18\xff\xa6\x40\x56\x6e\x6a\x6d\xed\x2c\x36\xfb\x73\x1c\xe5\xde\x21\x4f\x71\xf0\xc2\x93\xe4\x7b\xdd\xbc\xfa\xcf\x05\x77\xb7\xcf\xb3\x9b\x15\x70\x9d\xbf\x7f\x35\x97\x39\x26\xf5\xdb\xfa\xc5\x8c\xa7\x3f\x9c\x61\xa5\x71\x78\xf3\x8b\x29\x53\x66\xe3\x29\x51\xea\xbb\x90\xf4\x26\xd5\x6b\xe0\x9a\xd5\x01\x9e\xbd\x14\xb1\xf7\x37\xff\xb0\x52\x6b\x8f\xb4\x6a\x2a\xf9\x02\xbb\x76\x8f\xed\x77\xb0\x82\xef\x0d\x76\xe6\x10\x66\xc7\x79\x42\x24\xd9\x80\x06\x69\x2a\x8d\x5a\x7f\x9d\xdd\x4c\x4b\xa9\x4d\x2b\xd4\x3f\x38\x21\x7a\xdd\xb4\xa0\x52\xeb\x2f\xb0\x9b\x12\xbd\x6e\x0c\x79\x3e\xd7\x69\x3a\x90\x8f\xc2\x7d\xca\x7a\x99\x3f\x89\x7a\x30\x50\xcf\x20\x92\xe0\x79\xe5\xdb\xc6\x2e\x27\x6c\xea\x9a\xd9\xab\x70\xd3\x42\x56\x4b\xe9\x66\x8d\xb4\x7d\xbc\xa8\xc9\x7e\x47\xcf\x9d\x86\x68\x92\x4d\x0a\x4d\x57\x61\x1b\xb2\x82\xaf\xb0\x04\x09\x3c\x6a\xb2\x22\x84\xc5\x72\x09\xb2\xa9\xaf\x50\x13\xc3\xf6\x6c\xd6\xda\x66\xc9\x1d\x41\xad\x3b\xf9\xa6\xe5\xba\x87\x57\xbd\xa5\x1d\x5c\xb3\x2f\xdf\x3c\xf4\x5b\xff\x80\x53\xf0\x14\x43\x4e\x03\x4c\x1b\x80\x10\x8b\xac\x1d\x6d\x9f\x3c\x22\xd1\x9a\xf1\x95\x91\xfc\x15\x08\x7d\xe6\xf1\xce\xb5\x48\x98\x57\x65\x78\x4e\xca\x80\xf9\x43\x8a\x4d\xb6\x2f\x3e\x3e\xa2\x98\xdf\xf0\x23\x4b\x64\x18\x7c\x12\xca\xbc\x5e\x68\xb9\x52\x88\xb7\x6b\xda\x3a\x30\x42\x38\x95\xcc\x56\x46\x96\x6e\x31\x2c\xbe\xb0\xd2\xff\x65\xda\xf3\x8f\xe8\x65\xcf\x68\x50\x8f\xf6\xdb\xbf\x58\xbf\x4a\xac\xdb\x3c\x87\xde\x49\xbe\xd8\x3a\x18\x8d\xc6\x89\x64\x1b\x22\x77\xe5\x5d\xa8\x1a\x2f\x62\xb1\x08\x83\xdc\x1d\x4e\xed\x97\x4f\x75\x33\x54\xfa\xd9\x78\xbb\xa6\x2d\x5f\xab\x9b\xfb\x2c\x22\x38\xa0\xf1\xf3\xcc\x04\x9c\x69\x65\xee\x3f\xa3\x7f\xb5\x42\x82\x56\x8b\xc6\x45\xf7\x0e\x79\x3d\x2b\x64\xc4\x16\xe7\x61\x50\x3d\x1c\x06\x8d\x0c\xe9\xb9\xb1\x29\xfb\xbb\x2d\x93\x3a\x25\xf1\x63\x16\xeb\xa0\xf0\x00\x21\x84\x0e\x83\xff\x0d\x00\x29\xf4\x3d\xd2\x9f\x28\x00\x00")
 
 func dcosagentresourcesvmasTBytes() ([]byte, error) {
 	return bindataRead(
@@ -287,7 +288,7 @@ func dcosagentresourcesvmasT() (*asset, error) {
 	return a, nil
 }
 
-var _dcosagentresourcesvmssT = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5a\xcd\x73\xda\x38\x14\xbf\xe7\xaf\xf0\xf8\x62\x98\xa1\xd0\xfd\x38\xf5\x96\x84\x6e\x96\x69\x08\x4c\xdc\xe6\x92\xe1\x20\xac\x67\xd0\xc4\x96\x3c\x92\x4c\x4b\x19\xfe\xf7\x1d\xf9\x03\x2c\x4b\x02\xb2\xc9\x26\xe9\x64\x7d\x68\x63\x4b\x7a\x7a\xfe\xbd\x0f\xfd\xde\x33\x9e\xe7\x79\x9b\x33\xaf\xb8\x7c\x94\x91\x3b\xe0\x82\x30\xea\x7f\xf2\xfc\xfb\x15\xe2\x04\xcd\x13\x10\x9d\x60\x3f\x32\x84\x18\xe5\x89\x0c\xba\x33\xbf\x57\xaf\x4b\x58\x84\xa4\x65\x55\xfd\x5c\x9b\x4c\x51\x0a\xed\x89\x9b\x4d\xff\x06\xa5\xb0\xdd\xde\x84\x57\xea\x0f\x6d\x41\xc6\x59\x06\x5c\x12\x10\xfe\xa7\x9d\xae\x9e\xe7\x0b\x88\x72\x4e\xe4\xfa\x36\x4f\x8a\xa1\xfb\xdd\x50\xf1\x52\x9b\x2b\x90\x61\x73\x8a\xd7\x9f\x32\x2e\xc5\x76\xbb\x9b\x37\xab\xfe\xda\xee\xf6\x92\xeb\xac\x50\x6e\x4c\x22\xce\x04\x8b\x65\xff\x06\xe4\x77\xc6\x1f\x06\xb4\xfc\xbf\x96\x78\xc5\x59\x9e\x09\xff\xac\x5a\xbe\xd9\x90\xd8\xeb\x8f\x44\x28\x19\x47\x0b\x38\x8f\x22\x96\x53\x59\x6d\xf5\x28\x7c\x2b\x09\x1a\x02\x11\xcb\xd6\xfa\xbb\x17\xe2\x9d\x28\xea\x5a\x88\x4b\xf5\x6f\x53\x60\xc3\x0a\x09\x63\x99\x6f\xc0\x80\x21\x03\x8a\xc5\x84\x6a\xb0\xfa\xf7\x11\xa3\x11\x92\x9d\xc0\x84\x27\xcb\xe7\x09\x89\x46\xd3\x73\x8c\x39\x08\x01\x62\x10\xf4\xbc\x86\x6e\x29\x12\x12\xf8\x54\x9f\x55\x9a\xba\x3b\xab\x15\x98\x3d\xd1\xa3\x2a\xf5\x1a\xf3\x85\x86\xc4\x94\x43\x4c\x7e\x80\x08\xba\xf7\x29\xc3\x1d\x84\x71\x47\x41\x3b\xa2\x18\x7e\x74\xba\xbd\xe3\x50\x4e\xe2\x58\x80\x0c\xba\xda\x5c\xfb\x1e\x15\xe8\xdd\xd9\xf1\xa9\x41\xf7\x1e\x93\xd5\x2b\xa8\xb3\x13\x5b\x4d\xde\xd9\xe3\x68\xec\xa1\x72\xc1\xd7\x2a\x5c\x9a\x26\x5a\xa5\x21\xf9\x09\x62\x8c\xb2\xa0\x6b\xf5\xce\xbb\xb1\x9a\x10\x74\x67\x7d\x5d\x55\x25\x6c\x66\xfa\xa2\x19\x92\x15\x08\x03\x7d\x79\x33\x18\x81\xe2\xed\xb6\x0c\xca\x91\x28\x9d\x4e\x8f\xfe\x37\x94\xf2\x5a\xd1\x70\x02\xf8\x98\x8a\x10\xa4\x24\x74\xa1\x0f\xa8\x21\x96\x22\x42\x95\xa4\x6b\x34\x87\xc4\xb9\xe9\x67\x8a\x33\x46\xa8\x1c\xde\x84\xea\x41\xe9\x25\xc1\x3e\x12\x1b\x06\x50\x8a\xd4\x61\x9b\xd4\xaf\x37\x06\xb9\x64\x58\x89\x1f\xae\x29\x4a\x49\x74\x8a\xdd\x9c\xb9\x62\x67\xb9\x67\x31\xcd\xf3\x27\x2f\x97\xad\x9e\x2f\x73\xd9\x36\xbb\x9e\x9f\xec\x11\x73\x14\x3d\x00\xc5\x95\x72\x53\xc6\x92\xf6\x81\xb8\xd1\x0e\xc7\x63\x1b\x5f\x94\xf2\x94\xa0\x5a\x87\xc6\xfa\xc6\x01\xda\x70\x92\x98\x33\x2a\x81\xe2\xd1\xf4\x92\xd1\x98\x2c\x72\x5e\xbc\xef\xd3\x14\xa9\x85\xb5\x91\x38\x8c\x47\xdb\x6d\x4b\x58\x2c\x53\x3c\xcf\x27\x85\x17\xdf\x73\x10\x2c\xe7\x11\x8c\xf0\x49\x0e\x12\x58\xd3\xa8\xd3\x3d\x4c\xe4\xda\x77\x76\x4c\x09\x9d\xb3\x9c\xe2\x1b\x24\x77\x24\xa7\x39\x9c\x30\x84\x2f\x50\x82\x68\x44\xe8\xc2\x45\x83\x3a\x57\x20\xaf\x2f\x2a\x06\xa4\x14\xab\x32\x61\x77\x6b\xdf\x33\xe3\x6c\xee\x14\x34\x2d\x06\x6d\x12\x1e\x11\xff\x7b\xb5\x81\x9b\x59\xbb\x74\x92\x9a\x50\x8d\x11\x45\x0b\xc0\x43\x22\x1e\xf6\xcc\xed\x31\x44\xaa\x29\xa0\xf4\xa0\xcd\x06\x12\x01\x8f\x13\xa6\xe5\x99\xa6\xa6\x46\xbe\xa9\x15\xbf\xcc\x85\x64\xe9\xdd\xcd\xe7\xaf\xfb\x99\x07\x52\x90\x95\x5e\xba\xd2\xd0\x8e\x25\x2b\x0f\x6b\xbf\x8e\x76\x16\x53\x90\xa3\x61\x50\x4d\xdb\x1f\x8a\x2e\xa6\xaa\xae\x9e\x4d\x4d\xc7\x91\x3b\x08\x4e\x62\x37\x35\xd9\xfa\xf8\x16\x28\xd6\x4b\x28\x71\x94\x58\xd9\x9d\xe1\x79\x50\xfe\xed\x2d\xa0\xfc\x12\x4a\xbc\x2a\xca\xbf\xbf\x05\x94\x5f\x42\x89\x57\x45\xf9\x8f\xb7\x80\xf2\x4b\x28\xf1\xaa\x28\xff\xf9\x16\x50\x7e\x09\x25\x9c\x28\x9f\x52\x33\xba\xce\x46\x2b\xad\x71\x1d\xdd\x35\xa9\x6f\xee\xd9\xe2\x60\xbe\x44\xaa\xb0\xab\xee\x9a\x4d\x1f\x0e\x05\xa5\x0e\x0b\xa6\xea\x7b\x8d\x96\x47\x80\x22\x01\x74\x41\x28\x7c\x70\x6c\x7c\x37\x6e\x16\x7a\x3d\x2f\xf8\xb0\x4a\x85\x68\x30\xfb\xed\xb3\x37\x5f\x4e\xd8\xfb\x68\x65\x93\x67\x0b\x8e\x30\x4c\x59\x42\xa2\x75\xbb\xda\x4d\x19\x2e\x29\x26\xa2\x39\x4a\xec\xb5\xeb\x8a\x70\x99\xa3\x64\x8c\xa2\x25\xa1\x30\xe5\x2c\x26\x09\xb4\x05\x55\xec\xcb\x3e\xba\x1f\x1f\x51\x09\x3c\x46\x11\x1c\xac\x70\x3c\xa3\xca\xd1\x80\xa2\x24\x6a\x95\x31\x36\x00\x0e\xd0\x48\x9b\x66\x1a\x6f\xb4\x16\x3a\xde\xc9\xc5\xce\xbf\xa7\xa2\xe6\x8e\xdb\x36\x5b\xd6\xd5\xc9\x8e\x02\xe9\x82\xd3\x04\x95\x64\x51\x21\xcc\x02\xee\x01\x88\x9d\xa1\x6e\x2e\x6f\xc6\xf7\xc5\xd1\x82\xfb\xd4\x57\xa8\xa1\xc0\xcd\x20\x0a\x06\x22\x9f\x8b\x88\x93\xac\xc0\x45\x81\xdf\x7c\xd0\xe9\xf6\x9b\xb7\x23\xdc\x0b\x06\xb5\x4d\xf7\xe6\xd2\x9e\x74\xba\x7d\x85\x55\xcf\x0b\x06\x19\x67\x2b\x82\x55\x8e\x7a\x62\x0e\x53\xc2\x2c\x9d\x87\x81\xbd\x38\xb6\x74\x15\xec\x3e\x53\x5f\x6e\x53\xcc\x0e\x79\x55\x05\xa8\xc8\xe7\x14\xa4\x33\x14\x74\xd8\xad\x49\x8b\x82\x0c\x0b\x21\x55\x05\xf5\x58\x3d\xed\xcf\x6d\x4f\x67\x36\x97\xf5\x33\x4e\x52\xc4\x55\xd2\xf3\x25\xcf\xc1\x54\xa0\x2d\x4a\xbf\x9f\x35\xbb\x0b\xcd\x0d\x7c\x26\x9c\x89\x0e\xe1\x94\xd0\x6f\x02\xb8\xad\x2f\xa3\x0d\x5a\xba\x31\x11\x4b\xb3\x5c\x02\xdf\x27\x7b\x37\xb8\x63\xbd\xed\xd8\x33\xbf\xdd\x0c\x2f\x27\xe1\xf9\x02\xa8\x2c\x53\xe0\x10\x49\xe4\xf5\x5b\x16\xf7\x13\x42\xf3\x1f\x5a\x12\xb1\x35\x82\x30\x11\x4a\x81\x29\x12\xe2\x3b\xe3\xf8\x3c\x97\x4b\xa0\x92\xec\x4f\xb9\x02\xdf\xb6\x11\x7c\x21\x96\xf6\x9e\x51\xd9\x0e\xfa\x02\x6b\x57\xd0\x3b\xd2\xef\x03\xac\xd5\x6b\x14\xa8\x64\x88\xa3\x14\x24\x70\xc5\x60\xc4\xf2\x36\x3c\x9f\xd6\x52\x4d\x40\xf6\x3b\x23\xb9\x6c\x83\x2a\xc4\xf2\x0b\xac\xa7\x48\x2e\x1d\x6e\x6a\xba\xdc\xec\xe4\xbe\x94\x32\x06\x89\xbd\xbf\x91\xb8\x56\x50\x87\x10\x71\xb0\xa4\x49\x13\xbb\x72\xa2\x41\x21\x94\x90\xca\xfb\x2a\x59\x86\xd2\x66\x68\xeb\xee\x5b\xf1\x3d\xa7\x0f\x93\x14\x2d\xe0\x16\x62\xe0\x40\x23\x73\x5c\x05\x40\x1c\x03\x6f\xab\xc6\xc4\x48\x2d\x9c\xa8\x31\x9b\x05\x4a\xab\x8b\xa5\x73\xe5\xb4\x1e\xb7\xae\x16\x0f\xb9\x63\x5d\xf8\xe5\x9b\x75\xc5\xca\xde\x94\xaa\x56\x55\x8d\x29\x03\xbd\xad\x2d\x94\x90\x44\x45\x03\xcc\x0c\x20\x26\xd4\x80\x0d\xa4\xa8\x60\x4c\x0b\xb5\xfd\x2d\x20\x3c\xa1\xc9\xda\xd4\xb1\xa0\xa4\x30\xc9\xea\x40\xfa\x8b\xb3\xb4\x50\xcf\x3f\xde\x68\x2a\xaf\xde\xee\x0c\x57\x9c\x90\x09\xac\xd4\x31\xe6\xac\x96\xf8\x92\x51\x89\x08\x05\x6e\x0f\xba\xdd\xd9\xc9\x6b\xcb\x77\xfe\x6f\x63\xfd\x37\xe5\x52\xef\xf0\x87\xeb\x6e\xbf\x3a\xb9\xea\x8f\x4c\xa2\x3f\x4f\xd8\xbc\x17\x94\xc6\xb5\x67\xb7\x17\x34\xdf\x7b\xef\x8f\xfd\xe2\xe6\x7b\xef\x8d\xb7\x5f\xdc\x7c\xef\xbd\xa3\xf7\x8b\x9b\xef\xbd\xb7\x0a\x9f\x6e\xbe\xb3\x96\xf9\x66\x7a\xef\x91\x82\xd7\x9f\x84\x8a\x94\x85\xe4\x27\x5c\x5d\x78\x1f\x5b\x94\xa9\xa7\xca\x99\x6a\x50\xf1\xb6\x8d\x36\xbd\x10\x63\x96\xc6\xf6\x4f\xcd\x5b\xa3\xf5\x57\xb2\xd4\x66\xcf\x11\x65\x28\x22\x72\xed\xac\xe4\x0e\xfd\xb8\xec\xf0\xcf\x7f\x9a\x2b\x24\x31\x69\x75\x6b\xc5\x57\x52\x32\xeb\x13\x3e\x34\x5f\x96\x85\xe8\x40\xef\xfd\x85\x11\x52\x45\xc7\xee\x87\x42\x67\xff\x04\x00\x00\xff\xff\x0c\x9d\x3b\xed\xfc\x28\x00\x00")
+var _dcosagentresourcesvmssT = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x59\x5b\x73\xda\x38\x14\x7e\xe7\x57\x68\xf4\x62\x98\xa1\xd0\x36\x7d\xea\x5b\x2e\xdd\x2c\xd3\x5c\x98\xd0\xe6\x25\xc3\x83\xb0\x0e\xa0\x89\x91\x3c\x92\x4c\xcb\x32\xfe\xef\x3b\x32\xf2\x45\xb6\x1c\x48\x93\xa6\xdb\x66\x61\x67\x6b\xa3\x73\x8e\x8e\xbe\x73\xd1\x27\x05\x21\x84\xb6\x1d\x94\x7d\x30\x89\xd9\x2d\x48\xc5\x04\xc7\x1f\x11\xbe\x5b\x13\xc9\xc8\x2c\x02\xd5\x0d\xca\x91\x33\x98\x93\x24\xd2\x41\x6f\x8a\xfb\xb9\x5e\x24\x42\xa2\x3d\x5a\xf9\xef\x8e\x30\x27\x2b\xa8\x0b\x6e\xb7\x83\x2b\xb2\x82\x34\xbd\x9a\x9c\x9b\x07\x47\x21\x96\x22\x06\xa9\x19\x28\xfc\xb1\xf0\x15\x21\xac\x20\x4c\x24\xd3\x9b\x9b\x24\xca\x86\xee\x8a\x21\xf3\xdf\x76\x7b\x0e\x7a\x52\x15\x41\x83\xb1\x90\x5a\xa5\x69\x21\x37\xb5\x4f\x69\x31\x97\xde\xc4\x99\x73\x97\x2c\x94\x42\x89\xb9\x1e\x5c\x81\xfe\x26\xe4\xfd\x90\xef\xfe\xcd\x2d\x9e\x4b\x91\xc4\x0a\x77\xac\xfa\x76\xcb\xe6\x68\x30\x52\x13\x2d\x24\x59\xc0\x71\x18\x8a\x84\x6b\x3b\xd5\xa3\xf0\xb5\x16\x1c\x04\x42\x11\x6f\xdc\xb5\x67\xe6\x5b\x51\x74\xbd\x50\xa7\xe6\xff\x55\x83\x95\x28\x44\x42\xc4\xb8\x01\x03\x85\x18\x38\x55\xd7\xdc\x81\x15\xdf\x85\x82\x87\x44\x77\x83\x26\x3c\x71\x32\x8b\x58\x38\x1a\x1f\x53\x2a\x41\x29\x50\xc3\xa0\x8f\x2a\xbe\xad\x88\xd2\x20\xc7\xae\xd4\x2e\xd4\xbd\x69\xee\xc0\xf4\x89\x19\x65\xdd\xab\xc8\x2b\x07\x89\xb1\x84\x39\xfb\x0e\x2a\xe8\xdd\xad\x04\xed\x12\x4a\xbb\x06\xda\x11\xa7\xf0\xbd\xdb\xeb\xef\x87\xf2\x7a\x3e\x57\xa0\x83\x5e\xaf\xbf\x77\x0e\x0b\x7a\x6f\xba\x5f\x34\xe8\xdd\x51\xb6\xfe\x05\xee\x14\x66\xad\x70\x11\x8f\xbd\xb5\x47\x76\x0a\x5f\x6c\xb9\xec\x8a\xcd\x99\xd8\x0c\xa1\x41\x9a\xe2\x03\x6a\xcc\x6a\x0e\x5d\xd7\xab\xd5\x05\x9c\xa6\xe9\xae\xca\x46\x6a\x97\x45\x6e\x39\x3f\xaa\xc6\x7e\x6e\x0f\xab\xa5\xf7\x01\x68\x52\xae\x26\xa0\x35\xe3\x0b\x77\xc0\x0c\x89\x15\x61\xdc\x18\xbe\x20\x33\x88\x5a\x27\xfd\xc4\x69\x2c\x18\xd7\x67\x57\x13\x23\xbc\x0b\x7b\x50\x96\x56\x25\x00\xc6\x91\xbc\x0e\xa3\x7c\x79\x97\xa0\x97\x82\x1a\xf3\x67\x1b\x4e\x56\x2c\xc4\x8f\xe8\x8d\x8d\xe2\x2f\x22\xf7\x2c\xa1\x79\xfe\x6e\xd4\x16\xab\xe7\x6b\x45\xbe\xc9\x2e\x66\x07\x67\xc4\x8c\x84\xf7\xc0\xa9\x75\x6e\x2c\x44\x54\xdf\xe1\x4a\xe1\x43\x26\x3e\xd9\xd9\x33\x86\x72\x1f\x2a\xfa\x95\x1d\x31\xf7\x0c\x21\x3c\x97\x82\x6b\xe0\x74\x34\x3e\x15\x7c\xce\x16\x89\xcc\x5a\xef\xd3\x1c\xc9\x8d\xd5\x91\x78\x18\x8f\x7c\xd4\x0d\xab\x47\x04\x21\xcc\xb2\x2c\xbe\x93\xa0\x44\x22\x43\x18\xd1\x83\x12\x24\xf0\xf6\xc5\xd6\xf4\x68\x22\x57\x7f\x2b\x9f\x8b\x54\x32\xce\xf1\x99\x48\x38\xbd\x22\xba\x60\x2d\xd5\xe1\x48\x10\x7a\x42\x22\xc2\x43\xc6\x17\x6d\xbc\xa6\x7b\x0e\xfa\xe2\xc4\x52\x1a\x83\xa3\xed\x84\xbd\xd4\x3f\x67\x2c\xc5\xac\xd5\xd0\x38\x1b\xf4\x59\x78\x44\xfd\x97\x6e\x83\x6c\x76\x6d\xa3\xbc\x2d\x18\xd2\x25\xe1\x64\x01\xf4\x8c\xa9\xfb\x92\x8a\x1d\xd4\x1a\xec\x2e\x51\x35\xb0\xcb\xa0\xed\x16\x22\x05\x69\xfa\xc3\x7d\xa6\xea\x69\xa3\xdf\xe4\x8e\x9f\x26\x4a\x8b\xd5\xed\xd5\xa7\x2f\xa5\xe4\x03\x2d\xc8\xcb\x17\xdb\xda\x50\x41\x7b\x4d\x03\xaa\x2f\xa7\xba\x80\x35\x07\x3d\x3a\x0b\xac\x58\xb9\x29\xb6\x51\x4f\xf3\xed\xfb\xdc\x6c\xd9\x72\x87\x4e\x1d\xf8\xa9\x44\x85\x3d\xbd\xf5\x56\xcd\xf3\x92\x94\xbd\x9c\xe9\x25\x9c\x28\xcc\x5a\xe1\x22\x5a\x95\x3a\xfb\x69\x28\xbf\x7b\x81\x05\xee\x45\xf9\xdd\x9f\x8e\xf2\xfb\x17\x58\xe0\x5e\x94\xdf\xff\xe9\x28\x1f\xbd\xc0\x02\xf7\xa2\x7c\xf4\xa7\xa3\xfc\xe1\x05\x16\xb8\x17\xe5\x0f\xbf\x12\xe5\x43\xce\x8c\x6d\x7b\xa3\x97\xd6\xb4\x6d\xdd\x17\xb3\xe6\x9c\x35\x0e\x86\x35\x31\x07\x3b\xfb\x56\x52\x56\x1c\x4a\xc8\x28\xf5\x24\x63\xaa\x18\x55\xee\x30\x02\x12\x2a\xe0\x0b\xc6\xe1\x4d\xcb\xc4\xb7\x97\xd5\x83\x5e\x1f\x05\x6f\xd6\x2b\xa5\x2a\xcc\x3e\x7d\xe2\x11\xc6\x7a\xf2\xb8\xb9\xfb\x9d\x87\x99\x3c\x4e\xe2\x85\x24\x14\xc6\x22\x62\xa1\x7b\xa9\x85\x10\x5e\x09\x9a\xcd\x7d\x49\x78\x42\xa2\x92\x6c\x17\x4b\x41\x08\xaf\x99\xd4\x09\x89\x2e\x49\xb8\x64\x1c\xc6\x52\xcc\x59\x04\x75\x43\x96\x7d\xf9\x47\xcb\xf1\x11\xd7\x20\xe7\x24\x84\x07\x4f\x38\xcd\x53\x8e\x03\x14\x67\x61\xb1\xec\xf6\xa3\xcc\x03\x34\xd2\xe7\x99\xc3\x1b\x1b\xfe\x3f\xee\xb0\xe3\x33\x79\x18\x15\xcd\xe7\x29\x3f\x35\x5e\xef\x7e\x31\x8b\xf7\x02\xd9\x06\x67\x13\x54\x16\x87\x99\x31\xdc\x6f\x13\xf6\x41\xdc\x5a\xea\x8d\x6f\xe5\xb4\x05\xd2\x1e\x90\xed\x71\xcf\x77\xe0\x3e\x74\x09\x6e\x64\xf2\x72\x1e\xaa\x64\xa6\x42\xc9\x62\x53\x6f\x19\xf8\xd5\x1f\xba\xbd\x41\xf5\x75\x44\xfb\xc1\x30\x8f\x69\x19\x2e\xe7\x97\x6e\x6f\x60\xee\x3d\xfb\x28\x18\xc6\x52\xac\x19\x35\x3d\xea\x89\x3d\xcc\x18\xf3\xdc\x3c\xb8\x9b\xcf\x43\xb7\x0a\xfe\x9c\xc9\x3f\xed\xa1\x98\x3e\x94\x55\x16\x50\x95\xcc\x38\xe8\xd6\x52\x70\x61\xf7\xf9\x7b\xcb\x41\x4f\x92\x59\x79\x82\x2a\xb4\x0e\xf4\x33\xed\x1c\xfa\x6b\xe5\xf8\x5d\x7e\x71\x2c\xd9\x8a\x48\xd3\xf4\xb0\x96\x09\xe0\xce\x3e\x53\xee\xfb\xb4\xe3\x94\x61\xfe\x88\x10\x16\xaa\xb5\xd1\x11\xba\x62\xfc\xab\x02\x99\x17\x56\x15\x1a\x67\xb0\xda\xbd\xad\x72\x28\x56\x71\xa2\x41\x96\xcd\xbe\x15\xdc\xd3\x86\x68\xd3\x5e\x76\x4b\x7c\x76\x7a\x3d\x39\x5e\x00\xd7\xbb\x46\x78\x46\x34\x31\xd7\xc4\xee\xc4\x11\xe3\xc9\x77\xa7\x95\x78\xe2\x8e\x29\x53\x26\xc6\x63\xa2\xd4\x37\x21\xe9\x71\xa2\x97\xc0\x35\x2b\xf7\xba\x0c\x65\xd7\x07\x93\x48\x6a\xe9\xb1\x56\x5c\x2f\x7d\x86\x4d\x5b\xe9\x37\x75\xcc\x17\xdf\xc3\xc6\x2c\xc3\xcc\x78\x17\x13\x49\x56\xa0\x41\x1a\x1e\xa3\x96\x37\x93\xe3\x71\x6e\xb5\x09\x48\xfe\xc1\x31\xd1\xcb\x3a\xb4\x4a\x2d\x3f\xc3\x66\x4c\xf4\xb2\x25\x59\x5d\xcc\xea\x19\xd2\x94\x70\xdf\xb2\xfd\xe8\x6f\xa2\x2e\x0c\xd4\x13\x08\x25\x78\x9a\x65\x13\xbb\x9d\x60\xdd\xd7\x2c\x5e\x36\x07\xad\xad\x86\xd3\xcd\x02\x77\x93\xd8\xb2\xbe\xd6\x4c\x66\x2b\xb2\x80\x1b\x98\x83\x04\x1e\x36\xc7\x4d\x19\xcc\xe7\x20\xeb\xae\x09\x35\x32\x8a\xd7\x66\xcc\x17\x81\x5d\xd4\xd5\xb2\x55\x73\x9c\x8f\x7b\xb5\xd5\x7d\xd2\xa2\x37\xf9\xfc\xd5\xab\xb1\xf6\x5f\x4d\x59\x2d\x7b\x3d\xd5\x40\x2f\xf5\x95\x12\xd1\x24\xbb\x47\x6b\x16\x90\x50\x66\xc0\x07\x52\x98\xf1\xa6\x85\x99\xfe\x06\x08\xbd\xe6\xd1\xa6\xe9\x63\x46\x4c\xe1\x3a\xce\x0b\xe9\x2f\x29\x56\x99\x7b\x78\xff\x75\x53\x4e\xac\xf3\x86\x63\x98\xa1\x50\xd4\xb8\xd3\x90\x59\x2f\xe9\xa9\xe0\x9a\x30\x0e\xd2\x5f\x74\xc5\x0e\x2a\xf3\xc8\x77\xf3\x2d\xb5\xdc\xec\x9e\xe7\xd0\xf4\xda\x2f\xb3\xfa\xde\x8b\x52\x3b\x75\xd0\xeb\x0d\xec\xfe\x95\xff\xa9\x49\x0d\x66\x91\x98\xf5\x83\x5d\x70\x7d\xb9\xfe\xa2\xe1\x7b\xed\xb7\x64\xbf\x79\xf8\x5e\xfb\xf5\xdb\x6f\x1e\xbe\xa3\xff\x42\xf8\x8e\xfe\x0f\xdf\x0f\x86\xef\xb5\x5f\x18\x3e\x3d\x7c\x9d\x5a\xf8\xa6\xc5\x89\x36\x63\x4c\x1c\xd0\xe0\x7a\x62\x48\xd9\x84\xfd\x03\xe7\x27\xe8\x6d\x8d\x32\xf5\x31\x2d\x06\x0d\x6f\xdb\x3a\xe2\x99\x99\x3a\x7f\x76\x29\x7d\xda\xa9\x3f\x15\x9c\xd1\xb2\xd4\x92\x0b\xe2\x90\xc4\x24\x64\x7a\x53\x67\xa1\x05\x3c\x16\xbc\x6a\x5a\x16\x8c\xce\xab\x70\x7b\x69\x16\x56\xd3\xd0\x0c\xe4\x1e\x8d\x2f\x6c\xc7\xcb\x1b\x3e\x37\xff\xdc\x6c\xcf\x98\x43\xf7\x06\x70\x12\x92\x08\x26\xa0\x15\xee\x20\x84\x50\xda\xf9\x77\x00\x69\xe2\x33\x23\xd3\x28\x00\x00")
 
 func dcosagentresourcesvmssTBytes() ([]byte, error) {
 	return bindataRead(
@@ -307,7 +308,7 @@ func dcosagentresourcesvmssT() (*asset, error) {
 	return a, nil
 }
 
-var _dcosagentvarsT = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x55\x4b\x6f\xdb\x30\x0c\xbe\xef\x57\x08\xb9\xd8\x06\x9c\x74\xdb\x71\xb7\xf4\x81\xc2\x40\xe2\x19\x70\x91\x4b\x51\x0c\xb2\x45\x67\x42\x6d\x29\xa0\xe4\x34\x9d\x91\xff\x3e\xf8\x91\xfa\x11\x79\x4d\xba\x6c\x27\x09\xa0\xf8\x7d\x14\xf9\x91\x24\x84\x90\x49\x51\xcc\x7c\x9a\xc1\x7e\x7f\x23\x73\xa1\x27\xdf\xc8\xe4\x71\x43\x91\x66\xa0\x01\x95\x6d\xf5\xcd\x96\xf3\x34\x71\x3f\xf5\xfd\xfc\xf0\xde\xbb\xad\xfc\x10\x94\xcc\x31\x06\x8f\xd9\xd6\x92\xc7\x28\x95\x4c\xf4\xcc\x07\xfd\x22\xf1\xf9\x4a\xd4\x67\x08\x71\x8e\x5c\xbf\xde\xa3\xcc\x37\xca\x72\xb7\x14\x39\x8d\x52\xe8\x72\xf9\xe1\x7d\x79\xb1\x9c\x92\x8e\x1c\xf3\x95\x97\x8a\x31\x96\x22\xa6\xda\xee\x60\x48\x8c\x7f\x82\xd2\x48\xb5\xc4\x1a\xc3\x25\xd6\xf4\xcd\x79\x2a\xd4\x7a\x6a\xb9\xa4\xe3\x21\x68\x06\x61\x9e\x24\x7c\x67\xe6\x5b\x2d\xcb\x33\x40\x48\xf8\xee\x83\xa4\xe7\x12\x86\xfc\x17\x8c\x57\xa2\xb6\x9b\x4a\x51\x5b\x1e\x38\x60\xe5\xad\x36\x29\xd7\xf6\x1f\x31\x5c\xeb\x87\xe5\x3c\x7e\x7e\x2a\xb1\x8a\x82\x27\x64\xe6\xa9\xf9\x96\xf2\x94\x46\x3c\xe5\xfa\x35\x04\xad\xf6\xfb\x8a\xe6\x60\x0e\xb5\x44\xba\x86\x79\x1c\x97\x8a\x68\x8c\x6d\x0c\x7d\xb3\x6a\x55\x45\x19\xb3\x19\xdf\xda\xa6\x82\x37\xe2\xea\xa5\x29\xa3\xbb\xd5\x52\x05\x80\x7d\x44\xcb\x71\x5c\x92\x49\x66\x97\x78\xe5\x79\x01\xbc\xaf\x8e\x4b\x2e\x08\xf7\xc5\x71\x0c\xc5\xe9\xbf\xfb\x9e\x24\x0a\xea\xc4\x64\x79\x6a\xf7\x81\x07\x39\x0c\x00\xe7\x6b\xa8\x22\x30\x45\xe7\x09\x06\x8d\x94\x9a\x42\x81\x60\x47\x75\x19\x54\xb5\xab\xe4\x16\x6a\x4a\xfb\xaf\xde\x51\xee\x80\xa2\xf3\x27\xa3\xe8\x6a\x7b\x2d\xdc\xa2\x80\x54\xc1\x85\xa4\x75\x62\xf6\x86\x09\x6a\xcf\x9a\xfe\x26\x57\x5a\x66\x2b\xff\xee\xe1\x88\x7a\x25\x40\x87\x79\x24\x40\x37\xb3\xce\xdc\x55\x9d\x57\x86\x6f\x76\x7e\x52\x3d\x1a\x07\xaa\xed\xa6\x16\xaf\x2d\x1f\x1f\x80\xe3\xc8\x47\x5f\x3c\xc6\xde\x36\x3f\x73\xad\x2b\x55\xbd\x54\x57\xe6\x01\xde\x46\xd9\x08\xa5\x97\x6a\x4f\x05\x79\x94\xf2\x98\xcc\x02\x89\x6f\xf3\xa5\x8d\xe4\x4e\xb0\x8d\xe4\x42\xdf\xfa\xe1\x60\xf4\x6a\x99\xca\x17\x40\xf3\x4c\x33\xba\x19\x85\xea\x05\x73\xc6\x10\x94\x3a\x3b\x8f\xb4\x54\xd2\x94\x6f\x06\x8d\xf1\x5e\x0c\xa5\xeb\x59\xad\xb4\x88\xae\x69\xfc\x0c\x82\x05\x52\xa6\x7f\xb1\xee\xce\xdb\x3c\x8b\xe8\xa4\x4d\x9e\x4a\xca\xae\x69\x4a\x45\x0c\x38\xb2\xc1\x17\xd1\xf8\x02\x5f\x44\x5e\x70\x23\x45\xc2\xd7\xa3\x42\xeb\x45\x54\xe9\x2d\x41\x29\x34\x08\x76\x70\xcd\x91\x6a\x2e\x45\x29\x40\x63\x21\x5a\x92\x53\x02\xf9\x8f\x19\xfe\x67\x54\x87\x1e\xfb\x1d\x00\x00\xff\xff\xd3\xe0\x42\x07\xd6\x09\x00\x00")
+var _dcosagentvarsT = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x55\x4f\x8f\xa2\x4e\x10\xbd\xcf\xa7\x20\x5e\xd0\x04\xf5\x77\xfe\xdd\x1c\x67\x62\x48\x94\x25\x61\xe2\xc5\x4c\x36\x0d\x14\x6e\x67\xa0\x9b\x54\x17\x8e\xb3\x84\xef\xbe\x01\x1c\xe5\x4f\xb3\xa3\x66\x77\x4f\x90\xbc\xae\xf7\xea\xcf\xeb\x6a\xc3\x30\x8c\x51\x9e\xcf\x1c\x96\x40\x51\x2c\x65\x26\x68\xf4\xbf\x31\xda\xa5\x0c\x59\x02\x04\xa8\xc6\x66\x1b\x36\x27\xaf\x23\xeb\xa1\x1d\xe7\x78\x2b\xfb\xa9\x8a\x43\x50\x32\xc3\x00\xec\x70\x6c\x6e\x78\x80\x52\xc9\x88\x66\x0e\xd0\xbb\xc4\xb7\xb9\xa8\xbf\x1e\x04\x19\x72\xfa\x58\xa1\xcc\x52\x65\x5a\x07\x86\x9c\xf9\x31\x34\xb5\x1c\x6f\x55\xfe\x98\x93\x52\xce\xe8\xeb\x95\x60\xa5\x18\x48\x11\x30\x1a\x37\x38\x24\x06\x3f\x40\x11\x32\x92\x58\x73\x58\x86\x39\x3d\x07\x4f\x85\xda\x4f\x4d\xcb\x68\x44\x08\x96\x80\x97\x45\x11\x3f\xea\xf5\xb6\x9b\xf2\xeb\x22\x44\xfc\x78\xa7\xe8\x6d\x82\x4b\x99\xa4\x19\x01\xb6\x65\xf3\x7c\x05\xb4\xd8\x83\xa0\x3e\x6e\xcc\x8a\xa2\x37\x97\xed\xc6\xe3\x3f\x61\x78\xa0\x35\xae\x9b\x68\x8d\xbc\x70\xc0\x2a\x5a\xa5\x31\xa7\xf1\x6f\x39\x2c\xf3\xbb\x39\xd9\xfd\xf7\x5a\x72\xe5\x39\x8f\x8c\x99\xad\x16\x07\xc6\x63\xe6\xf3\x98\xd3\x87\x07\xa4\x8a\xa2\x4a\xf0\x13\xf6\x48\x22\xdb\xc3\x22\x08\x4a\x63\x15\x45\x27\x87\x36\xac\xce\xe6\xac\xda\xa0\x03\xb5\x3d\x68\x1f\xfc\x16\x45\x0a\x2a\x96\x5d\x92\xc5\xcd\x01\x26\xec\xd8\xe1\x74\x01\xab\x66\x9b\x13\xad\x43\x6d\x11\xc2\x69\x7c\xa7\xaa\x40\x84\xbd\x22\x3a\x2d\x68\xba\xe7\x42\x35\x65\xed\x53\x5f\xd8\xb3\x23\xd1\xa8\x49\x3b\xa1\x1a\xaf\xa7\x9c\xe7\x10\x2b\xf8\x33\x73\xd8\x5d\xd9\xbd\x6e\x83\x2e\xdf\xda\x06\xcb\x4c\x91\x4c\xb6\xce\xf3\x4b\x4f\x7a\x2b\x80\xbc\xcc\x17\x40\xf6\xd3\x70\x81\xcd\x53\x9a\x32\x1b\x95\x54\x54\xc3\x44\x35\x89\xee\x3e\xd4\xc8\xfd\x4b\x67\x98\xb9\x57\x62\x9f\xfb\x70\xaa\xcc\x32\xe7\xaa\xe2\x51\x73\xfd\xd2\xbc\x64\x79\x32\x4a\xab\xd5\xb6\x72\x33\x3f\xe6\x81\x31\x73\x25\x9e\x2f\xe3\x25\x93\x67\x11\xa6\x92\x0b\x7a\x72\xbc\xf6\xde\xd9\x91\x8c\xe5\x3b\xa0\x7e\x01\x68\xc3\xb4\x46\xb5\xdd\x45\x18\x22\x28\x75\x73\x1f\x59\x79\x0f\xa7\x3c\xed\x5c\x8c\xaf\x72\x28\xf7\xfe\x4d\x57\x69\xed\x3f\xb2\xe0\x0d\x44\xe8\x4a\x19\xdf\x3f\xed\x1b\xb7\xfd\xda\xbf\xea\xf5\x8c\x25\x0b\x1f\x59\xcc\x44\x00\x38\xf0\x6a\xae\xfd\xe1\x47\x73\xed\xdb\xee\x52\x8a\x88\xef\x07\x8d\xd6\x3c\x5b\xfb\x2d\x42\x29\x08\x44\xf8\x19\x9a\x21\x23\x2e\x85\x9a\xb7\x2b\xd4\x88\x5c\x93\xc8\x3f\xec\xf0\x5f\x93\x7a\xc8\x73\x10\x61\x51\xfc\x1a\x00\xf2\xef\xa4\x8d\x4a\x09\x00\x00")
 
 func dcosagentvarsTBytes() ([]byte, error) {
 	return bindataRead(
@@ -327,7 +328,7 @@ func dcosagentvarsT() (*asset, error) {
 	return a, nil
 }
 
-var _dcosbaseT = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x53\x41\x8b\xdb\x3c\x10\xbd\xe7\x57\x08\x7f\x01\x6f\xc0\xab\x24\x1f\xf4\x12\xe8\x21\x65\x0f\xed\xa5\x0d\x6c\xe9\x65\xd9\xc3\xac\x3d\xf1\xba\xb5\x24\xa3\x19\x87\xdd\x0a\xfd\xf7\x62\xd9\x38\x51\x6c\xba\xb4\x25\x97\xc8\x7a\xf3\xde\x9b\xd1\x1b\xb7\x10\x22\x59\x52\xfe\x8c\x0a\x92\x9d\x48\x9e\x99\x1b\xda\xad\xd7\xfd\x17\xa9\x40\x43\x89\x0a\x35\x4b\xf8\xd9\x5a\x94\xb9\x51\xc3\x1d\xad\xff\xdf\x6c\xdf\xdd\x6e\xb6\xb7\x9b\xed\xba\xc0\xa6\x36\xaf\x1d\xee\x2b\xaa\xa6\x06\x46\xf9\x9d\x8c\xfe\x2f\xc9\x3a\xfe\xdc\x68\x46\xcd\xdf\xd0\x52\x65\x74\x27\xb3\x95\x9b\xee\xd7\x5f\x37\x60\x41\x21\xa3\xa5\x64\x27\x3a\x43\x42\x38\x67\x41\x97\x28\xe4\xbe\x44\xcd\x07\x63\xea\x83\x35\xc7\xaa\x46\xf2\xde\x39\x1e\x34\x44\x02\xdd\x75\xa8\x27\xc9\x89\x90\xde\x67\xce\xa1\x2e\xbc\x1f\x68\xce\xd0\x22\x37\x14\x21\x27\x08\x05\xc4\x68\xaf\x31\x3e\x78\x3c\x81\xad\xe0\xa9\xc6\xa9\xc5\x65\xa5\x0b\x7c\xc9\xc4\x32\x78\x11\xbb\xf7\xb3\xa6\x43\xc9\x9c\xa5\x50\x75\x02\x1b\xb9\xea\x81\xd5\x51\xc8\x4f\x74\xcf\xc6\x42\x89\xfb\x3c\x37\xad\xe6\x0b\x80\x10\x89\x73\xf2\x33\x28\xf4\x3e\x06\x7d\x39\x1e\x09\xb9\x9b\xf3\x83\x6a\xeb\x9b\xd1\xfb\x4d\xaa\xe0\x25\x86\xd2\x01\x6d\xb0\x9b\xae\x32\xe7\xfa\x5e\xbc\x5f\x3d\x86\x97\x99\xea\x0c\x55\xdd\x21\xd0\xe7\x46\xe7\xc0\x97\x0a\x14\xd1\x7f\x00\xc2\x0e\x9c\xae\x32\x91\x42\xa9\xf9\xac\x91\x5e\x89\xf4\xfd\x7e\x04\xba\xab\xe8\x07\x45\x7d\x5e\x3a\xb8\x03\x86\x7f\x72\x51\x00\xc3\xef\x5c\x9c\xd3\x73\x7d\xbe\xfc\x3f\x1b\xaf\x3e\x40\xf1\x63\xf6\xf1\xb1\x48\xa6\xb5\x79\x88\xcf\xc3\x9b\x09\x5f\xc4\x09\xd8\x9f\xa0\xaa\xe1\xa9\xaa\x2b\x7e\xbd\x47\x7e\x33\x4d\xa3\xd8\x49\xc1\xb8\x16\x23\x27\xd6\x84\x7f\xc0\x40\x33\x0c\xb3\x13\x99\x1f\xc6\xc8\x74\x9e\xc8\x63\x98\x88\x69\xb9\x69\xf9\x2f\x37\x7e\x28\x8e\x17\x39\x58\x99\xee\xf3\x04\xeb\x17\x7e\xf1\x2b\x00\x00\xff\xff\x7e\xee\x61\x5d\xf7\x04\x00\x00")
+var _dcosbaseT = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x53\x4d\x6b\xdb\x40\x10\xbd\xeb\x57\x2c\x5b\x43\x12\x70\xd6\x76\xa1\x17\x43\x0f\x86\x40\xdb\x4b\x6b\x48\xe9\x25\xe4\x30\x91\xc6\x8e\xda\xfd\x10\x3b\x23\x93\x74\xd9\xff\x5e\x56\x52\x6c\xaf\xad\x92\x36\x48\x07\x49\xf3\xe6\xbd\xb7\x33\x4f\xa1\x10\x42\x4e\xa8\x7c\x44\x03\x72\x29\xe4\x23\x73\x43\xcb\xd9\xac\xff\xa2\x0c\x58\xd8\xa2\x41\xcb\x0a\x7e\xb7\x1e\x55\xe9\xcc\x50\xa3\xd9\xfb\xf9\xe2\xc3\xf5\x7c\x71\x3d\x5f\xcc\x2a\x6c\xb4\x7b\x4e\xb8\xef\x68\x1a\x0d\x8c\xea\x27\x39\xfb\x4e\x4e\x13\x7f\xe9\x2c\xa3\xe5\x1f\xe8\xa9\x76\x36\xc9\x2c\xd4\x3c\x5d\x7d\xb9\x01\x0f\x06\x19\x3d\xc9\xa5\x48\x86\x84\x08\xc1\x83\xdd\xa2\x50\xab\x2d\x5a\x5e\x3b\xa7\xd7\xde\x6d\x6a\x8d\x14\x63\x08\x3c\x68\x08\x09\xa9\xdc\xf5\x93\x62\x29\x54\x8c\xd3\x10\xd0\x56\x31\x0e\x34\x07\x68\x55\x3a\xca\x90\x67\x08\x03\xc4\xe8\x4f\x31\xb1\xf3\xb8\x03\x5f\xc3\x83\xc6\x73\x8b\x93\xda\x56\xf8\x34\x15\x93\xce\x8b\x58\x7e\x1c\x35\xdd\x89\x8d\x59\xea\xba\x76\xe0\x33\x57\xe9\x0e\xa1\xde\x08\xf5\x85\x6e\xd9\x79\xd8\xe2\xaa\x2c\x5d\x6b\xf9\x08\x20\x84\x0c\x41\x7d\x05\x83\x31\xe6\xa0\x6f\x9b\x0d\x21\xa7\x39\xdf\x99\x56\x5f\xee\xbd\x5f\x5e\x18\x78\xca\xa1\xb4\x46\xdf\xd9\xbd\xb8\x9a\x86\xd0\x9f\x25\xc6\xab\x7b\x39\x1d\xd5\x19\xba\x92\x68\xa2\x0f\xe1\x13\x72\x4e\x98\x4a\x69\x2f\x96\xe5\x30\x9a\x18\x33\xb2\xfe\x5c\x9f\x81\x6e\x6a\xfa\x75\x3c\x99\x5c\xe9\x06\x18\xfe\x55\xad\x02\x86\xbf\xaa\x1d\xd2\x70\xfa\x7e\xfc\x5c\x8c\xed\xa6\x0f\x44\xbe\x9c\x3e\x0e\x1e\xc9\xb5\xbe\xec\xe2\x70\xf7\x6a\x62\x8b\x7c\xa3\xab\x1d\xd4\x1a\x1e\x6a\x5d\xf3\xf3\x2d\xf2\xab\xe9\xd8\x8b\xed\x0c\xbc\x38\x79\x39\x63\x08\xa8\x09\xff\x83\x81\x46\x18\x46\x27\x72\x4a\xd4\x0f\x63\xcf\x34\xb0\x14\x42\xdc\x27\x26\xe9\x5a\x6e\x5a\x7e\xe3\x1f\x3c\x34\x1f\x38\xf7\x56\x8e\xa1\xbd\x83\x33\x6c\x2c\x62\xf1\x67\x00\x80\xb5\x56\xad\xc7\x04\x00\x00")
 
 func dcosbaseTBytes() ([]byte, error) {
 	return bindataRead(
@@ -347,7 +348,7 @@ func dcosbaseT() (*asset, error) {
 	return a, nil
 }
 
-var _dcoscustomdata173T = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x59\xff\x73\xe2\x36\x16\xff\x9d\xbf\x42\x75\x3b\x43\x72\x1b\xd9\x40\xbe\x6d\xc8\xb8\x33\x84\xb0\x5d\xae\x49\xc8\x00\xe9\xf5\x6e\xd3\x61\x64\xeb\x19\x54\x64\xc9\x27\xc9\x04\x36\xdd\xff\xfd\x46\xb6\x49\x30\x81\x0d\xdb\x6e\xf7\x32\xb3\x1b\xe7\xf9\xf3\x9e\x9e\xde\x37\xbd\x27\x07\x52\x9a\x30\xa6\xcd\x0a\x46\x01\xd1\x13\x84\x43\xe4\xb0\x08\x7d\x40\xdf\x21\x1c\x21\x6f\x46\x94\xc7\x59\xe0\x69\x1a\xe0\x71\x62\xd0\x6f\xe7\x66\x02\x02\x41\x38\x91\xe8\xb2\xdd\x1b\xe0\xe3\xb7\x67\xb5\xf3\x84\x28\x03\x14\x61\x8d\x3c\x0a\x33\x0b\x46\xf1\x94\x93\x00\x78\x05\xa1\x71\x62\xce\x8d\x4c\xc3\xc9\x0b\x69\xe7\x11\x73\x2a\x94\xe9\xe9\x48\x83\x49\x93\x66\x05\x21\x48\x26\x10\x83\x22\xbc\x66\xff\x42\x88\x93\x85\x4c\x4d\xfe\x8c\xd1\x71\xad\xfc\x20\x67\xa0\x1e\x14\x33\xd0\x44\x46\xa5\x90\xd1\x0c\x09\x38\x8c\xcc\x22\x81\xa6\x5d\xba\x12\xe9\xa5\x74\x8c\x28\xcc\x58\x08\xcd\x95\x55\xdc\x7a\x05\xa1\x88\x71\xd0\x0b\x6d\x20\x6e\x22\x98\x9b\xa3\xca\x4b\xc9\x1b\x79\x1b\xbb\xf1\xc6\x32\x15\x46\xdb\xf5\xf1\xfa\xca\xf8\xd9\x26\x31\x68\xa9\xd7\x31\x8d\x32\x86\xca\x70\x0a\xaa\xa2\x52\x51\xb8\xcc\x4b\x75\xfe\x86\x24\xc6\xfe\x73\x73\x55\xa8\x4b\x09\xe3\x8b\x0a\xce\x1d\xd5\xa0\xb4\x1e\x42\xfd\x14\xd7\x4e\xcf\x00\x1f\xd5\x0e\x43\x1c\x1c\x1e\x37\x30\xa9\x9f\x35\xea\x00\x8d\xda\x29\x00\xfa\x11\x79\x7a\xa1\xbd\x20\xd5\xde\x2c\xb6\xff\x53\xc5\x66\xa0\xb4\x37\x99\x8d\x52\xc3\xb8\x97\x8a\x80\x09\x8a\xbe\x47\x31\x33\x6c\x4c\x0c\x93\x02\x45\x52\xa1\x20\x1d\xa3\x89\x31\x89\x6e\x7a\x5e\x90\x8e\xb5\xcb\x49\x2a\xc2\x49\x42\xa8\x2b\xc0\x78\x69\x90\x0a\x93\x7a\x6f\xb4\x4c\x55\x08\x1e\x67\x22\x9d\x7b\x6f\x82\x74\xec\xd5\x4f\x4e\x4f\x4e\x0e\x8f\x2b\x18\x69\x1b\x3c\x0c\x39\xf5\x43\x76\xff\xd5\x35\xbe\x17\x0e\xf2\xc0\x84\x9e\x0a\x5d\x2e\x43\xc2\xff\x96\x2d\x60\xc4\x45\xe6\x2c\xac\x73\x9f\x05\x4c\x78\x2a\xce\x9f\xad\x97\x8a\xbf\xb7\x20\xe3\x29\x65\xaa\x0c\xce\x49\x5b\xf0\x86\xac\xa1\x2d\x61\x0b\xb6\x20\x3d\x41\xb9\xd8\x86\x0c\x93\x32\x32\x4c\xb6\x21\xf3\x38\x0b\x0d\x2f\x33\x3c\x93\xb7\xed\xd3\xe6\xc2\xda\x3e\x33\xd2\x16\xbc\xad\x49\x65\x78\x46\xb1\xe8\xb2\x0a\xda\xc8\x5c\x79\x05\x5a\xf2\x59\x28\x45\xe4\x6a\x50\x36\x67\x37\xa0\x29\xd3\xb6\x4c\xec\xce\xf0\x24\x9e\xcf\x43\x2c\xc0\xec\x28\xfb\x75\x74\x4c\xf4\x74\x0b\xd4\x93\x36\xa9\x3f\xa6\x0a\xbc\x50\x0a\x43\x98\xb0\xc1\x9d\x28\x39\x63\x9a\x49\xe1\x16\x76\x28\x9c\x86\x0b\xdf\x65\x91\x9e\x6d\xc9\xb5\x7b\xca\x89\x26\x4e\x4a\x44\xcb\x57\x04\x27\x8e\x36\xf3\x6d\x10\xbd\x2e\x65\x2b\xdf\xba\xed\x88\xca\x7d\x4e\x43\xa9\x71\x5e\xc4\x30\x13\xda\x10\xce\x3f\x6b\xf3\x12\x1f\x67\x62\x8a\x41\xcc\x76\xe7\xa0\xf2\x41\x70\x49\xe8\xee\x1c\xd9\x49\xb1\x3b\xdc\x6e\x98\x8d\x71\x56\xec\xd5\x13\x5b\xf6\xe7\x28\x3b\x18\x6c\x8d\xb6\xbe\x03\x61\x9a\xa8\xba\x2c\x31\xe4\xe3\xdb\xc3\xd3\x46\xed\xd0\x9d\x49\x37\xd6\x10\x8a\xbc\xcc\x58\x91\x9e\x01\x6d\x98\x18\x57\xb2\xb3\xac\x6a\x4f\x93\x07\x01\xaa\x89\x94\x94\x76\xe1\x84\x98\x49\x33\x37\x7a\x76\x60\x24\x13\x50\xe0\x65\x6a\xe3\x88\x93\xb1\xf6\x14\x24\x52\x33\x23\xd5\x02\xa7\xca\x6a\x9d\x80\x8a\x99\xb6\x11\xa3\x9b\xa8\x5a\x3b\x39\x3a\xaa\x96\xb4\xba\xe8\xf5\x86\x83\x61\xbf\x75\x3b\xea\x5e\xfa\x34\x3a\xac\xbd\x0d\x4e\xa2\xf0\x30\xa0\x67\x75\xa8\x37\x4e\x4f\x03\x42\x8e\xc9\x21\x0d\xce\x1a\x6f\x09\x9c\xd6\xce\x4e\x8e\x4e\x1b\x8d\x3f\xaf\x9f\x6d\x38\xb4\x51\x24\xc1\x8c\xee\xa0\x9d\x6d\x32\x46\x9d\x9b\x5f\xba\xfd\xde\xcd\x75\xe7\x66\xe8\x3f\x3e\x3e\x1a\xa2\xc6\x60\x3a\x62\xc6\x94\x14\x31\x08\xf3\xe9\xd3\xa7\x3f\xaf\x50\x1e\x2a\x90\x70\xb9\xb0\xe1\xb5\x94\xb9\x83\x6e\x1f\x9c\xd5\x28\xc8\x83\x67\x64\x15\xfe\xe9\xae\x7b\xe9\x1c\xa0\xfc\x75\x0c\x86\x50\x62\xc8\x0b\xc0\x6f\x7f\x5e\xe7\x90\xa7\xda\x80\xc2\x09\x09\xa7\x64\x0c\xda\xfd\x5d\x4b\xb1\x8b\xc6\xff\x94\xa9\x12\x84\x17\x4b\x5f\x93\xf9\x15\xcc\x80\xb7\xa5\xd0\x92\x83\xff\x40\x94\xd8\x2d\xf8\x8a\x3e\xc3\xfb\x3d\x97\x47\xb3\xdc\x77\x69\x66\xcc\x65\x71\x78\x4d\x99\xc7\xc7\x47\x41\x62\x18\xa4\x51\xc4\xe6\x25\x17\x6e\x36\xc0\x72\xd3\xbb\xc4\x8d\x73\x2f\x14\xcc\x15\x59\x34\xef\x05\x42\x5c\x8e\xb9\xdd\x67\x13\x31\x11\x49\x4b\x89\x25\x4d\x6d\x7e\xda\x67\x84\x28\x44\x24\xe5\xa6\x28\x4d\x05\x11\x2d\x2b\x39\x6d\xde\x67\x9a\xdd\x67\xed\xdc\xbd\x70\xca\x1a\xe6\xeb\x78\x79\x14\xb8\x8b\x78\x97\x9c\xfb\x70\x27\x98\x29\x9c\x70\x01\x91\x54\xe0\x67\x96\xcb\x23\x3b\xa7\x7f\x18\xe4\xf5\xa4\x80\x0d\x17\x09\xf8\x52\x80\x9e\xc8\x02\x30\x30\x44\x50\xa2\x68\x2f\x35\x49\x6a\xfc\xc2\x15\x6f\xc2\xdc\x99\x65\x4c\x47\x29\xa9\x36\x43\x3a\x73\x08\x07\xb6\xae\xdd\x2a\xf0\xcb\xdd\x07\xc2\x49\xbe\xc7\x44\x49\x5b\xcf\x5c\xba\xc6\xe2\xaf\x34\x15\x08\xeb\x28\x3f\xb3\x56\x7c\xb6\x92\x50\x2e\xcc\x13\xa9\xcc\x9a\xc0\x3c\x62\xf4\x64\xa3\xf3\x97\x51\x96\xff\xf6\x36\x1f\x03\x5f\x66\xed\x56\x64\x40\xf9\x02\xcc\x83\x54\x53\x2c\x05\x67\x02\x4a\x66\xff\x17\x11\x46\x7f\x0e\xd0\x96\x82\x32\xdb\x3a\xde\x12\x33\xe9\xcc\x99\x36\xda\xff\x6e\x7d\xdf\x1b\x5d\xb8\x52\xb1\xde\x31\x0e\xfe\xce\x65\xf2\xff\x11\x00\x61\xaa\x38\xc2\xd1\x95\x1e\xcc\x10\xc6\x0a\x8c\x5a\xa0\x46\x0d\xe1\x7f\xa3\x7a\xcd\xfe\x20\xbc\x40\x27\xc5\x3c\x26\xd7\xc6\x18\x8f\xf2\xe7\x0d\x58\xe3\xb9\xf3\x8f\xe8\xf1\xf1\xd1\x3a\xf0\x62\x49\xbf\xeb\x5f\x3d\x25\xfd\x6b\x31\x58\xb6\xee\xd6\x20\x34\x44\x21\x4c\xe6\xd1\x2e\xea\xe0\xf6\x2b\x62\x6f\xa5\x36\x3e\x5e\xe9\xdd\x4b\x03\xf1\x56\xc1\x3b\x07\xf2\x8b\xee\xe4\xcb\x02\xb9\x0f\xff\x4d\x99\x02\xed\x6f\x16\xb6\x12\xec\x9f\x01\x7c\xb3\x1a\xb3\x1e\xf9\xdb\xcb\xc4\x0b\xe7\xae\x41\xad\x2f\x92\xe9\x38\xb1\x6b\xa2\x2c\x63\x10\xc6\x42\xe2\x80\xcb\x70\x8a\x0b\x43\x17\xbb\xeb\xe6\x3d\xe6\x6f\xcf\x99\x0d\xf4\x62\xe1\xc7\x29\x37\x0c\xa7\x1a\x54\x29\xb1\x77\xf0\x59\xb9\x3f\xfc\xd6\x95\xe7\x55\x6f\xad\x98\xd9\xbf\xec\x5c\x74\x5b\x37\xa3\x77\xfd\xde\xcd\xb0\x73\x73\xe9\x0b\x29\x98\x30\xa0\x48\x68\xd8\x0c\xfe\x9e\x02\xe2\x97\xa6\xb4\xec\x2e\xc9\xa8\x85\x5f\x3f\x4f\x85\x61\x1c\xd1\x64\x3a\x46\xf8\xf2\xd0\xce\xfa\x2f\x13\x89\xba\x14\x02\xf4\xc7\x1f\x68\x6f\xcf\xa8\xc5\x8f\x67\xfb\xfb\xe7\x54\x66\xf2\xb3\x2b\x81\xbc\x02\xfd\x90\xbd\x7c\xf3\x66\x7f\xff\x5c\x73\x80\xa4\x20\xfc\xc3\xa8\x45\x86\x17\x70\xfe\xd4\xb1\x67\x71\xf1\x90\xf7\xec\x28\x3f\xda\xcf\x57\xdb\x79\x64\x47\xbf\x95\x97\xae\xb6\xbf\x8c\xf3\x05\x19\xbc\x71\x92\xd9\x21\x2c\xca\x8e\xec\xe7\x6a\xf8\x84\x3f\x90\x85\x7e\x32\xbb\x32\x57\x2c\x66\xa6\x6b\xbd\x36\x23\xdc\xaf\x95\xd0\x03\x08\xfd\xfa\xf1\xba\x03\xb6\x3a\x24\xd7\x15\x51\x02\xb1\x14\x08\xbf\x47\x11\x6d\x7a\x1e\xc2\x58\x1b\xa9\xc8\x18\x70\x7e\x79\xe2\xcb\x19\x28\x4e\x16\x3b\xd9\xa0\xb0\x5a\xbe\x17\x97\x7a\x30\x87\x30\xd3\x6d\xd7\x0e\x70\x35\x3d\x6e\x89\x32\xbd\xc8\x2f\x0b\x5d\xc6\x7d\xe6\x98\x02\x78\xc5\xb4\x01\x31\x30\x0a\x48\xec\x67\x61\xa4\x52\xe1\xad\xb8\xb0\x30\x60\xc6\x73\x2d\x29\xf8\xb5\x93\x93\xda\x2a\xf1\x4e\x83\xf2\xb3\x9e\x76\x85\xf8\x93\x92\x69\x52\x2c\xbf\x61\xa1\xc6\xe1\x69\x61\xec\x0b\x26\x68\xf7\x76\x76\xd2\x13\x7c\xe1\x07\xd2\x4c\x3e\x5f\x6c\xf2\xa0\xd2\x5f\x52\x69\x56\xa3\xf1\x2f\x9d\x0a\xe5\x72\xb5\x7e\x24\x6c\x78\xfb\x25\x15\xe6\xb9\x85\x79\x59\xbd\xff\x42\xb9\x7f\x51\x41\xb2\xec\xc7\x80\xee\x9d\x7a\xe3\xd4\xad\xb9\x35\xb7\x8e\xb2\x7b\xbc\x89\xd4\xe6\x5e\xfc\xb0\x47\xc1\x40\x68\x46\x2c\xd9\x47\x3f\xec\x59\xa2\x9d\x2a\xf6\xef\x9d\x4c\xf2\x8f\xb9\x89\x2d\x59\xef\x9e\xd9\x9b\x07\xfb\xd7\x5d\x71\xdd\x19\xf4\x06\xa3\xf6\xd5\xdd\x60\xd8\xe9\xdb\x51\x35\x26\x76\x64\xb9\x4d\x03\xce\xc2\xee\x6d\x8b\x52\x05\x5a\xdf\x90\x18\x76\x98\x77\xf2\x76\x70\x39\xe2\xe5\x8a\x65\xb7\x3f\x14\x14\xce\xee\x85\x8a\x91\xf2\x99\x11\xe7\xeb\x3d\xc1\x4a\xca\x15\x01\x70\x79\xdd\xbd\xe9\xf7\xee\x86\x9d\xfe\xa8\xd5\x1e\x76\x7f\x69\x0d\x3b\xa3\xd6\xdd\xf0\xfd\xe8\xba\x77\x79\x77\xd5\xb1\x5a\x4b\x92\x9a\x49\x47\x64\xc3\xcf\x57\x57\x94\xd0\x98\x09\x25\x53\x6b\x59\x10\xb3\xb5\x89\xba\x5a\x3d\xc8\x3e\x2c\xb4\xef\x06\xc3\xde\xf5\x65\x6b\xd8\xba\xbd\xbb\xb8\xea\xb6\xbb\xb7\x83\x61\xbf\x5a\x5d\x1d\x9a\xbf\x8a\xd5\x32\x7b\x8d\x38\xd3\xa6\xa4\x48\xe7\xd7\xf7\xdd\x8b\xee\xb0\xd7\x1f\x5d\xb4\xda\x3f\xdb\xf3\xb3\xf5\x9f\xbb\x7e\xa7\x30\xa1\x7d\x1c\xb5\x7b\x37\xc3\x56\xf7\xa6\xd3\xcf\xb3\x09\xe6\x13\x16\x30\x23\xd5\x2a\xe6\xb6\xdf\x79\xd7\xfd\xf5\xdb\x06\xc2\xb3\x22\xab\x1b\x0a\x65\xec\x0a\x30\x11\x67\x73\xf7\x09\xe1\x66\xcc\x2e\x09\x43\x99\x0a\x83\x6d\xde\x3c\xeb\x3a\xc8\x0f\x86\x56\xfe\xb2\xa4\xea\x2e\xc2\xa6\xb0\xf0\xad\x33\xad\x69\x7f\x86\x85\xde\x53\x90\xdf\x9f\x77\xe9\x5e\xb5\x7a\xcd\x42\x25\xb5\x8c\x8c\x5b\x2c\xe3\xe9\xd2\x72\xba\x5a\x3d\xc8\x96\x9a\x11\xc5\x6c\x1c\xea\xbd\x6a\x75\x9b\x5e\xd5\xea\xfe\xfe\x01\xaa\x56\x1b\xb5\xfa\x31\xae\x9d\xe0\xfa\x71\xb5\xba\xef\x4e\x61\x51\x3f\xa8\x56\xff\x26\xe3\xba\x89\x92\x09\x28\xc3\x40\x97\x2f\x35\x9c\x94\xb5\xb3\xea\x91\xaa\xec\x03\x83\xd3\x7c\x74\x12\x9e\x8e\x99\xd0\xf6\x31\x20\x42\x80\xb2\x4f\x90\xe7\x97\xd3\x8c\x08\xd7\x70\xe0\x04\x24\x9c\x8e\x95\x4c\x05\x6d\x4b\x2e\x95\xd3\x74\xbe\xaf\x77\x1a\x87\x8d\x77\xce\x81\x13\x49\x05\x6b\xef\xde\x65\x3f\xce\x81\x33\x01\x42\x41\x0d\x99\xe1\xe0\x34\x45\xca\xf9\x92\xd4\xce\x95\x5a\x12\x23\x29\xcd\x0b\x22\x8b\xc9\x18\xec\x20\xbb\x24\x50\xa6\xb3\x0a\x17\x2c\xa5\x7d\x3a\x70\x02\x45\x04\x65\x62\xfc\x52\xeb\x4f\x07\x0e\xcc\x0d\xd8\x96\x30\x1b\xcc\xb5\x93\x7f\xaa\x5b\xc1\xa1\x02\x58\xc9\x1d\xe1\xd8\xd2\x02\xc2\xb0\xf0\xc9\x3a\xeb\x22\x97\xc8\xac\x0a\x95\x00\x2f\x4b\xd3\x41\x26\xef\xbd\xd4\xc6\x69\x3a\xcb\xfb\xd5\xec\x4a\xc1\xd2\x6b\x6e\x28\x63\x27\x93\x98\x8b\x2c\x9a\xeb\x6d\x7b\x91\x6a\x4c\x04\xfb\xb8\x55\x33\xc7\x28\x12\x6e\xe1\xfe\xea\x79\x9c\xb2\xe2\x18\xca\x2f\xf7\x4a\x41\xf6\xe9\x6b\x2c\x92\x4c\xc7\x4c\x44\xf2\xa5\xf8\xed\xc2\x95\xe4\xa0\xf3\x6f\x12\x25\x8e\xdb\x7e\xef\x97\xee\xa0\xdb\xbb\x19\xd9\x2a\xfd\xc4\xee\xbc\xfe\x09\xc3\x59\x3f\x55\x9d\xda\xe9\xd1\x91\xf3\x7c\xf7\xe8\xd8\x46\xcd\xa9\xe0\xa5\x46\x4f\xdf\x42\x43\xa9\x8b\x43\x4f\x73\x32\x03\x1c\xca\x38\xce\x2e\x41\x9f\xd5\x6a\x0d\x87\xfd\xee\xc5\xdd\xb0\x33\x78\xd2\xab\xbc\xd4\xc9\xa6\xa5\xfe\x17\x00\x00\xff\xff\xfb\x6e\xae\xb9\x13\x1f\x00\x00")
+var _dcoscustomdata173T = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x59\xff\x73\xe2\x36\x16\xff\x3d\x7f\x85\xea\x76\x86\xe4\x36\xb2\x81\x7c\x6b\xc8\xb8\x33\x84\xb0\x5d\xae\x49\xc8\x00\xe9\xf5\x6e\xd3\x61\x64\xeb\x19\x54\x64\xc9\x27\xc9\x04\x9a\xee\xff\x7e\x23\xdb\x7c\x31\x81\x86\x6d\xb7\xbd\xfd\x61\x63\x9e\x3f\xef\xe9\x7d\xd7\x93\x1c\x48\x69\xc2\x98\x36\x0e\x30\x0a\x88\x1e\x23\x1c\x22\x87\x45\xe8\x23\xfa\x0a\xe1\x08\x79\x53\xa2\x3c\xce\x02\x4f\xd3\x00\x8f\x12\x83\x7e\xbe\x32\x63\x10\x08\xc2\xb1\x44\x37\xad\x6e\x1f\x9f\x7d\x7b\x59\xbd\x4a\x88\x32\x40\x11\xd6\xc8\xa3\x30\xb5\x60\x14\x4f\x38\x09\x80\x1f\x20\x34\x4a\xcc\x95\x91\x69\x38\x7e\x25\xed\x2a\x62\xce\x01\x65\x7a\x32\xd4\x60\xd2\xa4\x71\x80\x10\x24\x63\x88\x41\x11\x5e\xb5\xbf\x10\xe2\x64\x2e\x53\x93\x3f\x63\x74\x56\x2d\x3f\xc8\x29\xa8\x67\xc5\x0c\x34\x90\x51\x29\x64\x2f\x0d\x09\x38\x0c\xcd\x3c\x81\x86\x5d\xfa\x20\xd2\x0b\xe9\x18\x51\x98\xb2\x10\x1a\x6b\xab\xb8\xb5\x03\x84\x22\xc6\x41\xcf\xb5\x81\xb8\x81\x60\x66\x4e\x0f\x5e\x4b\xde\xca\x5b\xdf\x8f\x37\x96\xa9\x30\xda\xae\x8f\x37\x57\xc6\x2b\x9f\xc4\xa0\xa5\xde\xc4\xd4\xcb\x18\x2a\xc3\x09\xa8\x03\x95\x8a\x22\x64\x5e\xaa\x73\x6e\x92\x18\x8f\x24\xc6\xcd\xcd\xa0\x2e\x25\x8c\xcf\x0f\x70\x1e\xa8\x3a\xa5\xb5\x10\x6a\x17\xb8\x7a\x71\x09\xf8\xb4\x7a\x12\xe2\xe0\xe4\xac\x8e\x49\xed\xb2\x5e\x03\xa8\x57\x2f\x00\xd0\x77\xc8\xd3\x73\xed\x05\xa9\xf6\xa6\xb1\xfd\x9f\x2a\x36\x05\xa5\xbd\xf1\x74\x98\x1a\xc6\xbd\x54\x04\x4c\x50\xf4\x35\x8a\x99\x61\x23\x62\x98\x14\x28\x92\x0a\x05\xe9\x08\x8d\x8d\x49\x74\xc3\xf3\x82\x74\xa4\x5d\x4e\x52\x11\x8e\x13\x42\x5d\x01\xc6\x4b\x83\x54\x98\xd4\x7b\xa7\x65\xaa\x42\xf0\x38\x13\xe9\xcc\x7b\x17\xa4\x23\xaf\x76\x7e\x71\x7e\x7e\x72\x76\x80\x91\xb6\xc9\xc3\x90\x53\x3b\x61\x4f\x5f\x5c\xe3\x27\xe1\x20\x0f\x4c\xe8\xa9\xd0\xe5\x32\x24\xfc\x2f\x31\x01\x23\x2e\xb2\x60\x61\x9d\xfd\xf1\x02\x26\x3c\x15\xe7\xcf\x36\x4a\xc5\xef\x1d\xc8\x78\x42\x99\x2a\x83\x73\xd2\x0e\xbc\x21\x1b\x68\x43\x76\x62\xb9\x28\x43\xb9\xd8\x85\x0c\x93\x32\x32\x4c\x76\x21\xf3\x3c\x0b\x0d\x2f\x33\xac\xc8\x3b\xf8\xb2\x5a\x28\xf3\xe4\xa4\x1d\x78\xdb\x93\xca\xf0\x8c\x62\xd1\x65\x15\xb4\x91\xb9\xf2\x0a\xb4\xe4\xd3\x50\x8a\xc8\xd5\xa0\x6c\xcd\x6e\x41\x53\xa6\x6d\x9b\xd8\x9f\x61\x29\x9e\xcf\x42\x2c\xc0\xec\x29\xfb\x6d\x74\x4c\xf4\x64\x07\xd4\x93\xb6\xa8\x7f\x4d\x15\x78\xa1\x14\x86\x30\x61\xcb\x31\x51\x72\xca\x34\x93\xc2\x2d\xfc\x50\x04\x0d\x17\xb1\xcb\x32\x3d\x33\xc9\xb5\x4e\xc8\x89\x26\x4e\x4a\x44\xab\x47\x91\x9c\x38\xda\xce\xb7\x45\xf4\xa6\x94\x9d\x7c\x65\x13\xb5\x21\xca\x64\x82\x68\x28\x35\xce\x9b\x18\x66\x42\x1b\xc2\xf9\xef\xb8\x66\x83\x8f\x33\x31\xc1\x20\xa6\xfb\x73\x50\xf9\x2c\xb8\x24\x74\x7f\x8e\x6c\xa7\xd8\x1f\x6e\x0d\x66\x23\x9c\x35\x7b\xb5\x64\xcb\x7e\x0e\xb3\x4d\xc5\x36\x7d\x1b\x3b\x10\xa6\x81\x2a\x2f\x2f\x2f\xd6\x92\x1e\x24\x52\x33\x23\xd5\xfc\xb1\x77\xfb\xe9\xd3\xa7\x83\x6c\xdf\xaa\xd8\x5d\xe7\x59\x80\x6a\x20\x25\xa5\xb5\x3b\x21\x66\xdc\xc8\x5b\x57\xb6\x39\x24\x63\x50\xe0\x65\x2a\xe2\x88\x93\x91\xf6\xd4\x52\x12\x4e\x95\x75\x76\x02\x2a\x66\xda\x66\x87\x6e\xa0\x4a\xf5\xfc\xf4\xb4\x52\xd2\xe0\xba\xdb\x1d\xf4\x07\xbd\xe6\xc3\xb0\x73\xe3\xd3\xe8\xa4\xfa\x6d\x70\x1e\x85\x27\x01\xbd\xac\x41\xad\x7e\x71\x11\x10\x72\x46\x4e\x68\x70\x59\xff\x96\xc0\x45\xf5\xf2\xfc\xf4\xa2\x5e\xff\xe3\xfa\xd9\xe1\x42\x1b\x45\x12\xcc\xe8\x1e\xda\xd9\x81\x62\xd8\xbe\xff\xb1\xd3\xeb\xde\xdf\xb5\xef\x07\xfe\xcb\xcb\x8b\x21\x6a\x04\xa6\x2d\xa6\x4c\x49\x11\x83\x30\x7f\xca\x61\xd6\xfd\x98\x42\xc2\xe5\x1c\xc3\x4a\xe6\x1e\xba\x7d\x74\xd6\x23\x8e\x33\x2b\x87\x56\xe1\xef\x1f\x3b\x37\xce\x31\xca\x5f\xc7\x60\x08\x25\x86\xbc\x02\xfc\xfc\xc7\x75\x0e\x79\xaa\x0d\x28\x9c\x90\x70\x42\x46\xa0\xdd\x5f\xb4\x14\xfb\x68\xfc\x4f\x99\x2a\x41\x78\xb1\xf4\x1d\x99\xdd\xc2\x14\x78\x4b\x0a\x2d\x39\xf8\xcf\x44\x09\x26\x46\x7b\xe8\x55\xcc\x14\xde\x2f\xb9\x3c\xea\xda\xb4\x77\xa9\x67\x2d\x5e\x34\x82\xb7\x94\x79\x79\x79\x11\x24\x86\x7e\x1a\x45\x6c\x56\x0a\xe1\x76\x07\x2c\x8c\xde\x27\x6f\x9c\x27\xa1\x60\xa6\xc8\xbc\xf1\x24\x10\xe2\x72\xc4\xad\x9d\x0d\xc4\x44\x24\x2d\x25\x96\x34\xb5\xb5\x68\x9f\x11\xa2\x10\x91\x94\x9b\xa2\x0d\x15\x44\xb4\xe8\xda\xb4\xf1\x94\x69\xf6\x94\x0d\x94\x4f\xc2\x29\x6b\x98\xaf\x63\xdb\x71\xc4\x46\xee\x3c\xde\xa7\xe6\x3e\x3e\x0a\x66\x8a\x20\x5c\x43\x24\x15\xf8\x99\xe7\xf2\xcc\xce\xe9\x1f\xfb\x79\xef\x28\x60\x83\x79\x02\xbe\x14\xa0\xc7\xb2\x00\xf4\x0d\x11\x94\x28\xda\x4d\x4d\x92\x1a\xbf\x08\xc5\xbb\x30\x0f\x66\x19\xd3\x56\x4a\xaa\xed\x90\xf6\x0c\xc2\xbe\xed\x61\x0f\x0a\xfc\xd5\x0e\x6c\x27\x0d\x84\x93\xdc\xc6\x44\x49\xdb\xbb\x5c\xba\xc1\xb2\xc2\x73\x81\xb0\x8e\xf2\xfd\x69\x2d\x66\x6b\x05\xe5\xc2\x2c\x91\xca\x6c\x08\xcc\x33\x46\x8f\xb7\x06\x7f\x91\x65\xf9\x5f\x6f\x7b\xcb\xff\x3c\x6f\x37\x23\x03\xca\x17\x60\x9e\xa5\x9a\x60\x29\x38\x13\x50\x72\xfb\xbf\x88\x30\xfa\xf7\x00\x2d\x29\x28\xb3\x93\xee\x03\x31\xe3\xf6\x8c\x69\xa3\xfd\xaf\x36\xed\xde\x1a\xc2\xb5\x8e\xf5\x9e\x71\xf0\xf7\x6e\x93\xff\x8f\x04\x08\x53\xc5\x11\x8e\x6e\x75\x7f\x8a\x30\x56\x60\xd4\x1c\xd5\xab\x08\xff\x1b\xd5\xaa\xf6\x1f\xc2\x73\x74\x5e\x9c\xbd\xe4\xc6\x91\xc5\xa3\x7c\x65\x80\xf5\xae\x3b\xfb\x15\x15\x3b\xdd\xf5\x82\xbe\xbe\xd1\xbd\x95\x83\x65\xef\x6e\xf0\xac\x18\x0c\x51\x08\x93\x59\xb4\x8f\x3a\xb8\xf5\x86\xd8\x07\xa9\x8d\x8f\x97\xb2\x55\x8c\xf0\x3e\x82\xf7\x4e\xe4\x57\x93\xc8\xe7\x25\x72\x0f\xfe\x9b\x32\x05\xda\xdf\x2e\x6c\x2d\xd9\x7f\x07\xf0\xb7\xf5\x98\xcd\xcc\x2f\x7b\xde\x5b\xdf\x77\x37\x83\xbb\x01\xb5\x71\x4e\x26\xa3\xc4\xf6\x35\x94\x55\x0c\xc2\x58\x48\x1c\x70\x19\x4e\x70\xe1\xe8\xc2\xba\x4e\x3e\x4f\x16\xd6\xd9\xca\x06\x7a\x3d\xf7\xe3\x94\x1b\x86\x53\x0d\xaa\x54\xd8\x7b\xc4\xac\x3c\x0b\xfe\xdd\x9d\xe7\xcd\x68\xad\x35\x18\xff\xa6\x7d\xdd\x69\xde\x0f\xdf\xf7\xba\xf7\x83\xf6\xfd\x8d\x2f\xa4\x60\xc2\x80\x22\xa1\x61\x53\xf8\xb2\xd1\x7d\x5d\x89\xcb\x7b\x23\xa3\xe6\x7e\xed\x2a\x15\x86\x71\x44\x93\xc9\x08\xe1\x9b\x13\x7b\xae\x7f\x5d\x48\xd4\xa5\x10\xa0\xdf\x7e\x43\x87\x87\x46\xcd\xbf\xbb\x3c\x3a\xba\xa2\x32\x53\x21\x3b\xfe\xe7\x1d\xe8\x9b\xec\xe5\xbb\x77\x47\x47\x57\x9a\x03\x24\x05\xe1\x1f\x46\xcd\x33\xbc\x80\xab\xe5\x74\x9e\xe5\xc5\x73\x3e\x9f\xa3\x7c\x6b\x5f\xbd\xcc\x04\x2b\x58\x7f\xe9\x6a\x8b\x31\xce\x67\x54\xf0\xd6\x53\xcb\x1e\x69\x51\x0e\x64\x2f\x57\xc3\x27\xfc\x99\xcc\xf5\xd2\xed\xca\xdc\xb2\x98\x99\x8e\x8d\xda\x94\x70\xbf\x5a\x42\xf7\x21\xf4\x6b\x67\x9b\x01\xd8\x19\x90\xdc\x44\x44\x09\xc4\x52\x20\xfc\x01\x45\xb4\xe1\x79\x08\x63\x6d\xa4\x22\x23\xc0\xf9\xd5\x8e\x6f\x2f\xaa\x38\x99\xef\xe5\x83\xc2\x6b\xb9\x2d\x2e\xf5\x60\x06\x61\xa6\xdb\xbe\x13\xe0\x7a\x79\x3c\x10\x65\xba\x91\xbf\x08\x45\xb9\x4b\x59\xe2\x02\x78\xcb\xb4\x01\xd1\x37\x0a\x48\xec\x67\x69\xa4\xd2\x85\x7d\x59\x08\x0b\x07\x66\x3c\x77\x92\x82\x5f\x3d\x3f\x2f\x7c\x97\x13\x1f\x35\x28\x5f\xc9\x65\x8f\xcb\x88\xdf\x2b\x99\x26\xc5\xf2\x5b\x16\xaa\x9f\x5c\x14\xce\xbe\x66\x82\x76\x1e\xa6\xe7\x5d\xc1\xe7\x7e\x20\x4d\x31\xba\xec\x6a\x36\x56\x23\x30\xe5\xd1\x6e\x3f\xbf\x66\x8c\x7f\x6a\x57\x28\xb7\xab\xcd\x2d\x61\xcb\xdb\xcf\xe9\x30\xab\x11\xe6\x75\xf7\xfe\x13\xed\xfe\x55\x07\xc9\xaa\x1f\x03\x7a\x72\x6a\xf5\x0b\xb7\xea\x56\xdd\x1a\xca\xee\xec\xc6\x52\x9b\x27\xf1\xcd\x21\x05\x03\xa1\x19\xb2\xe4\x08\x7d\x73\x68\x89\xf6\x54\x71\xf4\x64\xc7\x74\x64\xaf\x30\xad\x8a\x96\xac\xf7\xaf\xec\xed\x87\xf8\xb7\x43\x71\xd7\xee\x77\xfb\xc3\xd6\xed\x63\x7f\xd0\xee\xd9\xa3\x6a\x4c\xec\x39\xed\x21\x0d\x38\x0b\x3b\x0f\x4d\x4a\x15\x68\x7d\x4f\x62\xd8\xe3\xbc\x93\xc5\x67\x79\xc4\xcb\x15\xcb\x6e\x7a\x28\x28\x9c\xdd\x01\x15\x47\xca\x15\x23\xce\xd7\x5b\xc2\x4a\xca\x15\x09\x70\x73\xd7\xb9\xef\x75\x1f\x07\xed\xde\xb0\xd9\x1a\x74\x7e\x6c\x0e\xda\xc3\xe6\xe3\xe0\xc3\xf0\xae\x7b\xf3\x78\xdb\xb6\x5a\x4b\x92\x9a\x71\x5b\x64\x87\x9f\x2f\xae\x28\xa1\x31\x13\x4a\xa6\xd6\xb3\x20\xa6\x25\x15\x3f\x3a\x95\xca\x71\xf6\x11\xa1\xf5\xd8\x1f\x74\xef\x6e\x9a\x83\xe6\xc3\xe3\xf5\x6d\xa7\xd5\x79\xe8\x0f\x7a\x95\x8a\xf3\xf3\x97\x55\x26\xf7\xd7\x90\x33\x6d\x4a\x8a\xb4\x7f\xfa\xd0\xb9\xee\x0c\xba\xbd\xe1\x75\xb3\xf5\x83\xdd\x3f\x9b\xff\x79\xec\xb5\x0b\x17\xda\xc7\x61\xab\x7b\x3f\x68\x76\xee\xdb\xbd\xbc\x9a\x60\x36\x66\x81\xbd\x77\x59\xc7\x3c\xf4\xda\xef\x3b\x3f\xfd\xbd\x89\xb0\x52\x64\xdd\xa0\x50\xc6\xf6\xde\x3a\xe2\x6c\xe6\x2e\x11\x6e\xe6\x0f\x97\x84\xa1\xbd\x6f\xc5\xb6\x6e\x56\xba\xf6\xf3\x8d\xa1\x99\xbf\x2c\xa9\xba\x8f\xb0\x09\xcc\x7d\x1b\x4c\xeb\xda\x1f\x60\xae\x0f\xed\x2d\xa1\xbd\xee\xef\xd0\xc3\x4a\xe5\x8e\x85\x4a\x6a\x19\x19\xb7\x58\xc6\xd3\xa5\xe5\x74\xa5\x72\x9c\x2d\x35\x25\x8a\xd9\x3c\xd4\x87\x95\xca\x2e\xbd\x2a\x95\xa3\xa3\x63\x54\xa9\xd4\xab\xb5\x33\x5c\x3d\xc7\xb5\xb3\x4a\xe5\xc8\x9d\xc0\xbc\x76\x5c\xa9\xfc\x45\xce\x75\x13\x25\x13\x50\x86\x81\x2e\xf9\xf9\xc5\x49\x59\x2b\xeb\x1e\xa9\xca\x3e\x26\x38\x8d\x17\x27\xe1\xe9\x88\x09\x6d\x1f\x03\x22\x04\x28\xfb\x04\x79\x7d\x39\x8d\x88\x70\x0d\xc7\x4e\x40\xc2\xc9\x48\xc9\x54\xd0\x96\xe4\x52\x39\x0d\xe7\xeb\x5a\xbb\x7e\x52\x7f\xef\x1c\x3b\xf6\x86\x60\xe3\xdd\xfb\xec\x9f\x73\xec\x8c\x81\x50\x50\x03\x66\x38\x38\x0d\x91\x72\xbe\x20\xb5\xf2\xb2\x5a\x10\x23\x29\xcd\x2b\x22\x8b\xc9\x08\xec\x41\x76\x41\xa0\x4c\x67\x9b\x4d\xb0\x90\xf6\xe9\xd8\x09\x14\x11\x94\x89\xd1\x6b\xad\x3f\x1d\x3b\x30\x33\x60\x47\x42\xcc\x99\x98\x68\x27\xff\x2c\xb7\x86\x43\x05\xf0\x20\x0f\x84\x63\x5b\x0b\x08\xc3\xc2\xa5\x77\x36\x45\x2e\x90\x59\x17\x2a\x01\x5e\xb7\xa6\xe3\x4c\xde\x07\xa9\x8d\xd3\x70\x16\x9f\x6b\x6c\x3d\xba\x96\x5e\x75\x43\x19\x3b\x99\xc4\x5c\x64\x31\x5c\xef\xb2\x45\xaa\x11\x11\xec\xd7\x9d\x9a\x39\x46\x91\x70\x07\xf7\x17\xaf\xe3\x94\x15\xdb\x50\x7e\xb9\x57\x4a\xb2\x4f\x5f\x62\x91\x64\x32\xb2\xd7\x61\xaf\xc5\xef\x16\xae\x24\x07\x9d\x7f\x7f\x28\x71\x3c\xf4\xba\x3f\x76\xfa\x9d\xee\xfd\xd0\x76\xe9\x25\xbb\xf3\xf6\xe7\x0a\x67\x73\x57\x75\xaa\x17\xa7\xa7\xce\xea\xee\xd1\xb1\x83\x9a\x73\x80\x17\x1a\x2d\xce\x0d\xd6\x7b\xc5\xa6\xa7\x39\x99\x02\x0e\x65\x1c\x67\x97\xa0\x2b\xb5\x9a\x83\x41\xaf\x73\xfd\x38\x68\xf7\x97\x7a\x95\x97\x3a\xdf\xb6\xd4\xff\x06\x00\x08\xe9\xd1\xa7\xff\x1e\x00\x00")
 
 func dcoscustomdata173TBytes() ([]byte, error) {
 	return bindataRead(
@@ -367,7 +368,7 @@ func dcoscustomdata173T() (*asset, error) {
 	return a, nil
 }
 
-var _dcoscustomdata184T = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x59\x7d\x73\xe2\xb6\xd6\xff\x3f\x9f\x42\x75\x3b\xc3\xe6\xd9\xc8\x36\xe4\xad\x4b\xc6\x9d\x21\x84\xed\xf2\x34\x09\x19\x20\xbd\xbd\x77\xb7\xc3\x08\xeb\x00\x2a\xb2\xe4\x2b\xc9\x2c\x34\xdd\xef\x7e\x47\x96\x21\x98\x40\xc2\xf6\xa6\xbd\x99\xd9\x8d\x73\x7c\x8e\xf4\xd3\x79\x3f\xf2\x50\x4a\x13\x27\xb4\x7e\x80\xd1\x90\xe8\x09\xc2\x31\xf2\xd8\x08\x7d\x44\xdf\x20\x3c\x42\xc1\x8c\xa8\x80\xb3\x61\xa0\xe9\x10\x8f\x53\x83\x7e\xbd\x30\x13\x10\x08\xe2\x89\x44\x57\xcd\x4e\x0f\x9f\x7e\xff\x2e\xbc\x48\x89\x32\x40\x11\xd6\x28\xa0\x30\xb3\xcc\x28\x99\x72\x32\x04\x7e\x80\xd0\x38\x35\x17\x46\x66\xf1\xe4\xc9\x6a\x17\x23\xe6\x1d\x50\xa6\xa7\x03\x0d\x26\x4b\xeb\x07\x08\x41\x3a\x81\x04\x14\xe1\xa1\xfd\x0b\x21\x4e\x16\x32\x33\xee\x19\xa3\xd3\xb0\xfc\x20\x67\xa0\x3e\x2b\x66\xa0\x8e\x8c\xca\x20\xa7\x19\x32\xe4\x30\x30\x8b\x14\xea\x76\xeb\x83\x91\x5e\xae\x8e\x11\x85\x19\x8b\xa1\xbe\xb6\x8b\x5f\x3d\x40\x68\xc4\x38\xe8\x85\x36\x90\xd4\x11\xcc\xcd\xc9\xc1\xd3\x95\xb7\xca\xd6\xf6\x93\x4d\x64\x26\x8c\xb6\xfb\xe3\xcd\x9d\xf1\xa3\x4e\x12\xd0\x52\x6f\xf2\xd4\xca\x3c\x54\xc6\x53\x50\x07\x2a\x13\x85\xc9\x82\x4c\xbb\x37\x24\x35\xf6\x9f\xef\xa0\x50\x9f\x12\xc6\x17\x07\xd8\x19\xaa\x46\x69\x35\x86\xea\x39\x0e\xcf\xdf\x01\x3e\x09\x8f\x63\x3c\x3c\x3e\xad\x61\x52\x7d\x57\xab\x02\xd4\xc2\x73\x00\xf4\x03\x0a\xf4\x42\x07\xc3\x4c\x07\xb3\xc4\xfe\x4f\x15\x9b\x81\xd2\xc1\x64\x36\xc8\x0c\xe3\x41\x26\x86\x4c\x50\xf4\x2d\x4a\x98\x61\x63\x62\x98\x14\x68\x24\x15\x1a\x66\x63\x34\x31\x26\xd5\xf5\x20\x18\x66\x63\xed\x73\x92\x89\x78\x92\x12\xea\x0b\x30\x41\x36\xcc\x84\xc9\x82\xb7\x5a\x66\x2a\x86\x80\x33\x91\xcd\x83\xb7\xc3\x6c\x1c\x54\xcf\xce\xcf\xce\x8e\x4f\x0f\x30\xd2\xd6\x79\x18\xf2\xaa\xc7\xec\xd3\xab\x23\xfe\x24\x3c\x14\x80\x89\x03\x15\xfb\x5c\xc6\x84\xff\x25\x47\xc0\x88\x8b\xdc\x58\x58\x3b\x9b\x0d\x99\x08\x54\xe2\x9e\xad\x95\x8a\xbf\x77\x70\x26\x53\xca\x54\x99\xd9\x91\x76\xf0\x1b\xb2\xc1\x6d\x09\x3b\x78\x0b\xd2\x8a\x95\x8b\x5d\x9c\x71\x5a\xe6\x8c\xd3\x5d\x9c\xce\xcf\x62\xc3\xcb\x02\x8f\xe4\x5d\xe7\xb4\xb1\xb0\x71\xce\x9c\xb4\x83\xdf\xe6\xa4\x32\x7b\x4e\xd9\xc2\x6d\xdf\x6b\xcb\x90\x69\x50\x84\xd2\xb2\xd4\x92\x68\x05\xcb\xd8\x29\xd3\x36\x63\xb8\xb5\xb0\x90\x9f\xf3\x27\x05\x5a\xf2\x59\x2c\xc5\xc8\xd7\xa0\x6c\xe4\x6f\x11\x4d\x88\x9e\x6e\xc8\xf1\x79\x8c\x05\x98\x35\xa1\x40\xda\xd0\xfc\x3d\x53\x10\xc4\x52\x18\xc2\x84\x75\xd1\x54\xc9\x19\xd3\x4c\x0a\xbf\x38\x4d\xa1\x7a\x5c\x58\x20\xf7\xd7\x1c\x83\x6f\x41\x38\xa2\x49\xd2\x12\xd1\xca\x15\x2e\x86\x47\xdb\xe5\xb6\x2c\xbd\xb9\xca\x4e\xb9\xf2\x61\xb5\x21\xca\x59\x8e\xc6\x52\x63\x97\x8a\x30\x13\xda\x10\xce\x9f\x51\xd2\x86\x9c\x5d\x9d\x8d\x71\x9e\x1f\xd5\x33\x62\x0a\x1e\x05\x8b\xa4\x86\x7f\x93\x99\x12\x84\xd3\x3d\xc5\x1c\xc4\xfd\xa1\x71\x26\xa6\x18\xc4\xec\x19\x09\x10\x2b\x5f\xc9\x45\xf2\xca\xf2\x0c\xbf\x75\x0c\x3c\xe4\x32\x9e\x6e\xdb\xb0\x2c\x9d\xab\x64\x90\xd7\x13\x9b\xda\xad\xb3\x80\x30\x75\x54\x59\x66\x26\x2b\xc3\xa4\x9f\xfb\x12\xd0\x31\xe4\xb9\xc9\x12\x03\x03\xda\x30\x31\x3e\xc8\x0b\x60\xc5\x96\xa0\xcf\x02\x54\x1d\x29\x29\xed\x76\x29\x31\x93\xba\xb3\x71\x5e\x65\xd2\x09\x28\x08\xf2\xdd\xf1\x88\x93\xb1\x0e\x14\xa4\x52\x33\x23\xd5\x02\x67\xca\x42\x4f\x41\x25\x4c\x5b\x07\xd5\x75\x54\x09\xcf\x4e\x4e\x2a\x25\x4c\xb6\xf6\x0f\x5a\xb7\x3f\xb7\xbb\x9d\xdb\x9b\xd6\x6d\x3f\x7a\x78\x78\x30\x44\x8d\xc1\xb4\xc4\x8c\x29\x29\x12\x10\xe6\xcb\x97\x2f\x7f\x1e\x92\xf3\x32\x48\xb9\x5c\x58\xa3\x2c\xd7\xdc\x03\xdb\x65\xa7\xd3\xef\xf5\xbb\x8d\xbb\x41\xfb\x2a\x3a\x1d\x9e\x10\x72\x72\x7c\x56\x0d\xe3\xd3\x73\x80\x2a\x3d\x0b\x2d\x25\x3c\x3f\xad\x92\xea\x68\x78\x7e\xfa\x7d\xed\x24\x0e\xbf\x3f\xfe\xf3\x40\x6d\x07\xa5\x8d\x22\x29\x66\x74\x0f\x74\x1f\xbd\xf5\x38\x70\x3e\x30\xb0\xea\xfc\xf1\xbe\x7d\xe5\x1d\x21\xf7\x3a\x01\x43\x28\x31\xe4\x09\xc3\xaf\x7f\x1e\x68\xcc\x33\x6d\x40\xe1\x94\xc4\x53\x32\x06\xed\xff\xa6\xa5\xd8\x07\xf1\xff\xbb\xb8\x2b\xb6\xbe\x21\xf3\x6b\x98\x01\x6f\x4a\xa1\x25\x87\xe8\x33\x51\x62\xe5\x7c\x5d\x62\xe0\x9a\x25\xcc\xb4\x85\x01\x35\x23\x3c\xaa\xea\x8d\x37\x97\x99\xd2\x26\xaa\x85\x61\x18\xee\x71\x96\x22\xf6\x83\x55\xec\xe7\x39\x99\xe6\xee\xb1\xcc\x5f\x2f\x1c\xc0\x53\x30\x57\x64\x51\xff\x24\x10\xe2\x72\xcc\x2d\xf8\x3a\x62\x62\x24\x2d\x25\x91\x34\xb3\x21\x67\x9f\x11\xa2\x30\x22\x19\x37\x98\xd0\x84\x89\x82\x86\xd0\x44\x6a\x53\xff\x94\x83\xfd\x84\x4c\x9c\xd6\x83\xa0\x5a\x3b\xf7\x43\x3f\xf4\xab\xf5\xb3\x6a\x18\x1e\x97\xa5\x5d\xe6\x59\x89\x17\x25\x86\xba\x7e\xf0\x93\xf0\xca\x47\x74\xf0\x02\xe7\x11\xfe\x22\xd9\x27\xfe\x3e\xde\x0b\x66\x0a\x83\x34\x46\x06\x54\x24\xc0\x7c\x96\x6a\x8a\xa5\xe0\x4c\x80\xef\x82\xd1\x31\xfc\x83\x08\xa3\x9f\x63\xf8\xd8\x73\x39\xa8\x58\xb0\xbf\x48\x21\x92\x02\xf4\x44\x16\x0c\x6b\x41\x1d\x5d\xb5\x2e\xdb\x8d\xdb\xc1\xfb\x6e\xe7\xb6\xdf\xba\xbd\x8a\x84\x14\xcc\x1a\x9b\xc4\x86\xcd\xc0\xf1\xf7\x0c\x11\x94\x28\xda\xc9\x4c\x9a\x99\xa8\x30\xde\xdb\xd8\xb9\x4c\x99\xa7\xa5\x94\x54\xdb\x59\x5a\x73\x88\x7b\x36\x67\x46\xa5\xf2\x9f\x0f\x29\x46\x2d\xa2\xea\x45\x26\x0c\xe3\x88\xa6\xd3\x31\xc2\x57\xc7\xb6\x89\x2c\x37\xd4\x01\xe5\x01\xf5\x29\x0c\xd1\x1f\x7f\xa0\x37\x6f\x8c\x5a\xfc\xf0\xee\xf0\xf0\x82\xca\x7c\xfd\xbc\xd7\x54\x60\xd4\x02\x7d\x97\xbf\x7c\xfb\xf6\xf0\xf0\x42\x73\x80\xb4\x20\xfc\x9f\x51\x8b\x9c\x5f\xc0\xc5\x2a\xb5\xbb\x7a\xef\xb2\x79\x51\x65\x2e\xd6\xf3\x3e\x5a\x96\xa1\x55\x09\xb2\xbf\x8c\xf7\xe8\xee\x5b\x1c\xdc\xfd\x0e\x9e\x2b\xae\x7b\xb8\x45\xd9\x90\x5d\x07\x23\x22\xfc\x33\x59\xe8\x95\xda\x95\x29\x87\x68\x58\xe2\xee\x41\x1c\x55\x4f\x37\x0c\x70\xa7\x20\xc2\xae\xbf\x62\x29\xb2\x95\x12\x51\xe0\xc5\xf9\xc2\x4d\x6b\xed\xb4\x9e\xe3\x47\x94\x40\x22\x05\xc2\x1f\xd0\x88\xd6\x83\x00\x61\xac\x8d\x54\x64\x0c\xd8\xb5\xf0\x91\x1d\xa1\x38\x59\xec\xa5\xb0\x52\x95\xf7\x69\x00\x73\x88\xf3\x83\xec\x99\x1e\x4a\xb1\x74\x47\x94\xe9\x8c\xa2\x8d\xd6\xa1\x08\x92\xdc\x8a\x05\xe3\x35\xd3\x06\x44\xcf\x28\x20\x49\x94\xfb\x9c\xca\x44\xb0\x66\xef\x42\xdb\xb9\xcc\x8d\xa4\x10\x85\x67\x67\xe1\x3a\xf1\x5e\x83\x8a\xf2\x84\xb7\x46\xfc\x51\xc9\x2c\x2d\xb6\xdf\xb2\x51\xed\xf8\xbc\xb0\xcc\x25\x13\xb4\x7d\x37\x3b\xeb\x08\xbe\x88\x86\xd2\x4c\x0a\x90\x6d\xe7\x33\xbf\x3e\x46\x3e\xd0\xcb\x45\xe4\x3c\x50\x97\xa2\x7e\x3f\xbd\xe6\x82\x5f\xa9\xc5\x2e\xfc\x3b\x63\x0a\x74\xb4\xa5\xcd\x59\x4b\x59\xbb\xde\x7e\x4d\x3a\x7a\xcf\x38\x44\x39\xfe\xf5\x26\x61\x3b\x97\xed\xc4\xd7\x6a\xe3\x53\x81\x67\xd2\x4d\x9e\x2a\xbe\x7b\x43\xc1\x40\x6c\x06\x2c\x3d\x44\xdf\xbd\xb1\x65\x41\x90\x04\x0e\xed\x28\x6a\x21\x58\x82\xde\x3f\xcc\xb7\xf7\xc2\x2f\xab\xfa\xa6\xd5\xeb\xf4\x06\xcd\xeb\xfb\x5e\xbf\xd5\xb5\x9d\x57\x42\x6c\x61\xbf\xcb\x86\x9c\xc5\xed\xbb\x06\xa5\x0a\xb4\xbe\x25\x09\x94\x3a\xb0\xe7\x3a\x84\x65\x4f\xe0\x80\xe5\xd3\x09\x05\x85\xf3\x5e\xb3\xe8\x41\x1e\x05\xb1\xdb\x6f\xc5\x56\x02\xd7\xb8\xba\x69\xdf\x76\x3b\xf7\xfd\x56\x77\xd0\x68\xf6\xdb\x3f\x37\xfa\xad\x41\xe3\xbe\xff\x61\x70\xd3\xb9\xba\xbf\x6e\x59\xbc\x92\x64\x66\xd2\xca\x7b\x69\xfa\xea\x10\xf3\xd2\xad\x64\x66\x75\x0a\x62\xb6\xd1\x7c\x55\x2a\x47\xf9\xc5\x55\xf3\xbe\xd7\xef\xdc\x5c\x35\xfa\x8d\xbb\xfb\xcb\xeb\x76\xb3\x7d\xd7\xeb\x77\x2b\x95\xf5\xfe\xea\x55\xf4\x95\x6b\x6a\xc0\x99\x36\x25\x20\xad\x5f\x3e\xb4\x2f\xdb\xfd\x4e\x77\x70\xd9\x68\xfe\x64\xcb\x68\xe3\x5f\xf7\xdd\x56\x11\x1d\xf6\x71\xd0\xec\xdc\xf6\x1b\xed\xdb\x56\xd7\xc5\x09\xcc\x27\x6c\x68\x5b\xf4\x75\x9e\xbb\x6e\xeb\x7d\xfb\x97\xbf\xd7\x05\x1e\x81\xac\x1f\x28\x96\x89\x9d\x47\x46\x9c\xcd\xfd\x15\x87\x9b\x55\x7c\x12\xc7\x76\xc6\xc7\x36\x56\x1e\xb1\xf6\x5c\xca\x6f\xb8\x97\xad\xa5\x4c\x09\xf3\x3e\xab\x4e\x61\x11\x59\xab\x5a\x1d\xff\x04\x0b\xfd\xc6\xce\xb1\x99\x8a\xa1\x4d\xdf\x54\x2a\x37\x2c\x56\x52\xcb\x91\xf1\x8b\xfd\x02\x5d\xda\x57\x57\x2a\x47\xf9\x56\x33\xa2\x98\x75\x48\xfd\xa6\x52\x79\x11\x60\xa5\x72\x78\x78\x84\x2a\x95\x5a\x58\x3d\xc5\xe1\x19\xae\x9e\x56\x2a\x87\xfe\x14\x16\xd5\xa3\x4a\xe5\x2f\x52\xb7\x9f\x2a\x99\x82\x32\x0c\x74\x49\xf3\x0f\x5e\xc6\x9a\x79\x26\xc9\x54\x7e\xa5\xe5\xd5\x1f\xbc\x94\x67\x63\x26\xb4\x7d\x1c\x12\x21\x40\xd9\x27\x37\xbd\x52\xaf\x3e\x22\x5c\xc3\x91\x37\x24\xf1\x74\xac\x64\x26\x68\x53\x72\xa9\xbc\xba\xf7\x6d\xb5\x55\x3b\xae\xbd\xf7\x8e\xbc\x91\x54\xb0\xf1\xee\x7d\xfe\xe3\x1d\x79\x13\x20\x14\x54\x9f\x19\x0e\x5e\x5d\x64\x9c\x2f\x49\x4d\x07\x6a\x49\x1c\x49\x69\x9e\x10\x59\x42\xc6\x70\x47\xcc\x64\x49\xa0\x4c\xe7\xd9\x6e\xb8\x5c\xed\xcb\x91\x37\x54\x44\x50\x26\xc6\x4f\x51\x7f\x39\xf2\x60\x6e\xc0\xf6\x8a\xf9\xc0\xae\x3d\x77\x39\xbc\xc6\x87\x0a\xc6\x03\x67\x08\xcf\x26\x1b\x10\x86\xc5\x2b\xed\x6c\x2e\xb9\xe4\xcc\xf3\x52\x89\xe1\x69\xb2\x3a\xca\xd7\xfb\x20\xb5\xf1\xea\xde\xfa\x68\xee\x5b\x7a\xe8\xc7\x32\xf1\xf2\x15\xdd\x92\x46\x91\x78\xba\xfd\x24\xaf\x1e\x99\x19\x2b\x4a\x8a\x9b\xec\x4a\x4e\xf2\xe5\x35\x36\x49\xa7\x63\x3b\x36\x3d\x5d\x7e\xbd\xf8\x5f\x82\xf5\x9d\x3c\x69\x7d\xdd\x90\xf1\xda\x43\x83\xed\x59\xcb\xb7\xa9\x08\xa7\xee\xf4\xa9\x92\x23\xc6\xc1\xa7\x3b\x0b\x3f\x17\x08\xeb\x11\x7a\xa6\x67\xf0\x61\x9e\x4a\x65\x36\x16\x74\xae\xa0\x27\x7b\x37\x01\x4f\x6e\x9d\xbe\xae\xd5\xba\x02\x1d\x2b\x96\x5a\xcf\x8e\xee\xa6\xe3\xd4\xaa\xa7\x8e\xae\xe4\x67\xc1\x25\xa1\xe8\xaa\x19\x74\x7a\xc8\x48\x64\x26\x4c\xe7\x73\xac\xff\x5a\x43\x63\x53\x0a\xca\xec\xbe\x36\x98\x5b\x73\xa6\x8d\x8e\xbe\xd9\xd4\xd7\x56\xd3\x6f\x6d\xdf\xf6\xb9\x5d\xf9\x5f\x38\x4e\x9c\x29\x3b\xf0\x4d\x01\x52\xc2\xd9\x0c\xb0\x61\x09\xa0\x1a\xc2\xa3\x6b\xdd\x9b\x21\x8c\xdd\xfc\x58\x0b\x11\xfe\x27\xaa\x86\xf6\x07\xe1\x05\x3a\x2b\x3e\x55\xc9\x2d\x03\xe9\xea\x4c\x56\x9f\xfe\xfc\x77\xf4\xf0\xf0\x60\x7d\xe1\x72\x49\xbf\xef\x5e\xaf\x92\xc3\x4b\xee\x5c\x56\xf8\x4e\x7f\x36\x44\x21\x4c\xe6\xa3\x7d\xe0\xe0\xe6\x0b\xcb\xde\x49\x6d\x22\xbc\xf6\x59\xa3\xf4\xad\x70\xe7\xc2\x5f\x31\xff\x3a\xf7\x7d\xd5\x98\xe8\xa5\x10\x33\xc2\xd9\xef\x50\x44\xc5\x48\xaa\x27\x61\x51\x9e\x5c\x9e\xe0\xd8\x1c\x5e\xb6\x33\xfc\x6d\x99\xee\xbf\x18\x70\x36\x58\xad\x19\xd3\x42\x55\x28\x8f\xbf\xb5\xbb\x6b\x5c\xd8\xe8\xf9\x11\x33\xc9\xb8\x61\x38\xd3\xa0\xbe\x66\xca\x7c\x3a\x02\xbe\x6c\xeb\xdd\xa5\x4c\x49\x0e\xda\x7d\x6d\x29\x49\xdc\x75\x3b\x3f\xb7\x7b\xed\xce\xed\xc0\x76\xf9\x2b\x71\xef\xe5\x8f\x33\xde\x26\x1e\x2f\x3c\x3f\x39\xf1\x1e\xaf\x2c\x3d\x3b\xc2\x7b\x07\x78\x89\x68\xf5\xad\x36\x96\xba\x18\x97\x34\x27\x33\xc0\xb1\x4c\x92\xfc\xbe\x75\x6d\x56\xea\xf7\xbb\xed\xcb\xfb\x7e\xab\xb7\xc2\x55\xde\xea\x6c\xcb\x56\xff\x09\x00\x00\xff\xff\xd1\xeb\x1c\xe6\xb2\x1f\x00\x00")
+var _dcoscustomdata184T = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x59\x6d\x73\xe2\xb6\xf6\x7f\x9f\x4f\xa1\xba\x9d\x21\xf9\x6f\x64\x1b\xf2\xd4\x25\xe3\xce\x10\xc2\x76\xf9\x37\x09\x19\x20\xbd\xbd\x77\xb7\xc3\x08\xeb\x00\x2a\xb2\xe4\x2b\xc9\x04\x9a\xee\x77\xbf\x23\xd9\x24\x98\x40\xc2\xf6\xa6\xbd\x7d\xd1\x75\xa4\xdf\x39\x3a\x3a\xcf\x47\x0c\xa5\x34\x71\x42\xeb\x7b\x18\x0d\x89\x9e\x20\x1c\x23\x8f\x8d\xd0\x27\xf4\x0d\xc2\x23\x14\xcc\x88\x0a\x38\x1b\x06\x9a\x0e\xf1\x38\x35\xe8\xd7\x73\x33\x01\x81\x20\x9e\x48\x74\xd9\xec\xf4\xf0\xc9\xf7\xef\xc3\xf3\x94\x28\x03\x14\x61\x8d\x02\x0a\x33\x0b\x46\xc9\x94\x93\x21\xf0\x3d\x84\xc6\xa9\x39\x37\x32\x8b\x27\xcf\xb8\x9d\x8f\x98\xb7\x47\x99\x9e\x0e\x34\x98\x2c\xad\xef\x21\x04\xe9\x04\x12\x50\x84\x87\xf6\x2f\x84\x38\x59\xc8\xcc\xe4\xdf\x18\x9d\x84\xe5\x0f\x39\x03\x75\xaf\x98\x81\x3a\x32\x2a\x03\xb7\x69\xc8\x90\xc3\xc0\x2c\x52\xa8\xdb\xa3\xf7\x46\x7a\xc9\x1d\x23\x0a\x33\x16\x43\x7d\xe5\x14\xbf\xba\x87\xd0\x88\x71\xd0\x0b\x6d\x20\xa9\x23\x98\x9b\xe3\xbd\xe7\x9c\x37\xd2\xd6\x76\xa3\x4d\x64\x26\x8c\xb6\xe7\xe3\xf5\x93\xf1\x93\x4e\x12\xd0\x52\xaf\x63\x6a\x65\x0c\x95\xf1\x14\xd4\x9e\xca\x44\x61\xb2\x20\xd3\x39\x35\x49\x4d\x40\x52\xe3\xe7\xd7\xa0\x3e\x25\x8c\x2f\xf6\x70\x6e\xa8\x1a\xa5\xd5\x18\xaa\x67\x38\x3c\x7b\x0f\xf8\x38\x3c\x8a\xf1\xf0\xe8\xa4\x86\x49\xf5\x7d\xad\x0a\x50\x0b\xcf\x00\xd0\x0f\x28\xd0\x0b\x1d\x0c\x33\x1d\xcc\x12\xfb\x7f\xaa\xd8\x0c\x94\x0e\x26\xb3\x41\x66\x18\x0f\x32\x31\x64\x82\xa2\x6f\x51\xc2\x0c\x1b\x13\xc3\xa4\x40\x23\xa9\xd0\x30\x1b\xa3\x89\x31\xa9\xae\x07\xc1\x30\x1b\x6b\x9f\x93\x4c\xc4\x93\x94\x50\x5f\x80\x09\xb2\x61\x26\x4c\x16\xbc\xd3\x32\x53\x31\x04\x9c\x89\x6c\x1e\xbc\x1b\x66\xe3\xa0\x7a\x7a\x76\x7a\x7a\x74\xb2\x87\x91\xb6\xce\xc3\x90\x57\x3d\x62\x9f\xdf\x5c\xe2\xcf\xc2\x43\x01\x98\x38\x50\xb1\xcf\x65\x4c\xf8\x5f\x72\x05\x8c\xb8\x70\xc6\xc2\xda\xfd\x13\x0c\x99\x08\x54\x92\x7f\x5b\x2b\x15\x7f\x6f\x41\x26\x53\xca\x54\x19\x9c\x2f\x6d\xc1\x1b\xb2\x86\x36\x64\x2b\x96\x8b\x32\x94\x8b\x6d\xc8\x38\x2d\x23\xe3\x74\x1b\x32\xf7\xb3\xd8\xf0\x32\xc1\xd3\xf2\x16\x3a\x17\x0b\x65\x9a\x7c\x69\x0b\xde\xe6\xa4\x32\xdc\xad\x6c\x40\xdb\x7d\x6d\x01\x99\x06\x45\x28\x2d\x53\x2d\x17\x2d\x61\x59\x76\xca\xb4\xcd\x18\x0e\x8e\xb1\x90\xf7\xee\x4b\x81\x96\x7c\x16\x4b\x31\xf2\x35\x28\x1b\xf9\x1b\x48\x13\xa2\xa7\x6b\x74\x7c\x1e\x63\x01\x66\x85\x28\x90\x36\x34\x7f\xcf\x14\x04\xb1\x14\x86\x30\x61\x83\x2a\x55\x72\xc6\x34\x93\xc2\x2f\x6e\x53\xa8\x1e\x17\x16\x70\xfe\xea\x64\xf0\xad\x10\xf9\xa2\x49\xd2\xd2\xa2\x95\xa8\x70\x31\x3c\xda\x4c\xb7\x81\xf5\x3a\x97\xad\x74\xe5\xcb\x6a\x43\x94\x71\x8c\x68\x2c\x35\xce\x53\x11\x66\x42\x1b\xc2\xf9\x0b\x4a\x5a\xa3\xb3\xdc\xd9\x18\xbb\xfc\xa8\x5e\x20\x53\xf0\x44\x58\x24\x35\xfc\x9b\xcc\x94\x20\x9c\xee\x48\x96\x8b\xf8\x02\x78\x05\x6a\xaf\xc4\x99\x98\x62\x10\xb3\x17\x28\x40\x3c\xfa\x8a\xbb\x8d\xab\x2c\x2f\xe0\xad\x43\xe1\x21\x97\xf1\x74\xd3\x81\x65\x6a\xa7\x92\x81\xab\x45\xb6\x56\x58\x67\x01\x61\xea\xa8\xf2\xf0\xf0\x60\xe1\x5d\x48\xa5\x66\x46\xaa\xc5\x5d\xf7\xea\xcb\x97\x2f\x7b\xae\xdc\x55\x6c\xb1\xba\x17\xa0\xea\x48\x49\x69\x99\xa7\xc4\x4c\xea\x79\xc6\x73\x35\x25\x9d\x80\x82\xc0\x9d\x85\x47\x9c\x8c\x75\xa0\x1e\x39\xe1\x4c\x59\xeb\xa6\xa0\x12\xa6\xad\x3b\xea\x3a\xaa\x84\xa7\xc7\xc7\x95\x92\x04\xb6\xd2\x0f\x5a\x37\x3f\xb7\xbb\x9d\x9b\xeb\xd6\x4d\x3f\x7a\x78\x78\x30\x44\x8d\xc1\xb4\xc4\x8c\x29\x29\x12\x10\xe6\xbf\x12\xc9\x5e\x10\x53\x48\xb9\x5c\x60\x78\xe2\xb9\x83\x6c\x17\x9d\x4e\xbf\xd7\xef\x36\x6e\x07\xed\xcb\xe8\x64\x78\x4c\xc8\xf1\xd1\x69\x35\x8c\x4f\xce\x00\xaa\xf4\x34\xb4\x2b\xe1\xd9\x49\x95\x54\x47\xc3\xb3\x93\xef\x6b\xc7\x71\xf8\xfd\xd1\x9f\x17\xd4\xf6\x4b\xda\x28\x92\x62\x46\x77\x90\xee\x93\xb7\xea\xf5\xd8\x71\x1a\x58\x75\xfe\x78\xd7\xbe\xf4\x0e\x51\xbe\x9d\x80\x21\x94\x18\xf2\x0c\xf0\xeb\x9f\x17\x34\xe6\x99\x36\xa0\x70\x4a\xe2\x29\x19\x83\xf6\x7f\xd3\x52\xec\x22\xf1\xff\xe7\x51\x56\x1c\x7d\x4d\xe6\x57\x30\x03\xde\x94\x42\x4b\x0e\xd1\x3d\x51\x82\x89\x71\xbe\xd9\x25\x06\xae\x58\xc2\x4c\x5b\x18\x50\x33\xc2\xa3\xaa\x5e\xdb\xb9\xc8\x94\x36\x51\x2d\x0c\xc3\x70\x87\xbb\x14\x91\x1e\x3c\x46\xba\x4d\x17\x3e\x0d\xac\x96\x96\xd9\xea\x95\x0b\x78\x0a\xe6\x8a\x2c\xea\x9f\x05\x42\x5c\x8e\xb9\x15\xbe\x8e\x98\x18\x49\xbb\x92\x48\x9a\xd9\x00\xb3\xdf\x08\x51\x18\x91\x8c\x1b\x4c\x68\xc2\x44\xb1\x86\xd0\x44\x6a\x53\xff\xec\x84\xfd\x8c\x4c\x9c\xd6\x83\xa0\x5a\x3b\xf3\x43\x3f\xf4\xab\xf5\xd3\x6a\x18\x1e\x95\xa9\xf3\x3c\xf3\x48\x5e\x14\x14\x9a\xf7\xa4\x9f\x85\x57\xbe\x62\x2e\x9e\xad\x05\x23\x36\xf6\x17\xc9\x2e\xf1\xf7\xe9\x4e\x30\x53\x18\xa4\x31\x32\xa0\x22\x01\xe6\x5e\xaa\x29\x96\x82\x33\x01\x7e\x1e\x8c\x39\xe0\x1f\x44\x18\xfd\x12\xe0\x53\x2f\xcf\x57\x05\xc3\xfe\x22\x85\x48\x0a\xd0\x13\x59\x00\x56\x82\x3a\xba\x6c\x5d\xb4\x1b\x37\x83\x0f\xdd\xce\x4d\xbf\x75\x73\x19\x09\x29\x98\x35\x36\x89\x0d\x9b\x41\x8e\xef\x19\x22\x28\x51\xb4\x93\x99\x34\x33\x51\x61\xbc\x77\x71\xee\x32\x65\x4c\x4b\x29\xa9\x36\x43\x5a\x73\x88\x7b\x36\x25\x47\xa5\x62\xef\x46\x12\xa3\x16\x51\xf5\x3c\x13\x86\x71\x44\xd3\xe9\x18\xe1\xcb\x23\xdb\x32\x96\xdb\xe7\x80\xf2\x80\xfa\x14\x86\xe8\x8f\x3f\xd0\xfe\xbe\x51\x8b\x1f\xde\x1f\x1c\x9c\x53\xe9\x44\x70\x9d\xa5\x02\xa3\x16\xe8\x3b\xb7\xf9\xee\xdd\xc1\xc1\xb9\xe6\x00\x69\xb1\xf0\x7f\x46\x2d\x1c\x5e\xc0\xf9\x63\x22\xcf\xab\x7b\x9e\xbb\x51\x6e\xeb\xa7\x4d\xc7\x58\xc1\xea\xa6\xaf\x2d\xc6\x78\x4f\xee\xbe\xc1\xc1\x73\x06\xc1\x4b\xa5\x74\x07\xb7\x28\x1b\xb2\x9b\x8b\x11\x11\x7e\x4f\x16\x45\x1c\x3a\x7d\x96\x43\x34\x2c\xa1\x7b\x10\x47\xd5\x93\x35\x03\xdc\x2a\x88\x70\xde\x4d\xb1\x14\x71\x26\xa6\x88\x02\x2f\xee\x17\xae\x81\xa3\xad\xd6\xcb\xf1\x88\x12\x48\xa4\x40\xf8\x23\x1a\xd1\x7a\x10\x20\x8c\xb5\x91\x8a\x8c\x01\xe7\x23\x46\x64\x07\x26\x4e\x16\x3b\x29\xac\x54\xd3\x7d\x1a\xc0\x1c\x62\x77\xed\x1d\xd3\x43\x29\x96\x6e\x89\x32\x9d\x51\xb4\xd6\x28\x14\x41\x62\x17\x97\xc0\x2b\xa6\x0d\x88\x9e\x51\x40\x92\xc8\xf9\x9c\xca\x96\xf7\x73\xf6\x2e\xb4\xed\x68\xae\x25\x85\x28\x3c\x3d\x2d\x14\x95\x2f\xde\x69\x50\x91\x2b\x87\x2b\x8b\x3f\x2a\x99\xa5\xc5\xf1\x1b\x0e\xaa\x1d\x9d\x15\x96\xb9\x60\x82\xb6\x6f\x67\xa7\x1d\xc1\x17\xd1\x50\x9a\x49\x11\xc9\xed\xdc\x67\x0a\x29\x6d\xe4\x03\xbd\x58\x44\x56\x22\x30\xba\x14\xf5\xbb\xe9\xd5\x11\x7e\xa5\x16\xbb\xf0\xef\x8c\x29\xd0\xd1\x86\xa6\x66\x25\x65\x6d\xdb\xfd\x9a\x74\xf4\x81\x71\x88\x9c\xfc\xab\x4d\xc2\x66\x94\xed\xbb\x57\x6a\xe3\x73\x82\x17\xd2\x8d\x4b\x15\xdf\xed\x53\x30\x10\x9b\x01\x4b\x0f\xd0\x77\xfb\xb6\x2c\x08\x92\xc0\x81\x1d\x95\xad\x08\x76\x41\xef\x1e\xe6\x9b\x3b\xdf\xd7\x55\x7d\xdd\xea\x75\x7a\x83\xe6\xd5\x5d\xaf\xdf\xea\xda\xce\x2b\x21\xb6\xb0\xdf\x66\x43\xce\xe2\xf6\x6d\x83\x52\x05\x5a\xdf\x90\x04\x4a\x1d\xd8\x4b\x1d\xc2\xb2\x27\xc8\x05\x73\xb3\x08\x05\x85\xdd\x94\x52\xf4\x20\x4f\x84\x38\x3f\xef\x11\x56\x12\xae\x71\x79\xdd\xbe\xe9\x76\xee\xfa\xad\xee\xa0\xd1\xec\xb7\x7f\x6e\xf4\x5b\x83\xc6\x5d\xff\xe3\xe0\xba\x73\x79\x77\xd5\xb2\xf2\x4a\x92\x99\x49\xcb\x75\xce\xf4\xcd\x45\x74\xa5\x5b\xc9\xcc\xea\x14\xc4\xac\x24\xdc\x27\xaf\x52\x39\x74\xcf\x54\xcd\xbb\x5e\xbf\x73\x7d\xd9\xe8\x37\x6e\xef\x2e\xae\xda\xcd\xf6\x6d\xaf\xdf\xad\x54\xbc\x5f\xdf\x56\x98\x5c\x53\x03\xce\xb4\x29\x09\xd2\xfa\xe5\x63\xfb\xa2\xdd\xef\x74\x07\x17\x8d\xe6\x4f\xb6\x8c\x36\xfe\x75\xd7\x6d\x15\x05\xdd\x7e\x0e\x9a\x9d\x9b\x7e\xa3\x7d\xd3\xea\xe6\x51\x04\xf3\x09\x1b\xda\x16\x7d\x15\x73\xdb\x6d\x7d\x68\xff\xf2\xf7\xba\xc0\x93\x20\xab\x17\x8a\x65\x62\x5f\x46\x46\x9c\xcd\xfd\x47\x84\xef\xf4\xe1\x93\x38\xb6\x13\x3d\xb6\xb1\xf2\x24\x6b\x2f\x4f\xf9\x8d\x7c\xb3\xb5\xa4\x29\xc9\xbc\x0b\xd7\x29\x2c\x22\x6b\x55\xab\xe3\x9f\x60\xa1\xf7\xed\x28\x6b\x5f\x96\xda\x74\xbf\x52\xb9\x66\xb1\x92\x5a\x8e\x8c\x5f\x9c\x17\xe8\xd2\xb9\xba\x52\x39\x74\x47\xcd\x88\x62\xd6\x21\xf5\x7e\xa5\xf2\xaa\x80\x95\xca\xc1\xc1\x21\xaa\x54\x6a\x61\xf5\x04\x87\xa7\xb8\x7a\x52\xa9\x1c\xf8\x53\x58\x54\x0f\x2b\x95\xbf\x48\xdd\x7e\xaa\x64\x0a\xca\x30\xd0\x25\xcd\x3f\x78\x19\x6b\xba\x4c\x92\x29\xf7\x80\xe5\xd5\x1f\xbc\x94\x67\x63\x26\xb4\xfd\x1c\x12\x21\x40\xd9\xaf\x7c\x56\xa5\x5e\x7d\x44\xb8\x86\x43\x6f\x48\xe2\xe9\x58\xc9\x4c\xd0\xa6\xe4\x52\x79\x75\xef\xdb\x6a\xab\x76\x54\xfb\xe0\x1d\x7a\x23\xa9\x60\x6d\xef\x83\xfb\xcf\x3b\xf4\x26\x40\x28\xa8\x3e\x33\x1c\xbc\xba\xc8\x38\x5f\x2e\x35\xf3\x40\x5b\x2e\x8e\xa4\x34\xcf\x16\x59\x42\xc6\x70\x4b\xcc\x64\xb9\x40\x99\x76\x85\x65\xb8\xe4\xf6\xe5\xd0\x1b\x2a\x22\x28\x13\xe3\xe7\x52\x7f\x39\xf4\x60\x6e\xc0\xf6\x8a\xd8\xb6\x21\xda\xcb\x9f\x82\x57\x70\xa8\x00\xee\xe5\x86\xf0\x6c\xb2\x01\x61\x58\xfc\xa8\x9d\x75\x96\x4b\xa4\xcb\x4b\x25\xc0\xf3\x64\x75\xe8\xf8\x7d\x94\xda\x78\x75\x6f\xf9\x44\x68\x23\xd4\xb7\xeb\xa1\x1f\xcb\xc4\x73\x1c\x73\x96\x46\x91\x78\xba\xf9\x26\x6f\x1e\x99\x19\x2b\x4a\x4a\x3e\xd9\x95\x9c\xe4\xcb\x5b\x1c\x92\x4e\xc7\x76\x6c\x7a\xce\x7e\xb5\xf8\x5f\x80\xf5\x1d\x97\xb4\xbe\x6e\xc8\x78\xeb\xa1\xc1\xf6\xac\x8f\x85\xdc\xbd\x9d\x22\x9c\xe6\x2a\x4e\x95\xb4\xcf\x2a\x3e\xdd\x5a\xf8\xb9\x40\x58\x8f\xd0\x0b\x3d\x83\x0f\xf3\x54\x2a\xb3\xc6\xd0\xa9\xcf\x3e\xe1\xed\xda\x04\x3c\x7b\x63\xfa\xba\x56\xeb\x12\x74\xac\x58\x6a\x3d\x3b\xba\x9d\x8e\x53\xab\x9e\x3a\xba\x94\xf7\x82\x4b\x42\xd1\x65\x33\xe8\xf4\x90\x91\xc8\x4c\x98\x76\x73\xac\xff\x56\x43\x63\x53\x0a\xca\xec\xb9\x36\x98\x5b\x73\xa6\x8d\x8e\xbe\x59\xd7\xd7\x46\xd3\x6f\x6c\xdf\xb6\x3c\x5a\x94\x5e\x57\xfe\x17\x8e\x13\x67\x8a\x23\x8c\xa7\x00\x29\xe1\x6c\x06\xd8\xb0\x04\x50\x0d\xe1\xd1\x95\xee\xcd\x10\xc6\xf9\xfc\x58\x0b\x11\xfe\x27\xaa\xda\x57\x8d\x10\xe1\x05\x3a\x2d\x7e\x98\x92\x1b\x06\xd2\xc7\x3b\x59\x85\xfb\xf3\xdf\x51\xf1\x9e\x77\xb1\x5c\x5f\x7d\xce\x7b\xcd\x9d\xcb\x0a\x5f\xa3\x79\x22\x30\x44\x21\x4c\xe6\xa3\x5d\xc4\xc1\xcd\x57\xd8\xde\x4a\x6d\x22\xfc\xc8\x5b\x25\x08\xef\xc2\x78\xe7\x98\xa0\x85\xfb\xbe\x69\x4c\xf4\x52\x88\x19\xe1\xec\x77\x28\xa2\x62\x24\xd5\xb3\xb0\x28\x4f\x2e\xcf\xe4\x58\x1f\x5e\x36\x03\xfe\xb6\x4c\xb7\x1e\x47\xdb\x93\xd5\x9a\x01\x9f\x41\xad\x8b\xa4\x85\xaa\x90\x4b\xf6\x2b\x2f\xd5\xb8\xb0\xd1\xcb\x23\x66\x92\x71\xc3\xb0\xfd\x59\xa5\x94\x26\x76\x30\x77\x79\x04\x7c\xdd\xd6\x6b\x2c\x57\xae\xa1\x24\x07\x9d\xff\xb6\x52\xa2\xb8\xed\x76\x7e\x6e\xf7\xda\x9d\x9b\x81\xed\xf2\x1f\xc9\xbd\xd7\x7f\x8a\xf1\xd6\xe5\xf1\xc2\xb3\xe3\x63\xef\xe9\xc9\xd2\x53\x52\x1a\x6f\x0f\x2f\x25\x5a\x46\x81\xbd\x59\x31\x2e\x69\x4e\x66\x80\x63\x99\x24\xee\xbd\xf5\x49\xac\x46\xbf\xdf\x6d\x5f\xdc\xf5\x5b\xbd\x47\xb9\xca\x47\x9d\x6e\x38\xea\x3f\x03\x00\xe6\x4d\x2c\x09\xa0\x1f\x00\x00")
 
 func dcoscustomdata184TBytes() ([]byte, error) {
 	return bindataRead(
@@ -387,7 +388,7 @@ func dcoscustomdata184T() (*asset, error) {
 	return a, nil
 }
 
-var _dcoscustomdata187T = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x59\x7d\x73\x1a\xb7\xd6\xff\x3f\x33\xf9\x0e\xea\xb6\x33\xc4\x4f\xac\xdd\x05\xc7\xf6\x13\x3c\xdb\x19\x1b\x93\x86\x5b\xdb\x78\x00\xf7\xf6\xde\xa6\xc3\x88\xd5\x01\x54\xb4\xd2\x5e\x49\x8b\xa1\x6e\xbe\xfb\x1d\xad\x16\x02\xcb\x4b\x70\x9b\x74\x6e\x66\x5a\xc3\xd9\x73\xa4\x9f\xce\xf9\xe9\xbc\x2c\x03\x29\x4d\x9c\xd0\xfa\xcb\x17\x18\x0d\x88\x1e\x23\x1c\x23\x8f\x0d\xd1\x2f\xe8\x1b\x84\x87\x28\x98\x12\x15\x70\x36\x08\x34\x1d\xe0\x51\x6a\xd0\xaf\x17\x66\x0c\x02\x41\x3c\x96\xe8\xba\xd1\xee\xe2\xd3\xff\x7f\x1b\x5e\xa4\x44\x19\xa0\x08\x6b\x14\x50\x98\x5a\x65\x94\x4c\x38\x19\x00\x7f\xf9\x02\xa1\x51\x6a\x2e\x8c\xcc\xe2\xf1\xc6\x72\x17\x43\xe6\xbd\x7c\x41\x99\x9e\xf4\x35\x98\x2c\xad\x5b\x75\x48\xc7\x90\x80\x22\x3c\xcc\xbf\x22\xc4\xc9\x5c\x66\xa6\xf8\x82\xd1\x69\x58\xfe\x24\xa7\xa0\x1e\x15\x33\x50\x47\x46\x65\xe0\x84\x86\x0c\x38\xf4\xcd\x3c\x85\xba\x45\xf0\xf2\xc5\x50\x2f\x37\xc1\x88\xc2\x94\xc5\x50\x5f\xd9\xcc\xaf\x5a\xbb\x21\xe3\xa0\xe7\xda\x40\x52\x47\x30\x33\x6f\xac\x6c\x63\xf9\xad\xe6\xb5\x83\xcd\x13\x99\x09\xa3\x73\x18\x78\x03\x00\xfe\xe4\xa4\x04\xb4\xd4\x1b\x5a\xb5\x92\x16\x95\xf1\x04\xd4\xcb\x17\x2a\x13\x8b\x40\x06\x99\x76\xcf\x48\x6a\xec\x7f\xbe\x83\x44\x7d\x4a\x18\x9f\x5b\x8d\x3c\x7e\x35\x4a\xab\x31\x54\xcf\x71\x78\xfe\x16\xf0\x9b\xf0\x24\xc6\x83\x93\xd3\x1a\x26\xd5\xb7\xb5\x2a\x40\x2d\x3c\x07\x40\xdf\xa3\x40\xcf\x75\x30\xc8\x74\x30\x4d\xec\xff\xa9\x62\x53\x50\x3a\x18\x4f\xfb\x99\x61\x3c\xc8\xc4\x80\x09\x8a\xbe\x45\x09\x33\x6c\x44\x0c\x93\x02\x0d\xa5\x42\x83\x6c\x84\xc6\xc6\xa4\xba\x1e\x04\x83\x6c\xa4\x7d\x4e\x32\x11\x8f\x53\x42\x7d\x01\x26\xc8\x06\x99\x30\x59\xf0\x5a\xcb\x4c\xc5\x10\x70\x26\xb2\x59\xf0\x7a\x90\x8d\x82\xea\xd9\xf9\xd9\xd9\xc9\xa9\x85\xa9\x2d\xa9\x18\xf2\xaa\x27\xec\xc3\x17\x87\xfc\x41\x78\x28\x00\x13\x07\x2a\xf6\xb9\x8c\x09\xff\x3a\x67\xc0\x88\x0b\x17\x32\xac\x8b\xd0\x0d\x98\x08\x54\x52\x7c\xb1\xb1\x5a\x08\x76\x6a\x27\x13\xca\x54\xc9\xa0\x90\xed\xb4\x31\xa4\x6c\x91\x4b\x76\xea\x2f\x84\x4b\x75\x2b\xd8\xa9\x1d\xa7\x25\x6d\x2b\xd8\xa9\xed\x08\x18\x1b\x5e\x32\x5a\x91\xef\x3e\xbb\xbd\x2e\xe5\xb3\x3b\xd9\x4e\x1b\x9b\xca\x4a\x26\x4e\xb4\xd5\xc2\xaa\x68\xab\x93\x69\x50\x84\xd2\x92\xe5\x52\x6a\x8d\x4b\x27\xa1\x4c\xdb\x3c\x53\x2c\x88\x85\x7c\x74\x1f\x15\x68\xc9\xa7\xb1\x14\x43\x5f\x83\xb2\xc9\x62\xab\x79\x42\xf4\x64\xc3\x96\xcf\x62\x2c\xc0\xac\x1a\x06\xd2\xde\xe4\xdf\x33\x05\x41\x2c\x85\x21\x4c\x58\x3e\xa7\x4a\x4e\x99\x66\x52\xf8\x8b\xa3\x2d\xa2\x82\x17\xd1\xc9\xf9\x9d\x63\xf1\x2d\x98\x42\x6a\x92\x74\x5d\x6a\x6d\x17\x8c\xc4\xc3\x5d\xb6\x5b\x77\xd8\x58\x6b\xb7\x6d\xe9\xf0\xda\x10\x55\x04\x96\xc6\x52\x63\x97\xc9\x30\x13\xda\x10\xce\xf7\xfa\xad\x6c\x6a\xf7\x60\x23\x9c\xa7\x59\xb5\xd7\x52\xc1\x8a\x6d\x91\x17\xf1\x6f\x32\x53\x82\x70\x7a\xb8\xa5\xc3\xfa\x2c\x8c\x9c\x89\x09\x06\x31\xdd\x6b\x04\xe2\x13\x9d\x72\xab\xbc\x64\xed\x35\xb1\xcc\xc1\x03\x2e\xe3\xc9\xf6\x7d\x4b\x2b\xe4\x3e\xea\xe7\x85\x2a\x2f\x16\x96\x4f\x20\x4c\x1d\x55\x16\x99\xce\x5a\x31\xe9\xe7\x74\x03\x3a\x82\x3c\xd7\x59\x61\xa0\x8d\x43\xe7\x2a\x6c\x25\xaf\x6e\x8f\x02\x54\x1d\x29\x29\xf3\x3d\x53\x62\xc6\x75\x17\xfe\xbc\x7c\xa5\x63\x50\x10\xe4\x10\xf0\x90\x93\x91\x0e\x14\xa4\x52\x33\x23\xd5\x1c\x67\x2a\x3f\x42\x0a\x2a\x61\xda\xf2\x58\xd7\x51\x25\x3c\x7b\xf3\xa6\xb2\x0e\xec\x17\x6f\x35\xc8\xee\x40\x7d\xdb\x7d\xfc\xf0\xd0\xba\xf6\x8e\x91\x7b\x9c\x80\x21\x94\x18\xb2\xa1\xf0\xeb\x5f\x03\x1c\xf3\x4c\x1b\x50\x38\x25\xf1\x84\x8c\x40\xfb\xbf\x69\x29\x0e\xc2\x6d\x11\xf4\x9b\x77\x3f\xb5\x3a\xed\xbb\xdb\xe6\x5d\x2f\x7a\x7a\x7a\x32\x44\x8d\xc0\x34\xc5\x94\x29\x29\x12\x10\xe6\xe3\xc7\x8f\x7f\x0d\x9f\xbb\x3b\x90\x72\x39\xb7\xf4\x5a\xac\x7b\x98\x67\xff\xe1\x98\xbf\x74\xd1\x2d\x99\xdd\xc0\x14\x78\x43\x0a\x2d\x39\x44\x8f\x44\x09\x26\x46\x8b\xc7\x1d\x62\xe0\x86\x25\xcc\xb4\x84\x01\x35\x25\x3c\xaa\xea\x8d\x67\x57\x99\xd2\x26\xaa\x85\x61\x18\x1e\x78\xb2\xe2\x1e\x06\xcb\x7b\x98\x27\x4e\x9a\x1f\x6d\x99\x55\x3e\x77\x18\x4f\xc1\x4c\x91\x79\xfd\x83\x40\x88\xcb\x11\xb7\xc7\xa8\x23\x26\x86\xd2\x4a\x12\x49\x33\x4b\x79\xfb\x19\x21\x0a\x43\x92\x71\x83\x09\x4d\x98\x28\x64\x08\x8d\xa5\x36\xf5\x0f\x0e\xf1\x07\x64\xe2\xb4\x1e\x04\xd5\xda\xb9\x1f\xfa\xa1\x5f\xad\x9f\x55\xc3\xf0\x64\xdd\xdc\x65\x81\xa5\x7d\x51\x0c\xa8\x6b\xf4\x3e\x08\xaf\x74\x4c\x07\x30\x70\x3c\xf6\xe7\xc9\x81\xec\x7f\x10\xcc\x2c\x03\x74\x39\x34\xa0\x22\x01\xe6\x51\xaa\x09\x96\x82\x33\x01\xbe\x23\xd5\x42\xe5\x9f\x44\x18\xbd\x5f\xe5\x97\xae\x4b\x07\xcb\x65\x7b\xf3\x14\x22\x29\x40\x8f\xe5\x52\x69\x85\xa4\xd1\x75\xf3\xaa\x75\x79\xd7\x7f\xd7\x69\xdf\xf5\x9a\x77\xd7\x91\x90\x82\x59\x0a\x90\xd8\xb0\xe9\x32\x23\x74\x0d\x11\x94\x28\xda\xce\x4c\x9a\x99\xa8\x08\xe7\xeb\xd8\x91\xa9\xac\xd5\x54\x4a\xaa\x5d\x4a\xcd\x19\xc4\x5d\x9b\xc9\xa2\xb5\x0a\x9e\xcf\x27\x46\xcd\xa3\xea\x45\x26\x0c\xe3\x88\xa6\x93\x11\xc2\xd7\x27\xb6\x4f\x5c\xef\x9c\x03\xca\x03\xea\x53\x18\xa0\x3f\xfe\x40\xaf\x5e\x19\x35\xff\xfe\xed\xd1\xd1\x05\x95\x6e\x83\xbc\x9f\x54\x60\xd4\x1c\x7d\x97\x3f\x7d\xfd\xfa\xe8\xe8\x42\x73\x80\xb4\x10\xfc\x9f\x51\xf3\xdc\x40\xc0\xc5\x32\xe7\xba\x42\xed\x92\x6c\x51\x05\x2e\xd6\x12\x32\x5a\x14\x8a\x65\x8d\xb0\x7f\x8c\xb7\x76\x15\xb6\x70\xdf\xfd\x0d\xf6\x56\xc2\x43\xd8\x52\x8e\x6c\xc7\xa1\x89\x08\x7f\x24\x73\xbd\x12\x03\x65\xd6\x6f\x72\x58\xb2\xe8\x42\x1c\x55\x4f\x37\xe2\x71\xaf\x20\xc2\xae\x5d\x62\x29\xb2\x45\x0d\x51\xe0\xc5\x61\xc3\xcd\xf0\xed\x09\xa8\xb3\x41\x94\x40\x22\x05\xc2\xef\xd1\x90\xd6\x83\x00\x61\xac\x8d\x54\x64\x04\xd8\x35\xee\x91\x9d\xa0\x38\x99\x1f\xea\xc1\xb5\xd2\xec\xd3\x00\x66\x10\xe7\x27\x3a\x38\x95\x94\xee\xdc\x3d\x51\xa6\x3d\x8c\xca\x35\x7f\x71\x95\xf2\xf8\x2e\x95\x6f\x98\x36\x20\xba\x46\x01\x49\xa2\x9c\x91\x2a\x13\xc1\x0a\x17\x96\x21\xc8\xed\x6e\x25\x85\x28\x3c\x3b\x0b\xd7\xc5\x0f\x1a\x54\xe4\x32\xe5\xaa\xf8\x07\x25\xb3\x34\x5a\x8c\x7c\x5b\x36\xac\x9d\x9c\x2f\x43\x76\xc5\x04\x6d\xdd\x4f\xcf\xda\x82\xcf\xa3\x81\x34\xe3\x25\xe4\x96\x23\xd6\xaf\xab\x19\x03\xe8\xd5\x3c\x72\x5c\xd5\xa5\x6c\x71\xa0\xc3\x73\xdb\x3f\xe1\xde\x0e\xfc\x27\x63\x0a\x74\xb4\xad\x5d\x59\xcd\x7a\xbb\x9f\x3f\x37\x9f\xbd\x63\x1c\xa2\xfc\x2c\x6b\x15\x73\x87\x9e\x6d\xbe\x57\x8a\xef\x36\x93\x3d\xd9\x2a\x4f\x34\xdf\xbd\xa2\x60\x20\x36\x7d\x96\x1e\xa1\xef\x5e\xd9\x4a\x23\x48\x02\x47\x76\x58\xb5\x30\xac\x40\x3f\x2b\x47\xec\x68\x79\x0f\xf0\xfe\x6d\xb3\xdb\xee\xf6\x1b\x37\x0f\xdd\x5e\xb3\x63\x7b\x92\x84\xd8\x06\xe7\x3e\x1b\x70\x16\xb7\xee\x2f\x29\x55\xa0\xf5\x1d\x49\xa0\xdc\x9b\xec\x6b\x46\x16\xed\x91\x43\x97\x8f\x26\x14\x14\xce\xbb\xc8\xa2\x25\xfb\x64\x88\xdd\x96\x4b\xb5\x75\x80\x97\xd7\xb7\xad\xbb\x4e\xfb\xa1\xd7\xec\xf4\x2f\x1b\xbd\xd6\x4f\x97\xbd\x66\xff\xf2\xa1\xf7\xbe\x7f\xdb\xbe\x7e\xb8\x69\x5a\xcc\x92\x64\x66\xdc\xcc\xbb\x65\xfa\x35\x60\xe6\x9d\x81\x92\x99\x75\x2e\x88\x69\xb9\x21\xad\x54\x8e\xf3\xf7\x5f\x8d\x87\x6e\xaf\x7d\x7b\x7d\xd9\xbb\xbc\x7f\xb8\xba\x69\x35\x5a\xf7\xdd\x5e\xa7\x52\x29\xf5\x9c\x5f\xc4\x6f\xb9\xc7\xfa\x9c\x69\xb3\x0e\xa6\xf9\xf3\xfb\xd6\x55\xab\xd7\xee\xf4\xaf\x2e\x1b\x3f\xda\xe2\x7c\xf9\xef\x87\x4e\x73\x79\x75\xec\x97\x7e\xa3\x7d\xd7\xbb\x6c\xdd\x35\x3b\xee\x12\xc1\x6c\xcc\x06\xb6\x09\x5f\xd7\xba\xef\x34\xdf\xb5\x7e\xfe\xdb\x29\xb1\x82\x66\xf5\x60\xb1\x4c\xec\xe8\x31\xe4\x6c\xe6\x2f\x55\xdc\x58\xe2\x93\x38\x96\x99\x30\xd8\x5e\xa2\x4f\x78\xbb\xae\x6e\x5c\xba\x87\xcd\x85\x4d\x09\xf7\x21\xeb\x4e\x60\x1e\xd9\x18\x5b\x77\xff\x08\x73\xfd\xca\x0e\xb3\x99\x8a\xa1\x45\x5f\x55\x2a\xb7\x2c\x56\x52\xcb\xa1\xf1\x8b\x1d\x03\xbd\xb6\xb3\xae\x54\x8e\xdd\x5e\x53\xa2\x98\xe5\xa8\x7e\x55\xa9\x7c\x16\x63\xa5\x72\x74\x74\x8c\x2a\x95\x5a\x58\x3d\xc5\xe1\x19\xae\x9e\x56\x2a\x47\xfe\x04\xe6\xd5\xe3\x4a\xe5\xeb\x79\xdd\x4f\x95\x4c\x41\x19\x06\x7a\x3d\x00\x4f\x5e\xc6\x1a\x79\x9a\xc9\x54\xfe\x52\xcc\xab\x3f\x79\x29\xcf\x46\x4c\x68\xfb\x71\x40\x84\x00\x65\x3f\xb9\xb1\x95\x7a\xf5\x21\xe1\x1a\x8e\xbd\x01\x89\x27\x23\x25\x33\x41\x1b\x92\x4b\xe5\xd5\xbd\x6f\xab\xcd\xda\x49\xed\x9d\x77\xec\x0d\xa5\x82\xd2\xb3\x77\xf9\x3f\xef\xd8\x1b\x03\xa1\xa0\x7a\xcc\x70\xf0\xea\x22\xe3\x7c\x21\x6a\x38\x50\x0b\xe1\x50\x4a\xb3\x21\x64\x09\x19\xc1\x3d\x31\xe3\x85\x80\x32\x9d\x67\xc2\xc1\x62\xb5\x8f\xc7\xde\x40\x11\x41\x99\x18\x6d\xa2\xfe\x78\xec\xc1\xcc\x80\x6d\x46\xf3\x59\x5d\x7b\xc5\x2b\xe7\x15\x45\x54\x68\xda\x60\xb8\x87\x9e\xcd\x43\x20\x0c\x8b\x97\x1e\x2a\x2f\xbb\xa2\x9c\x67\xad\x35\x9d\xcd\x54\x76\x9c\x2f\xf9\x5e\x6a\xe3\xd5\xbd\xd5\x99\xdc\xb7\xf2\xd0\x8f\x65\xe2\x2d\x16\x2d\x96\x35\x8a\xc4\x93\xed\x87\xfa\x1a\xf7\x35\x63\x45\xf5\x29\x46\xe1\x35\xce\x7c\xfc\x22\xdb\xa4\x93\x91\x9d\xdc\xb6\x6c\xb0\xde\x3b\x5c\x81\xa5\x53\x9e\xd4\xfe\xcc\x88\xf3\x35\x06\x16\xdb\x20\xaf\xbf\xa4\x45\x38\x75\xae\x48\x95\x1c\x32\x0e\x3e\xdd\xd3\x37\x70\x81\xb0\x1e\xa2\x3d\x4d\x87\x0f\xb3\x54\x2a\x53\x5a\xd2\x51\x44\x8f\x9f\xd3\x43\x6c\xbe\x92\x7a\x76\xf3\x76\x0d\x3a\x56\x2c\xb5\xdc\x8f\xee\x27\xa3\xd4\x7a\xaa\x8e\xae\xe5\xa3\xe0\x92\x50\x74\xdd\x08\xda\x5d\x64\x24\x32\x63\xa6\xf3\x01\xdb\xff\xb2\x93\x6c\x43\x0a\xca\xec\xee\xf6\xe2\x37\x67\x4c\x1b\x1d\x7d\x53\x76\xde\xff\x16\x27\xe2\x4c\xd9\x31\x72\x02\x90\x12\xce\xa6\x80\x0d\x4b\x00\xd5\x10\x1e\xde\xe8\xee\x14\x61\xec\xa6\xd2\x5a\x88\xf0\xbf\x50\x35\xb4\xff\x10\x9e\xa3\xb3\x10\x61\xb9\x65\xc6\x1d\x48\x69\xb4\x51\x24\xb5\x9e\xf1\x67\xbf\xa3\xa7\xa7\x27\x1b\xdd\xab\x85\xfc\xa1\x73\xb3\x92\x05\x3e\xc7\xd3\x75\xd7\xed\x21\xaa\x21\x0a\x61\x32\x1b\x1e\x02\x09\x37\x3e\xbb\xf0\xbd\xd4\x26\xc2\x2b\x3f\x87\xac\xfd\xfe\xb8\x73\xe9\xe7\x8d\xd5\x8e\x95\x5f\x9c\xee\xdd\x14\x62\x46\x38\xfb\x1d\x0a\xc2\x0f\xa5\xda\xc2\xf8\xf5\x41\x67\x13\xcd\xc6\xac\xb3\x4b\xe5\x6f\xe7\xf1\x5f\x9a\x88\x4a\xca\x36\xba\x69\xe1\x3a\x94\x67\xfb\x95\x57\xd8\xb8\x88\xdb\xe7\x47\xd5\x24\xe3\x86\xe1\x4c\x83\x7a\xe6\xb4\xba\x65\x88\x3c\x80\x06\x7b\xaa\x9a\x92\x1c\xb4\xfb\x75\x66\xdd\xe6\xbe\xd3\xfe\xa9\xd5\x6d\xb5\xef\xfa\x76\x28\xf8\xb4\x80\xf7\xf9\x9f\x73\xbc\x0d\x50\x5e\x78\xfe\xe6\x8d\xb7\xf2\x1e\xd5\x53\x52\x1a\xcf\xee\x58\xc0\x5a\xfe\x22\x1c\x4b\x5d\x8c\x59\x9a\x93\x29\xe0\x58\x26\x89\x7b\x65\xbd\x32\x63\xf5\x7a\x9d\xd6\xd5\x43\xaf\xd9\xfd\x04\x6e\x7d\xbb\xb3\xed\xdb\xfd\x37\x00\x00\xff\xff\x40\x7e\xb6\x06\x32\x20\x00\x00")
+var _dcoscustomdata187T = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x59\x6f\x73\x1a\x39\xd2\x7f\xef\x2a\x7f\x07\xed\xec\x56\x61\x3f\xb1\x66\x06\x1c\xdb\x4f\x70\xcd\x56\x61\x4c\x36\xdc\xda\xc6\x05\x78\x6f\xef\x92\x2d\x4a\x8c\x1a\xd0\xa2\x91\xe6\x24\x0d\x86\xf5\xe6\xbb\x5f\x69\xfe\x60\x18\x30\x21\x9b\x64\xeb\xf2\x22\x86\x56\xff\x5a\x3f\xb5\x5a\xad\x6e\x31\x94\xd2\x84\x11\xad\x1f\x1e\x60\x34\x24\x7a\x82\x70\x88\x1c\x36\x42\xef\xd1\x77\x08\x8f\x90\x37\x23\xca\xe3\x6c\xe8\x69\x3a\xc4\xe3\xd8\xa0\xdf\x2e\xcd\x04\x04\x82\x70\x22\xd1\x75\xb3\xd3\xc3\x67\xff\xff\xc6\xbf\x8c\x89\x32\x40\x11\xd6\xc8\xa3\x30\xb3\xca\x28\x9a\x72\x32\x04\x7e\x78\x80\xd0\x38\x36\x97\x46\x26\xe1\x64\xc3\xdc\xe5\x88\x39\x87\x07\x94\xe9\xe9\x40\x83\x49\xe2\xba\x55\x87\x78\x02\x11\x28\xc2\xfd\xf4\x2b\x42\x9c\x2c\x64\x62\xf2\x2f\x18\x9d\xf9\xe5\x4f\x72\x06\xea\x51\x31\x03\x75\x64\x54\x02\x99\xd0\x90\x21\x87\x81\x59\xc4\x50\xb7\x0c\x0e\x0f\x46\x7a\x39\x09\x46\x14\x66\x2c\x84\xfa\xca\x64\x6e\xd5\xe2\x46\x8c\x83\x5e\x68\x03\x51\x1d\xc1\xdc\xbc\x3e\x3c\xd8\x62\x7e\x2b\xbc\xb6\x37\x3c\x92\x89\x30\x3a\xa5\x81\x37\x08\xe0\x67\x27\x45\xa0\xa5\xde\xd0\xaa\x95\xb4\xa8\x0c\xa7\xa0\x0e\x0f\x54\x22\x8a\x8d\xf4\x12\x9d\x8d\x91\xd8\x78\x24\x36\x6e\x46\x89\xba\x94\x30\xbe\xb0\x1a\xe9\xfe\xd5\x28\xad\x86\x50\xbd\xc0\xfe\xc5\x1b\xc0\xaf\xfd\xd3\x10\x0f\x4f\xcf\x6a\x98\x54\xdf\xd4\xaa\x00\x35\xff\x02\x00\xfd\x88\x3c\xbd\xd0\xde\x30\xd1\xde\x2c\xb2\xff\x53\xc5\x66\xa0\xb4\x37\x99\x0d\x12\xc3\xb8\x97\x88\x21\x13\x14\x7d\x8f\x22\x66\xd8\x98\x18\x26\x05\x1a\x49\x85\x86\xc9\x18\x4d\x8c\x89\x75\xdd\xf3\x86\xc9\x58\xbb\x9c\x24\x22\x9c\xc4\x84\xba\x02\x8c\x97\x0c\x13\x61\x12\xef\x95\x96\x89\x0a\xc1\xe3\x4c\x24\x73\xef\xd5\x30\x19\x7b\xd5\xf3\x8b\xf3\xf3\xd3\x33\x4b\x53\xdb\xa0\x62\xc8\xa9\x9e\xb2\x0f\x5f\x9d\xf2\x07\xe1\x20\x0f\x4c\xe8\xa9\xd0\xe5\x32\x24\xfc\xdb\xac\x01\x23\x2e\xb2\x2d\xc3\x3a\xdf\xba\x21\x13\x9e\x8a\xf2\x2f\x76\xaf\x0a\xc1\x8b\xda\xd1\x94\x32\x55\x02\xe4\xb2\x17\x31\x86\x94\x11\x86\xec\xd2\xe7\xa2\xa4\xce\xc5\x0e\xed\x30\x2e\x69\x87\xf1\x0e\xed\x2c\x00\x43\xc3\x4b\xa0\x15\xf9\x8b\xd8\xf4\xb8\x94\x70\xb9\xec\x45\x8c\x4d\x65\x25\x48\x26\xda\x8a\xb0\x6c\xb4\xa5\x93\x68\x50\x84\xd2\x12\x72\x29\xb5\xe0\xd2\x4a\x28\xd3\x36\xcf\xe4\x06\xb1\x90\x8f\xd9\x47\x05\x5a\xf2\x59\x28\xc5\xc8\xd5\xa0\x6c\xb2\xd8\x0a\x8f\x88\x9e\x6e\x60\xf9\x3c\xc4\x02\xcc\x2a\xd0\x93\xf6\x24\xff\x91\x28\xf0\x42\x29\x0c\x61\xc2\x1e\xc1\x58\xc9\x19\xd3\x4c\x0a\xb7\x58\x5a\xb1\x2b\xb8\xd8\x9d\x34\xbe\x53\x2e\xae\x25\x93\x4b\x4d\x14\xaf\x4b\x2d\xb6\x88\x48\x3c\x7a\x09\xbb\x75\x86\x0d\x5b\x2f\x63\x4b\x8b\xd7\x86\x28\x93\xbb\x31\x94\x1a\x67\x99\x0c\x33\xa1\x0d\xe1\x7c\xa7\xdf\xca\x50\x3b\x07\x1b\xe3\x34\xcd\xaa\x9d\x48\x05\x2b\xd8\x3c\x2f\xe2\xdf\x65\xa2\x04\xe1\x74\x7f\x64\xc6\x75\xa7\xfe\xaa\xb6\xe5\xc8\x99\x98\x62\x10\xb3\x9d\x20\x10\xcf\xe1\x94\xa2\xd2\x2b\x6b\x27\xc4\x46\x0e\x1e\x72\x19\x4e\xb7\xcf\x5b\xb2\x90\xfa\x68\x90\x5e\x54\xe9\x15\x64\xe3\x09\x84\xa9\xa3\xca\xd3\xd3\x93\x05\x74\x21\x96\x9a\x19\xa9\x16\x0f\xdd\x9b\x8f\x1f\x3f\x1e\x1e\x58\x63\x08\x55\xec\x1f\xf9\x28\x40\xd5\x91\x92\x32\x5d\x5a\x4c\xcc\xa4\x9e\x45\x4a\x7a\x5b\xc5\x13\x50\xe0\xa5\x33\xe2\x11\x27\x63\xed\xa9\xa5\x35\x9c\xa8\x94\x71\x0c\x2a\x62\xda\x86\xad\xae\xa3\x8a\x7f\xfe\xfa\x75\x65\x9d\xc7\x7b\x67\x75\x4f\x71\x6a\x6d\x60\x8b\x8d\x9f\x1e\xda\xd7\xce\x09\xca\x86\x23\x30\x84\x12\x43\x36\x14\x7e\xfb\x32\xc2\x21\x4f\xb4\x01\x85\x63\x12\x4e\xc9\x18\xb4\xfb\xbb\x96\x62\x2f\xde\x96\xc1\xa0\x75\xf7\x4b\xbb\xdb\xb9\xbb\x6d\xdd\xf5\x83\xa7\xa7\x27\x43\xd4\x18\x4c\x4b\xcc\x98\x92\x22\x02\x61\xbe\xd8\xa1\xe9\xe2\x29\xc4\x5c\x2e\x30\x3c\xdb\xdd\xcf\xb3\xff\xc8\x02\x7d\xe9\xa2\x5b\x32\xbf\x81\x19\xf0\xa6\x14\x5a\x72\x08\x1e\x89\x12\x4c\x8c\x8b\xe1\x2e\x31\x70\xc3\x22\x66\xda\xc2\x80\x9a\x11\x1e\x54\xf5\xc6\xd8\x55\xa2\xb4\x09\x6a\xbe\xef\xfb\x7b\xae\x2c\x3f\x76\xde\xf2\xd8\xd9\xe3\xeb\xd2\x74\x69\xcb\x24\xf2\xa9\xc5\x38\x0a\xe6\x8a\x2c\xea\x1f\x04\x42\x5c\x8e\xb9\x5d\x46\x1d\x31\x31\x92\x56\x12\x49\x9a\xd8\x08\xb7\x9f\x11\xa2\x30\x22\x09\x37\x98\xd0\x88\x89\x5c\x86\xd0\x44\x6a\x53\xff\x60\xb9\x21\xf4\x01\x99\x30\xae\x7b\x5e\xb5\x76\xe1\xfa\xae\xef\x56\xeb\xe7\x55\xdf\x3f\x5d\x87\x67\x87\x7e\x89\xcf\x73\x3f\xcd\xaa\xce\x0f\xc2\x29\x2d\x33\x23\x68\x13\xf6\x88\x8d\xdd\x45\xb4\x67\xf4\x3f\x08\x66\x96\x1b\xd4\x18\x19\x50\x81\x00\xf3\x28\xd5\x14\x4b\xc1\x99\x00\x37\x0b\xaa\x42\xe5\x9f\x44\x18\xbd\x5b\xe5\x7d\x2f\xcb\x1f\x4b\xb3\xfd\x45\x0c\x81\x14\xa0\x27\x72\xa9\xb4\x12\xa4\xc1\x75\xeb\xaa\xdd\xb8\x1b\xbc\xed\x76\xee\xfa\xad\xbb\xeb\x40\x48\xc1\x6c\x08\x90\xd0\xb0\x19\x14\x88\x9e\x21\x82\x12\x45\x3b\x89\x89\x13\x13\xe4\xdb\xf9\x2a\xcc\x82\xa9\xac\xd5\x52\x4a\xaa\x97\x94\x5a\x73\x08\x7b\x36\x71\x05\x6b\x17\x76\xda\x8e\x18\xb5\x08\xaa\x97\x89\x30\x8c\x23\x1a\x4f\xc7\x08\x5f\x9f\xda\xb2\x70\xbd\x50\xf6\x28\xf7\xa8\x4b\x61\x88\xfe\xfc\x13\x1d\x1d\x19\xb5\xf8\xf1\xcd\xf1\xf1\x25\x95\xd9\x04\x69\xf9\xa8\xc0\xa8\x05\xfa\x21\x1d\x7d\xf5\xea\xf8\xf8\x52\x73\x80\x38\x17\xfc\x9f\x51\x8b\x14\x20\xe0\x72\x99\x62\xd3\xec\xfa\x98\xe5\xd4\x3c\xe9\x3f\x0f\x66\x96\x15\xac\x8e\xba\xda\x2a\x19\xa7\x58\x58\xe5\x85\xd8\xcf\xfe\x7a\x3b\x2f\xbe\x7d\xa2\xa5\xbc\xb3\xdd\x8c\x4d\x40\xf8\x23\x59\x2c\x0f\x6b\xea\xda\xf5\x93\xec\x97\x10\x3d\x08\x83\xea\xd9\xc6\x7e\xdc\x2b\x08\x70\x56\x1d\xb1\x18\x71\x26\xa6\x88\x02\xcf\x17\xeb\x6f\xa8\x07\x3b\x36\x34\xc3\x20\x4a\x20\x92\x02\xe1\x77\x68\x44\xeb\x9e\x87\x30\xd6\x46\x2a\x32\x06\x9c\xb5\x16\x81\x6d\x98\x38\x59\xec\xeb\xc1\xb5\x9b\xd8\xa5\x1e\xcc\x21\x4c\x7d\xb0\x77\x2a\x29\x9d\xb9\x7b\xa2\x4c\x67\x14\xac\x1b\x2e\x06\xdf\xf7\xac\xf8\x59\xf9\x86\x69\x03\xa2\x67\x14\x90\x28\x48\x23\x52\x25\xc5\x52\xd3\x58\x28\x14\x33\xdc\xad\xa4\x10\xf8\xe7\xe7\x4b\xcf\x65\xe2\x07\x0d\x2a\xc8\x32\xe5\xaa\xf8\x27\x25\x93\x38\x27\xb2\x75\xc2\xda\xe9\xc5\x72\xcb\xae\x98\xa0\xed\xfb\xd9\x79\x47\xf0\x45\x30\x94\x66\x52\x0c\xbc\x6f\x67\x81\xb5\xe4\x6c\x33\x06\xd0\xab\x45\x60\xf9\x81\xd1\xa5\x6c\xb1\xa7\xc3\xed\x1f\xf3\x17\xdc\xdb\x85\xff\x24\x4c\x81\x0e\xb6\x55\x27\xab\x59\xef\xe5\xf1\xcf\xcd\x67\x6f\x19\x87\x20\xcd\xc9\x6b\x37\xe6\x0b\x7a\xb6\xd6\x5e\xb9\x7c\xb7\x41\x76\x64\xab\x34\xd1\xfc\x70\x44\xc1\x40\x68\x06\x2c\x3e\x46\x3f\x1c\xd9\x9b\x46\x90\x08\x8e\x6d\x3b\x6d\x69\x58\x81\xfe\xac\x1c\xf1\x42\x85\xbb\x87\xf7\x6f\x5b\xbd\x4e\x6f\xd0\xbc\x79\xe8\xf5\x5b\x5d\x5b\x93\x44\xc4\x16\x38\xf7\xc9\x90\xb3\xb0\x7d\xdf\xa0\x54\x81\xd6\x77\x24\x82\x72\x6d\xb2\xab\x18\x29\xca\xa3\x2c\x83\xa5\x9d\x08\x05\x85\xd3\x1e\x25\x2f\xc9\x9e\x81\x38\x9b\x72\xa9\xb6\x4e\xb0\x71\x7d\xdb\xbe\xeb\x76\x1e\xfa\xad\xee\xa0\xd1\xec\xb7\x7f\x69\xf4\x5b\x83\xc6\x43\xff\xdd\xe0\xb6\x73\xfd\x70\xd3\xb2\x9c\x25\x49\xcc\xa4\x95\x16\xc7\xf4\x5b\xd0\x4c\x2b\x03\x25\x13\xeb\x5c\x10\xb3\x75\x82\xef\x9d\x4a\xe5\x24\x7d\xee\x6a\x3e\xf4\xfa\x9d\xdb\xeb\x46\xbf\x71\xff\x70\x75\xd3\x6e\xb6\xef\x7b\xfd\x6e\xa5\xe2\xfc\xf6\xd5\x09\x65\x1e\x1b\x70\xa6\xcd\x3a\x99\xd6\xaf\xef\xda\x57\xed\x7e\xa7\x3b\xb8\x6a\x34\x7f\xb6\x97\x73\xe3\xdf\x0f\xdd\x56\x41\x20\xfd\x32\x68\x76\xee\xfa\x8d\xf6\x5d\xab\x9b\x1d\x32\x98\x4f\xd8\xd0\x16\xe1\xeb\x5a\xf7\xdd\xd6\xdb\xf6\xaf\x7f\x7b\x48\xac\xb0\x59\x5d\x58\x28\x23\xfb\xaa\x32\xe2\x6c\xee\x2e\x55\xdc\x14\xed\x92\x30\xb4\x1d\x3f\xb6\x87\xe8\x99\x6f\x2f\xbb\x37\x1a\xd9\x60\xab\xc0\x94\x78\xef\x63\x77\x0a\x8b\xc0\xee\xb1\x75\xf7\xcf\xb0\xd0\x47\xb6\xa3\xb5\x0f\x53\x6d\x7a\x54\xa9\xdc\xb2\x50\x49\x2d\x47\xc6\xcd\x67\xf4\xf4\xda\xcc\xba\x52\x39\xc9\x7c\x34\x23\x8a\xd9\x18\xd5\x47\x95\xca\x27\x39\x56\x2a\xc7\xc7\x27\xa8\x52\xa9\xf9\xd5\x33\xec\x9f\xe3\xea\x59\xa5\x72\xec\x4e\x61\x51\x3d\xa9\x54\xbe\x9d\xd7\xdd\x58\xc9\x18\x94\x61\xa0\xd7\x37\xe0\xc9\x49\x58\x33\x4d\x33\x89\x4a\xdf\xc0\x9c\xfa\x93\x13\xf3\x64\xcc\x84\xb6\x1f\x87\x44\x08\x50\xf6\x53\xd6\xa5\x52\xa7\x3e\x22\x5c\xc3\x89\x33\x24\xe1\x74\xac\x64\x22\x68\x53\x72\xa9\x9c\xba\xf3\x7d\xb5\x55\x3b\xad\xbd\x75\x4e\x9c\x91\x54\x50\x1a\x7b\x9b\xfe\x73\x4e\x9c\x09\x10\x0a\xaa\xcf\x0c\x07\xa7\x2e\x12\xce\x0b\x51\x33\x23\x55\x08\x47\x52\x9a\x0d\x21\x8b\xc8\x18\xee\x89\x99\x14\x02\xca\x74\x7a\x0f\x0d\x0b\x6b\x1f\x4f\x9c\xa1\x22\x82\x32\x31\xde\x64\xfd\xf1\xc4\x81\xb9\x01\x5b\x8c\x62\x5b\xd6\x68\x27\x7f\x61\x5e\x51\x44\xb9\xa6\xdd\x8c\x6c\xd0\xb1\x79\x08\x84\x61\xe1\xd2\x43\x65\xb3\x2b\xca\x69\xd6\x5a\xd3\xd9\x4c\x65\x27\xa9\xc9\x77\x52\x1b\xa7\xee\x14\x8f\x8d\x76\x2f\x5d\x2b\xf7\xdd\x50\x46\x4e\x61\x34\x37\x6b\x14\x09\xa7\xdb\x17\xf5\x2d\xce\x6b\xc2\xf2\x5e\x3c\x6f\x85\xd7\x62\xe6\xe3\x57\x99\x26\x9e\x8e\x6d\xe7\xb6\x65\x82\xf5\xda\xe1\x0a\x6c\x38\xa5\x49\xed\xaf\xb4\x38\xdf\xa2\x61\xb1\x05\xf2\xb2\x0a\x48\xdf\x64\x11\x8e\x33\x57\xc4\x4a\xda\x77\x16\x97\xee\xa8\x1b\xb8\x40\x58\x8f\xd0\x8e\xa2\xc3\x85\x79\x2c\x95\x29\x99\x4c\x37\x2d\x7d\xf7\xdb\xbf\x86\xd8\x7c\x81\xfa\xec\xe2\xed\x1a\x74\xa8\x58\x6c\x63\x3f\xb8\x9f\x8e\x63\xeb\xa9\x3a\xba\x96\x8f\x82\x4b\x42\xd1\x75\xd3\xeb\xf4\x90\x91\xc8\x4c\x98\x4e\x1b\x6c\xf7\xeb\x76\xb2\x4d\x29\x28\xb3\xb3\xdb\x83\xdf\x9a\x33\x6d\x74\xf0\x5d\xd9\x79\xff\x5b\x31\x11\x26\x8a\x23\x8c\xa7\x00\x31\xe1\x6c\x06\xd8\xb0\x08\x50\x0d\xe1\xd1\x8d\xee\xcd\x10\xc6\x59\x57\x5a\xf3\x11\xfe\x17\xaa\xda\x67\x14\x1f\xe1\x05\x3a\xf7\x11\x96\x5b\x7a\x5c\xfb\x8b\x9d\x36\x8a\xc4\xd6\x79\xee\xfc\x0f\x94\x3f\xdb\x5d\x15\xf2\xf5\x57\xbb\x4f\xc5\xe9\xba\xeb\x36\x50\xcf\x10\x43\x14\xc2\x64\x3e\xda\x87\x12\x6e\x7e\xd2\xf0\xbd\xd4\x26\xc0\x4b\xeb\x2a\x42\x78\x1f\xd3\x9f\x13\xee\x34\x8f\xca\xaf\x1e\xee\xbd\x18\x42\x46\x38\xfb\x03\xf2\x80\x1f\x49\xb5\x25\xe2\xd7\x1b\x9d\x4d\x36\xab\xe7\x62\xa7\xca\xdf\x1e\xc7\x5f\xd4\x11\x95\x94\x6d\xec\xc4\xb9\xeb\x50\x7a\xa9\xac\xbc\x58\xe3\x7c\xdf\x3e\xdd\xaa\x46\x09\x37\x0c\xdb\x9f\x62\x4a\x19\x61\x9f\x48\x28\x35\x91\x7b\x84\x41\xd9\xea\xca\x82\x94\xe4\xa0\xb3\x1f\x63\xd6\x31\xf7\xdd\xce\x2f\xed\x5e\xbb\x73\x37\xb0\x4d\xc1\xb3\x01\xe7\xd3\xbf\xde\x38\x1b\xa4\x1c\xff\xe2\xf5\x6b\x67\xe5\x1d\xd5\x51\x52\xda\x67\x25\x5c\xd0\x2a\xce\x8a\x0d\x9d\xbc\xcd\xd2\x9c\xcc\x00\x87\x32\x8a\xb2\x27\xeb\x67\x6e\x8d\x7e\xbf\xdb\xbe\x7a\xe8\xb7\x7a\xcf\xe4\xd6\xa7\x3b\xdf\x3e\xdd\x7f\x07\x00\x76\x25\x68\x15\x21\x20\x00\x00")
 
 func dcoscustomdata187TBytes() ([]byte, error) {
 	return bindataRead(
@@ -407,7 +408,7 @@ func dcoscustomdata187T() (*asset, error) {
 	return a, nil
 }
 
-var _dcoscustomdata188T = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x59\x7f\x73\x1a\xb7\xd6\xfe\x3f\x33\xf9\x0e\xea\xb6\x33\xd8\x6f\xac\xdd\x05\xc7\xf6\x1b\x3c\xdb\x19\x1b\x93\x86\x5b\xdb\x78\x00\xf7\xf6\xde\xa4\xc3\x88\xd5\x01\x54\xb4\xd2\x5e\x49\x8b\xa1\x6e\xbe\xfb\x1d\xad\x16\x02\x0b\x38\xb8\x49\x3a\x37\x33\xad\xe1\xec\x39\xd2\xa3\x73\x1e\x9d\x1f\xcb\x40\x4a\x13\x27\xb4\xfe\xf2\x05\x46\x03\xa2\xc7\x08\xc7\xc8\x63\x43\xf4\x1e\x7d\x87\xf0\x10\x05\x53\xa2\x02\xce\x06\x81\xa6\x03\x3c\x4a\x0d\xfa\xed\xdc\x8c\x41\x20\x88\xc7\x12\x5d\x35\xda\x5d\x7c\xf2\xff\x6f\xc2\xf3\x94\x28\x03\x14\x61\x8d\x02\x0a\x53\xab\x8c\x92\x09\x27\x03\xe0\x2f\x5f\x20\x34\x4a\xcd\xb9\x91\x59\x3c\xde\x58\xee\x7c\xc8\xbc\x97\x2f\x28\xd3\x93\xbe\x06\x93\xa5\x75\xab\x0e\xe9\x18\x12\x50\x84\x87\xf9\x57\x84\x38\x99\xcb\xcc\x14\x5f\x30\x3a\x09\xcb\x9f\xe4\x14\xd4\x83\x62\x06\xea\xc8\xa8\x0c\x9c\xd0\x90\x01\x87\xbe\x99\xa7\x50\xb7\x08\x5e\xbe\x18\xea\xe5\x26\x18\x51\x98\xb2\x18\xea\x2b\x9b\xf9\x55\x6b\x37\x64\x1c\xf4\x5c\x1b\x48\xea\x08\x66\xe6\xb5\x95\x6d\x2c\xbf\xd5\xbc\xb6\xb7\x79\x22\x33\x61\x74\x0e\x03\x6f\x00\xc0\x9f\x9c\x94\x80\x96\x7a\x43\xab\x56\xd2\xa2\x32\x9e\x80\x7a\xf9\x42\x65\x62\x11\xc8\x20\xd3\xee\x19\x49\x8d\xfd\xcf\x77\x90\xa8\x4f\x09\xe3\x73\xab\x91\xc7\xaf\x46\x69\x35\x86\xea\x19\x0e\xcf\xde\x00\x7e\x1d\x1e\xc7\x78\x70\x7c\x52\xc3\xa4\xfa\xa6\x56\x05\xa8\x85\x67\x00\xe8\x47\x14\xe8\xb9\x0e\x06\x99\x0e\xa6\x89\xfd\x3f\x55\x6c\x0a\x4a\x07\xe3\x69\x3f\x33\x8c\x07\x99\x18\x30\x41\xd1\xf7\x28\x61\x86\x8d\x88\x61\x52\xa0\xa1\x54\x68\x90\x8d\xd0\xd8\x98\x54\xd7\x83\x60\x90\x8d\xb4\xcf\x49\x26\xe2\x71\x4a\xa8\x2f\xc0\x04\xd9\x20\x13\x26\x0b\x5e\x69\x99\xa9\x18\x02\xce\x44\x36\x0b\x5e\x0d\xb2\x51\x50\x3d\x3d\x3b\x3d\x3d\x3e\xb1\x30\xb5\x25\x15\x43\x5e\xf5\x98\x7d\xf8\xea\x90\x3f\x08\x0f\x05\x60\xe2\x40\xc5\x3e\x97\x31\xe1\xdf\xe6\x0c\x18\x71\xe1\x42\x86\x75\x11\xba\x01\x13\x81\x4a\x8a\x2f\x36\x56\x0b\xc1\x4e\xed\x64\x42\x99\x2a\x19\x14\xb2\x9d\x36\x86\x94\x2d\x72\xc9\x4e\xfd\x85\x70\xa9\x6e\x05\x3b\xb5\xe3\xb4\xa4\x6d\x05\x3b\xb5\x1d\x01\x63\xc3\x4b\x46\x2b\xf2\xdd\x67\xb7\xd7\xa5\x7c\x76\x27\xdb\x69\x63\x53\x59\xc9\xc4\x89\xb6\x5a\x58\x15\x6d\x75\x32\x0d\x8a\x50\x5a\xb2\x5c\x4a\xad\x71\xe9\x24\x94\x69\x9b\x67\x8a\x05\xb1\x90\x0f\xee\xa3\x02\x2d\xf9\x34\x96\x62\xe8\x6b\x50\x36\x59\x6c\x35\x4f\x88\x9e\x6c\xd8\xf2\x59\x8c\x05\x98\x55\xc3\x40\xda\x9b\xfc\x47\xa6\x20\x88\xa5\x30\x84\x09\xcb\xe7\x54\xc9\x29\xd3\x4c\x0a\x7f\x71\xb4\x45\x54\xf0\x22\x3a\x39\xbf\x73\x2c\xbe\x05\x53\x48\x4d\x92\xae\x4b\xad\xed\x82\x91\x78\xb8\xcb\x76\xeb\x0e\x1b\x6b\xed\xb6\x2d\x1d\x5e\x1b\xa2\x8a\xc0\xd2\x58\x6a\xec\x32\x19\x66\x42\x1b\xc2\xf9\x93\x7e\x2b\x9b\xda\x3d\xd8\x08\xe7\x69\x56\x3d\x69\xa9\x60\xc5\xb6\xc8\x8b\xf8\x77\x99\x29\x41\x38\xdd\xdf\xd2\x61\x7d\x16\x46\xce\xc4\x04\x83\x98\x3e\x69\x04\xe2\x13\x9d\x72\xab\xbc\x64\x3d\x69\x62\x99\x83\x07\x5c\xc6\x93\xed\xfb\x96\x56\xc8\x7d\xd4\xcf\x0b\x55\x5e\x2c\x2c\x9f\x40\x98\x3a\xaa\x2c\x32\x9d\xb5\x62\xd2\xcf\xe9\x06\x74\x04\x79\xae\xb3\xc2\x40\x1b\x87\xce\x55\xd8\x4a\x5e\xdd\x1e\x04\xa8\x3a\x52\x52\xe6\x7b\xa6\xc4\x8c\xeb\x2e\xfc\x79\xf9\x4a\xc7\xa0\x20\xc8\x21\xe0\x21\x27\x23\x1d\x28\x48\xa5\x66\x46\xaa\x39\xce\x54\x7e\x84\x14\x54\xc2\xb4\xe5\xb1\xae\xa3\x4a\x78\xfa\xfa\x75\x65\x1d\x98\x6d\x34\xfa\xcd\xdb\x5f\x5a\x9d\xf6\xed\x4d\xf3\xb6\x17\x3d\x3e\x3e\x1a\xa2\x46\x60\x9a\x62\xca\x94\x14\x09\x08\xf3\xf1\xe3\xc7\x2f\x03\xe6\x38\x08\x29\x97\x73\x1b\xa6\xc5\xba\x7b\x21\x7c\xef\xad\xd2\xd0\xb9\xbc\x6f\x61\xff\x74\xdf\xba\xf2\x8e\x90\x7b\x9c\x80\x21\x94\x18\xb2\xa1\xf0\xdb\x97\x21\x8f\x79\xa6\x0d\x28\x9c\x92\x78\x42\x46\xa0\xfd\xdf\xb5\x14\xfb\xe1\xfe\x87\x63\xfe\x12\xc0\x0d\x99\x5d\xc3\x14\x78\x43\x0a\x2d\x39\x44\x0f\x44\x09\x26\x46\x8b\xc7\x1d\x62\xe0\x9a\x25\xcc\xb4\x84\x01\x35\x25\x3c\xaa\xea\x8d\x67\x97\x99\xd2\x26\xaa\x85\x61\x18\xee\x79\xae\xe2\x1e\x06\xcb\x7b\x98\x27\x4e\x9a\x87\x64\x99\x55\x3e\x77\x18\x4f\xc1\x4c\x91\x79\xfd\x83\x40\x88\xcb\x11\xb7\xc7\xa8\x23\x26\x86\xd2\x4a\x12\x49\x33\x4b\x79\xfb\x19\x21\x0a\x43\x92\x71\x83\x09\x4d\x98\x28\x64\x08\x8d\xa5\x36\xf5\x0f\x0e\xf1\x07\x64\xe2\xb4\x1e\x04\xd5\xda\x99\x1f\xfa\xa1\x5f\xad\x9f\x56\xc3\xf0\x78\xdd\xdc\x65\x81\xa5\x7d\x51\x0c\xa8\x6b\xf4\x3e\x08\xaf\x74\x4c\x07\x30\x70\x2c\xf1\xe7\xc9\x7e\xec\x7f\x7f\x2f\x98\x59\x06\xe8\x62\x68\x40\x45\x02\xcc\x83\x54\x13\x2c\x05\x67\x02\x7c\x77\x19\x16\x2a\xff\x24\xc2\xe8\xa7\x55\xde\x77\x5d\x3a\x58\x2e\xdb\x9b\xa7\x10\x49\x01\x7a\x2c\x97\x4a\x2b\x97\x2b\xba\x6a\x5e\xb6\x2e\x6e\xfb\x6f\x3b\xed\xdb\x5e\xf3\xf6\x2a\x12\x52\x30\x4b\x01\x12\x1b\x36\x5d\x66\x84\xae\x21\x82\x12\x45\xdb\x99\x49\x33\x13\x15\xe1\x7c\x15\x3b\x32\x95\xb5\x9a\x4a\x49\xb5\x4b\xa9\x39\x83\xb8\x6b\x33\x59\xb4\x56\xc1\xf3\xf9\xc4\xa8\x79\x54\x3d\xcf\x84\x61\x1c\xd1\x74\x32\x42\xf8\xea\xd8\xf6\x89\xeb\x9d\x73\x40\x79\x40\x7d\x0a\x03\xf4\xe7\x9f\xe8\xe0\xc0\xa8\xf9\x8f\x6f\x0e\x0f\xcf\xa9\x74\x1b\xe4\xfd\xa4\x02\xa3\xe6\xe8\x87\xfc\xe9\xab\x57\x87\x87\xe7\x9a\x03\xa4\x85\xe0\xff\x8c\x9a\xe7\x06\x02\xce\x97\x39\xd7\x15\x6a\x97\x64\x8b\x2a\x70\xbe\x96\x90\xd1\xa2\x50\x2c\x6b\x84\xfd\x63\xbc\xb5\xab\xb0\x85\xfb\xee\x6f\xf0\x64\x25\xdc\x87\x2d\xe5\xc8\x76\x1c\x9a\x88\xf0\x07\x32\xd7\x2b\x31\x50\x66\xfd\x26\x87\x25\x8b\x2e\xc4\x51\xf5\x64\x23\x1e\x77\x0a\x22\xec\xda\x25\x96\x22\x5b\xd4\x10\x05\x5e\x1c\x36\xdc\x0c\xdf\x13\x01\x75\x36\x88\x12\x48\xa4\x40\xf8\x1d\x1a\xd2\x7a\x10\x20\x8c\xb5\x91\x8a\x8c\x00\xbb\xc6\x3d\xb2\x13\x14\x27\xf3\x7d\x3d\xb8\x56\x9a\x7d\x1a\xc0\x0c\xe2\xfc\x44\x7b\xa7\x92\xd2\x9d\xbb\x23\xca\xb4\x87\x51\xb9\xe6\x2f\xae\x52\x1e\xdf\xa5\xf2\x35\xd3\x06\x44\xd7\x28\x20\x49\x94\x33\x52\x65\x22\x58\xe1\xc2\x32\x04\xb9\xdd\x8d\xa4\x10\x85\xa7\xa7\xe1\xba\xf8\x5e\x83\x8a\x5c\xa6\x5c\x15\xff\xa4\x64\x96\x46\x8b\x91\x6f\xcb\x86\xb5\xe3\xb3\x65\xc8\x2e\x99\xa0\xad\xbb\xe9\x69\x5b\xf0\x79\x34\x90\x66\xbc\x84\xdc\x72\xc4\xfa\x6d\x35\x63\x00\xbd\x9c\x47\x8e\xab\xba\x94\x2d\xf6\x74\x78\x6e\xfb\x17\xdc\xdb\x81\xff\x64\x4c\x81\x8e\xb6\xb5\x2b\xab\x59\x6f\xf7\xf3\xe7\xe6\xb3\xb7\x8c\x43\x94\x9f\x65\xad\xd2\xef\xd0\xb3\xcd\xf7\x4a\xe9\xdd\x66\xf2\x44\xb6\xca\x13\xcd\x0f\x07\x14\x0c\xc4\xa6\xcf\xd2\x43\xf4\xc3\x81\xad\x34\x82\x24\x70\x68\x87\x55\x0b\xc3\x0a\xf4\xb3\x72\xc4\x8e\x96\x77\x0f\xef\xdf\x34\xbb\xed\x6e\xbf\x71\x7d\xdf\xed\x35\x3b\xb6\x97\x4a\x88\x6d\x1f\xee\xb2\x01\x67\x71\xeb\xee\x82\x52\x05\x5a\xdf\x92\x04\xca\x3d\xd5\x53\xad\xc8\xa2\xf9\x70\xe8\xf2\xd1\x84\x82\xc2\x79\x17\x59\x34\x3c\x9f\x0c\xb1\xdb\x72\xa9\xb6\x0e\xf0\xe2\xea\xa6\x75\xdb\x69\xdf\xf7\x9a\x9d\xfe\x45\xa3\xd7\xfa\xe5\xa2\xd7\xec\x5f\xdc\xf7\xde\xf5\x6f\xda\x57\xf7\xd7\x4d\x8b\x59\x92\xcc\x8c\x9b\x79\xb7\x4c\xbf\x05\xcc\xbc\x33\x50\x32\xb3\xce\x05\x31\x2d\xb7\x7b\x95\xca\x51\xfe\xfe\xab\x71\xdf\xed\xb5\x6f\xae\x2e\x7a\x17\x77\xf7\x97\xd7\xad\x46\xeb\xae\xdb\xeb\x54\x2a\xa5\x8e\xee\xab\xf8\x2d\xf7\x58\x9f\x33\x6d\xd6\xc1\x34\x7f\x7d\xd7\xba\x6c\xf5\xda\x9d\xfe\xe5\x45\xe3\x67\x5b\x9c\x2f\xfe\x7d\xdf\x69\x2e\xaf\x8e\xfd\xd2\x6f\xb4\x6f\x7b\x17\xad\xdb\x66\xc7\x5d\x22\x98\x8d\xd9\xc0\x36\xe1\xeb\x5a\x77\x9d\xe6\xdb\xd6\xaf\x7f\x3b\x25\x56\xd0\xac\x1e\x2c\x96\x89\x1d\x3d\x86\x9c\xcd\xfc\xa5\x8a\x1b\x4b\x7c\x12\xc7\x32\x13\x06\xdb\x4b\xf4\x09\x6f\xd7\xd5\x8d\x0b\xf7\xb0\xb9\xb0\x29\xe1\xde\x67\xdd\x09\xcc\x23\x1b\x63\xeb\xee\x9f\x61\xae\x0f\xec\x30\x9b\xa9\x18\x5a\xf4\xa0\x52\xb9\x61\xb1\x92\x5a\x0e\x8d\x5f\xec\x18\xe8\xb5\x9d\x75\xa5\x72\xe4\xf6\x9a\x12\xc5\x2c\x47\xf5\x41\xa5\xf2\x59\x8c\x95\xca\xe1\xe1\x11\xaa\x54\x6a\x61\xf5\x04\x87\xa7\xb8\x7a\x52\xa9\x1c\xfa\x13\x98\x57\x8f\x2a\x95\x6f\xe7\x75\x3f\x55\x32\x05\x65\x18\xe8\xf5\x00\x3c\x7a\x19\x6b\xe4\x69\x26\x53\xf9\x4b\x31\xaf\xfe\xe8\xa5\x3c\x1b\x31\xa1\xed\xc7\x01\x11\x02\x94\xfd\xe4\xc6\x56\xea\xd5\x87\x84\x6b\x38\xf2\x06\x24\x9e\x8c\x94\xcc\x04\x6d\x48\x2e\x95\x57\xf7\xbe\xaf\x36\x6b\xc7\xb5\xb7\xde\x91\x37\x94\x0a\x4a\xcf\xde\xe6\xff\xbc\x23\x6f\x0c\x84\x82\xea\x31\xc3\xc1\xab\x8b\x8c\xf3\x85\xa8\xe1\x40\x2d\x84\x43\x29\xcd\x86\x90\x25\x64\x04\x77\xc4\x8c\x17\x02\xca\x74\x9e\x09\x07\x8b\xd5\x3e\x1e\x79\x03\x45\x04\x65\x62\xb4\x89\xfa\xe3\x91\x07\x33\x03\xb6\x19\xcd\x67\x75\xed\x15\xaf\x9c\x57\x14\x51\xa1\x69\x83\xe1\x1e\x7a\x36\x0f\x81\x30\x2c\x5e\x7a\xa8\xbc\xec\x8a\x72\x9e\xb5\xd6\x74\x36\x53\xd9\x51\xbe\xe4\x3b\xa9\x8d\x57\xf7\x56\x67\x72\xdf\xca\x43\x3f\x96\x89\xb7\x58\xb4\x58\xd6\x28\x12\x4f\xb6\x1f\xea\x5b\xdc\xd7\x8c\x15\xd5\xa7\x18\x34\xd7\x38\xf3\xf1\xab\x6c\x93\x4e\x46\x76\x72\xdb\xb2\xc1\x7a\xef\x70\x09\x96\x4e\x79\x52\xfb\x2b\x23\xce\xb7\x18\x58\x6c\x83\xbc\xfe\x92\x16\xe1\xd4\xb9\x22\x55\x72\xc8\x38\xf8\xf4\x89\xbe\x81\x0b\x84\xf5\x10\x3d\xd1\x74\xf8\x30\x4b\xa5\x32\xa5\x25\x1d\x45\xf4\xf8\x39\x3d\xc4\xe6\x2b\xa9\x67\x37\x6f\x57\xa0\x63\xc5\x52\xcb\xfd\xe8\x6e\x32\x4a\xad\xa7\xea\xe8\x4a\x3e\x08\x2e\x09\x45\x57\x8d\xa0\xdd\x45\x46\x22\x33\x66\x3a\x1f\xb0\xfd\xaf\x3b\xc9\x36\xa4\xa0\xcc\xee\x6e\x2f\x7e\x73\xc6\xb4\xd1\xd1\x77\x65\xe7\xfd\x6f\x71\x22\xce\x94\x1d\x23\x27\x00\x29\xe1\x6c\x0a\xd8\xb0\x04\x50\x0d\xe1\xe1\xb5\xee\x4e\x11\xc6\x6e\x2a\xad\x85\x08\xff\x0b\x55\x43\xfb\x0f\xe1\x39\x3a\x0d\x11\x96\x5b\x66\xdc\x81\x94\x46\x1b\x45\x52\xeb\x19\x7f\xf6\x07\x7a\x7c\x7c\xb4\xd1\xbd\x5c\xc8\xef\x3b\xd7\x2b\x59\xe0\x73\x3c\x5d\x77\xdd\x13\x44\x35\x44\x21\x4c\x66\xc3\x7d\x20\xe1\xc6\x67\x17\xbe\x93\xda\x44\x78\xe5\xe7\x90\xb5\xdf\x1f\x77\x2e\xfd\xbc\xb1\xda\xb1\xf2\xab\xd3\xbd\x9b\x42\xcc\x08\x67\x7f\x40\x41\xf8\xa1\x54\x5b\x18\xbf\x3e\xe8\x6c\xa2\xd9\x98\x75\x76\xa9\xfc\xed\x3c\xfe\xa2\x89\xa8\xa4\x6c\xa3\x9b\x16\xae\x43\x79\xb6\x5f\x79\x85\x8d\x8b\xb8\x7d\x7e\x54\x4d\x32\x6e\x18\xce\x34\xa8\x67\x4e\xab\x5b\x86\xc8\x3d\x68\xf0\x44\x55\x53\x92\x83\x76\xbf\xce\xac\xdb\xdc\x75\xda\xbf\xb4\xba\xad\xf6\x6d\xdf\x0e\x05\x9f\x16\xf0\x3e\xff\x73\x8e\xb7\x01\xca\x0b\xcf\x5e\xbf\xf6\x56\xde\xa3\x7a\x4a\x4a\xe3\xd9\x1d\x0b\x58\xcb\x5f\x84\x63\xa9\x8b\x31\x4b\x73\x32\x05\x1c\xcb\x24\x71\x2f\x84\x57\x66\xac\x5e\xaf\xd3\xba\xbc\xef\x35\xbb\x9f\xc0\xad\x6f\x77\xba\x7d\xbb\xff\x06\x00\x00\xff\xff\x2a\x17\x3e\x91\x32\x20\x00\x00")
+var _dcoscustomdata188T = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x59\x6f\x73\x22\x37\x93\x7f\xef\x2a\x7f\x07\x65\x92\x2a\xec\x5b\x6b\x66\xc0\x6b\xfb\x16\xd7\xa4\x0a\x63\x36\xcb\xc5\x36\x2e\xc0\xb9\xdc\x79\x53\x94\x18\x35\xa0\xa0\x91\xe6\x91\x34\x18\xe2\xec\x77\x7f\x4a\xf3\x07\xc3\x80\x59\x36\xd9\x4d\x3d\xfb\x62\x0d\xad\xfe\xb5\x7e\x6a\xb5\x5a\xdd\x62\x28\xa5\x09\x23\x5a\x3f\x3c\xc0\x68\x48\xf4\x04\xe1\x10\x39\x6c\x84\x1e\xd1\x77\x08\x8f\x90\x37\x23\xca\xe3\x6c\xe8\x69\x3a\xc4\xe3\xd8\xa0\xdf\x2e\xcd\x04\x04\x82\x70\x22\xd1\x75\xb3\xd3\xc3\x67\xff\xfd\xce\xbf\x8c\x89\x32\x40\x11\xd6\xc8\xa3\x30\xb3\xca\x28\x9a\x72\x32\x04\x7e\x78\x80\xd0\x38\x36\x97\x46\x26\xe1\x64\xc3\xdc\xe5\x88\x39\x87\x07\x94\xe9\xe9\x40\x83\x49\xe2\xba\x55\x87\x78\x02\x11\x28\xc2\xfd\xf4\x2b\x42\x9c\x2c\x64\x62\xf2\x2f\x18\x9d\xf9\xe5\x4f\x72\x06\xea\x49\x31\x03\x75\x64\x54\x02\x99\xd0\x90\x21\x87\x81\x59\xc4\x50\xb7\x0c\x0e\x0f\x46\x7a\x39\x09\x46\x14\x66\x2c\x84\xfa\xca\x64\x6e\xd5\xe2\x46\x8c\x83\x5e\x68\x03\x51\x1d\xc1\xdc\xbc\x3d\x3c\xd8\x62\x7e\x2b\xbc\xb6\x37\x3c\x92\x89\x30\x3a\xa5\x81\x37\x08\xe0\x17\x27\x45\xa0\xa5\xde\xd0\xaa\x95\xb4\xa8\x0c\xa7\xa0\x0e\x0f\x54\x22\x8a\x8d\xf4\x12\x9d\x8d\x91\xd8\x78\x24\x36\x6e\x46\x89\xba\x94\x30\xbe\xb0\x1a\xe9\xfe\xd5\x28\xad\x86\x50\xbd\xc0\xfe\xc5\x3b\xc0\x6f\xfd\xd3\x10\x0f\x4f\xcf\x6a\x98\x54\xdf\xd5\xaa\x00\x35\xff\x02\x00\xfd\x88\x3c\xbd\xd0\xde\x30\xd1\xde\x2c\xb2\xff\x53\xc5\x66\xa0\xb4\x37\x99\x0d\x12\xc3\xb8\x97\x88\x21\x13\x14\x7d\x8f\x22\x66\xd8\x98\x18\x26\x05\x1a\x49\x85\x86\xc9\x18\x4d\x8c\x89\x75\xdd\xf3\x86\xc9\x58\xbb\x9c\x24\x22\x9c\xc4\x84\xba\x02\x8c\x97\x0c\x13\x61\x12\xef\x8d\x96\x89\x0a\xc1\xe3\x4c\x24\x73\xef\xcd\x30\x19\x7b\xd5\xf3\x8b\xf3\xf3\xd3\x33\x4b\x53\xdb\xa0\x62\xc8\xa9\x9e\xb2\x8f\x5f\x9d\xf2\x47\xe1\x20\x0f\x4c\xe8\xa9\xd0\xe5\x32\x24\xfc\xdb\xac\x01\x23\x2e\xb2\x2d\xc3\x3a\xdf\xba\x21\x13\x9e\x8a\xf2\x2f\x76\xaf\x0a\xc1\xab\xda\xd1\x94\x32\x55\x02\xe4\xb2\x57\x31\x86\x94\x11\x86\xec\xd2\xe7\xa2\xa4\xce\xc5\x0e\xed\x30\x2e\x69\x87\xf1\x0e\xed\x2c\x00\x43\xc3\x4b\xa0\x15\xf9\xab\xd8\xf4\xb8\x94\x70\xb9\xec\x55\x8c\x4d\x65\x25\x48\x26\xda\x8a\xb0\x6c\xb4\xa5\x93\x68\x50\x84\xd2\x12\x72\x29\xb5\xe0\xd2\x4a\x28\xd3\x36\xcf\xe4\x06\xb1\x90\x4f\xd9\x47\x05\x5a\xf2\x59\x28\xc5\xc8\xd5\xa0\x6c\xb2\xd8\x0a\x8f\x88\x9e\x6e\x60\xf9\x3c\xc4\x02\xcc\x2a\xd0\x93\xf6\x24\xff\x91\x28\xf0\x42\x29\x0c\x61\xc2\x1e\xc1\x58\xc9\x19\xd3\x4c\x0a\xb7\x58\x5a\xb1\x2b\xb8\xd8\x9d\x34\xbe\x53\x2e\xae\x25\x93\x4b\x4d\x14\xaf\x4b\x2d\xb6\x88\x48\x3c\x7a\x0d\xbb\x75\x86\x0d\x5b\xaf\x63\x4b\x8b\xd7\x86\x28\x93\xbb\x31\x94\x1a\x67\x99\x0c\x33\xa1\x0d\xe1\x7c\xa7\xdf\xca\x50\x3b\x07\x1b\xe3\x34\xcd\xaa\x9d\x48\x05\x2b\xd8\x3c\x2f\xe2\xdf\x65\xa2\x04\xe1\x74\x7f\x64\xc6\x75\xa7\xfe\xaa\xb6\xe5\xc8\x99\x98\x62\x10\xb3\x9d\x20\x10\x2f\xe1\x94\xa2\xd2\x2b\x6b\x27\xc4\x46\x0e\x1e\x72\x19\x4e\xb7\xcf\x5b\xb2\x90\xfa\x68\x90\x5e\x54\xe9\x15\x64\xe3\x09\x84\xa9\xa3\xca\xf3\xf3\xb3\x05\x74\x21\x96\x9a\x19\xa9\x16\x0f\xdd\x9b\x4f\x9f\x3e\x1d\x1e\x58\x63\x08\x55\xec\x1f\xf9\x24\x40\xd5\x91\x92\x32\x5d\x5a\x4c\xcc\xa4\x9e\x45\x4a\x7a\x5b\xc5\x13\x50\xe0\xa5\x33\xe2\x11\x27\x63\xed\xa9\xa5\x35\x9c\xa8\x94\x71\x0c\x2a\x62\xda\x86\xad\xae\xa3\x8a\x7f\xfe\xf6\x6d\x65\x9d\x87\xad\x2b\x06\xad\xbb\x5f\xda\xdd\xce\xdd\x6d\xeb\xae\x1f\x3c\x3f\x3f\x1b\xa2\xc6\x60\x5a\x62\xc6\x94\x14\x11\x08\xf3\xb7\x89\xd9\xa5\x62\x0a\x31\x97\x0b\x0c\x2f\x76\xf7\x62\xf8\xe8\xac\x46\x1d\x4e\xd7\x3b\xb0\xb4\x7f\x7a\x68\x5f\x3b\x27\x28\x1b\x8e\xc0\x10\x4a\x0c\xd9\x50\xf8\xed\xef\x31\x0f\x79\xa2\x0d\x28\x1c\x93\x70\x4a\xc6\xa0\xdd\xdf\xb5\x14\xfb\xf1\xfe\x9f\x2c\xd0\x97\x04\x6e\xc9\xfc\x06\x66\xc0\x9b\x52\x68\xc9\x21\x78\x22\x4a\x30\x31\x2e\x86\xbb\xc4\xc0\x0d\x8b\x98\x69\x0b\x03\x6a\x46\x78\x50\xd5\x1b\x63\x57\x89\xd2\x26\xa8\xf9\xbe\xef\xef\xb9\xae\xfc\xd8\x79\xcb\x63\x67\x8f\xaf\x4b\x3d\xeb\xb5\x65\x12\xf9\xdc\x62\x1c\x05\x73\x45\x16\xf5\x8f\x02\x21\x2e\xc7\xdc\x2e\xa3\x8e\x98\x18\x49\x2b\x89\x24\x4d\x6c\x84\xdb\xcf\x08\x51\x18\x91\x84\x1b\x4c\x68\xc4\x44\x2e\x43\x68\x22\xb5\xa9\x7f\xb4\xdc\x10\xfa\x88\x4c\x18\xd7\x3d\xaf\x5a\xbb\x70\x7d\xd7\x77\xab\xf5\xf3\xaa\xef\x9f\xae\xc3\xb3\x43\xbf\xc4\xe7\xb9\x9f\x66\x55\xe7\x47\xe1\x94\x96\x99\x11\xb4\x09\x7b\xc4\xc6\xee\x22\xda\x2f\xfa\x1f\x1f\x04\x33\xcb\x0d\x6a\x8c\x0c\xa8\x40\x80\x79\x92\x6a\x8a\xa5\xe0\x4c\x80\x9b\x1d\x86\x42\xe5\x7f\x89\x30\x7a\xb7\xca\x63\x2f\xcb\x1f\x4b\xb3\xfd\x45\x0c\x81\x14\xa0\x27\x72\xa9\xb4\x72\xb8\x82\xeb\xd6\x55\xbb\x71\x37\x78\xdf\xed\xdc\xf5\x5b\x77\xd7\x81\x90\x82\xd9\x10\x20\xa1\x61\x33\x28\x10\x3d\x43\x04\x25\x8a\x76\x12\x13\x27\x26\xc8\xb7\xf3\x4d\x98\x05\x53\x59\xab\xa5\x94\x54\xaf\x29\xb5\xe6\x10\xf6\x6c\xe2\x0a\xd6\x2e\xec\xb4\x1d\x31\x6a\x11\x54\x2f\x13\x61\x18\x47\x34\x9e\x8e\x11\xbe\x3e\xb5\x65\xe1\x7a\xa1\xec\x51\xee\x51\x97\xc2\x10\xfd\xf9\x27\x3a\x3a\x32\x6a\xf1\xe3\xbb\xe3\xe3\x4b\x2a\xb3\x09\xd2\xf2\x51\x81\x51\x0b\xf4\x43\x3a\xfa\xe6\xcd\xf1\xf1\xa5\xe6\x00\x71\x2e\xf8\x2f\xa3\x16\x29\x40\xc0\xe5\x32\xc5\xa6\xd9\xf5\x29\xcb\xa9\x79\xd2\x7f\x19\xcc\x2c\x2b\x58\x1d\x75\xb5\x55\x32\x4e\xb1\xb0\xca\x2b\xb1\x9f\xfd\xf5\x76\x5e\x7c\xfb\x44\x4b\x79\x67\xbb\x19\x9b\x80\xf0\x27\xb2\x58\x1e\xd6\xd4\xb5\xeb\x27\xd9\x2f\x21\x7a\x10\x06\xd5\xb3\x8d\xfd\xb8\x57\x10\xe0\xac\x3a\x62\x31\xe2\x4c\x4c\x11\x05\x9e\x2f\xd6\xdf\x50\x0f\x76\x6c\x68\x86\x41\x94\x40\x24\x05\xc2\x1f\xd0\x88\xd6\x3d\x0f\x61\xac\x8d\x54\x64\x0c\x38\x6b\x2d\x02\xdb\x30\x71\xb2\xd8\xd7\x83\x6b\x37\xb1\x4b\x3d\x98\x43\x98\xfa\x60\xef\x54\x52\x3a\x73\xf7\x44\x99\xce\x28\x58\x37\x5c\x0c\x3e\xf6\xac\xf8\x45\xf9\x86\x69\x03\xa2\x67\x14\x90\x28\x48\x23\x52\x25\xc5\x52\xd3\x58\x28\x14\x33\xdc\xad\xa4\x10\xf8\xe7\xe7\x4b\xcf\x65\xe2\x07\x0d\x2a\xc8\x32\xe5\xaa\xf8\x27\x25\x93\x38\x27\xb2\x75\xc2\xda\xe9\xc5\x72\xcb\xae\x98\xa0\xed\xfb\xd9\x79\x47\xf0\x45\x30\x94\x66\x52\x0c\x3c\xb6\xb3\xc0\x5a\x72\xb6\x19\x03\xe8\xd5\x22\xb0\xfc\xc0\xe8\x52\xb6\xd8\xd3\xe1\xf6\x8f\xf9\x0b\xee\xed\xc2\xbf\x12\xa6\x40\x07\xdb\xaa\x93\xd5\xac\xf7\xfa\xf8\x97\xe6\xb3\xf7\x8c\x43\x90\xe6\xe4\xb5\x9b\xfe\x15\x3d\x5b\x6b\xaf\x5c\xbd\xdb\x20\x3b\xb2\x55\x9a\x68\x7e\x38\xa2\x60\x20\x34\x03\x16\x1f\xa3\x1f\x8e\xec\x4d\x23\x48\x04\xc7\xb6\x9d\xb6\x34\xac\x40\x7f\x51\x8e\x78\xa5\xc2\xdd\xc3\xfb\xb7\xad\x5e\xa7\x37\x68\xde\x3c\xf4\xfa\xad\xae\xad\xa5\x22\x62\xcb\x87\xfb\x64\xc8\x59\xd8\xbe\x6f\x50\xaa\x40\xeb\x3b\x12\x41\xb9\xa6\xda\x55\x8a\x14\xc5\x47\x96\xc1\xd2\x4e\x84\x82\xc2\x69\x8f\x92\x17\x3c\x2f\x40\x9c\x4d\xb9\x54\x5b\x27\xd8\xb8\xbe\x6d\xdf\x75\x3b\x0f\xfd\x56\x77\xd0\x68\xf6\xdb\xbf\x34\xfa\xad\x41\xe3\xa1\xff\x61\x70\xdb\xb9\x7e\xb8\x69\x59\xce\x92\x24\x66\xd2\x4a\x8b\x63\xfa\x2d\x68\xa6\x95\x81\x92\x89\x75\x2e\x88\xd9\x3a\xc1\x47\xa7\x52\x39\x49\x9f\xbb\x9a\x0f\xbd\x7e\xe7\xf6\xba\xd1\x6f\xdc\x3f\x5c\xdd\xb4\x9b\xed\xfb\x5e\xbf\x5b\xa9\x38\xbf\x7d\x75\x42\x99\xc7\x06\x9c\x69\xb3\x4e\xa6\xf5\xeb\x87\xf6\x55\xbb\xdf\xe9\x0e\xae\x1a\xcd\x9f\xed\xe5\xdc\xf8\xff\x87\x6e\xab\x20\x90\x7e\x19\x34\x3b\x77\xfd\x46\xfb\xae\xd5\xcd\x0e\x19\xcc\x27\x6c\x68\x8b\xf0\x75\xad\xfb\x6e\xeb\x7d\xfb\xd7\x7f\x3c\x24\x56\xd8\xac\x2e\x2c\x94\x91\x7d\x55\x19\x71\x36\x77\x97\x2a\x6e\x8a\x76\x49\x18\xda\x8e\x1f\xdb\x43\xf4\xc2\xb7\x97\xdd\x1b\x8d\x6c\xb0\x55\x60\x4a\xbc\xf7\xb1\x3b\x85\x45\x60\xf7\xd8\xba\xfb\x67\x58\xe8\x23\xdb\xd1\xda\x87\xa9\x36\x3d\xaa\x54\x6e\x59\xa8\xa4\x96\x23\xe3\xe6\x33\x7a\x7a\x6d\x66\x5d\xa9\x9c\x64\x3e\x9a\x11\xc5\x6c\x8c\xea\xa3\x4a\xe5\xb3\x1c\x2b\x95\xe3\xe3\x13\x54\xa9\xd4\xfc\xea\x19\xf6\xcf\x71\xf5\xac\x52\x39\x76\xa7\xb0\xa8\x9e\x54\x2a\xdf\xce\xeb\x6e\xac\x64\x0c\xca\x30\xd0\xeb\x1b\xf0\xec\x24\xac\x99\xa6\x99\x44\xa5\x6f\x60\x4e\xfd\xd9\x89\x79\x32\x66\x42\xdb\x8f\x43\x22\x04\x28\xfb\x29\xeb\x52\xa9\x53\x1f\x11\xae\xe1\xc4\x19\x92\x70\x3a\x56\x32\x11\xb4\x29\xb9\x54\x4e\xdd\xf9\xbe\xda\xaa\x9d\xd6\xde\x3b\x27\xce\x48\x2a\x28\x8d\xbd\x4f\xff\x39\x27\xce\x04\x08\x05\xd5\x67\x86\x83\x53\x17\x09\xe7\x85\xa8\x99\x91\x2a\x84\x23\x29\xcd\x86\x90\x45\x64\x0c\xf7\xc4\x4c\x0a\x01\x65\x3a\xbd\x87\x86\x85\xb5\x4f\x27\xce\x50\x11\x41\x99\x18\x6f\xb2\xfe\x74\xe2\xc0\xdc\x80\x2d\x46\xb1\x2d\x6b\xb4\x93\xbf\x30\xaf\x28\xa2\x5c\xd3\x6e\x46\x36\xe8\xd8\x3c\x04\xc2\xb0\x70\xe9\xa1\xb2\xd9\x15\xe5\x34\x6b\xad\xe9\x6c\xa6\xb2\x93\xd4\xe4\x07\xa9\x8d\x53\x77\x8a\xc7\x46\xbb\x97\xae\x95\xfb\x6e\x28\x23\xa7\x30\x9a\x9b\x35\x8a\x84\xd3\xed\x8b\xfa\x16\xe7\x35\x61\x79\xa7\x9b\x37\x9a\x6b\x31\xf3\xe9\xab\x4c\x13\x4f\xc7\xb6\x73\xdb\x32\xc1\x7a\xed\x70\x05\x36\x9c\xd2\xa4\xf6\x57\x5a\x9c\x6f\xd1\xb0\xd8\x02\x79\x59\x05\xa4\x6f\xb2\x08\xc7\x99\x2b\x62\x25\xed\x3b\x8b\x4b\x77\xd4\x0d\x5c\x20\xac\x47\x68\x47\xd1\xe1\xc2\x3c\x96\xca\x94\x4c\xa6\x9b\x96\xbe\xfb\xed\x5f\x43\x6c\xbe\x40\x7d\x71\xf1\x76\x0d\x3a\x54\x2c\xb6\xb1\x1f\xdc\x4f\xc7\xb1\xf5\x54\x1d\x5d\xcb\x27\xc1\x25\xa1\xe8\xba\xe9\x75\x7a\xc8\x48\x64\x26\x4c\xa7\x0d\xb6\xfb\x75\x3b\xd9\xa6\x14\x94\xd9\xd9\xed\xc1\x6f\xcd\x99\x36\x3a\xf8\xae\xec\xbc\xff\xac\x98\x08\x13\xc5\x11\xc6\x53\x80\x98\x70\x36\x03\x6c\x58\x04\xa8\x86\xf0\xe8\x46\xf7\x66\x08\xe3\xac\x2b\xad\xf9\x08\xff\x1f\xaa\xda\x67\x14\x1f\xe1\x05\x3a\xf7\x11\x96\x5b\x7a\x5c\xfb\x8b\x9d\x36\x8a\xc4\xd6\x79\xee\xfc\x0f\x94\x3f\xdb\x5d\x15\xf2\xf5\x57\xbb\xcf\xc5\xe9\xba\xeb\x36\x50\x2f\x10\x43\x14\xc2\x64\x3e\xda\x87\x12\x6e\x7e\xd6\xf0\xbd\xd4\x26\xc0\x4b\xeb\x2a\x42\x78\x1f\xd3\x5f\x12\xee\x34\x8f\xca\xaf\x1e\xee\xbd\x18\x42\x46\x38\xfb\x03\xf2\x80\x1f\x49\xb5\x25\xe2\xd7\x1b\x9d\x4d\x36\xab\xe7\x62\xa7\xca\x3f\x1e\xc7\x7f\xab\x23\x2a\x29\xdb\xd8\x89\x73\xd7\xa1\xf4\x52\x59\x79\xb1\xc6\xf9\xbe\x7d\xbe\x55\x8d\x12\x6e\x18\xb6\x3f\xc5\x94\x32\xc2\x3e\x91\x50\x6a\x22\xf7\x08\x83\xb2\xd5\x95\x05\x29\xc9\x41\x67\x3f\xc6\xac\x63\xee\xbb\x9d\x5f\xda\xbd\x76\xe7\x6e\x60\x9b\x82\x17\x03\xce\xe7\x7f\xbd\x71\x36\x48\x39\xfe\xc5\xdb\xb7\xce\xca\x3b\xaa\xa3\xa4\xb4\xcf\x4a\xb8\xa0\x55\x9c\x15\x1b\x3a\x79\x9b\xa5\x39\x99\x01\x0e\x65\x14\x65\x0f\xc2\x2f\xdc\x1a\xfd\x7e\xb7\x7d\xf5\xd0\x6f\xf5\x5e\xc8\xad\x4f\x77\xbe\x7d\xba\x7f\x0f\x00\x1c\x4c\xe0\x82\x21\x20\x00\x00")
 
 func dcoscustomdata188TBytes() ([]byte, error) {
 	return bindataRead(
@@ -427,7 +428,7 @@ func dcoscustomdata188T() (*asset, error) {
 	return a, nil
 }
 
-var _dcoscustomdata190T = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x7a\xfb\x6f\x1c\x37\x92\xff\xef\x01\xf2\x3f\xf4\xea\x1b\x40\xf1\x37\xe1\x0c\xdf\x0f\x19\x5a\xc0\xaf\xdd\xf3\x5d\x12\x1b\x96\x7d\x87\x43\x36\x27\x14\xc9\xa2\xd4\x51\x4f\xf7\x6c\x37\x67\xac\xc9\x66\xff\xf7\x03\xbb\x47\x23\x69\xf4\xb0\x93\xdb\x3d\xdc\x0f\xb6\x7a\xd8\x55\xac\x62\x55\xf1\x53\xc5\x62\xfb\xae\xcb\x61\x11\x8f\xbe\xfc\x82\x54\x1e\x86\xf3\x8a\x84\xea\xa0\x4e\xd5\x8f\xd5\x1f\x2a\x92\xaa\xf9\x1a\xfa\x79\x53\xfb\xf9\x10\x3d\x39\x5b\xe6\xea\xa7\xa7\xf9\x1c\xdb\x0a\xc3\x79\x57\xbd\x7c\xf1\xe6\x84\x28\xeb\xe8\xd3\x25\xf4\x19\x63\x45\x86\x6a\x1e\x71\x5d\x88\xab\xc5\x45\x03\x1e\x9b\x2f\xbf\xa8\xaa\xb3\x65\x7e\x9a\xbb\x55\x38\xbf\x33\xdd\xd3\x54\x1f\x7c\xf9\x45\xac\x87\x8b\xd3\x01\xf3\x6a\x79\x54\xc8\x71\x79\x8e\x0b\xec\xa1\xa1\xe3\xcf\xaa\x6a\x60\xd3\xad\xf2\xf6\x07\xa9\xa4\xba\xfb\xc4\xe8\xf4\xd4\xad\xb1\xff\xd8\xd7\x19\x8f\xaa\xdc\xaf\x70\x1a\xcc\xe0\x1b\x3c\xcd\x9b\x25\x1e\x15\x5d\xbe\xfc\x22\x0d\x3b\x71\xa4\x8a\xb8\xae\x03\x1e\xdd\x10\x3b\x63\x85\x2f\xd5\x0d\x0e\x9b\x21\xe3\xe2\xa8\xc2\xcb\x2c\xcb\xd8\x9d\xe9\xef\x65\xe7\xff\x33\x76\xf1\xd9\xec\x8b\x6e\xd5\xe6\x61\x5c\x05\xb9\xa3\x3f\xb9\xb6\xf6\x02\x87\x6e\xb8\x43\xc5\xf7\xa8\x62\x17\x2e\xb0\xbf\x43\x26\x6e\x90\xe5\xc5\xf2\xcb\x2f\xfa\x55\x7b\x15\x31\xf3\xd5\x30\xf1\xc2\x32\x97\x7f\xb3\x49\xe5\x38\x8b\x50\x37\x9b\x42\x31\x06\x0a\x8f\x91\x05\x64\x86\x50\xe3\x90\x48\x2a\x02\xf1\x42\x71\x02\xcc\x71\x86\xc8\xa9\x41\xac\xfe\x58\xcd\x87\xcd\x30\xf7\xab\x61\xbe\x5e\x94\xff\x63\x5f\xaf\xb1\x1f\xe6\xe7\xeb\xd3\x55\xae\x9b\xf9\xaa\xf5\x75\x1b\xab\xff\x57\x2d\xea\x5c\x9f\x41\xae\xbb\xb6\x4a\x5d\x5f\xf9\xd5\x59\x75\x9e\xf3\x72\x38\x9a\xcf\xfd\xea\x6c\x98\x35\xb0\x6a\xc3\xf9\x12\xe2\xac\xc5\x3c\x5f\xf9\x55\x9b\x57\xf3\x6f\x86\x6e\xd5\x07\x9c\x37\x75\xbb\xba\x9c\x7f\xe3\x57\x67\x73\xa6\x8d\xd6\x42\x15\x35\x87\x12\xbd\x75\x75\xc0\x44\xfd\x97\x7f\xb8\xca\x7f\x69\x0f\xaa\x39\xe6\x30\xef\xc3\xac\xe9\x02\x34\xff\x9c\x35\x90\xaa\x69\x27\x5f\x91\x61\xeb\x33\x5f\xb7\xf3\x7e\xb1\xfd\x51\x7c\x75\x35\xf0\x20\xf5\xe2\x22\xd6\xfd\x1e\xc3\x76\xec\x41\x9e\x0c\xfb\x1c\xe3\xc8\x83\xf4\x57\x83\x3b\xf2\x32\xf0\x20\x75\x58\xee\x51\x97\x81\x07\xa9\xa7\x00\x0c\xb9\xd9\x63\xba\x31\xfe\xf0\xda\xcb\x76\xda\x5f\xfb\x34\xf6\x20\x4f\xc1\xcc\x3d\x96\x69\xe8\x5e\x8e\x42\x32\x14\x9a\xd5\x80\x3d\xc4\xb8\xc7\xb9\x1b\x2d\xcc\x7b\x2b\x89\xf5\x50\x60\x6c\x3b\x21\x69\xbb\x8f\xd3\x63\x8f\x43\xd7\xac\x43\xd7\xa6\xd9\x80\x7d\x01\x93\x7b\xd9\x17\x30\x5c\xdc\xe1\x6d\x2e\x03\x69\x31\x3f\xca\xf8\x90\xdc\x55\x3b\x84\xf8\x28\xe7\x90\xbb\xe5\xa7\xd9\xae\x76\xde\x30\xff\xaf\xb7\x5d\x9f\x2b\xce\xbf\x9a\x6f\x1f\xfe\xd2\x6e\x1f\x38\x9f\xb3\xed\x16\x1a\x86\xf3\xf2\x2f\x9e\x96\x15\xd7\x67\xd3\x0c\xe3\x64\xd5\x30\x9c\x17\x6b\x64\xe8\x73\x35\x82\x53\x57\x40\xe9\x97\x55\x8f\xf3\xd0\xb5\x19\xea\xb6\x6c\xcd\x65\xdf\xad\xeb\xa1\xee\xda\xd9\x95\x97\xae\x02\x8c\x5c\x05\xda\xb8\x55\x47\xb3\xce\x8a\x94\xed\x68\x5e\x2c\x6f\x8f\x16\xde\xab\xcd\x45\xd2\x43\xbc\xf7\x4a\xb8\x33\xd7\xc3\xbc\x77\x8c\x0a\xfd\x36\x46\x63\xe8\x06\x32\x81\x36\xa9\xdb\x21\x43\xd3\x7c\xc2\x1f\xb7\x59\x27\x0b\x92\x31\xa3\xf4\x8f\x72\x6e\xad\xba\x9d\x66\x82\x78\xf2\x73\xb7\xea\x5b\x68\x1e\x8f\x81\x5b\x9c\x93\xae\xbf\x49\xc7\xa6\x6e\x2f\x08\xb6\xeb\x47\x99\xb0\xbd\x8e\xd0\x91\x6b\x4c\xee\x8f\xb2\x94\x88\x24\xbe\xe9\xc2\xc5\xfd\x72\xf7\x66\x18\x6d\x74\x3a\xe6\xe4\x31\xef\x95\x78\xc2\x36\x1f\x55\x87\x57\xa0\x5d\xb8\xea\x6e\x36\x86\x1b\xc6\x33\x1c\x61\xbb\x0c\xce\x87\x3c\x69\xf7\xe5\x17\x87\x63\x12\xff\xd8\x62\x7f\x54\xf5\x5d\x37\xca\x5b\x42\x3e\x3f\x9a\x5c\x3f\x66\xe9\xe5\x39\xf6\x38\x1f\xc5\x93\xd4\xc0\xd9\x30\xef\x71\xd9\x0d\x75\xee\xfa\x0d\x59\xf5\xa3\xfa\x4b\xec\x17\xf5\x50\x62\x78\x38\xaa\x0e\xa9\x96\xf2\xf0\xb6\x52\x3f\x1e\x88\x98\x09\x31\x56\x1a\xf4\x9e\x4b\x9f\xb4\x51\x3a\x08\x46\x85\xa3\xdc\x19\xe6\x85\x0c\x22\x51\x17\x04\x24\x1f\x0f\xbe\xad\x0e\x20\x2e\xea\xb6\xef\x56\x19\x7b\x42\xa8\x74\x02\x74\x8a\x80\x91\x5a\x64\xce\x30\x6b\x98\x65\xe5\x51\x02\x48\x4d\x4d\x4c\x32\x51\x77\xf0\xed\x54\x60\x1d\xc0\xba\xef\x48\x58\x2e\x09\x31\x9a\x06\xce\x24\xd5\x22\x69\x4f\x85\xf5\x8a\x73\x84\x24\xbd\xd1\xd6\x3b\xaa\x12\x46\xa5\xd1\x87\x22\xd3\x77\xdd\x90\x49\x53\xfb\x81\x10\x4e\x99\x0a\xc1\x2b\x9b\xbc\x51\x3a\x69\x16\x28\x47\xd4\x00\x54\x85\xc0\x90\x1b\xa9\x1c\x38\x0c\x3b\x91\xa5\x70\x1d\x72\x0f\x4b\x42\xca\x1b\xaa\x30\x20\x72\x83\x86\x31\x6d\x31\x4a\x19\x30\x45\x90\xd2\xb2\xe4\x8d\xf6\x96\xe9\x38\xc9\xcc\x1d\x21\x5a\x48\x19\x05\x43\x4c\xd4\xf2\x60\x7c\x64\x82\x2b\xe7\x99\xa1\x42\x22\x18\xaf\x82\x90\x80\x31\xed\xa4\x85\x73\x0c\x17\x24\xd7\x0b\x24\xc4\xa3\x89\xd4\x83\x51\x06\x83\x35\xc9\x39\xad\x84\xb1\x09\xd1\x04\xa3\x41\x23\x2a\x40\xe7\x26\x71\xa1\xad\x09\x41\x69\x85\x30\x11\x84\x03\x1b\xa2\x30\x4e\x32\x09\x21\x21\x8d\xa0\x38\x38\xce\x35\x30\x1a\xb9\xbc\x96\xd6\x0d\x8b\x6e\x34\x4a\xc4\x80\xc9\xd1\x44\x35\x17\x18\xb9\x12\xc0\x38\x06\x19\x92\x0a\x4c\x5a\xcf\x6c\xb4\x5c\xba\x51\xd2\xaa\x6f\x08\x49\x41\x48\xab\x83\x14\xc9\x59\xd4\x22\x39\xcf\xb8\x36\x2a\x31\xa1\x34\xda\x84\x56\xf2\xc4\xb5\xa7\x3b\x51\x37\x91\x60\x8a\xfa\xd3\x52\xd6\xff\xf9\xc3\xeb\x97\x65\xd6\xf1\xf5\x79\x3d\x8c\xe1\x47\x8c\xf1\x14\x9d\x89\xc6\x73\x15\xac\xc7\x98\x6c\x32\x11\xa8\xb6\x2e\x40\xd0\xca\x5b\x81\xc2\x32\x71\x7b\xf6\x7a\x01\x67\xc5\x6c\x11\x34\x50\xee\x83\xe7\xe8\x39\x0b\x92\x33\x2b\x95\x00\x6b\x68\x08\xca\xca\xe4\x38\x03\x4b\xe9\x4e\xec\xc4\x17\x71\x39\x10\x62\x45\xa1\x88\x56\x5b\x54\x5e\x1a\x9a\x4c\x48\x46\x49\xc9\x25\x38\x30\xca\x0b\x6e\xd1\x79\x6d\xf7\x24\xb7\x19\xcf\xfa\xb1\xca\x22\x19\x87\x4c\x48\xe0\xb6\x28\x20\xa4\x31\xce\xc5\x00\x52\x50\x2b\x92\x52\x28\x83\xf1\xdc\x2a\x2a\x98\xd1\xc9\x85\x9d\x12\x4d\x77\x46\x88\x8c\x5a\xd0\x98\xac\x16\x9c\xdb\x24\x6c\xd0\x42\x08\x94\x52\x1b\xea\x65\x90\x9e\x53\x30\xd2\x0a\x7e\x5b\xfa\x02\x33\x44\xc8\xf0\xa0\x5d\x17\x98\xfb\x3a\x14\x3f\x0b\x44\x9e\xac\x0b\xca\x7a\xc6\x95\xf5\xc1\x29\x97\x58\xa4\x31\x9a\x88\x3e\x78\x2f\xa2\x71\x71\x6f\xfe\x0e\x56\xf9\x9c\x10\x4a\x8d\x53\xdc\x45\x60\x56\x04\x9a\xb8\x00\xaa\x23\xf7\x54\x3b\xc3\x99\xd7\x09\x74\x08\xc6\x2b\xbe\x13\x3b\xd4\x67\x2d\x34\x84\x30\x1f\xa2\xf0\x9a\xf1\xe0\x63\x10\xc6\x09\x4b\x63\xf0\xc0\x4c\x88\xc9\x81\xf0\x5a\x2b\x1e\x5d\x0c\xb7\xa5\xae\x6a\x42\xa2\x84\x14\xb5\x53\xc6\x69\x49\x25\x28\x2f\x94\x53\x34\x88\x08\x31\x06\x40\xc1\xb9\xb4\x0c\x82\x1c\x45\xb6\xc3\x72\x93\xcf\xbb\x96\x10\x9a\xac\x10\xe8\x1d\x58\xf0\xe8\x41\x30\xe3\xbc\x14\x49\x00\xa7\xb4\xec\x0c\xc9\xcb\xe6\x50\x70\x2d\x70\x4a\x6e\x67\x61\xda\xe3\x16\xa3\x96\x52\x03\xb5\xd2\x27\x23\x51\xa6\x24\xbd\x45\x21\x8d\x4b\xca\x21\x58\xc5\xed\x28\x72\x1d\x43\x53\x13\xa2\x84\x91\x31\xca\x94\xbc\x62\xc5\x9c\x05\x11\x30\x58\xe7\x39\x97\x06\x85\xa4\x32\x71\xe4\x78\x1d\xae\xd8\x37\xd0\x9e\x11\x02\x0e\x91\x2b\x41\x85\x32\x20\x04\x65\xa8\x04\x55\xda\x0b\x0d\x4e\x48\x4e\xad\x34\x5e\x08\x07\xa2\x08\xc3\xcb\xf3\xda\x17\x78\x26\xc4\x14\x73\xd9\xe4\xa4\x41\x25\x19\xc3\xb2\xbd\x65\x94\x34\x46\x64\xa0\x2c\x46\xf4\xac\xfc\xd9\xc9\x4b\x0d\x0c\x17\x84\x70\x1d\x8b\x2f\x3c\x8f\x2c\x88\x18\x12\x8b\x3c\x50\xe1\x03\x8d\x32\x71\x17\xac\x8e\x82\x33\xcf\x59\x11\xf7\x33\xac\x81\x90\x10\x15\x3a\xce\x02\x6a\xed\x69\x14\x82\x0a\x5b\x9c\xaf\xa3\x11\xa0\x95\x11\x4c\x52\x29\x05\xbd\x46\x94\xa6\xf6\xb8\xc6\x36\x17\x4c\xb1\x1e\xad\x52\x91\x7b\xe4\x2e\x38\x6e\x18\x18\x1f\x75\xa0\xd2\x70\x91\x92\x71\x4e\x6a\xa4\x63\xb8\x34\xb5\x4f\xa9\x2e\xbb\x2f\xa0\xf0\xd1\x60\x04\x5e\x20\xd3\xa1\x32\x98\x22\xf7\x81\xe9\xc0\xa4\x8c\x0a\x58\xa2\xee\x96\xb8\xa1\x8b\xf5\x6a\x41\x88\x4b\xc9\x31\x15\x3d\xb5\x41\x7b\x0f\x26\x6a\x23\x2c\x24\x45\xad\x44\x63\xb9\x67\x22\x14\x77\x8e\x8e\x6b\xba\xb3\xbe\xcb\x90\x91\x10\x93\x8c\x0f\x52\x32\x1d\x05\x44\x46\x59\xa4\x41\x71\x66\xad\xe1\x56\x59\x69\x85\x57\x4c\x7b\xa4\x66\x27\x72\x01\x3d\x4c\x61\xe6\x93\xe7\x32\x99\xe4\x68\xf0\x22\x44\xc5\x81\x05\xcf\x39\x50\x13\x00\x12\x28\xca\x84\xf7\x30\x99\x73\xcc\xb9\x84\x00\x60\x8c\x54\x20\x22\x8d\xca\x24\x55\x4c\xef\x82\x91\x29\x31\x54\x86\x33\xab\x79\x80\xe8\xae\xe1\x65\xe2\x8b\xed\x50\x92\x25\x65\x8a\x32\xcf\xbd\x62\x8a\x47\xca\x7c\x02\x2b\x53\xd2\x91\x52\x93\x22\x24\x94\x4c\x7a\xa6\xaf\xc5\x2d\xba\xb8\x6a\x70\x20\x44\x30\x66\xa5\x43\x00\x94\x5c\x3b\xed\x2d\x18\xc4\x84\x56\x7b\x07\x5e\xa0\x8f\xce\x47\x69\x93\xba\x21\x36\xf7\x5d\xdb\x95\x2c\x24\xb5\x41\x19\xb4\x4c\x96\xca\xe8\xa3\x0c\xd1\x2a\xc3\x15\xd3\xc8\x18\x03\x91\x1c\x77\x36\x30\x3a\x66\xa1\x16\xd6\xa5\xc0\x21\x84\x31\x6e\xa3\xa7\x5c\x48\x46\x15\x68\x99\xbc\xf4\xa8\x78\x92\x52\x89\x10\x35\x80\x75\x2a\x25\x71\x9d\x1e\xda\xb0\xea\x87\xa2\x6a\xb4\xd6\x59\x27\xbd\x61\x00\xac\xec\x78\xc6\x3c\x24\x4c\x14\xad\x92\xc6\x51\xae\xbc\x8c\x10\x7c\x11\xd7\x85\x0c\x7d\xdd\x22\x21\x58\x62\x57\x30\xae\x1d\x2b\xc0\xc6\x05\xb7\x34\x96\xf4\xce\x35\xe5\x4c\x1b\xee\x00\x80\xc2\xf5\x8e\xe8\x96\xd8\x0e\x43\x43\x88\xa7\x0c\x04\x07\xc9\x51\x84\xe0\x41\x71\x2f\x99\xe1\x46\xa3\x53\xd4\x81\x94\x0c\x59\x94\x60\xc7\x30\x5d\x5e\x9c\x2d\xa1\x8d\x40\x60\x59\x76\xbd\x64\x09\xbd\x05\xeb\x51\x59\x1f\x63\x49\x7b\xe3\x9e\x42\xe5\x13\x50\xc5\x54\x52\x60\xe2\xf5\x1a\x77\xec\x7d\xd7\x20\x21\xc9\x82\x91\x0e\xca\xe4\x4c\x1a\x0d\x91\x63\x32\xe8\x62\x74\x91\x71\xaf\xc1\x06\xa9\xa5\x80\x11\xdc\x96\x9b\x29\xad\x18\x0b\x88\x02\x95\x05\x2a\x5d\x88\xe0\x69\xe4\x5a\x43\x32\x32\x19\x13\xb5\xb2\x5e\x68\xea\xe5\x75\x3d\x71\x05\x89\xde\x00\x93\x12\xa4\x53\xc6\x00\xe7\x22\x0a\x13\xa5\x34\x41\x69\x9b\x14\x13\x82\x22\x3a\x25\x1c\xdd\x0a\x2b\x3c\x63\x89\x49\x16\x67\x8b\x4c\xca\x61\x61\xd5\x07\x24\x84\x8a\x40\x95\x52\x34\x49\xe1\x29\x1a\x90\x52\xa9\x20\x44\xc2\xf2\x3b\xa2\x37\x4a\x45\x6b\x12\xdd\x57\x20\xf4\x9b\x65\xee\xce\x7a\x58\x9e\x6f\x08\x91\xcc\x4a\xa3\x4d\xd0\x16\xa5\xb5\x94\xc5\x28\x9c\xa4\x86\x07\x2f\x0c\x0d\x9a\x51\xa0\x8a\x72\x17\x6f\x68\x13\x21\xe3\x2a\xd7\xa5\xf4\x88\x41\xa7\x12\x76\x49\xab\x18\xa9\x63\xb6\xa4\x68\x70\x10\x95\x36\x54\x6a\x2b\xa2\x60\xc6\xb2\x7d\x15\x62\x17\xba\x65\x2e\x65\x95\x07\x63\x13\x00\x13\xc5\x53\x32\x28\x2e\x9c\xf0\xd2\x5b\x1e\x2d\x4b\x22\x08\xc7\x00\xb4\xba\x21\xfc\x6c\xd5\xd6\xa1\xeb\x5b\x42\x40\x09\x93\x9c\xd2\x9a\x25\xcf\x4b\x39\xa2\x78\x42\xc6\x15\xa3\xe8\x69\xa4\x2c\x78\x2b\x20\xe9\x3b\xeb\xaf\x87\x2e\x8e\x78\x23\x69\x14\xc6\x06\x6d\x2d\x6a\x0f\x31\x45\xa6\xb5\xd1\x31\x5a\xaf\x98\x37\x92\xfb\x84\x3e\xd8\x78\x53\xfa\xcf\x75\xfb\x33\x70\x42\x8c\x54\x34\x29\x40\x05\x96\xf3\xa4\x45\x32\xa0\x6c\x30\xcc\x70\x6a\x3c\x52\xa9\x74\x4c\x8a\xdd\x80\xfd\x2d\xff\x05\xfc\xd2\x75\x84\x04\x6f\x02\x32\x01\x8c\x6a\x1b\xa2\xe5\x31\x5a\x89\x40\x23\x0a\xee\x15\x77\x60\x34\x05\xe9\xe3\x4d\xa3\x2f\xa0\xbf\x58\x2d\x07\x48\x48\x48\x8c\x52\x47\x0e\x22\x28\xab\x19\xd3\x4a\x03\x17\x2a\x39\x1d\x25\xc4\xa0\x98\xe7\x60\x40\x39\x8a\xfb\xd2\x97\x30\x0c\x4d\xed\x09\xb1\x94\x63\x10\x9a\xaa\x40\xbd\xe5\x92\xdb\x84\xd1\x83\xa6\xce\x0a\x5f\xf6\x38\x00\x15\xda\x7b\x7b\x43\xfe\x72\xb3\x81\x45\x43\x88\x65\xb1\x14\xda\x41\x02\x97\xb1\x2c\x23\x81\xa1\x4c\x07\xad\xac\x06\x26\x18\x84\xc4\x8d\x0b\x62\x5f\x76\x8f\x7f\x5d\xe1\x90\x87\x52\x83\x14\x60\xf5\x51\x58\xc6\x42\xa0\x81\x23\x72\xa1\x02\x3a\x6e\x90\x09\x04\x16\x35\x24\xc9\x6e\x2e\xbe\xc7\xdc\x6f\xea\x92\xa6\xd1\x1b\x6f\x3d\x04\x26\x44\x52\x54\x3a\xee\x19\x32\x21\x5d\xf0\x68\x4c\x28\xa5\xa8\x8f\x94\xdf\x15\x9f\xbb\x4b\x42\xa8\xe1\x52\x26\x0b\x4e\x38\x60\x54\x28\xa1\x85\x0c\x4e\xf1\xa0\xa3\xb5\x18\x24\x94\x1d\x65\xc4\x08\xdb\x3d\x5e\xf6\xb0\x21\xc4\x6a\xa7\x39\xf3\x5e\x32\x16\x5c\xe2\x60\x9c\x40\x90\x3c\xc4\x84\x18\xa5\x75\xe5\xa8\xc4\x01\xae\x0b\x98\xa1\xbe\x24\x24\x51\x2d\xb9\xf4\xda\x2a\x2e\x82\x8c\x09\x38\x14\xed\x8c\x34\x2a\x96\xd2\x45\x44\xa1\x19\xca\x11\xca\x86\x25\xf4\x19\x5a\x42\x5c\x08\xa5\xf8\x88\x4a\x49\xc5\xcb\xc1\x29\x3a\x8a\x82\x53\x96\x94\x76\xac\x24\x78\xc7\x24\x86\xeb\xe2\xac\x9c\x85\x0a\x12\x98\x48\x99\x71\x3a\x6a\xe9\x9c\x94\x8a\x85\x92\x99\x2c\x8f\x4c\x33\x10\x42\x05\x8f\x8e\x2a\xed\x46\xa0\xce\x08\x8b\x50\xe7\x0d\x59\xe0\x30\xc0\x59\xc1\x79\xd4\x5c\x80\x8c\x56\xa3\x17\x60\x23\x73\x2e\x60\x0a\x81\x97\x2a\x46\x06\x25\x35\x0d\x9e\x3b\x7d\x5d\x8a\xe6\x6e\xe3\x8b\x3d\x13\x17\x4a\x39\x09\xde\x22\x38\xe0\x56\x4b\x44\xc3\xc1\xa3\xd5\x86\x8b\x58\xaa\x6a\x8e\x56\xda\x83\x9f\x7e\xff\x51\x38\x34\xab\xa1\x1c\x50\x97\x10\x2e\x8a\xba\xb3\x9f\x87\xae\xfd\x9c\x13\xf1\xaf\x93\xb2\x3f\xfe\xeb\xd4\xb3\xf8\x69\xfa\xf9\x3d\x5c\x7e\x87\x6b\x6c\x5e\x74\xed\xd0\x35\x78\xfc\x11\xfa\xb6\x6e\xcf\xa6\x97\xef\x20\xe3\x77\xf5\xa2\xce\xaf\xdb\x8c\xfd\x1a\x9a\x63\x36\xec\xbd\x79\xbe\xea\x87\x7c\xcc\x29\xa5\xf4\xf1\xe5\x6c\x5b\x26\xf3\x5d\xcb\x64\x6c\xd7\xc5\xb1\x3f\xb0\x6b\x00\x7d\xe6\x1a\xa6\x58\xdc\x5e\x86\x54\x55\xd3\x9d\x35\x65\x0d\x47\x55\xdd\xa6\xee\x6a\x74\x5b\x61\xec\xa8\xaa\x2a\x62\x82\x55\x93\xc9\x78\xce\xbf\x31\x5e\x55\xe7\xdd\x90\x8f\xaa\x1c\x96\x47\xf3\x39\xe3\x66\x46\x67\x74\xc6\x8e\x34\xa3\x54\xdc\x65\x9f\x2a\xf2\x5b\xfc\xdb\x06\x61\xbc\xbe\x71\xb9\xb1\xf2\x49\xdb\xf9\x74\xc0\x9c\x6d\x16\x9f\xd5\xbf\xb8\xf2\xd6\x87\xb6\xce\x5b\x57\x3d\x4b\x19\xfb\xe3\x16\xf3\xc7\xae\xbf\x20\x5d\xdb\xd4\x2d\xce\x32\xf4\x67\x98\x27\x82\xff\x80\x36\x0f\x8f\x11\xfc\x78\x32\x35\x73\xb6\x13\xbe\xdf\x2c\xf1\xb8\x6b\x71\x38\xef\xb6\x04\xaf\xda\x75\xdd\x77\xed\x02\xdb\x7c\xfc\xf2\xd5\xf3\xd7\xcf\x7e\x38\xfd\xd3\xbb\x37\x3f\xbc\x7f\xf5\xc3\xcb\xe3\xb6\x6b\xcb\x59\xb2\x87\x90\xeb\xf5\xf6\x56\xe9\x24\x97\xa2\xa1\x8f\x6f\x56\x79\xb9\xca\xc7\x5b\xdf\x7e\x13\xa6\x60\xba\x4d\xf3\xaa\xef\xbb\xfe\x7e\x92\x57\x97\x18\x4e\x32\xf4\xf9\x6d\x8f\xc7\xd7\x3d\xee\x55\xdf\x54\x24\x7d\x37\x9c\xac\x2b\x32\x41\x5e\xc5\x69\x45\xfe\xb3\x62\x25\xdc\x68\x45\x36\x95\xa6\x15\xe9\x76\xf7\x32\xf3\x38\x8b\xe8\x77\x97\x08\xf0\x8b\x15\x86\x53\x31\x5b\x77\xb3\xc5\x80\xa1\x8d\xbb\x8e\xd4\x78\xa4\xde\xde\xf6\x10\x6c\xcf\xea\x16\x4f\xd9\x8c\x89\x19\x23\x94\x4c\x37\x0d\xe4\x12\xdb\x1a\x9a\x53\x58\x44\x2d\xcb\xbc\x7b\xba\x1e\xdf\x6a\x76\x8f\x77\x86\xb9\xdf\x1c\xb3\xa7\xab\x36\xd7\x4d\x15\x97\x17\x67\x15\x79\x29\x2a\x52\xef\xeb\xf7\xeb\xaf\xd5\xd7\x5f\xe7\x7e\xf3\x47\xf7\xe4\xc9\xd3\xd8\x4d\xf7\x43\xd3\xfa\xbe\x1a\x5f\x7c\xf3\xcd\x93\x27\x4f\x87\x06\x71\xb9\x1d\xf8\xff\xb9\xdf\x8c\xb4\x2d\x3e\xdd\x75\xf1\xa6\x96\xf2\xd4\xb6\xdb\xf6\x15\x6f\xbc\xbc\xea\x02\x5f\x35\x1c\xcb\x9f\x7c\xf0\xc0\xa6\x9c\xfe\xce\x1f\xed\xa6\x7e\x7e\xcc\xde\x8e\xb2\x77\x93\x22\xc7\xd0\x7c\x84\xcd\xb0\x8b\x89\x3e\xdf\x46\x16\x7a\x8b\xfa\x04\xc3\x31\xdb\xde\x6c\x8e\x74\x3f\xbc\xf9\xd3\xeb\xef\x5e\x1d\x33\x2d\xac\xbc\x27\x68\xc8\x74\xa5\x50\x2f\xab\xa6\x6e\x2f\xaa\x88\xcd\x76\xe1\x74\xdf\x6b\x0f\x7a\x71\xa2\xaf\x22\xe0\xa2\x6b\x2b\xf2\x2f\x55\x8a\x47\xf3\x79\x45\xc8\x90\xbb\x7e\xec\xc2\x8c\x17\x5b\xc7\xdd\xba\x1c\x91\x37\x9f\xb0\xe4\xad\x36\xef\x2c\xce\xf1\x12\xc3\xb8\xb2\xdf\x8a\x75\x37\x11\xe0\x2d\xf4\xf9\x4d\x3a\xde\xef\x21\x4f\x46\x1f\x1d\xfc\xd3\x95\xc9\x86\x8c\xed\x49\xee\x11\x16\xc7\x63\xf4\xf5\xab\x76\x7e\x23\x14\xb6\x6e\x18\x79\xbe\xef\x22\x1e\x53\xad\xe9\xcd\xc1\x0f\x03\xf6\xc7\x57\x30\x7e\x35\xf8\xe7\xbe\x5b\x2d\x8f\xaf\xee\x48\xef\x08\xe2\xc2\x6c\x5d\xf6\xbc\x6e\xe3\xeb\xb7\x6b\xfd\xa6\x6d\x36\xc7\xbe\xcb\xe7\x5b\x25\x5f\x4f\x41\xf5\xd3\x35\x5e\x61\x7c\xbe\x39\x9e\x82\x73\xb8\x81\x55\x9f\xb6\xec\xc8\xf2\xa0\x1d\xab\x3b\x76\xbc\x6d\xc9\x12\x68\x7f\x5d\xd5\x3d\x0e\xc7\xf7\xf5\xba\x27\x92\x09\x70\x1f\x7e\xbf\x17\xe8\xf7\x01\xea\x2d\x48\xfd\x53\xdd\xe0\xf1\xb8\x26\xbc\x1e\x7c\x90\xae\x5b\xe6\x9b\x35\xc0\x7d\x2c\x8f\x40\xd1\x88\x29\x5f\x7d\x1d\x31\x63\xc8\xa7\xf5\xf2\x49\xf5\xd5\xd7\x25\xcb\xb5\xb0\xc0\x27\xd5\x1f\x27\xd3\x96\x81\xe1\x73\x30\xe1\x81\x6b\x92\xcf\x0e\xe2\x03\xdf\xad\xda\x78\xba\x86\x66\x85\xc3\xc1\x2e\x75\xde\xec\xb8\x9f\xc2\x2a\x9f\x9f\x4e\xd7\x18\xf1\xe0\xa8\xfa\x95\x5c\x27\xd8\xbf\xfd\xed\x6f\x63\xa3\xef\xd5\xf4\xf6\xef\x7f\xff\xfb\x6e\x86\x6d\x39\x74\x5a\xd6\x75\x97\x6b\x01\xe5\xe5\xdb\x95\x6f\xea\xf0\xfa\xed\xb3\x18\x7b\x1c\x86\x1f\x60\x81\x37\xa7\xd8\x75\xaf\x4e\xc7\x63\xe5\x29\x84\xd0\xad\xda\x7c\x7a\x81\x9b\xbd\x19\x0f\xbf\xad\x9a\x7a\xc8\xff\x86\x9b\xe1\xeb\xab\x73\xe7\xeb\xf8\xf5\xe1\xf7\x75\xe8\xbb\xa1\x4b\x79\x76\x32\x61\xc5\x7c\x8b\x19\xcf\xa6\xa9\x86\xc3\x6f\xab\x35\xf4\x75\xd1\x7e\xf8\xfa\x70\xd2\xea\xe4\x16\xc9\xab\x2b\x25\x8a\x76\x87\x4f\x9e\x7c\x5b\x1d\x72\xca\x14\xa1\x9a\x30\x75\xf8\x64\x76\x81\x1b\xf6\x6d\x75\xf8\x49\xa5\x1f\xb3\xc3\x23\x12\x1f\xb3\xc7\xb2\xc7\x54\x5f\xfe\x4e\xe3\x4e\x44\xa7\xc5\x6c\x7b\x33\xfc\x78\x70\xf8\xed\xf8\xf9\xcc\x8b\x0f\x27\xef\xdf\x7c\xff\xf2\xd9\xfb\x67\x6f\x3f\x3c\xff\xee\xf5\x8b\xd7\x6f\x4f\xde\xbf\x3b\x3c\xd8\xed\xaa\x83\xee\x77\x84\xc6\x41\x03\x19\x4f\xa7\xb0\xdb\x96\xca\xa7\xf5\x6d\xe6\x31\xb2\xc7\xab\xd0\x88\x3d\xb9\xea\x4c\x6f\x7b\x0b\xd3\x8e\xff\x5d\x15\x7a\x91\x4c\xae\xaa\x3d\xf8\xdd\xe5\xde\x73\x4c\x5d\x8f\x23\xfc\xfc\xb6\x22\xee\x9f\x5a\x94\x8d\xdf\x41\x54\x64\x39\x59\x60\xd9\x77\xa9\x6e\x70\x16\x1f\xcc\xaa\x4d\x5b\x91\x21\x55\xfb\x68\x56\x5e\x41\x8c\xa7\x65\x75\xa7\xc5\xa4\xb3\xe1\x7c\x6f\xca\xe9\x64\x30\x7d\x59\xf0\x09\x78\xba\x7b\x43\xfa\xbb\x2c\xfe\x12\x87\xd0\xd7\xcb\x5c\x77\xed\xf1\xdb\x6d\x43\xec\xa8\x7a\xd9\x7d\x6c\x9b\x0e\x62\xf5\xf2\xc5\xfc\xcd\x49\x95\xbb\x2a\x9f\xd7\xc3\x78\x64\x98\xfd\xa3\x0a\xf3\x17\x5d\x1b\xeb\x22\xf7\x2d\xe4\xf3\x57\x97\xf5\x90\x87\xe3\x3f\xec\xdb\xec\xff\x82\xfb\xa7\x9a\x9c\x5c\x20\x2e\xa1\xa9\xd7\x38\x5e\x07\x56\xfc\x33\xcb\xf4\x5d\x1d\xbc\xbb\xb8\x2c\x56\x98\x5d\xfe\x52\xb6\x71\xf1\xe4\xf3\xab\xf1\x0f\xef\xbe\xdb\x6d\xe5\x4f\x05\xe2\x6d\x33\x3d\x18\x89\x19\xfa\x8a\xc0\x65\x7a\x4c\x0d\xf2\xe2\x13\xd3\xbd\xed\x86\x7c\x4c\x6e\x7c\x46\x34\x7e\x20\xf8\xe0\x84\x9f\x55\x6e\x4f\xe1\xf5\x4f\x89\xdd\x93\x25\x86\x1a\x9a\xfa\x17\xdc\x46\x6f\xea\xfa\x3b\xe1\x7b\xbb\x12\xba\x4f\x9f\x5b\xa5\xd0\xfd\x04\xff\x6b\x61\xf9\x5b\xcb\xa4\x1b\x91\x70\x0f\x06\x5d\xf5\xbd\xab\x11\xc0\x6f\x7c\x12\x41\xb6\xbe\x7a\xbc\x70\x5d\xac\x9a\x5c\x93\xd5\x80\xfd\xe7\xd5\xae\xf7\x96\x92\x9f\xfc\x94\xe1\xf0\xe1\xbc\xd3\x77\x0d\x0e\xd3\x07\x3e\xb7\x79\xde\xbe\x7b\xf3\xef\xaf\x4f\x5e\xbf\xf9\xe1\xb4\x24\xd3\xeb\x09\x0e\x3e\xfd\x45\xd0\xc1\x1d\xa5\x0e\xa8\x91\xf2\xe0\x46\x32\x3c\x28\xd9\xf0\xa0\x48\xdc\xaa\xb5\xfb\x7e\x32\x74\xc3\xa4\x1f\x19\x1a\x58\x97\x3c\xb8\x58\x4c\xfd\xa9\x6b\xdd\x9e\xbd\x7f\xff\xee\xf5\xf3\x0f\xef\x5f\x9d\x5c\x2b\x77\x5b\x9c\xbe\x5f\xdc\x7f\x07\x00\x00\xff\xff\x7f\xe6\x9b\x0e\xa9\x2b\x00\x00")
+var _dcoscustomdata190T = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x7a\x7b\x6f\xdc\x46\x92\xf8\xff\x06\xfc\x1d\xb8\xfa\x05\x50\xfc\x8b\x7b\xa6\xdf\x0f\x19\x5a\xc0\xaf\xdd\xf3\x9d\x13\x1b\x96\x7d\x87\x43\x76\x4f\xa8\xee\xae\x96\x18\x71\xc8\x59\xb2\x47\xd6\x24\xd9\xef\x7e\x68\x72\x24\x8d\x46\x0f\x3b\xb9\xdd\xc3\xfd\x23\x71\x9a\xf5\xee\xaa\xea\xea\x2a\xfa\xae\xcb\x61\x11\x0f\x1e\x3f\x22\x95\x87\xe1\xb4\x22\xa1\xda\xab\x53\xf5\x63\xf5\x87\x8a\xa4\x6a\x7e\x0e\xfd\xbc\xa9\xfd\x7c\x88\x9e\x9c\x2c\x73\xf5\xd7\x67\xf9\x14\xdb\x0a\xc3\x69\x57\xbd\x7a\xf9\xee\x88\x28\xeb\xe8\xb3\x25\xf4\x19\x63\x45\x86\x6a\x1e\xf1\xbc\x00\x57\x8b\xb3\x06\x3c\x36\x8f\x1f\x55\xd5\xc9\x32\x3f\xcb\xdd\x2a\x9c\xde\x22\xf7\x2c\xd5\x7b\x8f\x1f\xc5\x7a\x38\x3b\x1e\x30\xaf\x96\x07\x05\x1c\x97\xa7\xb8\xc0\x1e\x1a\x3a\xfe\xac\xaa\x06\xd6\xdd\x2a\x6f\x7e\x90\x4a\xaa\xdb\x4f\x8c\x4e\x4f\xdd\x39\xf6\x9f\xfb\x3a\xe3\x41\x95\xfb\x15\x4e\x8b\x19\x7c\x83\xc7\x79\xbd\xc4\x83\x22\xcb\xe3\x47\x69\xb8\x62\x47\xaa\x88\xe7\x75\xc0\x83\x2d\xb6\x33\x56\xf0\x52\xdd\xe0\xb0\x1e\x32\x2e\x0e\x2a\xbc\xc8\xf2\xf1\xa3\x3b\xc8\xdf\x89\xce\xff\x67\xe8\xe2\xab\xd1\x17\xdd\xaa\xcd\xc3\xa8\x05\xd9\xa6\x30\xca\x4f\xae\xad\xbd\xc0\xa1\x1b\x6e\x41\xf1\x1d\xa8\xd8\x85\x33\xec\x6f\x81\x89\x2d\xb0\xbc\x58\x3e\x7e\xd4\xaf\xda\x4b\x8f\x99\xaf\x86\x09\x17\x96\x79\x0e\xcb\x3c\x9b\x44\x8e\xb3\x08\x75\xb3\x2e\x10\xa3\xa3\xf0\x18\x59\x40\x66\x08\x35\x0e\x89\xa4\x22\x10\x2f\x14\x27\xc0\x1c\x67\x88\x9c\x1a\xc4\xea\x8f\xd5\x7c\x58\x0f\x73\xbf\x1a\xe6\xe7\x8b\xf2\x37\xf6\xf5\x39\xf6\xc3\xfc\xf4\xfc\x78\x95\xeb\x66\xbe\x6a\x7d\xdd\xc6\xea\xff\x55\x8b\x3a\xd7\x27\x90\xeb\xae\xad\x52\xd7\x57\x7e\x75\x52\x9d\xe6\xbc\x1c\x0e\xe6\x73\xbf\x3a\x19\x66\x0d\xac\xda\x70\xba\x84\x38\x6b\x31\xcf\x57\x7e\xd5\xe6\xd5\xfc\xbb\xa1\x5b\xf5\x01\xe7\x4d\xdd\xae\x2e\xe6\xdf\xf9\xd5\xc9\x9c\x69\xa3\xb5\x50\x45\xcc\xa1\x78\x6f\x5d\xed\x31\x51\xff\xe5\x1f\x2e\xf2\x5f\xda\xbd\x6a\x8e\x39\xcc\xfb\x30\x6b\xba\x00\xcd\x3f\x47\x07\x52\x35\xed\xb4\x57\x64\xd8\xec\x99\xaf\xdb\x79\xbf\xd8\xfc\x28\x7b\x75\xb9\x70\x2f\xf4\xe2\x2c\xd6\xfd\x0e\xc2\x66\xed\x5e\x9c\x0c\xbb\x18\x19\x1e\x82\x6f\xda\x1d\xf0\xa6\x7d\x00\x3a\x2c\x77\xa0\xc3\xf2\x01\xe8\xc9\x01\x43\x6e\x76\x90\xb6\xd6\xef\xc5\x1d\xc3\x69\x07\x6f\xb3\x76\x2f\x4e\xc9\x99\x3b\x28\xd3\xd2\x9d\x18\x45\x9a\xa1\x88\xb3\x1a\xb0\x87\x18\x77\x30\xaf\x56\x0b\xf2\x8e\x26\xb1\x1e\x4a\x1a\xdb\x10\x24\x6d\xf7\x79\x7a\xec\x71\xe8\x9a\xf3\xd0\xb5\x69\x36\x60\x5f\x72\xd1\x9d\xe8\x0b\x18\xce\x6e\xe1\x36\x17\x81\xb4\x98\x1f\x44\xbc\x8f\xef\xaa\x1d\x42\x7c\x10\x73\xc8\xdd\xf2\xcb\x68\x97\x91\x37\xcc\xff\xeb\x7d\xd7\xe7\x8a\xf3\x6f\xe6\x9b\x87\xbf\xb4\x9b\x07\xce\xe7\x6c\x13\x42\xc3\x70\x3a\x1f\x86\xd3\x78\x5c\x34\xae\x4f\x0a\xe3\x0d\xb1\x6a\x18\x4e\xab\x1e\x87\x0c\x7d\xae\xca\xfa\xbc\x2b\x49\xe9\xe7\x55\x8f\xf3\xd0\xb5\x19\xea\xb6\x64\x93\x65\xdf\x9d\xd7\x43\xdd\xb5\xb3\xcb\x5d\xba\x74\x30\xb2\xf9\x3f\xf2\x99\xcc\x3a\x2b\x5c\x36\xab\x79\xb1\xbc\xb9\x5a\x70\x2f\x83\x8b\xa4\xfb\x70\xef\xe4\x70\x8b\xd6\xfd\xb8\xb7\x8c\x0a\x7d\xde\xec\x4c\xe8\x06\x32\x25\x6d\x52\xb7\x43\x86\xa6\xf9\xc2\x7e\xdc\x44\x2d\x3c\xea\x13\x32\x9e\x28\xfd\x83\x98\x1b\xab\x6e\xc8\x8c\x02\x45\xf2\x53\xb7\xea\x5b\x68\xe2\xd7\x63\x4e\xb2\x3e\x08\xbf\x0d\x5d\x64\x6c\xea\xf6\x8c\x60\x7b\xfe\x20\x12\xb6\xd7\x1e\x3a\x62\x8d\x87\xfb\x83\x28\x25\x08\x88\x6f\xba\x70\x76\x37\xdf\x1d\x0a\xa3\x8d\x8e\xc7\x33\x79\x3c\x6d\x8b\x3f\x61\x9b\x0f\xaa\xfd\x5f\x7e\xf9\xa5\x20\x7c\xc0\x65\x37\xd4\xb9\xeb\xd7\x9f\x3e\xbc\xfd\xfb\xdf\xff\xfe\xf8\xd1\xe3\x47\xfb\x85\x5e\xf7\xb9\xc5\xfe\xa0\xea\xbb\x6e\x54\x6b\x09\xf9\xf4\x60\xf2\x92\xf1\x50\x5e\x9e\x62\x8f\xf3\x91\x1b\x49\x0d\x9c\x0c\xf3\xfe\x8a\x12\x59\xf5\xa3\xb4\x4b\xec\x17\xf5\x50\x5c\x76\x38\xa8\xf6\xa9\x96\x72\xff\xa6\x0c\x3f\xee\x89\x98\x09\x31\x56\x1a\xf4\x9e\x4b\x9f\xb4\x51\x3a\x08\x46\x85\xa3\xdc\x19\xe6\x85\x0c\x22\x51\x17\x04\x24\x1f\xf7\x9e\x56\x7b\x10\x17\x75\xdb\x77\xab\x8c\x3d\x21\x54\x3a\x01\x3a\x45\xc0\x48\x2d\x32\x67\x98\x35\xcc\xb2\xf2\x28\x01\xa4\xa6\x26\x26\x99\xa8\xdb\x7b\x5a\xc4\xa9\xaa\x3d\x38\xef\x3b\x12\x96\x4b\x42\x8c\xa6\x81\x33\x49\xb5\x48\xda\x53\x61\xbd\xe2\x1c\x21\x49\x6f\xb4\xf5\x8e\xaa\x84\x51\x69\xf4\xa1\xf0\xf4\x5d\x37\x64\xd2\xd4\x7e\x20\x84\x53\xa6\x42\xf0\xca\x26\x6f\x94\x4e\x9a\x05\xca\x11\x35\x00\x55\x21\x30\xe4\x46\x2a\x07\x0e\xc3\x15\xcb\x52\xa7\x0e\xb9\x87\x25\x21\xe5\x0d\x55\x18\x10\xb9\x41\xc3\x98\xb6\x18\xa5\x0c\x98\x22\x48\x69\x59\xf2\x46\x7b\xcb\xf4\xa8\xa7\xef\x72\x47\x88\x16\x52\x46\xc1\x10\x13\xb5\x3c\x18\x1f\x99\xe0\xca\x79\x66\xa8\x90\x08\xc6\xab\x20\x24\x60\x4c\x57\xdc\xc2\x29\x86\x33\x92\xeb\x05\x12\xe2\xd1\x44\xea\xc1\x28\x83\xc1\x9a\xe4\x9c\x56\xc2\xd8\x84\x68\x82\xd1\xa0\x11\x15\xa0\x73\x13\xbb\xd0\xd6\x84\xa0\xb4\x42\x98\x08\xc2\x81\x0d\x51\x18\x27\x99\x84\x90\x90\x46\x50\x1c\x1c\xe7\x1a\x18\x8d\x5c\x5e\x73\xeb\x86\x45\x37\x1a\x25\x62\xc0\xe4\x68\xa2\x9a\x0b\x8c\x5c\x09\x60\x1c\x83\x0c\x49\x05\x26\xad\x67\x36\x5a\x2e\xdd\xc8\x69\xd5\x37\x84\xa4\x20\xa4\xd5\x41\x8a\xe4\x2c\x6a\x91\x9c\x67\x5c\x1b\x95\x98\x50\x1a\x6d\x42\x2b\x79\xe2\xda\xd3\x2b\x56\xdb\x81\x4f\x46\xb7\x3b\x2e\x55\xfc\x9f\x3f\xbd\x79\x55\xa8\x8e\xaf\x4f\xeb\xa1\x38\x32\x21\xc6\x78\x8a\xce\x44\xe3\xb9\x0a\xd6\x63\x4c\x36\x99\x08\x54\x5b\x17\x20\x68\xe5\xad\x40\x61\x99\xb8\x49\xbd\x5e\xc0\x49\x31\x5b\x04\x0d\x94\xfb\xe0\x39\x7a\xce\x82\xe4\xcc\x4a\x25\xc0\x1a\x1a\x82\xb2\x32\x39\xce\xc0\x52\x7a\xc5\x76\xc2\x8b\xb8\x1c\x08\xb1\xa2\x40\x44\xab\x2d\x2a\x2f\x0d\x4d\x26\x24\xa3\xa4\xe4\x12\x1c\x18\xe5\x05\xb7\xe8\xbc\xb6\x3b\x9c\xdb\x8c\x27\xfd\x58\x54\x91\x8c\x43\x26\x24\x70\x5b\x04\x10\xd2\x18\xe7\x62\x00\x29\xa8\x15\x49\x29\x94\xc1\x78\x6e\x15\x15\xcc\xe8\xe4\xc2\x95\x10\x4d\x77\x42\x88\x8c\x5a\xd0\x98\xac\x16\x9c\xdb\x24\x6c\xd0\x42\x08\x94\x52\x1b\xea\x65\x90\x9e\x53\x30\xd2\x0a\x7e\x93\xfb\x02\x33\x44\xc8\x70\xaf\x5d\x17\x98\xfb\x3a\x94\x7d\x16\x88\x3c\x59\x17\x94\xf5\x8c\x2b\xeb\x83\x53\x2e\xb1\x48\x63\x34\x11\x7d\xf0\x5e\x44\xe3\xe2\x0e\xfd\x0e\x56\xf9\x94\x10\x4a\x8d\x53\xdc\x45\x60\x56\x04\x9a\xb8\x00\xaa\x23\xf7\x54\x3b\xc3\x99\xd7\x09\x74\x08\xc6\x2b\x7e\xc5\x76\xa8\x4f\x5a\x68\x08\x61\x3e\x44\xe1\x35\xe3\xc1\xc7\x20\x8c\x13\x96\xc6\xe0\x81\x99\x10\x93\x03\xe1\xb5\x56\x3c\xba\x78\x1d\x72\x23\xf6\xaa\x26\x24\x4a\x48\x51\x3b\x65\x9c\x96\x54\x82\xf2\x42\x39\x45\x83\x88\x10\x63\x00\x14\x9c\x4b\xcb\x20\xc8\x91\x65\x3b\x2c\xd7\xf9\xb4\x6b\x09\xa1\xc9\x0a\x81\xde\x81\x05\x8f\x1e\x04\x33\xce\x4b\x91\x04\x70\x4a\x4b\x64\x48\x5e\x82\x43\xc1\x35\xc3\xf1\x7c\x20\x27\x61\x8a\x71\x8b\x51\x4b\xa9\x81\x5a\xe9\x93\x91\x28\x53\x92\xde\xa2\x90\xc6\x25\xe5\x10\xac\xe2\x76\x64\x79\x1e\x43\x53\x13\xa2\x84\x91\x31\xca\x94\xbc\x62\xc5\x9c\x25\x23\x60\xb0\xce\x73\x2e\x0d\x0a\x49\x65\xe2\xc8\xf1\xda\x5d\xb1\x6f\xa0\x3d\x21\x04\x1c\x22\x57\x82\x0a\x65\x40\x08\xca\x50\x09\xaa\xb4\x17\x1a\x9c\x90\x9c\x5a\x69\xbc\x10\x0e\x44\x61\x86\x17\xa7\xb5\x2f\xe9\x99\x10\x53\xcc\x65\x93\x93\x06\x95\x64\x0c\x4b\x78\xcb\x28\x69\x8c\xc8\x40\x59\x8c\xe8\x59\xf9\x77\xc5\x2f\x35\x30\x9c\x11\xc2\x75\x2c\x7b\xe1\x79\x64\x41\xc4\x90\x58\xe4\x81\x0a\x1f\x68\x94\x89\xbb\x60\x75\x14\x9c\x79\xce\x0a\xbb\x9f\xe0\x1c\x08\x09\x51\xa1\xe3\x2c\xa0\xd6\x9e\x46\x21\xa8\xb0\x65\xf3\x75\x34\x02\xb4\x32\x82\x49\x2a\xa5\xa0\xd7\x19\xa5\xa9\x3d\x9e\x63\x9b\x4b\x4e\xb1\x1e\xad\x52\x91\x7b\xe4\x2e\x38\x6e\x18\x18\x1f\x75\xa0\xd2\x70\x91\x92\x71\x4e\x6a\xa4\xa3\xbb\x34\xb5\x4f\xa9\x2e\xd1\x17\x50\xf8\x68\x30\x02\x2f\x29\xd3\xa1\x32\x98\x22\xf7\x81\xe9\xc0\xa4\x8c\x0a\x58\xa2\xee\x06\xbb\xa1\x8b\xf5\x6a\x41\x88\x4b\xc9\x31\x15\x3d\xb5\x41\x7b\x0f\x26\x6a\x23\x2c\x24\x45\xad\x44\x63\xb9\x67\x22\x94\xed\x1c\x37\xae\xe9\x4e\xfa\x2e\x43\x46\x42\x4c\x32\x3e\x48\xc9\x74\x14\x10\x19\x65\x91\x06\xc5\x99\xb5\x86\x5b\x65\xa5\x15\x5e\x31\xed\x91\x9a\x2b\x96\x0b\xe8\x61\x72\x33\x9f\x3c\x97\xc9\x24\x47\x83\x17\x21\x2a\x0e\x2c\x78\xce\x81\x9a\x00\x90\x40\x51\x26\xbc\x87\xc9\x9c\xe3\x99\x4b\x08\x00\xc6\x48\x05\x22\xd2\xa8\x4c\x52\xc5\xf4\x2e\x18\x99\x12\x43\x65\x38\xb3\x9a\x07\x88\xee\x3a\xbd\x4c\x78\xb1\x1d\xca\x61\x49\x99\xa2\xcc\x73\xaf\x98\xe2\x91\x32\x9f\xc0\xca\x94\x74\xa4\xd4\xa4\x08\x09\x25\x93\x9e\xe9\x6b\x76\x8b\x2e\xae\x1a\x1c\x08\x11\x8c\x59\xe9\x10\x00\x25\xd7\x4e\x7b\x0b\x06\x31\xa1\xd5\xde\x81\x17\xe8\xa3\xf3\x51\xda\xa4\xb6\xd8\xe6\xbe\x6b\xbb\x72\x0a\x49\x6d\x50\x06\x2d\x93\xa5\x32\xfa\x28\x43\xb4\xca\x70\xc5\x34\x32\xc6\x40\x24\xc7\x9d\x0d\x8c\x8e\xa7\x50\x0b\xe7\xa5\x8e\x22\x84\x31\x6e\xa3\xa7\x5c\x48\x46\x15\x68\x99\xbc\xf4\xa8\x78\x92\x52\x89\x10\x35\x80\x75\x2a\x25\x71\x7d\x3c\xb4\x61\xd5\x0f\x45\xd4\x68\xad\xb3\x4e\x7a\xc3\x00\x58\x89\x78\xc6\x3c\x24\x4c\x14\xad\x92\xc6\x51\xae\xbc\x8c\x10\x7c\x61\xd7\x85\x0c\x7d\xdd\x22\x21\x58\x7c\x57\x30\xae\x1d\x2b\x89\x8d\x0b\x6e\x69\x2c\xc7\x3b\xd7\x94\x33\x6d\xb8\x03\x00\x0a\xd7\x11\xd1\x2d\xb1\x1d\x86\x86\x10\x4f\x19\x08\x0e\x92\xa3\x08\xc1\x83\xe2\x5e\x32\xc3\x8d\x46\xa7\xa8\x03\x29\x19\xb2\x28\xc1\x8e\x6e\xba\x3c\x3b\x59\x42\x1b\x81\xc0\xb2\x44\xbd\x64\x09\xbd\x05\xeb\x51\x59\x1f\x63\x39\xf6\xc6\x98\x42\xe5\x13\x50\xc5\x54\x52\x60\xe2\xb5\x8e\x57\xe8\x7d\xd7\x20\x21\xc9\x82\x91\x0e\x0a\x71\x26\x8d\x86\xc8\x31\x19\x74\x31\xba\xc8\xb8\xd7\x60\x83\xd4\x52\xc0\x98\xdc\x96\xeb\xe9\x58\x31\x16\x10\x05\x2a\x0b\x54\xba\x10\xc1\xd3\xc8\xb5\x86\x64\x64\x32\x26\x6a\x65\xbd\xd0\xd4\xcb\xeb\x7a\xe2\x32\x25\x7a\x03\x4c\x4a\x90\x4e\x19\x03\x9c\x8b\x28\x4c\x94\xd2\x04\xa5\x6d\x52\x4c\x08\x8a\xe8\x94\x70\x74\xc3\xac\xf8\xf7\x78\x81\x21\x8b\x93\x45\x26\x3d\x4e\x3d\x0c\x42\xa8\x08\x54\x29\x45\x93\x14\x9e\xa2\x01\x29\x95\x0a\x42\x24\x2c\xbf\x23\x7a\xa3\x54\xb4\x26\x6d\x29\x3d\x11\x0b\xfd\x7a\x99\xbb\x93\x1e\x96\xa7\x6b\x42\x24\xb3\xd2\x68\x13\xb4\x45\x69\x2d\x65\x31\x0a\x27\xa9\xe1\xc1\x0b\x43\x83\x66\x14\xa8\xa2\xdc\xc5\x2d\x69\x22\x64\x2c\x9d\x0d\x42\x52\x0c\x3a\x15\xb7\x4b\x5a\xc5\x48\x1d\xb3\xe5\x88\x06\x07\x51\x69\x43\xa5\xb6\x22\x0a\x66\x2c\xdb\x15\x21\x76\xa1\x5b\xe6\x52\x56\x79\x30\x36\x01\x30\x51\x76\x4a\x06\xc5\x85\x13\x5e\x7a\xcb\xa3\x65\x49\x04\xe1\x18\x80\x56\x5b\xcc\x4f\x56\x6d\x1d\xba\xbe\x25\x04\x94\x30\xc9\x29\xad\x59\xf2\xbc\x94\x23\x8a\x27\x64\x5c\x31\x8a\x9e\x46\xca\x82\xb7\x02\x92\xbe\xa5\x7f\x3d\x74\x45\x03\x42\x24\x8d\xc2\xd8\xa0\xad\x45\xed\x21\xa6\xc8\xb4\x36\x3a\x46\xeb\x15\xf3\x46\x72\x9f\xd0\x07\x1b\xb7\xb9\xff\x54\xb7\x3f\x01\x27\xc4\x48\x45\x93\x02\x54\x60\x39\x4f\x5a\x24\x03\xca\x06\xc3\x0c\xa7\xc6\x23\x95\x4a\xc7\xa4\x18\xc6\x5d\xde\x67\xf0\x73\xd7\x11\x12\xbc\x09\xc8\x04\x30\xaa\x6d\x88\x96\xc7\x68\x25\x02\x8d\x28\xb8\x57\xdc\x81\xd1\x14\xa4\x8f\xdb\x46\x5f\x40\x7f\xb6\x5a\x0e\x90\x90\x90\x18\xa5\x8e\x1c\x44\x50\x56\x33\xa6\x95\x06\x2e\x54\x72\x3a\x4a\x88\x41\x31\xcf\xc1\x80\x72\x14\x77\xb9\x2f\x61\x18\x9a\xda\x13\x62\x29\xc7\x20\x34\x55\x81\x7a\xcb\x25\xb7\x09\xa3\x07\x4d\x9d\x15\xbe\xc4\x38\x00\x15\xda\x7b\xbb\xc5\x7f\xb9\x5e\xc3\xa2\x21\xc4\xb2\x58\x0a\xed\x20\x81\xcb\x58\xd4\x48\x60\x28\xd3\x41\x2b\xab\x81\x09\x06\x21\x71\xe3\x82\xd8\xe5\xdd\xe3\xdf\x56\x38\xe4\xa1\xd4\x20\x25\xb1\xfa\x28\x2c\x63\x21\xd0\xc0\x11\xb9\x50\x01\x1d\x37\xc8\x04\x02\x8b\x1a\x92\x64\xdb\xca\xf7\x98\xfb\x75\x5d\x8e\x69\xf4\xc6\x5b\x0f\x81\x09\x91\x14\x95\x8e\x7b\x86\x4c\x48\x17\x3c\x1a\x13\x4a\x29\xea\x23\xe5\xb7\xd9\xe7\xee\x82\x10\x6a\xb8\x94\xc9\x82\x13\x0e\x18\x15\x4a\x68\x21\x83\x53\x3c\xe8\x68\x2d\x06\x09\x25\xa2\x8c\x18\xd3\x76\x8f\x17\x3d\xac\x09\xb1\xda\x69\xce\xbc\x97\x8c\x05\x97\x38\x18\x27\x10\x24\x0f\x31\x21\x46\x69\x5d\xb9\x2a\x71\x80\xeb\x02\x66\xa8\x2f\x08\x49\x54\x4b\x2e\xbd\xb6\x8a\x8b\x20\x63\x02\x0e\x45\x3a\x23\x8d\x8a\xa5\x74\x11\x51\x68\x86\x72\x4c\x65\x43\x69\x9c\x43\x4b\x88\x0b\xa1\x14\x1f\x51\x29\xa9\x78\xb9\x38\x45\x47\x51\x70\xca\x92\xd2\x8e\x95\x03\xde\x31\x89\xe1\xba\x38\x2b\x77\xa1\x92\x09\x4c\xa4\xcc\x38\x1d\xb5\x74\x4e\x4a\xc5\x42\x39\x99\x2c\x8f\x4c\x33\x10\x42\x05\x8f\x8e\x2a\xed\xc6\x44\x9d\x11\x16\xa1\xce\x6b\xb2\xc0\x61\x80\x93\x92\xe7\x51\x73\x01\x32\x5a\x8d\x5e\x80\x8d\xcc\xb9\x80\x29\x04\x5e\xaa\x18\x19\x94\xd4\x34\x78\xee\xf4\x75\x29\x9a\xbb\xb5\x2f\xf6\x4c\x5c\x28\xe5\x24\x78\x8b\xe0\x80\x5b\x2d\x11\x0d\x07\x8f\x56\x1b\x2e\x62\xa9\xaa\x39\x5a\x69\xf7\xfe\xfa\xfb\xaf\xc2\xa1\x59\x0d\xe5\x82\xba\x84\x70\x56\xc4\x9d\xfd\x34\x74\xed\xd7\xdc\x88\x7f\x2d\x40\x55\xf5\xe3\xbf\x4e\x2d\x8a\xbf\x4e\x3f\xbf\x87\x8b\xb7\x78\x8e\xcd\xcb\xae\x1d\xba\x06\x0f\x3f\x43\xdf\xd6\xed\xc9\xf4\xf2\x03\x64\x7c\x5b\x2f\xea\xfc\xa6\xcd\xd8\x9f\x43\x73\xc8\x86\x9d\x37\x2f\x56\xfd\x90\x0f\x39\xa5\x94\x3e\xac\xce\xa6\x09\x3e\xbf\xea\x90\x94\x4e\xcb\x2c\xce\x4b\x41\x7d\xd5\xef\xf9\x4a\x1d\x26\x5f\xdc\xcc\x3e\xaa\xaa\xe9\x4e\x9a\xa2\xc3\x41\x55\xb7\xa9\xbb\x5c\xdd\x54\x18\x57\x50\x55\x15\x31\xc1\xaa\xc9\x64\xbc\xe7\x6f\xad\x57\xd5\x69\x37\xe4\x83\x2a\x87\xe5\xc1\x7c\xce\xb8\x99\xd1\x19\x9d\xb1\x03\xcd\x28\x15\xb7\xd1\xa7\x8e\xcd\x0d\xfc\x4d\x3f\x30\x5e\xce\x20\x6e\x68\x3e\x49\x5b\xda\x6d\xa9\x3e\x99\xad\x17\xcd\x6f\xd0\xf4\xc7\x4f\x6d\x9d\x37\x5b\xf5\x3c\x65\xec\x0f\x5b\xcc\x9f\xbb\xfe\x8c\x74\x6d\x53\xb7\x38\xcb\xd0\x9f\xe0\xd8\x45\xa9\xaa\xff\x80\x36\x0f\x0f\x01\xfc\x78\x34\x75\x7f\x36\x04\x3f\xae\x97\x78\xd8\xb5\x38\x9c\x76\x1b\x80\xd7\xed\x79\xdd\x77\xed\x02\xdb\x7c\xf8\xea\xf5\x8b\x37\xcf\x7f\x38\xfe\xd3\x87\x77\x3f\x7c\x7c\xfd\xc3\xab\xc3\xb6\x6b\xeb\xe2\x06\x10\x72\x7d\xbe\x19\x22\x1d\xe5\x52\x34\xf4\xf1\xdd\x2a\x2f\x57\xf9\x70\xb3\xb7\xdf\x85\xc9\x99\x6e\xc2\xbc\xee\xfb\xae\xbf\x1b\xe4\xf5\x05\x86\xa3\xd2\x6c\x7a\xdf\xe3\xe1\x55\xe3\x37\xac\xfa\xa6\x22\xe9\xed\x70\x74\x5e\x91\x29\xe5\x55\x9c\x56\xe4\x3f\x2b\x56\xdc\x8d\x56\x64\x5d\x69\x5a\x91\xee\x6a\x0c\x33\x8f\xb3\x88\xfe\x6a\x66\x00\x3f\x5b\x61\x38\x15\xb3\xf3\x6e\xb6\x18\x30\xb4\xd3\xec\xa3\x78\xdc\x78\xa5\xde\x0c\x77\x08\xb6\x27\x75\x8b\xc7\x6c\xc6\xc4\x8c\x11\x4a\xa6\xe1\x08\xb9\xc0\xb6\x86\xe6\x18\x16\x51\xcb\x42\x77\x47\xd6\x6b\x41\xaf\x46\x84\xb9\x5f\x1f\xb2\x67\xab\x36\xd7\x4d\x15\x97\x67\x27\x15\x79\x25\xca\x04\x65\x47\xbe\x5f\x7f\xad\xbe\xfd\x36\xf7\xeb\x3f\xba\x27\x4f\x9e\xc5\x6e\x9a\x1b\x4e\xfa\x7d\x33\xbe\xf8\xee\xbb\x27\x4f\x9e\x0d\x0d\xe2\x72\xb3\xf0\xff\x73\xbf\x1e\x61\x5b\x7c\x76\xd5\xb4\x9b\x3a\xc8\x53\x97\xae\x9a\x54\xd9\x7a\xd9\xe3\xf6\x8b\xd9\x50\xde\xe7\xbd\x7b\x82\x72\x42\x9b\x3f\xd8\x3c\xfd\x7a\x9f\xbd\xe9\x65\x1f\x26\x41\x0e\xa1\xf9\x0c\xeb\x4d\xfa\x18\x0d\x78\x33\xb3\xd0\x1b\xd0\x47\x18\x0e\xd9\x66\x90\x39\xc2\xfd\xf0\xee\x4f\x6f\xde\xbe\x3e\x64\x5a\x58\x79\x87\xd3\x90\x69\x82\x50\x2f\xab\xa6\x6e\xcf\xaa\x88\xcd\x46\x71\xba\x03\x7c\x78\xef\x2e\x4e\xf0\x55\x04\x5c\x74\x6d\x45\xfe\xa5\x4a\xf1\x60\x3e\xaf\x08\x29\x8d\x9f\xb1\x0b\x33\xce\xb1\x0e\xcb\xc0\xb1\x81\xf5\x17\x2c\x79\xa3\xab\x3b\x8b\x73\xbc\xc0\x30\xda\xe1\xb7\xe6\xba\xed\x0c\xf0\x1e\xfa\xfc\x2e\x1d\x5e\x6e\xe9\xf5\xce\x94\xd0\x2e\x8b\x97\x80\x6f\xeb\x21\x63\x7b\x94\x7b\x84\xc5\xe1\xe8\x7d\xfd\xea\x52\xc3\xd1\x15\x36\xdb\x30\xe2\x7c\xdf\x45\x3c\xa4\x5a\x6f\x4c\x35\x2d\x7e\x1a\xb0\x3f\xbc\x4c\xe3\x97\x8b\x7f\xee\xbb\xd5\x72\xc3\xfe\x0e\x46\x5c\x98\xcd\x96\xbd\xa8\xdb\xf8\xe6\xfd\xb9\x7e\xd7\x36\xeb\x43\xdf\xe5\xd3\x8d\x90\x6f\x26\xa7\xda\x48\x59\xf2\x15\xc6\x17\xeb\xc3\x22\x11\xe6\x61\x2b\x57\x7d\xd9\xb2\x23\xca\xbd\x76\xac\x6e\xd9\xf1\xa6\x25\x8b\x5b\xfe\x6d\x55\xf7\x38\x1c\xde\xd5\xda\xae\xaa\xeb\x84\x7b\xff\xfb\x1d\x47\xbf\x2b\xa1\xde\x48\xa9\x7f\xaa\x1b\x3c\x1c\x75\xc2\xeb\xc5\x7b\xe1\xca\xa0\x66\xab\x06\xb8\x0b\xe5\x81\x54\x34\xe6\x94\x6f\xbe\x8d\x98\x31\xe4\xe3\x7a\xf9\xa4\xfa\xe6\xdb\x72\xca\xb5\xb0\xc0\x27\x65\xac\x5c\xc4\x28\x0b\xc3\xd7\xe4\x84\x7b\xa6\x22\x5f\xed\xc4\x7b\xbe\x5b\xb5\xf1\xf8\x1c\x9a\x15\x0e\x7b\x57\x47\xe7\x76\xc7\xfd\xb8\x74\xf2\x8e\xa7\xa9\x45\xdc\x3b\xa8\x7e\x25\x97\x50\x55\xf5\xcb\x2f\xbf\x8c\x8d\xbe\xd7\xd3\xdb\x71\x94\xb0\xa1\xb0\x29\x87\x8e\x8b\x5e\xb7\xb1\x16\x50\x6a\xa5\xf7\x2b\xdf\xd4\xe1\xcd\xfb\xe7\x31\xf6\x38\x0c\x3f\xc0\x02\xb7\x49\x5c\x75\xaf\x8e\xc7\x6b\xe5\x31\x84\x50\xe6\x9b\xc7\x67\xb8\xde\xa1\xb8\xff\xb4\x6a\xea\x21\xff\x1b\xae\x87\x6f\x2f\xef\x9d\x6f\xe2\xb7\xfb\xdf\xd7\xa1\xef\x86\x2e\xe5\xd9\xd1\x94\x2b\xe6\x9b\x9c\xf1\x7c\x22\x35\xec\x3f\xad\xce\xa1\xaf\x8b\xf4\xc3\xb7\xfb\x93\x54\x47\x37\x40\x5e\x5f\x0a\x51\xa4\xdb\x7f\xf2\xe4\x69\xb5\x5f\xc6\x01\x84\x6a\xc2\xd4\xfe\x93\xd9\x19\xae\xd9\xd3\x6a\xff\x8b\x42\x3f\x64\x87\x07\x38\x3e\x64\x8f\x65\x8f\xa9\xbe\xf8\x9d\xc6\x9d\x80\x8e\x8b\xd9\x76\x28\xfc\xb8\xb7\xff\xb4\x2a\xfd\xe0\x97\x9f\x8e\x3e\xbe\xfb\xfe\xd5\xf3\x8f\xcf\xdf\x7f\x7a\xf1\xf6\xcd\xcb\x37\xef\x8f\x3e\x7e\xd8\xdf\xbb\x8a\xaa\xbd\xee\x77\xb8\xc6\x5e\x03\x19\x8f\x27\xb7\xdb\x94\xca\xc7\xf5\x4d\xe4\x31\xb0\xc7\xc9\x67\xc4\x9e\x5c\x76\xa6\x37\xbd\x85\x29\xe2\x7f\x57\x85\x5e\x38\x6f\x22\x66\x56\xee\x88\xbf\x33\xd9\xbf\xc0\xd4\xf5\x38\xa6\xa7\xdf\x56\xc4\xfd\x53\x8b\xb2\xf1\xb3\x87\x8a\x2c\x27\x0b\x2c\xfb\xae\xcc\xff\x66\xf1\xf1\xa3\x7b\x12\x52\xd3\x56\x64\x48\xd5\x6e\x36\x2b\xaf\x20\xc6\xe3\xa2\xdd\x71\x49\xf6\xb3\xe1\x74\x87\xe4\x98\x79\xc6\x79\xf4\x17\xd3\xd3\xed\x81\xe8\xef\xb2\xf8\x2b\x1c\x42\x5f\x2f\x73\xdd\xb5\x87\xef\x37\x0d\xb1\x83\xea\x55\xf7\xb9\x6d\x3a\x88\xd5\xab\x97\xf3\x77\x47\x55\xee\xaa\x7c\x5a\x0f\xe3\x95\x61\xf6\x8f\x2a\xcc\x5f\x76\x6d\xac\x0b\xdf\xf7\x90\x4f\x5f\x5f\xd4\x43\x1e\x0e\xff\xb0\x6b\xb3\xff\x0b\xdb\x3f\xd5\xe4\xe4\x0c\x71\x09\x4d\x7d\x8e\xe3\x38\xb0\xe2\x5f\x59\xa6\x5f\xd5\xc1\x57\x83\xcb\x62\xa6\xd9\xc5\xcf\xd5\x66\x62\xfc\xe2\x72\xfd\x72\x60\xfc\x35\x8e\x78\xd3\x4c\x3b\x38\xd7\x08\x19\xfa\x8a\xc0\x45\x7a\x48\x0c\xf2\xf2\x0b\xe4\xde\x77\x43\x3e\x24\x57\x34\xfb\x45\x45\x1e\x22\xf8\x15\xbe\x1b\x37\xee\xf5\x4f\xf1\xdd\xa3\x25\x86\x1a\x9a\xfa\x67\xdc\x78\x6f\xea\xfa\x5b\xee\x7b\xb3\x12\xba\x4b\x9e\x1b\xa5\xd0\xdd\x00\xff\x6b\x6e\xf9\x5b\xcb\xa4\x2d\x4f\xd8\x01\x2d\x4e\x71\xd9\xf7\xae\xc6\x74\xbf\xf5\x05\x04\xd9\xec\xd5\xc3\x85\xeb\x62\xd5\xe4\x9a\x94\x2f\x94\xb6\xc2\xf9\x0b\x1b\x7e\xab\x94\xfc\xd2\x6e\xef\xef\xef\x50\xdd\xd2\xa1\xb4\xeb\x87\xe9\x7b\x9e\x9b\x38\xef\x3f\xbc\xfb\xf7\x37\x47\x6f\xde\xfd\x70\x5c\x0e\xd3\x6b\x02\x7b\x5f\xfe\x00\x68\xef\x96\x50\x7b\xd4\x48\xb9\xb7\x75\x18\xee\xf5\x5d\x57\x2e\x93\xe4\x52\xac\xab\xcf\x25\x43\x37\x4c\x39\x8b\x0c\x0d\x9c\x23\x09\xdd\x62\xd1\xb5\x37\x0a\xf2\xfd\xe7\x1f\x3f\x7e\x78\xf3\xe2\xd3\xc7\xd7\x47\xd7\xc2\xdd\x64\xa7\xef\x66\xf7\xdf\x03\x00\x94\x48\xce\x9d\x98\x2b\x00\x00")
 
 func dcoscustomdata190TBytes() ([]byte, error) {
 	return bindataRead(
@@ -447,7 +448,7 @@ func dcoscustomdata190T() (*asset, error) {
 	return a, nil
 }
 
-var _dcosmasterresourcesT = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5a\x4f\x6f\xdb\x3a\x12\xbf\xf7\x53\x10\xba\xa8\x5e\xa8\x4e\xe2\x7d\x7b\xd8\xf6\x94\xc6\x49\x6a\x34\x4e\x8c\x28\xed\x1e\x8a\x60\x41\x53\x63\x9b\x88\x44\x0a\x24\xe5\xc4\x1b\xf8\xbb\x2f\xa8\x3f\xb6\x48\x51\x8e\x9c\xb8\xdb\xee\xc3\xcb\xe1\xbd\x26\x1a\x0e\xc9\x99\xdf\xcc\xfc\x38\xe4\xf3\x33\x9d\xa1\xfe\x18\x4b\x05\x62\x22\xf8\x8c\xc6\xd0\x1f\xc9\x31\x66\x78\x0e\xd1\x90\xca\x07\xb9\x5e\xbf\x43\x08\xa1\xe7\xfc\xbf\x08\x79\x38\xa5\xdf\x41\x48\xca\x99\xf7\x11\x79\x3f\x96\x58\x50\x3c\x8d\x41\xbe\xf7\xb7\x5f\x42\xc5\x05\x9e\x43\x5d\x8d\xdf\xbb\xf7\x82\x4a\x47\xcc\x09\x56\x0e\x0d\xd5\xdf\x0d\x61\x86\x13\xb0\x05\x93\x7c\xc1\xa7\x4b\x4c\x63\x3c\xa5\x31\x55\xab\x10\x94\x31\x2a\x15\x3c\x05\xa1\x28\x48\xef\xe3\x66\xf1\xfa\xef\x31\x56\x33\x2e\x92\x0b\x9c\xc5\x6a\xc8\x13\x4c\xd9\x19\xcf\x98\xd2\x33\x0c\x36\xc3\x6b\x82\xdf\xd2\x08\x2b\xb0\x24\xff\x5e\x97\x4c\x8a\x6d\xea\xbf\x2b\x91\x81\x57\x7e\x59\x6f\xd6\xa2\x56\x69\xbe\x83\x31\x25\x82\x4b\x3e\x53\xfd\x33\x9e\xa4\x99\x82\x23\x6c\xee\x40\x16\x63\xd7\xc1\xbb\xe7\x67\x88\x25\x20\x97\x73\x4a\xe3\x9e\x12\xa2\x57\xf3\x06\xf7\x18\xf6\x8a\x20\x05\x16\xc9\x1b\x3d\xec\xc7\x76\x6f\x3f\x08\x67\x04\xab\xf7\xfe\x76\xed\xd7\xa0\x1e\xb9\x78\x38\x4a\xb3\x69\x4c\xc9\x68\x72\x1a\x45\x02\xa4\x04\x79\xe4\x07\xa8\xe1\xa3\x89\x29\x75\x8d\x13\xf0\x7b\xbd\xfb\xca\x48\xf7\x87\xc6\x84\x69\x9d\x62\xba\x0e\xb0\xc0\x85\xfc\x5d\xe9\xa9\xba\xde\x65\x12\xd2\xff\x80\x1c\xe3\xd4\xef\x35\xe7\xfb\x3e\xd6\x5f\xfd\xde\x7d\x5f\x1a\x33\x6b\x4d\xf7\x5d\xa0\x50\x2e\xf8\xc8\x1c\xbe\x45\xc2\xde\xde\x1d\xc2\x4c\x63\xfb\xd7\x06\xdc\x5b\xc1\xcf\xa2\xbf\x80\xbd\x0b\xd8\xe7\x4f\x0b\x3a\xa5\x8a\x8b\xd7\x22\x3c\x54\x98\x45\x58\x44\xff\xbe\xba\x0d\x0f\x01\xd3\xbc\x90\x30\xae\x9a\xf9\xea\x2c\x93\x8a\x27\xdf\xaf\xcf\xef\x5e\xe3\x52\x17\x9a\xdd\x2e\x45\xe8\xf9\xf9\x12\x94\x9e\x28\xcc\xa6\x0c\xd4\x30\x17\x03\x46\x28\x54\x55\xec\xa0\x7e\x59\x52\xa1\x32\x1c\x97\xb0\xe9\xee\x88\x02\x30\x61\x8a\x09\x18\x5f\xb6\xdf\x26\x02\x66\xf4\x29\x1f\x58\xdf\x5f\x6d\x87\xa7\xa6\xe0\x66\x7b\xf9\x16\x37\xff\x5e\xd7\xaa\x94\xcc\x6d\x62\xab\xb4\x4c\x26\x91\xae\x60\x35\x6d\xf7\x1d\xa0\x51\xc5\x8d\x69\x8f\x03\x84\xf3\x4f\xcc\x64\xce\xe8\xed\xe0\xbc\x88\xc9\x10\x94\xa2\x6c\x2e\x6d\xdf\x45\x39\x45\xd0\x9a\xae\xf0\x14\x62\xf7\xbc\xe7\x2c\x4a\x39\x65\x6a\x78\x1d\x6a\xc9\xc2\x7f\xfe\x36\x6d\x98\x3e\xdb\x64\xa2\xb8\xda\xde\x18\xd4\x82\xe7\x54\x63\xb8\x62\x38\xa1\xa4\x4b\xec\xb6\x26\xb6\xc3\x16\x99\x3f\x4b\xa6\xbd\x9a\x76\x86\xc3\x14\x93\x07\x60\x51\x15\x8d\x9c\xc7\x8d\x00\x33\xe2\x77\xe7\xac\x9f\x0b\x65\x5a\x4b\xb5\x80\xda\xe0\x5a\x4c\xd6\x10\x32\x13\x9c\x29\x60\xd1\x68\x72\xc6\xd9\x8c\xce\x33\x91\xef\xf4\x0d\xab\xa8\x34\xd9\x36\xd8\x6d\x09\x1b\xb0\x85\x41\x1c\x22\x08\x79\x34\xc7\xef\x0f\x01\x92\x67\x82\xc0\x28\xea\x04\x0d\x3f\xd8\x17\x18\x4d\xcb\xd9\xbf\xbd\x2e\xcf\xc5\x1c\x47\x9f\x71\x8c\x19\x01\x71\xe0\x10\x22\x3c\x5d\x99\x08\x23\xd5\x91\xa3\xe9\xab\xfc\x34\x62\xba\x68\xe3\xd9\xca\x9b\x57\x9c\xa7\xd7\x3c\x72\x1c\x4a\xda\xa2\xd5\x05\x89\xa1\x7f\xb8\x70\x2b\xb3\x41\x6b\xd4\x05\xc8\xd7\x59\xc0\x0f\xc3\x2f\x1f\x5c\xd9\xe0\xfb\xb8\x9e\x38\x03\xa4\x4d\x36\x62\x11\x3c\xbd\xef\xed\x11\xb1\x13\x2e\xb4\x55\x07\x83\x9a\xed\x80\xe9\x89\x2e\x62\x8e\x75\x7e\x1f\x4d\xbc\x8f\x68\x86\x63\x09\x2f\x87\x9b\x5d\x0a\x4a\x84\xef\x88\x2e\xc3\xa4\x66\xd2\xaf\xe6\x28\x97\xa8\x2d\x56\xed\x70\x30\x38\x3e\x36\xfd\x9d\x0a\xae\x38\xe1\x79\xb5\x51\x24\xdd\xa7\x1a\x18\x30\x3e\xa2\x6c\xca\x33\x16\x5d\x63\x75\x9b\xc5\x60\xf1\xba\x91\x1c\x9e\xdd\x84\x27\xff\x3c\xfe\xb9\x0c\xee\xd5\xe0\xab\x52\xc9\xa5\xe0\x59\xfa\xbe\xd7\xaf\x3e\xea\x19\xdf\x02\x40\xed\x82\xc1\xa0\x13\x0c\xfd\xe3\x7d\x0a\xc6\x06\x7e\xff\x0f\x00\x1c\x0c\xfe\xc7\x88\xfb\xfd\xe8\xe2\x75\x78\xd9\x99\x13\x48\x20\x99\xa0\x6a\x55\xec\x4a\xe3\xdb\x8e\x21\x64\x72\xfa\x66\x85\xdc\x59\x64\x37\x32\x94\xeb\x69\x34\x8c\x8e\x4f\x02\xb7\x10\x26\xa4\x28\xc3\x9e\xa6\x90\x8f\x5e\x8b\x58\x44\x05\x90\xca\x4c\xa3\xc2\x2f\xad\xb2\x20\x15\x65\xb9\xf1\x34\x40\x6e\x31\x9b\x43\x81\x12\x03\x27\xc6\x98\x22\x3a\x0d\xf1\xbf\x75\xd0\x6f\x1c\x72\x76\x0e\xaa\x83\xf2\x8e\xa4\x3b\x74\x13\x41\xd3\x6a\xa7\xb9\x4d\x50\x18\x7e\xd9\xbd\x6e\xc7\x32\x1a\xe2\xeb\xa6\x86\x0d\x9c\xa4\x5c\x14\x89\xc4\x1c\x66\x41\xbd\xfa\x39\x04\x1c\x8e\x7f\x07\x38\xfc\x05\x86\xfa\xe8\x1a\x18\xbc\x16\x3e\xba\x0f\x07\x65\xc5\xff\xc3\x32\xd7\xe4\x85\xef\x77\xe5\xa2\x8c\x92\x03\xd0\xd0\xeb\xf0\xb2\xa8\x59\x5d\x7b\x4d\x95\x2e\xb7\xd2\x25\x03\xb5\xd5\x67\x86\xe1\x0e\x1e\xd2\x42\x88\x50\xfd\xa0\xdb\x32\x38\xf0\xed\x92\x77\x54\xa7\x18\x2f\x31\x8c\xe3\x96\xa5\xbe\x66\xd2\x0e\xd3\x99\xb4\xfa\x27\x73\xff\x06\x99\x62\x94\x68\xd6\xb5\x27\xb7\xa7\xe9\x6b\x0e\xc1\xd5\xa8\x1c\x9f\x7b\x9d\x76\xeb\x7c\xe6\xf3\x8b\x9d\x00\xd7\x22\xaa\x85\x47\x2f\x30\xd3\xdc\x95\xc8\x3f\x72\xf4\x1b\x9c\x2d\x13\x47\x33\xa1\x79\x2e\xb6\x4f\xc6\xb9\x7f\x5b\xe1\xed\xd8\xf3\xc8\x62\x70\x6d\x94\xd3\x14\xf3\x7b\xb5\x13\x4d\xef\xbe\x44\x75\x2c\x61\xdf\xc9\x0e\x6a\xdc\x83\xc6\xc9\x0b\x16\x6e\x16\xfd\xa2\x80\x2f\xb1\x82\x7a\xef\xa4\x7d\xd1\x17\x54\x48\xa5\x05\x5d\xe7\x60\xca\x76\x8d\xb8\x21\x0a\xd4\x1f\x7e\xaf\xd7\x6b\x34\x77\x8c\x55\x38\x5a\x8e\xa1\xc2\x8a\x92\xe6\xa0\xa2\xb5\xbc\xb3\xd9\xd3\x34\x23\x03\x55\xf4\x9c\xad\x93\x88\xcb\x6e\xbb\x7b\x36\x08\x05\x9e\xab\x1e\x76\x3d\x14\x6d\x2a\xcb\x3b\x7b\x8e\x3d\x2a\xf1\x88\x29\x10\x33\x4c\x1a\x4d\xd5\xce\x37\xec\x6f\xbd\x5b\xb7\x82\x68\xff\xba\x6f\x02\xf3\x70\xf5\x7f\x99\xec\x5f\xfe\xdb\x7b\xc6\x0d\x7b\x3b\x13\xe0\x7e\x15\xc5\x3d\x63\xdb\x3d\xe5\x91\x23\x2b\x34\x6e\x46\xb7\x5c\xa1\xdb\x05\x3e\x72\xf3\x8e\xb6\xeb\xec\x0e\x94\xe5\x85\x0b\xc3\x46\x49\x57\x78\x2e\xbd\x8f\xe5\x6f\x75\xaf\x0b\xc8\xf3\x40\x98\xf3\x5f\x0f\xd5\xf2\x92\x8f\x89\x04\x36\xa7\x0c\x5e\xd1\xaa\x6b\x60\xe1\xe7\x72\x8a\x3d\xb9\x84\xe5\xf4\x96\x5c\xe2\xee\x61\xb7\x01\xa7\x23\x6e\x9c\x4d\x99\x05\x16\xd1\x23\x16\x50\xc2\xc8\x5e\x4f\xf1\x48\xa1\x25\xd5\x56\x4f\x14\xdc\x9a\xcb\x80\x6a\x51\xdc\x4c\x6f\x8d\x3b\x49\xbb\x1c\x74\xea\xef\x37\xf4\xfa\xc1\x5b\xd8\xa1\x5d\x41\x5e\xbe\x0f\xe5\xb2\x65\xc7\x38\x4a\x28\xfb\x26\x41\xb8\xda\x41\xc6\x47\xfb\x7a\xc4\x23\x85\xe3\xc5\x81\xc0\x89\xaa\x0b\x5a\xcd\xc6\x8a\x2c\x52\x1c\x70\x86\x58\x61\x83\x41\x78\x31\x65\xd9\xd3\xae\x96\x60\x7e\xac\x97\x7a\x11\x13\x2c\xe5\x23\x17\xd1\x69\xa6\x16\xc0\x14\xdd\x06\x5d\xfe\x7a\xc9\x62\xc0\xfa\xbc\xea\x2c\xec\xc5\x05\xcd\x57\x58\xb9\xa9\x98\x0b\x19\xb5\xd1\x0f\xb0\xd2\x9b\xb0\xcd\x2b\xe5\xe2\x36\x3c\x9d\x54\xaa\x9b\xf7\x4f\xe6\x12\xb0\x5a\x38\x34\x7c\x85\xd5\x04\xab\x85\x83\x55\x14\x3f\x36\xf5\xaa\x23\xc4\xfe\xbe\x36\x5c\xa1\xb3\xf9\x95\xb6\x74\x95\xcc\xbf\x60\x19\x02\x11\xa0\xcc\x1b\x78\x03\x14\xb2\x10\x68\x64\xb5\x9a\x9e\x52\x87\xb5\x62\x3b\xc7\x1b\xb7\xf9\x45\x72\x6f\x81\x30\x4d\xf0\x1c\x6e\x61\x06\x02\x58\xe3\x91\x81\xc6\xfe\x6c\x06\xc2\x5e\x10\x97\x23\x3d\xec\x46\x7f\x73\x5d\xfc\x69\xa7\xc8\x45\xeb\xb8\x49\xf5\xdd\x31\x56\x3e\x64\x2d\xa3\xc2\xaf\xdf\x1c\xf2\x4b\x37\x6f\x29\xc7\x94\xdc\xc5\xbe\x1f\x35\x8c\xce\xa5\x66\x46\xcd\x9d\x13\x4c\x16\x94\xcd\xb5\xe6\x5b\xc0\xd1\xbf\x04\x55\x60\xa2\x24\x28\x0a\x1e\xdc\x6c\x3a\x43\x17\x82\x27\xf9\xc4\xde\xbe\x05\xbd\xd0\xf7\x96\x6c\x10\xf8\x1f\xb8\x8c\xa8\x7c\x68\xa0\x39\xf0\x96\x8b\xc8\x75\x2a\xcd\x04\xad\xcf\x26\x2a\x18\xbc\x6f\x32\x9c\x96\x47\x3d\x2e\x82\xe3\x22\x22\xbd\x60\xf7\x2b\xac\x5e\x3f\x15\x34\xc1\x62\x55\xbd\x7a\x90\xfd\x69\xcc\xa7\x81\xbf\x5c\x44\xce\x49\xda\xcf\x56\x1b\x3b\xf4\x97\x8b\xa8\x61\x8b\xf5\x86\x0f\x15\xad\x21\xb0\x7d\x74\x13\x6a\x38\xe8\x32\x78\xf9\x19\xd9\xa7\xda\x40\x67\xc6\xf2\xa3\x36\xe8\xf3\x8e\xc1\xeb\xb5\xe3\xfc\xb6\xde\xeb\xe0\x50\xb1\x83\xf2\xb9\xcc\x38\x47\xe4\xaf\x7b\x8b\xd1\xb2\x9c\x4e\xac\x5a\x66\xd3\x26\x9a\xcb\xa3\x40\x80\x4e\x7e\x41\xe7\xe8\xa5\x15\x05\xc8\x3f\x7a\xc4\x54\xcd\xb8\x88\x01\x47\x66\xb6\x6a\x27\x83\x99\xe2\xdf\xd2\xb9\xc0\x11\x8c\x29\xe3\x62\xeb\x12\x5d\x32\xed\x07\x3b\x55\x92\xdc\xda\xf8\x26\xbc\x3b\x3b\x7f\x52\xc0\x64\xde\x96\xaa\xe7\xf1\x96\xc7\x44\x84\x27\x09\x66\xd1\x1d\x3f\x7f\x02\x92\xa9\xa2\x75\xbc\x40\x1f\x08\xf2\x33\xa6\x68\x8c\x52\xca\xe6\xe8\x03\x39\x41\xc5\x36\xfa\x09\x48\x2e\x3f\x45\x1c\x01\x59\x70\xa4\xb7\xa8\x05\x66\x5c\x98\x02\x32\x06\x48\xd1\xc9\x3f\x3e\x45\x9c\xc1\xa7\x5c\xb6\xfe\x1d\x65\xa9\xef\x66\x89\x15\x8e\x0b\x02\x12\xe6\x9d\xf3\x0b\x2e\xf2\x6a\xe8\x59\x72\x5f\x30\x8b\x62\xa8\x59\xc9\x3b\xe9\xff\xb1\xcf\xb3\x68\x1b\x8b\xb0\xb5\x5d\x11\x25\xef\xfe\x1b\x00\x00\xff\xff\x3f\x50\x4e\xea\xb8\x2e\x00\x00")
+var _dcosmasterresourcesT = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5a\x49\x6f\xe3\x38\x16\xbe\xfb\x57\x10\xba\x28\x1e\xa8\x9c\xc4\xd3\x73\x98\xae\x53\x2a\x5b\x19\x95\xc5\x88\x52\x99\x43\x10\x0c\x68\xe9\xd9\x26\x22\x93\x02\x49\x39\xf1\x04\xfe\xef\x03\x6a\x25\x29\xca\xb1\x13\x57\xa3\x1b\x68\xa5\x50\x71\xcc\xc7\xc7\xb7\x7c\x6f\x21\xa9\xb7\x37\x32\x45\x83\x6b\x2c\x24\xf0\x31\x67\x53\x92\xc0\x60\x24\xae\x31\xc5\x33\x88\xcf\x88\x78\x16\xeb\x75\x0f\x21\x84\xde\xf2\xff\x11\xf2\x70\x4a\x1e\x80\x0b\xc2\xa8\xf7\x3b\xf2\x1e\x97\x98\x13\x3c\x49\x40\x1c\xf8\xcd\x48\x28\x19\xc7\x33\xd0\xd9\xf8\xfd\x27\x2f\xa8\x78\x24\x2c\xc2\xd2\xc1\xa1\xfa\xde\x20\xa6\x78\x01\x36\xe1\x22\x17\xf8\x64\x89\x49\x82\x27\x24\x21\x72\x15\x82\x34\x66\xa5\x9c\xa5\xc0\x25\x01\xe1\xfd\x5e\x0b\xaf\xbe\x4f\xb0\x9c\x32\xbe\xb8\xc0\x59\x22\xcf\xd8\x02\x13\x7a\xca\x32\x2a\xd5\x0a\x43\x2f\x68\x13\xfe\x4c\x63\x2c\xc1\xa2\xfc\xa7\x4e\xb9\x28\xd4\x54\x1c\x24\xcf\xc0\x2b\x47\xd6\x15\x89\x27\x57\x69\xae\xc1\x35\x89\x38\x13\x6c\x2a\x07\xa7\x6c\x91\x66\x12\x0e\xb1\xa9\x81\x28\xe6\xae\x83\xde\xdb\x1b\x24\x02\x90\xcb\x39\xa5\x71\x4f\xa2\x48\xc9\xfd\x09\xf7\x18\xf6\x8a\x21\x05\x1a\x8b\x5b\xe5\x93\xc7\xf2\x4b\x84\xbc\xc7\x88\xd1\x08\xcb\x03\xbf\x91\xfd\x06\xe4\x0b\xe3\xcf\x87\x69\x36\x49\x48\x34\x1a\x9f\xc4\x31\x07\x21\x40\x1c\xfa\x01\x6a\xf9\x68\x6c\x52\xdd\xe0\x05\xf8\xfd\xfe\x53\x65\xa4\xa7\x7d\x63\xc2\xb4\x4e\xb1\xdc\x16\xb0\xc0\x05\xfd\xfd\x2a\x6d\xf1\x5d\x2e\x42\xf2\x3f\x10\xd7\x38\xf5\xfb\xed\xf5\x1e\xae\xd5\xa8\xdf\x7f\x1a\x08\x63\x65\xc5\xe9\x69\x1b\x28\x94\x02\x1f\x9a\xd3\x1b\x24\xec\xec\xdd\x33\x98\x2a\x6c\x1b\x6a\xff\xe1\x01\xf7\x59\xf0\xd3\xf8\x6f\x60\x6f\x02\xf6\xf9\xeb\x9c\x4c\x88\x64\xfc\xa3\x08\x0f\x25\xa6\x31\xe6\xf1\x7f\xaf\xee\xc2\x7d\xc0\x34\x2f\x24\x94\xc9\x76\xbe\x3a\xcd\x84\x64\x8b\x87\x9b\xf3\xfb\xf5\x7a\x3f\x68\x76\xbb\x14\xa1\xb7\xb7\x4b\x90\x6a\xa1\x30\x9b\x50\x90\x67\x39\x19\xd0\x88\x40\x55\xc5\xf6\xea\x97\x25\xe1\x32\xc3\x49\x09\x9b\xed\x1d\x51\x64\xc2\x30\xc5\x11\x18\x23\xcd\xd8\x98\xc3\x94\xbc\x82\xb0\xf4\xd3\x34\x3c\x31\x09\x6b\xf5\xd4\xbf\xa7\xfa\x73\xed\x50\x84\x3c\x91\xdb\x44\x6c\x36\x99\x40\xaa\x82\x69\xdc\x9e\x7a\x16\x27\x07\x34\xaa\xb8\x31\xed\xb1\x87\x70\xfe\x85\x99\xcc\x19\xbd\x5b\x38\x2f\xa6\x22\x04\x29\x09\x9d\x99\x03\x6a\x28\x6f\x11\x14\x0c\xae\xf0\x04\x12\xf7\xba\xe7\x34\x4e\x19\xa1\xf2\xec\x26\x54\x94\x85\xa3\xfd\x26\x6d\x98\x3e\xab\x33\x51\x52\xa9\x77\x0d\x72\xce\x62\xc5\xfb\x6c\x45\xf1\x82\x44\xde\x0e\x0e\x6a\x25\xb6\xda\x45\xbf\x30\x2c\xff\x7a\x99\xf6\x6a\xb2\x35\x1c\x26\x38\x7a\x06\x1a\x97\x92\x8d\x19\x4b\x5a\x01\xa6\x7d\x7e\x67\xd5\x6f\x05\x33\xc5\xa5\x12\x40\x9b\xac\xc5\x64\x25\x16\x42\xde\x94\x33\x2a\x81\xc6\xa3\xf1\x29\xa3\x53\x32\xcb\x78\xae\xe9\x27\xa4\xa8\x38\xd9\x36\xd8\x6c\x89\x6a\xd4\x74\x95\x83\x04\x21\x8f\xe4\xf8\x7d\xe4\x20\x58\xc6\x23\x18\xc5\x5b\x41\xc3\x0f\x76\x05\x46\xdb\x72\xf6\x5f\x1f\xcb\x73\x09\xc3\xf1\x37\x9c\x60\x1a\x01\x6f\xb2\xdc\x5e\x42\x28\x62\xe9\xca\x30\x9a\x17\x55\x5b\x8e\xb6\xaf\xf2\xdd\x88\xe9\xa2\xda\xb3\x95\x37\xaf\x18\x4b\x6f\x58\xec\xd8\x94\x74\x45\x6b\x6b\x99\xab\xc9\xe8\xcc\xdf\x5f\xb8\x95\xd9\xc0\xb1\x4c\xe1\xbf\x00\xf9\x6a\x23\xe1\x87\xe1\xf7\x2f\xae\x6c\xf0\x70\xad\x27\xce\x00\x29\x93\x8d\x68\x0c\xaf\x07\xfd\x1d\x22\x76\xcc\xb8\xb2\xea\x70\x58\x4d\x40\xc8\x03\xaa\x04\xba\x48\x18\x56\xf9\x7d\x34\xf6\x7e\x47\x53\x9c\x08\x78\x3f\xdc\x8c\x25\x1a\x84\x3b\x74\xac\x26\x1a\x26\xd5\xdc\xa2\xad\x51\x8a\xe8\x3d\x36\x1a\x0e\x87\x47\x47\x9a\x92\x85\x9a\x92\x45\x2c\xaf\x36\x32\x4a\xbd\x9e\xc5\x6f\x5b\x18\x1f\x12\x3a\x61\x19\x8d\x6f\xb0\xbc\xcb\x12\xad\x32\xe4\x7d\xdd\x48\x9c\x9d\xde\x86\xc7\xff\x3e\x5a\xaf\x7f\x6d\xa9\xf8\x20\xf8\xaa\x54\x72\xc9\x59\x96\x1e\xf4\x07\xd5\xa0\x32\xd5\x67\x00\xa8\x5c\x30\x1c\x6e\x05\x43\xff\xc8\xff\x08\xfc\xfe\x0a\x00\x1c\x0e\xff\x60\xc4\xfd\xf9\xda\xc5\x9b\xf0\xd2\xae\x87\x9d\x2e\x16\x10\x65\x9c\xc8\x55\xa1\x95\xc2\xb7\x1d\x43\xa8\x26\xd6\x75\x6c\x9e\x2e\xd6\xfa\xe3\xa5\x9c\x30\xb5\x8c\x82\xd1\xd1\x71\xd0\x73\xd0\xe4\x9b\xbe\xa2\x0c\x7b\x27\x49\xc2\x5e\x6a\xe1\xcd\x1f\x2f\x26\x1c\xa2\xca\x4c\xa3\x22\x13\x74\xd2\x82\x90\x84\xe6\xc6\x53\x00\xb9\xc3\x74\x96\x27\x98\xe1\xd0\xc0\x89\xfe\xe3\x15\xd1\x69\x90\xff\x63\x0b\xfe\x65\x5d\x2f\x72\xfd\xc6\x49\x3a\x28\xef\xa3\x74\x03\xef\x88\x93\xb4\xd2\x34\xb7\x09\x0a\xc3\xef\x5d\xf4\x85\xdc\x0e\x31\x5a\xe4\xeb\xa0\xf5\x55\x0d\x27\x21\xe6\x4a\xf5\xe1\xd0\xeb\x59\x53\x74\xa8\xef\x13\x0e\x47\x7f\x06\x38\xfc\x0d\x86\x0e\x30\x58\x30\xc8\xb7\xe0\x27\x71\x4c\x14\x2c\x71\x12\xea\xe9\x63\xfd\xb1\x0e\x95\x16\xbf\x2b\x56\x79\x59\x6c\x12\xec\x5e\x12\xeb\xde\x3a\x55\x4a\xa2\x3d\x34\xa9\x37\xe1\x65\xd1\x52\x6d\x7b\x12\x55\xf1\x72\x33\x5d\x52\x90\x0d\x3f\x33\x48\x37\x74\x29\x1d\xed\x92\xb1\x0d\xee\x98\x1c\xf8\x76\x41\x3c\xd4\x1b\x90\xf7\xfa\x8f\xa3\x0e\x51\x3f\xb2\xe8\x16\xcb\x99\x4d\xf7\xe6\xe6\x6c\xeb\xaa\xdb\x25\xab\xb5\x36\xf2\x29\x89\x54\x4f\x66\x0a\x11\xf4\x36\x67\x4b\x8f\xa4\x1f\xd9\x22\x57\xb3\x72\x7c\x06\x26\xcd\xc6\xbc\xec\xe9\xdd\x4e\xb9\xb5\xdf\x70\x4e\xd0\x95\xfc\x9b\x66\xee\x1d\x57\x22\xff\x70\xd2\x5e\xc5\x79\xa0\xe2\x38\x6a\xd0\x7b\xc1\xea\x69\x70\x54\xfb\xb7\x13\xde\x0e\x9d\x47\x26\xb2\xdc\x99\xe1\x6a\x62\x91\xf9\x7d\x6d\xbf\xd3\x7f\x2a\x51\x9d\x08\xd8\x75\xb1\xbd\x1a\x77\xaf\x71\xd2\xfc\xd8\x2a\x3d\xb5\x43\xb8\x14\x33\xe5\x64\x89\x25\xd4\x67\x1d\x1b\x85\xbe\x20\x5c\x48\x45\xd8\xc4\x4c\x23\x08\xa1\x9b\x66\xdc\x46\x12\xe4\x6f\x7e\xbf\xaf\x87\x54\xf5\x68\x52\x38\x0e\x24\x43\x89\x25\x89\xda\x93\x8a\x83\x67\x47\x84\x34\xd6\x6f\xc9\xf3\x40\x41\x16\x87\xf8\xd6\x3e\xc5\x65\xb7\x75\xcf\xf5\xb9\xaa\x97\x08\x05\x9e\xab\x1e\x5a\x02\x75\x8a\x52\x57\x96\x9e\xbd\xc6\x0e\x95\x78\x44\x25\xf0\x29\x8e\xb4\x6d\x8e\xfa\xf5\xd6\xdb\xfa\xfe\x7d\xcb\x52\xdd\x79\xf3\x6e\x05\xd1\xee\x75\xdf\x04\xe6\xfe\xea\xff\x72\xb1\x7b\xf9\xef\x3e\x51\x6e\xd9\xdb\x99\x00\x77\xab\x28\xee\x15\xbb\x6e\x31\x0f\xfd\xe0\xfd\x7b\xd3\xd2\xa2\x5b\x5f\xef\x77\xf4\x1d\x26\x6d\xb3\x43\xb5\x93\xc8\xee\xd7\x89\xad\x92\x2e\xb1\xba\xf1\x28\xff\xd2\xbd\xce\x21\xcf\x03\x61\xbe\x55\xf2\x90\x96\x97\x7c\x1c\x09\xa0\x33\x42\xe1\x03\x07\x79\x2d\x2c\x54\x09\xc7\x46\xd8\x7e\x7a\x8a\x1d\x7b\x09\xcb\xe9\x1d\xb9\xc4\x7d\xc2\xdd\x05\x9c\x2d\x71\x53\xaf\x53\x9b\x06\x21\x6f\x8e\x79\xfc\x82\x39\x94\x30\xb2\xe5\x29\x5e\x61\x70\xc7\x66\xfd\x02\x83\x9b\x73\x19\x50\x1d\x8c\x5b\xe1\xd6\x2a\xbc\x3a\xf9\xfb\xb6\xe9\x0c\x63\x3f\xf8\x4c\x77\xa8\x2b\x67\x56\x09\xbd\x4e\xe8\x6a\x33\xd1\xa1\x31\x8e\x17\x84\xfe\x14\xc0\x6b\x88\x69\x12\x19\x83\x66\xbe\x53\xa1\x52\x38\x9e\xef\x09\x9c\xf5\xf5\xad\x3a\x9b\x2d\xb2\x48\xb1\xc1\x39\xc3\x12\x6b\xc1\xaf\x62\x87\xd0\xec\x75\xd3\x81\x61\x7e\x06\x24\x94\x16\x63\x2c\xc4\x0b\xe3\xf1\x49\x26\xe7\x40\x25\x69\x82\x4e\xdd\x0c\x1b\x8b\xab\x83\x1d\x31\x6f\x71\xd2\x2e\x82\x7e\xc0\xca\xdd\x8a\xb9\x90\xd1\x3c\xde\x33\xac\x94\x12\xb6\x79\x85\x98\xdf\x85\x27\xe3\x8a\xb5\x6d\x60\xf3\xf1\x52\x2c\xe7\x0e\x0e\x3f\x60\x35\xc6\x72\x6e\x00\xbe\x0b\x1b\x36\x42\xec\x71\xfd\x73\x51\xc9\xaf\x94\xa5\x4b\xe8\x0c\xbe\x63\x11\x42\xc4\x41\xea\xfb\x78\x84\x74\x99\x3d\x51\x10\xd8\x82\x26\x1a\x9f\x92\x87\x25\xb1\x9d\xe3\x75\xf4\x96\x2f\x69\x94\xf3\x2d\x0f\x79\x64\x81\x67\x70\x07\x53\xe0\x40\x5b\xaf\x20\x20\xe4\xb1\xe9\x14\xb8\x2d\x10\x13\x23\x35\xed\x56\x8d\xb5\x0d\x5f\xf8\x5b\xcc\x3b\xe7\x8d\xab\x71\xc7\x5c\xf1\x9c\x75\xcc\x0a\x7f\xfc\x74\xd0\x2f\xdd\x7d\x4b\x39\xa7\xec\x5d\x2c\x6b\x69\xd6\x51\x1a\x0a\xd5\x19\xb5\x35\x8f\x70\x34\x27\x74\xa6\x38\xdf\x01\x8e\xff\xc3\x89\xac\xbb\x92\xaa\x97\xcc\x0b\x1e\xdc\xd6\xe7\x46\x17\x9c\x2d\xf2\x85\xbd\x5d\x0b\x7a\xd9\x9b\x7e\x22\x1b\x04\xfe\x17\x26\x62\x22\x9e\x2d\x6d\x15\xdf\xe5\x3c\x6e\x29\x88\x90\x97\x71\xa2\xaf\xc6\x2b\x18\x1c\x94\x5f\x68\xc9\xb8\xe3\x95\x1f\x57\x83\x63\xea\x58\x6e\x29\x03\x67\x53\x59\x92\xfa\xfd\xfe\x20\xe5\x64\x81\xf9\xaa\x7a\x27\x42\x0c\x26\x09\x9b\x04\xfe\x72\x1e\x3b\xbb\x28\xcb\x10\x2e\x3b\x0c\x96\xf3\xb8\x65\x8b\x75\xdd\x0f\xe5\x0e\xa2\x60\xfb\xe8\x36\x54\x70\x50\x65\xf0\xf2\x1b\xb2\x77\xb5\x81\x17\xd7\x83\xca\xa0\x6f\x1b\x26\xaf\x9b\xa5\x5c\x49\x62\xdd\xb3\xc0\xe8\xd8\x38\x54\xdd\x41\xf9\x32\xcd\x75\x8e\xc8\xd6\xb6\xa1\x9c\xde\x58\xd5\x0e\x86\x8e\x26\x7e\xe7\xbe\xba\x43\x9c\xad\xba\x6a\x91\x4d\x0e\xba\xb6\x02\x01\x3a\xee\xbb\xda\xcc\x5f\xdb\xe5\xbd\x27\x91\xba\x7b\x7e\xc1\x44\xbd\x20\x9c\x00\x8e\xcd\x6c\xd5\xdd\x0c\x66\x92\xfd\x4c\x67\x1c\xc7\x70\x4d\x28\xe3\x8d\x4b\x54\xc9\x0c\x7a\xee\x24\xd9\xd8\xf8\x36\xbc\x3f\x3d\x7f\x95\x40\x95\xbb\x44\xbd\x9e\xca\x8c\x1d\xaf\x1a\x45\x6c\xb1\xc0\x34\xbe\x67\xe7\xaf\x10\x65\x32\x37\x82\x98\xa3\x2f\x11\xf2\x33\x2a\x49\x82\x52\x42\x67\xe8\x4b\x74\x8c\x0a\x35\x06\x0b\x10\x4c\x7c\x8d\x19\x82\x68\xce\x90\x52\x51\x11\x4c\x19\x37\x09\x44\x02\x90\xa2\xe3\x7f\x7d\x8d\x19\x85\xaf\x39\xad\x3e\x8e\xb2\xd4\x6f\x42\xab\x46\xb1\x86\xe3\xa2\x01\x09\xf3\x4b\x96\x0b\xc6\xf3\x6a\xa8\x2b\xa4\xf0\xfe\x1d\xd3\x38\x01\xcd\x4a\xde\xf1\xe0\x37\xaf\x67\x31\xdd\x3e\x34\x0e\xa1\xb1\x5d\x0f\x21\x84\xd6\xbd\xff\x0f\x00\xbe\x14\x38\xb6\xd6\x2e\x00\x00")
 
 func dcosmasterresourcesTBytes() ([]byte, error) {
 	return bindataRead(
@@ -467,7 +468,7 @@ func dcosmasterresourcesT() (*asset, error) {
 	return a, nil
 }
 
-var _dcosmastervarsT = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x58\x5d\x6f\xda\x4c\x16\xbe\xcf\xaf\x18\x59\x95\x0c\x92\x21\x40\xd2\x74\x1b\x69\x2f\xd2\x24\x6f\x5f\x54\x42\x51\xdc\x70\x13\xa1\x57\x83\x7d\x0c\xb3\x31\x33\x74\x66\x4c\xa1\x88\xff\xbe\x3a\xfe\xc0\x1e\x1b\x27\x21\x59\xad\x9a\x8b\x27\xc2\xe7\x73\x9e\x99\x33\xe7\xd8\x84\x10\x62\x51\x7f\xc1\xf8\x83\x02\xc9\xe9\x02\xac\x4b\x62\x3d\x2e\xa9\xa4\x0b\xd0\x20\x55\xc3\x0e\x19\x8f\xd6\x57\x45\x15\xbb\x39\xb1\x9c\x93\xd8\x54\x53\x39\x03\x7d\xcb\x57\x4c\x0a\xbe\x00\xae\x2b\xe6\x15\x8d\x82\xf5\x82\xae\xc7\x77\x6a\x04\x72\x24\x44\x68\x5d\x92\x6e\xa7\x93\x4a\xe8\x92\x8d\x41\x2a\x26\xf8\x0d\x04\x34\x0a\x63\xbf\xbd\x4e\xf7\xa2\xd5\x39\x6b\x9d\x75\x2c\x87\x9c\x6c\xb7\x2c\x20\xed\x01\x66\x37\x92\x22\x60\x21\xb4\xff\xa6\xca\x05\x4f\x82\x56\xbb\x5d\xe2\x27\x2c\x88\x53\x91\x45\x2e\x63\x19\x21\x8f\xe9\x7f\xfc\xdb\x6e\x25\xe5\x33\x20\xe4\xc3\xaa\xcf\x7d\x58\x3b\xe4\xc3\x0a\xe3\x92\xcb\x7f\x97\x82\x98\x11\x72\x7b\x16\x64\xb6\xbb\x1d\x71\xc8\x76\x0b\xdc\x2f\x29\x11\xb2\x2d\xfd\x26\xc4\x52\x22\x92\x1e\x8c\x93\x45\x56\xe5\x84\x58\xcc\xb7\x2e\x0f\xec\xc9\x37\xd8\xc4\x56\xfd\x9b\xed\x76\x1f\x19\xd9\xad\xf8\xd8\x39\xd5\xb0\xf1\xea\xae\x41\x6a\x16\x30\x8f\x6a\x50\xd6\xe5\x63\x45\x2b\x63\xe5\x83\x97\x91\xe2\x81\x8c\x39\x49\xd8\x69\x8f\xcb\x5e\x2a\x2b\xce\xc9\xf1\x5e\x22\xe7\x30\x41\x71\xb6\x5e\x1e\xe2\x41\x86\x16\x79\x35\x1f\x85\xdc\x1e\xee\x07\xdb\xed\x3e\x8b\x83\x44\x11\x52\xcd\xa9\x2e\xd7\xc9\x49\x9d\xa5\x69\x31\x89\xcf\x6a\xfe\xc4\x5a\x50\xa5\x41\x5e\xad\x28\x0b\xe9\x94\x85\x4c\x6f\x5c\x48\xea\xc6\x13\xdc\xa3\xba\xb1\xa2\x92\xd1\x69\x08\xaa\x61\x0b\xe9\xcd\x41\x69\x49\xb5\x90\xc3\xb8\xf4\x1c\x62\xb7\x12\x0f\x2d\x6a\xba\x68\xd9\x0e\x29\x98\x62\xa5\xba\x51\x10\xb0\xb5\xdd\xc4\x92\x23\xc5\xe8\xd7\x22\x8a\x6b\x75\xbb\x6d\xdf\xc5\x0f\xb2\xd3\x1d\x0b\x76\x3b\x53\xfb\x96\xfb\x4b\xc1\xb8\xbe\x19\xba\x98\xc4\x48\x42\xc0\xd6\x71\xc6\x5a\x84\xe2\x17\xc8\x46\x71\x33\x9e\xb1\x49\x32\x29\xba\x1e\x4c\xbf\x50\xef\x09\xb8\x8f\x57\xc0\x30\xbb\x7f\x8e\x24\x62\x29\x44\x78\xcc\xea\x07\xd3\xfe\x4d\x1c\x47\x42\x52\x7d\x7d\xbf\x61\xdf\x31\x4f\x0a\x25\x02\xdd\x1e\x82\xfe\x25\xe4\xd3\x69\x28\xa8\xff\x85\x86\x94\x7b\x20\x95\xed\x14\xbc\x67\x6e\x92\x4c\x0e\xf9\x1f\x5d\x0b\x1e\xb0\x59\x1a\xa7\xba\x9e\x3c\x11\xbb\xe9\xd8\xa7\x81\x14\x5c\x03\xf7\x33\xbb\x48\x52\xcd\x04\x57\xa7\xe6\xaa\xca\xee\x5f\x8c\xff\x56\x46\xc3\xe9\x5f\x98\xd1\x2d\xf7\x8f\xe3\xf5\xed\xf1\x8e\x89\x33\x74\xbf\xbe\x6a\x03\x79\xf2\xdf\x05\x2f\x92\x4c\x6f\xbe\x4a\x11\x2d\x0f\x6d\xe4\xd0\xfd\x5a\xc3\x64\x2a\x79\xcb\x92\xb8\x9a\x1d\xb3\xa6\x51\x34\x0d\x99\xd7\x1f\x5d\xf9\xbe\x04\xa5\xde\x1a\x95\x2d\x5b\x87\x8e\x4c\x4d\x35\xa2\xe1\xab\x72\xcc\xfb\xb1\xab\x85\xa4\x33\x48\xfb\xf1\xc7\x56\xe7\xa2\xd5\xfd\x98\xd5\xb4\x4a\x84\x57\x9e\x87\xd7\xc8\x17\xaa\x60\xbf\x8e\x88\xb3\x9f\x11\xb8\x5a\x32\x3e\x6b\xd4\xd5\x43\x5d\x9a\x05\xc5\x50\x78\x71\x69\x98\x4f\xab\x9c\x54\x19\x76\x8d\xe4\x6e\xd7\x73\x36\x65\xa9\x7a\x0d\xd3\x87\x97\x13\xd3\x06\xeb\xf9\xb4\x63\x17\x62\x98\xba\x3f\x36\xcb\xd8\xa9\xab\x29\xf7\xa9\xf4\xff\x19\xdc\xbb\x96\x93\xce\x2c\x38\xa6\x18\xda\x37\x4c\x3d\xa9\xbc\x3d\xa4\x43\x91\xa9\x63\x5d\x92\x5e\x27\x9f\x9b\x4c\x21\xaa\x5f\xcd\xb2\xf1\xcb\x67\x2b\x93\xda\xc2\x94\x65\xd2\x56\x13\xab\x78\x4d\xfb\x54\x53\x53\x9a\xec\x8a\x0b\xe0\x5b\x97\xe4\xf3\xa7\xc3\x04\x24\x4a\x38\x55\x90\x47\x62\xe1\xc0\x66\x5d\x20\x78\x08\x0c\x41\x20\x44\x08\x5d\x84\x4f\x08\x3e\xc2\x7f\x10\x96\x08\x2b\x84\x1e\xc2\xbf\x10\x00\xe1\x09\xe1\x27\xc2\x2f\x84\x33\x84\xcf\x08\x01\x42\x88\x20\x11\xd6\x08\xe7\x08\x14\x61\x86\xb0\x40\x50\x08\x1b\x84\x8f\x08\x53\x84\x39\x02\x47\xd0\x08\xbf\xad\xb8\x6b\x3f\xb3\xac\xac\x7f\x5a\x8f\x21\xf0\x99\x9e\xd7\x9f\x9a\xcc\xa2\x58\x4e\xdb\xed\x57\xd0\x2e\xfb\x0d\x77\x74\xb9\xdb\xe1\xb1\x80\x50\x41\x76\x00\xea\x89\x9c\x38\xfb\x49\x62\x7f\x92\xee\x28\xa7\x33\xf0\x8d\x23\x54\xa9\xd6\xa2\x52\x3e\x49\x9f\xb7\xce\x3a\xad\xa5\x84\x15\x83\x5f\x56\xd9\xb5\x39\x16\xf4\x4b\x27\xd6\x9c\x65\x4c\xd9\xdb\x0a\x6a\xa1\xb4\x4c\x0a\xea\x85\x44\xae\x23\xa5\xc5\x62\x3c\xbc\xfd\x61\x26\x31\xe6\xa0\xdd\x68\xca\x41\xa7\xbd\xa1\x3a\x93\x14\x55\xf6\xa1\x0a\xcc\xa7\xab\x89\x35\x6a\x5c\x24\x42\xbb\x3c\xc6\x24\x8f\xdf\x78\x69\x57\x7c\xae\xf2\x45\x3c\xdf\xe0\x56\x4c\xea\x88\x86\xe9\x4f\xb3\xb5\x99\xb2\xbc\xbf\x3d\xcb\x59\x35\xf1\x55\xca\x96\x63\x9f\xaa\x58\x13\x67\x92\xca\xc5\x9d\xaf\xbf\x18\xa4\x9a\xc2\x51\xec\x60\xe8\x17\xdb\xd3\x81\xd1\xfa\x2f\x26\x95\xc6\x3e\xfa\xdd\xd3\xf8\xc2\x87\x31\xd5\x32\x64\xda\x18\x53\x03\xd4\xba\x16\x5c\x81\x17\x69\xb6\x02\x57\x53\x8d\x0d\x18\x97\xda\xae\x6c\xb0\xe9\xf3\x3c\xf6\x59\x61\xa1\x14\xd8\x6e\x3e\x9e\x4d\xea\xfc\x14\x86\xe8\xba\x6e\x58\x75\xd7\x99\x60\x6e\x55\xfa\xab\x9a\xdd\x57\x6b\xf6\x26\x87\xd6\x3b\xbe\x2b\x4d\xfa\x47\xce\x21\xf5\xdb\x16\xcf\x1c\x95\xe6\x3c\xbe\x1b\x32\x0f\xef\xb8\xf4\xad\xa9\x9e\x95\x62\x66\xb1\x37\xce\xbc\x56\xb1\x15\x1f\x6d\xdc\x7d\x8f\x71\xef\x3d\xc6\x67\xef\x31\x3e\x7f\x8f\xf1\xc7\xf7\x18\x5f\xec\x5f\x9e\x27\xe5\x5d\xc4\xae\x56\x77\xfb\xc6\xc2\xe2\xd6\xe7\xc7\xa2\x62\x52\x3c\x31\xb9\x81\xa0\x91\x9e\xdf\x72\xcc\x10\x87\x0f\x2b\xa0\xa1\x82\x5c\x5a\x3a\x90\xa8\xe1\x7b\x42\xe5\x0a\xaa\xbf\xa0\x33\xf8\x1e\x04\x20\x51\xf8\x30\x8d\xb8\x8e\x5c\x90\x2b\x90\x65\xa5\x78\x1e\x57\xf3\x44\xf1\x9a\x72\xc1\x99\x47\xc3\xb2\x96\xfb\xed\x01\xe5\xdd\x8b\x76\xe7\xbc\x35\xf8\xe1\x96\xe5\x69\x1b\xde\xeb\xb4\x7b\x9d\xee\xa7\xce\x45\xf7\x73\x77\x3f\x32\xab\xf9\x37\xd8\x8c\xa8\x9e\x17\xcb\xcc\x3e\x9d\x8b\x05\x94\xde\xfe\xa8\xf9\x11\xce\x21\xf6\x69\x5b\xa9\xf9\x29\x92\x22\x24\xfb\x0d\xfe\x3f\x4f\xb0\x51\xc6\x54\xaa\xe6\xf7\xee\x55\xf2\x6e\xf1\x0d\x36\x15\x9a\x4b\xf2\xc2\x35\x90\xcd\xd9\xaa\x50\x94\xf1\x49\xc9\x3a\x52\xfc\x36\xd5\x68\xb6\x33\xc5\xcc\x32\x55\x33\x3e\xcf\xec\x67\xf6\xfd\xb1\x31\x83\x94\xaf\xd3\x7d\x70\xbb\xf9\xb8\x10\x7e\x83\xfa\x7e\xa3\xe7\xa4\xa3\xd6\x61\xcf\xcd\xa6\x83\x5a\xdd\x97\xb4\x9a\x93\x49\x36\x88\xf7\xd5\xcd\xf5\x77\xb7\xfb\xb9\x53\xea\xff\x6a\xde\xe7\x53\x11\x71\x7f\x48\xf5\x7d\x14\x42\xdf\x7f\xc5\x65\xbd\x7f\x95\x67\x86\xad\x3a\x75\xdd\xbf\x5b\x07\x2e\x62\xb3\xb0\x2a\xf3\x84\x9a\x8f\x84\xd4\xbd\xde\xff\x38\x93\xc4\xe9\xf1\xf9\x0c\xa6\x66\x22\xa5\x53\x51\xfa\x98\x9a\x7e\x06\x3b\xfc\x29\x8f\xf9\xcf\x66\x5f\xcb\x3e\x2e\xa9\xf3\xda\xcf\x9b\x6f\x8e\xfd\x1c\xeb\xcf\x64\x60\x3c\x99\x38\xff\x7f\x66\xba\x7f\x68\x5e\xbd\x3f\x34\xaf\xb3\x3f\x34\xaf\xf3\xd7\xe4\x75\x52\xc8\xd0\x98\x7f\xb1\xdb\x7d\x11\x42\x63\x13\x5c\x3e\xdc\x0f\x2a\xf7\x7d\x59\x21\x8e\x76\xf2\xdf\x00\x00\x00\xff\xff\x7b\xd7\x1a\x58\x06\x1a\x00\x00")
+var _dcosmastervarsT = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x58\xeb\x6f\xda\xca\x12\xff\x9e\xbf\x62\x65\x55\x32\x48\xe6\x99\x34\xbd\x45\xba\x1f\xd2\x90\xd3\xa2\x12\x8a\xe2\x26\x5f\x22\x74\xb4\xd8\x03\xec\x8d\xd9\xe5\xec\xae\x29\x14\xf1\xbf\x5f\x8d\xb1\xcd\xda\xe6\x9d\xfb\x38\x6d\xf4\x43\xf6\xce\x7b\x67\x76\xc6\x4b\x08\x21\x16\xf5\xa7\x8c\x3f\x2b\x90\x9c\x4e\xc1\x6a\x11\xeb\x75\x46\x25\x9d\x82\x06\xa9\x4a\x76\xc0\x78\xb8\xb8\x33\x49\xec\xf2\xc0\x72\xae\x22\x56\x4d\xe5\x18\xf4\x03\x9f\x33\x29\xf8\x14\xb8\x2e\xb0\x17\x28\x0c\xee\x29\x5d\xbc\x3c\xaa\x3e\xc8\xbe\x10\x81\xd5\x22\x8d\x7a\x3d\x5e\xa1\x33\xf6\x02\x52\x31\xc1\xdb\x30\xa2\x61\x10\xc9\x6d\xd6\x1b\xb7\x95\xfa\x75\xe5\xba\x6e\x39\xe4\x6a\xb5\x62\x23\x52\xed\xa2\x75\x7d\x29\x46\x2c\x80\xea\x37\xaa\x5c\xf0\x24\x68\xb5\x5e\x6f\xe4\x04\xc6\x72\xbc\x64\x91\x56\xb4\x46\xc8\x6b\xfc\x8b\x7f\xab\x95\xa4\x7c\x0c\x84\x7c\x98\x77\xb8\x0f\x0b\x87\x7c\x98\xa3\x5e\xd2\xfa\x67\x4e\x49\x56\x43\xf2\x2f\xb2\x26\xe6\x5d\xaf\x89\x43\x56\x2b\xe0\x7e\x8e\x88\x90\x55\xee\x99\x10\x4b\x89\x50\x7a\xf0\x82\xca\xac\x56\x71\x9d\x10\x8b\xf9\x56\x6b\xc7\x9e\x7c\x87\x65\xc4\xd5\x69\xaf\x56\xa9\x66\x8c\x6e\x41\xc6\xda\x29\xbc\xb2\x22\xef\xee\x41\x6a\x36\x62\x1e\xd5\xa0\xac\x96\x19\x8f\xc4\xab\x4d\x54\x3e\x78\x49\x50\x3c\x90\x51\x4c\x36\xd1\xa9\xbe\xe4\xa5\x14\x3c\xde\x06\xc7\x3b\x16\x9c\xdd\x01\xc2\xff\x96\xb7\x55\xf1\x2c\x03\x8b\x9c\x1c\x0f\xc3\xb6\xe7\xa7\xee\x6a\xf5\xc1\x3b\x14\x28\x42\x8a\x36\xed\xb3\x75\x70\xb5\x8f\x33\xcb\x31\x88\x72\x75\xfb\xc6\x9a\x52\xa5\x41\xde\xcd\x29\x0b\xe8\x90\x05\x4c\x2f\x5d\x88\xf2\xfb\xd5\x13\xdc\xa3\xba\x34\xa7\x92\xd1\x61\x00\xaa\x64\x0b\xe9\x4d\x40\x69\x49\xb5\x90\xbd\xa8\xf4\x1c\x62\x57\x36\x12\x2a\x34\x2b\xa2\x62\x3b\xc4\x60\xc5\x4a\x75\xc3\xd1\x88\x2d\xec\x32\x96\x1c\x31\xb5\xdf\x8b\x30\xaa\xd5\xd5\xaa\xfa\x18\x09\x4b\xb2\x3b\x5a\x58\xaf\xb3\xd4\x0f\xdc\x9f\x09\xc6\x75\xbb\xe7\xa2\x11\x7d\x09\x23\xb6\x88\x2c\xd6\x22\x10\xbf\x40\x96\xcc\xcd\x38\xc0\xb3\xb1\xc4\x14\xdd\x1d\x7e\xa1\xde\x1b\x70\x1f\x8f\x80\x5e\x72\xfe\x9c\x19\x88\x99\x10\xc1\x39\xde\x77\x87\x9d\x76\xa4\x47\xc2\xa6\xfa\x3a\x7e\xc9\x7e\x64\x9e\x14\x4a\x8c\x74\xb5\x07\xfa\x97\x90\x6f\xb5\x40\x50\xff\x0b\x0d\x28\xf7\x40\x2a\xdb\x31\xa4\x27\x62\x36\x96\xec\x92\xdf\xbf\x17\x7c\xc4\xc6\x9d\xf6\x1e\x7f\x52\xc2\xb6\x5d\x76\xec\xda\x48\x0a\xae\x81\xfb\x09\x5f\x28\xa9\x66\x82\xab\x5a\xd6\xab\xbc\xf8\xa3\xfa\x2f\x8d\x68\x30\xfc\x03\x2d\x7a\xe0\xfe\x79\x71\xbd\x5c\xdf\x39\x7a\x7a\xee\xd7\x93\x36\x90\x6f\x7e\x5d\xf0\x42\xc9\xf4\xf2\xab\x14\xe1\x6c\xd7\x46\xf6\xdc\xaf\x7b\x22\x19\xaf\x5c\xe2\x12\x57\xe3\x73\x7c\xea\x87\xc3\x80\x79\x9d\xfe\x9d\xef\x4b\x50\xea\x52\xad\x6c\x96\x53\x7a\xb0\x1a\xb1\x86\x4e\xb2\x71\xdb\x8f\x5d\x2d\x24\x1d\x43\xdc\x8f\x3f\x56\xea\xb7\x95\xc6\x47\xcb\x89\xdb\xf1\x37\xaa\x9e\x39\xfb\x2b\x84\x98\x0c\xdd\x48\x9b\xb1\xda\xbc\xbb\xf3\x3c\x3c\x64\xbe\x50\x05\xa9\x97\x61\xcc\x24\x19\x1f\x97\x62\x97\x55\x38\x54\x9e\x64\x33\x2c\x84\x52\xb9\x6a\x3e\x76\x7c\x27\xd9\xf9\x68\x53\x4b\xe5\x2a\x1a\xee\x9c\xec\xb7\x41\x18\x08\x2f\xaa\xb5\xec\xdb\x62\x90\xa3\x2d\xc3\x93\x3c\x50\x70\xa1\x47\xff\x75\xf3\x76\x76\x1a\x37\x63\xe4\xc3\x62\xc2\x86\x2c\x66\x43\x4b\x57\xab\xaf\xa0\xb3\x34\x28\x91\x58\xb0\x98\x0c\x2d\x52\x5f\xaf\xd3\x2c\xc8\xba\xfb\x73\x39\x8b\xd2\xc0\xd5\x94\xfb\x54\xfa\x7f\x76\x9f\x5c\x33\x11\xb2\x32\xdb\x4c\xbd\xa5\x99\x90\x0e\x7e\x59\x1a\xab\x45\x9a\xc9\x04\x38\xa5\x8b\xec\x22\xce\x89\x77\xe3\x64\xc4\xf4\xd9\xdc\xac\x89\xcc\x24\x99\x0d\xd5\x1e\x5d\x66\x2b\xf2\xa9\xa6\xd9\xd5\xcd\x4e\xb8\x00\xbe\xd5\x22\x9f\x3f\xed\x0e\xc0\x86\x08\x27\x27\xf2\x4a\x2c\x1c\x4a\xad\x5b\x04\x0f\x81\x21\x08\x84\x10\xa1\x81\xf0\x09\xc1\x47\xf8\x17\xc2\x0c\x61\x8e\xd0\x44\xf8\x07\x02\x20\xbc\x21\xfc\x85\xf0\x0b\xe1\x1a\xe1\x33\xc2\x08\x21\x40\x90\x08\x0b\x84\x1b\x04\x8a\x30\x46\x98\x22\x28\x84\x25\xc2\x47\x84\x21\xc2\x04\x81\x23\x68\x84\xdf\x16\x19\x1c\x76\x2b\x99\x11\xac\xd7\x00\xf8\x58\x4f\xcc\x78\xef\xe6\x30\x8f\x8c\x4d\x5a\xb1\xdf\xf0\x48\x67\xeb\xf5\xe1\xc2\x49\xf8\x31\x90\x03\x27\xcd\xe1\x34\x93\x1e\x29\xa7\x63\xf0\x33\x29\x54\x38\x91\x4c\xa2\xed\xd7\xc2\x4d\xe5\xba\x5e\x99\x49\x98\x33\xf8\x65\xe5\x45\x67\x47\x9f\x4e\x2e\x63\x0f\x55\xd1\xd1\xe2\x99\x2a\x2d\xe3\xe2\x39\xa2\xf4\x3e\x54\x5a\x4c\x5f\x7a\x0f\x3f\xb3\x0a\x5f\x38\x68\x37\x1c\x72\xd0\x71\xaf\x2b\xce\x58\x26\x89\xbd\xe3\x78\x8a\x2d\x8f\x84\xec\x11\xb1\x61\xcf\x7c\x93\x6d\x5f\x5f\xd8\x84\x0a\x32\xe7\x5b\x27\x0e\x37\xec\x39\x93\x3a\xa4\x41\xfc\x98\x6d\xd5\xd9\xb5\xf8\xd0\xcb\x99\x5d\x88\x59\xd1\xf0\x79\x1c\x2d\xc7\xae\xa9\xc8\x4e\x55\xcb\xa8\xc9\xfb\x6f\x2a\x29\x9a\x70\x56\x74\x50\xf5\xd1\x76\x9b\x24\x8b\xe1\xd5\x1f\x4c\x2a\x8d\x73\xc1\x0f\x4f\xe3\x07\x2c\xea\x54\xb3\x80\xe9\xcc\xd8\x3d\x42\xaa\x7b\xc1\x15\x78\xa1\x66\x73\x70\x35\xd5\x38\x50\xa0\xab\xd5\xc2\x06\x67\x65\xde\x44\x7e\x18\x76\xed\x54\x6c\x97\x5f\xaf\x07\xfb\xe4\x18\x1f\x05\xc5\x78\xec\x13\x57\x1f\xa0\x6d\xce\x09\x94\x8d\x93\x29\x9b\x83\x5d\xfe\xbe\x3c\x6e\x1b\xec\x25\x73\xd5\xfe\x6d\x8b\x66\x28\x63\x54\x4a\xf5\x31\x0f\xcf\xb3\xf8\x2b\x70\x7f\x54\x4c\xcb\x22\x69\x9c\x79\x95\xba\x21\xf1\x6c\xe6\xc6\x7b\x98\x9b\xef\x61\xbe\x7e\x0f\xf3\xcd\x7b\x98\x3f\xbe\x87\xf9\x36\xbd\x0c\x48\x5b\x62\x42\xed\xb2\xdf\xc5\x2b\x31\x73\xd1\xd0\x6b\x6d\xd3\xa2\xc0\x62\x66\xcc\x96\x41\xd0\x50\x4f\x1e\x38\x5a\x88\x83\x86\x35\xa2\x81\x82\xed\x6a\x2e\x21\x91\xc2\xf7\x84\xda\x12\xa8\xce\x94\x8e\xe1\xc7\x68\x04\x12\x17\x9f\x87\x21\xd7\xa1\x0b\x72\x0e\x32\x4f\x14\x7d\x5f\xa8\xc9\x86\xf0\x9e\x72\xc1\x99\x47\x83\x3c\x95\xfb\xfd\x19\xd7\x1b\xb7\xd5\xfa\x4d\xa5\xfb\xd3\xcd\xaf\xc7\x2d\x37\xa5\xa9\x36\xeb\x8d\x4f\xf5\xdb\xc6\xe7\x46\x52\x6e\x4a\x4d\xbe\xc3\xb2\x4f\xf5\xc4\x2c\x33\xbb\x36\x11\x53\xc8\x7d\xcd\x66\xee\x1d\xa3\xec\xad\x55\x95\x9a\xd4\x30\x28\x42\xb2\xdf\xe0\xff\xf9\x06\x4b\x65\x06\x4c\xa9\xc9\x93\x7b\x17\xf9\xe2\x7d\x87\x65\x21\xcc\xb9\x75\xe3\x18\x48\xe6\x68\x65\x14\x25\x21\x46\x47\x4a\x3e\x24\x12\xc2\x84\x33\x26\x33\xb5\x24\x24\x46\xda\x64\x95\xe4\x8f\xd3\xe4\x3d\x9e\x64\x53\xe1\x97\xa8\xef\x97\x9a\x4e\x3c\x56\xed\x96\x5c\x2e\x3b\x48\xd5\x38\x46\x55\x8e\x4e\xe4\x68\x54\xea\xa8\xf6\xfd\x0f\xb7\xf1\xb9\x9e\xeb\xff\x6a\xd2\xe1\x43\x11\x72\xbf\x47\xf5\x53\x18\x40\xc7\x3f\xe1\xb0\x4e\xaf\x26\x58\x86\x57\xd5\x5c\xf7\x5b\xc5\x76\x8e\x14\x56\xfe\xf8\x75\xd5\xa4\x2f\xa4\x6e\x36\xff\xc3\x96\x6c\x84\x9e\x6f\x4f\x77\x98\x35\x24\x97\x15\xb9\xcb\xe1\xf8\x5a\xaf\xf0\x26\xbd\x9d\x3d\x60\xfd\xde\xe8\x63\x70\xeb\x69\x06\x1d\xb9\xae\xbd\x58\xf7\xa1\xa8\x1f\xb0\x20\xf3\x66\xe0\x64\x1e\xff\x27\x91\x69\xec\x8e\xcc\xff\xdd\xae\xe6\xdf\xd4\xae\xeb\xbf\xa9\x5d\x37\xa7\xd8\x95\x3e\x19\x1f\x7f\xf8\x1c\x75\xbb\x2f\x42\x68\x6c\x82\xb3\xe7\xa7\x6e\xe1\xbc\xcf\x13\x18\x07\x3e\x2e\x3d\xc1\x4c\x28\xbc\xec\x58\xee\x63\xce\x50\xd8\xe5\x81\x75\x75\xf5\xef\x01\x00\x98\x27\x0b\x9c\x14\x1b\x00\x00")
 
 func dcosmastervarsTBytes() ([]byte, error) {
 	return bindataRead(
@@ -487,7 +488,7 @@ func dcosmastervarsT() (*asset, error) {
 	return a, nil
 }
 
-var _dcosparamsT = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x4c\x8f\xc1\x6e\x83\x30\x0c\x86\xef\x7b\x0a\x2b\x67\x94\xb4\x05\x0a\xe2\xb8\xf3\x4e\xd3\xb6\xbb\x93\x18\x88\x46\x49\x14\x1b\x69\xeb\xd4\x77\x9f\x4a\x4b\xd5\xab\xed\xff\xf3\xf7\x03\x00\x28\xef\x22\xbf\xc6\x28\x2c\x19\xd3\xe7\xfb\x9b\xea\xe0\xef\x05\xe0\xb6\xa3\x1e\x97\x49\xbe\x70\x5a\x48\x75\xa0\x46\x91\xc4\x9d\x31\xd7\x4c\x88\x1a\xcf\x4b\x26\xf2\x03\xe9\x99\x64\x1d\x1a\x16\xb4\x13\x19\xbb\x01\x4d\xdd\xf6\x7e\xd7\x96\xa5\xa3\x76\x6f\x8f\x87\xaa\xea\x5d\x53\xda\xfe\x58\xdb\xda\x93\xad\xca\xc3\xbe\xb1\x3b\xeb\x1b\xfd\x88\x68\xc1\xac\x7f\xce\xaa\xd8\x2c\x4e\x24\xe8\x51\xf0\xc9\x6c\x75\x63\x97\x43\x92\x10\xe7\xab\xda\xc7\x48\x70\xd7\x85\x13\x71\xe4\x34\x52\x26\x78\x50\x21\xa1\xfb\xc6\x81\xb4\xba\x23\x2e\x05\x6c\x0f\xe4\x37\xad\xf5\x58\x72\x98\x87\xdb\xc1\xa5\xf8\x0f\x00\x00\xff\xff\x6c\xef\x0b\x11\x1e\x01\x00\x00")
+var _dcosparamsT = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x90\x31\x6f\xf3\x20\x10\x86\xf7\xef\x57\x9c\x98\x23\x48\x62\x3b\xb6\x3c\x7e\x73\xa7\xa8\xed\x7e\xc0\xd9\x46\x75\x0c\x82\xb3\xd4\xa4\xca\x7f\xaf\x42\x1c\x2b\x63\x55\x95\x09\x21\xee\xbd\xe7\x79\x01\x00\x84\x35\x3e\xfd\xf7\x9e\x13\x47\x0c\x6f\xc7\x17\xd1\xc2\xd7\x3f\xc8\x47\x58\xea\x70\x1e\xf9\x1d\xc7\x99\x44\x0b\x62\x60\x0e\xa9\x55\xea\x36\xe3\xbc\xc4\xcb\x1c\x89\x6c\x4f\x72\x22\xce\x8f\x2a\x31\xea\x91\x94\x7e\x04\xaa\xaa\xe9\xec\xb6\x29\x0a\x43\xcd\x4e\x1f\xf6\x65\xd9\x99\xba\xd0\xdd\xa1\xd2\x95\x25\x5d\x16\xfb\x5d\xad\xb7\xda\xd6\x72\x1d\x91\x8c\x51\x7e\x5e\xc4\xe6\x41\x71\x22\x46\x8b\x8c\x4f\x64\x99\x2d\x99\xe8\x02\x3b\x3f\xdd\xd0\x5e\x07\x82\x05\x17\x4e\x94\x7c\x0a\x03\x45\x82\x35\x15\x02\x9a\x0f\xec\x49\x8a\x25\xe2\xba\x81\xe5\x26\xf8\x1c\xb2\x5e\xe2\xe8\xa6\xfe\xfe\xe1\x7a\x5f\x9f\xeb\x39\x52\xf0\xc9\xb1\x8f\xe7\x3f\xea\xe7\x17\x6e\x4f\x4e\x8b\x09\xc4\x15\xeb\xe7\x52\xdf\x03\x00\x4d\x0b\x7f\x72\xf3\x01\x00\x00")
 
 func dcosparamsTBytes() ([]byte, error) {
 	return bindataRead(
@@ -547,7 +548,7 @@ func kubeconfigJson() (*asset, error) {
 	return a, nil
 }
 
-var _kubernetesagentcustomdataYml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x57\x5f\x6f\xdb\x38\x12\x7f\xf7\xa7\x98\x6a\x0f\xfb\x72\x47\x2b\xbd\x4d\x72\x80\x16\xbe\x83\xe3\xa8\xa9\x91\x34\x31\x6c\xa7\x05\x6e\xbb\x10\x68\x6a\x6c\xf3\x2c\x91\x5a\x72\x94\xda\x4d\xf2\xdd\x0f\xa4\xe4\xff\x76\x9b\x76\x17\x7d\xb1\x41\x0e\x67\x7e\xbf\x19\x0e\x67\x46\x3f\x89\x4c\x97\x29\x13\x5a\x8d\xe5\xa4\xd1\xf8\x64\x24\x61\x32\x96\x19\xda\xa8\xc1\xa0\xe0\x34\x8d\x20\x08\x91\x44\x68\x17\x96\x30\x4f\xeb\xff\x30\xd5\x62\x86\xa6\x69\xd1\x3c\x48\x81\xcd\x34\x14\x19\x72\x93\xe4\xba\x54\x94\x14\x46\x17\x7c\xc2\x49\x6a\x95\x8c\x33\x3e\xb1\x4d\x07\x10\x34\x00\x0a\x34\xb9\xb4\x56\x6a\x65\x23\x08\x4e\xce\x4f\x4f\xdd\xae\xfe\xa4\xd0\x44\x10\x18\xad\xc9\xad\x85\x56\x84\x8a\x22\x78\x6a\x00\x00\xfc\x36\xa8\x50\x7e\xf7\xab\x77\x0e\xe2\x8d\xb3\xda\xb2\x53\x6e\x30\x6d\x7c\x23\x53\x9c\xa3\x48\x2c\x71\x43\x7f\x25\xad\x78\x8e\x62\xe0\x8c\xb6\x76\x96\x61\x69\x4d\x38\x92\xaa\x26\x02\x29\xc7\x5c\x2b\x60\x6f\x61\x9c\x46\x61\x08\x8c\x59\xd2\x86\x4f\x90\xa5\x46\x3e\xa0\x69\xe9\x07\x34\x19\x5f\x00\x63\x23\x59\xb4\x1e\x1f\x3f\x18\x5e\xb4\xed\x7b\x6e\x24\x1f\x65\x08\x41\x65\xe7\xc2\xc8\x74\x82\x1d\x99\x9a\xe0\xf9\x79\x37\x04\xd5\x91\xb0\x82\x6a\xfe\xcf\x6a\xf5\xdd\x5e\x3e\xfa\x5f\x80\x20\x93\x0f\xc8\x0c\x3a\xb2\x18\x44\x40\xa6\xc4\x7f\xac\x64\x7a\x52\xb3\x0f\x22\x08\x1c\x1e\x73\x49\x14\x6c\x1d\xd0\x05\xd9\x20\x5a\x5b\x74\xfb\x39\x9f\x33\x2b\x3f\x3b\x83\xc1\xd9\x49\xbe\x52\x58\xca\xbc\x15\x27\x0b\x6a\xc1\x73\xa3\xfa\xdd\x71\x78\x56\x8e\xd0\x28\x24\xb4\xa1\x40\x43\x36\x14\xbc\x29\x0c\x1d\xf7\x1a\x95\xd0\xa9\x54\x93\x08\x82\x11\xb7\x78\xfe\xa2\x50\xec\x5d\x85\xe0\x1d\x34\x24\xc7\x52\x70\xc2\x03\xf7\xb0\x47\x8b\x17\xd2\x25\x22\x9a\x1f\xc1\x6e\x05\xf6\x8d\x24\x45\x26\x51\xd1\x0f\x89\x9f\x47\x3a\x4e\xef\x81\x9b\x30\x93\x23\x4f\x31\x43\xf2\xff\x55\xad\xfa\xee\x7c\xe6\x85\x7c\x8f\xc6\x29\x45\xf0\xf0\xda\x6f\xcd\xa4\x4a\x23\xe8\x54\x35\xd0\x6d\x88\xac\xb4\x84\xc6\x46\x7e\xc5\x40\xf1\x1c\x23\xc8\xb4\xe0\x59\x2d\xaa\xb3\xb1\x5e\x45\xab\xac\x15\x6b\x57\x18\x2f\x69\xaa\x8d\xa4\x45\x04\x5f\xca\xd1\x95\x6e\x75\x57\x11\x4c\x89\x0a\x1b\x85\xe1\x7e\xb8\xd6\x16\xda\xbd\xee\xc0\x1f\xef\xf6\x82\xe7\xe7\xe8\xf4\xf4\x17\x6f\xa6\xb4\x7b\xac\xab\x10\xd7\x20\x4e\xbe\x41\xd6\x8b\xd8\x06\xe7\xa3\x4c\x57\x19\xb1\xab\x3c\xc3\xe3\xee\x55\x4a\x33\x5c\x54\x41\x75\xf7\x30\xa7\x15\xbd\x7a\xbd\x49\xa7\x0a\xe6\xa1\x40\xd7\xd4\xb7\xbd\xd9\xbf\x96\xda\x66\x85\x57\x1a\xe3\xdd\xab\x71\x0e\x1e\xfc\x72\xeb\xf0\xe9\x46\x19\xc3\x39\x19\x2e\x68\xd9\x43\xbe\xbf\x63\xdc\x2b\x49\x55\xbb\xb8\x44\x2b\x8c\x2c\x5c\x8b\x6c\x5d\x57\x30\x50\xc3\x48\xad\xfc\x91\x3e\xfe\x51\x4a\x83\xb6\xb5\xdd\xc1\xbc\xac\x3d\x26\x34\x87\x04\x1d\xad\x52\xe9\x4c\xf4\x38\x4d\xe3\xb9\xb4\x64\x5b\xaf\x7c\x0b\xf2\xee\xfb\x46\x54\xbb\xd5\x38\xd0\xc5\x86\x32\x47\x5d\x92\xef\x5c\x03\x14\xad\x93\x9a\x89\x6f\x97\x2d\x57\xd6\xb9\xcc\x4a\x83\x9b\xdb\xee\xdc\x99\xdd\xee\x7a\x3d\x83\x2d\x8f\x95\xcf\x52\x69\x80\x15\x10\x52\x5e\x2c\x91\x53\x69\x0e\x1c\xdf\xe9\x93\x45\x99\x65\x07\x4a\xc6\x3a\xcd\xde\x2e\x0a\x34\x6e\x39\x28\x50\xb8\xc2\xf1\x55\x93\xa6\x54\xc0\x98\xc9\x81\x3d\xec\xf2\x89\x42\x5d\xd0\xc6\xfa\x9b\x90\xc1\x83\x8c\xb8\x9d\x02\x13\x10\x88\x02\xc2\xe9\xf2\x08\xec\x18\x0e\x83\x63\x91\xda\xe3\xb4\x69\xe4\xf0\x0d\xee\x4c\x1a\x4e\x24\xa6\xb9\x4e\x81\xff\x7d\x7e\x4c\xa7\xba\xf5\xae\xb2\xc4\xb3\xac\xba\xf5\x0f\x5c\x11\xa6\x17\x8b\x56\x5e\x66\x24\x99\x7b\x6a\x4d\xe2\x66\x82\x7b\x0f\x24\xc5\x31\x2f\x33\x5a\x16\xe4\xef\x7e\x09\xd7\xf7\x17\xf1\x4d\x3c\x4c\x3a\x37\xf7\x83\x61\xdc\x4f\x2e\x6f\x07\x07\x26\x1d\x87\x72\xa9\x6c\x9d\xa1\xbe\xd4\x6d\x69\xb7\x7b\xdd\x64\x10\xf7\xdf\xc7\xfd\x41\xeb\x4f\x54\xcd\xa5\xb9\xee\xbb\xf6\x55\x7c\x84\xc6\x17\x52\x6e\xa9\x7e\x1b\x0f\x3f\xdc\xf5\xaf\x93\xde\xcd\xfd\x55\xf7\xb6\xe5\x8e\x29\xac\x6a\xd1\xe5\x5d\xe7\x3a\xee\x27\x77\xbd\xe1\xa0\x1a\x0f\x3b\xf7\x83\xe1\xdd\xbb\xa4\xf3\xee\xb2\xba\x35\x37\x4d\x6d\x19\xeb\xc7\x57\x5d\x1f\x99\x41\xe7\x6d\x7c\x79\x7f\xd3\xbe\xb8\x89\x5b\x7b\xa7\x6e\xef\x2e\xe3\xe4\xa6\x7d\x11\xdf\xb8\xf0\xc1\x15\xd2\xf5\x8a\xeb\x0d\x1f\x61\x66\xa1\x09\x3b\x34\x7b\x77\x97\x49\xf7\xf6\x4d\xbf\x9d\x74\xee\x6e\x87\xed\xee\x6d\xdc\x7f\x81\xe7\x3d\x9d\x76\xd5\xd8\xf0\x8e\x56\xc4\xa5\x42\xb3\x8c\xc0\xe3\xa3\x1c\x43\xd7\xae\x71\xeb\xee\x7a\x85\x10\xbc\x6e\x9e\x37\x4f\x96\x61\x5a\x11\x78\x13\xb7\x87\xf7\xfd\x38\xb9\x6a\x0f\xe3\x41\x8b\xb1\x31\x72\x2a\x0d\xb2\x09\x27\xb4\xad\xb6\x10\x98\xa1\xe1\xa4\x8d\xad\x1c\x7e\x7c\x44\x95\xee\x8f\x2d\x07\x6a\x75\x86\x2f\xa8\xd1\xeb\xc9\x65\xf2\x59\x16\x5f\x4a\xd5\x57\xaf\x46\x52\x71\xb3\xd8\xc9\x59\x97\x71\xdd\x4e\x9c\x5c\x9c\x9f\x26\x57\xff\xed\xf6\x92\xc1\xb0\xbf\x49\xce\xbd\x77\xfe\xb9\x34\x18\x8a\x65\xb0\xec\x9a\xde\xf4\x00\xb3\x7f\x9d\x9d\xbd\xe0\xcd\xfc\xf4\x6a\x55\x66\xfc\x1a\xe7\x92\xe0\xe4\xab\xc8\x85\xd1\x0f\xd2\x41\x1d\xc1\xfe\x93\x51\xd9\x4f\x9a\x15\xe0\xc0\x77\x38\x3f\xd2\x99\x52\x89\x3c\x75\xdf\x94\xbc\x20\x36\x41\x82\xb2\x48\x39\xe1\xc6\x86\xac\x2a\x12\xb0\x85\xdf\x22\xc3\x95\x2d\xb4\x21\xe6\x5f\x36\x08\xbe\x39\xa8\x58\x50\x63\xcb\x84\xce\x73\xad\x1a\x0c\xaa\x1c\xf0\x3d\x54\x79\x12\xa6\x10\x23\xa9\xd2\x23\x22\x66\x89\xd3\xb6\xd0\x77\xb2\x83\x6a\x2b\xc9\x4a\x6b\xac\x0d\x48\x90\x0a\x5e\xc3\x3f\xe1\x17\x38\x85\xb3\x5f\x21\xd5\x6e\xe0\xc8\x80\x31\xf7\xd9\x42\x32\x47\x38\x3f\x01\x36\xb6\x83\x9b\xd5\x40\xc7\x0b\xaa\x3b\xb6\xbf\x24\x4c\x27\xd8\x54\x48\xe1\xa4\x98\xc0\x93\x77\x7a\x86\x0b\xe0\x69\x0a\xec\x57\xf8\x0d\xfe\xf6\x1f\x60\xf8\x07\x9c\xc0\xef\xf0\xf3\xcf\x30\x32\xc8\x67\xf0\xf4\x04\x36\x43\x2c\x2a\x48\xe5\xe2\x87\x62\xaa\x21\x48\x71\x74\xe0\x2a\x2a\xb8\x58\x4d\xa4\xc2\x4b\xfd\x49\x65\x9a\xa7\x7d\x2c\xb4\xeb\x59\xe5\xa8\x54\x54\xb2\x39\x2a\xc9\x33\xc8\xb9\x54\x01\x3c\x81\x2d\x53\x0d\x84\x58\xcd\x74\xbc\xa0\xd0\xea\xd2\x08\xb4\xcd\x4c\x5a\x6a\xa6\xcb\xcf\x69\xb7\x6a\x30\x08\x3c\xfa\xc7\xa0\xc7\xc5\x8c\x4f\x30\x82\x4a\xcc\xd0\x43\x7e\x54\x3d\xe9\xc6\xec\xaa\x22\x7c\x85\x5f\x5d\x37\x82\xe7\x67\xaf\xc6\x7a\x46\xd6\xd3\xf3\xd9\xd9\xc9\x47\xf5\x31\x80\x7f\xaf\x49\x15\x06\xc7\x68\x50\x39\x62\x2b\x4e\x6e\x33\x78\x61\x8a\xe1\x88\x1c\x05\x7b\x58\xba\xe5\xc5\x56\x36\x98\x6a\xe6\xa9\x4f\x34\x18\xac\x07\x9c\x9d\x21\x38\xe7\x4a\x8e\xd1\x92\x83\x70\x1d\xd5\xb5\x65\xc6\xaf\x6a\xcd\x03\xc1\x70\x87\xdc\x48\xeb\x1e\x0c\xab\xbb\xb7\x1c\x79\x77\x79\x41\xcd\xba\xde\x35\x53\x2e\xb3\x45\x83\x01\xe9\x52\x4c\xe1\xf0\x9b\xaf\x9e\x5b\x53\xe8\xbc\xc8\x90\xb0\xf1\xff\x00\x00\x00\xff\xff\x73\x1d\xc4\xa5\xe7\x11\x00\x00")
+var _kubernetesagentcustomdataYml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x59\xfb\x6f\xdb\xb8\x93\xff\xdd\x7f\xc5\x54\x6b\x14\xed\x5d\x69\x25\xdd\xb6\x0b\x78\xa1\x5d\xb8\xb6\x92\x1a\x75\x6c\x9f\x1f\x2d\xee\xda\x9e\x41\x4b\x23\x87\x1b\x89\x54\x49\x2a\xb5\xeb\xf8\x7f\xff\x82\x94\x2c\x3f\x62\xaf\x93\xa2\xbb\x68\xd0\x84\x8f\x99\xf9\xcc\x70\x38\x33\x1c\xfd\x12\xc4\x22\x0b\x49\x20\x78\xc4\x66\x95\xca\x37\xc9\x34\x4e\x22\x16\xa3\xaa\x57\x96\x4b\x16\xc1\x3b\xaa\xde\x09\xa5\x1b\x31\xa3\x0a\xd5\x6a\x55\x21\x90\x52\x7d\x5d\x07\xc7\x15\xa9\x76\xe9\xf7\x4c\xa2\x1b\x08\xae\x29\xe3\x28\x95\x7b\x2d\x94\xa6\xf9\x66\xa7\x02\x90\xa2\x4c\x98\x52\x4c\x70\x55\x07\xe7\xec\xcd\xab\x57\x66\x56\x7c\xe3\x28\xeb\xe0\x48\x21\xb4\x19\x1b\x7a\xe4\xba\x0e\x77\x15\x00\x80\x5f\xc0\x70\x81\x82\x4d\x65\xb9\x94\x94\xcf\x10\x2e\x51\x1b\x28\xea\x82\xc5\xe8\x73\x2d\x99\xc1\x63\xf6\x2f\x97\xb5\xd5\xaa\xb2\x5c\x22\x0f\x77\x7e\xb3\x08\x6a\xef\xa8\xea\x4b\x8c\x05\x0d\xdb\x09\x9d\x3d\x44\x83\x34\xdf\xce\xec\xf6\x1f\xd2\x61\x0d\xb8\xb6\x27\xf9\x04\xd6\x4b\xd4\x2d\xaa\x69\x8b\xa9\x9b\x2b\x91\x71\xdd\x14\x49\x42\x79\xa8\xa0\x76\x1a\x74\x62\x08\x42\xaa\x69\xc8\xd4\x8d\xaa\xa9\xeb\x03\xc0\x7f\x7b\x98\xf1\x9f\xb8\x53\xc6\xdd\x29\x55\xd7\x76\xac\x50\x03\x99\xdb\x3f\xad\x90\x35\xc4\x67\xcf\x61\x69\x67\xcd\x4f\x67\xdc\xf5\xaa\xe7\xe5\xf0\x62\x38\xfa\xdf\xbe\xef\x55\x5f\x96\x33\xbd\xfe\xa8\xdd\xeb\x0e\xbd\xea\xaf\xe5\xd4\x55\x6f\xdc\x1d\xf5\x7b\xed\xee\xc8\xab\xbe\x2a\x67\x5b\xed\xe1\x7b\xcf\x0d\xf1\xd6\x35\x9a\x14\x8a\xaa\x40\xb1\x73\x37\xce\x78\x75\xd9\x19\x77\x57\xe5\xe6\x7e\x63\x30\x6a\x1b\xc6\x5e\x75\x69\x08\x57\x24\xa5\x52\x6f\x70\x64\x21\xde\xd2\x30\x01\x85\x5a\xc7\x58\x4e\x27\x37\x21\x93\x40\x52\xa8\x6e\x30\x94\x8b\x2c\xca\xf5\x84\x3b\x98\x49\x4c\x81\x7c\x05\x67\x7b\x23\x38\xe5\x56\x7d\x8d\xbc\x1c\x98\x1f\x0c\xae\x05\x38\x71\xc6\xa1\xda\x19\x77\x81\x29\xa0\xb1\x44\x1a\x2e\x72\x96\x18\x6e\x68\xcd\x3f\x89\x3a\x93\x1b\x0e\x11\x2b\xff\x64\x11\x7c\x82\x27\x40\x10\xaa\xa5\x8e\xf0\xe5\xb8\x60\x57\x99\x33\x53\x33\x63\x33\x20\x84\xe3\x37\x38\x87\xaa\x31\xc9\xce\xb6\x23\xf6\xd8\x15\xfc\x04\xa6\xf1\x0d\x0b\xb7\x24\x1f\x97\x6b\x71\x3a\xd5\xfc\xbc\x1d\xf0\xc0\x99\x47\xca\xd9\x42\x7a\x90\x6a\x83\x38\xb9\x89\x54\x6d\x1e\x29\x20\x11\x90\x0e\x94\x27\x7c\x48\xb8\xf9\x87\xb1\xda\xc0\x3e\xc0\x0a\xe7\xfa\x15\x90\x8b\x5d\x5e\xc4\x87\x98\x7e\x5f\x4c\x98\xa6\xd3\x18\x27\x8c\x33\xed\x9d\xbf\xb0\x53\x7f\x89\x4c\x72\x1a\x17\x73\xc7\xa4\x46\xec\x90\xa9\xc6\xe3\x76\xcb\xab\x3e\xcb\x8d\x45\x94\x1d\x03\x11\x70\x4b\xe3\x6c\xfb\xdc\x9e\x97\x14\xd6\xb8\xa5\x53\x99\xfd\x5e\xd5\xfc\xef\x80\x8b\x3a\x70\x23\xa5\xe9\xb4\x72\xd4\x6a\xb9\x73\x6d\xa8\x8a\xe9\x6d\xd7\x2c\x66\xf2\xe3\xb0\x7f\x16\xf7\xee\x05\x17\x11\x65\x71\xb1\xe1\xac\xf8\xfd\xd2\x81\x3f\xfe\x38\x24\x7b\x4b\x4b\xeb\xb8\xf7\x2e\xca\x6a\x3b\x28\x1f\x0d\x5a\xc7\xe3\xdd\x26\x9a\x19\xe1\x6a\xa1\x34\x26\x61\xf1\xdb\x0d\x45\x70\x83\xb2\xa6\x50\xde\xb2\x00\x6b\xa1\x1b\xc4\x48\xe5\xc4\x22\x99\xa4\x52\xa4\x74\x46\x35\x13\x7c\x12\xc5\x74\xa6\x6a\x26\x77\xfd\x50\x98\x36\xf0\x3e\x0d\x73\x29\xb9\xc7\x5a\x0d\x2e\x0c\x57\x4f\x5d\x53\x89\x61\xe5\x91\x48\x71\x8e\xc1\x44\x69\x2a\xf5\xcf\x84\xe5\xcf\x31\x18\x1a\xa6\xde\xde\xd0\xcd\x94\xb4\xd1\x3a\x07\x02\x21\xc5\x44\x70\x20\xef\x20\x0a\xeb\xae\x0b\x84\x28\x2d\x24\x9d\x21\x09\x25\xbb\x45\xe9\x89\x5b\x94\x31\x5d\x00\x21\x53\x96\x7a\xcb\xe5\x47\x49\xd3\x86\xfa\x40\x25\x33\x57\x03\x9c\x9c\xcf\x5b\xc9\xc2\x19\x36\x59\x28\x9d\xd5\xaa\x52\x26\xa7\xc6\x0c\xb9\x1e\x64\x5c\xb3\x04\x07\x68\x8e\x07\xc3\x2b\x16\xc7\xac\xd9\x1f\x43\xed\xf1\xa7\x9a\x66\x93\xaf\x99\xd0\xf4\x67\x9a\xaa\xd9\x1f\xff\x8f\xe1\xe9\x2d\x97\x97\xb8\x06\xbb\x9e\x84\x67\x27\xb5\x78\x9e\x2b\xbc\x95\x95\xdb\x6a\x24\x52\x11\x8b\xd9\xa2\x43\xa7\x18\x2b\x9f\x1b\x53\x85\xa7\x53\xb2\x2e\xc8\x62\x4b\xf6\x33\x53\xf2\x2f\x40\xc3\x50\x99\x08\x01\x12\x67\x4c\x70\xa0\x3c\x84\x34\xa6\x3a\x12\x32\x81\x88\x66\xb1\x86\x50\x24\x94\x71\x10\x91\xdd\xc7\x45\x88\x2f\xc0\xe4\x22\x88\xa4\x48\xec\x1c\xe3\x4a\x53\x1e\x20\x24\xa8\xa9\xa9\x1d\xa0\x38\x9c\x17\xa0\x05\x30\xad\x20\x87\xbe\x5f\x06\x5c\xf9\xa3\x46\xab\x31\x6a\x4c\xc6\x83\x8e\x77\xad\x75\x5a\x77\x5d\x6b\xee\xf6\x55\x6b\xd8\x08\x43\x89\x4a\xad\x56\xee\x9a\xab\xbb\x96\xe3\x06\x22\x49\x33\x9d\x07\xf0\x48\x48\x60\xc0\x38\x54\x9f\x29\xfc\x0a\xe7\xf0\xe6\xec\xf9\xef\x10\x8a\x22\xf0\x0c\xfc\x4b\x9b\xd6\x9f\x05\x99\x8c\x81\x44\x6a\x68\xbc\xfa\xaa\x60\x59\xd7\x32\x43\x70\xaa\xdb\x48\xdc\x58\x04\x36\x2c\xfc\x49\x53\x46\x6e\x51\x9a\x48\xe0\xbd\x3c\x3b\xff\x8d\x9c\xbd\x22\x67\x2f\x9f\x1a\xdb\x50\xed\x69\x9c\x6b\xe7\x39\x3c\x7d\x0a\x17\x8d\x71\x67\x34\x69\xf5\xae\x1a\xed\xc7\x49\x5a\x5b\xfa\xc2\x18\xba\x65\xed\xfc\x60\xa1\x53\x89\xf4\xa6\x50\x52\xc5\x88\x29\xbc\xb6\xa3\x50\xf0\xdc\x30\x36\xa5\x92\xef\xe0\x54\x73\x1b\x38\xf0\x05\xee\xee\xd6\x73\xdb\x98\x1d\xf8\xf2\xfb\x76\x9a\xc8\x13\x44\x20\xb2\x38\x04\x2e\x74\x7e\xda\x7b\x4e\xb2\xe3\x1b\xa7\x3d\x61\x5d\xaf\xe0\x9c\x69\x38\xaf\x6c\xe5\x06\x85\x21\x10\x06\x8e\xba\xfb\xff\xf7\xe3\xb7\x7e\xc7\x1f\x4d\xba\xbd\x96\x3f\xe9\x34\xde\xfa\x9d\xa1\x57\xfb\xaf\xbb\xa7\x2f\xac\x53\xac\x6f\x4f\x57\x84\x98\xdf\xa0\xd5\xea\xae\xc8\x78\x21\x5a\x3c\xee\x4d\x36\xc5\x18\xf5\xbd\x9b\xd7\x17\xa1\xf1\xa9\xb7\xb1\x09\x4b\x27\xf3\x46\xc1\x65\x2b\xc4\x4c\x0d\xe1\x84\x25\xa1\xfa\x47\xc2\x71\x5f\xa2\x57\xde\x4c\x20\x01\x38\x2c\xb5\x35\x86\x02\xd2\x84\x8b\xde\xe0\x63\x63\xd0\x02\xa2\xe0\x7e\xa0\x35\x58\x9b\x71\xa6\x34\xca\x22\xce\x02\x09\xe1\xc0\x2d\xfa\xf5\x25\x90\xbf\xa0\x35\xe8\xf5\xe1\xe5\x1f\xb6\x2e\xe6\x59\x1c\x1b\x8f\xd8\xc8\x6a\xff\x74\x59\xce\xe6\x28\x76\x4d\x9e\x47\x71\x37\x4f\x34\xb5\xbf\x94\xe0\x3f\x6c\xd4\xf5\xeb\xc1\x89\xd9\x2d\x12\x89\x26\x55\xa1\x53\x07\x73\xe3\x5e\x94\x6b\x62\x56\xe4\x2e\xa7\x0e\x8e\x91\x47\xcc\xeb\xd4\xd9\xd9\x20\x52\xad\x9c\xfa\x86\xa3\x21\x4c\xe8\x9c\x28\xf6\xdd\x30\x74\x5e\x9f\x25\xce\x8b\xbd\x35\xcb\xc5\xac\xad\x5d\x7c\x55\x14\x36\x7b\x0a\x1b\xe3\x49\x8e\x1a\x95\x1b\xa0\xd4\xca\x0d\x68\x2d\x90\xfa\xb8\xd6\xc8\x03\x11\x32\x3e\xab\x83\x33\xa5\x0a\xdf\x3c\xc8\x14\xf7\xce\x2c\xa0\x4d\x94\x9a\x45\x2c\xa0\x1a\x9d\xd5\x69\x58\x34\x65\xc6\xef\x51\xfe\x1b\xe8\x4a\x61\x8f\x04\x19\xc4\x0c\xb9\xfe\x57\xec\x67\x25\x1d\x87\x77\x4b\xa5\x1b\xb3\xe9\x3a\xf2\xd8\xdf\x26\x44\xb0\xd9\x71\x64\x27\x40\xd0\x94\x7d\xc8\x13\x40\x1d\x6e\xf3\x58\x79\xc3\x78\x58\x87\xa6\xe5\x6b\x27\x82\xfc\xca\xab\xba\x1d\x11\xe0\x34\xc1\x3a\x98\xcc\x15\x17\x4b\x85\x37\x16\xa3\x7a\x31\x04\x08\x36\xaa\x10\x9a\xe9\x6b\x21\x99\x5e\xd4\xe1\x88\x9d\xad\x8f\x96\xb4\xb9\x63\xd4\xc1\xe4\x6a\x65\x93\xf5\xbe\xb9\x36\x1c\x1a\xfd\xb6\x09\x76\x28\xdb\x7d\x67\xb5\xaa\xdb\x28\xf1\xfe\xfe\x6a\x5f\x48\xbd\xca\xaf\x4c\xa6\xee\x29\x94\x9f\x73\x21\x3f\x53\x3b\x7a\xd8\x25\xb2\xa5\x4e\x1d\x4e\x39\xcb\x3e\xf1\x0d\x1e\xd7\xdc\xee\xa8\xdd\xe0\xc2\x12\xd9\x23\x9a\xeb\x12\x5e\x31\xde\x86\x93\xdb\xf9\xd0\x19\x14\xd0\x0b\xa9\xc5\xe4\xfd\x13\x2b\x78\xda\xf5\x20\x93\xd2\x20\x5c\xcb\x39\xb8\xf1\x74\x16\x0b\x74\x4c\x70\xae\x25\x0d\xca\x6c\xf6\xc3\x6e\xf9\x69\xcc\x99\xce\x8b\xe3\x16\xaa\x40\xb2\xd4\x14\x49\x9e\x39\xd3\x40\xc7\x50\x88\x61\x22\xaf\x22\x06\xf8\x35\x63\x12\x95\xb7\x5b\xae\xdb\xb5\x46\xa4\x51\x1e\x5a\x68\x0a\x1e\x32\xc3\xb5\x4f\xf5\xb5\x3f\x67\x4a\x2b\xef\x89\x7d\x9b\x58\xf5\x6d\x8a\x2c\xd4\xaa\x1c\xc8\xa7\x23\x96\xa0\xc8\xb4\x7d\xe1\x0c\x31\xf0\xce\x0a\x24\xf6\x1d\xe5\x99\x88\x4f\x59\x9c\x49\xdc\x9e\x36\xfb\x5e\xab\x23\xe9\xb8\xec\xef\xb8\x3a\x49\xd7\x06\x0d\x99\x3c\xb0\x7d\xef\x01\x95\x9a\xdc\xfa\x77\xd7\xe3\xdd\x22\x45\x69\x86\xc3\x14\x03\x67\xb5\x3a\xcd\x52\x66\x1c\x08\x91\x09\x90\xdb\x7d\x3c\x75\xfb\x70\xd8\x8c\x1f\x25\x19\x76\x2b\x8f\x20\x05\xf7\x7a\xbd\x05\xf6\x18\xbb\xce\x01\x9c\x86\x3c\xb9\x87\x69\x9b\xc9\xe1\x13\xdc\xe1\x94\xb3\x09\xae\x13\x11\x02\xfd\xef\xf9\x31\x1a\x2b\xfe\x53\xdb\x94\x99\x71\x9c\x3b\xe3\x47\x6a\x1a\x62\x6f\x17\x5e\x92\xc5\x9a\x11\x73\xd5\x6a\x9a\xca\x19\xde\xbb\x20\x7b\x55\xe2\x0f\xdf\x84\x75\x9d\xda\xec\x8c\x87\x23\x7f\x30\x69\x75\x87\x07\x9e\xc0\x46\x4a\x8b\xab\xc2\x43\x6d\x14\xdc\xa1\x6e\xf4\xdb\x93\xa1\x3f\xf8\xe0\x0f\x86\xde\x3f\x13\x50\xd7\x92\xda\x57\x8d\x4b\xdf\x7b\x8c\x4f\xec\x90\x77\xfd\xd1\xc7\xde\xe0\xfd\xa4\xdf\x19\x5f\xb6\xbb\x9e\xd9\xc6\x51\xdb\x2d\xad\x5e\xf3\xbd\x3f\x98\xf4\xfa\xa3\x61\xde\x52\x68\x8e\x87\xa3\xde\xd5\xa4\x79\xd5\xca\x0f\xd4\xd4\x60\x3b\xcc\xcc\x5b\xc4\x1a\x6d\xd8\x7c\xe7\xb7\xc6\x9d\xc6\xdb\x8e\xef\xdd\xdb\xb5\xfd\x02\x58\x2e\x61\x47\xd3\xbc\xf6\x87\x1a\xec\xc1\xec\xf7\x5a\x93\x76\xf7\x62\xd0\x98\x34\x7b\xdd\x51\xa3\xdd\xf5\x07\x0f\xd0\xdc\xbc\x0c\x78\x24\x69\x73\xfd\xde\x3e\x64\x01\xff\x43\xbb\x69\xda\x5f\x93\x8b\x4e\xe3\xd2\x20\x5a\x3f\xff\x0d\xaa\x18\xb5\x7f\xcb\x02\x13\xb6\x6c\xc7\x07\x6a\x7b\xd4\x03\xdf\x1e\x73\xeb\x18\xf5\xba\x79\x70\x98\xda\x68\x32\x3a\x46\x3a\xa2\xac\xe8\x33\x41\x6d\xf3\xda\xd9\xd8\xaa\xa8\x23\x2e\x11\x9c\xf3\xda\x9b\xda\xd9\x5a\xb1\x92\xfb\x85\xdf\x18\x8d\x07\xfe\xe4\xb2\x31\xf2\x87\x1e\x21\x11\x52\x9d\x49\x24\x33\xaa\x51\x79\x8d\x20\xc0\x18\x25\xd5\x42\xaa\xfc\x90\xd6\xc5\xfc\xa3\x1e\x50\x0f\xa9\xd1\x66\xdf\x59\xfa\x77\x37\xef\xc9\x93\x29\xe3\x54\x2e\xf6\xae\xa0\xb1\x6c\xbb\xe9\x4f\xde\xbe\x79\x35\xb9\xfc\xbf\x76\x7f\x32\x1c\x0d\x2a\xa7\x1a\x2a\x25\xbc\x83\x9d\x94\xd7\xaf\x7f\xa0\x93\x62\xdf\xb5\x67\x27\x25\xa7\x52\xdc\x32\x63\x84\xbf\xed\xe2\xfc\xb0\x55\xee\x3b\x7a\x29\x70\x68\x13\xb6\x39\xff\x8a\xcc\x78\x90\x84\xc7\x3f\xcb\xed\x76\x97\x77\xbf\xa1\x15\xef\x6d\x33\xa5\xf2\x9e\x01\x04\x54\xc3\xc9\x4f\x78\x65\x7f\xb8\xa0\x8c\xd8\xa3\x3e\x59\x3d\xf0\x5b\x55\xc9\x93\x00\x4d\x35\x99\xa1\x86\x2c\x0d\xa9\xc6\xad\x09\xdb\x44\x8a\x63\x20\x0b\x3b\xa5\x25\xe5\x2a\x15\x52\x13\x1b\x83\x21\xa0\xdb\x25\xa5\x02\x1e\x29\x12\x88\x24\x11\xbc\x42\x20\xef\x0f\xd8\x6a\xc7\x36\xef\x40\xa6\xc1\x94\xf1\xf0\xc8\x12\x51\x9a\xea\xdd\x45\x5b\x73\x1c\x24\x2b\x57\x4a\xaa\x7b\x9d\xad\xbd\x90\xdf\x5f\x9f\xed\x00\xb5\x5c\x34\x8d\xd9\x56\x2b\xdb\xfb\x82\xbc\x05\x45\xcc\xdb\xd5\xf4\x2d\x8f\x52\x16\x15\x53\x9b\x0f\x31\x10\x3c\x34\x9f\x16\x49\xa4\x86\x9d\xb2\xc2\xa7\xa9\x2e\xea\x34\x7b\xba\x18\xce\xb0\xc6\x51\xbb\xb3\x74\x06\x77\xd6\x80\x37\xb8\x30\x5d\x44\x20\xbf\xc3\x27\xa8\xfe\x09\x04\xbf\xc2\x19\x7c\x29\x5b\x54\xa6\xc3\x50\xf4\xa7\x0c\x34\x6e\xce\x22\x6f\x30\x85\x38\x3d\x50\xa8\xe4\xe2\x7c\x3e\x63\x1c\x5b\xe2\x1b\x37\x9f\x50\x07\x98\x0a\x53\xa9\x64\xd3\x8c\xeb\x8c\xcc\x91\x33\x1a\x83\x69\x3d\x39\x70\x07\x2a\x0b\x05\x68\xc4\xdc\x35\x69\xaa\x5d\x25\x32\x19\xa0\xaa\xc5\x4c\xe9\x5a\x58\x14\x50\x76\x54\x21\xe0\x58\xe9\x9f\x9d\x3e\x0d\x6e\xe8\x0c\xeb\x90\x2f\x13\xb4\x22\x3f\xf3\x3e\xe3\x75\x28\x3a\x70\x27\xf0\x15\xe1\xd5\x59\xad\x2c\x19\xe9\x4b\x56\x3c\xa7\x5e\xbf\x3e\xfb\xcc\x3f\x3b\x50\x78\xbd\x01\x95\x4a\x8c\x50\x22\x37\xc0\x4a\x4c\x66\xd2\x79\xa0\xbb\xe2\x34\xef\xd3\x1c\x5e\xdd\xd1\x62\xc7\xb3\x24\xe6\xbe\x95\xef\xa8\x10\xd8\x94\xb5\x7b\x4f\x9f\x84\x72\x16\xa1\xd2\xaa\x42\xec\x93\xc5\x14\x63\x84\x5e\x16\xbc\x0f\x18\xc3\x6c\x32\x0f\x19\x13\x57\x48\x51\xb3\xb1\xa9\x55\x97\xa6\xba\x56\xa4\x85\x5a\x48\x59\xbc\x38\xdd\x05\x7f\x60\xfb\x7b\xeb\x96\x6b\x91\x05\xd7\x47\xe8\xf2\x30\x57\x0b\x44\x92\xc6\xa8\xb1\xf2\x9f\x01\x00\x3e\x4c\x17\xdd\xa2\x20\x00\x00")
 
 func kubernetesagentcustomdataYmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -567,7 +568,7 @@ func kubernetesagentcustomdataYml() (*asset, error) {
 	return a, nil
 }
 
-var _kubernetesagentresourcesvmasT = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5a\x5f\x6f\xdb\x38\x12\x7f\x5e\x7f\x0a\x42\x28\xaa\x18\x50\xec\x6d\xef\xad\xc0\x15\xc8\x25\x69\x6b\x74\xdd\x18\x75\x9a\x7b\xc8\xe6\x81\x16\xc7\x32\x11\x89\xd4\x92\x94\x9b\xac\xe0\xef\x7e\x20\xf5\x97\x12\xe5\x78\xdb\xa4\x9b\xdb\xdd\xbe\xa4\x16\x87\xa3\xe1\x6f\xfe\x0f\x85\x10\x42\xf9\x08\x99\x7f\x1e\x4e\xe9\x15\x08\x49\x39\xf3\xde\x20\xef\x7a\x8b\x05\xc5\xab\x18\xe4\x91\xdf\xac\x9c\xc1\x1a\x67\xb1\xf2\xc7\x37\x5e\x50\xed\x0b\x79\x7a\xef\xbd\xa9\xf9\x98\x27\x19\x53\x86\x89\xcc\x56\x47\x2d\x46\x79\x3e\xf9\x84\x13\xd8\xed\x4e\x35\x85\x3f\x0e\x90\x6b\xf1\x62\xbd\x96\xa0\xfc\x71\xeb\x25\x08\x79\x0c\x27\xa0\x79\xc6\x9c\xa7\x5e\xf9\x78\x57\x0b\x41\x20\x05\x46\xe4\x85\x96\xfd\x7a\x94\xe7\x74\x8d\x26\x33\x79\x9a\x49\xc5\x93\xab\x4f\xe7\x97\xbb\x5d\x45\xd9\x3e\x18\x93\xd1\xec\x4c\x1f\x66\x94\xe7\x10\x4b\x70\x53\x6d\x19\xa8\x86\x8c\x91\x9a\xea\xa6\x7e\x7d\xcc\x43\xac\x1c\xc8\x55\xcf\x2d\xc0\xaa\x93\x5c\x87\x9c\x85\x58\x39\x01\xba\x9a\xeb\xbf\x0b\x01\x6b\x7a\xa7\x71\xf2\x19\x0d\x8f\xfd\x00\x69\xb0\x67\x8c\xc0\x9d\x73\x57\x8d\x5c\xfb\x75\xa9\xe0\x29\x08\x45\x41\x1a\x2d\xed\xc1\x46\xcb\x06\xea\x2b\x17\xb7\x4b\x08\x33\x41\xd5\xfd\x7b\xc1\xb3\xd4\x52\x2e\x42\x1e\x25\xdd\x63\x36\x38\x56\x44\xbb\xa0\x83\x95\xde\x97\x9e\x72\xb6\xa6\x51\x26\x0c\x26\xd2\xa8\xaa\xe1\x9b\xe7\x02\xb3\x08\xd0\x0b\x09\xbf\xa1\x37\xff\x46\x5a\xd1\xe8\x15\x9a\xcc\x16\x27\x84\x08\x90\xd2\x18\x4d\x8b\x21\xb2\xc4\x6a\x01\x4b\xd3\xd0\xbc\x28\xcf\x35\xaf\xdd\xae\x65\x46\x2e\x44\x90\xf5\xcf\xe0\x03\xbf\x15\x62\xbc\xb2\x5e\x57\x6e\xa6\x09\x16\xda\xe2\x95\xc8\x20\xe8\xed\xb6\x0f\xdd\x6c\xda\x62\x05\xb3\xc5\x49\x5c\x99\xc4\x1c\xd4\x86\x1b\x24\xcf\xee\x19\x4e\x68\xe8\x75\x79\x79\x32\x5b\x31\x50\x0e\x19\x9d\x4a\xc8\xf3\x17\x95\xf1\x30\x50\x4b\xb3\xb7\xa3\x95\x52\x37\xa3\xa1\x5f\x16\xb6\x1a\x87\x58\x15\x38\xbc\xe8\x69\x21\xe8\x9f\xb4\xfb\xe4\xa6\xb0\x35\xc6\x15\x9a\x49\x6d\x68\x33\xa6\x20\x12\x58\x41\x9b\xaa\xe5\xe2\xc0\xf4\x51\x66\x8b\x77\x5c\x7c\xc5\x82\x50\x16\x95\x28\x77\x6c\xa9\x71\x7b\x75\x9f\x1a\x8d\xcf\x69\x28\xb8\xe4\x6b\x35\xf9\x54\x18\xf0\xb4\x34\x64\xfd\x4a\xb1\xc6\x21\xc8\x02\x05\x63\x97\x85\x03\xcc\x31\xc3\x11\x90\x33\x2a\x6f\xe5\x6e\x87\x46\xed\x58\xf8\x6d\xfe\xec\x72\xc9\x93\x2d\xa6\x31\x5e\xd1\x98\xaa\xfb\x25\xd8\x91\xf3\x90\x88\xbb\x54\x5c\xe0\x08\xda\xc2\xfa\x43\xde\x5d\x23\xd9\xf1\x8b\x34\xc6\x6a\xcd\x45\xf2\x4e\xc7\xee\x33\x9e\x60\xca\x4e\xab\x10\xfd\xba\xe7\x1c\x25\xf1\x97\x94\x60\x05\x1d\xea\x7f\x79\xc1\xe8\xa7\x9f\x6a\xda\xa4\x90\xca\x43\x6f\x90\xa7\xf5\x64\xf9\x3f\x42\xc3\x5a\x3a\xe5\x49\x9a\x29\x98\x62\x1b\x9d\xb6\x92\x74\x3c\x46\x85\xa6\x4a\x0c\x4e\xc2\xb0\x15\x01\xfe\x50\xde\x2a\x39\x1c\x9c\xb7\x5c\x9a\xb4\xa5\x90\x65\x0a\xfb\xe6\x1c\x55\x6f\xaa\xd2\x80\xdf\x37\xe2\x34\x5b\xc5\x34\xac\x5d\x0f\xe4\xd4\xb7\x52\x66\x82\xa5\x02\xb1\xb0\xa9\xb4\xb4\x26\x79\x3e\x59\x96\x92\x16\x12\x45\x92\x02\xe9\x8f\xaf\x13\x4e\x8e\x30\x21\x47\x4d\x96\x1a\x07\x0f\x43\x59\x67\xad\xe0\xc1\x77\x94\xa0\x8f\x6f\x1e\x26\xf5\xc7\xd7\x84\x6e\xff\x04\x71\x1a\xd7\x2f\x88\x6b\x7d\x0c\x65\xe4\xda\x12\x70\xb1\xe1\xb2\x74\x17\xab\x06\x49\x96\xf4\x77\x90\x73\x9c\xfa\x63\xa7\x75\x5e\xcd\x35\x81\x3f\xbe\x99\xd8\xa2\x6a\x66\x37\x7d\x5b\xec\xbb\x64\x09\xc2\xd4\xde\xde\x78\x24\xaa\x72\xc2\xe4\x03\x96\x65\xd0\x7c\xf6\x8e\x48\xb0\xc2\x84\xca\xdb\x5f\xfe\x71\xc8\x7e\xd9\xa8\xc1\x59\x3a\x76\x2e\x01\x48\xc7\xfc\xff\x7c\xcf\x7d\x56\x72\xd7\x6c\xcf\xb0\xc2\x7f\x45\x37\x6f\xaa\xad\x67\x58\x12\xb9\x9a\x50\x1b\xeb\x7d\x00\x1c\x52\x7a\xe8\xd3\xeb\xea\x25\x6f\x45\xbd\x6e\xc1\xf8\x68\x45\x5c\xb7\xf5\xfc\x26\x08\xec\x02\xf9\x47\xf7\xe4\xdb\x44\x07\xd8\x4f\x9c\xc0\xa1\x9d\xb9\xb3\xa4\x1b\x8a\xbe\x03\x36\x3b\xf5\x0f\x8a\x25\x55\x0c\xd4\x31\xe5\xc0\xf6\x39\xb0\x42\xfa\xdd\xd5\x5c\x2e\x40\xd8\x22\x3f\x93\x00\xf9\xff\x78\xa8\x3d\x05\xd2\x68\xa0\xc6\x78\x5a\xcb\x78\x56\x40\xfe\x81\xec\xf6\x98\x86\xf4\x37\xc0\xe0\xc1\xac\x5d\x05\xd1\xde\xe4\x6a\x4f\x45\xd8\x9b\x33\x74\x2a\xc2\x27\x98\xe8\xb9\x05\x1a\xca\x6b\x43\xf2\xf4\xb2\xb0\xab\x40\x55\x38\x6a\xe6\x0a\xed\x6c\x22\xc0\x24\xfd\x25\xcf\x44\x08\xa6\xff\xaf\x45\xc2\xa1\x04\x16\x51\x06\xc7\x07\x22\xf1\x4d\x08\x08\x90\xe6\xdd\x9a\x68\x99\xad\xd7\xf4\xae\x90\xa2\x3d\x95\xac\x97\x3a\xdd\x01\x17\xe1\x06\xa4\x12\x58\x71\xd1\xdb\xd5\x5e\xd4\xcc\xcb\x8c\x7b\x89\xa3\x0e\x97\x94\xf3\x58\x13\x18\x0e\xb5\xb8\xfd\x04\xf8\xe4\xb3\xe1\xef\x9f\x09\x37\xa5\xa9\x6d\x14\x03\x93\xdf\x0a\xfb\x19\x39\xc4\x00\x7d\x77\xec\x1f\x36\xbf\x16\x78\x08\x79\x1b\x2c\xc8\x57\x2c\x60\x21\xf8\x9a\xc6\xd0\x15\xa9\xa8\x98\x07\x6b\xcd\xba\x5e\x76\x33\x2f\xbd\x77\x80\x77\x7f\x86\x68\x4f\xac\xbb\x83\xb6\x87\x10\x1a\x8c\x19\x7e\xf0\x84\x57\x01\xdd\x01\x70\x7b\x64\x7b\xe3\x44\x85\xcb\x01\x40\x30\x49\x28\xfb\x22\x41\xb8\xea\xfb\xac\x7c\x6e\xbb\x89\x29\x3d\x8d\x61\x88\x1f\x63\xdb\x46\x2d\xf9\x7b\x50\x1f\xb3\x15\x08\x06\x0a\xe4\x49\x04\x4c\x15\xf7\x1d\x3a\xf4\xa3\x89\x35\xb6\xf6\x62\xca\xb2\x3b\xeb\x6a\xc2\x31\x72\xf7\x08\x95\xfa\xf5\x0b\x2c\xe5\x57\x2e\xc8\x49\xa6\x36\xc0\x14\x6d\x7c\xdb\x0c\x40\xfb\x33\x7c\xb9\x71\x0f\xf0\x8b\x51\xc2\x47\xb8\xef\x5b\x95\xdb\xb6\xca\x7d\xb7\x70\xaf\x0f\x61\x80\x4c\xb1\xc0\x09\x28\x10\x3a\x11\xca\xcd\xe7\xe5\xc9\xa2\xe2\xda\xd5\x42\xfb\xcd\x58\x6d\xba\xca\x93\x72\xf3\x11\xee\x17\x58\x6d\x1c\x17\x06\xa8\x77\x69\x80\x2c\xdb\x71\x51\xd8\xbf\x4c\x51\xf7\x01\xcb\x5f\x34\xd4\x4b\x08\x05\x28\xd9\xbb\x25\xe9\x63\x57\x10\xf6\x02\xa7\x66\x52\x5a\x68\xc9\xab\x27\x74\x37\x85\xb7\xcd\xbb\x2c\x19\xdc\x36\x6e\x4c\x47\x03\x6c\xca\xcf\xae\xa9\xd0\x04\x47\xf0\x19\xd6\x20\x80\x85\xdd\xad\xda\x73\xd6\x6b\x10\x5d\x79\xb9\x9c\xe9\x6d\x17\x7a\xad\xaf\x96\xc2\x10\xe4\x66\x70\xdf\xa2\x5a\x77\xec\x95\xb7\xd9\xc0\xae\xe5\xc7\x2f\x0e\xfa\xad\xbb\xb1\x2c\xf7\x94\xa9\xae\x03\xe6\xce\x72\x66\x6e\xea\xf2\xfe\xc9\x4d\x45\x00\x17\x69\xe5\x0d\xef\x04\x4f\x0c\x53\x5b\x2f\x81\x17\xe2\x70\x53\xdc\xec\x78\x9f\x01\x93\xff\x0a\xaa\x2c\x9a\x07\x3b\xc4\x82\xcd\x13\x06\x93\xc0\x3f\xe6\x92\x50\x79\xdb\xb3\xaa\xc0\xdb\x6e\x88\x2b\x38\x64\x82\xb6\x85\x11\x95\x85\x1c\xfd\xd3\xcc\xfe\x3d\x9b\xd9\x60\xff\xf4\x7b\x3c\x29\xaf\x91\xcf\x19\x49\x39\x65\x4a\x4e\x56\x31\x5f\x05\x7e\x61\x78\x87\xf6\x10\x87\x82\x85\x2a\x8b\x9e\x6c\x37\xa4\x67\xd5\xfb\xee\x70\x4b\x7f\x64\x80\x26\x17\x4b\xed\xf9\xba\x9c\x7a\xff\x1f\xf4\x73\xcf\x21\x49\xbd\xa8\x1d\x24\xb7\xc8\xf7\x5e\x13\xb7\x05\x38\xe4\x86\xb7\xaa\x33\xb7\x54\xa8\x0c\xc7\x73\x13\x4f\xa0\x33\xc1\xfc\xde\x79\xe2\xb3\x1d\xa0\xd5\x5b\xf7\xb4\x80\x1d\x64\x1e\xd9\x9a\xbe\xfb\x22\xe3\x60\x95\x4e\xe1\x4e\x01\x93\xe6\x5b\x91\x1f\xd1\x21\x05\xfe\x34\x94\xf0\x28\x5f\xd9\xd4\x6a\xb2\xb2\x7b\x73\xe2\x93\xdf\x33\x01\x93\xf3\xfe\xf9\x5a\xf8\x14\x15\xeb\x32\x14\x34\x55\xdd\xf5\x0f\x98\x91\x18\x44\xcb\xb6\x5f\x4f\x7e\x6e\x13\xe1\x4c\xf1\x2f\x69\x24\x30\x81\x39\x65\xbc\x45\x69\x7f\xb6\xe2\x49\x50\x8a\xb2\xc8\x9e\x9b\x17\x07\x52\x10\x2a\x20\xcb\x16\x41\xa7\xac\x4f\x30\x23\x97\xfc\xfc\x0e\xc2\x4c\x59\x4a\xf1\xa7\x99\x14\xd3\x15\x65\x53\xc6\x37\x59\x8a\xcc\x7f\x57\x58\x6e\xd0\x71\x88\x7e\xf5\x9a\x9f\x53\x9e\xaa\x29\xd6\x60\x4c\x43\xce\x14\xa6\x0c\x84\x9c\xa6\x82\x6f\xa9\x16\x77\x22\x37\xc8\x4a\x8c\x0a\x18\x66\xe6\xb3\x96\xc0\xb7\x57\x64\xb6\x92\x06\x2a\xca\xd9\x8c\xf4\xd7\xab\x96\xcc\x7c\xd2\xd4\x5f\x6e\x2c\xd5\xc1\x98\x41\x19\xd7\xbb\x6b\x4c\x46\xee\x85\xd2\x92\xcb\x8e\xcf\x4d\x23\x78\xa6\xe0\x52\xff\x70\xaf\x97\x29\xa2\xec\x94\xcb\x46\xd9\x4d\x2a\x41\x6c\x69\x08\x0b\x41\x59\x48\x53\x1c\x9f\xc6\x14\x98\x72\xc1\xe0\xa6\x2c\xca\xe8\x3e\x75\x68\x56\x17\xc5\xd7\x4b\xa6\xab\xe8\x52\x28\x2c\x22\x50\xe7\x6c\x4b\x05\x67\x09\x30\x07\x93\xaa\xc9\xe6\x31\x0d\x0b\x0e\x6f\xdf\xa2\xe9\x16\x8b\x69\xcc\xa3\x4a\xf9\x71\x26\x15\x88\xe3\x46\xf3\x31\x8f\xd0\xeb\xb7\x2f\x5f\xa1\x97\xbf\x7a\xe8\xa5\xdd\xc1\x8f\xda\x7f\x77\xa3\xff\x05\x00\x00\xff\xff\x72\xc0\x15\x02\xc7\x28\x00\x00")
+var _kubernetesagentresourcesvmasT = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5a\x5f\x6f\xdb\x38\x12\x7f\x5e\x7f\x0a\x42\x58\x54\x09\xa0\xd8\xdb\xde\x5b\x80\x2b\x90\x4d\xd2\xd6\x68\xd3\xf8\xea\xb4\xf7\x90\xcd\x03\x2d\x8e\x65\x22\x12\xa9\x25\x29\x37\x59\x41\xdf\xfd\x40\xea\x1f\x45\x49\x89\x9d\x36\xdb\x2e\x70\x49\x1e\x62\x71\x66\x38\xfc\xcd\x7f\xca\x08\x21\x94\x4f\x90\xf9\xf1\x70\x4a\xbf\x80\x90\x94\x33\xef\x18\x79\xd7\x5b\x2c\x28\x5e\xc5\x20\x0f\xfc\x76\xe5\x0c\xd6\x38\x8b\x95\x7f\x78\xe3\x05\x35\x5f\xc8\xd3\x7b\xef\xb8\x91\x63\x9e\x64\x4c\x19\x21\x32\x5b\x1d\x58\x82\xf2\x7c\xfa\x11\x27\x50\x14\xa7\x3c\x63\xca\x3f\x0c\xd0\xd0\xe2\xe5\x7a\x2d\x41\xf9\x87\xd6\x26\x08\x79\x0c\x27\xa0\x65\xc6\x9c\xa7\x5e\xf5\xb8\x68\x94\x20\x90\x02\x23\xf2\x52\xeb\x7e\x3d\xc9\x73\xba\x46\xd3\xb9\x3c\xcd\xa4\xe2\xc9\x97\x8f\xe7\x57\x45\x51\x53\xda\x07\x63\x32\x9a\x9f\xe9\xc3\x4c\xf2\x1c\x62\x09\xc3\x54\x5b\x06\xaa\x25\x63\xa4\xa1\xba\x69\xb6\x8f\x79\x88\xd5\x00\x72\xf5\xf3\x0e\x60\xf5\x49\xae\x43\xce\x42\xac\x06\x01\xfa\x72\xa1\xb1\x58\x08\x58\xd3\x3b\x8d\x93\xcf\x68\x78\xe4\x07\x48\x83\x3d\x67\x04\xee\x0e\x1e\x44\xce\xde\x2e\x15\x3c\x05\xa1\x28\x48\x63\xa5\x07\xb0\xd1\xba\x81\xfa\xca\xc5\xed\x12\xc2\x4c\x50\x75\xff\x56\xf0\x2c\xed\x18\x17\x21\x8f\x12\xef\x78\x0c\xc7\x9a\xa8\x08\x1c\xac\x34\x5f\x7a\xca\xd9\x9a\x46\x99\x30\x58\x69\x75\xae\x9b\x55\x84\xf2\x5c\x60\x16\x01\xfa\x55\xc2\x9f\xe8\xf8\xdf\x48\x1b\x1a\xbd\x44\xd3\xf9\xe2\x84\x10\x01\x52\x1a\xa7\xb1\x04\xb6\xbe\xeb\x00\x4b\xd3\xd0\x6c\x94\xe7\x5a\x56\x51\x78\x41\x97\xce\x41\xa4\x7e\x5e\xab\x41\xd7\x08\xfe\x2c\xd5\x78\xd9\xd9\xae\x62\xa6\x09\x16\xda\xe3\x95\xc8\xa0\x2b\x19\x21\xf7\xd0\x2d\xd3\x16\x2b\x98\x2f\x4e\xe2\xda\x25\x2e\x40\x6d\xb8\x41\xf2\xec\x9e\xe1\x84\x86\x8e\x96\x08\x79\x32\x5b\x31\x50\x03\x3a\x0e\x1a\x21\xcf\x7f\xad\x9d\x87\x81\x5a\x66\xab\xd6\x6d\x6b\xae\xca\x36\x9d\xcf\xc5\x64\xf8\x7f\x83\x43\xac\x4a\x1c\x7e\xed\x59\x21\xe8\x9f\xd4\x7d\x72\x53\xc6\x21\xe3\x0a\xcd\xa5\x76\xb4\x39\x53\x10\x09\xac\xc0\xa6\x6a\x4f\xed\x01\xd3\x47\x99\x2f\xde\x70\xf1\x15\x0b\x42\x59\x54\xa1\xec\xf8\x52\x1b\xf6\xea\x3e\x35\x16\xbf\xa0\xa1\xe0\x92\xaf\xd5\xf4\x63\xe9\xc0\xb3\xca\x91\xf5\x96\x62\x8d\x43\x90\x25\x0a\xc6\x2f\xcb\x00\xb8\xc0\x0c\x47\x40\xce\xa8\xbc\x95\x45\x81\x26\x76\x2e\xac\x8d\xe4\x62\xfc\x70\x3c\x0f\x85\xe4\xc9\x16\xd3\x18\xaf\x68\x4c\xd5\xfd\x12\xba\x99\x73\x97\x8c\xbb\x54\x5c\xe0\x08\x6c\x65\xfd\xb1\xe8\x9e\x8c\xc4\x45\x1a\x63\xb5\xe6\x22\x79\xa3\x73\xf7\x19\x4f\x30\x65\xa7\x75\x8a\x7e\xe5\x05\xc3\xc4\x9f\x53\x82\x15\x38\xd4\xff\xf2\x82\xc9\x2f\xbf\x34\xb4\x49\xa9\x95\x87\x8e\x91\xa7\xa3\xa1\x13\xff\x08\x8d\x5b\xe9\x94\x27\x69\xa6\x60\x86\xbb\xe8\xd8\x46\xd2\xf9\x18\x95\x96\xaa\x30\x38\x09\x43\x2b\x03\xe4\x4f\x40\x71\xe7\xba\x35\x64\xc9\xae\x16\xb2\x2a\x61\xad\xc0\x3d\x6b\x54\xc3\x54\x97\x01\xbf\xef\xc4\x69\xb6\x8a\x69\xd8\x84\x1e\xc8\x99\xdf\x29\x99\x09\x96\x0a\xc4\xa2\x4b\xa5\xb5\x35\xc5\xf3\xd9\xaa\x94\xec\x20\x51\x16\x29\x90\xfe\xe1\x75\xc2\xc9\x01\x26\xe4\xa0\xad\x52\x87\xc1\xe3\x50\x36\x55\x2b\x78\x74\x8f\x0a\xf4\xc3\x9b\xc7\x49\xfd\xc3\x6b\x42\xb7\x3f\x40\x9d\x46\x6c\x45\xdc\xd8\x63\x30\x66\x6d\xff\xc3\x25\xc3\x55\x15\x2e\x79\xfe\x16\x54\x57\x37\xbd\x84\xa6\x45\xe1\xed\x90\x09\x2b\xce\x59\x57\xf5\x36\xc4\x9a\x24\x3f\x7d\x87\x65\x95\x05\x7f\xfa\xc8\x22\x58\x61\x42\xe5\xed\x87\xff\x47\x58\x15\x61\x16\x97\x06\xa7\x8b\x65\xc9\xb9\x04\x20\x8e\x3f\x3f\x93\xef\xef\x11\x8a\x3f\x95\xde\x8d\xd8\x33\xac\xf0\x3f\x22\x6e\xdb\x7e\x28\xff\x36\xe7\x7b\x8e\xa6\x65\x68\x4c\xec\x82\x57\x04\xdf\xd6\x1c\xe8\xd3\xeb\xfe\x22\xb7\xd2\x98\xdb\xd2\xed\xa3\xf1\x83\x6d\x96\x3b\x1c\x3e\x09\x02\xdb\x64\x7f\xff\xd4\xbc\x4d\x74\xc6\xfc\xc8\x49\xd3\xa3\x8d\x65\xcd\x1a\xcb\x65\xc7\xfd\x8a\xe2\xc1\x74\x3a\xe2\xb3\x33\x3f\xd8\x27\xa9\x0d\x04\x8e\xe9\x22\x74\xc5\x1b\x8c\xd6\xef\x9b\x04\x1e\x4d\x5e\x3f\x58\xbf\x46\x6c\x3f\x41\x4d\x46\x4a\xf9\xf3\xda\xeb\xb9\x30\xd9\xa3\x1e\xec\x01\xdf\xa3\xe6\xfd\x67\x1d\xe7\xd1\x92\x55\x27\x9c\x6e\xe2\x79\xb8\x1d\xea\x4d\xcd\x4e\x3b\xf4\x0c\xf7\x53\xc3\x0a\x8d\xd5\x80\x31\x7d\x7a\x15\x6b\xa8\x3b\x53\x38\x6a\xa7\x64\x3b\xf3\x0a\x30\x05\x72\xc9\x33\x11\x82\x99\x66\x1b\x95\x70\x28\x81\x45\x94\xc1\xd1\x8e\x48\x3c\x09\x01\x01\xd2\xec\xad\x89\x96\xd9\x7a\x4d\xef\x4a\x2d\x2c\x11\xac\x59\x6a\x6b\x8a\xfe\xf5\xb8\x08\x37\x20\x95\xc0\x8a\x8b\x1e\x97\xbd\xa8\x85\x57\xd5\xe9\x0a\x47\x8e\x14\x9c\xd2\x84\x13\x88\xdf\x61\xb9\xe9\x49\xb1\x17\x1d\xbe\x08\x18\x98\x2b\x9d\x13\xd5\x63\xb3\xd6\x1c\xae\x94\xf3\x58\xab\x63\x58\x1a\x70\xfa\xa5\xe9\x69\x2d\x4d\x65\xbb\x21\xfc\xbf\xdd\x5a\xe3\x5d\x60\xd7\x05\x47\x6e\x4d\x6b\x4b\xcf\xc9\x2e\xee\xee\x07\x43\x6a\x3d\xe0\xec\x16\x78\x08\x79\x1b\x2c\xc8\x57\x2c\x60\x21\xf8\x9a\xc6\xe0\xaa\xb4\x4d\x96\xf4\xaf\xf1\x2e\xf0\xcb\x85\x5e\x76\xaf\x75\xdd\xab\xe2\x11\xd9\xbd\x4c\xd2\x19\xc9\xba\x01\xb8\x0b\x42\xa3\x19\xca\x0f\xf6\x30\xf7\xbe\x69\xca\x3e\xbb\x7b\x41\x7a\x33\x88\x0a\x97\x23\x80\x60\x92\x50\xf6\x59\x82\x68\xdc\xd4\xda\x3a\xab\x9e\x77\xc3\x44\xa7\xa6\x32\x0f\x8a\xbf\xc7\xb7\xf5\x9f\xa9\x80\xef\xb3\x15\x08\x06\x0a\xe4\x49\x04\x4c\x95\xef\x0a\xf4\x6c\xa4\xeb\xa0\xad\x5f\x4c\x59\x76\xd7\xb9\xd6\x77\xce\xad\xff\x3c\x42\xa5\x3e\xe8\x02\x4b\xf9\x95\x0b\x72\x92\xa9\x0d\x30\x45\xdb\xd8\x36\x97\x87\xb6\x16\xfa\xd7\x93\x72\x33\x20\x4d\x87\xa0\x99\xc7\xdf\xc3\xbd\xfb\x0e\xa1\xfe\xe9\xf3\xe8\x5f\xef\x16\xee\xf5\x21\xf4\x8e\xd7\x29\x16\x38\x01\x05\x42\x97\x5d\xb9\xf9\xb4\x3c\x59\xd4\x52\x5d\x2b\xb4\x3f\x5e\x8a\xd5\xc6\x35\x9e\x94\x9b\xf7\x70\xbf\xc0\x6a\x33\x70\xd9\xee\x7a\x8d\xeb\x3b\x43\x14\xdd\x4f\xa6\x21\x7f\x87\xe5\x07\x0d\xf5\x12\x42\x01\xca\x6e\xef\xdc\x5b\xf4\x4a\x51\x59\x12\xba\xba\x1a\x7b\x55\x1e\x5a\xc9\xea\x29\xed\x36\x0c\xb6\x7b\x57\x0d\xca\xb0\x8f\x1b\xd7\xd1\x00\x9b\x16\xd4\x75\x15\x9a\xe0\x08\x3e\xc1\x1a\x04\xb0\xd0\x65\xd5\x91\xb3\x5e\x83\x70\xf5\xe5\x72\xae\xd9\x2e\xf5\x5a\xdf\x2c\xa5\x23\xc8\xcd\x28\xdf\xa2\x5e\x1f\xe0\x95\xb7\xd9\x08\xd7\xf2\xfd\xe7\x01\xfa\xed\xf0\xc8\x57\xf1\x54\x85\xd5\x01\xd3\x82\x4e\x9f\xd0\x5c\xb3\xf5\x4f\x6e\xfa\x0f\xb8\x4c\xeb\x68\x78\x23\x78\x62\x84\x76\xed\x12\x78\x21\x0e\x37\xe5\x5b\x11\xef\x13\x60\xf2\x5f\x41\x95\x75\xe7\x8e\xd0\xa3\xb3\x9b\xfe\x0b\x9e\xb3\x50\x06\xfe\x11\x97\xfa\x82\xae\xe7\x55\x81\xb7\xdd\x90\xde\xd9\x11\xf2\x32\x41\x6d\x65\x44\xed\x21\x07\xd5\x03\xab\x08\x7c\x9f\xb1\xe5\x39\x7a\xfc\x3d\x1a\xf7\x47\xe7\x90\x1f\xac\x5f\x23\xb6\x3b\x54\x04\x83\xd7\x1c\xd5\xd6\xfe\xe1\xe1\xb4\x7a\x31\x7a\xce\x48\xca\x29\x53\x72\xba\x8a\xf9\x2a\xf0\x4b\x77\xd8\x75\x8e\xd8\xd5\xcd\x50\xed\x67\xd3\xed\x86\xf4\x7c\xad\x98\x8c\x67\xb3\x2a\x4a\x18\xa0\xe9\xe5\x52\xc7\xa3\x6e\x72\xde\xfe\x8e\x7e\xeb\x85\x09\x69\x16\xb5\xdb\xe6\x1d\xf2\xe2\xe1\x2d\x8a\x89\xfb\xdf\x2e\x17\x5e\x5b\x2a\x54\x86\xe3\x0b\x13\xe5\xd6\x1b\x4b\xbb\xa2\x3d\xed\xf2\xe9\x67\xbe\x70\x6a\x58\xaf\xfb\x01\x3f\x82\xcc\x77\xf6\xa6\xa1\x59\x71\xaf\xc9\x63\x67\x93\xce\xe0\x4e\x01\xd3\x81\x23\x5b\xee\x67\x4d\xc7\xb3\x50\x82\xff\x5d\xe7\x9c\x4e\xcd\x6d\x4f\x7c\xf2\x57\x26\x60\x7a\xde\x3f\x9f\x85\x4f\xd9\x47\x2e\x43\x41\x53\xe5\xae\xbf\xc3\x8c\xc4\x20\x2c\xdf\x7e\x35\xfd\xcd\x26\xc2\x99\xe2\x9f\xd3\x48\x60\x02\x17\x94\x71\x8b\xb2\xfb\x45\x0c\x4f\x82\x52\x94\x45\xdd\x7b\x66\xdd\x2c\x08\xae\x20\x54\x40\x96\x16\x41\xb3\x6c\x02\x22\x49\x30\x23\x57\xfc\xfc\x0e\xc2\x4c\x75\x8c\xe2\xcf\x32\x29\x66\x2b\xca\x66\x8c\x6f\xb2\x14\x99\x7f\x57\x58\x6e\xd0\x51\x88\xfe\xf0\xda\x8f\x33\x9e\xaa\x19\xd6\x60\xcc\x42\xce\x14\xa6\x0c\x84\x9c\xa5\x82\x6f\xa9\x56\x77\x2a\x37\xa8\x53\xae\x14\x30\xcc\xcc\x17\x35\x02\xbf\xbb\x22\xb3\x95\x34\x50\x51\xce\xe6\xa4\xbf\x5e\x0f\x4a\xe6\x4b\x3a\xfd\xe5\xd6\x53\xdd\x95\xf2\x7b\x25\xda\x55\xfa\x6b\x4c\x46\xc3\x0b\x95\x27\x57\x73\xd8\x30\x8d\xe0\x99\x82\x2b\x7d\xb0\xe1\xf5\xaa\x44\x54\xf3\x6b\x35\xbe\x0e\x93\x4a\x10\x5b\x1a\xc2\x42\x50\x16\xd2\x14\xc7\xa7\x31\x05\xa6\xe6\x64\x57\xca\xb2\xb9\xed\x53\x87\x46\xce\xa2\xfc\x3e\x8e\xe9\xf5\x5d\x0a\x85\x45\x04\xea\x9c\x6d\xa9\xe0\x2c\x01\xa6\xfa\x24\xd5\x10\xba\xe0\x31\x0d\x07\x24\x84\x31\xcf\xc8\x42\x5b\x9c\x80\xf8\x84\x15\x7c\xa0\x09\x55\xff\x59\x2c\x77\x25\xfd\x3d\x0b\x6f\x2b\xe5\x5f\xbf\x46\xb3\x2d\x16\xb3\x98\x47\xb5\x57\xc5\x99\x7e\xeb\x7f\xd4\xba\x54\xcc\x23\xf4\xea\xf5\x8b\x97\xe8\xc5\x1f\x1e\x7a\xd1\xa9\x86\x4d\xf9\x99\x20\x84\x50\x31\xf9\xdf\x00\xd8\x9a\x97\x3b\xf2\x27\x00\x00")
 
 func kubernetesagentresourcesvmasTBytes() ([]byte, error) {
 	return bindataRead(
@@ -587,7 +588,7 @@ func kubernetesagentresourcesvmasT() (*asset, error) {
 	return a, nil
 }
 
-var _kubernetesagentvarsT = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x93\x4d\x8f\xd3\x30\x10\x86\xef\xfc\x0a\x2b\x17\xc7\x92\xbb\x5b\x90\x38\xc0\xad\x5a\x38\xf4\xd0\xa5\x22\xa8\x1c\x10\x87\x69\x32\x29\x96\x12\x7b\xe5\x71\xba\x85\xa8\xff\x1d\x39\xa1\xbb\x36\xf9\xd0\x82\x7a\x69\x13\xcd\x3b\xcf\x7c\xbc\x93\xb6\x55\x25\xbb\x59\x53\xe6\x8c\x85\x03\xae\xf2\xdc\x34\xda\x9d\xcf\xaf\x18\x63\x2c\x69\xdb\x9b\x7b\xa8\xf1\x7c\x8e\xc3\x9f\xca\x92\xd0\x25\xef\x59\xf2\xad\x6e\xaa\xf4\x08\x56\xc1\xbe\x42\x4a\x79\x0d\xa7\x58\x4a\x5b\xb4\xab\x03\x6a\xc7\x85\x0c\x74\x4f\xe0\xb5\x2e\xf0\xc4\x85\xf8\x9e\xc8\xd9\x92\x74\xe7\x7f\xbb\x92\x50\x14\x69\xa1\x8e\xe9\x18\xae\x53\x71\x21\x59\xdc\xd3\x6e\xe3\xfb\x88\x89\x5c\x08\xc9\x6a\x53\xa4\x9e\xe7\xff\xaf\xc0\x7b\x23\x24\xbb\x22\xee\xb5\xe8\x17\xd3\xb6\xa8\x8b\x81\x27\xcf\x1b\x79\x00\x0b\x35\x3a\xb4\x23\xb5\x86\x8b\x0d\xdc\x1b\x4d\xec\xe3\x63\x99\xab\x23\xa8\x0a\xf6\xaa\x52\xee\x67\xf6\x07\x91\x1b\x9d\x83\x0b\xd2\x17\x10\xab\x16\x3c\x1a\x57\x43\x8d\x59\x53\x96\xea\xe2\xfa\xe5\x02\xbf\x2a\x5d\x98\x47\xba\x8c\xf9\xa8\xf4\x67\x24\xd3\xd8\x1c\x3d\x77\x6b\xb1\x54\xa7\x84\xf9\x92\xd4\xec\xc9\x59\xa5\x0f\xe9\x04\x57\xb2\xa5\x64\x6f\x47\x06\xd8\x6d\x02\x56\xd0\x7d\xc0\x19\xad\xeb\x91\x1c\x72\xe2\xbd\xbd\xef\x96\xcb\xd9\x63\xbe\x98\x56\x11\x0e\x5c\x7b\x41\x0b\xc6\xe6\x3f\x90\x9c\x05\x67\xac\x17\x77\xd5\x17\xcf\x0b\x9e\x5e\xa8\xd7\x75\xc6\xb1\x89\x9b\xd9\x6d\x32\xf5\x0b\xa7\xbd\xef\xe3\x3c\x32\xe6\xae\x21\x67\xea\xdd\xfd\xc7\x2f\x43\x9c\x46\x97\x35\x7b\x8d\x6e\xfd\x61\x06\x1a\xa8\xc6\xce\xaa\x8f\xf9\xe7\xff\x67\xf8\xf8\x16\xac\xa3\x0e\x41\x0f\x95\x72\xe9\x0b\x40\x92\xdf\x52\xf7\x42\xb7\x7c\xd6\xb5\xbf\x07\x0d\x0c\x38\xfe\xdb\x78\x41\x26\x3d\x45\x78\xf4\x9d\xff\x0e\x00\x00\xff\xff\xdf\xd4\xcf\xd3\x95\x05\x00\x00")
+var _kubernetesagentvarsT = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x92\x4f\x8f\xda\x30\x10\xc5\xef\x7c\x0a\x2b\x17\x07\x29\xb0\x5c\x7a\x68\x6f\x68\x5b\x55\x1c\x76\x8b\x9a\x8a\x1e\x56\x3d\x0c\xc9\x84\x5a\x4a\xec\x95\x67\xc2\xd2\x5a\xfe\xee\x95\xc3\x12\x39\x25\xa4\x7f\x6e\xa0\x37\xf3\x7b\xf3\xfc\xe2\x9c\xaa\xc4\x72\x43\x39\x1b\x0b\x07\x5c\x17\x85\x69\x35\x7b\x3f\x13\x42\x88\xc4\xb9\xe5\x23\x34\xe8\xfd\x50\xfe\x54\x55\x84\x9c\xbc\x13\xc9\x53\xd3\xd6\xe9\x11\xac\x82\x7d\x8d\x94\xca\x06\x4e\xc3\x51\xda\xa2\x5d\x1f\x50\xb3\x9c\x67\xd1\x5c\x0f\xde\xe8\x12\x4f\x72\x3e\xff\x96\x64\x93\x96\x74\x1f\x68\xc1\xd2\xb9\x8f\xc8\x63\xa2\x58\x7a\x9f\x64\x33\xe7\x50\x97\x57\x01\xfa\xf5\xa7\x67\xb0\xd0\x20\xa3\x8d\xcf\xe8\x64\x39\x72\x45\x14\x75\x74\xf1\xac\x8f\x6d\xae\x8f\xa0\x6a\xd8\xab\x5a\xf1\x8f\xfc\x15\x51\x18\x5d\x00\x47\xeb\x0b\x18\x4e\x2d\x64\x26\xa2\x67\xd2\xd0\x60\xde\x56\x95\xba\x3c\xd1\xa5\xae\xaf\x4a\x97\xe6\x85\x2e\x31\x5f\x94\xfe\x8c\x64\x5a\x5b\x60\x30\xdf\x5a\xac\xd4\x29\x11\x21\x2e\xb5\x7b\x62\xab\xf4\x21\xbd\xc1\xcd\xc4\x2a\x13\x6f\x46\x02\xec\x1e\x22\x56\x74\x7d\xc4\x19\xf5\x0d\x48\x09\x05\xc9\x4c\x40\x59\xa6\x6f\x57\xab\xc9\xe6\x5f\x73\x61\x4d\xe8\xfd\xbf\x9f\x60\x6c\xf1\x1d\x89\x2d\xb0\xb1\xe1\xde\xce\x7d\xd1\x13\x26\x1e\x34\xcc\x75\xc5\x89\x1b\xdf\xcc\xee\x21\x57\x3f\xf1\x76\xf7\x67\x5d\x0e\x8a\xb9\x6f\x89\x4d\xb3\x7b\xfc\xf0\xe5\x1a\xa7\x91\xf3\x76\xaf\x91\x37\xef\x27\xa0\xd1\xd4\xd8\x67\x75\x26\x84\xdf\xff\xcf\x08\xfa\x16\x2c\x53\x87\xa0\xe7\x5a\x71\xfa\x17\xa0\x4c\xde\x51\xf7\x87\xee\xe4\x64\x6b\xd1\x56\xe7\x10\x15\x70\xfc\xc3\x69\xbf\xc5\x8b\x36\xa9\x57\x7a\x73\x5d\x7a\x3f\xfb\x35\x00\x26\x1b\xe1\x6e\xc2\x04\x00\x00")
 
 func kubernetesagentvarsTBytes() ([]byte, error) {
 	return bindataRead(
@@ -607,7 +608,7 @@ func kubernetesagentvarsT() (*asset, error) {
 	return a, nil
 }
 
-var _kubernetesbaseT = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x54\x4d\x4f\xdb\x40\x10\xbd\xe7\x57\x8c\xb6\x48\x01\x29\x38\x49\xa5\x5e\x22\xf5\x00\xe5\x50\xd4\x0f\xa1\x42\xe9\x01\x71\x18\xec\x49\xd8\x62\xef\x5a\x3b\xe3\xa4\xd4\xda\xff\x5e\xad\x6d\xfc\x01\xa1\xd0\x54\xbe\x79\xdf\xbc\xf7\x66\xe6\xed\x96\x23\x00\xb5\xc7\xf1\x2d\x65\xa8\x16\xa0\x6e\x45\x72\x5e\x4c\xa7\xf5\x9f\x28\x43\x83\x2b\xca\xc8\x48\x84\xbf\x0b\x47\x51\x6c\xb3\xe6\x8c\xa7\x6f\x67\xf3\x77\x87\xb3\xf9\xe1\x6c\x3e\x4d\x28\x4f\xed\x7d\xc0\x5d\x50\x96\xa7\x28\x14\xfd\x64\x6b\xde\xa8\x49\xe0\x8f\xad\x11\x32\x72\x49\x8e\xb5\x35\x41\x66\x1e\xcd\xc2\x57\x1f\xe7\xe8\x30\x23\x21\xc7\x6a\x01\xc1\x10\x40\x59\x3a\x34\x2b\x82\xe8\x68\x45\x46\xce\xac\x4d\xcf\x9c\x5d\xea\x94\xd8\xfb\xb2\x94\x46\x03\x14\x86\xe3\xaa\x9e\x23\x51\x10\x79\x3f\x29\x4b\x32\x89\xf7\x0d\x8d\x5e\x42\xf4\x11\xf9\x87\x36\x89\xdd\x70\xf3\x1b\x40\xdd\x15\x37\x74\xac\x0d\x3a\x4d\x7c\x7e\x74\xfe\xfd\xdb\xe7\x56\xbb\x2e\x3c\xb3\x79\x11\x34\x3e\xa4\xc8\xac\xe3\x2f\x36\xa1\x13\x5a\x62\x91\xca\x25\xa6\x05\x6d\x65\x68\xe9\x01\x54\x46\x82\x09\x0a\x0e\x68\x01\x54\x42\x1c\x3b\x9d\x4b\x33\x88\x8b\x5b\x82\xc4\x6e\x4c\x6a\x31\x81\xc2\xa5\xb0\xb4\x0e\x02\xb5\x33\x24\xc4\xb0\xa9\x8d\xc3\x4d\xa3\x14\xa9\x96\xcc\x4f\x3a\x35\xb9\xcf\x29\xd0\xb1\x38\x6d\x56\x0f\x98\x16\x31\x30\xdb\xad\x61\xe7\x7e\x1f\x28\x76\x68\xf8\xd3\xf3\xbd\xc1\xba\xa1\xdd\xa5\xc5\x7e\x2a\x1a\xe2\x36\x17\xbe\x41\x0d\xa3\xd1\xe1\x33\x64\x21\x37\x8c\xd1\x13\x50\xb7\x94\x01\x70\xd4\x78\x50\x6b\x74\x1a\x6f\x52\x7a\x1a\xe2\x3d\x6d\x12\xfa\x35\x81\xbd\x2a\xad\xb0\x78\xbf\x35\xd6\x5d\xa7\x65\x19\x7d\xc5\x8c\xbc\x3f\x0d\x75\x81\xae\xac\x29\x7c\x6f\x1e\xdb\xad\x55\x0a\x6b\x74\x3d\x77\x0f\xf0\x70\x13\x4e\xf9\x5c\xac\xc3\x15\x1d\xc5\xb1\x2d\x8c\xf4\x00\xbd\xcb\x72\xa2\xf9\x8e\x07\x47\x7d\x53\x27\x28\xd8\x94\x87\x1f\x61\x25\x57\xb1\x35\x31\xca\x7e\x3b\x82\xfd\x31\x0f\x74\x8e\x91\x29\x80\xc7\x07\x13\x18\x87\x90\x74\x1d\x8d\x0f\xae\xd5\x64\xe0\xa2\xdb\xd1\x63\xe9\xff\x92\xc5\x95\x91\x47\xb2\x30\xda\xa6\xfa\x5c\x4a\xba\x29\xd7\x79\x19\x8e\xb9\x0e\x81\x23\xb6\x85\x8b\xab\x10\x5c\xbd\xf8\x92\x8d\x86\xbb\x79\xfc\x48\x3d\x27\xbf\xd1\xa6\xda\x73\x2b\xb6\xce\x70\x98\xdb\xaa\x89\x94\xe9\x45\xaa\xd7\xf0\xfc\xf3\x60\x5a\xbe\x6e\x3a\xd7\xd5\x74\x6c\x21\x79\x21\xaf\x7b\xe5\xb7\x5c\xeb\xca\x6c\xc3\xd1\xcf\xf7\xdf\xaf\xf5\x93\x02\x3f\xf2\x7f\x02\x00\x00\xff\xff\x38\x94\xc7\x8e\xf6\x06\x00\x00")
+var _kubernetesbaseT = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x94\xcf\x6e\xdb\x30\x0c\xc6\xef\x7e\x0a\x42\xeb\x31\x75\x92\x01\xbb\x04\xd8\xa1\x5b\x81\xad\xd8\x1f\x14\x6b\xd7\x1d\x86\x1d\x58\x9b\x71\xb5\xda\x92\x21\xd1\xc9\x3a\x41\xef\x3e\xc8\x56\x1c\xbb\x49\xd0\x2c\x48\x0e\x86\x44\xfd\xf8\x91\xfc\x24\x97\x00\x88\x33\x9b\x3d\x50\x85\x62\x01\xe2\x81\xb9\xb6\x8b\xe9\xb4\x5b\x49\x2b\x54\x58\x50\x45\x8a\x53\xfc\xdb\x18\x4a\x33\x5d\xc5\x3d\x3b\x7d\x3d\x9b\xbf\x39\x9f\xcd\xcf\x67\xf3\x69\x4e\x75\xa9\x9f\x42\xdc\x2d\x55\x75\x89\x4c\xe9\x6f\xab\xd5\x2b\x31\x09\xfc\x4c\x2b\x26\xc5\x77\x64\xac\xd4\x2a\xa4\x99\xa7\xb3\xf0\xeb\xb6\x6b\x34\x58\x11\x93\xb1\x62\x01\x41\x10\x80\x73\x06\x55\x41\x90\x5e\x14\xa4\xf8\x5a\xeb\xf2\xda\xe8\xa5\x2c\xc9\x7a\xef\x1c\xc7\x1c\x20\x30\x6c\xb7\xe7\x6d\xca\x02\x52\xef\x27\xce\x91\xca\xbd\x8f\x18\xb9\x84\xf4\x23\xda\x1f\x52\xe5\x7a\x6d\xe3\x32\x80\x78\x6c\xee\xe9\x9d\x54\x68\x24\xd9\x9b\x8b\x9b\xef\xdf\x3e\xf7\xb9\xc3\xdf\xb9\x6b\x5d\x37\xa1\x8e\xf7\x25\x5a\x2b\xb3\x2f\x3a\xa7\x4b\x5a\x62\x53\xf2\x1d\x96\x0d\xed\x25\xf4\x78\x00\x51\x11\x63\x8e\x8c\x23\x2c\x80\xc8\xc9\x66\x46\xd6\x1c\x1b\x71\xfb\x40\x90\xeb\xb5\x2a\x35\xe6\xd0\x98\x12\x96\xda\x40\x40\x1b\x45\x4c\x16\xd6\x9d\x70\xb8\x8f\x99\x52\xd1\xc3\xfc\xa4\xff\x14\xfc\x54\x53\xe8\xab\x65\x23\x55\x21\x92\x67\x11\x23\xb1\xdb\x31\x9c\x5c\xef\x06\x71\x42\xc1\x9f\x0e\xd7\x06\xab\x88\x3d\xa5\xc4\xa1\x2b\x22\xb8\xf7\x85\x8f\x51\x63\x6b\x6c\xe3\x2b\xb4\x4c\x66\x6c\xa3\x9d\xa0\xed\x50\x46\x81\x49\xd4\x20\x56\x68\x24\xde\x97\xb4\x6b\xe2\x33\xa9\x72\xfa\x33\x81\xb3\xd6\xad\xb0\x78\xbb\xd7\xd6\xb1\x0e\x00\xe1\x5c\xfa\x15\x2b\xf2\xfe\x2a\x9c\x0b\x38\xd7\x21\x36\xb2\x0e\x4b\x6b\x33\xac\xd0\x0c\xd4\x6d\xc2\xc3\x4d\xb8\xb2\x37\xac\x0d\x16\x74\x91\x65\xba\x51\x3c\x08\x18\x5c\x96\x4b\x69\x1f\x87\x82\xc6\xa2\x2e\x91\x31\x1e\x0f\x0b\xc1\x75\xce\x7d\x20\x1e\x93\xc3\x16\x88\xd6\x0f\xb1\x7e\xef\xc5\x56\xfd\x78\x16\xcf\x53\x1c\x8b\xc7\x42\xf1\x10\x0f\xc9\x3e\xfa\xf8\x7b\x5f\xd7\xba\xf9\x8f\xdb\xd6\xb6\x5a\x18\xb2\xba\x31\x59\x3b\xd4\x9f\x2f\xbe\x4c\xc9\xb8\xd7\xcf\x1f\x9d\x43\xe9\xd7\x32\x3c\xb2\x8a\xfb\x64\xab\x0a\x37\x4a\x36\x2d\x73\x8e\x4a\x4b\x2f\xa2\x8e\xe1\xfc\x77\x63\x7a\x5e\x64\x25\x00\xbf\x82\x2e\xa1\x1b\xae\x1b\x3e\xee\xd5\x4e\x76\x65\xb7\x62\x23\x63\x8b\x3e\xac\xab\x1b\xd3\xce\x01\x9f\xf8\x7f\x03\x00\x92\x6d\xd2\xae\xc6\x06\x00\x00")
 
 func kubernetesbaseTBytes() ([]byte, error) {
 	return bindataRead(
@@ -627,7 +628,7 @@ func kubernetesbaseT() (*asset, error) {
 	return a, nil
 }
 
-var _kuberneteskubeletService = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x54\x4d\x6f\xea\x38\x17\xde\xe7\x57\x58\xa8\x8b\xf7\x5d\x98\xdc\xf9\x58\x71\xe5\x05\x2d\x6e\x07\x95\x81\x8a\x80\xee\xa2\xad\x90\x13\x1f\x12\x0f\x8e\x9d\x39\xb6\xe1\x32\x73\xfb\xdf\x47\x81\xd0\x92\x40\x47\x1a\x45\x8a\xec\xe7\x9c\xe7\x39\x1f\x3e\xf6\xf3\xd2\x28\xff\x1a\x8d\xc0\x65\xa8\x2a\xaf\xac\x61\x8f\x21\x05\x0d\x3e\x9a\xc3\x9f\x41\x21\x38\x26\x6d\xb6\x01\xec\x3b\xc0\xad\xca\x20\x1a\xae\x3d\x60\x17\x8c\x9e\x93\xe3\xea\x35\x9a\x83\xf3\x02\x3d\x13\x7a\x27\xf6\x2e\xe2\x66\xab\xd0\x9a\x12\x8c\xbf\x57\x1a\x58\x0c\x3e\x8b\x25\xac\x45\xd0\x3e\xde\x34\xb1\x92\x90\x65\xe0\x1c\xff\xae\x7c\xe2\x85\x0f\x8e\xfd\xf4\xeb\x2f\x11\xff\x0e\x59\x52\x6b\x3d\x21\xb0\x38\x55\x26\x4e\x85\x2b\x48\x6c\x2b\x1f\x8b\xbf\x02\x42\x9c\x59\xe3\x85\x32\x80\xee\x24\xd5\x77\xc5\x15\x5e\xb9\x91\x0a\x09\xad\x48\xbc\x15\x18\x6b\x95\xbe\x47\xfe\x24\x06\xcd\x48\x4f\xad\xc9\x33\xb9\xf9\x5f\x69\x83\xf1\xe4\x07\xc9\x11\x2a\xf2\xd2\xeb\x2a\xbc\xf4\xc8\x0f\xb2\xcb\x08\xd5\xff\x27\x54\x03\xf9\x42\x5e\xc9\x57\xe2\x0b\x30\xe4\x18\xfa\x40\xa7\x34\x55\x46\x5e\x84\xbf\x04\xbe\x92\xb5\xea\x5d\xab\xa0\x91\x29\xc5\x06\xa8\x2b\x04\xc2\xa5\x5a\x9b\x46\x63\x57\x13\x21\xf5\x22\xd5\xe0\x08\xf5\xc4\x88\x5a\x42\x2b\x77\xdd\x55\x55\xff\xee\xca\xe2\xe0\xf0\x90\xcd\xf1\xf4\x09\x06\x43\x5e\x22\x42\x28\x35\xe0\x59\x61\x9d\x6f\xb6\x95\x92\xad\x2d\xaa\xad\xd2\x90\x83\x6c\x00\x2c\x9b\xc5\xd6\xea\x50\x02\x8b\x25\x6c\x07\xf5\xaf\x03\xbb\xbd\x1b\x1c\x7e\x68\x3b\x96\xba\x74\x0c\x66\xf0\xbe\xc0\xdd\x15\x8f\xba\x39\xc7\x5c\xe3\x41\x07\xf8\x9c\xd0\x74\xf3\x8c\xd1\x20\x83\xa6\xef\x57\x68\x36\x1f\xbc\x2f\x2e\x84\xeb\x89\xaf\x25\xd0\x80\x07\x17\x0f\x3a\xc0\x65\x71\x0e\xb7\x6d\x42\x1b\xa8\x09\x37\xa3\xd9\xdd\x23\x9f\xaf\x66\x4f\x8b\xe4\xc0\x26\xe4\xe6\xef\xc7\xe5\x2d\x9f\xf0\xc5\x6a\xfc\xfb\xf0\x81\xbf\x35\x30\x21\x71\xb1\xaf\x00\x6b\x3e\x39\x8d\xd9\xc9\x54\x47\xad\xb1\xcc\x9a\xb5\xca\x2f\x7b\xf0\x61\x6b\x51\xf0\xf8\x36\xd0\x4f\xcc\x95\x95\x54\x99\x35\x0a\xfa\x7e\x41\xa9\x2a\x45\x0e\xac\xf7\x91\xe4\xd3\x6c\xb4\x1a\x4f\xef\xe7\xc3\xd5\xdd\x6c\xba\x18\x8e\xa7\x7c\xde\x24\xde\x6b\x89\x09\x29\x11\x9c\x63\x5f\xfa\x87\xaf\x6d\xd3\xda\xee\xce\xc6\x8b\x79\x0c\xd0\xf2\x00\x53\x8f\x34\xad\xdf\x29\xc0\x6b\x16\x09\x69\xc8\x73\x65\x72\x5a\x08\x23\x35\xa0\xbb\x28\xa5\x14\x46\xad\xc1\x79\x5a\x09\x5f\x5c\x1c\xe7\xc9\xda\xe6\x65\x3a\x38\x0f\x48\xa5\x71\xec\xa3\xe6\xbb\xc9\x32\x59\xf0\xf9\x6a\x34\x4d\xde\xae\xbb\xdb\x52\x28\xc3\x9a\x6d\x5f\xdb\x4c\xe8\x4e\xe7\x73\x75\xf0\x74\x59\x01\x32\xe8\xba\x86\xb3\x00\x73\xfe\x30\x3e\x44\x48\xee\x7e\xe3\xa3\xe5\x64\x78\x3b\xe1\xed\x48\xc6\x4a\xa0\x5a\xa4\xa0\xdd\xf9\x69\x4c\x67\x23\xbe\x9a\x0c\x6f\xf9\x24\xe9\xf4\x3f\xd3\x36\x48\x5a\xa1\xdd\x2a\x09\xc8\x0e\x0f\xef\x15\x87\xd3\x04\x75\xba\x73\x70\xef\xff\xe1\xac\x69\x1f\x5c\x0d\x9f\x4d\xc7\xb1\x2c\xdc\xff\x47\x99\x42\x28\xac\x94\xa1\xa5\x95\xc0\x2a\xb4\xa5\x72\x59\xb0\xc1\xd1\x14\x95\xcc\xdb\x69\x1a\xf0\x3b\x8b\x1b\x5a\xe9\x90\x2b\x73\xd6\xb3\x29\x5f\x7c\x9b\xcd\x1f\x57\x4f\x93\xe5\xc3\x78\xda\xee\xd6\x96\xfd\x7c\x76\xaf\xee\xf9\x70\xb1\x9c\xf3\xd5\xc3\x70\xc1\x93\xb7\x28\x7a\x1e\x1b\xe7\x85\xd6\xaf\xd1\x37\x61\x3c\xc8\xdb\x3d\x2b\x83\xf6\x8a\x06\x07\xd8\xf7\x02\x73\xf0\xd1\x3f\x01\x00\x00\xff\xff\x2c\xc5\x16\x6e\x63\x07\x00\x00")
+var _kuberneteskubeletService = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x54\xdf\x6f\xe2\x38\x10\x7e\xcf\x5f\x61\xa1\x3e\xdc\x3d\x98\xec\xfd\x78\x62\x95\x07\x0a\x2e\x87\xca\x41\x95\xc0\xee\x43\x5b\x21\x27\x1e\x12\x1f\x8e\x9d\x1b\xdb\xb0\xdc\x6d\xff\xf7\x53\x42\xda\x12\xa0\x27\xad\x22\x45\xf1\xf7\xcd\x37\x9f\x67\x3c\xf1\xe3\x4a\x4b\xf7\x1c\x8c\xc1\x66\x28\x2b\x27\x8d\x8e\xee\x7d\x0a\x0a\x5c\x10\xc3\xdf\x5e\x22\xd8\x48\x98\x6c\x0b\xd8\xb7\x80\x3b\x99\x41\x30\xdc\x38\xc0\x73\x30\x78\x4c\x8e\xf4\x73\x10\x83\x75\x1c\x5d\xc4\xd5\x9e\x1f\x6c\xc0\xf4\x4e\xa2\xd1\x25\x68\x77\x27\x15\x44\x21\xb8\x2c\x14\xb0\xe1\x5e\xb9\x70\xdb\x7a\x25\x3e\xcb\xc0\x5a\xf6\x4d\xba\xc4\x71\xe7\x6d\xf4\xcb\xef\xbf\x05\xec\x1b\x64\x49\x9d\xeb\x01\x21\x0a\x53\xa9\xc3\x94\xdb\x82\x84\xa6\x72\x21\xff\xc7\x23\x84\x99\xd1\x8e\x4b\x0d\x68\x5f\x53\xf5\x6d\x71\x45\x57\x6e\x85\x44\x42\x2b\x12\xee\x38\x86\x4a\xa6\x6f\xce\x1f\x78\xd0\x8c\xf4\xe4\x86\x3c\x92\x9b\x9f\x4a\xe3\xb5\x23\xdf\x49\x8e\x50\x91\xa7\xde\x79\x86\xa7\x1e\xf9\x4e\xf6\x19\xa1\xea\x67\x42\x15\x90\x4f\xe4\x99\x7c\x26\xae\x00\x4d\x8e\xd6\x8d\x9c\xd2\x54\x6a\x71\x61\x7f\x09\x7c\x26\x1b\xd9\xbb\x56\x41\x9b\xa6\xe4\x5b\xa0\xb6\xe0\x08\x97\xd9\xba\x32\x1a\xda\xda\x1f\x52\xc7\x53\x05\x96\x50\x47\x34\x77\x84\x52\x25\xed\xf5\x50\x59\xfd\x7f\x68\x14\x7a\x8b\xcd\x6e\x8e\xa7\x4f\xd0\x6b\xf2\x14\x10\x42\xa9\x06\x17\x15\xc6\xba\x76\x59\x49\xd1\x59\xa2\xdc\x49\x05\x39\x88\x16\xc0\xb2\xfd\xd8\x19\xe5\x4b\x88\x42\x01\xbb\x41\xfd\x3a\x83\xed\xc1\x0e\x9a\x17\x9a\x33\xa6\xee\x1b\x7a\x3d\x78\xfb\xc0\xfd\x95\x88\xba\xb3\xc7\xbd\x86\x83\x33\xe0\x63\x41\xdb\xcd\x70\x70\x8e\x0c\xda\xbe\x5f\x91\x99\xbc\x8d\x36\xf9\x65\xe2\x7a\xe2\xeb\xa4\xa8\xc1\x81\x0d\x07\x67\xc0\x65\x71\x16\x77\x5d\x41\x17\xa8\x05\x37\xe3\xc5\xe8\x9e\xc5\xeb\xc5\xc3\x32\x69\xea\x20\xe4\xe6\xdf\xfb\xd5\x2d\x9b\xb1\xe5\x7a\xfa\xe7\x70\xc2\x5e\x5a\x98\x90\xb0\x38\x54\x80\xb5\x9e\xb4\x95\xbc\x51\xb5\x6b\x8d\x65\x46\x6f\x64\x7e\xd9\x83\x77\xae\x23\xc1\xe3\xdd\x40\x3f\xa0\x2b\x23\xa8\xd4\x1b\xe4\xf4\xed\x07\xa5\xb2\xe4\x39\x44\xbd\xf7\x4d\x3e\x2c\xc6\xeb\xe9\xfc\x2e\x1e\xae\x47\x8b\xf9\x72\x38\x9d\xb3\xb8\xdd\x78\xaf\x93\x8c\x0b\x81\x60\x6d\xf4\xa9\xdf\x3c\x5d\x4e\x29\xb3\x3f\x19\xaf\xc8\xa1\x87\x4e\x04\xe8\x7a\xa4\x69\x7d\x4f\x01\x5e\x63\x04\xa4\x3e\xcf\xa5\xce\x69\xc1\xb5\x50\x80\xb6\x13\x55\x97\x52\x72\x2d\x37\x60\x1d\xad\xb8\x2b\x2e\x8e\xf3\x95\xed\xea\x32\xe5\xad\x03\xa4\x42\xdb\xe8\xbd\xe6\xd1\x6c\x95\x2c\x59\xbc\x1e\xcf\x93\x97\xeb\xe1\xa6\xe4\x52\x47\xed\xb2\xaf\x4c\xc6\x55\x27\x10\x21\x97\x4d\x62\x9b\x15\x20\xbc\xaa\xab\x3b\x31\x88\xd9\x64\xda\x38\x24\xa3\x3f\xd8\x78\x35\x1b\xde\xce\x4e\x06\xa1\x76\xd2\x46\x00\x55\x3c\x05\x65\x4f\x4f\x63\xbe\x18\xb3\xf5\x6c\x78\xcb\x66\xc9\x59\xff\x33\x65\xbc\xa0\x15\x9a\x9d\x14\x80\x51\x73\xf1\x5e\x09\x78\x9d\xa0\xb3\xee\x34\xe1\xfd\xbf\xac\xd1\x1d\x4d\x03\x9f\x4c\xc7\xb1\x2c\x3c\xfc\x60\x9a\x82\x4b\xac\xa4\xa6\xa5\x11\x10\x55\x68\x4a\x69\x33\x6f\xbc\xa5\x29\x4a\x91\x77\x27\x41\x83\xdb\x1b\xdc\xd2\x4a\xf9\x5c\xea\x93\x9e\xcd\xd9\xf2\xeb\x22\xbe\x5f\x3f\xcc\x56\x93\xe9\xbc\xdb\xad\x5d\xf4\xeb\xc9\x7f\x75\xc7\x86\xcb\x55\xcc\xd6\x93\xe1\x92\x25\x2f\x27\x04\xfb\x32\x1d\x2d\xa7\x8b\xf9\xfa\x6e\x36\x9c\x74\x98\x98\x25\x2c\xfe\xc2\xc6\x97\x4c\x3d\xf2\xcb\x16\x0e\x82\xc7\xa9\xb6\x8e\x2b\xf5\x1c\x7c\xe5\xda\x81\xb8\x3d\x44\xa5\x57\x4e\x52\x6f\x01\xfb\x8e\x63\x0e\x2e\xf8\x6f\x00\x7a\x37\x67\xa4\xae\x07\x00\x00")
 
 func kuberneteskubeletServiceBytes() ([]byte, error) {
 	return bindataRead(
@@ -647,6 +648,26 @@ func kuberneteskubeletService() (*asset, error) {
 	return a, nil
 }
 
+var _kubernetesmasterEtcdYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x51\x49\xaf\xd3\x30\x10\xbe\xe7\x57\x8c\x7c\x79\x27\xcb\xe2\x6a\x01\xd2\x93\xa8\x38\x75\x11\x95\x38\x42\x27\xf6\x40\xad\x78\x93\x3d\x09\xf4\xdf\x23\x87\xb4\x2a\x5d\x78\xf2\x25\x33\xf9\x56\x1b\xb3\xfb\x4a\xa5\xba\x14\x35\x88\xe9\x9d\xe8\x06\x17\xad\x06\xb1\x4b\x56\x74\x81\x18\x2d\x32\xea\x0e\x20\x62\x20\x0d\x82\xd8\x58\xb1\x8c\x35\xa3\x69\xbb\x61\xec\x49\xd6\x53\x65\x0a\xed\x97\xc7\x9e\x7c\x6d\x1c\x00\x76\x54\x34\x98\x14\xb9\x24\x2f\xb3\xc7\x48\xf3\xde\xa4\x90\x53\xa4\xc8\x1a\x9a\x62\x57\x33\x99\xc6\x38\xa6\xca\x1b\xe2\x5f\xa9\x0c\x1a\xb8\x8c\x0d\xdd\xd8\xe8\x22\x95\x45\x53\xde\x66\x69\xc7\x05\xfc\xd9\x76\xef\x5b\x98\x12\x89\xa9\xae\xd8\xd8\x7d\x26\xf3\xf1\x8c\x31\x29\x04\x8c\x56\x2f\x23\x80\x04\xa1\x7a\x17\x55\x3d\x9e\x21\xf3\x4e\x9a\x7f\x46\xfa\x4d\x06\xd4\x58\x8b\xf2\xc9\xa0\x9f\x19\xcd\x1a\x0e\x95\x2c\xc8\x08\x2f\x55\x7d\xfb\xf4\xba\x5a\x6f\x37\xdf\x5f\xbf\x7c\xde\x7f\x50\x2a\xbf\x80\x22\x36\xca\xd2\x0f\x1c\x3d\xb7\x6f\x7b\x38\x8b\x4e\xc9\x8f\x81\xd6\x69\x8c\xbc\x34\xba\x6d\x25\x17\x9e\xbc\x6e\xd8\x4e\x68\xa4\x1d\xf2\x51\x83\xb8\x33\xb8\x06\x16\x42\xbb\x8d\xfe\x74\xb9\xc4\x1b\x93\x09\x8b\xf4\xae\x9f\x0d\xac\xab\xc3\x53\x93\x09\x8b\xf2\xae\x57\xd7\xc0\xbf\x05\x1e\xbc\xc6\xc3\xdc\xed\x49\xe7\xc8\x17\x8b\xfc\xdf\x02\x6f\x66\x7c\x2e\x78\x1f\xf6\xcf\x00\x57\x5b\x99\x67\xdf\x02\x00\x00")
+
+func kubernetesmasterEtcdYamlBytes() ([]byte, error) {
+	return bindataRead(
+		_kubernetesmasterEtcdYaml,
+		"kubernetesmaster-etcd.yaml",
+	)
+}
+
+func kubernetesmasterEtcdYaml() (*asset, error) {
+	bytes, err := kubernetesmasterEtcdYamlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "kubernetesmaster-etcd.yaml", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _kubernetesmasterKubeAddonManagerYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x90\xcd\x4e\xc3\x30\x0c\xc7\xef\x7d\x0a\x6b\xf7\xaa\x70\xd8\x25\x42\x48\x3c\x40\x61\x12\x12\x77\x93\x58\x5b\xd4\x25\x0e\xb1\x53\xd4\xb7\x47\xe9\x17\xdb\x65\xc7\xd8\xbf\xff\x87\x83\xc9\x7f\x51\x16\xcf\xd1\xc0\xf8\xdc\x0c\x3e\x3a\x03\x27\x76\x4d\x20\x45\x87\x8a\xa6\x01\x88\x18\xc8\xc0\x50\xbe\xa9\x45\xe7\x38\xb6\x01\x23\x9e\x29\xaf\x2b\x49\x68\xb7\xbd\x4c\xa2\x14\x1a\x80\xf1\xc6\x55\x12\xd9\xea\x73\x61\xd1\x77\xd2\x5f\xce\x83\x01\xcd\x85\x1a\x00\xcb\x51\xd1\x47\xca\x52\x89\xf6\x51\x16\x80\x0f\x78\x26\x03\x2f\x75\x9d\x23\x29\xc9\x5b\x65\xfa\x05\xf9\x4c\x64\x5f\x67\x2e\x93\x70\xc9\x96\x66\xcf\x65\xf0\x53\x48\x74\x7f\x03\xd8\x54\x0c\x1c\xc3\xfe\x0e\x14\x38\x4f\x06\x8e\x4f\xbd\x9f\x87\x23\x5f\x4b\xa0\x9e\x4b\xdc\x64\x5b\xb9\xb9\x97\xac\xca\x50\x81\x13\xea\xc5\xc0\xa1\x23\xb5\xdd\x7f\xb7\x6e\x01\x0f\x7b\x07\x74\x1f\xf1\x3a\xed\xa7\x2f\x09\x77\x77\xdf\x58\xd7\xcf\x9a\x7d\x57\x79\x7a\x98\xf1\x17\x00\x00\xff\xff\x0f\xdc\x24\x8e\xc8\x01\x00\x00")
 
 func kubernetesmasterKubeAddonManagerYamlBytes() ([]byte, error) {
@@ -667,7 +688,7 @@ func kubernetesmasterKubeAddonManagerYaml() (*asset, error) {
 	return a, nil
 }
 
-var _kubernetesmasterKubeApiserverYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x54\x5f\x4f\xdb\x4e\x10\x7c\xcf\xa7\xb0\xfc\x9c\xc3\xc0\x0f\xe9\x87\x2c\x8c\x84\x68\xa5\x22\x51\x94\x12\xa9\xef\x9b\xbb\x4d\x72\xcd\xfd\xeb\xde\x9e\x51\xfa\xe9\xab\x73\x9c\x10\xec\x40\x2b\x35\x79\xf2\xcd\xce\xec\x78\x76\x7d\x10\xf4\x77\xa4\xa8\xbd\xab\x8b\xb2\xbd\x28\x27\x1b\xed\x54\x5d\x94\x33\xaf\xca\x89\x45\x06\x05\x0c\xf5\xa4\x28\x1c\x58\xac\x8b\x72\x93\x16\x28\x20\xe8\x88\xd4\x22\x95\x3d\x10\x03\xc8\x03\x1a\xb7\x91\xd1\x66\xc8\xc0\x02\x4d\xcc\xec\xa2\x60\x8d\x54\x17\xd2\x3b\x26\x6f\x44\x30\xe0\xb0\x3b\x97\xde\x06\xef\xd0\x71\x5d\xbc\xd5\x9e\xc4\x80\x32\x73\xd7\x3e\xf2\x13\xf2\x8b\xa7\x4d\x5d\x30\xa5\xcc\xcb\x3a\xa0\x1d\x52\xaf\x2e\xde\xf7\x97\x7f\xda\xc2\x2a\xa3\x37\x19\x26\x87\x8c\xf1\xcb\x36\x20\xe5\xc7\x79\x40\x79\xbb\x2f\x94\xde\x5a\xc8\x01\xf4\xcf\x59\xb9\xac\xd6\xfb\xda\xf2\xf8\x78\xd4\xa5\x3b\x15\x02\x94\xd5\x31\x27\x2a\xfa\xb7\x6d\x9e\xf6\x11\x3d\xea\x25\xca\xad\x34\x38\x7d\xd4\x56\xf3\x33\xb8\x15\xd2\x74\x8e\xd4\x6a\x89\x77\x52\xfa\xe4\x78\xfa\x09\x97\x90\x0c\xcf\xd9\x13\xac\xf0\xde\x40\x8c\xd3\x67\x8c\x3e\x91\xc4\x6f\xc9\x33\x8c\xfa\x29\xc2\x18\x9b\xf3\xb3\xee\x3f\x44\x8d\xf1\x2f\x22\x90\x6e\xb5\xc1\x15\xaa\x01\xac\x5d\x44\x99\x08\x45\xf0\xc4\xcd\xf5\xf9\xf5\x90\x7f\x0c\x5f\x5d\xfd\x37\x40\xa5\xf1\x49\x89\x40\xbe\xd5\x0a\xa9\x81\x5f\x89\xf0\x64\x89\xf4\x6e\xa9\x57\x4d\x85\x2c\xab\xd7\x21\x54\x1d\xe1\xec\x47\xf4\x6e\xd4\xb6\xcb\x44\x48\x93\x22\x23\x09\x1d\x04\xe5\xb4\x9a\x6e\x84\x7d\x62\xf7\x5a\xd1\xed\x80\x88\x2c\x95\xd8\xcd\x25\x36\x6b\xe6\x50\x57\xd5\xc5\xe5\xff\x5d\x36\x17\xf5\x8d\x85\x2c\xf7\x99\xa5\xba\x37\x1a\x1d\xcf\x3c\xf1\x49\x89\x9f\xc9\x53\xb2\x82\x10\x54\x93\x97\x6e\x14\x7a\x8b\xc4\x3a\xe2\x21\xfe\xa3\xdd\xba\x9b\x3d\xcc\x3b\x07\x0f\xb3\xa1\x36\x9b\x28\x24\x12\x8b\xa5\x36\x38\x8a\x23\x23\xb1\x3a\x2c\xd6\x99\x24\x3e\xc1\xcf\xc3\x04\x46\xb1\xc1\xed\xdf\xc9\x6c\x70\x3b\x1a\x4a\x7e\x79\x21\xe1\x23\x01\x09\x27\x0c\xec\x07\x03\xbb\x6d\xfd\x17\x13\x71\xb7\xe1\x62\x01\x72\x83\x4e\x35\x39\xf7\xcb\x41\x4d\xdb\x5c\xed\x4f\x5a\x6f\x92\xc5\xaf\xb9\x6b\xff\xd5\xbf\xf9\xf2\x91\xa5\x78\x6d\xff\x2a\x53\x14\x36\x53\x66\xc0\xeb\xba\x28\x07\x2e\xcb\xb1\x4e\x0b\x24\x8c\x5e\x74\x5a\x06\xf9\x5d\xa1\x16\xa8\x32\x7a\x51\x1d\xd5\xed\x1c\x0e\xaf\xa4\xd3\xc6\xf2\xad\xd6\x49\x1d\xf4\xc3\x07\x0e\xff\xe4\xee\x7d\xb5\x91\xcd\xdf\x01\x00\x00\xff\xff\xcf\x19\x09\x97\xf2\x05\x00\x00")
+var _kubernetesmasterKubeApiserverYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x94\xdf\x4e\x1b\x3d\x10\xc5\xef\xf3\x14\xd6\x5e\xc7\x59\x40\x9f\xf4\xa1\x15\x8b\x84\x68\xa5\x22\x51\x94\x82\xd4\xfb\x89\x77\x92\xb8\xf1\xbf\x8e\xc7\x8b\xd2\xa7\xaf\xbc\xd9\x04\x70\x02\x54\x7b\x65\xcf\x99\x9f\x8f\x8f\xbd\x86\xa0\x7f\x22\x45\xed\x5d\x23\xaa\xfe\xbc\x9a\x6c\xb4\xeb\x1a\x51\xcd\x7d\x57\x4d\x2c\x32\x74\xc0\xd0\x4c\x84\x70\x60\xb1\x11\xd5\x26\x2d\x50\x42\xd0\x11\xa9\x47\xaa\xc6\x42\x0c\xa0\x0e\xd5\xb8\x8d\x8c\x36\x97\x0c\x2c\xd0\xc4\xdc\x2d\x04\x6b\xa4\x46\x28\xef\x98\xbc\x91\xc1\x80\xc3\x61\x5e\x79\x1b\xbc\x43\xc7\x8d\x78\xcb\x9e\xc4\x80\x2a\xf7\xae\x7d\xe4\x07\xe4\x67\x4f\x9b\x46\x30\xa5\xdc\x97\x39\xa0\x1d\xd2\x48\x97\xef\xfb\xcb\x9f\xb6\xb0\xca\xd5\xab\x5c\x26\x87\x8c\xf1\xdb\x36\x20\xe5\xe1\x53\x40\x75\xbd\x17\x2a\x6f\x2d\xe4\x00\xc6\xb1\x10\x52\x54\xf5\x7a\xaf\xdd\xcb\x86\xe9\xa3\x55\x86\x59\x29\xa1\xb3\x3a\x46\xed\x9d\x1c\x77\xdb\x3e\xec\x23\xba\xd7\x4b\x54\x5b\x65\x70\x7a\xaf\xad\xe6\x47\x70\x2b\xa4\xe9\x13\x52\xaf\x15\xde\x28\xe5\x93\xe3\xe9\x17\x5c\x42\x32\xfc\xc4\x9e\x60\x85\xb7\x06\x62\x9c\x3e\x62\xf4\x89\x14\xfe\x48\x9e\xe1\x68\xbd\x8e\x30\xc6\xf6\x6c\x36\x7c\x65\xd5\x18\xff\x2c\x03\xe9\x5e\x1b\x5c\x61\x57\x94\xb5\x8b\xa8\x12\xa1\x0c\x9e\xb8\xbd\x3c\xbb\x3c\x2b\x04\xaf\xcb\xaf\xe2\xbb\x99\xdf\x65\xdb\x48\x73\x4f\x7c\x5d\xf4\x28\xe3\x53\x27\x03\xf9\x5e\x77\x48\x2d\xfc\x49\x84\x27\x25\xca\xbb\xa5\x5e\xb5\x35\xb2\xaa\x5f\xd8\xf5\xd0\x30\xfb\x15\xbd\x2b\xba\xf2\xa9\x6a\x85\x52\x99\x14\x19\x49\xea\x20\x29\x67\xb8\x73\x36\xe6\x78\xab\x3b\x2a\x1d\x21\xab\x6e\xe8\x46\x8a\xed\x9a\x39\x34\x75\x7d\x7e\xf1\x7f\xce\x6b\x76\xde\x5c\x59\xc8\xb8\xaf\xac\xba\x5b\xa3\xd1\xf1\xa9\x4d\x0d\x88\xdf\xc9\x53\xb2\x92\x10\xba\x36\x5f\xc5\x42\x03\x5d\x8f\xc4\x3a\xe2\xe1\x50\x4e\x45\x76\x37\x2f\xd9\xe0\xbc\xdb\x5a\x9f\xa2\x84\xc4\xeb\xf6\xea\x30\xbe\x49\xbc\x2e\xc5\x6c\xa2\x54\x48\x2c\x97\xda\xe0\x51\x76\xb9\x12\xeb\xc3\xdd\x9c\x29\xe2\x13\xfd\xf9\x3e\x00\xa3\xdc\xe0\xf6\xdf\x30\x1b\xdc\x16\x18\x35\x24\x25\x15\x7c\x04\x50\x70\xc2\xc0\xfe\x14\x61\x77\xe1\x3f\x33\x31\xca\x47\xf5\x2c\xa4\x45\xc9\xdb\xfd\x29\x72\x01\x6a\x83\xae\x6b\xf3\x49\x5d\x14\x9a\xbe\xfd\x6f\x3f\xd3\x7b\x93\x2c\x7e\xcf\x4b\x8f\xaf\xc7\x9b\x17\x04\x59\xc9\x17\x0f\x2f\x18\x21\x6c\x6e\x99\x03\xaf\x1b\x51\x15\x56\xab\x63\x4e\x0f\x24\x8d\x5e\x0c\x2c\x83\xfc\x2e\xa8\x07\xaa\x8d\x5e\xd4\xaf\x74\x3b\x87\xe5\xd3\x76\xda\x58\x7e\x1d\x07\xd4\x81\x1f\x3e\x70\xf8\x99\xbb\xf7\x69\x47\x36\xff\x0e\x00\xae\xb0\xc1\x50\x3a\x06\x00\x00")
 
 func kubernetesmasterKubeApiserverYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -687,7 +708,7 @@ func kubernetesmasterKubeApiserverYaml() (*asset, error) {
 	return a, nil
 }
 
-var _kubernetesmasterKubeControllerManagerYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x53\xc1\x6e\xdb\x30\x0c\xbd\xe7\x2b\x04\xdf\x15\x63\x3b\x1a\x71\x2f\x05\x86\x5d\x56\x04\x18\xb0\x3b\x23\x31\x89\x16\x49\xd4\x28\xda\x5b\xf6\xf5\x03\x5d\xa7\xd9\x9c\xa6\xab\x6f\x7c\x7c\xef\xf1\x99\xa0\xa0\x84\x6f\xc8\x35\x50\xee\x4c\x33\x7e\x68\x56\xa7\x90\x7d\x67\x9a\x2d\xf9\x66\x95\x50\xc0\x83\x40\xb7\x32\x26\x43\xc2\xce\x34\xa7\x61\x87\xd6\x51\x16\xa6\x18\x91\x6d\x82\x0c\x07\xe4\x66\x66\xd4\x02\xee\x85\x56\xcf\x55\x30\x69\x2b\xc2\x0e\x63\x55\x1b\x63\x24\x20\x77\x66\xb6\xb0\x25\x42\xc6\x09\x77\x94\x0a\x65\xcc\xd2\x99\x3b\x43\x56\xb5\xa0\x53\x93\x23\x55\x79\x42\xf9\x49\x7c\xea\x8c\xf0\xa0\x06\x4a\x87\x90\x91\xe7\x31\xf6\x1d\x89\xf5\x0b\x09\x0e\x4a\xdb\x28\x8f\x33\x0a\xd6\xcf\xe7\x82\xac\xe5\xd7\x82\xee\xe1\x42\x74\x94\x12\xe8\x6e\xe6\x5a\x47\x34\xed\xf1\xc2\x6d\xfe\x86\xef\x8f\x9b\xda\xd6\xaa\xc2\x51\xde\x87\x43\xdf\x8e\xc0\x6d\x0c\xbb\x56\xb1\x88\xd2\x5e\x7b\x0b\x11\xc4\x48\x0e\x04\x6d\x26\x8f\xd6\x05\xcf\xb5\xdf\x5c\xc0\x27\xf2\xf8\xa8\xd0\xc3\x42\xe5\xe2\x50\x05\x79\xe2\xf7\xd3\x5f\x3e\x3e\x23\xca\xbe\x47\xd6\xdd\xf5\x9b\x04\x5a\x7c\xfa\xe1\xf3\x96\x71\x1f\x7e\xdd\xb2\x69\xf0\xb6\x30\x8d\xc1\x23\xf7\xf0\x7b\x60\x7c\x95\x72\xf9\x55\x14\xd7\x5e\xf7\xdc\x4e\x82\xf5\xf7\x4a\x79\xa1\x62\x22\xb1\x0e\xec\x3e\x44\xbc\x51\x39\x64\xa9\xad\x83\xb5\x63\x59\xe8\x2a\xf2\x18\x1c\x5a\x70\x8e\x86\x2c\xb6\x70\x18\x75\x61\x27\x3c\xbf\xe5\x05\x25\xa8\x12\x79\x7d\xc2\xf3\xc2\x32\x22\x78\x64\x8b\x11\x9d\xf4\x7a\x6b\x8b\xfe\xd8\x7f\xbc\x20\x23\xc5\x21\xe1\x17\x9d\x5c\xff\x39\x93\xf9\x12\x51\x9c\xbd\xce\xbe\xfa\x18\x93\x54\xb3\x05\x39\x76\xa6\x59\x44\x6c\x6e\x7d\x46\x60\x1b\xc3\xce\xce\x07\x73\xd7\x68\x71\x58\xca\x7b\x8e\xb8\x7c\x22\xaf\x07\xd3\x57\x36\x59\xbd\xf8\x97\x37\x12\xfe\x2f\xdd\x7d\xb7\x9b\x98\x7f\x02\x00\x00\xff\xff\x14\xd2\x1e\x86\x94\x04\x00\x00")
+var _kubernetesmasterKubeControllerManagerYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x94\x41\x6f\xdb\x30\x0c\x85\xef\xf9\x15\x82\xef\xaa\xb1\x1d\x8d\xb8\x97\x76\xc3\x0e\x5b\x11\x6c\xc0\xee\x8c\xc4\x24\x5a\x64\xd1\xa3\x68\x77\xde\xaf\x1f\xe8\xd8\x0d\xea\x34\xe9\x8e\xe2\x7b\xef\x13\x4d\x88\x86\x36\xfc\x44\xce\x81\x52\x65\x8a\xfe\x43\xb1\x3a\x86\xe4\x2b\x53\x6c\xc8\x17\xab\x06\x05\x3c\x08\x54\x2b\x63\x12\x34\x58\x99\xe2\xd8\x6d\xd1\x3a\x4a\xc2\x14\x23\xb2\x6d\x20\xc1\x1e\xb9\x98\x1c\xb9\x05\xf7\x62\xcb\x43\x16\x6c\x54\x8a\xb0\xc5\x98\x15\x63\x8c\x04\xe4\xca\x4c\x08\xdb\x46\x48\x38\xd6\x1d\x35\x2d\x25\x4c\x52\x99\x2b\x97\xac\x72\x8b\x4e\x21\x07\xca\xf2\x84\xf2\x4c\x7c\xac\x8c\x70\xa7\x00\x05\x42\x48\xc8\xd3\x35\xf6\x3f\x3a\xd6\x6b\x43\x03\x7b\xb5\xad\xd5\xc7\x09\x05\xf3\x97\xa1\x45\xd6\xe3\x8f\x16\xdd\xfd\x6c\x74\xd4\x34\xa0\xb3\x99\xce\xc6\x58\x53\x94\x87\xd9\x3b\xdb\xc6\xf2\xf5\xeb\x46\xd9\x5a\x4d\x38\x4a\xbb\xb0\xaf\xcb\x1e\xb8\x8c\x61\x5b\x6a\x2d\xa2\x94\x67\x6d\x11\x82\x18\xc9\x81\xa0\x4d\xe4\xd1\xba\xe0\x39\xd7\xeb\xb9\xf8\x44\x1e\x1f\xb4\x74\xbf\x48\xb9\xd8\x65\x41\x1e\xfd\xf5\xf8\x95\x0f\xa7\x8a\xba\xaf\x99\x75\x76\xf5\xba\x01\xf5\x7d\xfe\xed\xd3\x86\x71\x17\xfe\x5c\xba\xa9\xf3\xb6\x65\xea\x83\x47\xae\xe1\x6f\xc7\xf8\xa6\x65\xfe\x54\x14\x57\x9e\xe7\x5c\x8e\x81\xbb\x5f\x99\xd2\x22\xc5\x44\x62\x1d\xd8\x5d\x88\x78\x91\x72\xc8\x92\x4b\x07\x77\x8e\x65\x91\xcb\xc8\x7d\x70\x68\xc1\x39\xea\x92\xd8\x96\x43\xaf\x03\x3b\xe2\x70\x8b\x35\xc5\xa6\xd4\xdd\x11\x87\x05\x37\x22\x78\x64\x8b\x11\x9d\xd4\xfa\xe0\x6e\xe8\x7a\xc8\x68\x7d\xc7\x20\x81\x52\xbd\x3e\x85\x3f\x69\xf6\xab\x4a\x8f\x93\x72\x7f\x0b\xc2\x98\xf0\xd9\x7a\x04\x1f\x43\xc2\x57\x90\xef\x2a\x3d\x4e\xca\x3b\x10\xe1\xc1\xb6\xc8\x81\xfc\x02\x21\x3c\x6c\xc6\xfa\x12\xd0\xd7\x1f\xe7\x4a\x4f\xb1\x6b\xf0\x9b\x4e\x32\xbf\x7a\xf6\xd3\x66\xa1\x38\x7b\x9e\xe5\x99\x63\x4c\xa3\x99\x0d\xc8\xa1\x32\xc5\x62\xe4\xc5\x25\xa7\x07\xb6\x31\x6c\xed\xb4\x00\x57\x41\x8b\x45\x51\xdf\xa9\xc5\xe5\xca\xbf\xdd\x98\xfe\x35\xc6\x9e\x5e\xf8\xed\x8d\x0e\xdf\xeb\xee\x3a\xed\xa2\xcd\x7f\x03\x00\xe6\xdc\xa9\xfb\x64\x05\x00\x00")
 
 func kubernetesmasterKubeControllerManagerYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -707,7 +728,7 @@ func kubernetesmasterKubeControllerManagerYaml() (*asset, error) {
 	return a, nil
 }
 
-var _kubernetesmasterKubeSchedulerYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x91\xcd\x4e\xc3\x30\x10\x84\xef\x79\x8a\x95\xef\x56\x04\x47\x8b\x72\xe6\x02\xaa\x84\xc4\x7d\xe3\x2c\x8d\x55\xff\xc9\xde\x04\xf5\xed\xd1\xb6\x69\x53\x42\x0b\xbe\x79\x67\xe6\xcb\x6c\x8c\xd9\x7d\x50\xa9\x2e\x45\x03\x6a\x7a\x50\xcd\xde\xc5\xde\x80\xda\xa6\x5e\x35\x81\x18\x7b\x64\x34\x0d\x40\xc4\x40\x06\xd4\x7e\xec\x48\x57\x3b\x50\x3f\x7a\x2a\x6a\x16\x6a\x46\xbb\xa8\x87\xca\x14\x44\xf2\xd8\x91\xaf\x92\x06\x60\x47\xc5\x80\x4d\x91\x4b\xf2\x3a\x7b\x8c\x74\x9c\xdb\x14\x72\x8a\x14\xd9\xc0\x4f\x76\x53\x33\x59\xc9\x0e\xa9\xf2\x1b\xf1\x57\x2a\x7b\x03\x5c\x46\xc9\x09\x07\x5d\xa4\x32\xd3\xf5\xfd\x7e\x72\x5c\xc0\x9d\xa8\x4f\x22\x97\x48\x4c\xf5\xe5\x90\xa9\xc8\xf5\x3d\x93\x7d\x3e\x1b\x6d\x0a\x01\x63\x6f\xe6\xab\x80\x55\x3b\x9c\xad\xea\x7a\xfc\xeb\x23\xc7\xa9\xd6\x62\xb4\x29\x7e\xba\xdd\xa6\x9d\xb0\xb4\xde\x75\xad\xcc\x3c\x71\xbb\x68\xab\x90\x27\xec\xa9\x68\xf2\x64\x79\x23\x3b\xae\xf4\x69\xf3\x78\x9e\x4c\xc9\x8f\x81\x5e\xd3\x18\xb9\x5e\xf7\x9c\x7f\x00\xb1\xd5\xcb\x96\x0b\x06\x20\x48\x64\x8b\x3c\x18\x50\x2d\xb1\x6d\x6f\xd9\x2e\x9c\x09\x8b\xf6\xae\xd3\x73\xf5\xbb\xa0\xd5\x8a\xe2\x3b\x35\x5c\xbf\xcc\xed\x62\xf2\xb8\x47\xd4\x85\x9f\xff\x68\xf8\x5f\xbb\xfb\xb4\x1b\x35\x4f\xa7\xf9\x0e\x00\x00\xff\xff\xf1\xc7\x19\x60\x02\x03\x00\x00")
+var _kubernetesmasterKubeSchedulerYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x92\x4f\x8b\xdb\x40\x0c\xc5\xef\xfe\x14\xc2\xf7\xc1\xb4\xc7\x61\xb3\xa7\x2d\xf4\xd0\x96\xd0\x42\xef\xca\x8c\xba\x19\x32\xff\xd0\xc8\x5e\xf2\xed\x8b\xbc\xce\x86\x78\xf3\x87\x9c\xa4\xf7\xde\x2f\xcf\x68\xb0\x86\xbf\xc4\x2d\x94\x6c\xa1\x9f\xbe\xf4\xdd\x21\x64\x6f\xa1\xdf\x16\xdf\x77\x89\x04\x3d\x0a\xda\x0e\x20\x63\x22\x0b\xfd\x61\xdc\x91\x69\x6e\x4f\x7e\x8c\xc4\xfd\x22\xb4\x8a\xee\xac\x1e\x9b\x50\x52\x29\xe2\x8e\x62\xd3\x34\x80\x04\x62\x0b\xae\x64\xe1\x12\x4d\x8d\x98\x69\xde\xbb\x92\x6a\xc9\x94\xc5\xc2\x25\xbb\x6b\x95\x9c\x66\xf7\xa5\xc9\x2f\x92\xb7\xc2\x07\x0b\xc2\xa3\xe6\x94\x83\x21\x13\x2f\x74\x73\xbb\x9f\xfe\x42\xc2\x57\x55\x9f\x54\xe6\x4c\x42\xed\xfb\xb1\x12\xeb\xf8\xa7\x92\x7b\x3e\x19\x5d\x49\x09\xb3\xb7\xcb\x08\x60\xa0\x1f\xf6\x27\xeb\xc9\x35\xaf\x3f\xfd\xc9\xbc\x35\x46\x8d\xae\xe4\x7f\xe1\x75\x33\x4c\xc8\x43\x0c\xbb\x41\x77\x91\x64\x38\x6b\xab\x50\x24\xf4\xc4\x86\x22\x39\xd9\xe8\x37\xde\xd1\x75\x68\x64\xfc\xc8\x28\xa1\xe4\xcd\xd3\x7b\xf8\x9b\x66\x7f\xa8\xf4\xb2\x28\xcf\xf7\x20\x4c\x99\xde\x8c\x27\xf4\x31\x64\xba\x80\xfc\x56\xe9\x65\x51\x1e\x40\x84\x8f\xa6\x12\x87\xe2\x57\x08\xe1\xe3\x76\xde\xaf\x01\xd3\xe6\xeb\x69\x33\x95\x38\x26\xfa\x59\xc6\x2c\xcb\x1d\x2f\x6e\x49\xe2\xcc\xf9\x60\x67\x0c\x40\xd2\xc8\x16\x65\x6f\xa1\x1f\x48\xdc\x70\xcd\xf6\xc1\x99\x90\x4d\x0c\x3b\xb3\x5c\xe1\x26\x68\x75\x2d\xf5\xbd\x37\x5c\x3f\xb2\xeb\xc5\xf4\x9d\xce\x9d\x3e\xf8\xf5\x4e\xc3\x47\xed\x6e\xd3\xae\xd4\x04\x00\x00\xe8\xfe\x0f\x00\x57\x05\x97\xd4\xcd\x03\x00\x00")
 
 func kubernetesmasterKubeSchedulerYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -747,7 +768,7 @@ func kubernetesmasteraddonsCalicoConfigmapYaml() (*asset, error) {
 	return a, nil
 }
 
-var _kubernetesmasteraddonsCalicoDaemonsetYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x56\xdf\x6f\xda\xc8\x13\x7f\xe7\xaf\x18\x99\x87\xbe\xd4\xf0\x4d\xbf\xbd\x53\x65\x9d\x4e\x22\xc6\xc9\x59\xa1\x06\x81\x93\x5e\x55\x55\x64\x59\x0f\xb0\xc7\x7a\xd7\xdd\x5d\x93\xa0\xbb\xfe\xef\xa7\xb5\x4d\x30\x60\x93\xf6\xe5\x78\x4a\xc6\x33\xf3\xf9\xcc\xef\xed\x82\x4f\x38\xa3\x12\x1e\x50\x69\x26\x05\x6c\xdf\xf5\xde\xf5\xae\x3a\x5d\x58\x1b\x93\x79\xfd\x7e\x22\xa9\xee\x65\x4a\xfe\x85\xd4\xd0\x42\xb5\x27\xd5\xaa\x6f\xd5\xfa\x0a\x39\x12\x8d\xba\xfb\x62\x14\xaf\x99\x86\x94\x08\xb6\x44\x6d\x80\x09\xca\xf3\x04\x35\x98\x35\xc2\x52\x72\x2e\x9f\x98\x58\x01\x95\x69\x26\x05\x0a\x03\xdb\x12\x54\x7b\x9d\x2e\x00\x94\xee\xfb\x42\x26\xe8\x6d\xaf\x2a\x8f\x2f\x62\x2a\x98\x95\x7e\xe8\xfd\xbf\xd3\x00\xa4\x0d\xe1\xbc\x04\xaa\xb9\x01\x2a\x85\x21\x4c\xa0\x7a\x0b\x44\xc3\x13\x72\xde\xe9\xda\xbf\xac\x5e\x15\xb8\x1f\x85\x90\xf1\x7c\xc5\x84\x06\x22\x12\x10\x68\x9e\xa4\xda\x58\xd3\x25\x5b\x81\x14\x9d\x2e\x20\xa1\x6b\x48\x89\x36\xa8\x0a\x1d\xab\x80\x0a\x0a\x08\x26\x80\xc0\x5d\xbe\x40\x25\xd0\xa0\x06\xca\x73\xab\xd7\xeb\x6c\x98\x48\x3c\x18\x12\x4c\xa5\x98\xa1\xe9\x90\x8c\x55\x49\xf6\x00\x9f\x0d\x8a\x22\xf4\xfe\xf6\x6a\x81\x86\x5c\x75\x52\x34\x24\x21\x86\x78\x1d\x00\x41\x52\xf4\xaa\x40\x5c\x8b\x52\xc9\x74\x46\x28\x7a\xb0\xc9\x17\xe8\xea\x9d\x36\x98\x76\x00\x38\x59\x20\xd7\xd6\x0c\x60\xf3\x41\xbb\x24\xcb\x4e\x6d\xa1\x30\x29\x09\xf6\x98\xec\x57\x1c\x5d\x8d\x6a\xcb\xac\x47\xc7\xa8\x1c\x9d\x42\x93\x24\x89\x14\x29\x11\x64\x85\xaa\x77\x6c\x96\xda\xca\x80\x33\x45\x2a\x05\x65\x1c\x9d\x8e\xce\x90\x5a\x64\x8d\x1c\xa9\x91\xaa\x64\x91\x12\x43\xd7\xa3\x1a\xad\x56\x62\x06\xd3\x8c\x13\x83\x95\x5d\x2d\x05\xf6\xc7\x8f\x5c\x5c\x88\x0e\x80\x08\x21\x0d\x31\x65\x33\xed\xf5\x35\x5d\x63\x92\x73\x54\x3d\xc2\xb3\x35\x39\x89\x86\x2a\x66\x18\x25\xdc\xcd\x64\xe2\xc1\x9b\x37\x3f\x68\x66\x24\x47\x55\x21\xc1\x3f\x2f\x46\x00\x5f\xfe\x76\x36\xb8\x73\x3c\x70\x12\x4c\x18\x25\x06\x13\xe7\x2d\x38\x5b\xc2\x73\xb4\xd2\xb2\x7f\xac\x08\x97\x4b\xa4\xc6\xca\x22\x39\xab\xc0\x1c\xf8\xfe\xb6\xe6\x0c\x2a\x67\x8e\x5f\xb1\x1c\xd8\xb2\xe8\xb1\xe0\x3b\xeb\x41\x66\x96\x83\x54\x8e\xe7\x04\xcf\x4c\x1b\xed\x7c\xff\x5a\x58\xef\x0b\x62\x7f\x6b\xa9\x4d\x54\x76\xb3\x07\xb6\xc0\x95\xfc\x65\x28\x6a\xa9\xea\xc2\x34\x17\xba\x79\x76\x40\x8a\x72\x02\x6a\x5d\x6e\x35\x7a\x50\x8c\x61\xcd\xc7\xc1\x24\x53\x72\xa5\x48\xaa\x5f\xc6\x29\x93\x9c\xd1\x5d\x31\x3d\x4a\xe6\xd6\x45\xe5\xb5\x66\x6e\x09\xf7\x5e\xfe\x77\x1b\xc7\x60\xff\x63\x29\x59\xa1\x07\xdf\x72\xb2\x2b\x8a\x79\xbe\x3b\x0e\xba\x28\xb6\x5e\x3d\xb7\xd0\x85\x7b\x8d\xf5\x70\x06\x93\x70\xbf\x16\x16\x84\x6e\xec\x96\xb2\x8d\xa8\x8d\x54\xd8\x3b\x32\xdd\xb3\x1a\x0e\xe2\xc1\x2c\x1e\x4f\x83\x79\xfc\x79\x12\x1c\xa9\x00\x14\x35\xf7\xc0\x39\x74\x8e\x73\x82\x1f\x08\xb2\xe0\x08\x4b\xe4\xec\x19\x98\x58\x4a\xe0\x72\xb5\x62\x62\xd5\x8c\x76\x13\x8c\xc2\x3f\xe7\xa3\xf1\xed\x2c\x78\x08\xa6\x61\xfc\x79\xe6\x4f\x83\x20\x6a\x81\xb5\xfe\x4e\x01\x87\x52\xbc\x31\x80\x25\xec\xf5\xed\xa4\x19\xc7\x1f\x8c\x42\x7f\x3c\x8f\x82\xf8\xd3\x78\x7a\x17\x46\xb7\xf3\xeb\x81\x7f\x17\x44\xc3\x16\x24\x21\x05\x9e\x21\x31\x5d\xc6\xc6\x38\xee\xa3\x02\x2d\xe1\xd1\x66\x83\x1a\x6e\x65\xfa\xb1\xd8\xa1\xfa\x22\x8b\x61\x38\x1b\x5c\x8f\x82\xf9\x4d\x38\x0a\x6c\xec\xb7\x61\x74\xdb\xc2\xe3\xb0\xbe\x0e\x3c\x66\x68\xe0\xa6\xc8\x2f\x8a\x24\x93\x4c\x18\x30\xb2\xe8\x31\x48\x70\x49\x72\x6e\x80\x50\x3b\xc7\x56\x3c\xf0\xfd\x60\x12\x5f\x4a\xfe\x30\xb8\x19\xdc\x8f\xe2\x20\x1a\x4e\xc6\x61\x14\xc7\xe3\x3f\xc6\xb3\x78\xe0\xc7\xe1\xb8\xad\x0c\xa5\xcf\xb6\xf4\x84\x93\x87\x5f\xed\x0c\x1c\xba\xf0\x12\xba\xd5\x9e\xdd\x4f\x26\xe3\x69\xdc\x82\xb6\x24\x5c\x9f\xe5\xe0\x13\x61\x06\x96\x52\x15\x9d\xfd\x4a\x47\x7f\x1a\x84\xf1\xfc\x66\x3c\x9d\xbf\xb4\xf6\x4f\x24\x3b\x3e\x1c\xd4\x70\xb2\x7d\x0f\x99\x94\xdc\xe6\x35\xd7\xfb\x35\x01\x7a\x2d\x73\x9e\x94\xc7\x01\x1e\x5d\x77\x7f\x84\x28\x4b\xd4\xe3\xa5\x3e\x08\x27\x0f\xef\x27\xe3\xf1\x68\xee\x87\xc3\x69\x0b\xa7\xdf\x6c\x73\xf9\xa5\x47\x9f\x25\xea\xf7\x96\x81\x0b\x27\xe1\xe4\x87\xb0\xce\x14\x0f\x58\x84\x3f\x91\xdd\xd9\x44\xdb\x76\x5b\x10\x8d\x89\x2d\xaa\x4d\xf7\xe6\x43\xb9\x26\x0b\x80\xe6\x94\x47\xe3\x61\x10\x0d\x3e\x36\x26\xfa\x46\xc9\xd4\x3b\xf9\x00\xb0\x64\xc8\x93\x29\x2e\xcf\xbf\x54\xdf\x26\xc4\xac\xbd\xe2\x0c\xf4\x2c\x78\x44\x52\x3c\x21\x1a\xd9\x12\xd9\x33\xaf\x50\xdb\xfd\x8c\x09\x26\xcd\xf4\x5a\x33\x50\x8f\x5d\x23\xcd\x15\x33\x3b\x5f\x0a\x83\xcf\xe6\x98\x58\xa6\xd8\x96\x71\x5c\x61\x72\x74\x81\xec\x4f\xa1\x96\xb9\xa2\xa8\x8f\x2d\x14\x7e\xcb\x51\x1b\x7d\x1a\x20\xcd\x72\x0f\xde\xfd\xf2\xbf\xb4\x26\xdf\x4a\x9e\xa7\xf8\x51\xe6\xe2\x54\xdf\x85\xd4\x4a\xcb\x64\xf4\x39\x5b\xd8\xb7\x4b\xce\x51\x9f\x78\x2d\xe3\xe4\x6c\xe1\x36\x7f\x57\x48\x12\x7b\x73\xcf\xd8\x9f\x42\x6c\x89\xea\xab\x5c\x54\x77\xa8\x11\x65\x4b\x94\xab\x72\xe1\x36\xaa\x1c\x80\x8a\x39\xae\x1d\xc5\x62\x76\x0e\x87\xf5\xe8\xa9\x5b\x7b\xc2\x2e\x98\x20\x8a\x61\xfd\x1a\xdb\x5b\x6b\x3f\x9d\xbc\x68\x8b\xbd\xbc\x3f\xe9\xc5\x1d\x3f\x3b\xb9\x15\x88\x4b\x05\x7b\xf5\xe4\xd6\xde\xe5\xb5\x6a\xc9\x34\x25\xf6\xf9\xfb\xc5\xe9\xd7\x9c\xf5\xf4\xda\xf9\x7a\xf9\x30\x17\x8b\xe4\x9c\xb4\x91\x7b\x52\x2d\xd4\xeb\xf4\xfd\x28\xdc\x9f\xb0\xb9\x3f\x8e\x6e\xc2\xc6\xb3\xd1\x3c\x60\x25\xde\x47\x92\xdd\xe1\xae\x65\xce\x8e\x9e\x25\xa5\x7e\x83\xd6\x06\x77\x1e\x50\xc1\xe6\x55\x24\xf3\x06\xcd\x72\x6b\xd8\x4a\xda\xb3\x64\xfd\xfe\xf4\x0a\xb9\xbb\xbf\x0e\xa6\x51\x10\x07\xb3\xb9\xdd\x26\xf3\xff\x74\x9d\xfc\xe8\x00\xda\xe8\xfa\x32\x33\xb6\x59\xfa\x0b\x26\x1a\xe7\x83\x0a\xe6\x2e\x98\x70\x13\xa6\x5e\x73\x85\x86\x16\xae\x04\x9a\x5e\xd2\xea\x4c\xa0\xa9\x39\x2b\xb9\x1e\x3d\x79\xef\x6d\xaa\x17\xbb\xfa\xab\xf7\x7c\x16\x9a\x97\x83\xa5\x51\x30\x3a\x5e\x77\xed\xfb\xc6\x7d\x6d\x0b\x5c\xf4\xd8\xb2\x5e\xaa\x10\x6a\xc3\xe1\x47\x61\xc3\x0b\xba\x31\xb3\x17\x01\x9b\x8a\xe5\xb6\xe6\xf6\x55\x77\xc7\x05\xfb\x37\x00\x00\xff\xff\x1e\xe0\x76\xc6\x75\x10\x00\x00")
+var _kubernetesmasteraddonsCalicoDaemonsetYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x56\x5d\x6f\xe2\xcc\x15\xbe\xe7\x57\x1c\x99\x8b\xbd\x59\xe3\x66\xfb\xb6\x5a\x59\xab\x95\x88\x71\x52\x2b\xac\x41\xe0\x64\xbb\x5a\xad\xc8\x30\x3e\xc0\x94\xf1\x8c\x3b\x33\x26\x41\xed\xfe\xf7\x6a\x6c\x03\x86\xd8\x49\x7a\xf3\xc6\x5c\x38\xc7\x67\xce\xf3\x9c\xef\xe9\x43\x40\x38\xa3\x12\x1e\x50\x69\x26\x05\xec\x3e\x0d\x3e\x0d\xae\x7a\x7d\xd8\x18\x93\xfb\x9e\x97\x4a\xaa\x07\xb9\x92\xff\x42\x6a\x68\xa9\x3a\x90\x6a\xed\x59\x35\x4f\x21\x47\xa2\x51\xf7\x8f\x87\x92\x0d\xd3\x90\x11\xc1\x56\xa8\x0d\x30\x41\x79\x91\xa2\x06\xb3\x41\x58\x49\xce\xe5\x13\x13\x6b\xa0\x32\xcb\xa5\x40\x61\x60\x57\x81\x6a\xbf\xd7\x07\x80\xca\xbc\x27\x64\x8a\xfe\xee\xaa\xb6\x78\x14\x53\xc1\xac\xf4\xf3\xe0\xaf\xbd\x16\x20\x6d\x08\xe7\x15\x50\xc3\x0c\x50\x29\x0c\x61\x02\xd5\x47\x20\x1a\x9e\x90\xf3\x5e\xdf\xbe\x59\xbd\xda\xf1\x20\x8e\x20\xe7\xc5\x9a\x09\x0d\x44\xa4\x20\xd0\x3c\x49\xb5\xb5\x47\x57\x6c\x0d\x52\xf4\xfa\x80\x84\x6e\x20\x23\xda\xa0\x2a\x75\xac\x02\x2a\xb0\x4c\x81\x09\x20\x70\x57\x2c\x51\x09\x34\xa8\x81\xf2\xc2\xea\x0d\x7a\x5b\x26\x52\x1f\x46\x04\x33\x29\xe6\x68\x7a\x24\x67\x75\x90\x7d\xc0\x67\x83\xa2\x74\xdd\xdb\x5d\x2d\xd1\x90\xab\x5e\x86\x86\xa4\xc4\x10\xbf\x07\x20\x48\x86\x7e\xed\x88\x6b\x51\x6a\x99\xce\x09\x45\x1f\xb6\xc5\x12\x5d\xbd\xd7\x06\xb3\x1e\x00\x27\x4b\xe4\xda\x1e\x03\xd8\x7e\xd6\x2e\xc9\xf3\xcb\xb3\x50\x1e\xa9\x08\x0e\x98\xf4\x6a\x8e\xae\x46\xb5\x63\xd6\xa2\x63\x54\x81\x4e\x69\x82\xa4\xa9\x14\x19\x11\x64\x8d\x6a\x70\x7e\x2c\xb3\x99\x01\x67\x86\x54\x0a\xca\x38\x3a\x3d\x9d\x23\xb5\xc8\x1a\x39\x52\x23\x95\x7d\x07\xc8\x88\xa1\x9b\x71\x83\x56\x27\x31\x83\x59\xce\x89\xc1\xfa\x5c\x23\x04\x00\xe7\x9e\xbd\xea\x1d\x00\x11\x42\x1a\x62\x6c\x44\x4f\xfa\x9a\x6e\x30\x2d\x38\xaa\x01\xe1\xf9\x86\x5c\x78\x43\x15\x33\x8c\x12\xee\xe6\x32\xf5\xe1\xc3\x87\x77\x1e\x33\x92\xa3\xaa\x91\xe0\xbf\xc7\x43\x00\x3f\xff\xe3\x6c\x71\xef\xf8\xe0\xa4\x98\x32\x4a\x0c\xa6\xce\x47\x70\x76\x84\x17\x68\xa5\x55\xfd\x58\x11\xae\x56\x48\x8d\x95\xc5\x72\x5e\x83\x39\xf0\xfb\x63\xc3\x18\xd4\xc6\x9c\xa0\x66\x39\xb4\x69\xd1\x13\xc1\xf7\xd6\x82\xcc\x2d\x07\xa9\x1c\xdf\x09\x9f\x99\x36\xda\xf9\xfd\xab\x3c\x7d\x48\x88\x7d\x36\x52\x9b\xb8\xaa\x66\x1f\x6c\x82\x6b\xf9\xb1\x29\x1a\xa1\xea\xc3\xac\x10\xba\xbd\x77\x40\x8a\xaa\x03\x1a\x55\x6e\x35\x06\x50\xb6\x61\xc3\xc6\xe9\x48\xae\xe4\x5a\x91\x4c\x1f\xdb\x29\x97\x9c\xd1\x7d\xd9\x3d\x4a\x16\xb6\x51\x6a\xab\x8d\xe3\x96\xf0\xe0\xf8\xbf\xdb\xda\x06\x87\x87\x65\x64\x8d\x3e\x7c\xa9\x18\xc7\x32\xc5\x79\x8e\xf4\x6b\x43\x03\xc5\xee\xe4\x9f\x7d\xfa\x70\xaf\xb1\xe9\xc4\x70\x1a\x1d\x86\xc1\x92\xd0\xad\x9d\x4d\xb6\x03\xb5\x91\x0a\x4f\x3c\x9a\x5c\x46\xc3\x64\x38\x4f\x26\xb3\x70\x91\xfc\x98\x86\x67\x2a\x00\x65\xa6\x7d\x70\x4e\xf5\xe2\x9c\x69\xf4\x21\x14\x64\xc9\x11\x56\xc8\xd9\x33\x30\xb1\x92\xc0\xe5\x7a\xcd\xc4\xba\x1d\xed\x26\x1c\x47\xff\x5c\x8c\x27\xb7\xf3\xf0\x21\x9c\x45\xc9\x8f\x79\x30\x0b\xc3\xb8\x03\xd6\xda\xbb\x04\x1c\x49\xf1\xc1\x00\x56\xb0\xd7\xb7\xd3\x76\x9c\x60\x38\x8e\x82\xc9\x22\x0e\x93\xef\x93\xd9\x5d\x14\xdf\x2e\xae\x87\xc1\x5d\x18\x8f\x3a\x90\x84\x14\xf5\xb0\x38\x3c\x7d\x18\x31\x5d\x82\xac\x18\xc7\x83\x57\xa0\x25\x3c\xda\x68\x50\xc3\xad\x4c\x3f\x82\xad\x05\xfd\x2a\x8b\x51\x34\x1f\x5e\x8f\xc3\xc5\x4d\x34\x0e\xad\xef\xb7\x51\x7c\xdb\xc1\xe3\x34\xb4\x0e\x7f\x7d\x98\xa3\x81\x9b\x32\xbe\x28\xd2\x5c\x32\x61\xc0\xc8\xb2\xb2\x20\xc5\x15\x29\xb8\x01\x42\x6d\xf7\x5a\xf1\x30\x08\xc2\x69\xd2\x4e\xa7\x0a\xfe\x28\xbc\x19\xde\x8f\x93\x30\x1e\x4d\x27\x51\x9c\x24\x93\x7f\x4c\xe6\xc9\x30\x48\xa2\x49\x57\x1a\x2a\x9b\x5d\xe1\x89\xa6\x0f\x7f\xb7\xfd\x74\xaa\xc2\xd7\xd0\xad\xf6\xfc\x7e\x3a\x9d\xcc\x92\x0e\xb4\x15\xe1\xfa\x45\x0c\xbe\x13\x66\x60\x25\x55\xb9\x0e\xdf\xa8\xe8\xef\xc3\x28\x59\xdc\x4c\x66\x8b\x63\x69\x77\x20\xb5\x05\x3b\x39\xad\xd1\x68\xba\xfb\x03\x72\x29\xb9\x8d\x6b\xa1\x0f\xc3\x01\xf4\x46\x16\x3c\xad\x56\x02\x3c\xba\xee\x61\xf5\x50\x96\xaa\xc7\x56\x46\x75\x1d\x44\xd3\x87\x3f\xa6\x93\xc9\x78\x11\x44\xa3\x59\x07\xa7\x2f\xb6\xb8\x82\xca\x62\xc0\x52\xf5\xb5\xa3\xe1\xa2\x69\x34\x7d\x17\xd6\x0b\xc5\x13\x16\xe1\x4f\x64\xff\xa2\xa3\x6d\xb9\x2d\x89\xc6\xd4\x26\xd5\x86\x7b\xfb\x59\x57\xf7\x02\x9b\xc7\xf6\x90\xc7\x93\x51\x18\x0f\xbf\xb5\x8e\x8f\x1b\x25\xb3\xf3\xa9\x65\x9f\x15\x43\x9e\xce\x70\xf5\xf2\x4b\xfd\x6d\x4a\xcc\xc6\x2f\x87\xff\xc0\x82\xc7\x24\x6b\xce\x4a\x1b\x89\xd8\xa6\x08\x48\x9a\x2a\xd4\x76\x2a\x63\x8a\x69\x3b\xbd\xce\x08\x34\x7d\xd7\x48\x0b\xc5\xcc\x3e\x90\xc2\xe0\xb3\x39\x27\x96\x2b\xb6\x63\x1c\xd7\x98\x9e\xed\x1d\xfb\x53\xa8\x65\xa1\x28\x36\x56\x8f\xfd\x29\xfc\x77\x81\xda\x5c\x48\x01\x68\x5e\xf8\xf0\xe9\x6f\x7f\xc9\x1a\xf2\x9d\xe4\x45\x86\xdf\x64\x21\x2e\xf5\x5d\xc8\xac\xb4\x0a\x86\xc7\xd9\xd2\xcb\xa4\xdd\xff\xfa\x4c\xeb\x70\xbd\xe2\x6c\xe9\xb6\x7f\x57\x48\x52\xbb\x69\x5f\xb0\xbf\x84\xd8\x11\xe5\xa9\x42\x78\xd5\x12\xba\xb0\x52\xa1\xec\x88\x72\x55\x21\xdc\x56\x95\x13\x50\xd9\xc7\xc7\xaf\xf5\xfd\xf6\xb4\x4e\xcf\x2e\xb8\x8d\x8b\xeb\x92\x09\xa2\x58\xc3\x81\x7e\xb9\x61\xed\x9d\xf6\xe2\x1e\x5b\xce\xe5\xc3\x22\xb7\x35\x32\xe8\x5d\x26\xbe\x06\x71\xa9\x60\x9d\x8b\x36\x88\xa3\xcb\x3d\x4b\x65\x96\x11\x7b\xd5\xfd\xe9\x78\x0d\x13\x03\xbd\x71\x7e\xbd\xbe\x8e\xcb\xf1\xf1\x92\xaa\x91\x07\x2a\x1d\x84\x9b\xa4\x83\x38\x3a\x2c\xae\x45\x30\x89\x6f\xa2\xdb\xf7\xb7\x55\x15\x9a\x6f\x24\xbf\xc3\x7d\x47\x77\x9d\x5d\x41\x2a\xfd\x16\xad\x2d\xee\x7d\xa0\x82\x2d\x6a\x4f\x16\x2d\x9a\xd5\x6a\xb2\x23\xc2\x2e\x23\x6b\xf7\xff\x1e\x1c\x77\xf7\xd7\xe1\x2c\x0e\x93\x70\xbe\xb0\x33\x64\xf1\xa7\x0e\x91\xf7\xb6\x9d\xf5\xce\x93\xb9\xf1\xa8\x60\xde\x92\x89\x5e\x6b\x40\x05\x73\x97\x4c\xb8\x29\x53\x6f\x99\x42\x43\x4b\x53\x02\xcd\x20\xed\x34\x26\xd0\x34\x8c\x55\x5c\x1b\x34\xcb\xab\x5f\x0a\xcb\x7d\x9d\x4a\xaf\xbd\x03\xda\x47\x82\xf5\xa8\x64\xd4\x90\x01\xe4\xdd\x53\xc6\x7d\xab\xf7\x5f\xb5\xd8\x31\x54\x6a\x17\x1a\xcd\x11\xc4\xd1\x4b\x17\xda\x23\xfb\x2a\x60\x5b\xb2\xdc\xce\xd8\xbe\x69\xee\x3c\x61\xff\x1b\x00\xfe\x50\x56\x4c\x61\x10\x00\x00")
 
 func kubernetesmasteraddonsCalicoDaemonsetYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -787,7 +808,7 @@ func kubernetesmasteraddonsDefaultStorageClassYaml() (*asset, error) {
 	return a, nil
 }
 
-var _kubernetesmasteraddonsHeapsterDeploymentYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x54\x5d\x6b\xdb\x4a\x10\x7d\xd7\xaf\x58\xf4\x7c\x57\xb1\xef\x4d\x20\x77\xb9\xba\x10\x92\x94\x3e\x34\x4d\x48\xa0\x50\xaa\x62\x26\xab\x49\xb5\x78\xbf\xba\x3b\x32\x56\x4b\xff\x7b\x91\x2c\xd9\xb2\x62\x87\x10\xaa\x27\x73\x66\xe6\x9c\xf1\xd9\xb3\xcb\x39\x4f\x96\xca\x96\x82\x5d\xa1\xd7\xae\x31\x68\x29\x89\x1e\xa5\x48\x18\x0b\xe8\xb5\x92\x10\x05\x9b\x27\x8c\x11\x1a\xaf\x81\xb0\xad\x30\x36\xf4\xb4\x9f\x74\x96\x40\x59\x0c\x71\x40\x38\x53\x06\xbe\xa1\x60\xff\x2d\xeb\x47\x0c\x16\x09\xe3\x7b\x04\x1f\x09\xc3\x83\x47\xf9\x7f\xdf\xd7\xce\x1a\x03\xb6\x14\x5b\x80\xb3\xf4\xa4\xea\x5b\xd3\x31\xca\x79\x74\x75\x90\x98\xef\x28\xb3\x58\x1b\x03\xa1\x59\x80\x57\xa2\x48\x8b\x74\x37\x60\xc1\xa0\x60\x03\xd1\x16\x0e\xb8\x21\x89\x3b\xc1\x16\xfc\x5e\x63\xa4\x3d\x8c\x31\xe9\x6b\xc1\xce\x67\x66\x0f\x34\x68\x5c\x68\x04\x9b\x9f\xce\x6e\xd4\xa8\xa2\x95\x51\x6f\x26\x38\xe4\xd6\x45\x59\x3a\x7b\x8f\x51\xfd\x78\x95\x63\xde\x95\x0b\x0b\xd6\x36\x13\xcb\xa4\xaf\xf3\xf3\x99\x99\xa0\xb8\xa6\x00\x5d\x6d\x96\x9d\x4d\x8b\x9b\x0d\xf3\x6e\xc1\x83\x73\x7d\xc3\xe9\xb3\x32\x55\x01\x63\xe5\x74\x99\x9f\x4d\x2a\xe5\x36\x5c\xf9\x70\x24\x7c\x35\xcf\xfe\xce\x66\xfc\x11\x09\xb2\xf9\x74\xed\x21\x51\xf9\x91\x28\x78\xa7\x35\xf7\x18\x94\x2b\xf3\x7f\x66\xed\x37\x5d\x35\x92\x32\x40\x2e\xe4\xb8\xf6\xce\xa2\x25\x05\xfa\x58\x3c\x78\x67\xdd\x9b\x43\x72\x76\xe4\x8c\xff\x7d\x65\x46\x5e\x35\x8f\x76\x35\x3e\xf2\x15\xe8\x1a\xdf\x05\x67\xf6\xf9\x9e\x14\xea\xf2\x1e\x9f\xf6\xd1\x1e\xbf\x03\xaa\x04\x33\x48\x50\x02\x41\xd6\x7a\x30\x6a\xdb\x58\x72\xf3\x79\x71\x77\x7b\xb5\xf8\x78\x71\x73\xfd\xe7\xd5\xa2\x07\xf9\xa2\xe4\xc3\xdd\xc5\xe5\xa0\x6b\x5d\x89\x0f\xa8\x51\x92\x0b\x3b\x81\x2e\x2e\xa3\x17\x40\xb9\x13\x17\x05\xd3\xca\xd6\xeb\x64\x63\xde\x46\x70\x18\xd1\xf0\x88\x7a\xe4\xfa\xf2\x3c\x72\xf0\xfe\xc0\xdb\xb0\xc2\x10\x95\xb3\x82\x6d\x92\xd9\xe3\x60\xad\x23\x20\xe5\xec\x88\x24\xca\x0a\xcb\x5a\x63\xc8\x40\xfb\x6a\xba\x90\x0c\x8a\x94\x04\xcd\xbd\x2b\x05\x1b\x3d\x4a\x2f\x8f\x91\xd3\x18\x7a\x25\x96\x7e\xf9\x59\xa4\x4b\x6c\x8a\x54\x14\xe9\x65\x4f\xd8\x3d\x0a\xf1\xd6\xea\xa6\x48\xff\x2a\x52\xe7\xdb\x7e\x17\xba\x9e\xeb\xb5\x8a\x14\x8b\xf4\xd7\xd7\x56\x2f\xee\x19\x67\x80\x64\xf5\x61\xcf\x88\x63\x36\x4c\x4d\x00\xaf\x3e\x0d\x10\xae\x09\x6d\xfb\x33\x9e\xac\xe6\xed\x41\xcc\x93\xb1\xdb\x63\xa7\x0f\xd3\x1f\x72\x78\x62\x9d\xae\xbb\x0b\x19\x31\xac\x94\x44\xc1\x52\x0a\x35\xb6\xff\x68\x9b\x1f\xd1\x8d\xf0\xd8\x44\x42\x93\x3c\xbb\xca\x3d\x75\xf2\x3b\x00\x00\xff\xff\x3a\xcd\x91\x40\xda\x06\x00\x00")
+var _kubernetesmasteraddonsHeapsterDeploymentYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x54\xdf\x6b\xe3\x38\x10\x7e\xcf\x5f\x21\xfc\x7c\x72\x92\x5e\x0b\x3d\x71\x39\x28\xfd\xc1\x1d\x5c\xae\x25\x85\x83\x65\xbd\x04\xd5\x9a\x6e\x44\xf4\x6b\xa5\xb1\x89\x37\xe4\x7f\x5f\xe4\xd8\x8e\xe3\x4d\x4b\xb7\xec\xcb\xc6\x79\x48\xbe\xf9\xe6\x1b\xeb\x9b\x19\x51\x4a\x47\x6b\x69\x04\x23\x37\xe0\x94\xad\x34\x18\x1c\x05\x07\x39\x1b\x11\xe2\xc1\x29\x99\xf3\xc0\xc8\x74\x44\x08\x82\x76\x8a\x23\xc4\x08\x21\x2d\x27\x3e\xb9\x35\xc8\xa5\x01\x1f\x5a\x84\x12\xa9\xf9\x67\x60\xe4\xcf\x75\xf1\x04\xde\x00\x42\xf8\x1b\xb8\x0b\x08\xfe\xd1\x41\xfe\x57\xc3\x8b\xb9\x5a\x73\x23\xda\xc4\x98\x9a\x8c\x57\x0d\x35\xe9\xa3\x94\x06\x5b\xf8\x1c\x66\x07\xc9\x34\x14\x5a\x73\x5f\x2d\xb9\x93\x2c\x4b\xb2\xe4\x90\x60\xb8\x06\x46\x5a\xa1\x0e\xf6\xb0\x17\xe9\xde\x34\x7e\x3d\x7c\x29\x20\xe0\x11\x46\x48\xee\x0a\x46\x2e\x27\xfa\x08\xd4\xa0\xad\xaf\x18\x99\x9e\x4f\xe6\xb2\x17\x51\x52\xcb\x77\x08\x6c\xb7\xf2\x99\xa4\xff\x84\x2b\x21\xac\x09\x0b\x6b\xf1\x4e\x2a\x08\x55\x40\xd0\x0b\xe0\xe2\xde\xa8\x6a\xb7\xeb\x04\x02\xe4\x85\x97\x58\x5d\x5b\x83\xb0\xc1\x7e\x39\xdf\xb0\x8f\x35\x18\x41\x5f\x40\x47\x2b\xad\x2a\x34\xcc\x6d\x61\xfa\xef\x4a\x1b\xb3\x50\xbb\x0e\x23\x44\x47\xd6\x03\xc7\x15\x23\xe3\x18\xd9\x6e\xc1\x88\xdd\xee\x95\x06\xd7\x87\x58\x40\x90\x5f\xdf\xd4\x64\x67\xc5\xd2\x70\x63\xaa\x41\x97\x73\x57\xcc\x2e\x27\x7a\x80\xc2\x06\x3d\xaf\x63\x93\xf4\x62\x18\xdc\x77\x65\x56\x37\xe5\x64\x5e\x43\x38\xff\x2e\x8c\x2b\x0f\x61\x65\x95\x98\x5d\x0c\x22\xa2\xdb\x87\x59\x3b\x45\xb4\x9c\xa6\x67\xe9\x84\x3e\x01\xf2\x74\x3a\xe0\x77\x4b\xd0\xd1\x07\x04\x67\x95\xa2\x0e\xbc\xb4\x62\xf6\xfb\x24\x7e\x06\x04\x08\x28\x35\x47\xeb\x67\xb0\x71\xd6\x80\x41\xc9\xd5\x4b\x13\x4d\x6b\xeb\xde\x3d\xd7\x17\x2f\x8c\xe5\x1f\x6f\x1c\xeb\x57\xf3\x7f\xd9\xa9\x26\x04\x4c\xd9\x97\x28\xb9\x2a\xe0\xce\x5b\x7d\x00\xe3\xf3\x2c\x41\x89\x05\x3c\x1f\xa3\x0d\xbe\x57\xd7\x80\x5c\x70\xe4\x69\x6c\x5b\x8f\x16\xff\x32\x32\xff\xb0\x7c\xb8\xbf\x59\xfe\x77\x35\xbf\xfd\xf9\xd5\x82\xe3\xf9\xab\x25\x1f\x1f\xae\xae\x6f\x7f\xac\x4b\xfb\x9b\xa3\xb3\xf7\x94\xb9\xa0\x1d\x56\x37\xd2\x33\xb2\xdd\x0d\x7c\x35\x56\xc0\x23\x28\xc8\xd1\xfa\xc3\x29\xea\x35\xea\x5d\xe6\xd2\x8e\x6d\x60\x44\x49\x53\x6c\x6a\x52\x7b\xaa\x36\x45\xf1\x27\x50\xbd\x16\xaf\x2f\x03\xe5\xce\x9d\xb8\xe6\x4b\xf0\x41\x5a\xc3\xc8\x7e\x63\x1b\x9c\x1b\x63\x91\xa3\xb4\xa6\x27\x12\xf2\x15\x88\x42\x81\x4f\xb9\x72\xab\xe1\x0b\xe5\x5e\xa2\xcc\xb9\xa2\xce\x0a\x46\x92\xe4\x8d\x69\x68\x15\xf8\xa6\x12\x49\x3e\x6e\xb3\x64\x0d\x55\x96\xb0\x2c\xb9\x6e\x04\xf7\xae\x47\x8f\xb3\xe4\xb7\x2c\xb1\x2e\xf2\xad\xaf\x39\xb7\x1b\x19\x30\x64\xc9\xee\x53\xac\x17\x8e\x8c\xd3\x1c\xf3\xd5\xbf\x47\x46\xbc\x64\xc3\xd0\x04\xee\xe4\xff\x2d\x04\x1b\x04\x13\x7f\x86\x71\x39\x8d\x8d\x98\x8e\xfa\x6e\xf7\x9d\x3e\x2d\x7f\xca\xe1\x81\x75\xaa\x88\x3d\xa1\x01\x7c\x29\x73\x60\x24\x89\x5b\x1b\x4f\xd4\x0d\x29\xab\x53\xe8\x7e\xe6\x9a\xc0\xa1\x0e\x2d\xa7\xe9\x59\x3a\x19\x8d\xbe\x0d\x00\xdc\x60\x10\x55\xa5\x08\x00\x00")
 
 func kubernetesmasteraddonsHeapsterDeploymentYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -827,7 +848,7 @@ func kubernetesmasteraddonsHeapsterServiceYaml() (*asset, error) {
 	return a, nil
 }
 
-var _kubernetesmasteraddonsKubeDnsDeploymentYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x56\x4d\x6f\xe3\x36\x10\xbd\xfb\x57\x0c\xd4\x6b\x25\xcb\x59\xec\x6e\x40\xd4\x0b\x14\xf1\xa2\x0b\x14\xd9\x1a\x75\xda\x4b\xdd\x03\x4d\x4e\x62\x22\x14\x87\xe1\x90\x46\xbc\x45\xff\x7b\x21\xd9\x91\x25\xc5\x71\x9d\x1c\x8a\xfa\x94\xcc\x37\xdf\xe3\x3c\x4a\x7a\xf3\x3b\x06\x36\xe4\x04\x6c\x26\xa3\x7b\xe3\xb4\x80\x5f\xd1\x5b\xa3\x64\x34\xe4\xae\xc8\xc5\x40\xd6\x62\x18\x55\x18\xa5\x96\x51\x8a\x11\x80\x95\x2b\xb4\x5c\xff\x05\x70\x9f\x56\x18\x1c\x46\xe4\xc2\xd0\x58\xd9\xc4\x11\x43\xce\x18\x36\x46\xa1\x80\x2c\x86\x84\xd9\x2e\xf2\x92\x73\xe9\xbd\x68\x52\x72\xed\xb8\xb1\x6e\xda\xfe\x17\xe5\x08\xc0\xc9\x0a\x0f\x11\xf9\xc1\xc8\x5e\xaa\x27\x0f\x6f\x39\x62\x35\x62\x8f\xaa\x1e\x22\xec\x06\x66\x01\x17\x23\x00\x46\x8b\x2a\x52\x10\xe7\x37\x8d\x58\x79\x2b\x23\xee\x52\xba\x27\xad\x7f\xd2\x39\x8a\x0d\x1c\xfc\x64\x02\x60\xb5\x46\x9d\x2c\x86\x42\x5a\xbf\x96\xc5\x00\x86\x60\xa2\x51\xd2\xe6\x9e\xb4\x80\x2c\x3b\x33\x2d\x92\xc5\xb0\xef\x04\xd9\x1f\x7f\x2d\xb3\x7b\xdc\x2e\x33\xb1\xcc\xae\xf6\x05\x7f\xd4\x9a\x1c\xff\xe2\xec\x76\x99\x7d\xbf\xcc\xc8\xd7\xf1\x14\x9a\x98\xcf\x8f\x86\x23\x2f\xb3\xbf\xff\x7c\xea\xd7\xe5\xe9\x65\x30\x5e\xc7\xe2\x11\xf8\x00\x9e\x98\x68\x9c\x64\x53\x85\x6d\xd7\x7c\x48\xa9\x22\x77\x6b\xee\xda\x5a\xbb\x7f\xaf\xa5\x3f\x8c\x39\xbc\x05\x1d\x07\xf9\x1a\x1d\x69\x05\xd4\x13\x8d\xda\x12\x51\x1a\x87\xa1\xd3\x54\x86\xbb\xce\xc1\x73\xc8\xf2\x5c\x53\x25\x8d\x9b\xee\x0f\x57\x58\x52\xd2\x16\xd9\x20\xc6\x71\xee\x29\xc4\xe9\xa4\x2c\xdf\xbf\x1b\x38\x37\xd3\x8b\x81\x65\x37\x7c\xae\x4d\x98\x8e\x07\x07\x3c\x44\x9a\x4a\xde\xa1\x80\x1f\x0e\x18\xff\x9c\x56\x38\xfb\xba\x58\x78\x54\x9f\xda\x30\x6b\x36\xe8\x90\x79\x1e\x68\x85\x5d\x30\x6e\xa5\xb1\x29\xe0\xcd\x3a\x20\xaf\xc9\x6a\x01\xef\x3b\xde\x75\x8c\xfe\x27\x8c\xdd\x04\x00\x2f\xe3\x5a\x40\x36\x5e\xa3\xb4\x71\xfd\x2d\xaf\x5b\x6b\xc7\x59\x3f\x88\x42\x14\x70\x59\x5e\x96\x3d\x73\x7d\x45\x6b\xf4\xbf\xdc\xdc\xcc\x3b\x0e\xe3\x4c\x34\xd2\xce\xd0\xca\xed\x02\x15\x39\xcd\x02\x3e\x74\x53\x39\x29\x85\xcc\x9d\x39\x27\x1d\x6f\x34\x15\x52\x8a\x6d\xea\xe1\x0c\x07\xb2\xbb\x5c\xd7\xd3\xf5\x18\x6c\x59\x9e\x37\x73\x37\x04\x3d\xbb\x32\x35\xfe\x0d\xb1\x1d\x8f\x0f\x14\x49\x91\x15\xf0\xdb\x6c\xfe\x96\x82\x51\xf9\x13\x45\x6f\xae\x0e\x45\x03\x4a\x6d\x8e\x92\x78\x92\xa6\x36\xed\x05\x82\x26\x6f\x26\xe8\x5d\x79\x16\x05\x01\x99\x52\x50\xc8\xdd\xf9\xac\xa9\x4c\xe4\xfe\xc4\x15\x56\x14\xb6\x02\x26\x1f\xcb\x6b\xd3\xf1\x04\x7c\x48\xc8\xc3\x68\xe5\x53\x83\x6b\x75\xb4\x46\xaf\xc4\x4e\x35\xae\x29\xb9\x3e\xeb\xa7\xc5\x03\xa0\xaa\x33\xe6\x0d\x8e\xc3\x0d\x3c\xa5\x05\x4a\xaa\x35\xe6\x6c\xbe\x61\xbd\xe9\xe5\x60\xad\x1d\xe5\x35\x22\x76\x33\xb0\xd7\x7a\x88\x61\x3a\xb9\xf8\x58\x94\x45\x59\x4c\xbe\x3b\xa6\x12\xfb\xa0\xb1\x71\xb9\xd4\x3a\x14\x32\x78\x39\x3e\x33\xc5\x7f\x38\x27\xdc\xd2\x5d\x7e\x2b\x95\xb1\x26\x6e\xa7\xf9\x29\xa1\x99\x7d\x5d\x5c\x4b\x7e\xe8\x0b\x4d\x7b\xb1\x2b\xc9\x0f\x67\xef\xdb\xf1\xdd\x78\xfd\x9a\xbd\xbc\x63\xff\xb2\x5d\xc7\x79\xac\xf4\xd4\xb1\x25\xba\x4f\xbe\xfb\x88\x69\xbc\x95\xc9\xc6\x82\x37\xaa\xe8\x09\x3e\xb4\xd0\xc2\xa7\xb1\xc6\xcd\xd8\x25\x6b\x07\xf8\xa6\x60\xa7\xad\x74\xee\x71\x1a\x0a\xff\xdb\xfb\x8a\x86\xd2\x33\xbb\x3f\x13\xee\x26\xa4\x79\xa1\x6a\xe1\x1e\xd8\x1f\x92\xc1\x78\xea\x3e\x7c\x7e\x44\xf5\x65\x57\xfa\xbf\x7b\x7c\x9e\x21\x08\xff\xbb\xc7\x67\x3f\xea\xd9\xcb\x30\x98\xfb\xa5\xc7\xe0\x95\xa2\xfa\xfe\x15\x9a\x7a\x5c\x52\x3b\x15\xb4\xe3\x39\x59\xa3\xb6\x02\x66\xbb\x4b\xb9\x77\x38\xd2\xb8\xe8\x7d\x23\xd7\xbf\x15\xc6\xe1\xd7\x28\xb1\x00\x6b\x5c\x7a\x1c\xfd\x13\x00\x00\xff\xff\x2f\x13\x10\xaa\x27\x0c\x00\x00")
+var _kubernetesmasteraddonsKubeDnsDeploymentYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x57\x4b\x6f\xe3\x36\x10\xbe\xfb\x57\x10\xea\xb5\x92\xe5\x2c\xbc\xbb\x20\xea\x05\x8a\x78\xdb\x2d\x8a\x6c\x8d\x38\xed\xa5\xee\x81\x26\xc7\x31\x11\x8a\x64\xf8\x10\xe2\x35\xf2\xdf\x0b\x4a\xb2\x5e\x51\x0c\x25\x08\x02\xea\x90\x70\x9e\x9a\x6f\xe6\x1b\x99\x68\xfe\x0f\x18\xcb\x95\xc4\x28\x9f\x4d\xee\xb8\x64\x18\x5d\x83\x16\x9c\x12\xc7\x95\xbc\x54\xd2\x19\x25\x04\x98\x49\x06\x8e\x30\xe2\x08\x9e\x20\x24\xc8\x16\x84\x0d\x7f\x21\x74\xe7\xb7\x60\x24\x38\xb0\x09\x57\x53\x2a\xbc\x75\x60\x62\x0b\x26\xe7\x14\x30\x8a\x9c\xf1\x10\x95\x9a\x9f\x6d\x4c\xb4\xc6\x85\x49\xcc\xa4\x2d\x6e\xf3\x3a\xfe\x45\x3a\x41\x48\x92\x0c\x1a\x8d\xb8\xb9\xb4\x9a\xd0\x93\xc4\x1e\xac\x83\x6c\x62\x35\xd0\x90\x84\x29\x13\xb6\x18\x5d\x4c\x10\xb2\x20\x80\x3a\x65\xf0\xf8\xa0\x0e\x32\x2d\x88\x83\xd2\xa4\xfd\xa6\xe1\x10\x29\x95\x2b\xca\x51\xbd\x72\x78\x2c\xdd\x03\xf3\x02\x4c\x42\x84\xde\x93\xa4\x57\x06\xc3\x1d\xa7\x44\xc4\x5a\x31\x8c\xa2\x68\xa4\x99\x53\x02\x4c\x15\x09\x45\xff\x1e\x37\xd1\x1d\x1c\x36\x11\xde\x44\x97\x95\xc3\x5f\x19\x53\xd2\xfe\x25\xc5\x61\x13\xfd\xbc\x89\x94\x0e\xfa\xca\x14\x3a\x5f\x1f\xb8\x75\x76\x13\x3d\xfe\x77\x8a\xd7\xc6\xe9\xf9\x62\xbc\x0c\xc5\x81\xf2\x21\x74\x42\x22\x9c\x5c\x09\x9f\x41\x1d\x35\xee\x43\x4a\x95\xdc\xf1\xdb\x4a\x8a\x50\xf9\xef\x15\xd1\x27\x03\x84\xfa\x5d\xd0\x12\x28\x1d\xaa\x43\x04\x46\x21\xa3\xc9\xf1\xc8\x77\x28\xf9\xc3\x96\x65\xb9\x56\xca\xfd\xc6\x05\x94\xed\x71\x0d\x84\x85\x42\x3d\x3e\xf6\x32\x71\x99\xae\x3d\x42\xa6\xdd\x61\xc9\x0d\x46\xc7\xbe\x1a\x93\x36\x23\xf6\x3e\x36\x5e\x0e\xab\x1f\x8f\x20\x59\xed\x9d\x2a\xe9\x08\x97\x60\x5a\x6f\x4e\xcc\x6d\xfd\x5f\x70\x1c\xc5\x31\x53\x19\xe1\x72\x51\x55\x38\x11\x8a\x12\x91\x34\x95\x2d\x75\xa4\x8d\xb5\x32\x6e\x31\x4b\xd3\xf9\x87\x9e\x30\x5f\x5c\xf4\x6e\xca\x0a\xc6\x8c\x9b\xc5\xb4\x57\xe5\x46\x93\x67\xe4\x16\x30\xfa\xa5\x01\xfa\x4f\xbf\x85\xe5\xf7\xf5\x5a\x03\xfd\x52\xab\x09\x9e\x83\x04\x6b\x57\x46\x6d\xab\x71\x28\x9f\x1d\xe1\xc2\x1b\xb8\xd9\x1b\xb0\x7b\x25\x18\x46\xf3\x96\x74\xef\x9c\xfe\x1d\x5c\xdb\x00\x21\x4d\xdc\x1e\xa3\x68\xba\x07\x22\xdc\xfe\x47\x1c\x42\x33\x69\x9b\x9c\xc2\x09\xef\x89\xd1\xe7\xf4\x73\xda\xb9\x0e\x73\x12\x40\xf8\x76\x73\xb3\x6a\x09\xb8\xe4\x8e\x13\xb1\x04\x41\x0e\x6b\xa0\x4a\x32\x8b\xd1\xc7\xb6\xa9\xf5\x94\x82\xb5\xad\x3c\x67\x2d\xa9\xe3\x19\x28\xef\x6a\xd3\xf9\xe4\x69\xc7\xb5\x1b\x2e\x64\xd7\x41\xb0\x46\x79\x55\xe4\x5d\x00\xf4\xa4\x6f\x43\x97\x17\xc0\xb6\x24\xda\x28\xa7\xa8\x12\x18\xfd\xbd\x5c\xbd\xc6\xa1\xa3\xfa\x8c\xd3\x9b\xcb\xc6\xa9\x01\xc2\xf8\x20\x88\x67\x61\xaa\xcd\x9e\x01\x68\xf6\x6a\x80\x3e\xa4\xa3\x20\x30\x60\x95\x37\xb4\xa1\x8e\x70\x04\xcf\x78\x1b\x82\x70\x32\xc8\x94\x39\x60\x34\xfb\x94\x5e\xf1\x96\xc4\xc0\xbd\x07\xdb\xd7\xa6\xda\x17\x75\xcd\x06\x7d\x14\x2e\x5e\x44\x23\x61\xc3\x50\x6f\xb8\x3b\x84\xed\x08\x0f\x9d\x72\x9a\x4a\xbb\xeb\xa3\x66\xab\x36\x61\x9c\x98\xf2\x4a\x79\xd9\xce\x39\xee\xb1\x5f\x9f\x30\x11\xca\x82\xc5\xaa\x80\xad\x3f\xf0\x2f\x63\xc4\x61\x4e\xec\x06\x08\x92\x6e\xe2\x83\xbc\x46\x09\xdd\x43\x6c\xf9\x0f\x08\xac\x95\x36\x3d\x54\x48\xa5\x8a\x03\xba\x22\xef\xdd\x87\x05\x03\x66\x31\xbb\xf8\x94\xa4\x49\x9a\xcc\x7e\x1a\x62\xbc\x4a\x69\xca\x65\x4c\x18\x33\x09\x31\x9a\x4c\x47\x9a\xe8\x8f\x63\xd4\x85\xba\x8d\x77\x84\x72\xc1\xdd\x61\x11\x9f\x23\xcd\xe5\xf7\xf5\x15\xb1\xf7\x5d\xd2\xac\x87\x34\xec\x8b\xd1\xdc\x31\x3c\xe7\x83\xd3\x7d\x96\x32\x9e\xe7\x8b\x41\x5f\x81\x29\xde\xa9\xdf\xc7\xf5\xf9\xf9\xd6\xeb\x6b\x0f\x6d\xe5\xae\x55\x4e\xcc\x34\x48\xc7\x34\x6d\xc6\x16\xd2\x0a\xa5\xee\xbc\x6e\x7f\x02\x31\xd8\x11\x2f\x5c\x62\x73\x9a\x74\x36\x35\xaa\xfb\x08\x7d\x99\x32\xc8\xa7\xd2\x0b\xd1\x6b\x26\x6f\xc4\xa2\xde\x79\x55\xba\xd1\x9b\xc5\xc5\x45\xff\x8e\x8c\xfe\x64\xe3\x16\xd1\x03\xa3\x2f\xc2\xc6\xed\xdd\xdf\x7b\x0e\xee\x5c\xf3\x7f\x7d\x00\xfa\xad\x74\xfd\x7e\x5f\x0d\x4f\x2a\xd8\x59\x4a\xe9\xab\x97\xd2\x1b\x7e\x35\x54\xa9\x8e\x9e\xfc\x5e\xde\xdd\xd9\x7c\xf5\x36\x9c\xbf\x60\x19\x0e\xef\xc2\xf9\x3b\xee\xc2\xb7\xe0\x86\xee\x84\x33\x69\x57\x4a\x70\x7a\xc0\x68\x59\xce\x51\x25\x90\x8a\xc1\xba\xf3\xa3\x30\x3c\x5b\x70\xfd\x9f\x5f\xca\x62\x24\xb8\xf4\x0f\x93\xff\x07\x00\xfc\x27\xf7\x97\x18\x0f\x00\x00")
 
 func kubernetesmasteraddonsKubeDnsDeploymentYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -847,7 +868,7 @@ func kubernetesmasteraddonsKubeDnsDeploymentYaml() (*asset, error) {
 	return a, nil
 }
 
-var _kubernetesmasteraddonsKubeDnsDeployment15Yaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x56\xdf\x6f\xdb\x36\x10\x7e\xf7\x5f\x71\xd0\x5e\x27\x45\x6e\x91\x35\x23\xe6\x02\x43\x52\xac\xc0\xd0\xce\x98\xb3\xbd\xcc\x7b\xa0\xc9\x4b\x44\x84\x22\x19\xde\xd1\x88\x3b\xec\x7f\x1f\x24\x3b\x16\xad\x3a\x99\x53\x0c\x43\xf9\x64\xdc\xcf\xcf\xf7\xdd\x7d\x90\x0c\xe6\x77\x8c\x64\xbc\x13\xb0\x9e\x4e\xee\x8c\xd3\x02\x7e\xc5\x60\x8d\x92\x6c\xbc\xbb\xf4\x8e\xa3\xb7\x16\xe3\xa4\x45\x96\x5a\xb2\x14\x13\x00\x2b\x57\x68\xa9\xfb\x05\x70\x97\x56\x18\x1d\x32\x52\x65\xfc\x99\xb2\x89\x18\x63\x49\x18\xd7\x46\xa1\x80\x82\x63\xc2\x62\x1b\x79\x41\xa5\x0c\x41\xf4\x29\xa5\x76\xd4\x5b\xd7\x43\xff\xef\x27\x00\x4e\xb6\x38\x44\x94\x83\x91\x82\x54\x8f\x1e\xda\x10\x63\x3b\xa1\x80\xaa\x03\x11\xb7\x80\x49\xc0\xab\x09\x00\xa1\x45\xc5\x3e\x8a\xd3\x9b\x32\xb6\xc1\x4a\xc6\x6d\x4a\xfe\x4f\xbb\x27\x9d\xf3\xdc\x8f\x83\x1e\x4d\x00\xa4\x1a\xd4\xc9\x62\xac\xa4\x0d\x8d\xac\x46\x63\x88\x86\x8d\x92\xb6\x0c\x5e\x0b\x28\x8a\x13\xd3\xd8\x5b\x8c\xbb\x4e\x50\xfc\xf1\xd7\xb2\xb8\xc3\xcd\xb2\x10\xcb\xe2\x72\x57\xf0\x47\xad\xbd\xa3\x5f\x9c\xdd\x2c\x8b\x6f\x97\x85\x0f\x5d\xbc\x8f\x7d\xcc\xbb\x07\x43\x4c\xcb\xe2\xef\x3f\x1f\xfb\xe5\x3c\x3d\x3d\x8c\x97\xb1\x78\x64\x7c\x00\x8f\x4c\x74\x4f\x79\xc7\xd2\x38\x8c\xfb\xc6\x25\xc8\x78\x9b\xc1\x28\xa1\x28\x4b\xed\x5b\x69\xdc\x6c\xd7\xaa\xb2\x5e\x49\x5b\x15\xa3\x18\x47\x65\xf0\x91\x67\xd3\xba\x3e\x7f\x3d\x38\x4d\x2b\x6f\x51\xc0\x0f\x03\xea\x9f\xd3\x0a\xaf\x3e\x2e\x16\x01\xd5\xdb\x7d\x98\x35\x6b\x74\x48\x34\x8f\x7e\x85\x43\x7b\x80\x1b\x69\x6c\x8a\x78\xdd\x44\xa4\xc6\x5b\x2d\xe0\x3c\xf3\x36\xcc\xe1\x27\xe4\x3c\x01\x20\x48\x6e\x04\x14\x67\x0d\x4a\xcb\xcd\xa7\xb2\x6b\xad\x1d\x15\x87\x41\x3e\xb2\x80\x8b\xfa\xa2\x3e\x30\x77\xa4\x77\x5b\xfd\xfe\xfa\x7a\x9e\x39\x8c\x33\x6c\xa4\xbd\x42\x2b\x37\x0b\x54\xde\x69\x12\xf0\x5d\x9e\x4a\x49\x29\x24\xca\x70\x4e\x33\x2f\x9b\x16\x7d\xe2\x7d\xea\xf0\x1f\x86\x23\xca\x39\xee\xd0\x1d\xb0\xb0\x67\x6a\xde\xe3\xee\x87\x9c\xd5\xdf\x56\xe9\x38\xe8\xc9\xc9\x3c\x21\x7a\xf6\xca\x5b\x01\xbf\x5d\xcd\xbf\xa4\x20\xab\xf0\x4c\xd1\xeb\xcb\xa1\x68\x44\xa9\xcd\x51\x12\x9f\xa5\x69\x9f\xf6\x04\x41\xd3\x2f\x26\xe8\x75\x7d\x12\x05\x11\xc9\xa7\xa8\x90\x72\x7c\xd6\xb4\x86\xe9\x10\x71\x8b\xad\x8f\x1b\x01\xd3\x37\xf5\x07\x93\x79\x22\xde\x27\xa4\x71\xb4\x0a\xa9\x9f\x6b\x7b\xb4\x46\x56\xe2\xe8\xcd\x29\xa9\x1a\x2c\xc9\x7c\xc2\xee\xa2\xea\xd1\xb5\x39\x5f\x76\xa8\xed\x7a\x64\xef\x54\x00\xe3\x6c\xfa\xea\x4d\x55\x57\x75\x35\xfd\x66\x74\x8d\x7d\x90\xf5\xb7\xe5\x8d\x54\xc6\x1a\xde\xcc\xca\xe7\x4e\xf5\xea\xe3\xe2\x83\xa4\xfb\xc3\x53\xdd\xaf\x46\x2b\xe9\xfe\xe4\x8d\x3d\xbe\x5d\x2f\x5f\xd4\xa7\xb7\xf4\x5f\xf6\xf3\xf8\x94\x5b\x3d\x73\x64\xbd\xbf\x4b\x21\x17\x56\x8d\x37\x32\x59\xae\x68\xad\xaa\x03\xd9\x83\xfd\x68\xe1\xed\x99\xc6\xf5\x99\x4b\xd6\x8e\xe6\x9b\xa2\x9d\xed\xc5\x67\x37\xa7\xe2\x3f\xeb\x2b\x7a\x4a\x4f\xec\xfe\x99\xf4\xf5\x21\xbd\x4e\x77\xd2\x37\xb2\xdf\x27\x83\xfc\xdc\x3e\xbc\x7b\x40\xf5\x7e\x5b\xfa\xff\x93\xef\xcf\x26\x08\x5f\x9d\x7c\xef\xa0\x9e\x7c\x0c\x23\xdc\x4f\xc9\xe9\x0b\x65\xe9\xfc\x05\xaa\x74\x5c\x94\xb2\x0a\xda\xd1\xdc\x5b\xa3\x36\x02\xae\xb6\x4b\xb9\x73\x38\xaf\x71\x71\xf0\xdd\xd6\xbd\x15\xf2\xf8\x0b\xc9\x93\x00\x6b\x5c\x7a\xf8\x27\x00\x00\xff\xff\x8e\xfc\x3a\x78\xba\x0a\x00\x00")
+var _kubernetesmasteraddonsKubeDnsDeployment15Yaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x57\x5f\x6f\xdb\x36\x10\x7f\xf7\xa7\x20\xb4\xd7\x49\x96\x5b\x64\xcd\x88\xb9\xc0\x10\x77\xeb\x30\xa4\x33\xe2\x6c\x2f\xf3\x1e\x68\xf2\x12\x13\xa1\x48\x86\x47\x1a\x71\x8d\x7c\xf7\x81\x92\xa2\x7f\x55\x0c\x27\x28\x0a\x14\xd4\x43\xc2\xfb\xcb\xfb\xdd\xfd\x0e\x66\x56\xfe\x03\x0e\xa5\xd1\x94\xec\x66\x93\x3b\xa9\x05\x25\x57\x60\x95\xe4\xcc\x4b\xa3\x2f\x8c\xf6\xce\x28\x05\x6e\x52\x80\x67\x82\x79\x46\x27\x84\x28\xb6\x01\x85\xf1\x2f\x42\xee\xc2\x06\x9c\x06\x0f\x98\x49\x33\xe5\x2a\xa0\x07\x97\x22\xb8\x9d\xe4\x40\x49\xe2\x5d\x80\xa4\xd2\x3c\xc7\x94\x59\x4b\x4b\x93\x54\x68\x2c\x6f\x77\x6d\xfc\x9f\x27\x84\x68\x56\x40\xab\x91\xb6\x97\x68\x19\x7f\x92\xe0\x1e\x3d\x14\x13\xb4\xc0\x63\x12\xae\x4a\x18\x29\x79\x33\x21\x04\x41\x01\xf7\xc6\xd1\xd3\x83\x7a\x28\xac\x62\x1e\x2a\x93\xee\x4b\xe3\x61\x5a\x1b\x5f\x96\xa3\x7e\x72\xfc\x90\x6f\x41\x04\x05\x2e\x63\xca\x6e\x59\x36\x28\x83\x93\x5e\x72\xa6\x52\x6b\x04\x25\x49\x72\xa2\x99\x37\x0a\x5c\x1d\x89\x24\xff\x1e\xd6\xc9\x1d\xec\xd7\x09\x5d\x27\x17\xb5\xc3\x5f\x85\x30\x1a\xff\xd2\x6a\xbf\x4e\x7e\x5c\x27\xc6\x46\x7d\xe3\x4a\x9d\x0f\x0f\x12\x3d\xae\x93\xc7\xff\x9e\xe2\x75\x71\x7a\xbe\x18\x2f\x43\x71\xa4\x7c\x84\x54\x48\x1c\x0e\xf2\x86\x64\x7f\x60\x95\xe4\x95\x31\xfe\x37\xa9\xa0\x02\xeb\x0a\x98\x88\x69\x3f\x3e\xd6\x5e\x76\x46\x85\x02\x9a\xe4\xd2\x1a\x79\x5f\xd8\xfa\x86\x10\x28\xac\xdf\x2f\xa4\xa3\xe4\xf0\x38\x50\x13\x1a\x0b\x86\xf7\xa9\x0b\x7a\x5c\xfd\x70\x00\x2d\x9a\x60\xdc\x68\xcf\xa4\x06\xd7\x89\xc7\xdc\x6d\xf3\x5f\x74\x9c\xa4\xa9\x30\x05\x93\x7a\x5e\x3f\x3f\x53\x86\x33\x95\xb5\xcf\xae\x74\x34\xa6\xd6\x38\x3f\x9f\xe5\xf9\xd9\xdb\x56\x28\x0b\x76\x0b\x94\xfc\xd2\x56\xf2\xcf\xb0\x81\xc5\xa7\xd5\xca\x02\x7f\xdf\xa8\x29\xb9\x03\x0d\x88\x4b\x67\x36\x75\xbf\x55\xdf\x0d\x93\x2a\x38\xb8\xde\x3a\xc0\xad\x51\x82\x92\xb3\x8e\x74\xeb\xbd\xfd\x1d\x7c\xd7\x80\x10\xcb\xfc\x96\x92\x64\xba\x05\xa6\xfc\xf6\x73\x1a\x43\x0b\x8d\x6d\x4e\xf1\xc4\x5c\x29\x39\xcf\xcf\xf3\xde\x75\x6c\xc4\x58\xc8\x8f\xd7\xd7\xcb\x8e\x40\x6a\xe9\x25\x53\x0b\x50\x6c\xbf\x02\x6e\xb4\x40\x4a\x7e\xea\x9a\x62\xe0\x1c\x10\x3b\x79\xce\x3a\x52\x2f\x0b\x30\xc1\x37\xa6\xed\x1b\xda\xc1\xee\xf6\x5d\xcc\xae\x87\x42\x83\xd4\xb2\xcc\xbb\x2c\x72\x23\x7e\xf2\x22\x34\xa6\x25\x38\x1d\x89\x75\xc6\x1b\x6e\x14\x25\x7f\x2f\x96\xaf\x71\xe8\xb9\x3d\xe2\xf4\xfa\xa2\x75\xea\x80\x09\x39\x0a\xe2\x51\x98\x1a\xb3\x67\x00\x9a\xbd\x1a\xa0\xb7\xf9\x49\x10\x38\x40\x13\x1c\x6f\x87\x2e\x1e\x25\x0b\xd9\x85\x20\x9e\x02\x0a\xe3\xf6\x94\xcc\xde\xe5\x97\xb2\x23\x71\x70\x1f\x00\x87\xda\xdc\x86\xb2\xae\xc5\xa8\x8f\xd2\xc5\x8b\x98\x21\x52\x38\x0f\x4e\xfa\x7d\x5c\x3f\xf0\xd0\x2b\xa7\xab\xb5\xfb\x3e\x28\x89\x04\xd5\xa8\x55\xdc\x72\x69\x82\xee\xe6\x3a\x46\x31\x84\x14\x51\x6b\x59\x42\x34\x8d\x92\x3e\x71\x8c\xd2\x04\x67\x7c\x0b\x29\xca\xcf\x10\x49\x20\x6f\xe1\x2c\xa5\xda\xa4\xb1\xd0\x6a\x37\xb8\x8f\x64\x0a\x6e\x3e\x7b\xf3\x2e\xcb\xb3\x3c\x9b\xfd\x30\x20\x90\x52\x49\x99\xdb\xf4\x86\x71\xa9\xa4\xdf\xcf\xd3\x63\xec\xb2\xf8\xb4\xba\x64\x78\xdf\x67\x97\xa6\x9b\x23\x39\x9e\x3c\x64\xe3\x03\x31\x3a\x06\x47\x67\xeb\xf9\xc1\x1a\xf5\x15\x47\xea\x3b\x6a\x8c\xa1\xf6\xd8\x0a\xea\x5b\xed\x98\x9b\x46\xe9\x29\x2d\x55\x88\xb9\x46\x65\xcc\x5d\xb0\xdd\x65\x2c\xe0\x86\x05\xe5\x33\xdc\xf1\xac\xb7\x96\x48\xd3\x47\xe4\xfd\x54\xc0\x6e\xaa\x83\x52\x83\x66\x0a\x4e\xcd\x9b\xe5\x50\xa7\x9b\x7c\xb5\xb8\xb4\xec\xdf\x13\xa3\x7f\xb1\x9a\xca\xe8\x91\xfa\xe6\x71\x35\x0d\xee\xef\x83\x04\x7f\xac\xf9\x3f\x3c\x00\xff\x58\xb9\xfe\x76\xeb\xf5\x8b\x0a\xf6\xd8\x3b\x7f\x35\x7b\x7f\xc5\xf5\x5a\xa7\x7a\xf2\xe4\x0f\xf2\xee\xcf\xe6\xab\xd7\xc6\xd9\x0b\xb6\xc6\xf8\xd2\x38\xfb\xae\x97\x86\xd0\xb8\x34\x4a\xf2\x3d\x25\x8b\x6a\x8e\x6a\x81\x36\x02\x56\xbd\x9f\x27\xf1\xdb\x80\x1f\xfe\x10\x30\x48\x89\x92\x3a\x3c\xfc\x3f\x00\xab\x91\xd4\xff\xa1\x0d\x00\x00")
 
 func kubernetesmasteraddonsKubeDnsDeployment15YamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -887,7 +908,7 @@ func kubernetesmasteraddonsKubeDnsServiceYaml() (*asset, error) {
 	return a, nil
 }
 
-var _kubernetesmasteraddonsKubeProxyDaemonsetYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x54\xbb\x8e\xdb\x30\x10\xec\xfd\x15\x84\x7a\x1d\xef\x5a\xe1\x2e\x8d\x53\xa4\xc9\x03\x30\x90\x9e\xa6\xe6\x6c\xc2\x7c\x28\xbb\x4b\xc7\xfe\xfb\x80\x52\x6c\x51\xf7\x70\x2e\xaa\x84\xd9\xe1\xec\xec\xac\x44\x33\xb8\x9f\x20\x76\x29\x76\x0a\x27\x41\x2c\xaf\xac\x8f\x0f\x5b\x88\x79\x58\x1d\x5c\xec\x3b\xf5\xd9\x20\xa4\xb8\x81\xac\x02\xc4\xf4\x46\x4c\xb7\x52\xca\x9b\x2d\x3c\x97\x37\xa5\x0e\x79\x0b\x8a\x10\xf0\x9d\x4b\xda\xfa\xcc\x02\x6a\x19\x74\x74\x16\x9d\x6a\x84\x32\x9a\x91\x69\x53\x18\x52\x44\x94\x6e\x3c\xd4\x0e\x94\x4e\xe7\xb1\x22\x0e\xd4\xa9\x98\x7a\xac\x94\x8a\x26\xe0\x05\xa3\x40\x3c\x18\x7b\xc1\xf9\xcc\x82\xb0\xe2\x01\xb6\x98\x10\x84\xc1\x1b\xc1\x64\xa8\x36\x5a\x9e\xda\xec\x6d\x1b\xaf\xac\x28\x75\x69\x31\x1d\x8c\x62\x5c\x04\x5d\xc5\xda\x22\x16\x4c\xec\x67\xf5\x56\x35\x7a\x7f\x1e\x40\x45\xbb\xa9\xe0\x65\x9b\x56\x35\x6d\x5b\x28\x36\xc5\x67\xb7\x7b\xd2\x47\x43\xda\xbb\xad\x2e\x98\x87\xe8\xb9\xd6\x2c\x0f\x5d\x12\xb6\xae\xa7\xa7\xc7\x42\x5b\x4f\xc8\xda\xf5\xf4\x69\x26\xbb\x60\x76\x25\xff\xc7\x79\x41\x5f\x2e\xbe\x36\x03\x6c\x45\x7d\x23\xf1\xe9\x21\x70\xca\x64\x51\xa5\x57\xc0\x5f\x19\x2c\x0b\x4c\x29\x3b\xe4\x4e\x3d\xdc\xdf\x87\x2b\xca\xb0\x99\x9c\x9c\xd7\x29\x0a\x4e\x52\xd3\x07\x72\x47\xe7\xb1\x43\xdf\xa9\xf2\x7d\x5c\x4b\xc7\xe4\x73\xc0\xd7\x94\x63\xad\xdf\xaa\x50\x90\x1f\x46\xf6\x9d\x6a\x34\xc4\x6a\x66\xaf\x2d\x48\xb8\xa9\x64\xa7\x39\x98\x7d\x3b\x96\xda\x7d\x62\x59\x18\x37\xfd\xf7\xe8\xcf\x2f\x7a\xbe\xa1\x3e\x47\xf6\x5a\x1e\x62\xdb\xb9\xfe\xdf\xf2\x1f\xd9\x73\xbd\x91\xa9\xf6\xaf\x36\x65\xd2\x6f\x90\xdf\x89\x0e\x0b\x7c\xca\xb3\xfa\x5e\x0b\x71\xb4\x52\x6f\xe3\xaf\xb5\xcc\xa4\x79\x6f\x08\xda\x9a\x31\x41\xf7\xec\xac\x59\x84\x70\x23\xe1\xdb\xda\x1f\x19\xfb\x9d\xa1\x6f\x0b\xbf\xb7\xae\x1b\xcb\x2a\x3f\xf7\x06\x1e\x56\x12\xcd\x92\xe5\xd6\xbb\x5b\x5e\x66\x89\x3b\xe5\x5d\xcc\xa7\x3f\x01\x00\x00\xff\xff\x50\xe1\x1c\xc9\x2b\x05\x00\x00")
+var _kubernetesmasteraddonsKubeProxyDaemonsetYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x54\xcb\x6e\x1b\x31\x0c\xbc\xfb\x2b\x88\xbd\x2b\x4a\xae\x8b\xc4\x40\xe1\xde\x8a\xb6\x01\x0c\xf4\x2e\x6b\x19\x5b\xb0\x1e\x5b\x92\x72\xed\xbf\x2f\xb8\xc9\x7a\xd7\x79\xb8\x29\xec\x83\x30\x1c\x0d\x87\x43\x5b\xae\x0f\xbf\x90\x38\x94\xdc\x02\x1e\x05\xb3\x1e\xd9\x1e\xee\x36\x28\xee\x6e\xb1\x0f\xb9\x6b\xe1\xab\xc3\x54\xf2\x1a\x65\x91\x50\x5c\xe7\xc4\xb5\x0b\x80\xe8\x36\x18\x59\x4f\x00\xfb\xba\x41\xca\x28\xc8\x37\xa1\x58\x1f\x2b\x0b\x92\x61\xa4\x43\xf0\xd8\x42\x23\x54\xb1\x19\x98\xbe\xa4\xbe\x64\xcc\xd2\x0e\x97\x4c\x4f\xe5\x78\x1a\x2a\x12\x90\x5a\xc8\xa5\xc3\x05\x40\x76\x09\x5f\x31\x14\xe2\xde\xf9\x11\xe7\x13\x0b\xa6\x05\xf7\xe8\xd5\x84\x60\xea\xa3\x13\xd4\x33\xc0\xdc\x28\xc0\xa5\xd9\xeb\x36\xde\x58\x01\x18\x5b\xe8\xc7\x97\x2c\x2e\x64\xa4\xb3\x98\x01\x5f\x52\x72\xb9\x1b\x01\x00\x03\x8d\xdd\x9d\x7a\x24\x35\xda\xcc\xe0\xcb\x36\x06\x1a\x63\x94\xe2\x4b\x7e\x0a\xdb\x07\x7b\x70\x64\x63\xd8\x58\xc5\x22\x8a\x9d\x6a\x73\x91\xc6\x98\x31\x61\x1f\x3a\x7a\xb8\x57\xda\xea\x19\x59\x85\x8e\x96\xcd\x80\x3c\x6a\xaf\x2f\xb4\xe5\xe5\xf9\x6e\x48\x6e\xab\xeb\xb8\x9f\xf6\xf5\x6d\x64\xae\x7b\xf4\xcb\xa9\xcd\x3b\x0b\x50\x18\x80\x90\x4b\x25\x8f\xb3\x30\x15\xfc\x5d\x91\xe5\x02\x03\xf0\x7d\x6d\xe1\xee\xf6\x36\x9d\x51\x46\x5f\x29\xc8\x69\x55\xb2\xe0\x51\xe6\xf4\x9e\xc2\x21\x44\xdc\x62\xd7\x82\xfe\x5c\xce\xa5\x43\x89\x35\xe1\xf7\x52\xf3\x5c\xdf\x40\x52\xe4\xd1\xc9\xae\x85\xc6\xa2\x78\xcb\x1c\xad\x47\x12\x9e\xa6\x18\xe7\x60\x8e\x66\x28\x99\x5d\x61\x99\x95\x09\x5d\xf7\x33\xc7\xd3\xab\x9e\xef\xa8\x4f\x91\xbd\x95\x47\xf1\x66\xaa\xff\xb7\xfc\x67\xd6\x3e\xb6\x9a\x6a\xff\x6a\xa3\x93\xfe\x40\xf9\x53\x68\x7f\x81\x3f\xe7\x79\x8e\xd2\x0c\xc4\x21\xc7\x17\x44\xbf\xfd\x8b\xb5\xca\x64\x79\xe7\x08\xad\x77\x43\x82\xe1\x29\x78\x77\x11\xc2\x95\x84\xaf\x6b\x7f\x66\xec\x0f\x86\xbe\x2e\xfc\xd1\xba\xae\x2c\x4b\xff\xeb\x6b\x8c\xe8\xa5\xd0\x24\xa9\x8f\xe0\xcd\xc4\xd5\xb7\xad\x70\x0b\x31\xe4\x7a\xfc\x3b\x00\xc3\x15\x44\xcc\x3a\x05\x00\x00")
 
 func kubernetesmasteraddonsKubeProxyDaemonsetYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -907,7 +928,7 @@ func kubernetesmasteraddonsKubeProxyDaemonsetYaml() (*asset, error) {
 	return a, nil
 }
 
-var _kubernetesmasteraddonsKubernetesDashboardDeploymentYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x92\x4f\x6b\xdb\x40\x10\xc5\xef\xfa\x14\x83\xef\x8a\x63\x7a\x69\x96\x52\x28\x35\xf4\xd2\x83\xc0\xa5\xf7\xd1\xea\x11\x2f\x99\xfd\xc3\xce\xc8\x8d\xbe\x7d\x11\x71\x2c\x09\x42\x3c\xa7\xe1\xcd\xd3\xd3\x4f\xa3\xe1\x12\xfe\xa2\x6a\xc8\xc9\x11\x5e\x0d\x69\x6e\x75\x7f\x39\xf4\x30\x3e\x34\x2f\x21\x0d\x8e\x8e\x28\x92\xa7\x88\x64\x4d\x84\xf1\xc0\xc6\xae\x21\x12\xee\x21\x3a\x77\x44\x2f\x63\x8f\x9a\x60\xd0\x87\x90\xf7\x5e\x46\x35\xd4\x56\x51\x2f\xc1\xc3\xd1\xce\xea\x88\xdd\x9b\xf3\xab\xb6\x5c\x8a\x5b\x3d\xd2\x0e\xac\xe7\x3e\x73\x1d\x1a\xa2\xc4\x11\x9f\x0e\xb5\xb0\xbf\x3a\x5a\x9d\xd4\x10\x1b\x2d\xf0\x33\x47\x45\x91\xe0\x59\x1d\x1d\x1a\x22\x85\xc0\x5b\xae\x6f\x84\x91\xcd\x9f\x7f\xaf\x90\xef\xa3\x18\x62\x11\x36\x5c\x03\x56\x9f\x3e\x97\x6c\xb2\xee\xa7\x11\xbd\x63\xce\xe5\x73\x32\x0e\x09\xf5\x96\xd0\x12\xd7\x67\x75\x74\x0b\x0c\x91\x9f\xe1\xe8\xdb\x92\x77\x7c\x8f\x3b\x15\xf8\xef\x5b\x63\x37\x8a\x74\x59\x82\x9f\x1c\xfd\x90\x7f\x3c\xe9\x6d\x2e\xe1\x82\x04\xd5\xae\xe6\x1e\x0b\x30\xd1\xd9\xac\xfc\x82\xad\x25\xa2\xc2\x76\x76\xb4\xdb\xef\xb6\x6a\xae\xe6\xe8\xe9\xf1\xe9\x71\x25\x87\x14\x2c\xb0\x1c\x21\x3c\x9d\xe0\x73\x1a\xd4\xd1\x97\xb5\xc3\x42\x44\x1e\xed\xa3\xe1\xa7\x3f\x7a\x79\xe9\x6a\xc3\xed\xb2\xb5\xee\x23\x9c\x52\xb3\x65\x9f\xc5\xd1\x9f\x9f\xdd\x55\x4f\x79\xc0\x69\x73\x08\x73\xcd\xd7\xfd\xb0\xbd\xd9\xac\x8e\x24\xa4\xf1\x95\x9a\xff\x01\x00\x00\xff\xff\x21\x75\x73\x99\x15\x03\x00\x00")
+var _kubernetesmasteraddonsKubernetesDashboardDeploymentYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x53\x41\x8b\xdb\x3c\x10\xbd\xfb\x57\x0c\xb9\x3b\xd9\xf0\x5d\xbe\x15\xa5\xb0\x6c\x68\x29\xb4\xd4\x6c\x4a\xef\x13\x79\xba\x11\x2b\x69\x84\x66\x9c\xc6\x84\xfc\xf7\xa2\xc6\xeb\xd8\x10\xb6\x2d\xd6\x41\x9e\x79\x7a\xf3\xe6\x49\x83\xc9\x7d\xa7\x2c\x8e\xa3\x01\x3a\x2a\xc5\xb2\x95\xd5\x61\xbd\x23\xc5\x75\xf5\xe2\x62\x6b\x60\x43\xc9\x73\x1f\x28\x6a\x15\x48\xb1\x45\x45\x53\x01\x78\xdc\x91\x97\xb2\x03\x78\xe9\x76\x94\x23\x29\xc9\xd2\xf1\xca\xfa\x4e\x94\x72\x2d\x94\x0f\xce\x92\x81\x85\xe6\x8e\x16\x17\xe4\xff\x52\x63\x4a\x66\x72\xa4\x6e\x51\xf6\x3b\xc6\xdc\x56\x00\x11\x03\xbd\x99\x94\x84\x76\x40\xd4\xd2\x8b\x52\xa8\x24\x91\x2d\x3a\x32\x25\xef\x2c\x8a\x81\x75\x05\x20\xe4\xc9\x2a\xe7\x92\x01\x08\xa8\x76\xff\x79\x22\xf9\xcf\x52\x94\x42\xf2\xa8\x34\x10\x4c\x5a\x07\x98\xb7\xff\x37\x6c\x00\xaf\x32\xcb\x67\x39\x2a\xba\x48\x79\x64\xa8\x01\xf3\xb3\x18\x18\x7e\x01\x5c\xc0\x67\x32\xf0\xee\xca\xb7\x79\xa5\xdb\x26\xb2\xef\xe7\xc0\xa6\xf3\xbe\x61\xef\x6c\x6f\xe0\xc1\xff\xc4\x5e\xc6\xbc\x77\x07\x8a\x24\xd2\x64\xde\x0d\xcd\x5c\xd6\x5e\x35\x7d\x24\x9d\x86\x00\x12\xea\xde\xc0\x62\xb5\x98\x47\x39\xab\x81\xfb\xbb\xfb\xbb\x49\xd8\x45\xa7\x0e\xfd\x86\x3c\xf6\x5b\xb2\x1c\x5b\x31\xf0\xdf\x14\xa1\x2e\x10\x77\x7a\x2b\xf9\xe6\x45\x5f\x8b\x4e\x1c\xae\xaf\xae\x35\xb7\xe4\xa4\xcc\xca\x96\xbd\x81\x6f\x8f\x4d\x75\x3a\xb9\x1f\xb0\xfc\x24\x0f\x6d\xcb\x51\x9e\x98\xf5\x83\xf3\x74\x79\x31\x4f\x84\xed\xd7\xe8\xfb\xf3\x79\x3c\x2e\x64\xbb\xec\xb4\x7f\xe4\xa8\x74\x9c\x79\x92\x07\xf4\x9c\xc3\x40\x79\xd4\x23\xec\xc0\xbe\x0b\xf4\x85\xbb\x38\x97\x7c\x69\x53\x43\x1a\x63\x00\xa1\xa0\x9a\xdf\x3e\xaf\x4a\xe6\x74\xa2\xd8\x9e\xcf\xff\x26\xf9\x52\x70\xac\x75\xab\x12\x85\xa4\xfd\xc6\x65\x03\xa7\xf3\x58\x64\x70\x9f\x5b\xda\xce\x06\xa4\xac\x32\xf5\xcb\xeb\x95\x94\x59\x66\x31\xe0\x5d\xec\x8e\x50\xfd\x1a\x00\x15\x17\xeb\xe3\x2d\x04\x00\x00")
 
 func kubernetesmasteraddonsKubernetesDashboardDeploymentYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -947,7 +968,7 @@ func kubernetesmasteraddonsKubernetesDashboardServiceYaml() (*asset, error) {
 	return a, nil
 }
 
-var _kubernetesmastercustomdataYml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x5a\x6d\x73\x1a\xb9\xb2\xfe\xce\xaf\xe8\x9d\xa4\x8e\xe3\x3a\x16\x63\x27\x4e\xf6\x2e\x7b\xd9\x5b\x18\x26\x0e\x15\x0c\x14\xe0\xdd\x7b\xee\xee\x29\x4a\x8c\x1a\xd0\x7a\x90\x26\x92\xc6\x86\xc4\xfe\xef\xb7\x24\x0d\x2f\xe6\xc5\xc6\x3e\x59\xef\x17\xa8\x91\x5a\xdd\x4f\xb7\x5a\x52\xeb\x99\x79\x15\x27\x32\x63\x24\x96\x62\xc8\x47\x85\x42\x4a\xe3\x2b\x3a\x42\x5d\x2a\x00\x01\x34\x31\xb3\xff\x7f\x7e\xb1\xbf\x46\xd1\x18\x95\xcc\x0c\x16\x0a\x37\x8a\x1b\xec\x0f\x79\x62\x25\x09\xa4\xd4\x8c\x4b\x10\x84\x68\xe2\x50\xcf\xb4\xc1\x09\xcb\xff\x43\x26\xe3\x2b\x54\x45\x8d\xea\x9a\xc7\x58\x64\x61\x9c\x20\x55\xfd\x89\xcc\x84\xe9\xa7\x4a\xa6\x74\x44\x0d\x97\xa2\x3f\x4c\xe8\x48\x17\x2d\x8e\xa0\x00\x90\xa2\x9a\x70\xad\xb9\x14\xba\x04\xc1\xf1\x87\xd3\x53\xdb\x2a\x6f\x04\xaa\x12\x04\x4a\x4a\x63\x9f\x63\x29\x0c\x0a\x53\x82\xdb\x02\x00\xc0\xef\x5d\x6f\xe5\xdf\xee\xe9\xc2\x9a\xf8\x68\xb5\x96\xf5\x98\x2a\x64\x85\x27\x22\xc5\x29\xc6\x7d\x6d\xa8\x32\xdf\x13\x56\x34\xc5\xb8\x6b\x95\x96\xd7\x1e\xc3\x4c\xab\x70\xc0\x45\x0e\x04\x18\xc5\x89\x14\x40\x3e\xc1\x90\x95\xc2\x10\x08\xd1\x46\x2a\x3a\x42\xc2\x14\xbf\x46\x55\x96\xd7\xa8\x12\x3a\x03\x42\x06\x3c\x2d\x7f\xfb\xf6\x9b\xa2\x69\x45\xff\x4a\x15\xa7\x83\x04\x21\xf0\x7a\xce\x14\x67\x23\xac\x72\xa6\x82\xbb\xbb\xf5\x10\x78\x91\xd0\x9b\x2a\xfe\xa9\xa5\x78\xb6\x97\xdf\xdc\x2f\x40\x90\xf0\x6b\x24\x0a\x2d\x58\x0c\x4a\x60\x54\x86\x47\x8b\x3e\x39\xca\xd1\x07\x25\x08\xac\x3d\x62\x93\x28\xb8\x27\x20\x53\xa3\x83\xd2\x52\xa3\x6d\x9f\xd0\x29\xd1\xfc\xab\x55\x18\xbc\x3f\x9e\x2c\x06\xcc\xfb\x9c\x16\xdb\x17\xe4\x1d\x77\x05\xff\xbb\xe6\xf0\x55\x36\x40\x25\xd0\xa0\x0e\x63\x54\x46\x87\x31\x2d\xc6\xca\xec\xf6\x1a\x45\x2c\x19\x17\xa3\x12\x04\x03\xaa\xf1\xc3\x5e\xa1\xd8\x98\x8a\x98\x56\x51\x19\x3e\xe4\x31\x35\xb8\x65\x1e\x36\x60\xd1\x94\xdb\x44\x44\xf5\x12\xe8\x68\xca\xbb\xce\xd8\x13\x41\xc6\x09\x47\x61\x5e\x24\x7e\xce\xd2\x6e\x78\xd7\x54\x85\x09\x1f\x38\x88\x09\x1a\xf7\xef\xb7\xb4\x67\xe7\x33\x4d\xf9\xaf\xa8\xec\xa0\x12\x5c\x9f\xb8\xa6\x2b\x2e\x58\x09\xaa\x7e\xab\xb4\x0d\x71\x92\x69\x83\xca\xee\x96\x00\x40\x40\xd0\x09\x96\x20\x91\x31\x4d\xf2\xae\x3c\x1b\xf3\xa7\xd2\x22\x6b\xe3\xa5\x2b\x84\x66\x66\x2c\x15\x37\xb3\x12\x3c\x94\xa3\x8b\xb1\x3e\x31\x4a\xcb\x30\xa1\x1a\x50\xc3\x27\x10\xc4\x52\xc4\xd4\xbc\x39\x18\x1b\x93\xea\x52\x18\x1e\x1c\xc1\x75\x1e\x43\xfd\xe6\x60\x42\x2d\x86\xb6\xe2\xd7\xd4\x60\x3d\xad\x30\xa6\xf4\xc1\xe1\xef\xb1\x4c\x67\x75\xc1\x70\xfa\x66\x43\xb6\x35\x1c\x6a\x34\x07\x87\x87\xff\x3e\x82\x83\xd2\xe9\xe9\xbb\x83\x43\x1b\x78\x0b\x22\xd3\x1b\x7e\xfb\x49\xca\x61\xda\xfe\x15\x77\x5d\x17\x59\xf1\x7a\xa7\xaf\x8b\x9c\x5a\x1f\x7c\x85\xbb\x03\xe4\x07\x5d\xe1\xcc\x4f\x8b\x9d\xc9\xa9\x59\xc0\xcb\x9f\x57\xe1\xf8\xe9\xd8\x36\x55\x39\xf4\xfb\xde\x6c\x4e\x6c\xae\xd3\xdb\xcb\x94\x72\xee\xe5\x76\xb6\x0a\x2e\xb2\x75\xdd\x85\x09\x15\x7c\x88\xda\x68\xd7\x48\x96\x2b\x7f\x46\x27\xc9\x1e\xeb\x6a\xf4\x95\xa7\x0f\xa5\xf3\x0f\x3f\x0c\xb8\xa0\x6a\x96\xe7\xf5\x45\xa5\xdb\x8b\x3a\xfd\xcf\x97\x67\x51\xa7\x19\xf5\xa2\x6e\xbf\xd2\xae\x77\xa3\xce\xaf\x51\xa7\x7f\xf6\xe1\xb4\x7f\xfe\x7f\xf5\x76\xbf\xdb\xeb\xec\x0d\xd8\x1a\x52\x32\x49\x50\x91\x09\x15\x74\xf4\x82\xc8\xab\xad\x66\xaf\xd3\x6a\x34\xa2\x4e\xff\xa2\xd2\xac\x9c\x3f\xd7\x05\x1d\x8f\x91\x65\xc9\x0b\x22\xef\x56\x3f\x45\xb5\xcb\xc6\x73\x01\x53\xc6\xa4\x78\xf1\x70\x57\x6a\xb5\x56\xf3\x89\x91\x76\x48\x73\xd4\x4c\x68\x32\x2f\xaf\xfe\x52\xcc\x1e\xa8\x45\xde\xaf\x35\xbb\x7d\x9b\xdd\xf5\x6a\xf4\x4c\xc4\x0c\xd3\x44\xce\x26\x76\x83\x79\x49\xd0\xb5\xa8\xdd\x68\xfd\xeb\x22\x6a\xf6\x9e\x81\x3b\x55\x72\x3a\x23\xbe\xac\xd3\xf8\x72\xc0\xdb\x9d\xd6\xff\xfe\xab\x5f\xab\x44\x17\xad\x66\x37\x7a\x06\x72\xdf\x42\x18\xd5\xe3\x81\xa4\x8a\xfd\x0d\xd1\xcf\x93\xbd\x56\xe9\x7e\x3a\x6b\x55\x3a\xb5\xff\x68\x26\x36\xfc\x79\xe1\xfc\xdf\x70\xe6\xf9\x6b\x61\x8c\x34\xb5\x07\xda\x4b\xba\xf0\x29\xaa\xb4\xdd\xe3\x77\x80\xfd\xb2\x99\xb4\x40\xfe\xdc\xec\x61\x38\xa4\x59\x62\x16\x97\xbe\x38\xa1\x5a\xbf\x04\xf2\x5a\xf4\xb1\x72\xd9\xe8\xf5\xbb\xbd\x56\xa7\x72\x1e\xf5\xab\x8d\x4a\xb7\xbb\x86\xfd\xdb\x37\x3e\x04\xfc\x02\xc5\x96\x8a\xc7\xa8\x8d\xa2\x46\xaa\xb6\x92\xf6\x1a\x56\xfc\xbc\xf0\xc5\x97\xca\xc5\x26\x9a\x1b\xa9\xae\xda\x32\xe1\xf1\xcc\x5e\x86\x12\x1e\x4b\x5b\x48\x3e\x12\x02\x2f\x98\x73\x13\x13\x9a\xbe\x84\xf7\xd5\x4a\xa3\x5e\x6d\xd9\xca\xe2\x63\xfd\xfc\xa2\xd2\x7e\xda\xa4\xe5\x88\x5f\x74\xe3\xcd\x11\xef\xd8\x74\xbf\x7d\x43\xc1\x36\xef\x72\x6b\xdc\x87\xbb\x2f\x99\x84\xe0\xd4\x28\x1a\x9b\x39\x09\xf2\x7c\xca\xe3\x52\x70\xe3\xf9\x8e\x1a\xea\x58\xf1\xd4\x70\x29\xca\x9f\xbd\x19\xc8\xcd\x70\x29\x9c\x48\x07\xbf\x64\x5c\xa1\x2e\xdf\xa7\x60\x5c\x5f\x65\x68\x50\x6d\xeb\xa8\x4a\xc1\xb8\x55\xd1\xa6\x66\x1c\x4d\xb9\x36\xba\xfc\x83\xe3\x50\x5c\xf5\xed\x98\x94\xdc\xad\xc2\x16\x1a\xa6\xc7\x27\x28\x33\xe3\xa8\x97\x2e\xc6\xe5\xe3\x1c\x89\xe3\x7b\xca\x52\x90\x21\xe5\x49\xa6\x70\xb5\xd9\xca\xbd\xd7\xf7\x69\x9b\xb6\xc2\xb2\xb3\x35\xb9\x62\x5c\x01\x49\x21\x34\x93\x74\x6e\x99\x71\xb5\x45\x7c\x8d\xe8\x49\xb3\x24\xd9\x72\xe7\x5d\x66\xd7\xa7\x59\x8a\xca\x3e\x76\x53\x8c\xe7\x17\xb0\x07\x55\xaa\x4c\x00\x21\x6a\x02\xe4\x7a\x1d\x4f\x29\x94\xa9\x59\x79\x7e\x92\x65\x70\x46\x06\x54\x8f\x81\xc4\x10\xc4\x29\x84\xe3\xb9\x08\xac\x29\x0e\x83\x5d\x91\xda\xc0\xb4\xaa\x64\xfb\x0c\xae\x51\x65\xb6\x2b\x1e\x4f\x24\x03\xfa\xcf\xe9\xae\x31\x7e\xd6\xeb\x42\x1b\x9a\x24\x7e\xd6\x7f\xa3\xc2\x20\x3b\x9b\x95\x27\x59\x62\x38\xb1\x37\xbd\xa2\xa1\x6a\x84\x66\x83\x19\xf3\xdb\xef\x9c\x51\x78\xf6\x4a\xb0\x87\x70\x23\xea\xf5\xab\x8d\x4b\x7f\x1c\x34\xbb\x5b\xa8\x3a\x6b\xa5\x26\x74\x9e\xa1\xf5\xf6\x7c\x92\xe7\xa3\x2b\xed\x7a\xdf\xdf\xd1\x56\x46\xff\x1d\xd7\xfe\x39\xa0\xfa\x45\xe5\x3c\xda\xe1\xc8\x03\x49\x3b\x1f\xde\x8c\x7a\xbf\xb5\x3a\x9f\xfb\xed\xc6\xe5\x79\xbd\xe9\x99\xd0\x5a\xab\xfa\x39\xea\xf4\x5b\xed\x5e\xb7\x7c\x4f\xb8\x13\x9d\xd7\x7d\x11\xe0\x2f\x4d\x95\xb3\xc6\x36\xd3\x0a\x47\xdc\xa2\xef\xfa\xcb\x9c\x6d\xdc\x30\xdb\xaa\x45\xfd\x46\xe5\x2c\x6a\x74\xcb\x4a\x26\x58\xf6\xfe\xde\x93\x69\xb7\x6a\xfd\x7a\xf3\x63\xa7\xe2\x6e\x97\x95\x7a\x33\xea\xec\xe1\x6d\x5b\xb2\xba\x18\x2a\x5a\x95\xc2\x50\x2e\x50\xcd\xbd\x7e\x7c\xdf\x4d\x70\x8f\xfd\xf6\xd9\x47\xc5\xdc\xad\x87\x0b\xa8\xc0\xad\x5d\xfa\x35\x53\x18\xc6\x73\x17\xf4\x12\xde\x78\x0b\xb2\x1f\xdf\xbf\xdf\x23\xff\x5f\xfd\xb0\xd8\x32\x0a\x9e\xbc\x32\x40\x30\xaf\x20\x46\x06\x8a\x17\x79\x7a\xfa\xda\xa1\x2a\x33\x61\xe0\x24\x9f\xb7\x57\x50\xb1\x90\x80\x49\xd4\x20\xa4\x01\x9d\xa5\xa9\x54\x06\xcc\x8d\x84\x86\xa4\xec\x8c\x26\x54\xc4\xa8\xf4\x9b\xc6\xd9\x21\xe8\x31\x55\x5c\x8c\xc0\x8c\x11\x34\x9d\x20\x08\x1e\x03\x15\x0c\x06\x34\xbe\x42\xc1\xc0\x8e\x2d\xce\x35\x6b\xa0\x60\xcb\x12\xaa\x64\x26\xd8\x91\x1b\x55\x17\x06\x95\xa0\x09\x34\xce\xde\xd4\xad\xca\xc4\xa6\x94\xd0\x30\x94\x0a\x16\xcc\x0c\x18\x45\x87\x43\x1e\x83\x14\x4e\x25\x9c\x9e\x9e\xbe\x73\x86\xac\x8e\x68\xba\xd4\x11\x59\x1d\x4b\xa9\x77\xb9\xed\xde\x98\x6b\xa8\xb7\x7b\x2e\x8b\x54\x96\xa0\x1d\x28\x40\x21\xe3\x0a\x63\xa3\xa1\xde\x38\x5b\x18\x31\x72\x31\x1c\xb8\x70\x26\x52\xe5\x5e\xc4\x58\x5f\xe3\x31\xe5\xfe\x14\xe5\xa9\x71\xab\x18\x88\x01\x41\x0d\x90\x0a\xb4\x3b\x51\xa7\x75\xd9\xab\x37\xcf\xed\xc1\x64\xe2\x14\x08\x61\x4b\xc4\xe4\x4f\xe8\x44\xb5\x7a\x27\xaa\xf6\x80\x10\x23\xc9\x02\xe6\xa2\x6a\xf0\x53\xc6\x80\x70\x08\xf4\xed\x7f\x2f\x13\xbe\x62\x0b\x9e\x0b\x4f\x40\xd8\x5c\xff\xe5\xf6\xa1\xe5\xb1\x2e\x1d\xdc\xdd\xdd\x8e\x82\x4d\x5e\xf9\x51\x9a\x23\xd8\x8d\xe8\xde\x86\xf3\x30\x9c\xf5\xbd\xe9\x76\xf4\x33\xe4\xba\xf2\x2d\xb8\xca\x99\xda\xa5\x63\x45\x64\x39\xd6\xef\x24\x91\x89\x59\xd5\x11\x87\x6d\xa9\xcc\x36\x05\xdb\xe4\xee\x23\xc8\x23\xd6\xae\x7b\x4e\xbe\xde\x7e\x24\xb4\x4b\xc1\x7d\xa3\x7a\x8f\x61\xfc\x4b\x23\xea\xbd\xfd\xf8\x85\x89\xb6\xc2\x21\x9f\xee\x8e\xc8\x52\x66\x39\x9a\x26\xf6\x50\x37\xd8\x94\xcc\x45\x5b\x6f\x1b\xbe\x21\x74\x3f\x9a\x55\xcf\xc2\x3e\x34\x9f\x2b\x22\x7b\x46\x70\x07\xe5\xf9\x57\x85\xf2\x71\x40\xf7\x09\xcc\xbf\x7c\x91\x7c\x9f\xa0\x3e\x46\x58\x3d\xe0\x86\xbd\x48\xd4\x9a\xdd\xc7\x9d\x58\x11\xdc\xb6\xca\x6a\xcd\xee\x05\xd5\x5f\x1e\xd7\xb3\x22\xb8\x4d\x8f\x2d\x4d\x3f\x21\x4d\xcc\xf8\xeb\xe3\xba\xd6\x84\xf7\x09\xcf\x16\x1e\xf2\xa1\x49\xce\x29\x8f\x3d\xe6\x78\x45\x72\xeb\x2e\x64\x31\x74\x50\xf3\xaf\x7b\xef\xf2\x2b\xd2\xfb\x78\xb6\x8b\x9e\x79\xc0\xbd\xda\x9c\x4c\xdb\x63\xda\x56\x45\xf7\x80\xf3\x18\xfd\x18\x7c\x37\xea\xc3\x17\x02\xf5\x21\x54\x5d\x13\xe4\x12\x28\xac\x0b\xcc\xd7\x03\x59\xca\xa8\x41\xc8\x97\x12\xd8\xb5\xb4\x2d\x2a\xdf\x75\x35\x6e\x65\x30\x82\x6d\x34\xc2\xd6\x8a\x31\x55\xf2\x9a\xdb\x12\x71\x47\xcd\xf8\x1f\x56\xb3\x9b\xde\x2d\x0c\x76\x1d\xcb\x10\xec\x81\xd1\x7d\x7b\x82\x26\x66\x0f\x62\x7c\x62\x5d\xfb\xca\x7f\x6f\x62\x4b\x32\xae\x81\x49\x81\x30\x46\x85\xc0\x85\x36\x48\x19\xc8\xa1\xfb\x9c\x06\x06\x18\xd3\x4c\xa3\x7d\x1e\x64\x23\x98\x5f\xd9\x06\xd9\x48\x17\x13\x9a\x89\x78\x9c\x52\x56\x14\x68\x42\xff\x61\x0e\x17\xdc\x84\xff\x1c\x64\xa3\xf0\xe4\xc3\x4f\x6f\x8f\x7f\x9a\x97\x90\x2d\x11\xbb\xaa\xd1\x69\xe1\x1a\x86\x7c\x8a\xec\x08\x14\xa6\x09\x9d\xf7\x60\x22\x6f\xe0\x86\x9b\xb1\x7b\x74\xfa\xc0\xea\xb3\x45\xa3\x18\xa1\x9e\x4b\xdb\xd6\x05\x92\x11\x37\xe3\x6c\x50\x8c\xe5\x24\x74\xc5\x77\x48\x63\x4d\x50\x8c\xb8\xc0\x30\xcd\x92\x24\xfc\xf0\xe1\xa4\xb8\x2c\xe5\xa7\xfe\xee\x56\xef\x7e\x2e\x87\x0c\xaf\x43\xcd\x62\xd7\xd2\xae\x74\x7a\xf5\x5e\xbd\xd5\x2c\xbf\xfe\x66\x7b\xef\xfc\x2b\xf4\x8b\xd6\x65\xb3\xd7\x6e\xd5\x9b\xbd\xf2\xe2\xa5\xbd\x8d\x0b\xe3\xfa\xca\xbf\x57\x66\x78\x4d\xd9\xc4\x2a\x37\x89\x67\x5f\x16\xcc\xca\xeb\xe5\x68\xdf\xe1\x2e\x0b\xb7\x30\x52\xb8\xd9\xc9\x87\xf0\x3b\xbc\xfe\x1f\x20\xf8\x05\x8e\xc1\x5f\xff\xed\xaa\x5a\xbc\xe6\xc5\x78\x2c\x21\xb0\x86\x6d\xfc\x68\xa2\x90\xb2\x99\xd7\x89\x2c\x58\x8a\x4d\xb9\x01\xcf\x0e\x0d\x79\x1e\xfc\x21\x4f\x12\x4f\x01\x0e\xb5\xa1\x03\xd7\xea\x40\x04\xf3\x18\x9c\x04\xeb\xfd\x0b\x3c\x02\x1f\xc2\xf3\x7a\x11\xb8\xbc\x79\xc5\xaf\xbc\x85\x66\x46\xda\xff\x9c\xa2\xd0\x47\x42\x0e\x29\x4f\xf2\xde\xe3\xfc\xff\x6d\x00\xbf\xfc\xb2\x0e\x62\xe1\x41\x3c\xc6\xf8\xca\x62\x4a\xa9\x32\x8e\x46\xb3\x8e\x6a\xe3\xd9\xad\x44\xc3\x12\xc7\x7e\xe8\x5f\xad\x68\x5a\x5c\xd7\x9c\xca\x85\x48\xa8\xed\x8a\xd1\x23\x17\x72\x42\x04\xde\xc0\x09\xbc\xb6\xc9\xb1\x26\x32\xb9\x1a\xea\x22\x4e\xcd\xe9\x0a\x0a\x20\x0d\xb7\x80\xfa\x7e\xf4\x47\x20\x11\x24\xf4\xeb\xac\xcf\xdd\xad\xa7\x6f\xf3\xba\x7c\x72\xe4\x9a\xfe\x94\x99\xbd\x80\xe5\x6d\xab\x8e\xfb\x8c\x59\x4d\x95\x82\xca\x44\x3c\x61\xa5\x02\xf1\xdc\x96\x9b\x05\xcf\xa5\xf6\x2b\x9d\xf3\x6e\x99\x10\x61\xaf\x92\xc1\x26\xed\xb2\xc1\x9b\xfc\x7a\xd1\xa4\x13\xdc\x9b\x5c\x09\xee\xee\x02\x20\x6e\x85\x73\x9a\x10\xca\xae\x51\x19\xae\x91\xa4\x88\x8a\x64\x2a\xd1\x7b\x59\xb5\x77\x89\x36\xa2\xba\xec\x34\x9e\x6a\xda\xdf\x6e\x5f\xce\xde\xd2\xc5\xfc\x9b\x8e\x27\x19\xf5\x17\xa6\xe7\xbb\xf9\x88\xcd\x9c\x45\xfb\x4e\xa6\x8f\xe0\xe0\xc8\x6e\xa9\xa5\x30\x3c\x79\xfb\x63\xf1\xb8\x78\x5c\x3c\x29\x6d\x23\xe6\xee\x5f\x05\x0f\x0e\x0f\xd7\xd2\x22\xff\x8c\x84\x18\x79\x85\x02\x82\xab\xff\xd2\xc4\xae\x83\x79\xfb\x16\xd1\x27\x04\xd4\xc9\x77\x0d\x35\x2e\x6b\x19\xbf\xde\x74\xc9\x31\x32\x07\x87\x47\xf0\xd6\xc5\x13\x08\x61\xd4\x50\x62\xb7\xe4\x60\x63\x0b\x0f\xb6\x21\xd7\x56\x3f\x04\x02\x6f\x02\xb8\x05\x83\x08\x84\xc2\x3d\x92\xd5\x7d\x68\x4a\x40\x67\x4c\x42\xce\xed\xca\x1b\x01\xa4\xe3\x96\x7c\xc9\x1d\x9c\xf7\x6c\xcd\x47\xda\x55\xfb\xe8\x19\xff\x24\xcd\xee\x20\x22\xe0\xf9\xb9\xd8\x24\xa0\x8d\x4c\x61\x15\x20\xc9\xfc\x49\xae\x26\x40\xd4\x70\x27\xae\xa5\x06\xe5\xdf\x1e\xcc\x95\x0c\xa5\x02\x6e\x4f\xdc\xd7\x6f\x34\x7e\x81\x13\x78\x7b\x7c\xf8\x33\x30\x09\x71\xa6\x12\x20\x64\x42\xa7\xc4\xf0\x09\xc2\x87\x63\xd8\xc8\xa0\xb7\xef\x7e\xfc\x29\xbc\x7e\x1b\x4e\x68\x3c\xe6\x02\xf5\xcf\xf7\x0e\x39\xf8\xc7\x3f\x60\xa0\x90\x5e\xc1\xed\x2d\xe8\x04\x31\x85\xf7\x3f\xbb\x62\xa4\x40\x80\xa6\x86\x8c\xd0\xe4\x55\xe5\x4a\x03\xf7\x0c\x39\x90\x99\x6b\x32\x8a\x0a\x9d\x4a\x65\x88\x2b\x09\x20\xa6\xab\xdf\x6d\xe9\x55\x0f\x4e\xe0\x2d\xbc\x83\x53\x6f\x64\x1b\x7e\x32\xd4\xdd\xc6\xa2\xb4\xa0\xa9\xc9\x5f\xe4\xb8\xf9\x42\x36\x42\x57\xe9\x8c\xd2\x11\xdc\x3a\xdb\x57\x38\x03\xca\x18\x90\x27\xf8\x95\x9f\xe3\x38\xd8\x52\x1d\x7a\x73\x91\xab\x5e\x6a\xf2\x46\x24\x92\xb2\x0e\xa6\xb6\x02\x87\x6c\x90\x09\x93\x91\x29\x0a\x4e\x13\x98\x50\x2e\x6c\x7a\xba\x29\xb6\x39\xea\x12\x94\xa6\x26\xd4\x32\x53\x31\xea\xa2\xdd\x45\x8a\x6c\xfe\x99\xb0\x7d\x2a\x10\x08\x9c\xf5\x3f\x82\xb6\xff\x6e\xba\x04\xbe\x3b\x2f\x98\xfe\x10\x6d\x2e\x4a\x70\xed\xbf\x23\x7c\x04\x5f\xfe\xb5\x61\x70\x77\xe7\x86\x91\xb6\xe2\xf9\x57\x81\xef\xdf\x1f\xff\x21\xfe\x08\xe0\x97\x25\xa8\x54\xe1\x10\x15\x0a\x0b\x6c\x81\xc9\x36\x06\x7b\xce\x34\x0e\x3c\x5b\xb8\xbd\xf7\x9e\x17\x5b\x93\xd9\x4b\x14\xc8\xb2\x3a\xdb\x49\x51\x14\x88\xfb\xa4\x6e\x22\x19\x10\x7a\x9e\x8f\xdc\x12\x0c\x2b\x64\xcf\x5a\xff\x66\xd8\xbf\xd4\xe1\x03\xe7\x2e\x4d\x4d\x31\xa7\xce\x8b\x8c\xf2\x64\x56\x20\x60\x64\x16\x8f\x77\x2c\x7f\x7f\xa8\xdb\x22\x36\x4d\xd0\xe0\xff\x07\x00\x00\xff\xff\x3c\x9d\x8d\x04\xe5\x2e\x00\x00")
+var _kubernetesmastercustomdataYml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x7c\xff\x73\x1a\xb9\x92\xf8\xef\xfe\x2b\x7a\x27\xae\x4d\xf2\x9e\x07\xec\x6c\x92\xfd\x3c\xf6\x43\xde\x11\x20\x0e\x15\x0c\x1c\x90\xec\xbd\xdb\xec\x51\x62\xa6\x01\xad\x07\x69\x22\x69\x1c\x13\x9b\xff\xfd\xaa\x35\x9a\xe1\xbb\xc1\xde\xac\xef\xaa\xae\x6a\x6b\x9d\xd1\x97\x56\x7f\x97\xd4\xdd\xe2\x49\x10\xc9\x24\xf4\x03\x29\x46\x7c\x7c\x74\x14\xb3\xe0\x92\x8d\x51\x97\x8e\x6e\x6e\xf8\x08\x84\x34\x50\x68\xab\x60\x82\xda\x28\x66\xa4\xea\x28\x39\xe2\x11\x16\x3e\x24\x43\x54\x02\x0d\xea\xaa\x9d\x59\x68\xe8\xba\x09\xc2\x9e\x61\x86\x07\x1d\x19\xce\xe7\x47\xe0\x03\x9a\x20\x3c\xba\xb9\x41\xe1\xbe\xff\xf8\x42\xad\x46\xb1\x00\x95\x4c\x0c\x1e\x1d\x7d\x55\xdc\xe0\x80\x40\x66\x4b\xbe\x67\xfa\xbd\xd4\xa6\x12\x71\xa6\x51\xcf\xe7\x47\x3e\xc4\xcc\x4c\x4a\xe0\x15\x65\x6c\x8a\xec\x5b\xa2\xb0\x18\x48\x61\x18\x17\xa8\x74\x71\x22\xb5\x61\xe9\x60\xef\x08\x20\x46\x35\xe5\x5a\x73\x29\x74\x09\xbc\xd3\xd7\x2f\x5f\x52\xab\xfc\x2a\x50\x95\xc0\x53\x52\x1a\xfa\xa6\xf9\x28\x4c\x09\x6e\x8f\x00\x00\x9e\x00\x41\x01\x07\xe6\xe8\xe6\x46\x31\x31\x46\x38\x47\x43\xa8\xe8\x77\x3c\xc2\xba\x30\x8a\x13\x3e\x34\xfe\xe6\xa6\x30\x9f\xe7\x84\x65\x7f\x17\x88\xa2\x09\x8a\x7a\xa6\x0d\x4e\x43\xf7\xb7\x18\xca\xe0\x12\x55\x41\xa3\xba\xe2\x01\x16\xc2\x62\x10\x21\x53\x83\xa9\x4c\x84\x19\xc4\x4a\xc6\x6c\xcc\x0c\x97\x62\x30\x8a\xd8\x58\x17\x48\x1e\x0f\x26\xe7\xb7\x5e\xba\xca\xef\xf6\xeb\x82\x96\x78\x47\x50\xcb\x7a\xc2\x14\x86\x47\xf7\xc4\x14\xaf\x31\x18\x68\xc3\x94\xf9\x9e\x68\xd5\xaf\x31\xe8\x11\xd0\xf2\xda\x67\x31\xd1\xaa\x38\xe4\xc2\x21\x02\x21\xc3\xa9\x14\xe0\xbf\x87\x51\x58\x2a\x16\xc1\xf7\xb5\x91\x8a\x8d\xd1\x0f\x15\xbf\x42\x55\x96\x57\xa8\x22\x36\x03\xdf\x1f\xf2\xb8\x7c\x73\xf3\xab\x62\x71\x45\x7f\x62\x8a\xb3\x61\x84\xe0\xa5\x70\xde\x2a\x1e\x8e\xb1\xca\x43\xe5\xcd\xe7\x47\xa9\xae\x9d\xa3\xb9\x60\xda\xa0\xea\x26\xc2\xf0\x29\x76\x91\xe4\x83\xe1\x05\x8f\x22\x5e\xed\x7c\xbc\xbf\x50\xe3\x64\xf0\x25\x91\x86\x7d\x4f\x4e\x55\x3b\x1f\xff\x9d\x60\x96\x6f\x6e\xce\xd1\x38\x54\xb3\x46\x78\xb6\x8f\x88\xe7\x29\xb9\x99\xae\xf2\x11\x34\x74\x5f\xc6\x32\x92\xe3\x59\x93\x0d\x31\xd2\x75\x41\x8c\x0a\xf7\xdb\x9a\x71\xd3\x22\x3b\xad\xa0\x27\x5b\x28\xfc\xf9\x20\x0a\x9f\xfc\x60\x45\x3c\x64\x7a\xe2\x2c\x90\x85\xa1\x06\x33\x41\x50\x38\xe6\x52\x00\x13\x21\xc4\x11\x33\x23\xa9\xa6\x30\x62\x49\x64\x20\x94\x53\xc6\x05\xc8\x91\x1d\x27\x64\x88\x27\xa0\x90\x85\x30\x52\x72\x6a\xdb\xb8\xd0\x86\x89\x00\x61\x8a\x86\x85\xcc\x30\x70\xb2\x39\x01\x23\x81\x1b\x0d\x29\xea\x76\x4d\x8d\x06\xfc\x6b\xfb\xcf\x8b\x7a\xbf\x52\xab\xf4\x2b\x83\x8f\xdd\x66\x79\x62\x4c\x5c\x2a\x16\x2d\xb7\x1b\x17\xb5\x5e\x25\x0c\x15\x6a\x3d\x9f\x17\x33\xa8\xc5\x6c\x9d\x62\x20\xa7\x31\x39\x32\x02\x32\x92\x0a\x38\x70\x01\xc7\xcf\x34\x7e\x81\x33\x78\x7d\xfa\xfc\x17\x08\xa5\x5d\x01\xa0\x5b\x3f\x6f\xb4\x5b\xe5\xe3\x67\x41\xa2\x22\xf0\x47\xba\x47\x3a\x7d\xe1\x40\x96\x8c\x4a\x10\xbc\xe3\x65\x4c\x8a\x91\x0c\xac\x53\xf8\x27\x8b\xb9\x7f\x85\x8a\x98\x5c\x7e\x71\x7a\xf6\xb3\x7f\xfa\xd2\x3f\x7d\xf1\x23\xf1\x86\x99\xb2\xc1\x6b\xe3\x3d\x87\x1f\x7f\x84\x77\x95\x8f\xcd\xfe\xa0\xd6\xbe\xa8\x34\xee\xb7\x52\xc6\xe9\x77\xc4\xe8\x9a\xe5\xf3\xc1\x8b\x0e\x15\xb2\x4b\x47\xa4\x8e\x10\x63\x78\x65\xbf\x42\x29\x52\xc6\xf0\x11\xfc\x06\xfe\x37\xf0\x8e\x53\x1e\x78\xf0\x3b\xdc\xde\x66\x6d\xcb\x38\x7b\xf0\xfb\x2f\x24\x48\xe1\xc0\x61\x30\x91\xe0\x05\x32\x89\x42\xbb\x17\x59\x69\xaf\x29\xc9\x8a\x6e\xec\xd7\x04\x2f\x03\x7d\xcd\x0d\x9c\xd9\x8f\x11\x77\xfa\x10\x82\xcf\xc1\xd3\xb7\xff\xf5\xe1\xe3\xdb\x7a\xb3\xde\x1f\xb4\xda\xb5\xfa\xa0\x59\x79\x5b\x6f\xf6\xca\x85\xbf\xdd\xfe\x78\x62\x95\x22\xb3\x9e\x96\x0c\x31\xb5\xa0\xf9\xfc\xd6\x03\xeb\x21\x42\xb4\xf8\x14\x2f\x93\x21\x46\x68\x36\x2c\xaf\x23\x43\xd2\xa9\xb7\x11\x39\xa5\xbd\xbb\x86\x83\xb2\xe4\x61\x86\x34\x71\xc0\xa7\xa1\xfe\x4b\x9c\x71\x47\x61\x39\xb7\x4c\xf0\x03\xf0\x78\x6c\xc8\x3b\x68\xf0\xab\xf0\xae\xdd\xfd\xb5\xd2\xad\x81\xaf\x61\xd3\xcd\x12\xae\xd5\x28\x21\x77\xea\xbc\x2c\xf8\x21\x6c\xb1\xa2\x9f\x5e\x80\xff\x07\xd4\xba\xed\x0e\xbc\x78\x53\x0c\xf1\xaa\x28\x92\x28\x22\x8d\x58\xac\xd5\xf8\xee\x6b\x79\x0b\x51\xac\xb2\x3c\x75\xe2\xc5\x74\x9b\x29\xfc\xa1\xa5\x78\x30\x53\x6f\x9c\x6e\x79\x11\xbf\x42\x5f\x21\x6d\x54\xe8\x95\x80\x2c\xee\x24\xef\x93\x63\xb7\x73\x79\x25\xf0\x68\x3d\x9f\xce\x3f\xde\xca\x00\x19\x1b\xed\x95\x16\x10\x69\xe2\x94\x5d\xfb\x9a\x7f\x23\x80\xde\xab\xd3\xa9\x77\xb2\xd6\x67\xa1\x50\x5f\xa6\xe2\x73\xfb\x77\xbe\xbe\xdf\x5f\xe6\x67\xb7\x62\x80\xca\xe8\x62\xc0\x0a\x81\x32\xbb\xa9\x46\x11\xc8\x90\x8b\x71\x09\xbc\x21\xd3\xf8\xfa\x20\x56\x6c\xc8\x2c\x60\x55\x54\x86\x8f\x78\xc0\x0c\x7a\xf3\xfd\x68\xb1\x98\x93\xde\xa3\x7a\x0c\xec\x58\xcc\xc9\x22\x50\xdd\x13\xc9\x20\xe2\x28\xcc\xa3\xf0\xcf\xae\xb4\x8e\x9e\x75\x2b\x85\xa5\xd6\xec\x84\xfe\x9e\x69\x67\xe2\x95\x20\xa0\x23\xe0\x27\x54\xe9\x10\x2e\xc5\x07\x9c\xad\x1e\xad\xb7\xd2\xe6\xbc\x0e\x4b\xe7\xfb\x57\x4b\x00\x0a\x71\x32\xfc\xab\x09\xd6\x77\xa2\xef\x2d\x9d\xbc\x97\x08\xb9\x62\xaa\x18\xf1\x61\xe6\x7f\xed\x5f\x72\x94\x7c\xbc\x1b\xdd\x3d\x98\xb1\x98\x7f\x4a\xb7\xc1\x12\x5c\xa5\x3b\xc6\x25\x17\x61\x09\xd2\xab\x8f\x6d\x08\x52\xc7\xa7\x4b\xf6\xcb\x07\xc1\xa6\x58\x02\xda\xbf\x23\xd7\xe5\x6c\xd2\x7d\x95\xdc\x27\x40\xb0\x10\x9d\xcf\x12\x33\x91\x8a\x9b\x59\x09\x76\x68\x9b\xb5\xd4\x7c\x2e\x71\x88\x78\x9a\xf3\x0e\xd5\x90\x19\x3e\xa5\x2d\x53\x04\xcc\x3c\x7b\x4a\x67\x19\x5d\x2a\x16\x9f\x9e\xc0\x95\x63\xac\x7e\xf6\x74\x6a\xcf\x8b\x1d\xc5\xaf\x98\xc1\x46\x4c\x07\x1c\xfd\xf4\xf9\x6f\x81\x8c\x67\x0d\x11\xe2\xf5\xb3\x8d\xb1\xed\xd1\x48\xa3\x79\xfa\xfc\xf9\xef\x27\xf0\xb4\xb4\x0a\x6d\x81\x65\xa5\xd3\x20\x9d\x43\xd5\x91\x8a\x06\x93\x8c\x08\xd1\x44\x6f\xb0\x26\xb5\x1b\x47\x49\xa2\x57\x38\x62\xbb\xfc\x25\xc6\x94\x60\x9f\xf1\xad\x4f\xbe\xc4\xdd\x3c\xb4\x23\x0a\x97\x38\xb3\x93\xac\xb0\xaf\x4d\x8e\x9e\xfb\x5e\x46\x27\x95\xd8\x36\x69\x3a\xd4\xdd\xaa\xae\x71\x53\xf6\x0e\xa6\xed\x0f\x12\xa5\x08\xc3\x6c\x9d\xad\x03\x73\x85\x5e\x27\x61\xca\x04\x1f\xa1\x36\xda\x36\xfa\x0b\x17\x39\x63\xd3\xe8\x00\x7b\x1c\x7f\xe3\xf1\x5d\x1a\xff\xc3\x0f\x43\x2e\x98\x9a\x39\xd5\xbf\xa8\xf4\xfa\xf5\xee\x80\x8e\x43\xdd\x56\xbd\x5f\xef\x0d\x48\xc4\xf5\xee\xa7\x7a\x77\xf0\xf6\xf5\xcb\xc1\xf9\x7f\x36\x3a\x83\x5e\xbf\x7b\x30\xc2\x44\xb5\x92\x51\x84\xca\x9f\x32\xc1\xc6\x8f\x88\x79\xb5\xdd\xea\x77\xdb\xcd\x66\xbd\x3b\xb8\xa8\xb4\x2a\xe7\x0f\x25\x41\x07\x13\x0c\x93\xe8\x11\x31\xef\x55\xdf\xd7\x6b\x1f\x9b\x0f\x45\x98\x85\xa1\x14\x8f\xce\xee\x4a\xad\xd6\x6e\xed\xe0\x74\xba\x69\x3d\x30\xae\xb4\x9f\x6a\x0a\x3b\x3d\x1a\x9d\xf5\x7e\xb5\xb6\x4a\xde\xc6\xae\xb4\x8e\xa9\x15\x88\x13\x4e\x28\xb4\xef\x36\xba\xbf\x16\xe5\x54\x1e\x84\xf8\xa0\xd6\xea\x0d\x7a\xf5\xee\xa7\x46\xb5\xbe\x26\x98\x43\x31\x0e\x31\x8e\xe4\x6c\x4a\x7e\xf4\x31\x91\xae\xd5\x3b\xcd\xf6\xbf\x2e\xea\xad\xfe\x03\xf0\x8e\x95\xbc\x9e\xf9\xe9\x31\x5f\xe3\xe3\x21\xde\xe9\xb6\xff\xe3\x5f\x83\x5a\xa5\x7e\xd1\x6e\xf5\xea\xeb\x98\xe7\x61\x56\x8a\x7b\x62\x34\xbd\xb0\x66\x1a\x56\x2c\xe2\xf3\xf9\x21\x94\xa5\x2d\x7e\xc8\xf4\x64\x28\x99\x0a\xff\x07\xa4\xe3\x6c\xa1\x56\xe9\xbd\x7f\xdb\xae\x74\x6b\x7f\x4a\x52\x1b\xf4\x3c\xb2\x7d\x6c\x10\xf3\x70\x5b\x99\x20\x8b\xe9\x00\xf0\x98\x26\xfe\xbe\x5e\xe9\x58\x8a\xbe\x03\xda\x8f\xab\x49\x39\xe6\xbb\xb4\xe7\x50\xcf\xea\x82\x30\x79\xa0\x38\x88\x98\xd6\x8f\x41\x41\xad\x9e\x46\xb3\x7a\xfd\x76\xb7\x72\x5e\x1f\x54\x9b\x95\x5e\x6f\x4d\x00\xd6\xe2\xf1\xcb\x81\xfb\x5f\x0b\xcd\x57\xa9\x2e\x3b\x32\xe2\xc1\x0c\xbc\x80\x45\x3c\x90\xde\x7e\xc7\x90\x0e\x74\x79\x9d\x29\x8b\x1f\x83\xfa\x6a\xa5\xd9\xa8\xb6\x07\xd5\x76\xeb\x5d\xe3\xfc\xa2\xd2\x59\x23\xfc\x30\x8c\x1f\xd5\x41\x3b\x8c\x77\x38\xe7\x5c\xdd\xb2\xc4\x54\x2d\xd5\xab\x1a\x8a\x59\x8b\x4d\x51\xc7\x2c\x40\x7d\x87\x2c\x44\x2a\xbc\xd8\x0a\x2f\xd7\xca\x10\xc5\xec\x6e\xe2\xf6\x05\xcf\x6d\x84\xd3\x46\xb2\x21\x42\x93\x86\xcd\x45\x8e\x10\x0c\x31\x92\x5f\x81\x45\xf4\x7f\xa3\xd8\x68\xc4\x83\x45\x88\xdc\xdd\x34\x20\xe5\xfa\xdd\x17\xdc\x9c\x48\x3b\x2c\x8b\xa4\x66\x57\x23\x5a\xb1\x04\x44\xae\x9f\x86\x2b\x5d\xbb\x45\xcc\xdd\xa7\xb6\x8f\xcb\x12\x6c\x87\x99\xc0\x2e\xae\x13\x6c\xdf\xf7\xff\x3c\x09\x69\x3a\x8f\xbe\x01\x98\x10\x92\x0e\x9b\x24\x10\xd7\x04\x20\xd0\x14\x86\x68\x58\x61\x21\xdb\x02\x97\x99\x78\xfd\x54\xbe\x25\xf0\x6e\x3e\x7b\x5c\x8c\x29\xf4\xf8\xd9\x2b\xd1\x87\x96\x91\x85\xf5\xd9\x2b\x7d\xf6\x96\xe8\xf8\xec\xcd\xe7\xde\x4e\x02\xf0\xda\xa0\xa0\x7f\xea\xe2\xd5\x19\xad\xbb\x42\xd0\xb2\x43\xb8\x83\x28\x2b\x7e\x7f\x53\x3a\xb9\x9e\x2c\xd3\xad\x63\x0c\xb2\xe9\xb1\x0c\x7b\x18\x61\x60\x24\x45\x16\x32\xbe\x38\xba\xb2\x41\xbe\x55\xa8\xec\x2b\xbb\xd6\x5b\xb8\xf9\xe4\xbc\x93\xfe\x9b\x32\x13\x4c\x9a\x6b\x9a\xb1\x5b\x3f\xb2\x98\xf9\xb2\x01\x4a\x05\x85\xf7\x4c\xbb\xb0\x45\x17\xc7\x5c\x1b\x35\x3b\xc4\x2e\x77\xa4\xb3\x50\xe8\x44\xa1\xef\x2c\xc2\xf9\xca\xef\x9c\xd5\x8a\xe3\x88\x63\x6a\xa1\x29\xfc\x44\x59\x8d\x00\x33\x61\x06\x04\xa2\xcb\x7a\xe5\x37\xf9\x13\x90\x94\xb6\x88\x95\xbc\xe2\xa4\x03\x18\x82\x54\xc0\x46\x64\xb3\x04\x45\xe1\x50\x4a\xb3\xe8\xe7\x62\x0c\x0a\xbf\x24\x9c\x32\xbb\xb4\x28\x1d\xf1\xab\xfd\x66\x9a\x47\xb5\xc1\x05\x8b\x14\xf1\x37\x30\xe4\x73\x00\x46\x89\x08\x2c\x12\x0a\x8d\x9a\x3d\x7b\xbe\x14\x66\xce\x93\x58\x37\x67\x85\xc2\xeb\xd3\xf9\x52\x02\xcb\x85\x9a\x8f\xff\xcd\xa3\x54\x93\x42\x93\x28\x01\xa7\x2b\x9d\xcb\xe9\x9f\x95\x14\xd0\x22\x99\x33\x66\x57\x08\x49\x0c\x2a\x11\x16\xf7\xe3\xbf\x65\xe1\x6a\xc8\x60\x9e\xb9\xb8\xb5\x6b\x52\x33\x38\x76\x44\x65\xde\xcb\xe7\x62\x24\x29\x63\xe0\xb2\x38\xd6\x41\x6f\x51\x0e\xa7\xde\x94\x76\xea\xf5\xbb\xff\x72\x5b\x53\x79\xdd\x4f\xc7\xa9\x4a\xf9\xca\x4d\x2b\x3a\x55\xa0\xe0\xfc\x52\x06\x6b\x04\xc7\x6b\x90\xd6\xd2\x55\x00\xad\xca\x45\xbd\xd7\xa9\x54\xeb\xbd\xb2\x67\xd3\x11\x6b\x08\x2d\xab\xe7\x82\x6e\x62\x7a\x3e\xd3\x66\x10\x6f\x16\x80\x8a\xc5\x93\x22\x6c\xca\x61\xc1\x12\x85\xcc\x2c\xf9\x7f\x38\x5e\x80\xf2\xfd\x50\xcd\x7c\x95\x08\xf0\x25\xd0\x96\x03\xb7\x8b\x89\x2c\x8e\xa3\x19\xf8\x23\xf0\x97\x38\x79\xd7\x12\x1a\x03\x85\x06\xc6\x28\x50\xf1\x00\xd6\xb9\x06\xbe\x9f\x23\x51\x5e\x41\xc2\xcc\x62\x2c\xaf\xfa\xce\x34\xeb\x92\xf2\x99\xd8\x0c\xbe\x4f\x1e\xc5\xa6\x42\xca\x85\xf5\xde\xf2\x06\xe3\xff\x1c\x65\x4f\xac\xcd\xb9\x0d\x39\xcb\x0c\x82\x0b\x74\x53\x82\x99\x81\xc0\xaf\x4b\x3c\xe5\xda\x31\x21\x04\xa6\x67\x22\x98\x28\x29\x64\xa2\xa3\xd9\x0a\xd8\x35\x65\x8d\xc9\xe7\xc1\x6a\x14\x3d\x5f\x74\x17\xb3\xe2\x74\x1b\x99\xb2\x31\x76\x92\x28\xea\x59\x9e\xeb\xcf\x5e\xe9\xb7\x9b\xcf\x1e\x4d\xb1\x7b\xc9\x3a\xef\x3f\x7b\xf3\xdf\xe7\xde\x16\x6a\x57\x2c\x50\x4d\xb7\x29\x71\x96\x03\x5d\x78\xdd\xbb\x4f\x3b\x5b\x28\xcd\x19\x7e\xcf\x13\xd0\x12\xc2\xb9\xaf\xbf\x33\x21\xba\xc3\x65\x2f\x52\xbb\x0f\x3a\x50\xfd\xf6\x51\x70\x93\x16\x5b\xd4\x50\x07\x8a\xc7\xe4\x1d\xcb\x15\x4b\xd5\xf2\xa1\xe9\x30\x17\x6e\x01\x75\x53\x9f\xac\xcb\x6b\x39\x5c\xdb\x59\x21\x77\xbe\xde\x03\xce\x45\xfb\x78\x4d\x25\x52\x8b\x19\x5b\x12\xb6\x7d\xb2\x28\x29\x50\x4f\xa4\x49\x1b\xf8\x14\x65\x62\x6c\x45\x4d\x0f\x83\xf2\xe9\x7a\x55\xcd\xbd\x76\x40\xb7\x64\x83\x92\xe8\x51\x94\x72\xe6\x57\x26\x0c\x86\x6f\x67\xe5\x69\x12\x19\xee\x53\xe0\xbb\x60\x98\x1a\xa3\x39\x50\x76\x3b\xc8\xfb\xde\x52\xa3\x2b\x54\x60\x22\x70\xcb\x70\x29\x56\xe5\xb1\x5a\xb4\xb3\x24\x8e\x2d\x1d\x55\x29\x42\x4e\xba\xd0\x61\x66\x52\xbf\xe6\xda\xe8\xf2\x0f\x3b\x36\xd6\x6d\x52\xda\x2a\x94\x2e\xda\x62\xaa\x32\x25\x7e\x19\x8f\x12\x85\xcb\xcd\x24\xbc\x57\x7a\x47\x56\x7e\x7a\x19\x72\x45\x3e\xa2\x68\xa6\x71\xb6\x72\xc8\xd5\x96\xe1\x6b\x55\x54\x31\xa5\xd8\x37\x73\x6c\x0b\x53\x7d\x3f\x8b\x51\xd1\x67\x2f\xc6\x20\x4b\xdc\xdc\x09\xd2\x3a\x5f\x9f\x7c\xca\xd5\x3a\x3e\x25\x7b\xe0\x5a\x7c\xdf\x6b\x65\x58\x2d\x40\x08\x62\x28\x4e\xb2\x21\xb0\x06\xb8\xe8\x6d\xc1\x93\xa6\x4f\x37\x70\x5a\x06\xb2\xfb\x68\x94\x43\x4a\xc1\x04\x93\xa9\x0c\x81\xfd\xfd\x7a\xd7\x9c\xfb\x19\xca\x9a\x81\xac\x15\x8b\x3c\xd8\x12\xb2\x72\x95\x6a\xf3\xa3\xbd\xdc\xd6\x5a\xbd\x2d\x75\x70\xb4\x4a\x4d\x64\x59\xe1\x46\x27\x13\x72\x36\xbb\xd2\x69\xd8\xb0\x70\xbd\xdb\x2b\xff\x6f\xcf\x28\x66\x38\x37\x2e\x2a\xe7\xf5\xf2\x7d\xb4\x6b\x65\x7a\xab\xde\xff\xb5\xdd\xfd\x30\xe8\x34\x3f\x9e\x37\x5a\x69\x25\x62\xad\x5d\xfd\x50\xef\x0e\xda\x9d\x7e\xaf\xbc\x32\x38\x3d\x82\x50\x60\x2d\xcd\xc7\x54\xde\x36\xb7\x2d\x9d\x6e\xc9\xa8\x7a\x69\x9e\x88\x1a\x37\x96\x5d\x2a\x2b\xb2\xa7\xc4\xb4\x0a\x71\x71\xf3\xcd\xaa\x8a\x56\x66\x75\xda\xb5\x41\xa3\xf5\xae\x5b\xa1\xc3\x67\xbf\xd2\x68\xd5\xbb\x07\xd0\x4f\x05\x47\x62\xa4\x58\x35\xf3\xfa\xdb\xf8\x50\xff\xd4\xa8\xf6\x1b\xed\xd6\xe0\x5d\xb3\x72\xbe\x81\x53\x84\xa6\x7e\xc5\xed\x8d\xc1\xd6\x91\xae\x4d\xee\xd6\xad\xd6\xd4\x76\x4e\xce\x2a\x12\xb3\xc9\xfb\xb7\x89\x08\x0f\xd8\x1e\x1e\x1c\x02\xca\x10\xbf\x3b\x40\xba\xe3\xd2\x98\xa3\xb7\xf5\x9a\xf8\xea\xd5\x03\xae\x89\xb6\x10\x11\xdd\x2d\x77\x6c\xa0\x70\xe1\xac\x29\x8d\x09\x56\xa9\xfc\x01\xce\x1c\xd7\x9f\x40\x85\x4a\xa0\x21\x94\xa8\x6d\xe2\x40\x27\x71\x2c\x95\x01\xf3\x55\x42\x53\xb2\xf0\x2d\x8b\xa8\x34\x51\xe9\x67\xcd\xb7\xcf\x81\x4a\x7e\xe9\x9a\x45\xa7\x18\xcd\xa6\x08\x82\x07\xb6\x6c\x6e\xc8\x82\x4b\xa4\x1a\x4b\xa9\x4c\x21\x83\xac\x81\x01\x45\x17\x98\x92\x89\x08\x4f\xec\xb1\xa6\x21\x0c\x2a\xc1\x22\x68\xbe\x7d\xd6\x20\x90\x11\xd7\x14\x9f\xb0\xb7\x96\xfc\xcc\x93\x07\x9a\xa4\xb0\x20\xe1\xe5\xcb\x97\x3f\xd9\x85\x08\x46\xfd\x7a\x01\xa3\x4e\x30\xa4\x58\x3d\x32\xd9\x39\x0e\x8b\xfe\x84\x6b\x68\x74\xfa\x64\x39\xa0\x92\x08\x69\xa8\x00\x85\x21\x57\x18\x18\x0d\x8d\xe6\xdb\x7c\x39\x23\xb7\x00\xa2\x2b\x14\xb5\xc6\xca\xd6\x99\x13\xfd\xc1\x84\x71\x77\x97\xcb\x8b\xcc\x0c\x08\x66\xc0\xaf\x40\xa7\x5b\xef\xb6\x3f\xf6\x1b\xad\x73\xda\x5b\x4d\x10\x83\xef\x87\x0b\x2a\xfc\x3f\xa0\x5b\xaf\x35\xba\xf5\x6a\x9f\x2e\x33\xd2\xb7\x5d\xd6\x48\x3e\x6c\xf7\x54\xcb\xb1\x8b\xd5\xd2\xc2\xff\xbf\xb0\x4c\x9b\xe3\x49\xf3\x3d\xd6\x28\xdf\xdc\xde\x65\xc7\xeb\xa3\xbd\xf9\xfc\x76\xec\x39\x13\xda\x9a\x06\xdd\x91\xfc\xf5\xdc\x6d\xf9\x81\xe9\xd7\x9d\xe4\xd8\xfa\x7f\x47\xc6\x39\x1a\xfa\x6c\xd0\x2d\x66\x2f\x9e\x79\xba\xd6\xcb\x99\xb6\x73\x91\x15\x6f\xfe\xe6\xf6\x3e\x8e\xff\x76\xfc\x0b\x38\x58\x6e\x0b\xa4\x32\xc5\x5d\x30\x96\x86\x2c\xe6\xa6\x3b\x17\x51\x56\xb5\x05\x1f\x54\xe6\xb2\x0d\xc0\xb6\x71\xab\x18\xac\xe9\x4c\xa3\xb3\x47\xf8\x8b\x81\x0b\x38\x4c\x48\x31\x9b\xca\x44\x57\x12\x33\xd9\x36\x7f\x65\xc0\x9d\xeb\xef\x22\x64\xc7\xd0\x43\x75\x2f\x33\x4a\x27\xdd\xbf\x50\xaa\x29\xc7\xdf\x7d\x09\x45\x47\xe1\x88\x5f\x6f\x03\xb2\x3e\x66\x31\x9b\x62\xa5\x54\x95\x48\x05\xbd\xa4\x14\x7a\xdb\xf4\x8d\x41\x8b\xf9\x6b\xe5\xa8\x6f\x6e\x0f\xa9\x58\x75\x73\x23\x64\x21\xaa\x3a\xc5\x4c\x9b\xc8\x34\xd6\xdc\xed\x72\x1b\x90\x5d\x63\xb7\x42\xeb\xa2\xc0\xaf\x35\x64\x61\xc4\x05\xee\x81\xb6\x32\x76\x07\x34\xa3\x66\x1d\x54\x5c\x86\x7b\x61\xe5\x23\x0f\xd4\x93\x1d\x45\x41\x7f\xa9\xc2\xec\x62\xe5\xff\x21\xb6\xaf\x16\x32\xdd\xc1\x6d\xda\x15\x3a\x54\x3d\xb1\x9f\xdb\x2b\x43\xbf\x8f\x81\x5c\x66\x20\x2b\x6a\xac\xdd\xe6\x92\x2f\x43\x6d\x3b\xa8\xdd\x57\xfb\xb1\x87\xe0\x5a\xab\x77\x18\xb9\x6e\xe0\x2a\xc2\x69\x77\xad\xd5\xbb\x60\xfa\xcb\x7e\x38\x4b\x03\xb7\xc1\xa1\x4b\xe9\x7b\x64\x91\x99\x7c\xdb\x0f\x6b\x6d\xb0\x77\x00\x7b\xb6\x94\xf4\x78\x7b\x2b\x53\x76\x72\xef\xbd\xab\x1e\xd8\x8f\xea\xf2\xc8\x6d\x74\xdb\x33\x4f\x17\x35\xff\x76\xf0\x09\x69\x69\xf4\x21\x94\xef\xaa\x74\xb8\x43\x39\x6a\x59\x5d\xca\x7e\x8c\x56\x86\x1e\x80\xce\xbe\x4a\x9e\xc5\x01\xe9\x7b\x55\x11\x10\x95\x4f\xa0\x31\x82\xaa\xcd\xbe\x83\x1b\x81\xe9\x8b\x30\x3a\x5a\x0b\x48\xe2\x90\xa2\xff\xce\x38\x81\xac\x73\x1b\x77\x96\x8c\x77\x17\x57\x96\x86\x2c\xc4\x9d\x22\x43\xbb\x6a\xc6\xd0\x73\x34\x29\x3a\xf6\xfc\x08\x1e\xbd\xf4\x5a\x1f\x5f\x6d\x35\x76\x0d\x0f\x04\xdf\xc3\xeb\xad\xa5\x06\x4b\xcc\xdd\x73\x05\xcc\x73\x6f\x77\xe6\x0a\x1f\x7c\x3d\xdd\xe4\x5d\xbe\x60\xcf\xc6\xa6\xbd\x03\x70\xb4\x0f\x4b\xed\xc9\xfa\xbb\xe6\x33\xed\x63\x52\xba\x4f\x71\x6d\x53\x0b\x30\x41\x95\x3e\xb6\xa2\x97\x59\x72\x64\x9f\xfc\xc2\x10\x03\x96\x68\xa4\x44\xca\x30\x19\x43\x16\x32\x1a\x26\x63\x5d\x88\x58\x22\x82\x49\xcc\xc2\x82\x40\x53\x4c\x5f\x1f\x73\xc1\x4d\xf1\xef\xc3\x64\x5c\x3c\x7b\xfd\x8f\x17\xa7\xff\xf8\xc9\xad\xd6\xa6\x4c\x28\x5d\xe4\x08\x0a\xd7\x30\xe2\xd7\x18\xd2\x9b\xbf\x38\x62\x59\x8f\xad\x75\xf8\xca\xcd\xc4\x55\x37\xc8\x24\x04\x82\x07\xc1\x84\x1e\xf1\xea\x6c\x34\xb5\xe6\x98\x8c\xb9\x99\x24\xc3\x42\x20\xa7\x45\x7b\x9b\x2e\xb2\x40\xfb\x28\xc6\x5c\x60\x31\x4e\xa2\xa8\xf8\xfa\xf5\x59\x61\xfd\x91\x60\xad\xd1\xfb\x50\xb6\xef\x95\x74\x18\xd8\x96\x4e\xa5\xdb\x6f\x50\xdc\xa4\x7c\x7c\x43\xbd\xf3\x34\xcb\x74\xd1\xfe\xd8\xea\x77\xda\x8d\x56\xbf\x9c\xbf\x47\x20\xbe\x84\x5c\xa7\xef\xe4\x92\x10\xaf\x58\x38\x05\x8d\xc6\x44\xae\x32\x21\x8b\xec\x1e\x2f\x66\xa7\x1d\xc4\x71\xb8\x85\xb1\xc2\xcd\x4e\xfb\xb2\xee\xf8\x9f\xe0\xe3\x17\x38\x85\x34\x16\xbe\x92\x8f\x4c\x73\xae\xb4\x30\x70\x0d\x2c\xa2\x07\x74\xb3\x14\x26\x86\x8b\x04\xa4\xcd\xc1\x9c\x2e\x3f\x86\x7b\x02\x23\x1e\x45\x69\x45\xcb\x48\x1b\x36\xb4\xad\x16\x09\x2f\xe3\xc1\x99\xb7\xde\x9f\xe3\x23\xf0\x2e\x7c\x8e\x73\xc6\xb9\xe6\x25\xba\x5c\x0b\x4b\x8c\xa4\x7f\xb8\x10\xa9\x3e\x11\x72\xc4\x78\xe4\x7a\x4f\xdd\xdf\x17\x1e\xbc\x79\xb3\x8e\x44\x4e\x41\x30\xc1\xe0\x92\x72\xb7\x31\x53\xc6\x86\xf1\x01\x6d\x0c\xdf\xf6\x47\x1a\x16\x78\x1c\x86\xfd\x93\x25\x48\x79\xfc\xc5\x82\xcc\x87\x14\x35\x59\x8c\x1e\x5b\x96\xfb\x3e\x65\x11\xcf\xe0\x98\x94\x63\x6d\xc8\xf4\x72\xa4\x0b\x78\x6d\x5e\x2e\x61\x01\x7e\x13\x48\x51\x06\xe9\xec\x77\xe0\xd7\x21\x62\xdf\x66\x03\x6e\x43\x16\x03\xd2\xeb\xf2\xd9\x89\x6d\xfa\x43\x26\x14\x51\x71\x6d\xcb\x84\x5b\xe9\xae\xa8\xca\x91\x4a\x44\x30\x0d\x77\xbf\xb0\xe7\x23\xf8\x21\xd5\x30\xff\x0b\x78\xab\xcf\xe1\x9d\x90\xa9\x49\xa7\xd9\x6e\x08\x98\x81\xbd\xaf\xf1\x73\xc9\xb8\x99\x4b\x19\x46\x3f\x0d\xf1\x5b\x65\x48\x6b\xaf\x06\x95\xee\x79\xaf\x9c\xe6\x44\xc1\xdb\x8c\x3e\x6f\x84\x8f\x3f\x5d\xd8\x54\xfa\xa1\x31\x66\xaa\xb7\x01\xdf\x27\x66\x71\x16\xf9\x2c\xbc\xa2\xd7\x25\x1a\xfd\x18\x51\xf9\x89\x8a\xf4\x41\xab\xd2\x95\xbe\x83\xa8\x3e\x76\x9b\xf7\x5d\x3a\x8d\x9a\x3d\xde\x7a\x0b\x12\xdd\x93\x98\x7b\x2d\x9a\xc6\x2d\x1e\x4e\xe6\x9e\x35\x5d\x32\xe1\x3b\x2d\x7d\x02\x4f\x4f\xdc\xa3\xed\xb3\x17\x3f\x17\x4e\x0b\xa7\x85\xb3\xb5\x8c\xc2\x3a\xf8\x45\x3a\x61\x59\x2d\xb2\x3c\xa8\x91\x97\x28\xc0\xbb\xfc\x7f\xda\x27\x73\xcc\xda\xb7\x0c\xbd\x07\x43\xed\x78\xfa\x81\x0c\x24\xc2\x42\x7e\xb5\x49\x92\x8d\xf4\x3e\x7d\x7e\x02\x2f\x2c\x3f\x29\x0a\xc9\x0c\xf3\x69\x67\xf0\x36\x76\x12\x6f\x1b\xe6\x9a\xe0\x83\x27\xf0\x2b\xf5\x4e\x90\x29\x33\x44\x66\x7c\x4e\x41\xdc\x2b\x46\x29\xc0\xc3\x4e\x8c\x2e\x82\xf7\x3e\x83\xd0\x70\x00\x2e\xe8\x27\x30\x7c\xdf\xd6\x78\x71\x29\x7c\xfa\xc5\x01\x99\x98\xfb\xc2\xad\xbb\xf9\x2e\x43\x6a\xa1\xde\x82\x41\x04\x9f\xad\x3e\xa4\x76\xbf\x21\xf2\xa7\x1e\x8c\xec\x3f\x22\x91\x6b\x8a\x34\xda\xd1\x3a\x09\x25\xb8\xec\x9f\xfc\x2a\xc0\xef\x5a\xa7\x5c\xa2\xff\xc1\x8a\x18\x32\x24\x0f\x5b\xe2\x3e\x90\x49\xc0\x84\x8a\x4d\x89\x50\x36\x5b\x1b\x19\xdb\xc1\x19\x18\x3f\xb1\x9f\x40\xf9\x57\x35\xda\x89\xd7\x02\x02\x3d\x47\x66\xca\x64\x40\x36\x7e\xad\xe0\x45\xfa\x6b\x05\x90\xfe\x68\x80\x4f\xaf\x8d\x49\xb8\xeb\x71\xee\x4e\x76\x16\x75\xa2\x6b\x88\x1e\x06\x52\x84\x24\xc1\x0d\x23\x7c\xf1\xd3\xcf\xff\x28\x5e\xbd\x28\x4e\x59\x30\xe1\x02\xf5\x2f\x6e\x83\x4d\x8f\x2b\xf9\x8f\x07\x50\x19\x88\x2b\x1d\x23\x14\x04\x2e\xed\x14\x2c\x36\xfe\x18\x8d\xbb\x85\x2c\x35\xd0\xa1\x93\x45\x11\xf8\x33\xdb\x64\x14\x13\x9a\x02\xf3\x3e\x61\xa1\x21\x60\xcb\x2f\x08\xf5\x36\x8a\x77\x51\x66\x43\x29\xd6\x16\xe7\xf3\x3f\xcd\x13\x7f\xa4\x7b\xcd\xfc\xe0\xc9\x62\xe3\xca\x0c\xac\xae\x60\x38\x46\x7b\x0e\x1e\xc7\x63\xb8\xb5\x74\x5c\xe2\x8c\x6a\x73\xc1\x3f\x98\x57\xbe\x3b\x55\x85\x38\xdc\x92\x67\x4f\x97\xab\xdb\xb3\x6d\x4d\x7e\x15\x91\x64\x61\x17\x63\xaa\x21\x87\x64\x98\x08\x93\xf8\xd7\x28\x38\x8b\x80\x7e\x40\xc1\x83\xdb\x54\xbd\xc8\x14\x49\xc7\x8b\x2c\x36\x45\x2d\x13\x15\xa0\x2e\xd0\x1e\x56\x08\x5d\xfe\xdf\x7e\x1d\xf9\xe0\xd9\xd5\x3f\x7b\x9d\xf4\xa7\x83\x4a\x90\x76\xbb\xe3\xf4\x67\xd1\xe1\x54\x93\x9b\xd6\xb6\xee\xc1\xcf\x55\xc0\x7a\xf3\xb9\x9d\xe6\x77\x14\x77\xcf\x61\x5f\xbd\x3a\xfd\x2c\x3e\x7b\xe0\x8e\x14\x84\x54\xac\x70\x84\x0a\x05\x21\x96\xe3\x44\x8d\xde\x81\x5a\x83\x43\x7b\xaa\xd2\xdb\x7b\x57\xa8\xd8\x6a\x48\xe9\x88\x23\x7f\x71\x76\xdf\x19\x70\x3b\xf2\xed\x43\x51\xaa\x25\xf0\xd9\xb9\xe3\xd0\x16\x66\xd0\x20\x3a\x02\xd1\x0d\xcf\x77\x25\x07\x7c\x68\x65\xc0\x62\x53\x70\x99\xd2\x42\xc8\x78\x34\xdb\xff\x5b\x2e\x07\xfe\x88\xcb\x92\xb1\x19\x99\x04\x93\x1d\xf3\xd2\x33\x64\x21\x90\xd3\x38\x42\x83\xff\x3d\x00\xb8\x33\x71\xaa\x39\x4a\x00\x00")
 
 func kubernetesmastercustomdataYmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -967,7 +988,7 @@ func kubernetesmastercustomdataYml() (*asset, error) {
 	return a, nil
 }
 
-var _kubernetesmastercustomscriptSh = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x5a\x7f\x77\xda\x36\xf7\xff\xfb\xf1\xab\xb8\x75\x72\xfa\xec\x47\x8d\x21\x69\x92\x95\x2e\xdb\xa1\xc4\xe9\x58\x53\xc8\x80\x64\xa7\xdf\x75\x63\xc2\x16\xa0\xc5\x96\x3c\x49\x4e\x42\x5b\xde\xfb\xf7\x48\xb2\x8d\x8d\x0d\xe9\xda\x6d\xe7\x3c\xf9\x23\x07\x5b\x57\xf7\xb7\xae\x3e\x57\xf2\xde\x23\x77\x4a\xa8\x3b\x45\x62\x61\x59\x7b\x9f\xfe\x67\xed\xc1\x68\xdc\x19\x8e\x61\xe4\x75\x87\xde\x18\xce\x3a\xe3\x0e\x38\xe0\x75\x7f\x18\xc0\x59\x6f\xd4\x79\x71\xe1\x9d\x7d\x16\x7f\x6b\x0f\xce\x09\x0e\x03\x01\x33\xc6\xe1\x77\xf4\x2e\xe1\xb8\xf1\x87\x60\xf4\x77\x6b\xec\xf5\x3b\xfd\xf1\xa4\x77\x76\x6a\xef\xbf\x6f\xad\x6c\x6b\x74\xf5\xa2\xef\x8d\x47\xdd\x61\xef\x72\xdc\x1b\xf4\xd3\x91\x83\x95\x6d\x0d\xbd\xd1\xe0\x6a\xd8\xf5\x26\x2f\x87\x83\xab\x4b\xf5\xf6\x70\x65\x5b\x17\x83\x6e\x47\x11\xaa\xe7\xa7\xf9\x7c\xf5\x74\xb4\xb2\xad\xbe\x37\xfe\x79\x30\x7c\x35\x19\x79\xdd\xab\x61\x6f\xfc\x66\x3d\xf7\x78\x65\x5b\xd7\xbd\xe1\xf8\xaa\x73\x31\x49\xa9\xd4\xeb\x13\x25\x68\x70\x35\xf6\x26\x63\x65\xb7\x7a\xf5\xcd\xca\xb6\x2e\x87\xbd\xd7\x9d\xe1\x9b\x49\xe7\xba\xd3\xbb\xe8\xbc\xe8\x5d\x28\x5e\x23\x23\xe7\x99\x92\xea\x0d\xaf\x7b\x5d\x6f\x72\x39\xec\xf5\xbb\xbd\xcb\xce\xc5\xa4\x7b\xd1\xf3\xd6\x86\x35\x77\xd1\x18\xb7\x6b\x3a\xe5\x81\x57\x57\x2f\xbc\x0b\x6f\xac\xe8\xae\x3b\x63\x6f\xf2\xca\x7b\xa3\xc7\x94\x0f\xc6\x9d\xe1\x4b\x6f\x3c\xf1\xfa\xd7\xbd\xe1\xa0\xff\xda\xeb\x9b\x69\x87\x05\x53\x2f\x07\x17\xbd\xae\x99\xa1\xfc\x61\xed\xc1\x6b\x24\x24\xe6\xc0\x68\xb8\x04\x81\x7d\x8e\xa5\xb0\x3a\x97\x3d\xa5\x8f\x37\xac\x88\x51\x6e\xeb\x76\x26\x5d\x6f\x38\xee\x9d\xf7\xba\x9d\xb1\x76\x42\xeb\xd8\xbc\xde\xa4\x56\xfe\x7a\xdd\x19\x8d\xbd\xe1\xe4\xfc\xa7\x33\x1d\x85\xd6\x37\xa9\x11\xdd\x41\xff\xbc\xf7\xb2\xc2\xe9\x59\x79\x38\xe5\x74\xa0\x5c\xd4\x39\x7b\xdd\xeb\x5f\x8d\xbc\xa1\x7e\xd3\x32\xea\xfb\x21\x4b\x02\x42\x89\x04\x9e\x50\x3f\x0a\x00\xd1\x00\xe4\x02\x03\xbe\x97\x98\x0a\xc2\x28\xdc\x91\x30\x54\xa3\x40\x28\xc4\x88\xa3\x30\xc4\xe1\x13\x90\x0b\x22\x80\x08\x90\x0c\x30\x15\x09\xc7\xd6\x5e\xc6\x62\x46\x28\x11\x0b\x2c\x2c\x33\x30\x4c\x68\x97\x45\x11\xa2\x41\x97\x45\x71\x88\x25\x0e\xbe\xf8\xd2\x7a\x6f\x01\x00\x60\x7f\xc1\xc0\xbe\x43\x44\x12\x3a\xd7\x09\x9c\xf2\x90\x2c\x65\x63\x6b\x3a\x35\x42\x94\x02\xef\x5b\x8d\xc6\xb3\x66\x73\xf5\x1c\x02\xa6\x47\xd4\x1f\x99\xc1\x2f\xe0\x60\x70\x59\x2c\x5d\xbd\x00\x5c\x9f\x51\x89\x08\xc5\x5c\xb8\x86\x63\xc3\x4f\x85\xc3\xaf\xcf\x95\x81\x34\x9f\xbd\xd6\xa3\xac\x7f\x60\x97\x48\xa6\x1c\xa3\x9b\xfc\xcd\x8c\xe4\x3f\x45\x88\x71\x0c\x2d\xfd\x1c\x30\x8a\xad\xd5\x76\xc3\x95\xcb\x3b\x10\xe0\x10\x2d\x95\x89\x42\x22\x2e\xb5\xbb\x6f\x92\x29\xe6\x14\x4b\x2c\x20\xe6\xcc\xc7\x42\x60\xed\x5e\x8a\xd5\x6f\xc4\x97\xd6\x9e\x32\x13\x01\xc7\x53\xc6\xa4\x1a\xe2\xf8\xcf\x84\x70\x1c\x34\x00\x06\x72\x81\xf9\x1d\x11\xf8\x89\x66\x86\xe6\x98\x4a\x61\x02\x87\xa9\xcf\x12\xaa\x52\x94\x08\x91\xe0\x36\x58\x7b\xb0\x90\x32\x16\x6d\xd7\x9d\x13\xb9\x48\xa6\xca\x33\xee\x5a\x7e\xf1\xa7\x9e\x22\xdc\xa7\xad\xd6\x37\x47\x96\xf1\xf2\x0c\xdc\x5b\xc4\x95\x53\x5d\xa3\x8a\x93\xe9\x51\x72\xec\xd0\x7b\x31\x18\x8c\x87\xde\x4f\x57\xbd\xa1\x77\x76\x2a\x79\x82\x2d\x1c\x0a\x5c\x37\x38\x43\x6a\x60\x46\x94\x73\x7a\x33\xa8\x5d\x3d\xca\x60\x1c\xc5\x72\xa9\x2d\xa4\x70\x87\x01\x71\x0c\x94\x49\x60\x54\x1b\x1d\xe9\x85\xa8\xb5\xfc\x05\x1e\x81\xf3\x0e\xec\xfd\xf7\xb5\xbc\x56\x36\xfc\x5a\xd4\xd5\x04\x7f\xab\x58\xca\xa8\x93\x8a\x46\x42\x24\x91\xca\x54\x23\x0c\x28\x0b\xb0\x6d\x69\x26\xb5\xd3\x27\x97\x9d\xf1\x0f\xa7\xb6\x8b\xa5\x5f\x74\xab\x8f\xb9\x14\x2e\x8a\x89\xc0\xfc\x16\xf3\xc6\x0d\x5e\x9a\x5c\x93\x2c\xf1\x17\x5b\xf5\xd6\xdc\x56\x86\xd2\x5f\x44\x2c\x80\xe6\x71\xb3\xf9\x91\xe4\xec\x8e\x02\x67\x4c\xb6\xd5\xbf\x8f\x9a\x63\xdc\xb2\xdd\x87\x1f\x60\x8a\x04\x3e\x7e\x0a\x8e\x13\x60\x9f\x05\x18\xbe\x7b\x90\x6f\x9e\x02\x0f\xf8\x7c\xd3\xdf\x77\x8c\xdf\xe4\xfe\xce\x33\xa5\x5c\x32\x3f\x31\x45\xca\x4c\xea\x73\xa3\xdb\xf9\x6b\xc9\x50\xe6\xb9\x33\x0b\x7c\x54\x13\xfe\x9a\xf9\xb5\x71\xdf\x45\x57\x09\xf8\x76\xe2\x2c\xd2\x15\x57\x6c\x09\x71\x3d\xa7\x8d\xd8\x6e\x0d\xce\xf6\xa0\xd6\x6c\xce\xbb\x7d\x17\x12\x4c\xa5\xf1\x5f\xee\xbb\x6d\x4c\x56\xb6\x55\x76\xde\x4e\xc2\x8a\xf7\x76\x50\x67\xee\xab\x21\xd9\xee\xc3\x1d\xfc\xac\xce\xff\x5d\x0d\xbd\xc9\x8f\xa3\x41\x7f\x8b\xf9\x6b\x90\x57\x30\x7c\x63\x56\xc5\xde\xba\xf1\x6a\x55\xa8\x12\x21\x09\xdf\x7e\x0b\xde\xe0\x3c\x5d\xdf\x15\x0a\xb3\x95\xdb\x1a\x4a\xd8\x6d\x7b\xff\x7d\x15\x48\xad\xec\x27\x86\x48\x62\x8a\xa8\xec\x05\x76\x5b\xf1\xca\x01\x6a\x3e\x2e\x92\xa9\xf0\x39\x89\x25\x61\x34\xa3\xaa\xa2\xd6\x9c\x1c\xa1\xa0\xab\x93\x20\xa7\xdd\x8e\x15\xab\x93\x46\x1a\xaf\x3d\x30\xd1\x00\xc8\x7c\x32\xc7\x82\x25\xdc\xc7\x2f\x39\x4b\x62\x33\xb5\x8c\x9d\x73\xca\x90\xf9\x48\x99\x61\x88\x32\x28\x5d\xb4\x94\x62\xd9\x47\x11\x2e\x5a\xb9\x1e\xc6\x7e\xc2\x89\x5c\x6a\x39\x6b\xaa\x7a\xcc\x9d\xcf\xba\x2d\xb1\xdc\x80\xe0\x6b\x1b\x58\x22\xf1\x18\x4d\x43\xbc\xa6\x2d\xe0\xf2\x9c\x2e\xe6\x24\x42\x7c\xd9\xb9\x45\x24\x44\x53\x12\x12\xb9\x1c\x15\xf9\x6f\x03\xee\x2b\xdb\x5a\x59\xde\xe0\xfc\x73\x1b\x27\xaf\x7f\x06\x83\xf3\x62\xe7\xf4\x79\x9d\x92\xc0\x12\x9c\x7b\xb5\x79\x28\xc4\xa9\x41\xa5\x5a\x54\xbe\x0c\x15\x18\xe3\x38\x66\x5c\x82\x48\x7c\x05\xb9\x66\x49\x08\x7e\x98\xe8\xca\xbe\xc0\x28\x94\x0b\x6b\x96\x50\x5f\x05\x34\x05\xbc\xaf\xcc\xd4\x2f\xbe\x04\xb3\x00\xc8\x0c\xf6\xcb\xc8\x66\x03\x68\x72\x2c\x13\x6e\x1e\x53\xf8\x98\x4a\x9f\xb1\x84\x06\xa7\xad\x2a\xd2\x3d\xde\x8a\x74\x13\xc1\x5d\x95\x60\xa1\xee\x52\x33\x2b\x7e\xcd\x09\x2b\x08\xb7\x24\xaa\xf9\x89\xc8\x36\x57\x61\xbf\xc8\x0e\x1c\x8a\xa1\x99\x0a\x2f\x09\xd6\xb4\x8f\x72\x85\x95\xaa\x01\xf3\x55\xd1\xdf\xa1\xa9\x29\xa7\x99\x49\x94\x71\x48\xe7\x04\x24\xd0\xbb\x39\xa1\x42\xa2\x30\x2c\x44\x2a\x5c\x96\xb1\x3a\xbe\x27\x32\xd5\xbb\x60\xd2\x8c\x58\x2b\x6b\x1d\xc5\x80\xdd\xd1\x90\xa1\xe0\x8a\x87\xa0\x83\xf8\x9f\x3d\xf8\x99\xa3\x38\xc6\x1c\x10\xd7\x86\xf9\x09\xd7\xa9\x91\x91\xc2\x34\x64\x53\x01\x11\xe3\x18\x38\x0e\x09\x9a\x86\xcb\x86\x9e\xc7\xf8\x4d\x3a\x47\x01\x0d\xc7\xe1\x58\xf2\xa5\x81\xdc\x0a\x88\xcb\x05\x20\x1d\xda\x90\xb1\x58\xf7\x58\x2a\x17\x11\x44\xe8\x1e\x24\x89\x30\x4b\x64\xc3\xfa\x4f\x1e\xfb\x16\x1c\xc0\x21\x3c\x85\x23\x15\x7c\xa3\x85\xe3\x44\xe8\xde\x51\xb4\x70\xdc\x04\x67\x26\x46\x17\xa0\x9a\xfa\xe7\x2a\x1a\xdf\x83\x83\xff\x54\x21\x80\xc7\x8f\x4d\x38\xe1\xc3\x87\x2c\x7c\xcd\xe7\x59\x5b\xb2\xb6\x5d\x60\xd9\xc7\x52\x6d\xc0\x97\x61\x32\x27\x14\xf2\x2c\x16\x38\x00\x87\x80\x2d\xdc\xdf\xb2\x2d\x2a\x6f\x7e\x2f\xae\x5e\xf6\xfa\xa7\x8d\xaf\xdc\x2d\x23\x4a\x1f\xd7\x06\xbd\x5b\x05\x78\x86\x92\x50\xea\xd4\x0c\xb1\xdc\x94\x7e\xa6\x43\x3a\x88\xa5\xa8\x11\xbd\xf7\xdb\xd9\xa0\xfb\xca\x1b\x4e\x06\x97\xe3\xd1\x69\xe3\xab\xbd\xe2\xa3\x12\xb2\xf7\x11\x42\x7c\x46\x67\x64\xde\x51\x3b\x65\x66\x2a\x0b\x89\xbf\xcc\xc5\x75\xfb\xbd\x49\xda\x26\x9f\xf5\x86\xa7\x9a\xa1\x4f\x89\x4b\xb1\x6c\x04\x9a\x22\xba\x09\x08\x07\x27\x86\xfd\x32\xad\x55\x80\x58\xce\xb0\xb0\x81\x6e\xd2\xad\x11\xdb\xc9\xd1\x51\x3d\x97\x3d\x38\xcb\x92\x4b\xeb\x0a\xd7\x7d\x6f\xac\x74\x83\x58\x47\x46\x34\x72\x65\x5f\xf4\xfa\x46\x53\xd5\xe6\x2a\x4d\xa7\x84\xd6\xe8\x99\x92\x65\xec\x5f\x13\xce\x19\x87\x19\x67\x51\x5d\xe3\xa7\x85\x1a\x40\xe1\xe4\x4d\xb3\x43\x8d\xc7\x08\x9d\xbb\x1c\x87\x18\x09\x2c\x5c\x89\xe6\xee\xbe\xd9\xfd\x4d\xbc\x27\xd7\xde\x30\x9d\xa9\xf6\x1d\xc7\xa7\xc4\x09\x09\x4d\xee\x1d\x14\x05\xc7\x4f\x9d\x0a\x71\x43\xce\xdf\xa5\xc5\x64\xbd\xf4\x32\x9d\x90\x2f\x9c\x48\xeb\xda\xd0\x3c\x71\x30\xc7\x0d\x8a\x8d\xa5\x3b\xa4\x84\x48\x62\x21\x15\x6b\xf8\x00\x12\x71\x70\xee\xdf\x81\xd3\x2d\xfb\xe2\xa3\x5c\x91\x9b\x5f\xb0\x5e\x09\xcf\x3d\x90\xa9\xed\x6a\xde\x43\xef\xc2\xeb\x8c\x3c\xed\x05\xa5\x54\x6a\xf4\xc6\xd0\xa8\x37\xe8\x7f\xba\xdd\x6b\xbe\x1f\x63\x26\x34\x5c\x55\x5f\xa6\xc8\xbf\xd9\x99\xa1\x45\xaf\x98\xf4\x74\x86\xeb\x0c\xdd\xc8\x9f\x2e\x8b\x97\xe9\x62\x82\x19\x09\xcd\x26\x10\xdd\x96\x68\xdd\x56\xd3\x31\x98\x54\x11\x6e\x26\xba\x5b\x10\xa4\xb0\xe8\xe6\x70\x69\x72\xbe\x2a\x92\x28\x06\x3c\x95\x0a\xa5\x08\xe0\x49\x88\xd3\x95\xe0\x0a\xb5\x8d\xe4\x23\x8e\x04\x8a\x24\x38\x4e\x48\x84\xcc\x26\x7b\x54\x0d\xaa\x45\xd3\x48\x2b\xcb\x46\xb9\xf3\x29\xc9\x06\x0a\x95\xc8\x06\xc7\xb9\x65\x61\x12\xe1\x75\x35\x68\x67\xbf\xda\x9c\x15\x86\xb3\x25\xd8\xce\x7e\xb5\x39\x53\xa8\xc7\x52\x0e\x53\xbe\x4a\x38\x16\xf0\xca\xd4\x25\xb5\x85\x24\x42\xeb\xa3\x6b\x7f\xc4\x12\x6a\x8e\x7d\x50\x1c\x73\x16\x73\x82\x24\x86\x05\x13\x32\x46\x72\x21\x36\x6b\x58\x17\x85\xc4\x67\xd5\x22\xb6\xde\xa7\xb7\x99\xf7\x0f\x98\x58\x53\x63\xeb\xcb\xab\xe9\xb2\x0b\x90\xd5\x9c\x9d\xae\x6c\x38\x05\x5b\xc7\x7b\xa3\xd5\xd6\x49\xb2\xa5\x68\x9b\xbe\x32\xdc\xc9\xd3\xd7\x6e\xda\xca\xb4\xc6\x8b\x29\xd7\xb4\x6d\x35\xa9\xd3\x67\x10\xeb\xc1\x27\x90\xee\x2e\xfa\x8c\x53\xed\x30\x6a\x63\xd8\xee\xf3\x94\x62\x9b\xdf\xed\x3a\x08\x62\x80\xa4\x21\x5b\x6f\x83\x4b\x21\x71\xa4\xb0\x0f\x36\x69\x6c\xe0\x4f\x9a\xda\xe6\xa0\x59\x9f\x1b\x6e\x9c\x07\x2a\x68\x94\x9d\xc5\x65\x11\x78\xf4\x00\x24\x5d\xcb\xe2\xd8\x30\x2d\x08\x33\x15\x4b\x3d\x8e\xd4\x10\xce\x20\x2a\x3c\x78\x20\x9b\x4b\xdf\x84\x7d\x84\xce\x58\xcd\xf1\xeb\x1a\xf6\x09\x89\x64\x22\x60\xff\x7b\xbb\x42\xa0\xf9\x3c\xa8\x70\x25\xa4\x65\xfe\xa9\x1e\xc2\x18\x54\x15\x52\xb6\xb7\x59\x19\x2f\x63\x65\x28\xe3\x65\xd8\xc0\xcc\x50\xc4\xcd\x90\x63\xe7\x92\x8c\x12\x78\x86\xed\x78\x78\x03\x03\x6b\x03\x6a\x40\xef\xc1\x03\xa0\x77\xdd\xba\x84\x58\x6e\x4f\xb9\x0c\x50\xc1\xbf\x92\x73\x45\x69\x9b\xfa\xde\x4b\x8e\x7c\x99\xf7\x5a\x3b\xf4\xf5\x65\xe8\xa4\xe4\xff\xa2\xde\x9b\x52\x6b\xfd\xfd\x23\x4b\x38\x45\x35\xea\x07\x08\x47\x8c\x3a\x1c\x2b\x54\x50\x6f\x9a\x79\x11\x38\x7f\x18\x1e\x41\x43\x60\x7e\x4b\x7c\xfc\x2f\xd9\xb8\x53\x7c\xad\xb1\x9d\xec\x5c\xfb\x73\x3b\x63\x73\xd8\x55\xae\x3d\xff\x54\x7b\xbc\x65\x46\x7a\x02\xe0\xa8\xba\xb5\x59\xdd\xd4\x46\xf4\xbd\xde\x79\x9a\x76\x81\x75\x2d\x7b\x28\xf5\xb5\xe9\x45\xcf\xd6\x22\x54\x35\xfe\x2f\x16\xa2\x4a\x01\xdc\xac\xc3\xb1\x80\x0f\x30\xe7\x38\x86\xfc\x16\xe2\x7f\xc8\xbc\x8f\x3f\xa2\x28\x89\xd9\x7e\x4e\x51\xd1\x7d\x4b\x99\xd5\x25\xf6\x70\x7b\xea\x7b\xd2\x0f\xf2\xac\xdf\x9d\xaa\x35\x4d\xbd\xea\x08\xda\xae\xdb\x3a\x38\x69\x34\x1b\xcd\x46\xab\x7d\x70\x78\xf2\xcc\xbd\x3d\x70\x23\xe4\x2f\x08\xc5\xe2\xf9\xc6\x46\x92\xb7\xfd\x0f\x6d\x1e\x4a\x2f\x05\x48\x92\x78\xc7\xa1\xc9\x03\xc7\x40\x47\x6b\x1f\xd7\x1b\x7e\x86\x24\x3a\x23\xeb\x55\x6f\x50\x6e\x9a\x66\x6e\x80\x6f\x5d\x11\xf8\xad\xfc\xc5\x2d\xe2\x6e\x48\xa6\x0a\x7b\x06\x01\x11\x37\xd6\xf6\xbc\xab\x89\x95\xb6\x88\xa8\xc6\x80\x52\x7d\x97\x40\xe4\x02\x02\x24\x11\xa8\x56\x18\xc9\x76\x55\x80\x5d\x57\x6e\x4a\x2b\xc5\xb0\xae\x4c\x84\x3b\x64\xaa\xa9\x39\xed\x42\x72\x6d\x4d\x03\xc6\x7c\xa9\xe4\x4b\x96\xda\x8b\xc2\x10\x02\xac\x2a\xa4\x68\xd8\xf5\x70\xe9\xb8\x06\x2d\x89\x24\xc8\x38\x38\x08\x1e\x3f\xfe\xab\xde\x7b\xfe\xf7\x2c\xdf\xaa\xed\x5a\x0f\x1c\x18\x97\x66\x6a\x54\xd7\x74\xc1\xa5\x35\x19\xb4\x99\x45\x50\x5c\xad\x33\xa2\x3b\x99\x42\x5c\xf3\x38\x6e\x3a\x7e\x5b\x54\xf5\xba\x7c\x5a\xca\xcb\x3b\x4e\xa4\xc6\x39\xa6\x1d\xcb\xb3\x72\xfd\x71\x84\x3e\x4a\x59\xb0\x08\xbb\xfb\xf9\xa7\x11\x6e\x43\x15\x81\x0d\xc2\xf3\xde\x85\x77\xba\x5f\x9a\xe8\x9a\x9e\x62\xe3\xf4\xa5\x44\x62\x32\x57\x5f\xce\xec\x97\x79\x15\x7a\xf3\xb5\xe4\xf6\xfa\x67\x1d\xa3\x8f\x24\x2f\xb0\xd7\xa7\x4e\xaa\xdb\xae\x63\x96\xb7\xe2\x1b\x53\xf5\xf0\xf9\x4f\x67\xfd\x51\x32\x9b\x91\xfb\x53\x73\xa9\x83\xe2\xb8\x91\x75\xe8\x91\x5d\x5c\xa8\xd5\x9b\x1e\x9d\x72\xba\x81\xeb\x2e\x08\x45\x5d\x7d\x29\x54\xb7\x8e\x6b\xa5\xa8\x3a\x87\xd2\x27\xd2\xf0\xa9\x5d\xc4\x04\x7b\x10\x10\xa1\x31\x51\xc8\xe6\x73\xb5\xea\xd0\x4c\x2a\x30\xaf\xaf\x71\x80\x25\x32\x4e\x64\xd6\xd6\xc3\xd7\xf7\xd6\x3a\xaf\x2d\xc7\x71\x2c\x14\x93\x6b\xcc\x05\x61\xb4\x0d\xb7\x2d\x2b\xdd\xdb\x45\xdb\x72\xb2\x7d\xbe\x6d\xdc\x83\xb9\x24\x33\xe2\x23\x89\x1d\x94\xc8\x05\xe3\x44\x2e\x1d\x95\x94\x6d\x78\x6b\xef\x97\xbf\xdf\x79\x6b\xa7\x12\xd5\x1e\xda\xce\x4f\x75\xf6\x0b\xdf\xed\x34\xf6\xb3\xcb\x9f\xc6\xfe\xda\x6a\x0b\x80\xa2\x08\x6b\x96\x05\xe2\xb7\xb6\xe5\x33\x2a\xf1\xbd\x34\x8a\x99\xdf\xa9\x62\xa9\x96\xd5\x29\x6a\x34\x11\xd5\x21\x07\x05\x11\xa1\x9a\x60\x9b\xb0\x84\x73\x4c\xa5\x93\x09\xaa\x52\xdc\x10\x1a\xb4\xd3\x53\x0d\x4b\x09\xd1\x8a\xd5\xb1\x2b\x48\xd3\xca\xa4\x4a\x13\xcd\xbf\xe0\xd4\xdc\x95\xf5\x1f\x32\xa5\xf6\xa4\x13\x6f\xf0\xb2\x76\xc2\x2b\xef\xcd\x5b\xdb\xb2\xe1\xbb\xda\xfc\xdf\x03\x9e\xe2\xe7\xba\x5c\x11\x79\x96\x38\xf7\xe6\xd0\x26\xbd\xc3\x47\x34\xd0\xf7\xd1\x56\xb1\x33\xb7\x6a\x8e\x39\xac\x52\x23\x65\x95\xdb\x14\xab\x84\xfa\x0b\xec\x15\x58\xff\x94\x2f\x54\x36\x8a\x99\x49\xec\xe2\x25\x54\xe1\x4d\x61\x2b\xde\x78\x5b\x78\xcc\x31\x7a\xfa\x31\x45\x44\x24\x99\xeb\xdb\x4a\xbd\x55\x4d\x93\x79\x9e\xc9\xd3\x64\x2e\x1a\x21\x4a\xa8\xbf\x88\x51\xa0\x4f\x26\x93\x69\x42\x65\xe2\x7e\x6d\x6e\x42\x5d\x7d\x1a\xeb\x7e\x3d\x4d\xe6\x6e\xeb\xf8\xe4\xf8\xf8\xf0\xc8\x5c\x89\x1f\x04\x41\xcb\xc7\xad\x13\xa7\x79\xf2\x0c\x3b\x4f\x9b\x87\xbe\x33\x3d\x3c\x3a\x70\x50\xeb\xd9\x41\x0b\xe3\x83\xe6\x09\xc6\xf0\x1d\xa8\x9e\xde\x9d\x26\xc2\xbd\x8d\xd4\xff\x80\x93\x5b\xcc\x85\xbb\xb8\x9d\x24\x92\x84\x6e\x42\xa7\x84\x06\x56\x76\x39\xd0\x3a\x24\x6f\xff\x76\xee\x6f\x69\x7a\xa1\xc0\xfd\x86\x6e\x02\xfe\x96\x4f\x91\x4c\xe9\xe9\xa5\xb7\x55\xf9\xf7\x67\x65\x04\x66\xed\x68\x8e\x3e\x21\x53\xd2\xab\xcd\x16\x44\x84\x26\x12\x9b\x7b\x4d\xd3\xc9\xa5\x5a\xe5\x45\xf1\xbf\x69\xab\x98\xf5\x88\x4f\xd2\xde\xb1\xf0\x49\x8b\xbe\x8a\x32\x9c\xfe\xab\x27\xe6\xdf\xc4\x82\xe3\x83\x2d\x16\x89\x0c\xf4\x11\x33\x87\x16\x3c\x56\xcb\xb6\xfc\x11\xc8\x2e\x11\xfa\x23\xb5\xaa\x84\x22\x4f\xca\xee\x2c\x5d\xfd\x67\xc4\xfa\xff\x00\x00\x00\xff\xff\xc2\x24\x49\x63\x90\x2b\x00\x00")
+var _kubernetesmastercustomscriptSh = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x7b\x7d\x73\xdb\x36\xf2\xf0\xdf\xe1\xa7\xd8\x50\x9a\xbb\xf6\x2e\x14\x2d\xe7\xad\x55\x2f\xed\x28\x34\x93\xea\xe2\x48\xae\x24\xbb\xd3\x27\xee\xf9\x20\x12\x92\x70\xa6\x40\x16\x00\x6d\xab\xa9\xbe\xfb\x33\x0b\x82\x14\x49\x51\xb2\xdd\xde\x75\xe6\x17\x7b\x62\x89\x58\xec\x3b\x16\xbb\x0b\xb0\xf5\xd4\x9d\x31\xee\xce\x88\x5c\x5a\x56\xeb\xf7\xff\xb3\x5a\x30\x99\xf6\xc7\x53\x98\xf8\xde\xd8\x9f\xc2\x49\x7f\xda\x07\x07\x7c\xef\xfb\x11\x9c\x0c\x26\xfd\xb7\xa7\xfe\xc9\x1f\xc2\x6f\xb5\xe0\x1d\xa3\x51\x28\x61\x1e\x0b\xf8\x37\xf9\x35\x15\xb4\xf3\x1f\x19\xf3\x7f\x5b\x53\x7f\xd8\x1f\x4e\xaf\x06\x27\x6f\xec\xf6\xe7\xee\xc6\xb6\x26\xe7\x6f\x87\xfe\x74\xe2\x8d\x07\x67\xd3\xc1\x68\x68\x46\x8e\x37\xb6\x35\xf6\x27\xa3\xf3\xb1\xe7\x5f\xbd\x1f\x8f\xce\xcf\x10\xfe\xf9\xc6\xb6\x4e\x47\x5e\x1f\x01\xf1\xfb\x8b\x62\x3e\x7e\x7b\xb9\xb1\xad\xa1\x3f\xfd\x71\x34\xfe\x70\x35\xf1\xbd\xf3\xf1\x60\xfa\xd3\x76\xee\xab\x8d\x6d\x5d\x0c\xc6\xd3\xf3\xfe\xe9\x95\x81\xc2\xc7\xaf\x91\xd0\xe8\x7c\xea\x5f\x4d\x51\x6e\x7c\xf4\xd5\xc6\xb6\xce\xc6\x83\x8f\xfd\xf1\x4f\x57\xfd\x8b\xfe\xe0\xb4\xff\x76\x70\x8a\xb8\x26\xfe\x14\xc7\xbf\x46\xaa\xfe\xf8\x62\xe0\xf9\x57\x67\xe3\xc1\xd0\x1b\x9c\xf5\x4f\xaf\xbc\xd3\x81\xbf\x15\xec\xe8\x10\x4c\xa6\x76\x44\xd5\x45\x0d\x7c\x38\x7f\xeb\x9f\xfa\x53\x84\xbb\xe8\x4f\xfd\xab\x0f\xfe\x4f\x7a\xec\x78\x63\x5b\xd3\xfe\xf8\xbd\x3f\xbd\xf2\x87\x17\x83\xf1\x68\xf8\xd1\x1f\x6a\x0e\xba\xcf\x4b\xa2\x9e\x8d\x4e\x07\x5e\x36\x03\xf5\xd1\x82\x5f\xa9\x88\xe1\x76\x49\x39\xa8\x25\x85\x0f\xe9\x8c\x0a\x4e\x15\x95\x70\x43\x85\x64\x31\x87\x30\xa6\x12\x78\xac\x40\xa6\x49\x12\x0b\x05\x82\x28\x0a\x11\x5b\x31\xc5\xf8\xc2\xf2\x4e\x47\xe7\x27\x67\xe3\xd1\xc5\xe0\xc4\x1f\x5f\x8d\xfb\x53\xff\x74\xf0\x71\x30\xbd\xfa\xe1\x6c\xa2\xa9\xa0\x9e\xf7\xc1\xbc\x3d\xf7\x3e\x18\xd1\x50\xe1\x56\x0b\x3e\x12\xa9\xa8\x80\x98\x47\x6b\x90\x34\x10\x54\x49\xab\x7f\x36\x98\xf8\xe3\x0b\x7f\xbc\x23\x33\x9a\xc3\xeb\x5f\x79\xfe\x78\x3a\x78\x37\xf0\xfa\x53\x5f\x3f\xfe\x2a\x7b\x5c\x87\x46\x4b\x7c\xec\x4f\xa6\xfe\xf8\xea\xdd\x0f\x27\x43\x04\x3d\x3e\x32\x1a\xf5\x46\xc3\x77\x83\xf7\x75\x4c\xc7\xdd\xea\xb0\xc1\x74\x8c\xba\xee\x9f\x7c\x1c\x0c\xcf\x27\xfe\x58\x03\xa2\x8a\x5b\x40\x57\x89\x5a\x43\xca\x23\x2a\x25\x10\x08\x69\xc8\x02\xa2\x68\x08\x92\x8a\x1b\x16\x50\x20\x41\x10\xa7\x5c\x81\x64\x0b\xce\xf8\x02\xae\xe9\x1a\x98\x84\x20\xe6\x73\xb6\x48\x05\x0d\x0b\x37\xe8\x7b\xde\xe8\x7c\xb8\x63\xe6\x63\x34\x9a\xd5\x82\x33\xc1\x6e\xd0\x0c\x82\x2e\x98\x54\x62\x0d\x81\xa0\x21\xe5\x8a\x91\x48\x3e\xcb\xd4\x97\x10\x29\x69\x98\x99\x96\x40\x52\x9f\x50\x21\xdb\x81\xe9\x92\x42\x2a\xa9\xe0\x64\x45\x81\xf0\xd0\x6a\x69\x04\xb7\xb1\x08\x81\x08\x9a\x63\x9b\x11\x49\x5f\xbd\x00\xca\x83\x38\xa4\x21\x10\x59\x4c\xea\xe5\xe0\xcf\x40\xc6\xc0\x63\x08\x96\x44\x90\x40\x5b\x73\x8e\xbe\xc5\x84\x04\x41\x49\xb0\xa4\x12\xbf\x82\x5c\xd2\x28\xea\x58\xb9\x80\x63\xff\xfd\x60\x32\x1d\xe3\xba\x41\x5b\x6b\xad\xa2\xef\xec\x8c\xf7\xcf\xa7\xdf\xeb\x51\xe3\x32\x41\x14\xa7\x21\xe3\x4c\x81\x48\x79\xb0\x0a\x91\x7b\x4d\x80\xde\x29\xca\xb5\x0b\xdf\xb2\x28\xc2\x51\x60\x1c\x12\x22\x48\x14\xd1\xe8\x19\xa8\x25\x93\xa8\x7d\x15\x03\xe5\x32\x15\xd4\x6a\xe5\x28\xe6\x8c\x33\xb9\xa4\xd2\xca\x06\xc6\x29\xf7\xe2\xd5\x8a\xf0\xd0\x8b\x57\x49\x44\x15\x0d\xbf\xf8\xd2\xfa\x6c\x01\x00\xd0\x60\x19\x83\x7d\x4b\xf4\x6a\xd0\x11\xcc\xe0\x50\xb1\x41\x63\x6b\x38\x1c\x61\xc8\xc0\xe7\x6e\xa7\xf3\xf5\xd1\xd1\xe6\x1b\x08\x63\x3d\x82\xbf\x6c\x0e\x9f\xc0\xa1\xe0\xc6\x89\x72\x75\x04\x74\x83\x98\x2b\xc2\x38\x15\xd2\xcd\x30\x76\x02\x43\x1c\x7e\xfe\x06\x05\xe4\xc5\xec\x2d\x1f\x55\xfe\x43\xbb\x02\x32\x13\x94\x5c\x17\x4f\xe6\xac\xf8\x28\x23\x4a\x13\xe8\xea\xef\x61\xcc\xa9\xb5\xd9\x2f\xb8\x65\xb5\xa0\x0f\x21\x8d\xc8\x1a\x54\x0c\x52\x11\xa1\x90\x1b\xb8\xde\x86\x8e\x44\xc4\x01\x95\x92\x6a\xf5\x72\x8a\x9f\x89\x58\x5b\x2d\x60\x73\x20\x20\xe8\x2c\x8e\x15\x0e\x09\xfa\x4b\xca\xb4\xfb\xc1\x48\x2d\xa9\xb8\x65\x92\xa2\x5d\x28\x90\x05\xe5\x4a\x66\x86\x43\x67\x4b\x39\x3a\x12\x93\x32\xa5\x3d\xb0\x5a\xb0\x54\x2a\x91\x3d\xd7\x5d\x30\xb5\x4c\x67\xa8\x19\x77\x4b\xbf\xfc\x51\x4f\x91\xee\x8b\x6e\xf7\xab\x97\x56\xa6\xe5\x39\xb8\x37\x44\xa0\x52\xdd\x8c\x15\x27\xe7\xa3\xa2\xd8\xb1\xff\x76\x34\x9a\x8e\xfd\x1f\xce\x07\x63\xff\xe4\x8d\x12\x29\xb5\x68\x24\x69\xd3\xe0\x9c\xe0\xc0\x9c\xa1\x72\x06\x73\x68\x8c\x58\x28\xb0\x0e\x10\x5a\x42\x0e\xb7\x54\x2f\x2c\x8c\xac\x71\x16\x7c\x57\x3a\xf8\x69\x2e\x3f\xc1\x53\x70\x7e\x05\xbb\xfd\xb9\x11\xd7\xc6\x86\x9f\xcb\xbc\x66\xc6\xdf\x4b\x96\xc7\xdc\x31\xa4\x89\x94\xe9\x0a\x3d\x35\x23\x06\x3c\x0e\xa9\x6d\x69\x99\x1a\xa7\x5f\x9d\xf5\x71\xb9\xb9\x54\x05\x65\xb5\x06\x54\x28\xe9\x92\x84\x61\x64\xa3\xa2\x73\x4d\xd7\x99\xaf\xa9\x38\x0d\x96\x7b\xf9\xd6\xd8\x36\x19\x64\xb0\x5c\xc5\x21\x1c\xbd\x3a\x3a\x7a\x20\x78\x7c\xcb\x41\xc4\xb1\xea\xe1\x7f\x0f\x9a\x93\xa9\x65\x0f\xe0\xc6\x86\xdf\xf2\x78\xe6\x38\x21\xc5\x88\x06\xdf\xde\x8b\xb7\x70\x81\x7b\x74\x5e\xd7\xf7\x6d\x2c\xae\x0b\x7d\x17\x9e\xe2\xf5\xf7\xcc\x7b\x8c\x8b\x78\xfd\xfb\x7d\xc3\xeb\x3f\xce\x19\xbc\xfe\x83\xbd\x20\x20\x0d\xe6\xf7\xfa\xfb\x8c\x52\xb5\xbb\xd7\x7f\x84\xc1\xbd\xfe\x7d\x96\xf6\xfa\x0f\x33\xb1\xd7\x7f\x80\x6d\xf7\x1a\xe7\x1e\xa3\xfe\xe9\xcb\xff\x40\xd2\x70\xd0\x72\x26\x2d\x31\x59\xc9\xd6\x8a\x55\x16\x0e\x60\xaf\x33\x52\x71\x81\xfb\xb8\x32\xa6\xdb\xf5\x89\x47\x4d\xdc\x71\x92\x47\xcc\xce\xbd\xe6\xc0\x94\xfd\x2e\xf4\x40\x3a\x85\x4f\xe1\x6f\x0b\x6e\x99\x5a\xc6\xa9\xaa\x64\x87\xd7\x74\xfd\x6c\x27\x45\x54\xf1\x35\xe5\x52\x6f\x11\x92\x2d\x38\x66\x72\x4c\x2d\x51\xd5\x50\x84\x5d\xcc\x1f\x0b\xdc\x11\x07\x47\xce\xb7\x83\x68\xce\x47\xf0\x89\xde\x8b\x78\x5a\x35\x12\x37\x54\xb0\x39\xc3\x9c\x2d\x63\xa8\xe0\x22\x49\x67\x11\x0b\x90\x05\x93\xe0\x55\xb2\x5a\x4c\xc2\x08\x5f\x63\x15\xc1\xe6\x28\x27\xe6\x61\xd7\x74\x2d\x9b\x1d\xf6\xfc\xed\xe9\xc0\xc3\x55\x36\x79\x8c\xc3\x26\xe9\x2c\xf3\xa1\x38\xa1\x5c\xca\x08\x84\x24\xe0\x30\xfe\x30\xb1\xc1\x49\xd2\x19\xda\xa2\xd9\x9c\x35\x96\x8c\x9a\x8a\x14\xe2\x01\xfc\x39\x65\xe1\x3b\x49\x3a\xdb\x49\xdc\x02\xa2\x7e\x27\xa6\x6f\x1f\xc3\xf4\x9c\x59\x68\xde\x86\xd2\xf1\xa0\xb6\x83\x88\xd1\x3c\x2c\x14\xab\x7a\x1f\x92\x8d\x6d\x55\x57\xf1\x41\xc0\x9d\x55\x7b\x00\x3a\x5f\xa5\x0d\x20\xfb\x57\xe7\x01\x7c\xfb\xca\xd1\x2c\xc5\x43\x03\x7f\x42\x0c\xfb\x8a\xd6\x1f\xce\x26\x1b\x1b\xde\x80\x7d\x54\x0b\x7e\xfb\xd0\x6e\x93\xc3\xfe\xff\x3b\x1f\xfb\x57\xff\x9c\x8c\x86\x7b\xf4\xbe\xed\x7d\x94\x34\x5e\x9b\xb5\xa3\xe8\xa6\xf1\x1d\xfd\x36\x00\x11\x05\xff\xf8\x07\xf8\xa3\x77\xf0\x6d\x33\x44\x56\xe0\xd8\xba\xc0\xb2\x7b\x76\xfb\xf3\x6e\x7f\x61\x63\x3f\xcb\x80\x14\xe5\x84\xab\x41\x68\xf7\x10\x57\xd1\xb7\x29\xc6\x65\x3a\x93\x81\x60\x09\x3a\x70\x0e\xb5\xdb\xcc\x29\xc0\x09\x09\x3d\xed\x7d\x05\xec\xbe\xf6\x48\xd3\xa4\x89\xee\x1c\xdc\x33\x31\xeb\xab\x14\x93\x05\x95\x71\x2a\x02\xfa\x5e\xc4\x69\x92\x4d\xad\xb6\x94\x0a\xc8\x28\xce\xd6\x61\x06\x94\x77\x98\x8a\x61\x99\xce\x38\x55\x43\xb2\xa2\x86\x01\x2d\xe5\x76\x98\x06\xa9\x60\x6a\xad\xe9\x6c\xa1\x9a\x5b\x51\xc5\xac\x9b\x0a\xca\x5a\x67\xaa\x80\x12\x71\xaa\xe8\x94\xcc\x22\xba\x85\x2d\xb5\xab\x0a\xb8\x44\xb0\x15\x11\xeb\xfe\x0d\x61\x11\x99\xb1\x88\xa9\xf5\xa4\x8c\x7f\x5f\x3f\xab\x40\xa0\x5d\xe2\x4c\xc4\x37\x2c\xa4\x62\x4c\x14\x3d\xc5\x76\x90\xdd\x83\xbd\xcb\x66\x73\x70\xe6\x0f\x67\x93\x43\x93\xb1\x99\x74\x18\xc1\xdb\x34\xb8\xa6\x07\x19\x30\xcd\xa6\x8d\xb5\xb1\xfc\xd1\xbb\x3f\xda\x11\xf5\x87\x27\x30\x7a\x57\x6e\x89\xfe\xb1\x16\xa8\xa4\x0a\x9c\x3b\xcc\x1f\xb1\x93\xa0\xdb\x08\x18\x16\x02\x15\x61\x91\x2d\xa8\x6e\xbc\xc9\x34\xc0\x52\x7a\x9e\x46\x10\x44\xa9\xce\xd8\x97\x94\x44\x6a\x69\xcd\x53\x1e\xa0\x4b\x9a\x46\x06\x76\xf1\x02\x15\x7d\xf1\x25\x7c\xce\x37\xad\x76\xb5\x62\xad\xed\x43\x82\xaa\x54\xf0\x7c\xaf\xc0\x3f\x86\xfa\x3c\x4e\x79\xf8\xa6\xbb\xdb\xc1\x78\xb5\xb7\x83\x91\x4a\xe1\xe2\x12\x89\x74\xfb\x39\x97\xe2\xe7\x02\xb0\x42\x78\x87\xd4\xd1\xef\xec\x58\x14\x2c\xb4\xcb\xe8\xc0\xe1\x14\x8e\x0c\xf1\x0a\x61\x0d\xfb\xb4\x60\x18\x59\x0d\xe3\x00\x93\xf9\x03\x9c\x66\x3b\x91\x21\x00\x3c\x16\x60\xe6\x84\x2c\xd4\x2d\x52\xc6\xa5\x22\x51\x54\xb2\x54\xb4\xb6\xab\x28\xee\x98\x82\x6e\x5d\xa4\x39\xb3\x36\xd6\xd6\x8a\x61\x7c\xcb\xa3\x98\x84\xe7\x22\x02\x6d\xc4\x27\x2d\xf8\x51\x90\x24\xa1\x02\x88\xd0\x82\x05\xa9\xd0\xae\x91\x83\xc2\x2c\x8a\x67\x12\x56\xb1\xa0\x20\x68\xc4\xc8\x2c\x5a\x77\xf4\xbc\x58\x5c\x9b\x39\x98\xa3\x39\x8e\xa0\x59\xfb\x0f\xfb\x22\x59\x4e\x49\xb4\xbf\x45\x71\x9c\xe8\xde\x19\xfa\x22\x81\x15\xb9\x03\xc5\x56\x34\x4e\x55\xc7\x7a\x52\xd8\xbe\xfd\x85\xa4\xbf\x40\x17\xf4\x22\x9d\x0c\x46\xc3\xb1\x3f\x1d\xff\xa4\x53\xac\x2f\xb1\xa3\x95\x31\xe6\x38\x2b\x72\xe7\xe0\xf4\x2d\xe0\x74\xf0\xd1\x1f\x9d\x4f\x07\xc3\x89\xef\x8d\x86\x27\x13\x70\xe6\x72\x72\x0a\xd8\xd7\xff\x06\xed\xf6\x1d\x38\xf4\x17\x34\x16\xfc\xe5\x2f\x99\xe1\xe1\xb7\xdf\x72\x43\x1f\x21\x6e\x4e\x1b\xb5\x74\xa1\xb3\x2c\x5a\xd1\xd6\x89\x19\x93\x1a\x3f\xa8\x18\xfd\x1d\xe6\x2c\xa2\xd0\xfe\x7c\xbc\xd1\x72\x92\x59\x2c\x94\x6e\x56\xdd\x30\x6c\x13\x32\xbe\xc0\x06\x55\x0e\x88\xca\x2b\x7a\xdf\x2b\xa2\x82\x2c\xf9\x9e\x7c\xdf\x3f\x7e\xf9\x0a\x82\x25\x0d\xae\x65\xba\x02\x3c\x67\xe8\x40\x9f\x9b\xe6\x6f\xf1\x5c\x5e\xb3\x24\x6b\x74\xea\x47\x1d\xeb\x49\xce\x2f\xf2\xa9\xb9\xfa\x56\xf3\x62\x3d\x41\x5f\xfc\x04\x8e\x4e\x5b\x9f\xeb\x92\x0a\x55\xf0\xb4\x28\x6a\x9f\x6f\x40\x43\x62\xb2\x23\x97\xe4\xf8\xe5\x2b\x24\xec\x04\xe0\x98\x65\xfc\xe4\x49\x06\x5a\xe7\x6d\xc5\x64\xc6\x38\x9a\x0f\x49\xda\xd6\x93\x27\xc6\x03\x9f\xd4\x7c\x4e\x52\x35\xa4\x0a\xbb\x14\x67\x51\xba\x60\x1c\x8a\xe8\x81\x7d\x5f\x87\x81\x2d\xdd\x7f\xe5\x59\x55\xbe\x5b\x9d\x9d\x9e\xbf\x1f\x0c\xdf\x74\xfe\xe6\xee\x19\x41\xa2\xae\x9d\xe5\xb8\x21\x9d\x93\x34\x52\xba\x8d\x14\x51\x55\xa7\x7e\xa2\x97\xd2\x28\x51\xb2\x81\x74\xeb\x5f\x27\x23\xef\x83\x3f\xbe\x1a\x9d\x4d\x27\x6f\x3a\x7f\x6b\x95\xbf\x22\x91\xd6\x03\x88\x64\x6d\xf6\x3e\xe6\x58\xb9\xa8\x71\xc4\x82\x75\x41\xce\x1b\x0e\xae\x4c\xab\xff\x64\x30\x7e\xa3\x11\x06\x9c\xb9\x9c\xaa\x4e\xa8\x21\x56\xd7\x21\x13\xe0\x24\xd0\xae\xc2\x5a\xa5\x96\x85\x33\x2e\xa5\x5e\x75\xb8\x6d\xb5\xfb\xfa\xe5\xcb\x66\x2c\x5b\xef\x05\xcd\x2b\x5c\x0c\xfd\x29\x78\xc3\x01\x24\xda\x32\xb2\x53\x30\xfb\x76\x30\xc4\x79\x6f\x74\xdb\x18\x39\x9d\x31\xde\xc0\xa7\x01\xcb\xd1\x7f\x64\x42\xc4\x02\xe6\x22\x5e\x35\x35\x52\x35\xd1\xac\x09\xed\x14\x4d\x68\x87\x67\x1a\x63\x7c\xe1\x0a\x1a\x51\x22\xa9\x74\x15\x59\xb8\xed\x2c\x6f\xcc\xec\x7d\x75\xe1\x8f\xcd\x4c\xcc\x58\x9c\x80\x33\x27\x62\x3c\xbd\x73\xc8\x2a\x7c\xf5\xc2\xd9\x01\xee\xa8\xc5\xaf\x26\x88\xef\x2e\xe6\x9c\x37\x12\x48\x67\xa5\x79\xee\x68\xdc\x34\x5c\xd0\x0e\xa7\x99\xc4\x07\xa8\x69\x62\xde\x70\x70\xe1\x8f\x31\x00\x21\x2d\x70\xd5\x2a\xa9\xcd\xd1\xcf\xed\x1c\xd8\xfb\xde\xf7\x3e\x4c\xce\x3f\x9a\x7e\x16\x11\xe0\xdc\xfd\x3a\xdf\x3b\xcf\xf1\xaa\x2a\x7e\x90\x86\x0b\xad\x96\x94\x8a\xb2\x14\x8a\xcd\xb5\xe1\x6a\xdc\x63\xff\xd4\xef\x4f\x7c\xad\x5c\xd4\xa8\xd1\x65\x6d\x28\x17\xb1\xa2\xce\x07\xab\x71\x8b\x37\x22\x8a\x4a\x85\x98\xe0\xb7\x5c\xfe\xba\x98\xd0\x71\x71\xbb\x98\x91\x20\x3b\x4f\x10\x2b\x70\xf6\xea\xe8\xe0\xd2\x28\xeb\x2d\x5b\x17\xce\x78\xbb\x34\x6a\x8e\xeb\xc5\xc9\xda\x9c\x5a\xe9\x58\xae\x1f\xaf\x6e\x2a\xb0\x6e\xf7\xc8\xd1\x76\xea\x20\x60\x7d\x85\xb9\x25\x42\x58\x3e\xd5\x87\x2b\x93\x73\xb2\x27\xe9\x2a\x01\x3a\x53\x98\x58\x4b\x10\x69\x44\xcd\x12\x74\x25\xe6\x0d\xc5\x88\xa3\x80\x13\x05\x8e\x13\x31\xa9\xf2\xc9\x3e\xc7\x69\xb8\x5a\x3b\x26\xa4\xd5\xe2\x6c\xc0\x59\x3e\x50\x0a\x81\x36\x38\xce\x4d\x1c\xa5\x2b\xba\x0d\x43\xbd\xfc\x53\x4f\xc4\xa5\xe1\x7c\xed\xf7\xf2\x28\xd0\x13\xb1\x8d\x81\xaf\x05\x5e\x7e\xc2\x27\x01\x73\xc2\x88\x2a\xdc\x0a\x53\xa9\xf9\xd1\x9b\xe0\x2a\x6b\x36\xe9\x96\x4f\x22\xe2\x44\x30\x3c\x56\x5c\xc6\x52\x25\x44\x2d\x65\x3d\x78\x7a\x24\x62\x41\xbc\x1b\x3d\x8b\x84\x66\xaf\x78\xff\x03\x11\x1b\x82\xfb\x2e\x67\x79\xb2\xf7\xa9\x5c\x65\x65\xa7\xe0\x59\x19\xaf\x9d\xa5\xa1\x8f\xb9\x6f\xb7\x30\x5d\xbd\x83\x38\x03\xad\xa6\xbd\x48\x1b\xb4\xd8\xd4\x2b\x1c\xc6\x90\xe8\xc1\x67\x60\xb6\x35\xec\xc2\xe9\x83\x36\xdc\x91\xf6\xeb\xdc\x40\xec\xd3\x7b\xd1\x14\x2a\xeb\x2f\xab\x1c\x32\xb0\x42\x71\x72\x2d\x15\x5d\x61\xb2\x4b\x33\x37\xce\xf2\x5d\xe3\xda\xfa\x98\x39\x3b\x00\xac\x1d\xec\x61\x2e\x9c\x1f\xaa\xe5\x16\x78\x7a\x4f\x0d\xb2\xa5\x25\x68\x86\xb4\x44\x0c\x7f\xb3\xaf\x13\x1c\xa2\x79\x4d\x72\xff\xc9\x6a\x41\xbd\x9e\xe7\x33\x3e\x8f\x6b\x2c\xe4\x3f\x59\x36\x25\x15\x51\xa9\x84\xf6\x77\xd5\x0c\x1e\x7f\x34\x9e\x7b\x19\xde\x31\x69\xfe\x93\xe1\x37\x7c\xe8\xa9\xf5\xa3\xda\x5d\x79\xab\x85\xd1\x6e\x71\x64\x4c\x9a\x7f\xac\x17\x49\x95\x42\xc9\xe8\xe4\x13\xb4\x2b\x34\x2a\xd5\xd2\x81\x02\xa8\x56\xf4\x68\x01\xaa\xec\xeb\x1c\xf3\xd8\xaa\xf1\xd5\xe8\x71\x67\x82\xe2\x4e\x37\x58\x91\x05\x95\x85\xe3\xb5\x20\x49\xa3\x28\xcb\x9f\x99\x1e\x02\x0c\xaa\x34\xc4\x13\xf4\x24\x9b\x62\x9e\xcf\xe8\x1c\x8b\x9e\xfc\x08\x1a\x63\x9c\xe6\x48\x3e\x83\x39\x61\x11\x66\xf5\x95\x14\xff\x36\x17\xaa\x05\x84\x83\x46\x02\x52\xe1\x41\x73\x40\x38\x3a\xee\x8c\x6a\xe2\x78\xbd\x61\x8e\x35\x76\x43\xa9\x03\x44\x29\xcc\xf7\xb3\x6e\xf6\xd9\xd8\x3f\x1d\xf5\x4f\xae\x06\x1f\xfb\xef\xfd\xa2\x81\xdd\x78\x92\x5f\x61\xbd\xd4\x51\x7e\x8a\xfb\xa7\xdd\xfe\xdc\x80\x0a\x0b\x82\x7b\xcb\x75\xbd\x06\x10\x29\xea\xa7\xfd\x05\xf6\x95\xf7\x61\xfb\xb2\xb2\x3c\x1e\x5c\xd8\x15\x33\xf0\xb7\xbe\x96\x50\x5f\x18\x5f\xb5\x36\x37\x76\x51\xc1\x55\x26\x69\x41\xdb\x4c\x97\x79\x4d\x3a\xad\x4b\x99\xff\xcb\x56\x0b\xda\x92\x86\x18\xff\x34\x31\x34\xb7\x51\x26\x3a\x05\xd2\x85\x9c\xfe\x03\xec\xb6\xbb\xda\x6a\x85\x79\x49\xb7\xf9\x4f\xb6\x9c\x5e\xee\x2e\xa7\x9d\xca\x74\xdb\x85\x89\xa8\xda\x1f\x4c\xf3\x1a\xe5\xcf\x89\xa6\x65\x6a\xf5\x95\x78\xa7\xf0\xaa\x4e\xd1\x36\x3a\xc0\x6f\xa0\x22\xc7\x80\xff\x89\x7c\xd7\xa9\x36\x46\x92\x7f\xc6\xa9\xe0\xa4\x81\xfd\x90\xd0\x55\xcc\x9d\xcc\x5b\x9a\x45\xcb\x64\x0d\x9d\xff\x64\x38\xc2\x8e\x39\x7f\xf9\x93\x64\x3c\x48\xbe\x51\xd8\x7e\x7e\x40\xf7\x47\x9b\x7c\xd9\xc9\x43\xbe\xcb\xfc\x6f\x3b\x7d\x7b\x66\x98\x66\xa6\x83\x3b\x72\x05\x5e\x53\xb2\xdb\xdf\x15\xc7\x2d\x95\xd1\x03\xc1\x62\x2b\xd8\xfe\xed\x75\x57\xf8\x47\x6e\xb1\x3b\x5b\xfb\x4e\x54\x94\xf0\x1b\x2c\x04\x4d\xb6\xc7\xa9\xff\x87\xc4\x2b\x7d\x2c\x27\x12\x0d\xdd\xd6\x0a\x99\xfd\x2d\xd7\x1d\xde\xf7\x24\x10\x3a\x12\x3f\x2f\xb9\x7e\x0b\x6e\x05\x53\xd9\x16\x6f\x54\x6b\x8a\xc0\x22\x3d\xd0\x3b\x76\x7e\x63\x34\x3f\x8f\xde\xb9\xf3\x88\x87\xe9\x81\xa0\xfa\xd0\x5d\x77\x3f\x66\x6b\x44\x6a\xb5\xcc\xa2\x72\x72\x4f\x34\xe8\xcd\x2a\x7c\x06\xb7\x4b\x16\x2c\x41\xd0\x55\x7c\x83\x97\xda\xf0\xca\x46\x90\xf1\x93\x93\xa4\x77\x58\xf3\x15\x8b\x54\x33\x6c\x2e\x69\x8e\x0d\xf9\xac\x18\x2b\xaf\xd7\x4f\x9f\xcc\x15\x8b\x9d\x4b\x8e\x78\x6a\xe5\x8f\x9b\xae\x57\xec\xae\xe2\xa2\xd7\xe3\xac\xe0\xe8\xf5\xd1\xd1\xce\x89\xb2\xd1\x83\x93\xeb\xc1\x04\xb5\x90\x49\x1d\xd6\xa3\x78\xb1\xc0\x2c\xe9\x76\x89\xed\x51\xe4\x1c\xbf\xa1\x70\xa5\x4b\xa5\x79\x89\x0a\x7f\xbf\xd3\x1f\xbf\x48\x57\x44\x5e\xc3\xd1\xeb\xd7\xdf\x18\xc3\x7e\xbe\xb4\x49\xaa\x96\xf2\xd2\xee\x7d\xbe\x3c\x20\x53\x36\x8e\xa0\x97\x76\xaf\x11\x10\x6f\x78\x6e\x2e\xed\xcd\x66\x63\xc3\xb7\xf7\x4a\x83\x17\x25\xe7\x6c\xa1\x4f\x4d\xbf\x34\xa2\x09\xca\x2b\xa2\x65\x09\x81\xb1\x55\x21\x8a\x73\x87\x81\xb5\x85\x95\x6f\xa4\x1d\xc1\x94\x67\xa9\xd0\xe7\x7b\xa0\x96\x44\x01\xa7\x34\x94\xd5\x3b\x11\xcf\x1e\xed\x77\xfa\x3e\x2d\x0e\x99\x3a\x0e\x42\xca\xd7\x60\xba\x3f\x59\x91\xc7\xa8\xec\xc0\x8f\x78\xeb\xab\xbc\xbd\xe4\x5b\x8b\xf1\x35\x73\x45\x24\x49\x22\xbc\x91\xc1\x94\xc9\x74\x70\x30\x9b\xd3\xa9\xef\x13\x5e\xe6\xd1\x0d\xbe\x07\x4f\x8b\x7b\x0c\x66\xfb\x31\x7f\xdd\xc6\xc5\x90\x6f\x49\x0f\x48\x46\x77\x76\xd7\x46\x84\x0f\xdd\x2d\xf5\x86\xba\xbb\x65\x36\xe2\xac\x4d\xdd\x46\x1d\xd4\x90\x01\xad\x19\x39\x88\xd3\x28\x0b\x43\xb3\x5c\xb3\xb5\x68\xaa\xe3\xd1\x36\xed\x9b\xb3\xfd\xbb\xb2\xaf\x82\xb0\x50\xf2\xe1\x5d\xf4\xe1\xe7\x24\xd8\xbf\xeb\xb9\x6e\xf7\xf8\x75\xe7\xa8\x73\xd4\xe9\xf6\x8e\x9f\xbf\xfe\xda\xbd\x39\x76\x57\x24\x58\x32\x4e\xe5\x37\x05\x52\x36\xaf\x9c\xa4\x14\xcf\xf7\x68\x05\xd9\xc5\x95\x90\x26\x07\x4e\xac\xaa\x9b\xc4\xce\xae\xf0\x72\xbb\x2b\x34\xeb\xe3\x84\x28\x72\xc2\xb6\x79\x4a\xd6\x71\x32\x1b\xa3\x1b\xd2\x1b\x57\x86\x41\xb7\x78\x80\x57\x73\x23\x36\x43\xbf\x0c\x43\x26\xaf\xad\xfd\x3b\x65\x45\xaa\x92\x44\x78\xb3\x38\xe5\x59\xb1\x87\xe7\x5b\x21\x51\x04\x30\x46\x12\xd5\xdb\x25\x60\x37\x79\x72\x65\x6f\xcf\x50\xef\x4c\x84\x5b\x92\xe5\xe6\xfa\x90\x14\x88\xda\x4a\xd3\x81\xa9\x58\x23\x7d\x15\x1b\x79\xf1\x54\x30\xa4\xb8\x80\x64\x67\x4b\xb1\xe6\x22\x55\x0f\xc1\x1f\x99\x86\x39\x06\x87\x60\x59\xf5\x48\xed\x6d\x5d\x63\xbf\x1a\xf7\x3a\xc9\x21\xd9\x35\x1f\x58\x22\xab\xde\x96\x0d\xdb\xaa\xcd\x2e\xab\xb4\xc1\x83\xea\x5e\x54\xc9\x2f\xcc\x85\xb4\x92\x5d\x0b\x3b\xd6\x15\xbf\xcf\xaa\x7a\xe5\xbe\xa8\xac\x53\xdc\xde\x28\x16\x3a\xb5\x88\xb8\x7d\xcb\x43\x9f\xa7\x2c\xe3\x15\x75\xdb\xc5\x3b\x1e\x6e\x07\x37\xa0\x1a\xe0\xbb\xc1\xa9\xff\xa6\x5d\x99\x68\xf6\xa2\xda\x11\x4c\x05\xa4\x74\x4d\xb6\x34\x17\x71\x99\xd6\x34\x1e\x21\x6d\x29\xf7\xb6\x1f\x9b\x10\x3d\x10\xbc\x84\x1e\x5b\xec\x98\x24\x34\x22\x2b\xda\xe2\xb5\xa9\x7a\x18\x5f\x9a\x99\xa4\xf3\x39\xbb\x7b\x93\x5d\xe3\x20\x49\xd2\xc9\xbb\xe5\xab\x52\x33\xc3\x6e\xef\x5e\x14\xd2\x2e\xa7\x9b\xa9\xde\x92\x71\xe2\xe1\xfc\xc6\x75\xdc\x48\x05\xe3\x1c\x31\xdf\x58\x27\xe0\x45\x07\xb3\x39\x93\x29\x6f\xf7\x10\xa7\x2a\x49\x55\x3d\x7f\xc9\xdc\xca\x72\x1c\xc7\x22\x09\xbb\xc8\xde\x75\xea\xc1\x4d\xd7\x32\xdb\x84\xec\x59\x4e\xbe\x65\xf4\xf4\x6c\xbc\xf3\x9e\xdd\x65\xa4\x0e\xa6\x2f\x31\x5e\xe3\x71\xd0\x29\x7b\x70\x69\xb7\xab\x2f\x22\x5d\xda\x86\x22\x66\xfd\xbd\xe2\x0c\xa6\x5d\x7a\x01\xa9\xd3\xce\xef\x0e\x75\xda\x5b\xa9\x2d\x00\x7c\x07\x47\xa3\x2c\x01\x5f\xda\x16\xb6\x91\xe8\x9d\xca\x18\xcb\x3e\x1b\xc6\x0c\x97\xbb\x53\x70\x14\x5f\xd0\xa9\x63\x73\x48\xb8\x62\xfc\xd2\x3e\x40\x2c\x15\x82\x72\xe5\xe4\x84\x76\x21\xae\x19\x0f\x7b\xe6\x84\xc1\x42\x22\x9a\xb1\x26\x74\x25\x6a\xa9\x2c\xb4\xa9\xaf\x77\x39\x65\xa5\x16\xaa\x6c\x7e\x23\xcb\xc8\x93\xdd\x4a\x74\xae\xe9\xba\x71\xc2\x07\xff\xa7\x4b\xdb\xc2\xc4\xb1\xc9\xff\x1f\x9b\x1a\x9a\x8b\xf1\x78\x52\x82\x97\xbc\xcd\x6b\x31\x27\xba\x08\xb1\xb2\x22\xa4\xda\xc6\x6f\x68\x6a\x5a\x95\x76\x90\x55\x6d\xb6\x98\x41\xd3\xbb\x28\x91\xc4\x76\xd0\xef\x79\x15\xa4\x16\xe0\xb4\xca\xb6\x0c\x04\x2a\x2a\x3d\x29\x6d\xcf\xb5\xa7\xa5\xaf\x45\xa7\x61\x8b\xbe\xb1\xb0\x29\x4d\xa9\x24\x9d\xe6\x5e\x3c\xbe\x2e\xb8\xc8\xf2\x6a\xdc\xf5\x66\xe9\xa2\x58\x14\xb3\x74\x21\x3b\x11\x49\x79\xb0\x4c\x48\xa8\x8f\x24\xd3\x59\xca\x55\xea\xfe\x3d\xbb\x93\xe7\xea\x33\x64\xf7\xef\xb3\x74\xe1\x76\x5f\xbd\x7e\xf5\xea\xf9\x4b\x4b\x2f\xe0\xe3\x30\xec\x06\xb4\xfb\xda\x39\x7a\xfd\x35\x75\x5e\x1c\x3d\x0f\x9c\xd9\xf3\x97\xc7\x0e\xe9\x7e\x7d\xdc\xa5\xf4\xf8\xe8\x35\xa5\x58\x42\xc8\xb5\x74\x67\xa9\x74\x6f\x56\xf8\x7f\x28\x18\xbe\xdc\xe8\x2e\x6f\xae\x52\xc5\x22\x37\xe5\x33\xc6\x43\x2b\xbf\x6c\xd0\x7d\xce\x2e\xff\xeb\xd8\x2f\xb9\xb9\xa0\x20\x82\x8e\xbe\x1d\xf5\x5f\x79\x57\x40\xb3\x69\x0f\xcc\xad\xa3\xe2\xfd\xb0\x6a\x32\x67\x1d\xe8\x0c\xfd\x0e\x07\x33\x57\xd4\xba\xb0\x62\x3c\xc5\xaa\x3c\x2e\x72\x72\xc3\x55\x11\x5f\xff\x6a\x0a\x99\xbc\x8a\x79\x66\xaa\x94\xd2\x2b\x27\x8c\x17\x98\xfe\x6a\x15\x47\x2b\xf8\xd2\x32\x38\x01\xd8\x72\x99\x2a\x3c\x0c\x07\x47\x40\x17\xfe\x62\x5b\xa5\xd4\xec\x5e\x12\xfa\x25\xb2\x5d\x0a\x65\x9c\x3c\xbe\xb5\x00\xe6\xcc\x9a\x33\xeb\xff\x0f\x00\xa9\x1c\x5b\xe1\x31\x3d\x00\x00")
 
 func kubernetesmastercustomscriptShBytes() ([]byte, error) {
 	return bindataRead(
@@ -987,7 +1008,7 @@ func kubernetesmastercustomscriptSh() (*asset, error) {
 	return a, nil
 }
 
-var _kubernetesmasterresourcesT = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x5b\x6d\x4f\x1b\xb9\xf6\x7f\xbd\xfd\x14\xd6\xe8\xaf\x7f\xca\x6a\x20\x25\xb0\xd2\x5e\xa4\xbb\x12\x05\xba\x44\x2d\x10\x35\x6c\xf7\x05\x8b\x56\x8e\xe7\x24\xb1\x98\xd8\xb3\xb6\x27\xc0\x46\xf9\xee\x57\x9e\xe7\xb1\x3d\xc9\x24\x84\xb6\x7b\x6f\x5e\xb4\x24\x3e\x3e\xb6\xcf\xe3\xef\xf8\x61\xb1\xa0\x63\x74\x70\x85\xa5\x02\x31\x10\x7c\x4c\x43\x38\xe8\xcb\x2b\xcc\xf0\x04\x82\x73\x2a\x1f\xe4\x72\x89\xde\x20\x84\xd0\x22\xf9\x17\x21\x0f\x47\xf4\x0b\x08\x49\x39\xf3\x4e\x90\x77\x37\xc7\x82\xe2\x51\x08\xf2\x6d\xa7\x6c\x19\x2a\x2e\xf0\x04\xaa\x7c\x3a\x7b\xf7\x9e\x9f\xf3\x08\x39\xc1\xca\xc1\x21\xff\xbd\x46\xcc\xf0\x0c\x4c\xc2\x59\x32\xe3\xd3\x39\xa6\x21\x1e\xd1\x90\xaa\xe7\x21\xa8\x5a\xaf\x48\xf0\x08\x84\xa2\x20\xbd\x93\xec\xb7\x72\x11\x39\x4d\x88\xd5\x98\x8b\xd9\x07\x1c\x87\xea\x9c\xcf\x30\x65\x67\x3c\x66\x4a\x8f\xd6\x2b\x58\x19\xc4\xbf\x45\x01\x56\x60\x50\x1f\x79\xfe\x9b\x1f\x7e\x28\x68\x67\xe9\xc2\x3d\x74\x82\x3c\x25\x62\xf0\x0a\x56\xcb\x62\x82\xea\x39\x4a\x96\x75\x45\x89\xe0\x92\x8f\xd5\xc1\x19\x9f\x45\xb1\x82\x2e\xae\x2f\x4b\xa6\xbd\x97\xfe\x9b\xc5\x02\x42\x09\xc8\xa5\xb2\x4c\xe2\xa7\x84\xe8\x29\x2d\x97\x9b\xeb\xec\x1c\xc6\x5a\x0c\xdf\x52\x4f\x68\xf1\x22\xf1\x6c\x6b\xa6\xb5\xf9\x04\x10\x01\x0b\xe4\x8d\xee\x76\x57\xa8\xcd\xbb\x23\x9c\x11\xac\xde\x76\xca\xf9\x5c\x83\x7a\xe4\xe2\xa1\x1b\xc5\xa3\x90\x92\xfe\xe0\x34\x08\x04\x48\x09\xb2\xdb\xf1\x91\x25\x83\x41\x9d\xea\x1a\xcf\xa0\xb3\xb7\x77\x9f\x5b\xc6\xfd\xae\x65\x5e\x37\x88\x74\xb8\x46\xb1\x97\xeb\xc4\x29\xfd\x6d\x26\xfd\x2a\xdf\xf9\x6c\x48\xff\x06\x79\x85\xa3\xce\x9e\x3d\xde\x97\x2b\xdd\xda\xd9\xbb\x3f\x90\xb5\x91\x35\xa7\x62\x95\xab\xd4\x9b\x4d\xb8\x5b\xef\x5e\x33\x7e\x16\x2c\x97\x6f\x92\x90\xc5\xb8\xb2\x7d\xe0\x2c\x96\x8a\xcf\xbe\x5c\x5f\xdc\xee\xca\xfe\x37\x37\x06\x96\xfe\x3f\x04\x12\x0b\xaa\x9e\x7f\x15\x3c\x8e\x4c\x83\x60\x72\x52\xaa\xbf\x58\x4e\x5f\xea\x99\xf7\x99\x82\x89\xc0\x0a\x82\x6c\x0d\xfa\xe3\xb7\x1a\x5a\xf0\x58\xc1\x6d\x32\x86\x31\x60\xd9\x52\x1d\x37\x15\xe7\xce\xcd\x6f\x4e\x85\x8a\x71\x98\xcd\xaa\xbd\xe1\xa5\x7e\x31\x8c\x30\x81\x5a\x4b\xd9\x36\x10\x30\xa6\x4f\x49\xc7\xbb\x7a\x6c\xae\x8d\xcf\x40\x9d\xd1\x40\x74\x4a\xe7\x4a\x56\x68\x87\x60\x84\x3c\x19\x8f\x98\x0e\x21\x75\x8e\x46\x9a\x70\xad\x32\xed\x68\xae\x6e\xf5\x1a\x5d\xab\x71\xf3\xb5\x79\xea\x69\x38\x4c\xcb\xc1\x1f\x21\x8f\x06\x26\x5b\x26\x27\xfd\x73\x43\x22\x89\x2c\x5a\xd9\x5f\xfa\xb1\x26\x54\x9a\x55\xdb\x69\x94\x3d\x1a\x67\x53\xb5\xca\xfc\x57\xd7\xdf\xf7\x2d\x42\x4a\xee\x19\x75\x93\xb4\x43\x0a\xfa\x2e\x72\x65\x11\x16\x5a\x78\x8b\xcc\x8c\xe0\x73\x1c\x66\xfe\x90\x22\xb9\x4b\x2c\x7f\xa7\x2c\xe0\x8f\xb2\x26\xc4\x06\x83\xc6\x61\xc8\x1f\xff\x14\x41\xe4\xf9\x68\x23\x0b\x26\x04\xa4\x6e\xf1\x4e\x35\x07\xb3\x77\x12\x38\x25\x11\x34\xca\xe5\x91\x90\xa1\xcf\xe7\x03\xa4\x04\x1e\x8f\x29\x41\x8a\xa3\x34\x6f\xb8\x3b\x2b\xca\x12\xa1\x9d\x9a\xbe\xf2\xe3\x6a\xfa\x01\x17\xea\x33\x66\x93\x64\x79\x47\x47\x3f\xff\x6b\x5f\xff\xe3\xea\x43\x05\x90\x7c\x7a\x7d\x36\xe2\x31\x0b\x1c\x64\x91\xa0\x5c\xcb\xd9\x3b\x41\x87\xef\x7a\xae\x76\xae\x38\xe1\xa1\xe6\x72\x4b\x2c\x39\x6a\x4d\xf1\x58\x10\x68\xb5\x8e\x94\xb4\xb6\x84\x1f\xbd\x66\x57\x28\xec\x37\xfb\xa1\xad\xbe\xa5\x9c\x6e\x18\xb0\x4c\x75\xb7\xd2\xf6\x70\x78\xe9\xd2\xf6\x86\xca\x6e\xab\xeb\x5e\x6f\xbf\x67\x42\xf6\x46\x35\xaf\xd4\xf2\xa1\xa3\xd9\x50\x72\x7b\x1d\xbf\x58\xc5\xe5\x97\x95\x3a\x7d\x88\x47\xf0\xa7\x0a\xe5\xd7\x50\xac\x1e\x6b\x1f\x47\x54\x82\x98\x83\x40\x6f\x55\x28\xf7\xbe\xa2\xa6\x8f\x8f\x8f\xf6\x8f\x8f\x8f\x76\xa2\xeb\x77\xdf\x91\xae\xb7\xca\x6c\x4e\xb8\x59\xc9\x6f\xab\x73\xfb\xb7\xcf\x79\x26\x32\xad\x90\x37\x2f\xba\x02\x74\x5f\x27\x95\xff\xb7\xd4\x80\x9f\x46\xad\x01\xc5\x08\x93\x07\x60\x41\x6e\xd7\x9c\x87\x5b\x80\xe2\x7c\xd4\xf7\x29\x33\xcd\x25\x9f\x80\xdb\xd2\x2b\x48\x7c\x2c\x38\x53\xc0\x82\xfe\xe0\x8c\xb3\x31\x9d\xc4\x22\x59\xe9\x0b\x66\x91\x73\xda\x0a\xa4\x1b\x0a\x5d\x09\x70\x05\xa4\xae\xde\x0f\x5a\x99\x46\xc7\xdf\xd4\x30\x6c\xc9\x99\xdf\xdc\x32\x0d\x39\x0e\xde\xe3\x10\x33\x42\xd9\xa4\x84\x8a\xeb\x84\xf9\xe9\xbd\xa6\xbd\xbc\xbd\x1d\x0c\x37\x13\x5a\x83\x0e\xdb\x56\x07\xa6\xe2\xdc\x35\x82\x15\x67\x6d\xd3\x5d\x39\x60\xe6\xc4\xae\x71\xcf\x3b\x7b\x3e\xea\x74\x1d\xbe\xe0\x74\x67\x87\xa1\xb7\x99\x6f\x35\xc5\x28\x57\x8a\xc9\xc5\xa8\x53\x87\x77\x82\x8e\x8f\x8f\x9a\xd6\xbc\x82\x02\x98\x9e\xeb\x87\x90\x63\x45\xd9\xa4\x3f\xf0\x4e\xd0\x18\x87\x12\x2c\x42\x1a\x84\x70\x4b\x67\xc0\x63\xd5\x67\x57\x94\xc5\x2a\x51\xee\x4f\x16\xa1\xb6\xa6\x73\x2a\x95\xa0\xa3\x38\x0f\x4e\x59\xf4\x74\xa5\x59\x3e\x5a\x5d\x16\xae\xd1\x43\xa7\x9b\xb0\x90\x5d\x45\xa2\xc4\x14\x07\xfa\xab\xb3\x68\x6c\xfa\xe6\x76\x8a\x94\x6d\xbb\xb0\x52\x1b\x7b\xc3\x00\xb2\x4e\xcb\x51\xb3\xee\x28\x53\x20\xe6\x38\xec\xb3\x21\x10\xce\x82\x04\xa2\xfd\xe4\xd8\x11\x88\x67\x23\x10\x37\xe3\x41\xbe\x24\xaf\xb7\x6b\x80\x51\x86\x10\x10\x06\xb0\x98\x58\x5b\x70\xc9\x76\x38\x3a\x7c\x9d\x34\xec\x3e\xaa\xb0\xf6\xfc\x90\xb9\x1f\x54\x6e\x7d\xa4\xdb\xe7\x4d\x74\x73\x06\xaa\x24\x7c\x9d\xbd\xb1\xd4\xc2\x35\x0e\x13\x0c\x87\xff\xcb\xe9\xb9\x94\xc1\xcb\xd2\xb4\xa0\x73\xac\xa0\x9a\xa7\x6b\x83\xe9\x5a\x45\x30\x50\x20\x4f\x07\xfd\x61\x52\xb0\xf4\x07\xce\xdd\xb5\x92\x53\x98\xab\xf3\x0a\xd4\x94\x27\xc1\x6a\xa8\xb0\xa2\xc4\x01\xf1\x93\xdd\xba\xb6\xf9\x4d\x5b\xd8\x30\xe9\xd1\xb0\xfb\xd5\xf4\x6d\xbb\xec\xde\xa4\x8c\x42\xf4\xdb\xa6\xf9\x1d\x25\xdc\x8a\x09\x7c\x9d\xc4\x6b\x26\xcd\x97\x64\xcd\x9d\x40\x1d\xdb\x09\x5a\x42\x9e\x16\x08\xa1\x75\x5a\x37\x13\xd5\xf7\x99\x4e\x5f\x92\x12\x9b\x53\xaf\x43\x6e\xdb\x88\x63\x07\xf9\xf4\x7b\xda\x88\x5e\x51\x93\xb4\x48\x55\x01\x93\x43\x50\xda\x7d\x4c\x45\x7a\x41\x72\x68\xae\x39\x7d\xc2\x23\x08\xdd\xe3\x7e\xf8\x2b\x60\xe9\xbe\x4a\xcd\x15\xaa\xa7\x36\x45\x49\xe5\x08\xd5\xe7\xcf\x0c\xcf\x28\x69\x73\xe2\xd8\x58\xa1\x6d\x7f\xa2\xec\xd2\x07\xe1\xd1\x73\x5d\x44\x24\xbf\x36\x70\x27\xe3\x91\x1d\x18\x13\x18\xa5\x23\xa2\xd5\x72\x33\x1e\x4b\x50\x49\xb4\xab\x88\x23\xd7\x61\x1e\x1c\x3f\x71\x1e\x5d\xf3\x00\x6c\x19\x34\x6d\x6c\x34\x80\xf0\x9d\xed\x4c\x34\x63\xfd\xd4\xac\x74\xf0\xef\xf8\xa8\x33\x1c\x5e\xee\xbb\x02\xfe\x97\x2b\x4d\x97\x5b\x85\x8f\xb4\x48\xfb\x2c\x80\x27\x9b\x65\x21\xa2\x0d\x60\x55\x16\x46\x7b\xbd\x8a\x50\xd7\x67\x82\x96\x39\x60\xf3\x42\x77\xe9\x18\x23\x9b\x62\xfd\xac\x50\x4e\xaf\xb1\xd2\x2d\xb2\xb3\x77\xd7\x46\x26\xf7\x35\xbb\x69\x08\x75\x1b\x87\xb1\x2e\x4d\x37\x5b\xaf\xb1\x4a\x61\xc8\x3f\xd3\x7d\x18\x25\x6d\x3d\xe7\xc5\xb5\x88\xdf\xba\x18\xf1\xad\x33\xd1\x16\xa5\xb3\xa1\x90\x6e\xea\x57\xeb\xdc\xaa\xa5\x57\xb5\xab\xfe\xf4\xc7\x6f\x55\xfc\xec\xb8\xdc\x6a\x12\x8e\x19\x43\x3a\x8c\x12\x1d\x6c\x76\x15\x4b\x68\xb4\x4d\x65\x44\x23\x92\xf4\x3a\xdc\x0c\x0e\x55\xfd\xef\xfd\xda\xda\xd0\x35\x83\x7c\xd6\xdf\x64\x53\xac\xb8\x78\xb0\xc2\x8a\xcc\x1e\x16\x0b\x13\xba\xb9\x57\xf8\xad\xcb\x90\xa6\x5b\x0d\x35\x14\x9d\xcd\xb4\xaa\xd5\xbe\x11\x53\x77\xac\xd1\xd7\x8e\x11\xb6\x10\xd6\x2d\x7e\x5d\x29\x9f\xa1\xd2\x8c\x2a\xd2\x54\x5b\xa5\xbd\x72\xb8\x19\x16\x3a\xb3\x28\x11\xdb\xf5\xdd\xf7\xbd\x1d\x90\xf8\xce\xca\x0b\x3b\x8b\x85\xc0\x6c\x02\xe8\xff\x24\xfc\x85\x4e\xfe\x8d\x42\xce\x23\xd4\xb3\x72\x56\x2e\xec\xb3\xca\xad\xd1\xf4\xd3\xe2\xe8\x39\x8f\x5d\x8b\x85\x1e\x65\xb9\xdc\x78\xeb\x26\x53\x80\xbb\xc2\x5e\xa9\x81\x1c\xe5\x7f\xfb\x1d\x19\x2d\xea\xfc\x62\x62\xdd\xcb\xef\x37\xbd\xdb\x97\x42\xce\xfe\xe0\x03\x17\x8f\x58\x04\x94\x4d\x32\xeb\xac\xdf\x7d\x6c\x87\x3b\xfc\x36\xf7\xc5\x5a\xdd\x14\x33\x57\xb6\xc1\xb9\x74\x12\x5b\xc7\x98\x38\x31\x61\x9b\xbb\xe7\x9b\x80\xc7\x95\x97\xce\x0d\xb8\xb5\x1d\x1a\xad\xcb\xe1\xeb\x21\xd3\xf9\x6c\xf3\x92\x6e\xed\x15\xd5\x52\x37\xce\xe4\xf6\x42\xb8\x54\xc5\x80\xf6\x54\x9a\xae\x72\x77\x1d\x09\xc8\xba\x3c\x5e\x40\xd0\x76\x97\xe0\x51\x03\x10\x75\x5f\x90\x6e\x04\xa3\x0a\x4f\xca\x97\x04\x55\x95\x0b\x48\x62\xd3\x30\x39\x02\x4e\x6e\xfc\x17\x0b\xc6\x44\x02\x9b\x50\x06\xaf\x51\xd4\x22\xe4\xe5\x07\xcf\x9a\xcf\x30\x1e\x8f\xe9\x53\x3a\x7e\xd5\x9d\x8b\xa6\xfa\xae\xb7\xc7\x05\x99\x82\x54\x02\x2b\x2e\xac\x5e\xd5\x46\xcd\x3c\x73\x88\x5b\x3c\xa9\x44\x86\xe5\x57\x02\xea\xbb\x02\xe8\x86\xb1\x35\x84\x42\xf7\x61\x7e\x93\xc1\xb6\xb5\xd7\x22\x96\x56\x34\x30\xc5\x22\x78\xc4\x02\x32\xfb\x35\xe7\x93\x5e\xb7\x77\xa3\xa0\xe2\xb2\xbd\x9b\x73\xe6\xe1\x0d\x8c\xed\xd8\x6c\x62\x4b\x7b\xf7\xb5\xcd\x45\x07\x8b\x6f\xc7\x6f\xa9\xe2\x8d\x62\x8b\x99\xa6\xab\xa9\xd8\x7d\xc9\x9b\xcb\x06\x49\xe0\x60\x46\xd9\x6f\x52\xc3\x7f\x7b\x17\x34\xce\x7e\x37\x4f\x8b\x3c\x92\xda\x82\x78\x6d\x43\x4e\x34\xb1\xf8\x15\xd4\xc7\xe2\x10\x2b\x0d\x78\x69\xbe\x3f\xc7\x0a\xa3\x83\x1a\x66\xf3\x42\xca\xe2\xa7\xd5\x07\x12\x5e\x40\xa5\x1e\x7a\x80\xa5\x7c\xe4\x22\x38\x8d\xd5\x14\x98\xa2\xa5\x07\x27\x0f\x96\x0c\x28\x27\xe5\xb4\xf9\x96\xcc\x47\x78\xde\xa0\x3e\x79\x80\x67\x3d\x75\xc7\x66\xd6\x20\xe7\xa6\xdb\x5d\x87\x74\xe9\x98\x58\x4d\x1d\x9d\x3f\xc2\xf3\x00\xab\xa9\x03\xc5\x39\x8a\x8f\x96\xb8\x4e\xe7\x98\x4f\x5a\xa4\x79\x8a\xb9\xc4\x72\x08\x44\x80\x92\x8d\x58\xd9\x93\x29\x81\x15\x0b\x2b\x7c\x32\x1e\xc6\x5c\x4d\xfc\x58\x7b\xa7\x90\x26\xab\x06\x3b\x0e\xb0\xc2\x09\xde\x59\xef\xc9\x49\xba\x82\x9b\xe2\x4a\xe7\xc5\x2c\x52\xcf\xa6\xc4\x7c\x6d\x24\x0f\x3a\xc4\xfc\xfa\x5e\x13\x1d\xf6\x7e\xb6\x49\xc2\x58\x33\x78\x67\x09\xac\x75\x52\xce\x19\xbd\x8a\x1f\xf9\x9d\x7d\x50\x24\xd0\xeb\x70\x98\x84\xef\xcd\xa7\x81\xbb\x48\x88\x05\xad\x4e\x46\xc0\x18\x04\x30\x02\x6f\x6d\x14\xd3\xf0\x62\xc9\x05\x62\x5c\x60\x63\xcf\x5f\xfd\x28\x6d\xef\x20\x2b\x91\x2e\x58\x10\x71\xca\x94\x3c\x18\x85\x7c\xe4\x77\xe6\xd3\xa0\x15\x66\xdb\x50\x4e\x07\xf3\x69\xb0\xa6\x08\x5a\xf7\x3c\xa3\x56\xd5\x7b\x74\x86\x27\xf0\x39\x17\xa0\x1d\x89\xf8\x78\x0c\xc2\xf4\x13\x2e\xfb\xba\xdb\x8d\x6e\x73\x5d\x07\xd0\x31\x42\x4e\x1b\xfb\x0d\xf2\x76\x47\x5f\xf9\x10\x37\xf4\x1a\x3e\xc4\x0e\xfa\xb9\xbb\x40\xc8\xfa\x64\xea\x32\x6f\x4f\xd4\x24\xc0\xa5\x76\x4b\x7b\xe5\x04\x93\x69\x5a\xde\x79\x9f\x01\x07\xbf\x0b\xaa\xa0\x2e\x77\xdf\xf2\xd4\x0f\x82\xcf\x92\x81\x37\x46\xbf\xe8\x55\xdd\x8c\x4b\xa7\x93\x35\xb9\xd8\x3f\xc8\xc1\x76\xe4\x5f\xa9\x80\x9c\xde\xb5\x34\x5e\x35\x82\xa9\xd5\x9b\xe1\x79\x11\x89\xd1\x3b\x4b\xa7\xb5\x30\xbd\x58\xac\xe8\xec\xd8\x9f\x40\xf5\x4d\xd5\x4a\xe2\x69\x51\xe8\xe7\x80\x38\x7b\x5a\x75\x95\x18\xf4\x3f\xf6\xe8\x67\x37\x05\x76\x83\x4c\x5a\x95\xd7\x9b\x95\xd5\x5b\x9e\x91\xb4\x56\x63\x17\x9e\x14\x30\x99\x1c\x63\xbc\x6e\xe1\xe6\x77\xba\x44\xc2\x0e\x8f\x61\x6a\x09\xa2\x5c\xe8\xe9\xdf\xb1\x80\x83\x0b\x7b\x59\x15\xb1\xa4\xb8\x7a\x98\xbc\x78\x31\xdb\x2f\x31\x0b\x42\x10\x15\x33\xee\x1d\xbc\xab\x12\xe1\x58\xf1\xdf\xa2\x89\xc0\x01\x5c\x51\xc6\x2b\x94\xf5\xfd\x65\x4f\x56\x2e\x47\x2c\x8d\xd3\x58\x20\x0a\x82\xa6\xdb\x13\x84\xcf\x66\x98\x05\xb7\xfc\xe2\x09\x48\xac\x6a\xba\xe8\x74\x63\x29\xba\x23\xca\xba\x8c\x4f\xe3\x08\x25\x7f\x8e\xb0\x9c\xa2\x7d\x82\xfe\xf0\xca\xaf\x5d\x1e\xa9\x2e\xd6\xc2\xe8\x12\xce\x14\xa6\x0c\x84\xec\x46\x82\xcf\xa9\x9e\xee\x81\x9c\xa2\x5a\xe0\x53\xc0\x30\x53\xe9\x91\x41\xbd\x45\xc6\xa3\xe2\x71\x50\x3f\xb0\xdb\xf3\x62\x31\xd9\x77\xb4\x9b\x4b\x03\x75\x30\x2e\x9e\xd6\x9a\x6d\xc5\x1b\x49\xb3\xc1\xf5\xea\xd8\x9a\x91\xf1\xd8\xc4\x6c\xcf\xb2\x41\x56\xc0\x67\xf5\xbb\x9b\x54\x82\x98\x53\x02\x03\x41\x19\xa1\x11\x0e\xcf\x42\x0a\x4c\xb9\xc4\xe0\xa6\x4c\x2b\x00\x9b\x9a\x24\xad\xd9\x21\xc7\x47\x78\xb6\x29\x14\x16\x13\x50\x17\x6c\x4e\x05\x67\x33\x60\x0e\x26\x79\xf9\xcf\x43\x4a\x1c\x1c\x70\x44\xd3\x9b\x90\xab\x86\x21\xf8\x4c\x7b\xd7\x58\xd7\x85\x8e\xf5\x13\xbc\xaa\xb3\x7d\x91\xc7\xa4\x78\x88\x47\x90\xd6\xa9\x2b\x87\x29\xc9\x56\x0d\x57\x56\xea\x7e\x07\xfd\xf2\x0b\xea\xce\xb1\xe8\x86\x7c\x92\xdb\x79\x18\xeb\xe9\xec\x97\x46\x1e\xf2\x09\xea\xfd\xf2\xff\x87\x7f\x78\xc6\x46\x77\xf5\xff\xe5\x9b\xff\x04\x00\x00\xff\xff\x99\xe9\x38\xdc\x1d\x45\x00\x00")
+var _kubernetesmasterresourcesT = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x5b\x6d\x6f\xdb\x38\xf2\x7f\xbd\xf9\x14\x84\xf0\xc7\x5f\xcd\x42\x89\xdb\xb4\x77\xb8\x0b\x70\x0b\xa4\x49\xba\x35\x9a\x07\x5f\x9c\xed\xbe\xe8\x06\x0b\x5a\x1a\xdb\x44\x64\x52\x4b\x52\x6e\xb3\x86\xbf\xfb\x81\x12\xf5\x40\x8a\x92\x65\x27\x69\xaf\x7b\x49\x10\xd8\xe2\x70\xc8\x99\xf9\xcd\x03\x29\x72\xb5\x22\x53\x74\x78\x89\x85\x04\x3e\xe2\x6c\x4a\x62\x38\x1c\x8a\x4b\x4c\xf1\x0c\xa2\x33\x22\xee\xc5\x7a\x8d\xf6\x10\x42\x68\x95\xfd\x47\xc8\xc3\x09\xf9\x08\x5c\x10\x46\xbd\x63\xe4\x7d\x5a\x62\x4e\xf0\x24\x06\xf1\xc2\xaf\x5a\xc6\x92\x71\x3c\x83\x3a\x1f\x7f\xff\xce\x0b\x0a\x1e\x31\x0b\xb1\x74\x70\x28\x9e\x1b\xc4\x14\x2f\xc0\x26\x5c\x64\x33\x3e\x59\x62\x12\xe3\x09\x89\x89\x7c\x18\x83\x34\x7a\x25\x9c\x25\xc0\x25\x01\xe1\x1d\xeb\x67\x95\x10\x05\x4d\x8c\xe5\x94\xf1\xc5\x3b\x9c\xc6\xf2\x8c\x2d\x30\xa1\xa7\x2c\xa5\x52\x8d\x76\xe4\x05\x6e\xe2\x5f\x92\x08\x4b\xb0\xa8\x5f\x7b\xc1\xde\x0f\x3f\x94\xb4\x8b\x5c\x70\x0f\x1d\x23\x4f\xf2\x14\xbc\x92\xd5\xba\x9c\xa0\x7c\x48\x32\xb1\x2e\x49\xc8\x99\x60\x53\x79\x78\xca\x16\x49\x2a\x61\x80\x4d\xb1\x44\xde\x7b\x1d\xec\xad\x56\x10\x0b\x40\x2e\x93\x69\x8d\x9f\x84\xa1\x12\x60\xbd\xde\xde\x66\x67\x30\x55\x6a\xf8\x96\x76\x42\xab\x47\xa9\x67\x57\x98\x1a\xf3\x89\x20\x01\x1a\x89\x6b\xd5\xed\x93\x7e\x88\x90\xf7\x29\x64\x34\xc4\xf2\x85\x5f\xcd\xe7\x0a\xe4\x67\xc6\xef\x07\x49\x3a\x89\x49\x38\x1c\x9d\x44\x11\x07\x21\x40\x0c\xfc\x00\x35\x74\x30\x32\xa9\xae\xf0\x02\xfc\xfd\xfd\xbb\x02\x19\x77\x4f\xad\x73\x13\x10\xf9\x70\xad\x6a\xd7\x4f\x95\xda\x72\xfa\xdb\x87\xa4\xc1\x77\xb9\x18\x93\x3f\x41\x5c\xe2\xc4\xdf\x6f\x8e\xf7\xf1\x52\xb5\xfa\xfb\x77\x87\xc2\x18\x59\x71\x2a\xa5\xec\x32\xaf\x9e\xf0\xc0\xec\x6e\x80\x9f\x46\xeb\xf5\x5e\x16\xb2\x28\x93\x4d\x1f\x38\x4d\x85\x64\x8b\x8f\x57\xe7\xb7\x4f\x85\xff\xed\xc1\x40\x73\x50\x8c\x21\x4c\x39\x91\x0f\x3f\x73\x96\x26\x36\x20\xa8\x98\x55\xe6\x2f\xc5\x19\x0a\x35\xf3\x21\x95\x30\xe3\x58\x42\xa4\x65\x50\xbf\x41\xaf\xa1\x39\x4b\x25\xdc\x66\xc6\xb2\x06\xac\x5a\xea\xe3\x02\xad\xc6\x78\x42\xf8\x2d\x09\x97\x29\x8e\xf5\xac\xfa\x03\x2f\xf7\x8b\x71\x82\x43\x30\x5a\xaa\xb6\x11\x87\x29\xf9\x02\xc2\x30\x86\xfa\x33\xc7\xa7\x20\x4f\x49\xc4\xfd\xca\xb9\xd4\xdf\x5d\xf9\xb9\x04\x21\x42\x9e\x48\x27\x14\xa4\xcd\xb1\x3e\x78\x8b\x94\x79\x47\x5b\xba\x6e\x19\x5d\xd2\xb8\xf9\x36\x79\xaa\x69\x38\xa0\xe5\xe0\x8f\x90\x47\x22\x9b\x2d\x15\xb3\xe1\x99\xa5\x11\xf5\xb7\xee\x85\x3f\x1b\x85\x7a\x98\x0a\x56\x7d\xa7\x51\xf5\x68\x9d\x4d\x1d\x95\xc5\x53\xd7\xe7\xbb\x3d\xcb\x9a\x8e\x90\x52\x78\x86\x09\xc9\x66\x48\x79\x92\x58\xf1\x68\xc7\x29\xc3\x42\x0f\x6f\x11\x1a\x04\x37\x69\xac\xfd\x21\xb3\xe3\xe1\x7b\x2c\x7e\x25\x34\x62\x9f\x85\xa1\xc4\x16\x40\xe3\x38\x66\x9f\x7f\xe7\x51\xe2\x05\x68\x2b\x04\x87\x21\x08\xd5\xe2\x9d\x28\x0e\x76\xef\x2c\x8b\x8a\x90\x93\xa4\xd0\x47\x46\x86\x6e\xce\x46\x48\x72\x3c\x9d\x92\x10\x49\x86\xf2\xbc\xe1\xee\x2c\x09\xcd\x92\xdd\x89\xed\x2b\x3f\x76\xd3\x8f\x18\x97\x37\x98\xce\x32\xf1\x5e\xbf\xfe\xc7\x3f\x0f\xd4\x3f\x57\x1f\xc2\x21\x2c\xa6\x37\xa4\x13\x96\xd2\xc8\x41\x96\x70\xc2\x94\xb3\x79\xc7\xe8\xd5\xcb\x23\x57\x3b\x93\x2c\x64\xb1\xe2\x72\x1b\x36\xf4\xa8\x2c\xc5\x52\x1e\x42\x2f\x39\x72\x52\x43\x84\x1f\x4d\x17\xa9\xdb\xb4\xc2\xaf\x7e\xd0\xd7\xde\x42\xcc\xbd\xc0\x24\xd8\xd2\xdc\xbd\xac\x3d\x1e\xbf\x77\x59\xbb\xc3\x78\x2e\x25\xf5\xb5\xf5\xd1\xd1\xc1\x91\x5d\xb2\xb7\x9a\xb9\xd3\xca\xaf\x82\x8d\x46\xee\x6f\xe3\x47\x9b\xb8\xa7\x4d\xef\xd3\x09\xfc\x2e\x63\xf1\x35\x0c\xab\xc6\x3a\xc0\x09\x11\xc0\x97\xc0\xd1\x0b\x19\x8b\xfd\xaf\x68\xe9\xd5\xea\x67\x90\x1f\xd2\x09\x70\x0a\x12\xc4\xc9\x68\x38\xce\x26\xa2\x88\xd6\xeb\x83\xee\xe6\x27\x41\xc8\xcb\xff\x22\x84\x64\xe2\x9e\x44\x11\x51\x7a\xc2\x71\x51\x1c\x64\x79\x61\xbd\x5b\xb6\x74\x96\xb0\xb5\x9c\xd9\x5d\x2f\x7c\xfb\x3c\x5a\x15\x19\x8d\x74\xda\x2e\x74\xd5\xe9\x99\xca\x83\xbf\xca\xba\xf2\x62\xd2\xbb\x48\x99\xe0\xf0\x1e\x68\xa4\x67\x36\x62\x2c\xde\xa1\xd0\x2e\x46\x7d\x9b\x33\x53\x5c\x8a\x09\xec\xb9\x7c\xa2\x14\x18\x21\x6f\xca\x19\x95\x40\xa3\xe1\xe8\x94\xd1\x29\x99\xa5\x3c\x93\xf4\x11\xb3\x28\x38\xd9\x3a\xe8\xd6\x44\xd1\x6a\x9a\xaa\xb3\x68\xe6\x90\x07\x82\x61\xd4\x0b\x1a\x7e\xb0\x2d\x30\x9a\x9a\xb3\xbf\xb9\x75\x1a\x33\x1c\xbd\xc5\x31\xa6\x21\xa1\xb3\xaa\xfc\x2c\xda\xdb\x94\x79\xf1\x56\xd1\xbe\xbf\xbd\x1d\x8d\xb7\x53\x5a\x8b\x0d\x3b\x95\xd7\x61\x38\xf7\xba\xc3\x9c\x91\x13\xba\x9d\x03\x6a\x27\x76\x8d\x7b\xe6\xef\x07\xc8\x1f\x38\x7c\xc1\xe9\xce\x0e\xa0\xf7\x99\x6f\x3d\x01\x49\x57\x02\x2a\xd4\xa8\x12\x8b\x12\xa5\x33\x4b\xb6\xa9\x63\xb7\xce\x40\x95\x84\xef\x62\x86\x25\xa1\xb3\xe1\xc8\x3b\x46\x53\x1c\x0b\x68\x10\x92\x28\x86\x5b\xb2\x00\x96\xca\x21\xbd\x24\x34\x95\x19\x24\xfe\xd6\x20\x54\x18\x3c\x23\x42\x72\x32\x49\x8b\x90\xa6\x63\x6e\x53\xf2\x84\xb3\x09\x3c\xc6\x7a\xfe\x20\x63\x21\x06\x32\x4c\x32\x00\x8f\xd4\x57\x17\x8c\xf6\xda\xbe\xb9\x5d\x29\x67\xdb\x2f\x18\x19\x63\x6f\xe7\x41\x1b\xb1\x91\xec\x64\x56\x42\x25\xf0\x25\x8e\x87\x74\x0c\x21\xa3\x91\x32\xd5\x6a\x75\x78\xcd\xc3\x39\x08\xc9\xb1\x64\xe5\xd6\x5c\xc5\x34\xf7\x61\xbd\x73\x77\xf1\x76\xa4\x34\x30\xb4\x19\x39\x06\xa3\xe9\x62\x02\xfc\x7a\x3a\x2a\x54\xb6\xd3\x48\x57\x06\x17\x9d\xd9\x9b\x36\x32\xec\xa5\x3f\xf5\x29\x9c\xaa\xd0\x08\xbc\x5e\x45\x90\x29\x9a\x35\xb6\x2b\xb3\x57\x07\xe8\xd5\xf3\x94\x17\xee\xd7\x3a\x8d\xfd\xd1\xc6\xde\x59\xb5\x4d\x94\xbf\x6a\x68\xa3\x5b\x52\x90\x15\x61\x59\x24\x3d\x43\xb9\x91\x81\x83\xe2\xf8\x7f\xb9\xec\xa8\x74\x50\x70\xb4\x75\xd1\xad\x91\xb2\x95\x2c\xb1\x84\xb2\x22\xb0\x07\xbb\x6f\xba\xfe\x70\xd4\x1c\xc5\xe0\x14\x17\xe6\xbc\x04\x39\x67\x59\x38\x1d\x4b\x2c\x49\xd8\xec\x94\xef\x6c\x76\x06\xe2\xda\x64\x14\xc2\xc6\xe9\xa4\xc2\x59\x41\x6b\x2b\xde\xfe\xe6\x36\xc9\xa6\xaa\xa5\xcd\x18\xa5\xea\x77\x2d\x5f\x9a\x60\xdc\x29\x15\xd5\x20\xf0\x75\x0a\x0a\x33\xe3\xbf\x79\xf3\xe6\xf5\xee\x79\xbd\xc5\x1f\x3a\x15\xd1\xc3\x09\xdc\xc0\x68\x1d\x7d\xb4\x5b\x96\xeb\x5b\x93\xd8\x59\x76\x4b\x80\x7e\xa5\x5a\xe0\x2f\x90\xb4\xdb\xca\x17\x27\x46\x77\xb1\x8a\xfe\xf4\x98\x8c\xff\x84\xfb\x06\x45\x80\xb5\x7b\x15\xcf\x0d\xe2\x02\x21\x9f\xfa\xad\x06\x6b\x3d\x5b\x60\xe3\x45\x54\x8c\x41\xaa\xc2\xdd\xc6\x93\x17\x65\x47\x20\x54\x48\xb9\xc0\x13\x88\xdd\xe3\xbe\xfb\x23\xa2\xf9\x36\x9f\xe1\xac\x35\xb0\x54\xcb\x62\x47\x32\x39\x7b\xa0\x78\x41\x42\x6f\xcf\xea\xd6\x61\x93\xc6\xda\xb8\xb4\xcb\x93\xd8\x23\x64\xc9\x83\xa9\xa2\xb0\x38\x04\xf2\x49\xa4\x93\x66\xe8\xce\x0a\x3d\x15\xb3\x1b\x2d\xd7\xd3\xa9\x50\xef\xfa\x6a\xec\x6b\x36\x2c\xc2\xf7\x05\x63\xc9\x15\x8b\xa0\xa9\x83\xb6\x2d\xa5\xc6\x40\x17\x13\x23\x56\x3e\xb6\x48\x6b\x5f\x2f\x29\x30\x28\x51\x7d\x95\x8a\xfc\xf1\xf8\xfd\x81\x2b\x25\x7d\xbc\x54\x74\x05\x2a\x02\xa4\x54\x3a\xa4\x11\x7c\x79\xd1\xae\xa2\x3e\x58\x35\x73\xd6\xd1\x51\xb0\xb7\x45\xae\xea\x99\xa5\x5a\xf3\x53\x6b\x5e\x5a\x3b\xc6\xd0\x53\x34\xd8\x08\x31\xbf\xc2\x52\xb5\x08\x7f\xff\x53\x1f\x9d\xdc\x55\x3a\x69\x0f\x75\x7d\x5c\xc6\x08\x63\x03\x92\x6f\x82\x5f\x61\xa9\x6a\x9e\xef\xd5\x7d\x28\x09\xfb\x7a\xce\xa3\x57\x4b\xc5\xc9\xac\xcd\xcb\x25\x33\x39\x18\x3b\xc0\x2e\x44\xa9\x5a\xcf\xb7\x0d\x32\xc8\xfd\x6a\x93\x5b\xf5\xf4\xaa\x7e\xeb\x53\xf5\x1b\x74\x56\x65\x45\x46\xb1\x04\x7c\xae\x58\x63\xc7\x10\x9f\x92\x50\x05\x9b\x9e\x52\x6f\x8c\x25\x24\x31\xa2\x40\xcf\xca\x8c\x24\x61\xd6\xeb\x55\x0d\x91\x5d\xc3\xe8\xd6\xba\xff\xe9\x6a\xbd\x63\xf5\xea\x9a\x81\x19\x9c\xbe\xf2\x76\x64\x79\x8c\xa4\x03\x45\x05\x65\xf1\xd3\x60\x11\xf4\x92\x70\xa3\x88\xcf\xbc\x50\x6a\x3b\xa3\x52\x03\xba\x63\xcd\xa9\x96\xf0\x66\x4c\x7d\x62\x8b\x3e\x77\x8c\x28\xa6\x53\xfc\x6c\x16\x7e\xd3\x66\x83\xae\x4a\x35\x55\xa2\xe0\xbe\x53\xda\xab\x86\x5b\x60\xae\x32\x8b\x3a\x6e\xfb\x9d\x6d\x58\x64\xbe\xd3\x79\xfc\x6a\xb5\xe2\xea\xa0\x03\xfa\x3f\x01\x7f\xa0\xe3\x7f\xa1\x98\xb1\x04\x1d\xd9\xce\x56\x2a\xfb\xb4\x76\x06\xb8\xe9\x5d\x1b\x62\xd7\x6a\xa5\x46\x59\xaf\xb7\x0b\x61\x95\x01\xdc\x7b\x00\x9d\x16\x28\xaa\xfc\x6f\x67\x82\xe2\x13\x42\x85\x77\xdb\x5e\x7e\xd7\xeb\xa4\x5c\xa3\xe4\x1c\x8e\xde\x31\xfe\x19\xf3\x88\xd0\x99\x46\x67\xc9\x7a\x8b\xba\x23\xe8\x73\xfa\xcf\xa1\x92\x6a\x43\xb7\x2d\x7e\xf5\xa9\x0f\xf5\xd8\x4a\x62\x3e\xc5\xa1\xb3\x26\xec\x73\x93\x60\x9b\xe2\xb1\xf3\x0a\x81\x55\x6e\xed\x56\x8d\x9a\x7a\xf8\x7a\x95\xe9\x72\xb1\xfd\x92\xae\xfd\x94\x40\xc3\x36\xce\xe4\xf6\xc8\x72\xa9\x9c\x49\xe0\x9a\x4a\xdb\xc1\xfc\x81\x1f\x6c\xbe\x0a\x50\x96\xa0\x0d\xec\x8c\x8d\x83\xe0\x1b\x0a\x51\x93\x78\x63\x31\x2a\xf1\xac\xba\x17\x52\x37\x39\x87\x2c\x36\x8d\xb3\x97\xef\xd9\xfd\x8d\x52\x60\x1c\x0a\xa0\x33\x42\xe1\x39\x16\xb5\xea\x38\xad\x7e\xe5\xaf\x26\x3f\x4e\xa7\xea\x88\x10\xb2\xd0\x4c\xcb\xa6\x0a\xc6\xea\xd7\x63\xb5\x6d\xb5\x46\xaf\x7a\xa3\x62\xae\x1d\xe2\x16\xcf\x2c\x2e\x38\x21\x0b\x16\x41\xfc\x1e\x8b\x79\x83\x4b\xbd\xd1\xea\x37\x03\x0a\xd9\x19\xa0\x13\xd9\xe8\x56\x6b\xab\xc5\xa1\x0a\xf1\x45\x36\xb0\x1d\xb7\x78\x5e\x1f\xaa\x74\xa1\xc2\x26\x4f\x6d\x85\xb6\x24\xe7\x59\xd0\x6e\x09\xbc\xee\x43\x1b\x6d\xee\xd1\xd7\x3b\x10\xb2\x74\x86\x90\x37\xc7\x3c\xfa\x8c\x39\x68\x6f\xb1\xe7\x93\x5f\xd5\xb0\x55\x6a\x5d\xd4\x70\x73\xd6\xf1\xa4\x85\x71\x23\xda\x34\x2a\xd9\x3a\xf9\x66\xdd\xb4\x46\x31\x3f\xe8\x69\xe2\xad\x22\x59\x5d\x68\x3b\xf1\xdf\x39\xd5\xc1\x44\x8b\x26\x70\xb4\x20\xf4\x17\x01\xbc\xc4\x64\x6d\xdc\x54\x3f\x37\xfd\x44\xc5\x97\x1c\x0b\xfc\xb9\x81\xac\xfe\xac\x37\x1d\x79\x78\xcd\xab\x8b\x33\x2c\x31\x3a\xac\x85\x54\xb5\x5c\x21\x34\xfd\xd2\xb5\xf5\xa5\x76\x1c\x89\x50\x43\x8f\xb0\x10\x9f\x19\x8f\x4e\x52\x39\x07\x2a\x49\xe5\xc1\xaa\xfa\x36\x26\xa1\x8a\x38\x31\x6f\x3f\x0d\xf5\x01\x1e\xb6\x58\x0d\xdd\xc3\x83\x9a\xba\xad\x6e\x21\xe6\xa3\x82\x9b\x6a\xb7\xd5\x5e\xfc\x78\x09\x96\x73\x47\xe7\x0f\xf0\x30\xc2\x72\x6e\xf8\x84\x0b\x22\x26\x4c\xec\xd6\xfa\xe7\x3c\xa3\x5d\x28\x95\x6a\xfc\xa8\xa3\xf9\x63\x08\x39\x48\xf3\x68\x7e\x7d\x9e\x9e\xc8\x09\xec\x29\xc6\x35\x3e\x9a\x87\x35\x57\x33\xcf\x99\x10\xd6\x17\xaa\x74\x7f\xcb\x14\x5e\x84\x25\xce\xaa\xab\xcd\x9e\x9c\x25\x47\xb8\x2e\x8f\x03\x9f\x2f\x12\xf9\x60\x6b\x2c\x50\x20\xb9\x57\x21\xe6\xe7\xb7\xe5\x71\xdd\x73\x19\x66\x35\x60\xfe\x78\xbd\x6e\x76\x8a\x53\xc5\xf2\x65\xed\xf9\x96\x45\x41\xc1\xe8\x59\x3c\x2b\xf0\x0f\x40\x86\x91\x92\xcc\x01\x92\xc0\x5b\xce\x23\x07\xc4\x11\xf2\x52\x4e\xea\x93\xe1\x30\x05\x0e\x34\x84\x17\xfa\x41\x2d\x14\xb6\xdc\x7f\x73\x15\x51\xa6\x12\xf4\x5e\x45\xe0\xac\x7a\x35\xa9\xbf\xbf\x7f\xa8\x97\x68\xe7\x34\x4a\x18\xa1\x52\x1c\x4e\x62\x36\x09\xfc\xe5\x3c\x72\x6f\x88\x58\x8a\xda\x52\x4f\x87\xcb\x79\xe4\xd0\xd5\xba\x03\xb4\x76\xbb\xb1\xab\xe0\x91\x05\x9e\xc1\x4d\xa1\xc0\x86\xba\x3d\x36\x9d\x02\xb7\x3d\x87\x89\xa1\xea\x76\xad\xda\x9a\x51\x21\x7f\xf5\x24\xe6\xad\xfd\x46\x45\xbb\xa3\xaf\xb8\x4f\x5b\x7a\x8d\xef\x53\x07\xfd\xd2\xbd\x40\xd1\x7d\xb4\xb9\x2c\x8d\xd5\xdc\x58\x15\x79\x42\xf9\x50\x53\xf2\x10\x87\xf3\x7c\x79\xe9\xdd\x00\x8e\x7e\xe5\x44\x96\x4b\x8b\x02\xa1\xb6\xef\xbe\xe3\x6c\x91\x0d\xbc\x75\xf5\xfd\xbc\x6e\xc6\x84\xd3\xc9\xda\x5c\xec\x3b\x72\xb0\x4d\x1a\xda\x4a\x41\x4e\xef\xaa\x96\xf6\x99\x49\x29\xd8\x56\xbd\x1e\x57\x41\x18\xbd\x6c\xd8\xd4\x08\xdc\xab\x55\x47\x67\xc7\xfe\x88\xb5\xa9\xbb\xde\xb3\x3f\x75\x6d\x34\x14\x25\xb2\xbe\xa8\x77\x99\x01\xfa\xbb\x7d\xf5\xf4\x34\x0b\xfc\x16\x9d\xf4\x5a\xde\xf7\xc1\x52\x85\x9e\xbb\xdd\x16\x63\xbd\xcd\x38\x80\x2f\x12\xa8\x32\x4b\x75\x3f\xe9\xb9\x02\xc8\x20\x14\xd0\x7f\x5f\x63\xe3\xba\xcf\x48\x10\x95\xa0\x27\x7f\xa6\x1c\x0e\xcf\x9b\x62\xd5\xd4\x92\x57\xda\xe3\xec\xfe\x94\xdd\xfe\x1e\xd3\x28\x06\x5e\x83\xf1\xd1\xe1\xcb\x3a\x11\x4e\x25\xfb\x25\x99\x71\x1c\xc1\x25\xa1\xac\x46\x69\xee\x6f\x7b\xa2\x76\x38\x63\x6d\xbd\x0d\x86\x50\x42\xd4\x76\x7a\x23\x64\x8b\x05\xa6\xd1\x2d\x3b\xff\x02\x61\x2a\x0d\x5b\xf8\x83\x54\xf0\xc1\x84\xd0\x01\x65\xf3\x34\x41\xd9\xc7\x09\x16\x73\x74\x10\xa2\xdf\xbc\xea\xeb\x80\x25\x72\x80\x95\x32\x06\x21\xa3\x12\x13\xaa\x5e\x20\x27\x9c\x2d\x89\x9a\xee\xa1\x98\x23\x23\xf0\x49\xa0\x98\x66\xbb\xb3\x81\x6f\xb6\x88\x74\x52\x5e\x35\x1b\x46\xcd\xf6\x62\xf9\x98\xed\x7b\x36\x9b\x2b\x80\xda\x2d\xf5\x8b\xda\x76\x5b\x79\xe3\xd6\x6e\xd0\x00\xd6\xab\x53\x37\x8d\x7d\xcd\xc8\x6e\xd7\xd9\x40\x2f\xe9\xf5\x8a\xde\x4d\xaa\x6e\xd2\x91\x10\x46\x9c\xd0\x90\x24\x38\x3e\x8d\x09\x50\x39\x8c\xfa\x52\xe6\x6b\x82\x26\x75\x98\xf1\x19\xe5\x5b\xef\x1f\xe0\xa1\x49\x21\x31\x9f\x81\x3c\xa7\x4b\xc2\x19\x5d\x00\x95\x4d\x12\xbd\x34\x1f\xb1\x98\x84\x0e\x0e\x61\xcc\xd2\x68\xa4\x2c\x1e\x01\xbf\xc1\x12\x2e\xc8\x82\xc8\x7f\x8f\xc6\x7d\x49\xdf\xa6\xe1\xbd\x6b\xf2\x38\x21\xfa\x64\x5e\xc7\xfc\x43\x7c\xaa\xde\x49\x4c\xd5\x12\xd4\xa1\xd8\x10\x77\x09\xdf\x3c\xa1\x64\x53\xa8\x23\xb1\xf9\x92\xb8\x73\x98\x8a\xac\x6b\xb8\x6a\x53\x40\xb5\xf8\x2e\xbb\xea\x82\xc3\xe4\xe2\xfb\x7e\x50\xde\xee\xd6\x4d\x37\x30\x53\xf7\x1f\x1e\xd6\x6b\x6b\x90\xc4\x6c\xcf\x35\xa8\xe7\x32\xc1\x02\xfe\xfe\xa6\xa8\x8c\xda\x3b\x15\xbb\x1a\xaa\xdb\x71\x17\xf7\x62\x1f\x40\x05\xd3\x40\x17\x04\x3e\xfa\xe9\x27\x34\x58\x62\x3e\x88\xd9\xac\x88\x0d\x71\xaa\x34\x7d\x50\x05\x86\x98\xcd\xd0\xd1\x4f\xff\xff\xea\x37\xcf\xa8\x62\xca\x5a\x61\x0f\x21\x84\xd6\x7b\xff\x19\x00\x66\x31\x4c\x68\x9f\x48\x00\x00")
 
 func kubernetesmasterresourcesTBytes() ([]byte, error) {
 	return bindataRead(
@@ -1007,7 +1028,7 @@ func kubernetesmasterresourcesT() (*asset, error) {
 	return a, nil
 }
 
-var _kubernetesmastervarsT = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x5a\x6d\x6f\xdb\x38\x12\xfe\xbe\xbf\x82\x30\xba\x50\x7c\xb0\x1d\xdb\xc9\xa6\x6d\x16\xfb\x21\x8d\xd3\xad\x91\x97\xfa\xa2\x26\x87\x43\x1b\x1c\x68\x69\x6c\xf3\x22\x93\x2a\x49\x39\x71\x0d\xff\xf7\x03\xa9\x37\x4a\xa2\xfc\x92\xed\xe6\xcb\xed\x02\x83\xc6\x7c\xe6\x99\xe1\x70\x48\x0e\x29\x22\x84\x50\x63\x8e\x9f\xef\xaf\xc5\x08\xf8\x88\xb1\xa0\x71\x8a\x7a\xdd\x6e\xeb\x17\xdd\x82\x43\xe2\x02\x5f\x00\x3f\x07\x2e\xc9\x84\x78\x58\x42\xe3\x14\x35\xbe\x86\x98\xe3\x39\x48\xe0\xe2\xc0\xb1\x81\x9c\xe6\x43\xa3\xcc\x31\xe2\x64\x81\x25\x5c\xc2\xb2\x9e\x22\xc7\x18\x0c\x1e\xde\x64\xbe\xd0\x5a\xd0\xda\x60\xd0\x6c\x34\x75\x02\x02\x54\x6e\xb4\x56\x46\x54\xb4\x37\x59\x2d\x01\x0c\xdd\xc7\x68\x0c\xe7\x8c\x4e\xc8\x74\x93\x75\x2b\xca\xca\xb2\xc1\x0b\x1b\xa8\xc4\xc1\x29\x48\x10\x9f\x96\x21\x70\xf5\xa7\x1b\x82\x67\xa5\xb1\xe0\xac\x4c\x67\xbe\xcf\xe8\x35\xa6\x78\x0a\x7c\x0b\x59\x19\x5a\xcf\x77\x0b\x82\xfc\xd8\x8d\xcf\x80\x5a\xf9\x06\x58\xcc\xc6\x0c\x73\x7f\x0b\x59\x01\x67\x65\xba\x78\x06\xef\x13\xe0\x40\xce\x7e\x6c\xe1\x2a\x21\xed\x23\x00\x38\x14\x72\x6b\x1f\x4d\x98\x95\x67\xc4\xfc\x21\x9d\x70\x7c\xce\xa8\xc4\x84\x6e\x25\xb4\xe2\xad\xcc\x97\xd1\x18\x06\x37\xee\x16\x3e\x03\x65\x8f\xff\x8d\x7b\x8d\xc5\xf7\x6d\xd1\xcf\x51\x06\x0b\x05\xf9\xc4\xf8\xe3\x88\x05\xc4\xab\x26\x7b\xa1\xd5\xd0\x12\xc0\x17\xc4\x83\x11\x27\xd4\x23\x21\x0e\xce\xf5\xd4\x1c\xfa\x15\x82\x3a\xe0\x56\x2e\x17\x3c\x0e\x72\x47\xbe\x18\x6c\x70\x46\x02\x38\xc5\xf3\xea\x12\x10\x10\x1a\x3d\x9f\xf9\x73\x42\xef\x12\x88\xa1\x35\xc7\x2a\x0d\x3e\x7e\xf7\xe9\x88\xc3\x84\x3c\x6b\x6d\xc9\x02\xf6\x04\xfc\xc0\x64\x89\x81\x17\xd4\x0f\x19\xa1\x72\x70\xe3\xde\xe0\x39\xc4\x3a\x4e\xb3\xcc\x97\x2c\x13\xc3\xb0\xe2\xcc\x84\x70\x21\xcf\x19\x15\xe0\x45\x92\x2c\xc0\x95\x58\x12\x6f\x38\xaa\xb8\x74\x7f\xed\x92\x1f\xd5\xce\x98\x8d\x66\x40\xc5\x6c\x14\x8d\x03\xe2\x5d\xc2\x72\x80\x25\xae\x06\x51\xcc\x6e\xdd\xb3\x0c\x13\xab\xae\x56\x64\x82\xd0\x9f\x20\xcf\x03\x2c\x04\xf1\xae\x99\x0f\xeb\xb5\xe9\xc5\x39\x8b\x68\x75\x44\x8c\xb6\x94\x08\x02\x51\xa3\xba\x5a\x75\xae\x93\xa0\xb0\x09\x09\xa0\xa3\x1b\xd6\x6b\xad\x45\xfd\xa2\xd2\xe7\xc9\x44\x58\x52\xc0\x6c\x2c\x6e\x94\xf7\xc0\x05\x61\x74\x00\x13\x1c\x05\x5a\xb1\xdf\xed\x9d\xb4\xbb\x47\xed\xa3\x6e\x0a\x0b\x98\x87\x25\x61\x54\x34\x4e\xd1\x57\xfd\x93\xfe\xaf\xf1\x95\x83\x60\x11\xf7\xe0\x4f\xce\xa2\xf0\xa0\xd9\x49\x81\xa9\x81\x04\x56\x48\xa6\x04\xa2\xbc\xd0\x98\x87\x92\x11\xed\xfb\x02\x73\x82\xc7\x01\x18\x0a\xc2\x69\x7e\x9d\x33\xff\x00\xfb\xfe\x41\xbf\x15\x00\x9d\xca\xd9\x81\x9d\xb9\xd9\x6c\x29\x54\x6f\x1b\xaa\xf9\x50\xca\x99\xb3\x05\x26\x01\x1e\x93\x80\xc8\xa5\x9b\x84\xd1\x63\xd4\xc3\x32\x0d\x61\x1b\x1b\x10\x01\xb2\xed\xb4\x90\xe1\xac\x9a\x1c\x6e\x34\x29\xe5\x74\xfe\x6b\x75\xb1\x30\x14\x32\x3c\xe3\xde\x0c\x84\xe4\x58\x32\x7e\x93\xcc\xc8\xc7\x77\x22\x6b\x16\xc3\x39\x9e\xc2\xe7\xc9\x04\xb8\x6a\xba\x1b\x47\x54\x46\x71\x35\x53\xc2\xe8\x7c\x15\xb3\x18\x77\x8e\x29\xa3\xc4\xc3\x41\x09\xe4\x5e\xde\xa9\xe6\xde\x49\xa7\x7b\xdc\xbe\xfa\xe2\x96\x9a\x93\x0c\xc9\x20\x9d\x7e\xb7\xf7\xb6\x7b\xd2\x7b\xdf\x4b\x81\x85\x34\xd0\x5d\x2c\x27\x86\xea\x66\xd6\x3d\xce\x22\x09\x5f\x54\xc4\xd2\xce\xa5\x41\x36\x22\x99\xce\x53\x73\x95\x68\x39\x5a\x55\x2a\x88\x11\xae\x9c\x6f\x38\x28\x58\x1f\xfa\x07\xce\x35\xf1\x38\x13\x6c\x22\x3b\x37\xf1\xba\x7c\x98\xc3\x45\x71\xf0\x8a\x7e\x99\x03\x28\xc4\xec\x06\xcb\x11\xe3\x52\x4f\x81\x7e\xbf\xd5\xef\x77\x7b\x4a\xe8\x7f\x1d\x29\x71\xfc\x90\x83\x2f\x61\x39\xc2\x72\x56\xc8\x9f\xc3\x19\x9b\xc3\xa1\xd3\x32\x0c\x46\xd9\x72\xda\x72\x0e\x3b\x42\xcc\x0e\x71\x24\x67\x8c\x93\x1f\xe0\xff\xe7\x11\x96\x22\xee\x64\xbc\xcc\x74\x3e\x61\xe1\x4a\xc6\xf1\x14\xce\x3c\x4f\x2d\x01\x03\x22\x1e\x45\x3a\xfd\xf3\xa9\x9c\x80\x92\xa9\xfc\x5b\xbb\x7b\xd2\xee\xfd\x96\xe7\x79\x52\x78\x17\xa9\x1a\xa7\xa8\xdf\xcd\x21\xc5\x46\x05\x3f\x9b\x42\xb2\x8e\xf9\x64\x51\x1c\x27\xa3\x92\x77\x9a\x2d\x5b\x53\x91\xce\x0c\xac\x8f\x25\x2e\xb6\xc6\x63\xed\x02\xa8\x7d\xf1\xfd\xdb\x34\xa6\x16\x0c\xe8\xb1\x40\x8d\x6e\xa3\x85\x1a\x27\x4a\x78\x4a\x10\x25\x98\x12\x91\x12\x3d\x25\xde\x2a\xe1\x2b\xf1\x5f\x25\x42\x25\x16\x4a\xf4\x95\x78\xa7\x04\x28\xf1\xa8\xc4\x77\x25\x9e\x94\x38\x52\xe2\xbd\x12\x13\x25\x02\x25\xb8\x12\xcf\x4a\x1c\x2b\x81\x95\x98\x2a\x31\x57\x42\x28\xb1\x54\xe2\x37\x25\xc6\x4a\xcc\x94\xa0\x4a\x48\x25\x7e\x34\xb2\x25\xcf\xde\xab\x7c\xcb\x48\x96\x2f\x23\xa4\x76\x0d\x33\xa2\x8b\xf9\xe6\xd1\x2d\x32\x7c\xc0\x22\x9f\x84\x11\x25\xdf\x23\x70\x25\x27\x74\x7a\x50\x37\x23\xf3\x9d\xbe\x38\xd8\xe6\xba\x9a\x3a\xb3\x5a\xfd\x09\x52\x6d\xb4\xd7\x38\x4c\xf6\x2b\x63\x97\xab\x1f\x53\x7b\x7c\x4c\x5f\x1b\xf9\xe6\x97\x4d\x8e\xb8\x82\xf7\x37\xcf\x0a\x13\x94\x6f\x76\xc7\xed\xa3\x6e\x3b\xe4\xb0\x20\xf0\x54\xa1\x2e\x6e\xbe\xc3\xd2\x24\x2c\x6e\xbf\xc5\xb6\x0d\xcb\x9b\xbd\x6b\x4e\xb3\x85\x9c\xb9\x90\xbc\x9b\x95\x05\xf9\x06\x1f\x72\xb6\x20\xba\x2b\x1e\x27\xa1\xce\x10\x1d\xe0\xcb\xac\x5a\xfd\x70\x72\x3c\x4a\x41\xeb\x75\xdd\x6e\x92\x04\xe4\x0b\x9e\xc6\x14\x9d\xcf\x06\x20\xed\xa6\xf9\xdb\x97\x65\x08\xeb\xf5\xe9\x0e\xc8\x84\x5a\xdb\xd6\xc1\x1b\x8a\xfb\x9b\x8b\x2f\x43\x2a\x61\xca\xb1\x84\xac\x2f\x38\xd0\xf9\x02\x37\xcc\x87\x73\xe2\x73\x35\x16\x13\x1c\x08\x28\x27\x89\x0d\x28\x79\x04\xdb\x06\xe9\x3c\x12\x92\xcd\x95\xf1\x94\x69\x41\x41\xba\xd1\x98\x82\x4c\x76\x09\x4b\x55\x68\x40\xcc\xda\x50\xff\xa4\x42\x77\x9b\x6c\x2c\x2e\x4c\xe7\xaa\x24\xa7\x3e\x3c\xeb\xdb\x8b\x0a\x52\x5b\x10\x61\x40\xa4\xa5\x08\x2e\xda\x69\x21\xe7\xd0\x69\x9a\x25\xcf\x66\x83\x8e\x51\xb6\x2c\x36\x3b\xf6\x2e\x85\x11\x2e\x23\x1c\x24\x3b\xe0\x5f\xf6\x6f\x93\xd5\xc4\xbb\xd2\x54\xd7\x64\x35\x51\x8f\x2d\x59\xe3\x5d\x33\x79\xca\x19\xad\x7d\x6c\x8b\x32\xcf\x22\x1f\xeb\xcd\x15\x41\x31\x3c\xa2\xb0\x47\x57\x43\x57\x58\x6d\xcb\x49\x55\x75\x76\x91\x86\xd1\x39\x8c\x3d\x14\xc5\x22\x20\xef\x6d\x81\xd8\x3e\x62\xbb\xc6\x42\x19\xdd\xa9\x34\x55\x40\x35\xaf\x74\x75\xd7\xed\xe8\xff\x0f\xdf\x95\x97\x1e\x75\x20\x1e\x50\xe1\xc6\x07\xc9\xf8\x54\x96\xa0\x7b\x5d\xf3\x6c\x9d\x20\x2a\x8c\xbd\x93\xc2\x7d\x51\x10\xe9\x03\x4e\x82\xaa\x5e\x15\xe5\xed\xc6\x70\xfa\xcc\x7b\x04\xfe\x81\x13\x7f\x0a\x56\xd5\x32\xc0\x38\xa3\x7d\xc2\xe2\x4a\x9f\x62\x55\x19\x93\xed\x0e\x1c\xa6\x44\x67\xa0\x37\x03\x3f\x0a\x54\xa0\x14\xa9\x5e\x88\x2a\x39\x5c\x03\x56\x8b\x51\x39\x5c\x54\x4c\xf7\xad\x6c\x91\x43\xc5\xd4\xbc\x62\x10\xd3\x9d\x52\x37\xb9\x6c\x70\xc1\x8b\x38\x91\x4b\x5d\x6f\x17\x13\x38\x71\xc6\x1c\xf4\x90\x93\x39\xe6\xcb\xe4\xb4\x93\x1c\x76\xca\x1e\x3b\xab\x15\x3a\x20\x6a\x4a\xa3\x8e\x0e\x9b\x2a\xf0\x92\x05\x56\xa0\x6e\xb3\xa3\x14\xd0\x7a\x5d\x38\x11\xb9\x3a\xed\xb6\x66\x5d\x72\xc8\xd7\x87\xe9\xe1\xe8\xcc\xf7\x39\x08\xb1\x77\x92\x27\x27\x32\x12\x96\x32\xdd\x52\xa8\x20\x67\xa7\xd9\x10\x6b\x5e\x8d\x77\x0a\x7d\xc0\xb0\xff\x01\x07\x98\x7a\xc0\x8b\x21\x4f\x69\xca\x71\xcf\xe8\x47\xf1\x65\x68\xed\x9a\x91\xfb\xa1\xd7\x8d\x09\x67\x54\x02\xf5\x53\xbd\x88\xc7\x27\xe2\x43\x5b\xbf\x73\xfa\x6d\xe6\x5f\x1a\xf0\x60\xfc\x51\x39\x74\x41\xfd\xbd\x82\xfa\x72\x73\xdb\xcc\xe8\x29\x3e\x95\xe5\x2a\x40\x17\xd2\xa8\x57\xac\xd0\x54\x2d\xc2\x29\x0e\x5e\xee\x0f\x49\x18\x76\x70\xcc\x6a\xf7\xa7\x24\x57\xb1\x1b\x1b\xcd\xfd\xc5\xd1\x36\xba\xfb\x82\x61\xaf\xfa\xb1\x25\xe9\xcd\x38\xed\x9f\xfc\xf6\x6e\x6f\x76\x2b\xbb\x32\xd3\xd5\x74\x72\x11\x96\x03\xd2\x0b\xc6\x18\xa6\x8e\x31\xe5\x2f\x03\xa3\x61\x7c\xf7\x32\x1c\x6d\xec\xd9\x47\xc2\x85\x54\x6b\x5d\xbe\x2a\x61\xdf\xdf\x18\x81\x51\x7a\x63\xd7\x42\x84\x6e\xa2\xfc\xec\x49\x90\xc7\xf1\x9d\x56\x65\xe7\xaa\x77\x75\xf7\x8b\x55\xcb\x55\xe3\xd5\xf8\x03\xf6\x1e\x81\xfa\x6a\x63\x78\x69\x76\x85\x8c\x05\x7b\xa4\x53\xd6\xe1\x73\x36\x9f\x27\x37\x12\x72\x06\x02\xd0\xb5\xb5\x1d\x61\x0e\x28\x12\xe0\x23\xc9\x50\x18\x60\x0f\xd0\x3c\x0a\x24\x09\x03\x40\x31\xa3\x40\x5e\xde\xe7\x60\x89\x08\x45\x72\x06\x6a\x64\xd4\x9e\x84\x44\x88\x3d\xa8\xf1\x41\x07\x5d\xd4\x54\xd2\xf5\xe1\x6c\x39\x9d\xea\xfd\x79\x71\x20\xcb\x77\xa0\x56\xc3\x4e\xf3\xeb\x51\xf9\x02\xb3\x94\x63\xbb\xe5\x63\x46\xd7\x7d\x50\xbe\x55\x17\x9b\x2a\xb2\xb7\x33\xb2\xff\x60\xeb\xaf\x59\xfd\xbc\x24\x6d\xea\x33\x46\x6f\xf5\x76\x73\xe6\xf5\xf5\x1e\x85\x59\x37\xa7\xdb\x4b\xaf\xf7\x42\xbd\xfe\x0b\xf5\x8e\x5e\xa8\x77\x5c\xb9\x8a\x2f\x7d\x83\x51\xe3\xb9\x5b\xec\x6a\x96\xb8\xee\x9e\xcb\xd7\x0b\xcd\xf4\x5e\xc7\x4c\xff\x75\xcc\x1c\xbd\x8e\x99\xe3\xbd\xcc\x58\xd2\xe4\x42\x7a\x7e\xf2\x92\x82\x71\x7d\xd5\x78\xf4\xae\x5b\x41\xc4\xdf\x1c\x33\xc4\xdb\xf7\x15\xc4\x08\x80\xdf\xdd\x5e\x89\xc6\x69\x25\xcf\x9c\x99\x94\xe1\xe9\xa1\x75\xc7\x2f\x66\x69\xbc\x88\x21\xe7\xd4\x06\x2d\x7a\xea\x58\xc3\xb6\x97\xa9\xde\xeb\x99\xea\xbf\x9e\xa9\xa3\xd7\x33\x75\xbc\x8f\xa9\x9a\xdc\x8b\x33\xeb\xef\xcf\x9c\x3c\x83\xff\xf6\xcc\xf9\xa9\xa6\x36\x67\xce\x4f\x35\xb5\x39\x73\x7e\xaa\xa9\xcd\x99\x53\x32\x55\x9b\x39\x51\x7c\x67\x8f\xe5\x5e\xb5\x41\x96\x2b\x7f\xd4\xd9\x4f\xd7\x32\x0d\xdc\x63\x57\xde\x93\xb9\x85\x9c\x96\x0d\x98\x93\xf5\x76\x25\xeb\xed\x40\xd6\xdf\x95\xac\xff\x7f\xd9\xe7\xed\x64\x47\xbb\x92\x1d\xed\x40\x76\xbc\x2b\xd9\xf1\x43\x79\x0a\x88\x68\x2c\xf4\x37\x24\xc2\x68\xf2\xfe\xc8\xfc\xe9\xa0\xd9\x29\x22\xb2\x6a\x5a\x02\xc5\xd9\x93\xa5\x92\x4a\xda\x96\x83\x31\x9f\x82\xbc\xa0\x0b\xc2\x19\x4d\x0f\x6b\x85\x23\x67\x05\x51\xb9\xed\xbd\xa0\x53\x42\x61\xc0\x9e\x68\xc0\xb0\x7f\x0b\x21\xab\xb9\xf5\xad\x02\x6b\xb8\xcc\xd7\x0c\x9d\x5e\xbf\xf3\x8f\x46\xf2\x05\x49\xdf\x0f\xa7\x57\x47\x9f\xb0\x88\xdf\x47\xa5\x77\xc5\x2d\xd4\x08\x0c\x40\xd2\xd8\x40\xa7\x49\x96\x1b\xcf\x62\xd0\x6a\xc5\x31\x9d\x02\x42\x6f\x16\xfa\x93\x48\x0b\xbd\x59\xe0\x28\x90\xe8\xf4\x8f\x92\x99\xa2\x8d\x5c\x9f\x4c\x52\xdd\xf5\x1a\xb5\x90\x79\xf8\x36\x60\xa5\xbf\xd5\xc0\xea\x1b\xa5\xfb\xf8\x25\x4f\xb5\x1d\xa1\x06\xf1\x1b\xa7\x96\xd7\x5d\x97\xb0\xd4\x5a\xc3\xc1\x6a\x95\x59\xce\xce\x05\xe6\x7f\xeb\x56\xd5\xac\xee\x9d\xf1\x3c\xd4\xd8\x89\xab\x51\x79\xe3\xa5\x41\xf1\x80\xeb\x98\xc4\xd1\xe9\xdc\x97\x59\x2a\x3d\xce\x83\xe3\x6d\x0b\x8e\x3d\x40\xda\x5b\x2f\x37\x71\xc7\x83\x06\xda\x39\x1e\x86\x6f\x77\xb7\x57\xab\x55\xe6\x85\x35\x50\x08\x55\x7d\xaa\xf3\xf5\xe1\x97\x3a\xcd\xa2\xc6\x43\xf5\xab\xf7\xbf\x08\xf5\xd9\x53\x96\xa6\x8d\xa7\xf8\xef\xc2\x73\xbd\xca\x9c\xb1\x81\x8c\xf9\x62\x36\x8f\xb0\x10\x4f\x8c\x57\x5f\x29\xda\x40\xa5\x97\x96\x1f\x08\xc5\x9c\x80\x70\xcf\xdc\xbb\xdb\x2b\xeb\xa7\x9e\x22\xa4\x46\xdf\x98\xb3\xb5\x04\x09\xc6\x7c\xdc\x36\x05\x2a\x93\xf0\x14\xde\x41\x65\xb7\xad\x49\x63\xf1\xe5\x94\xa9\x96\x3d\xb1\xda\x8a\x74\x1f\xa3\xec\x39\xc1\x00\x4b\xec\x01\x95\xc0\xdb\x4f\x44\xce\xda\xd9\x93\x56\x61\xd3\x34\x3a\x17\xa8\xac\x97\xd9\x67\x50\x42\xa7\x01\xfc\x33\x62\xf1\xab\x6c\xa7\x34\x3a\xf1\x17\xee\xf8\x2d\x40\xfe\xb8\x0c\xbd\x21\x34\x8c\xe4\x47\x12\x00\xfa\x03\x39\xbf\xba\xff\x76\xbf\x5c\x5c\x0f\x6e\x87\xf7\x17\xbf\x7e\xfb\x76\xf6\x23\xe2\xa0\xdc\xfb\xf6\x2d\x56\x57\xff\xee\x8c\x09\x75\xd0\xef\xe8\x0d\x8b\xe4\x9e\xaa\x2e\xc8\x28\x8c\x5d\xe8\x84\xa2\xa7\x58\xce\x59\xb8\x6c\x0f\x25\xcc\x4d\x4f\x4c\xea\xdf\xd1\x90\x2e\xd8\x23\xb4\x2f\x9e\x43\x0e\x42\xf5\xfd\xc0\x59\x75\xd7\x68\xd5\x5b\x3b\xa8\x3d\x31\xc1\x2d\xf4\x06\xf3\x69\xa4\xb6\x06\xd1\x44\xbf\xa3\x46\x9a\xff\xff\x0b\x00\x00\xff\xff\x12\x45\x1e\xe2\x45\x30\x00\x00")
+var _kubernetesmastervarsT = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x5b\x6b\x4f\x1b\x3b\xb7\xfe\xbe\x7f\x85\x35\xea\xab\x21\x47\x49\x48\x02\xbb\xbb\x65\x6b\x7f\xa0\x84\x96\xa8\x40\xf3\x32\x85\xa3\xa3\x16\x1d\x99\x99\x95\xc4\x87\x89\x3d\xb5\x3d\x81\x34\xca\x7f\x3f\x5a\x73\xf5\xdc\x72\xa1\x7b\xf3\xe5\x6d\x2a\x0b\xe2\xc7\xcf\xba\x78\x79\xd9\x5e\x33\x10\x42\x88\x35\xa7\xcf\x77\x57\x6a\x0c\x72\x2c\x84\x6f\x9d\x90\x7e\xaf\xd7\xfe\x2d\xea\xa1\x01\x73\x40\x2e\x40\x9e\x81\xd4\x6c\xc2\x5c\xaa\xc1\x3a\x21\xd6\xb7\x80\x4a\x3a\x07\x0d\x52\x1d\xd8\x75\x20\xbb\x75\x6f\x95\x39\xc6\x92\x2d\xa8\x86\xcf\xb0\x6c\xa6\xc8\x31\x06\x83\x4b\x37\x89\x77\x69\xbd\x5c\x97\x6e\x10\xe8\xd2\x7a\x49\x3e\x03\xae\x37\x4a\x2b\x23\x2a\xa3\x37\x49\x2d\x01\x8c\xb1\x8f\xe1\x03\x9c\x09\x3e\x61\xd3\x4d\xd2\x6b\x51\xb5\x2c\x1b\xb4\xa8\x03\xc5\x1c\xab\x15\x9b\x90\xae\x41\x3d\x96\x62\xc2\x7c\xe8\x5e\x50\x85\xf3\xc3\x5c\x38\x75\x5d\x11\x9a\x36\xac\xd7\xb1\xbb\x55\x43\x7f\x45\x7c\x13\x30\x55\x01\x7c\x05\xbb\x90\xc6\x60\xee\xad\xd7\x7b\xe8\x7d\x07\x32\x36\x8d\x09\xfe\x19\x96\xaa\x5e\x50\x19\xb5\xc5\x86\x32\x3c\xb3\x24\x52\x2e\x9b\x18\xc9\x41\x83\xba\x58\x06\x20\x71\x0a\x9c\x00\xdc\x0a\x71\x03\xae\x34\xc5\x31\xe2\xd4\xf3\x04\xbf\xa2\x9c\x4e\x41\x6e\x21\x2b\x43\x9b\xf9\x6e\x40\xb1\x9f\xbb\xf1\x19\xd0\x5a\xbe\x21\x55\xb3\x07\x41\xa5\xb7\x85\xac\x80\xab\x65\x3a\x7f\x06\xf7\x02\xa8\xaf\x67\x3f\xb7\x70\x95\x90\xb5\x6c\x17\x40\x03\xa5\xb7\xda\x68\xc2\xd2\x19\xc5\xe5\xf1\x45\xba\x33\x50\x5a\x52\x2d\x64\x1a\x67\x9f\xb3\x51\xf1\xc2\xea\x8e\x85\x37\xe2\x13\x49\xcf\x04\xd7\x94\x71\x90\xa3\x39\x9d\x66\x61\x9d\x0b\xa9\xe0\x52\xa5\x56\xab\x5f\x13\x54\x59\x4a\x3b\xc8\x6c\x70\x44\x2d\x7e\x63\x8c\xa3\x3b\x86\xd7\xce\x16\x5e\x03\x55\x3b\x4f\xc3\x6b\xe7\x8a\xaa\x1f\x5b\x58\x0c\xd4\xde\xb3\x84\x5f\x8c\xa5\x78\x5e\x36\xcc\x4e\xd6\xbf\xef\xac\x94\x89\x37\xcc\x46\x45\xc6\xee\xf9\xa0\xe0\x7d\x0e\xfa\x49\xc8\xc7\xb1\xf0\x99\x5b\xcd\xf9\x85\x5e\xc3\xdb\xae\x2f\x42\x6f\x2c\xc5\x82\x79\x20\x6f\xa8\x86\x4b\x36\x67\xfa\xdf\x63\x07\x19\x56\xab\x4f\xa0\xcf\x9a\x10\xeb\xf5\x66\x92\x0f\xa1\xfb\x08\x7a\x0b\x4f\x0c\xca\xa9\x92\xc4\x3a\x96\x8c\xbb\x2c\xa0\xfe\x59\xb4\x63\x8e\xbc\xa6\x0c\x5c\x01\x1a\xb6\xd5\x43\x1c\x70\x25\xe8\x1d\xf9\x62\xb0\x19\x57\x17\x54\x25\xdb\xd0\x0d\x4c\x99\xd2\x32\xdb\x00\x83\xe2\xd7\xb8\x5f\x82\xac\xc8\xa9\x45\x19\x4a\x97\xfa\x6f\x15\x48\x4e\xe7\xb0\x8d\x27\xc5\x35\x33\x8d\xa9\x52\x4f\x42\x7a\xdb\x98\x52\x5c\x4d\x88\x85\x4d\xca\xf8\x8c\x87\xcf\xa7\xde\x9c\xf1\x1a\x3d\xe6\x14\xb3\xe8\xc7\x1f\x1e\x1f\x4b\x98\xb0\xe7\x48\x01\x2d\x7c\xf1\x04\xf2\xc0\x64\x89\x81\xe7\xdc\x0b\x04\xe3\x7a\x78\xed\x5c\xd3\x39\xc4\x63\xec\x56\x99\x2f\x99\x84\x51\x50\x51\x66\xc2\xa4\xd2\x67\x82\x2b\x70\x43\xcd\x16\xe0\x68\xaa\x99\x3b\x1a\x57\x54\xba\xbb\x72\xd8\xcf\xaa\x31\x66\xa7\x31\x46\xa9\xd9\x38\x7c\xf0\x99\xfb\x19\x96\x43\xaa\x69\x65\x9c\x52\xb3\x1b\xe7\x34\xc3\x18\x41\x43\xa2\xf0\xa7\x4a\x31\xf7\x4a\x78\x59\x0e\x88\x05\x9d\xe1\x81\xa3\x41\x89\xa8\x2f\x9b\x08\x5f\x35\x0c\x5d\xad\xba\x57\x89\x53\xe2\x44\x17\x8d\x5b\xaf\x4b\xd3\x17\xcb\xfb\x32\x99\xa8\x9a\xf8\x37\x3b\x0d\xab\x69\xc0\xee\x40\x2a\x26\xf8\x10\x26\x34\xf4\xa3\x81\x83\x5e\xff\x6d\xa7\x77\xd4\x39\xea\xa5\x30\x5f\xc4\x47\x2a\x65\x9d\x90\x6f\xd1\x57\xd1\x7f\xeb\x9b\x04\x25\x42\xe9\xc2\x27\x29\xc2\xe0\xa0\xd5\x4d\x81\xa9\x80\x04\x66\x6a\x92\x42\x50\x8b\x88\xea\xbe\x24\x04\x55\xf8\xb6\xa0\x92\xd1\x07\x1f\x8c\x01\xca\x6e\x7d\x9b\x0b\xef\x80\x7a\xde\xc1\xa0\xed\x03\x9f\xea\x59\x21\xc0\x52\xa0\xdd\x6a\xb5\xda\x88\xea\x6f\x43\xb5\xee\x33\x4f\xc4\x0e\x3a\x5d\x50\xe6\xd3\x07\xe6\x33\xbd\x74\x12\x37\xba\x82\xbb\x54\xa7\x2e\xec\x50\x03\xa2\x40\x77\xec\x36\x31\x94\xc5\xc5\xe1\x84\x93\x52\x4c\xe7\xdf\x56\x26\xc6\x1c\x90\xe1\x85\xb1\x09\x5d\x27\x2b\xf2\xf1\x9d\xca\xba\x55\xb4\xa1\x7d\x99\x4c\xe2\x0c\x74\xfb\x10\x72\x1d\x26\x19\xa9\x88\x89\xe2\x55\xcd\x62\xdc\x19\xe5\x82\x33\x97\xfa\x25\x22\xe7\xf3\x2d\x76\xf7\xdf\x76\x7b\xc7\x9d\xcb\xaf\x4e\xa9\x3b\x89\x90\x0c\xd2\x1d\xf4\xfa\x7f\xf4\xde\xf6\xdf\xf7\x53\x60\x21\x0c\xac\x93\x9a\xc0\x40\x33\x33\xf3\xa4\x08\x35\x7c\x45\x8f\xa5\xc6\xa5\x4e\x36\x3c\x99\xae\x53\x33\x4b\xb4\xed\x68\xa8\x46\x88\xdd\xaa\xe1\x1b\x0d\x0b\xd2\x47\xde\x81\x7d\xc5\x5c\x29\x94\x98\xe8\xee\x75\xbc\x49\x1e\xe6\x70\x55\x9c\xbc\xbc\x03\x85\x9a\x13\xa8\xd4\xec\x9a\xea\xb1\x90\x3a\x5a\x02\x83\x41\x7b\x30\xe8\xf5\xb1\x89\x7e\x3a\xc2\xe6\x38\x0d\x64\xa5\x66\x9f\x61\x39\xa6\x7a\x56\x88\x9f\xc3\x99\x98\xc3\xa1\xdd\x36\x04\xa6\x19\x17\x2d\x3b\xec\x2a\x35\x3b\xa4\xa1\x9e\x09\xc9\x7e\x82\xf7\xbf\x8f\xd9\x55\x23\xdf\x9b\x1c\x2d\x24\x9d\xa6\x17\x93\x21\x53\x8f\xd9\x15\x27\x5f\xca\x09\x28\x59\xca\xbf\x77\x7a\x6f\x3b\xfd\xdf\x53\x4b\xb2\x62\x40\x91\xca\x3a\x21\x83\xb4\x2a\x30\xa7\xcf\xc5\x4e\xac\x1d\x9c\x4e\x21\xc9\x63\x1e\x5b\x1c\x18\x36\x14\xaa\x0b\x76\xab\x5d\xd7\x55\xa4\x33\x1d\xeb\x51\x4d\x8b\xbd\xf1\x5c\x3b\x00\xb8\x95\xbd\xff\x23\xc1\xa9\x1a\x0c\x44\x73\x41\xac\x9e\xd5\x26\xd6\x5b\x6c\x5c\x6c\x18\x36\x02\x9b\x10\x9b\x3e\x36\x7f\x60\xe3\x61\xf3\x7f\xd8\x04\xd8\x2c\xb0\x19\x60\xf3\x0e\x1b\xc0\xe6\x11\x9b\x1f\xd8\x3c\x61\x73\x84\xcd\x7b\x6c\x26\xd8\xf8\xd8\x48\x6c\x9e\xb1\x39\xc6\x86\x62\x33\xc5\x66\x8e\x8d\xc2\x66\x89\xcd\xef\xd8\x3c\x60\x33\xc3\x86\x63\xa3\xb1\xf9\x69\x91\xfb\x8d\x56\xe5\x5b\x46\x92\xbe\x0c\x97\xd6\x8f\x30\x3d\xba\x98\x37\xce\x6e\x16\x46\xb7\x9c\xfd\x08\x21\xc1\x60\xac\xe7\x17\xe5\xc2\xb8\x0f\x54\xe5\x4b\x34\x4c\x06\x49\xc6\xa7\x07\x49\x50\xab\xf0\x41\xb9\x92\x05\x98\x50\x0f\x5a\x5d\xf3\xd7\x91\xd7\xae\x4b\x02\xc5\xf8\x28\x1e\x1d\x8a\xd1\x63\x26\xea\xea\x16\xb9\xa7\xa2\xbf\x22\x35\xdb\x62\xa3\x83\xae\xc3\x7e\xc2\x15\x0d\xd6\xeb\xcd\x1a\xa5\x53\x83\x21\x7a\xdf\xde\xa6\xb3\x21\x28\x9b\xa4\xf8\xea\xef\x6d\x5e\xe4\x26\x28\xdf\xbb\x8f\x3b\x47\xbd\x4e\x20\x61\xc1\xe0\xa9\x42\x5d\x3c\x4b\x8c\x4a\x39\x25\x95\x14\xcf\x4d\xb1\x2f\xd5\x36\xf6\x43\xa5\x8b\x58\x73\xa5\xa5\x45\x7a\xeb\x75\xc9\x71\x56\x80\xb7\x87\x28\x37\x45\xe1\x91\x91\xe4\x37\xab\x0f\x6f\x8f\xc7\x29\x68\xbd\x6e\xda\x08\x13\xe3\xbf\xd2\xe9\x86\xeb\x9a\xf9\xdd\xd7\x65\x00\xeb\xf5\xc9\x0e\xc8\x84\x3a\x97\x4d\x03\x36\x17\x1e\xf8\x17\x54\xcd\x32\x85\x4f\xc7\xa3\xab\xf4\xcb\x1c\x3a\x05\x0e\x92\x6a\xf0\x4e\xf3\x0b\xd1\xa7\xfc\xbb\xc4\x1f\x6c\x42\x46\xea\xee\xfa\xfc\xeb\x88\x6b\x98\x46\xf8\xd4\x3f\xd4\x8f\xa2\x1d\xae\x85\x07\x67\xcc\x93\x98\xd9\x26\xd4\x57\x50\x0e\xb2\x3a\xa0\x96\x21\x6c\x9b\xe4\xb3\x50\x69\x31\x47\xe1\x29\xd3\x82\x83\x76\xc2\x07\x0e\x7a\x34\xac\x9c\x4a\x92\xcd\xd7\x80\x18\xdb\xad\x8a\xbe\xc2\xe9\xb8\x49\x16\xb8\x03\xd3\x39\x70\x3d\xe2\x1e\xe0\xf9\xbf\xdf\xab\x20\x23\x09\x2a\xf0\x99\x3e\xd8\x26\xa7\x4d\xec\x43\xbb\x65\x9e\x00\x37\x0b\xb4\x8d\x53\xdc\x62\x03\xce\x3a\x21\xef\x52\x18\x93\x3a\xa4\x7e\x72\x20\xf8\x65\xfd\x16\xdb\xb5\x2b\xa5\x8a\x88\xac\xc1\xeb\xf1\xa4\xd4\xfa\xbb\xe1\xa8\x54\x5e\x25\x91\x8e\x1d\x55\xe6\x59\xe4\x73\xbd\xf9\x80\x54\x74\x8f\x2a\x1c\x59\xaa\xae\x2b\x6c\x3e\x86\xa7\x1a\x94\x5d\xa4\x6e\xb4\x0f\x63\x0d\x55\xf1\x4c\x94\x5b\x5b\x20\xae\x88\xdd\xcb\x17\x0b\xbe\xe3\x49\x1d\x81\xb8\xae\x90\xbd\xdf\xeb\x46\x9f\xc3\x77\xe5\x74\x86\x75\x9a\x21\x4f\x6b\xcf\xa3\xc0\x40\xf7\xb3\x4b\x13\x82\x12\x44\x85\xb1\xff\xd6\x44\x9d\xf9\x21\x2e\xb7\x14\x55\x88\x89\x52\xbf\x31\x9d\x79\xed\xe8\x74\x3c\x4a\x1e\xaf\x08\x59\x97\x5b\x0b\xfd\x79\xd6\xf2\x81\x7a\x20\xcf\x7d\x70\xf5\x25\x50\x05\xc3\x50\x66\x17\xb0\x86\x84\x59\xa9\x84\x5d\x36\x70\xd4\x4a\xb9\x01\x0e\x4f\x43\xa0\x9e\xcf\x38\xbc\x50\x4a\x81\xa3\x41\x0a\x96\x39\x40\x32\xe1\xbd\x58\x46\xc6\x90\xa7\xee\xdd\x58\x46\xea\x94\x0b\xbe\x9c\x8b\x50\x9d\x86\x7a\x36\x64\x0a\xc3\x2d\x0b\x1c\x6a\x76\xa2\x76\x51\x92\xaf\xe4\x87\x0a\x0c\x53\x7c\x39\x08\x3d\xe1\x3e\x82\xfc\x20\x99\x37\x85\xda\xd0\x29\x03\x8c\x92\xc5\x05\x55\x97\x51\x51\x07\x4f\xf5\xd9\xe9\x42\x46\x35\x22\x90\x8e\x3b\x03\x2f\xf4\x51\xf3\x66\x1d\x1b\xc0\x75\x9a\x72\x35\xdd\xb0\x62\x6b\x2f\x7a\xc4\xe6\x6a\x6a\x04\x3b\x57\xd3\x9d\x52\x57\x52\x08\x75\xc0\x0d\x25\xd3\xcb\xe8\xe4\x59\x4c\x60\x89\x32\xe6\xa2\x0f\x24\x9b\x53\xb9\x4c\x2e\xff\xc9\xdd\xbf\xac\xb1\xbd\x5a\x91\x03\x86\x29\x9d\x74\xa3\xcb\x10\x3e\x4d\x4d\xe2\x40\x91\x5e\xab\x8b\x03\xc8\x7a\x5d\x28\x10\x38\x51\xda\xd9\x9a\x75\x92\x9a\x17\xde\xd5\xdd\xd1\xf8\xd4\xf3\x24\x28\xb5\x77\x92\x4b\x0a\x14\x2c\x28\x65\xba\x9a\x03\x2f\xb1\x77\xca\x86\xf1\xc8\xcb\x87\x9d\x5c\xef\x0b\xea\x7d\xa0\x3e\xe5\x2e\xc8\xa2\xcb\x53\x9a\xb2\xdf\x33\xfa\x71\xbc\x06\x47\xc3\x06\x7b\x33\x20\x6e\xbf\xf6\xe1\x44\x0a\xae\x81\x7b\xe9\xb8\x24\xe7\xa8\xc3\xa2\x4d\x65\xfa\x6d\xe2\x5f\xea\x70\xff\xe1\x23\x2a\x74\xce\xbd\xbd\x9c\xfa\x72\x71\xdb\xc4\x44\x4b\x7c\xaa\xcb\xa7\xc0\xe8\x5e\x49\xfa\xe9\xaa\x8c\xcd\xc7\xb3\xa8\xe4\xd4\x7f\xb9\x3e\x2c\x61\xd8\x41\xb1\x5a\xb9\x7f\x4b\x70\x15\xcd\xd8\x28\xee\x17\x67\xdb\x30\xf7\x05\xd3\x5e\xd5\x63\x4b\xd0\x1b\x03\x5e\x10\xfc\x55\x71\xdb\xdd\x93\x55\x90\xa3\x1b\x5a\x52\x17\xce\x01\x69\xbd\x3d\x86\xad\xd7\xcd\xa7\x91\xd1\x78\xa3\x65\x1f\x99\x54\x1a\x73\x5d\x9e\x95\xb0\x68\xbb\xd1\x86\xb4\x80\xdd\x26\x8c\x6f\xa2\xfc\xe2\x6a\xd0\xc7\x58\x39\x68\xdd\x57\x76\xae\x66\x55\x77\x7f\xce\x50\xd8\xdf\xd2\x15\xfd\x81\xba\x8f\xc0\x3d\xdc\x18\x5e\x1a\x5d\x81\x10\xfe\x1e\xe1\x94\x19\x7c\x26\xe6\xf3\xa4\x40\xa7\x67\xa0\x80\x5c\xd5\xf6\x13\x2a\x81\x84\x0a\x3c\xa2\x05\x09\x7c\xea\x02\x99\x87\xbe\x66\x81\x0f\x24\xb6\x42\x11\x37\xb7\xd9\x5f\x12\xc6\x89\x9e\x01\xa1\xf1\x9e\x44\x54\x40\x5d\x68\xd0\x21\x72\xba\x6a\xb8\x49\x35\xbb\xb3\x6d\x77\xed\x46\xbb\x22\xce\xe3\xf2\x23\x81\x5a\xc1\x76\xeb\xdb\xd1\x7d\x13\x8f\xf1\x6c\x6a\x6b\x3c\x66\x74\xbd\x7b\xd4\xad\xbd\x03\xb2\xbf\x33\x72\x70\x5f\x67\xaf\x79\xfa\x79\x49\xd8\x34\x47\x0c\x66\xae\x06\x71\xe6\xd3\x9c\x3d\x0e\x66\xbd\x9c\x6e\xaf\x71\xfd\x17\x8e\x1b\xbc\x70\xdc\xd1\x0b\xc7\x1d\x57\x9e\x4c\x95\x1e\x49\xe2\x7c\xee\xe6\xbb\x6c\xfa\x73\x7a\x4c\x71\xbd\x3d\xd3\xd7\x0b\xc5\xf4\x5f\x47\xcc\xe0\x75\xc4\x1c\xbd\x8e\x98\xe3\xbd\xc4\xd4\x84\xc9\xb9\x76\xbd\xc2\x6d\x7c\x70\xf4\xae\x57\x41\xc4\x2f\x2b\x64\x88\x3f\xde\x57\x10\x63\x00\x79\x7b\x73\xa9\xac\x93\x4a\x9c\xd9\x33\xad\x83\x93\xc3\xda\x1d\xbf\x18\xa5\x71\x12\x23\xf6\x49\x1d\xb4\xa8\xa9\x5d\xeb\xb6\xbd\x44\xf5\x5f\x4f\xd4\xe0\xf5\x44\x1d\xbd\x9e\xa8\xe3\x7d\x44\x35\xc4\x5e\x1c\x59\xff\x7c\xe4\xe4\x11\xfc\x8f\x47\xce\xdf\x2a\x6a\xf0\x7a\xa2\x8e\x5e\x4f\xd4\xf1\x3e\xa2\x1a\x23\x27\x2a\x33\xe2\xc9\x6c\xaf\xb3\x41\x16\x2b\x7f\x35\xc9\x4f\x73\x59\x04\xac\xb3\xf5\xef\x61\x6e\x13\xbb\x5d\x07\xcc\xc9\xfa\xbb\x92\xf5\x77\x20\x1b\xec\x4a\x36\xf8\x8f\xb4\x79\x3b\xd9\xd1\xae\x64\x47\x3b\x90\x1d\xef\x4a\x76\x7c\x5f\x5e\x02\xc5\xc7\xd6\xd1\xf9\x7b\xe3\x83\xed\x74\x32\x2d\x0d\x9c\x72\x5d\x3f\x24\xed\xcb\xc1\x54\x4e\x41\x9f\xf3\x05\x93\x82\xa7\x97\xb5\xc2\x95\xb3\x82\xc8\x4f\xb0\x49\xb5\xf7\x9c\x4f\x19\x87\xa1\x78\xe2\x58\x6d\xbb\x81\x40\x54\x48\x9a\x80\x0d\x5c\xc9\x63\x4f\xa4\xe9\x77\xfb\x83\xee\x7f\x59\x49\xb9\x3b\xaa\x0f\xa7\xa5\xa3\xe8\x45\x7b\x7c\x57\x32\xad\x15\xe3\xdb\x10\x06\x20\xe9\xb4\xc8\x49\x12\xe5\x69\xee\xc0\xcf\x6a\x25\x29\x9f\x02\x21\x6f\x16\xd1\x13\xc2\x36\x79\xb3\xc0\x77\xcd\xc8\xc9\x5f\x25\x31\x45\x19\xe9\xbf\x48\x9f\x64\xec\x7a\x4d\xda\xc4\xbc\x7c\xe7\xff\x56\xa5\xdf\x71\x62\xa3\x8a\xd2\x1d\x0a\xb3\x4e\xaa\xfd\x84\x58\xcc\xb3\x4e\x8a\xfe\x8b\x5e\x76\xfc\x0c\xcb\x68\xd4\x68\xb8\x5a\x65\x92\xb3\x7b\x81\xf9\x49\xea\x1f\xe6\xc7\x8a\xac\x33\xfe\x5c\xc1\xd8\x89\xab\x5e\x79\xe3\xa6\x4e\x71\x41\x46\x3e\x89\xbd\xd3\xbd\x2b\xb3\x54\x2c\xce\x9d\xe3\x6e\x73\x4e\xbd\x83\xf0\x63\xb9\xb9\x88\x5b\xe9\x5b\x64\x67\x7f\x18\xba\xdd\xde\x5c\xae\x56\x6f\xdc\x4d\x8e\x22\xa4\xaa\x53\x93\xae\xf7\xbf\x35\x8d\x2c\x8e\xb8\xaf\xbe\x35\xf1\xdf\x8c\x7b\xe2\x29\x0b\x53\xeb\x29\xfe\xbd\xf0\xf6\x6a\x65\xcd\xd4\x81\x8c\xf5\x62\x76\x37\xbe\x67\x5b\x07\x32\x38\xb0\xe8\xf4\x81\x71\x2a\x19\x28\xe7\xd4\xb9\xbd\xb9\xac\x30\x54\x21\x0d\xe3\x8d\x35\xdb\x48\x90\x60\x8c\x67\x3e\xdd\xc4\x35\xe9\x62\xbb\xa0\xc9\x5b\x01\x85\xb7\xe3\x29\x3e\xda\x48\x91\xe6\xcb\x83\xab\x55\x99\xa0\xf8\x82\x61\xfe\x2c\xce\xa4\xc8\xde\x51\x6c\x18\x1e\xf5\xd7\x0f\x75\x1e\xc3\x0d\x03\x9d\xc7\xb0\x7e\x98\xe1\x9c\xea\xd0\x4f\xa0\xcd\x57\x1a\xd7\xeb\x4a\x71\xb0\xd1\xfe\xac\x26\x9d\x74\x16\x5f\xb7\xac\xb5\x79\x2b\x32\x31\x31\x7a\x69\x07\x5f\x3a\x76\x01\x6b\x9d\x9d\x27\xa6\x67\x9d\xec\xcf\x31\x54\xdd\x48\xc3\x4a\x1f\x33\x8c\x2e\xd7\x24\x15\xe3\x53\x1f\xfe\x1d\x8a\xf8\x2f\xfa\xec\x52\x2c\xc7\x33\xef\x44\x5b\x5c\xfe\x66\x2a\x79\xc3\x78\x10\xea\x8f\xcc\x07\xf2\x17\xb1\xff\xe5\xfc\x8f\xf3\xf5\xfc\x6a\x78\x33\xba\x3b\xff\xd7\xf7\xef\xa7\x3f\x43\x09\xa8\xe6\xf7\xef\xf1\x70\xfc\xb9\xfb\xc0\xb8\x4d\xfe\x24\x6f\x44\xa8\xf7\x1c\xea\x80\x0e\x83\x58\x85\x6e\xa0\xfa\xc8\x72\x26\x82\x65\x67\xa4\x61\x6e\x6a\x62\x52\xff\x49\x46\x7c\x21\x1e\xa1\x73\xfe\x1c\x60\x41\x12\xf7\x5a\x7b\xd5\x5b\x93\x55\x7f\x6d\x93\xce\xc4\x04\xb7\xc9\x1b\x2a\xa7\x21\x6e\xb5\xaa\x45\xfe\x24\xd6\x6f\xab\x15\x70\x6f\xbd\xfe\xff\x01\x00\x96\xb6\x6b\x6c\x16\x39\x00\x00")
 
 func kubernetesmastervarsTBytes() ([]byte, error) {
 	return bindataRead(
@@ -1027,7 +1048,7 @@ func kubernetesmastervarsT() (*asset, error) {
 	return a, nil
 }
 
-var _kubernetesparamsT = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x97\xcf\x6e\xdb\x38\x10\x87\xef\x7d\x8a\x81\x4f\x5d\x20\x71\xb2\xbb\x41\x0f\xb9\x75\xed\x02\x09\x8a\xa4\x46\x5d\xf4\xb2\xd8\xc3\x98\x1c\x59\x44\x24\x52\x1d\x8e\xec\x3a\xae\xdf\x7d\x41\x49\xae\x54\xdb\xf5\x46\x7f\x0a\x6c\x4e\x8a\x24\x7e\x9a\xef\x27\x8a\xe6\x00\x00\x8c\x30\x33\x73\xe2\x15\xf1\x84\x58\x4c\x64\x14\x0a\x8d\x6e\x61\xfb\x0a\x8a\xbf\x51\x4a\x82\x1a\x05\x1b\xe7\x00\x46\x9a\xbc\x62\x93\x89\x71\x76\x74\x0b\xa3\x4f\x31\xc1\x02\x3d\xc1\x9b\x1b\xf0\x05\x0d\x54\x8d\x83\xdc\x93\x06\x67\x41\x62\x82\x14\xbd\x10\x8f\x2a\xd4\xee\x62\xff\x1c\xd9\x64\xe1\xb9\x23\x2f\x6c\xec\xb2\xbc\x5e\x5d\xad\x6b\x9c\xb1\x59\xa1\xd0\x7b\xda\x0c\x51\x62\x56\xd2\xe0\x89\x36\x27\x4a\x1c\x9f\xa9\x91\x54\xce\x74\xaa\x52\x85\x43\xc5\xd8\xcc\x0f\x73\x89\x1d\x1b\xd9\x34\xcf\xb6\x8b\x50\xe1\xc9\xec\xb6\xdb\x99\xcb\xf2\x04\x85\x26\x09\x7a\x6f\xd4\x83\xd3\x34\xa5\x08\xf3\x44\x3e\x63\x92\xd3\xc1\xc8\xdd\xae\xb3\xd0\xe4\xed\x2f\x09\x3c\x31\x64\x65\xb0\xd0\x0b\xda\xf1\xdc\x15\x07\xca\xa5\x69\x6e\xcb\x73\x6b\x23\x71\xe7\xc9\x5c\x3e\x63\xa0\x99\x5c\x15\x7c\x14\x6c\xe7\x82\x7f\x1a\xf4\x53\xbe\xa0\x89\xb3\x91\x59\xfe\x8a\x19\x5e\x14\xbd\xd8\x04\x9f\x41\xc3\xae\xab\x1e\x28\xf0\xa3\xa4\xfb\x16\xfd\xd3\xc0\xb5\x53\x4f\xc4\x7f\xb1\xd1\x4b\x9a\x18\xcd\xed\xbe\xda\xa3\xd1\x2d\xbf\xdc\x69\x31\x1e\x16\x05\x00\x2c\xc9\xda\xf1\x13\xdc\xcf\x00\xb5\x66\xf2\x1e\xd0\x6a\xf0\xf9\xc2\x92\x74\x78\x23\x49\x1e\x52\x69\x6f\x75\x38\xb8\xa5\xd4\xfb\x7c\x41\x6c\x49\xc8\x83\x2a\x29\x9d\x15\x4a\xcc\xdd\x26\x23\x0e\xff\xce\x33\x52\xed\x55\x4e\x41\x3a\xac\xb0\xca\x59\x41\x63\x83\x4d\x46\x0a\x22\xc7\x10\xef\x99\xe7\x16\xd5\xb3\x6a\x6f\xb5\x76\xf6\x01\x2d\x2e\x89\xfb\xd8\x1d\x71\xfe\x57\x82\x1f\xc9\x9b\xe7\x01\x04\x9b\x9c\x61\x04\x31\x60\x2f\xb9\xe4\x76\x96\x9c\xa2\x8f\x17\x0e\x59\xf7\x31\xfc\x11\x32\x8c\x5e\x4d\xbf\xd4\x7b\xfc\x25\xa6\xfa\xcd\x4d\x67\xd7\x77\x5f\x49\xdd\x11\x26\x12\x3f\xf7\xb1\x3d\xc4\x0c\xe3\x4b\x5f\x49\xc5\x25\xb5\xa7\xe6\x1d\x61\x16\x96\xae\x5e\x4b\x4e\x93\x31\xd0\x07\x59\x21\x3b\x7b\xcd\x9c\xbe\xb7\x11\xe3\x64\xcf\xee\x23\x78\x1a\x36\x8c\x69\xe6\x34\x98\x00\xef\xac\x1a\x7e\x87\xa6\x8f\xf3\x3e\x82\x4d\xc4\x70\x5f\xa4\xb6\xbe\xe7\xec\x9c\x3e\xce\x1f\xd0\x7f\xe9\xb5\xdc\x34\x10\xc3\xa9\x5d\x6a\xeb\x53\xf4\x5f\x3a\xf9\x95\xbb\xa9\x77\x76\x69\x2c\x4d\xdd\xda\x26\x0e\xf5\x47\xca\x5c\x73\x2b\xa9\x1b\x3a\x81\x13\x8b\x64\xfe\xf6\xea\x0a\x33\x29\x87\x8f\xf1\x39\x67\x22\xbd\xa4\xb1\x25\xb9\xe2\x30\xfe\xa2\xb5\x5e\xc9\x02\x2a\x6a\x01\x5d\x15\x03\x39\x27\x07\xeb\x6a\x4b\xc5\x6a\x8b\x37\x73\x89\x51\x9b\x73\x5e\xdb\xed\xf8\x03\xab\x38\xec\x59\x51\x1c\xcf\xd8\x45\x26\xa1\x71\xbd\xb7\x2a\xf7\xdb\xe3\xc7\x26\x70\xb7\xeb\xa0\xba\xdf\x75\x66\x05\x02\xc8\x46\x8e\x15\xa5\xa1\xe1\x11\x17\x76\xdf\xf0\xda\x3a\x4b\xdf\x8a\x5c\xbf\x29\x4c\x8c\x72\xbf\x1d\x5b\x63\x92\xb8\x35\xe9\x42\xc0\x8f\x6e\xe1\xef\xfa\xa1\x61\xf8\xf7\xc2\xc2\xad\x81\xd4\x3c\x51\x42\xf7\xcc\x7f\x5e\x94\xa4\x27\x5e\x19\x45\x33\x36\x56\x99\x0c\x93\x49\xd1\xa3\xdd\xeb\xd6\x7d\x47\x39\x10\xee\xa7\xf0\xba\x6e\x35\x5c\xae\x33\x76\x2b\xa3\x89\x4f\xb8\xfe\x77\x5b\x71\xba\xba\x39\x29\x26\xe9\xd4\x19\xcd\x4b\x20\x7c\x27\x42\x55\x77\xc9\xec\xd4\xd5\x97\x5d\xd3\x87\x28\xf2\x3f\x16\x75\x30\x17\xaf\x5f\xf0\x8e\xaf\xeb\xb7\xf9\x7b\x7d\xf8\x47\x7d\xf8\x67\x7d\x78\x73\xf4\x9e\x5f\x9c\x82\x2b\x6a\x05\x63\xc5\x35\xfa\x3e\xc8\x9c\x4b\x60\x1d\x13\x53\x98\xb2\x5e\x90\x05\x14\x13\x8a\xb1\xcb\xfd\x3d\x9f\x1f\xfc\x18\xe0\x53\x6c\x3c\xac\x8a\xb5\x50\xa1\x85\x05\x41\xc4\x2e\x85\xeb\x30\xee\xe6\x02\x16\xb9\x40\x9a\x7b\x09\x17\x92\xd0\x7f\x49\x8c\xb6\x22\x4c\x5c\x6e\xcf\xe5\x6c\x6c\xd5\xe0\xec\x5e\xbd\xfa\x37\x00\x00\xff\xff\x07\x20\x35\xe5\xf4\x13\x00\x00")
+var _kubernetesparamsT = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x98\x4f\x6f\xdb\x38\x10\xc5\xef\xf9\x14\x03\x9d\x5a\x20\x71\xba\xbb\x41\x0f\xb9\x65\xed\x02\x09\x82\xa4\x46\xd2\xcd\x65\xb1\x87\x11\x39\xb2\x88\x48\xa4\x3a\xa4\xe2\x3a\xae\xbf\xfb\x82\x94\x1c\x2b\xb1\x63\x58\x7f\x0a\xd4\x27\x43\x12\x1f\xdf\xef\x91\x94\x38\x04\x00\x88\xb0\x50\xf7\xc4\x4f\xc4\x63\x62\xa7\x12\x25\xd0\x51\x74\x0e\xcb\x23\x08\xbf\x28\x27\x87\x12\x1d\x36\xae\x01\x44\x92\xac\x60\x55\x38\x65\x74\x74\x0e\xd1\xb7\x94\x20\x46\x4b\xf0\xf9\x0c\x6c\x50\x03\xb1\x91\x83\xd2\x92\x04\xa3\xc1\xa5\x04\x39\x5a\x47\x1c\xd5\x52\xab\xe3\xfa\x4f\xe4\x16\x85\xef\x37\xb2\x8e\x95\x9e\x45\x47\x8d\xbb\x1b\x8f\x53\x56\x4f\xe8\xe8\x9a\x16\x43\x58\x2c\x2a\x35\x78\xa4\xc5\x0e\x8b\xa3\x3d\x1e\x49\x94\x4c\xbb\x9c\x0a\x1c\x2a\xc6\x66\x7e\x58\xba\xd4\xb0\x72\x8b\xe6\xd5\x76\x11\x0a\xdc\x99\xdd\x72\x39\x35\x45\x99\xa1\xa3\x71\x86\xd6\x2a\x71\x63\x24\x4d\x28\xc1\x32\x73\x0f\x98\x95\xf4\xa6\xe5\x6a\xd5\x19\x68\x7c\xf1\x4b\x02\xcf\x14\x69\x37\x58\xe8\x41\xed\x55\xf6\x61\x62\x38\x03\xc2\xe4\x79\xa9\x43\x17\x30\x57\x2e\x6d\x18\x6f\x39\x12\xa1\x8f\x9d\xa3\xd1\xd9\xf0\x56\xb0\x9d\x0d\xbf\x1b\xf4\x63\x19\xd3\xd8\xe8\x44\xcd\x7e\xc5\x0c\x0f\xa6\xe3\x05\x88\x4c\x0d\x1a\xf6\xc6\xf5\x40\x81\x6f\x25\xdd\xd7\xf4\xbb\x81\x4b\x23\x1e\x89\xff\x66\x25\x67\x34\x56\x92\xdb\xad\xda\xad\xd6\x2d\x57\xee\x24\xb4\x87\x38\x74\x0f\x9a\xdc\xdc\xf0\x23\x5c\x4d\x01\xa5\x64\xb2\x16\x50\x4b\xb0\x65\xac\xc9\x75\x18\x91\xac\xf4\x43\xd9\x9e\xea\x6d\xe3\x96\x50\xd7\x65\x4c\xac\xc9\x91\x05\x51\x59\xe8\x8c\x50\xc9\x5c\x2e\x0a\x62\x6f\xea\xbe\x20\xd1\x1e\x65\x97\x48\x4b\x24\xbf\xa0\x84\xd1\x0e\x95\xf6\x34\x05\x09\x48\x0c\x43\xba\xd6\x1c\x75\x45\xbb\x90\xd2\xe8\x1b\xd4\x38\x23\xee\x43\xb7\xa5\xf3\x5b\x01\xde\x91\x55\xcf\x03\x00\x36\x75\x86\x01\x44\x9f\xdb\x09\x57\xba\x9d\x21\x27\x68\xd3\xd8\x20\xcb\x3e\x84\xaf\x45\x86\xc1\xdb\xa8\x9f\xc8\xb5\xfc\x09\xe6\xf2\xf3\x59\x67\xd6\x2f\x3f\x48\x5c\x12\x66\x2e\x7d\xee\x43\xfb\x56\x66\x18\x5e\xfa\x41\x22\xad\xcc\xf5\xc4\xbc\x24\x2c\xfc\xab\xab\x0f\xe3\x2b\x8d\x61\x00\xd3\x5a\xb2\x33\xd7\xd4\xc8\x2b\x9d\x30\x8e\xd7\xda\x7d\x00\x77\x8b\x0d\x43\x5a\x18\x09\xca\x8b\x77\x46\xf5\xdf\xa1\xc9\xed\x7d\x1f\xc0\xa6\xc4\x30\x58\x5e\x5b\x6a\xdb\x73\x76\x4e\x6e\xef\x6f\xd0\x7e\xef\x83\xd6\x94\x18\x0e\xed\x44\x6a\x9b\xa3\xfd\xde\x89\xaf\xda\x4d\x7d\xd1\x33\xa5\x69\x62\xe6\x3a\x33\x28\xef\xa8\x30\x0d\x33\x91\x6c\xe0\xf8\xaf\x4f\xea\x5c\x61\xcf\x4f\x4f\xb1\x70\x55\xf3\x11\x3e\x97\x4c\x24\x67\x34\xd2\xe4\x4e\xd9\xb7\x3f\x6e\x8d\x57\x69\x01\x05\x2f\x20\x6b\x33\x50\x72\xf6\x82\x5a\xc5\xd8\x12\xb1\xde\xe2\x4d\x4d\xa6\xc4\x62\x1f\xd7\x72\x39\xfa\xca\x22\xf5\x7b\x56\x74\x86\xa7\x6c\x12\x95\xd1\x68\xb3\xb7\xaa\xf6\xdb\xa3\xdb\xa6\xe0\x6a\xd5\x01\xb5\xb6\x04\x45\xf0\x04\xa4\x13\xc3\x82\x72\x5f\xa1\x39\xe3\x0b\x48\xf8\xa0\x8d\xa6\x9f\x21\xd7\x9f\x02\x33\x25\xcc\xc7\x6d\x6a\xcc\x32\x33\x27\x19\x00\x6c\x74\x0e\xff\xd6\x37\x3c\xb4\xd1\xf4\x62\xcc\x1f\x86\x78\xa5\xe6\x85\x4a\x74\xad\xf9\xdf\x41\x49\xfa\x03\x10\x25\x68\xca\x4a\x0b\x55\x60\x36\x0e\x05\xdf\x95\x6c\x86\x7a\x50\x06\x55\x43\xb8\x9a\xc0\x87\x4d\xa9\x61\x4a\x59\xb0\x79\x52\x92\xf8\xe3\x9e\x11\x7e\xb7\xac\xd8\xed\xee\x9e\x04\x93\x6b\xed\xd0\x4f\x48\x7f\x32\xa3\x04\xc1\x8b\x22\xd4\xbe\x2b\xcd\x51\x4b\x93\xcb\xa5\x4a\x60\xd4\xa8\x31\xd7\x13\xec\x12\x6d\xdd\xd5\x85\x10\xa6\x6c\x96\xd0\xf5\x5b\x22\xb2\xef\xdc\x1f\xac\xe2\xab\x3b\x00\xac\x7a\x00\x67\x1e\x49\x5b\x40\x26\xb0\x6a\xa6\x49\x86\x6a\xb5\x3d\x32\x69\xb9\x5a\xb5\x40\x7f\x20\xae\xd2\x51\x46\x5f\xd3\xc2\xee\x0e\xe0\xed\x53\xfd\x62\x28\xe3\x4c\x09\x9f\x82\xdd\x17\xc3\x53\xe8\xb3\x0e\x02\x62\xb2\x4a\x92\x0d\x95\xb0\x0f\x48\xe9\x99\x57\xd8\x17\xd0\xbe\x68\x2e\xd1\xd6\x83\x7a\x47\x33\x65\x1d\xbf\x8c\x7c\xf1\xfa\xb2\x9f\x28\xc4\x9d\x78\xd7\xc3\xcd\xb5\x54\xf0\xae\x72\x9c\x11\x14\x65\x96\x81\x0d\xb3\x1a\x94\x05\xc1\x84\x8e\xa4\x7f\xed\x1e\x0e\xb4\xcb\xed\x3f\x96\x58\x63\xde\xed\x34\xa5\xb4\xc4\xe0\x5b\x83\x49\xc0\xed\x00\xe8\xe7\x6d\x8a\xd6\xce\x0d\xb7\x7f\x79\x79\x6f\x45\xdd\xb8\x8b\xb5\x7d\x0b\x25\x3c\x59\x1d\x07\x7d\x4d\x12\x4b\x6e\xcf\xf7\xea\xd3\x01\xdf\x81\x97\x67\x00\xfe\xd8\xfc\xfd\x73\xf3\xf7\xaf\xcd\xdf\xb3\xad\x6f\xc1\xc1\x71\x98\xe0\x15\x94\x76\xa6\x71\x36\x04\x85\x31\x19\xcc\x53\x62\xf2\xa7\x48\xd6\x21\xbb\x6a\x6e\xf9\xd5\x52\x3f\xf3\x70\x63\x47\x00\xdf\x52\x65\xe1\xc9\x83\x81\x40\x0d\x31\x41\xc2\x26\x87\x4f\xbe\xdd\xd9\x31\xc4\xa5\x83\xbc\xb4\xce\xdf\xc8\xfc\x19\x8d\x4b\x51\xd7\x0a\x63\xbf\x50\xf7\xe4\xad\xb4\x8b\x8e\x00\x00\x56\x47\x47\xff\x0f\x00\x77\x40\x08\xcc\x18\x18\x00\x00")
 
 func kubernetesparamsTBytes() ([]byte, error) {
 	return bindataRead(
@@ -1047,7 +1068,7 @@ func kubernetesparamsT() (*asset, error) {
 	return a, nil
 }
 
-var _kuberneteswinagentresourcesvmasT = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5a\x5f\x6f\xdb\x38\x12\x7f\x6e\x3e\x05\x21\x74\x4f\x31\xa0\x38\xd7\xbd\x97\x43\x0f\xbb\x40\x2e\x4e\x5a\xa3\xeb\xc4\x5b\xa5\x59\x1c\x92\x3c\xd0\xe2\x58\x21\x22\x91\x2a\x49\x39\xc9\x1a\xfe\xee\x07\x92\x92\x4c\x4a\x72\xe2\x36\x9b\x6e\xee\xb6\x7e\x09\x62\xcd\x0c\xc9\xdf\xfc\xe6\x0f\x47\x46\x08\xa1\xe5\x0e\x32\x9f\x00\x17\xf4\x1c\x84\xa4\x9c\x05\x6f\x51\x70\xb1\xc0\x82\xe2\x59\x06\x72\x37\x5c\x3f\x19\xc1\x1c\x97\x99\x0a\x07\x57\x41\x54\xeb\x25\xbc\xb8\x0f\xde\x36\x76\xcc\x37\x25\x53\xc6\x88\x2c\x67\xbb\x8e\xa1\xe5\x72\x78\x82\x73\x58\xad\x0e\xb5\x44\x38\x88\x50\xdf\xc3\xd3\xf9\x5c\x82\x0a\x07\xce\x22\x08\x05\x0c\xe7\xa0\x6d\x66\x9c\x17\x41\xf5\xf5\xaa\xd9\x04\x81\x02\x18\x91\xa7\x7a\xef\x17\x3b\xcb\x25\x9d\xa3\xe1\x58\x1e\x96\x52\xf1\xfc\xfc\xe4\xe8\x6c\xb5\xaa\x25\xdd\x83\x31\x99\x8e\x47\xfa\x30\x3b\xcb\x25\x64\x12\xfa\xa5\x16\x0c\xd4\x5a\x8c\x91\x46\xea\xaa\x59\x3e\xe3\x09\x56\x3d\xc8\xd5\xdf\x7b\x80\xd5\x27\xb9\x48\x38\x4b\xb0\xea\x05\xe8\x7c\xa2\xff\x4e\x05\xcc\xe9\x9d\xc6\x29\x64\x34\xd9\x0b\x23\xa4\xc1\x1e\x33\x02\x77\xbd\x5a\x0d\x72\xee\x72\x85\xe0\x05\x08\x45\x41\x1a\x2f\xf5\x62\xf3\xca\x6e\x0c\xd4\x2d\x17\x37\x31\x24\xa5\xa0\xea\xfe\x9d\xe0\x65\x61\x74\x5e\xd9\xe7\x94\xb4\xcf\xb7\x06\xf0\x55\xe5\x0f\x1f\x21\xad\x54\x1c\x72\x36\xa7\x69\x29\x0c\x12\xd2\x38\x08\x35\x9f\xe5\x52\x60\x96\x02\x7a\x2d\xe1\x33\x7a\xfb\x13\xd2\xee\x45\x6f\xd0\x70\x3c\x3d\x20\x44\x80\x94\x86\x2a\x8e\x41\xe4\x30\xcd\x83\x93\x16\x89\x59\x68\xb9\xd4\xb6\x56\x2b\x87\x3c\x7d\x38\x20\xef\x63\x50\x81\xcf\x76\x1b\x6f\xbc\xe5\x2a\x65\x9a\x63\xa1\x79\xae\x44\x09\x51\x47\xdb\x3f\xf4\x5a\x69\x81\x15\x8c\xa7\x07\x59\x4d\x84\x09\xa8\x6b\x6e\x60\x1c\xdd\x33\x9c\xd3\x24\x68\xdb\x0a\x64\x39\x63\xa0\x7a\xf6\xd8\xeb\x81\xe5\xf2\x75\x4d\x19\x06\x2a\x36\xba\x95\x4b\x3c\x55\x7f\x73\xee\x7f\x1e\xb4\x1a\x86\x4c\x59\x18\x5e\x77\x9c\x10\x75\x0f\xda\xfe\xe6\xca\x12\x8c\x71\x85\xc6\x52\xb3\x6b\xcc\x14\xa4\x02\x2b\x70\xa5\x9c\xb8\x06\xa6\x4f\x32\x9e\x1e\x73\x71\x8b\x05\xa1\x2c\xad\x40\x6e\x51\x69\x1d\xeb\xea\xbe\x30\x0e\x9f\xd0\x44\x70\xc9\xe7\x6a\x78\x62\x89\xbb\x5f\x11\x58\x2f\x29\xe6\x38\x01\x69\x41\x30\xb4\xb4\xac\x9f\x60\x86\x53\x20\x23\x2a\x6f\xa4\x35\xbd\x7c\x5a\x0c\xf7\x85\xe1\xc1\x02\xd3\x0c\xcf\x68\x46\xd5\x7d\x0c\x7e\xb6\xdc\x26\xcb\xc6\x8a\x0b\x9c\x82\xbb\xd7\x70\x53\x44\x37\x40\xb6\xa2\xa2\xc8\xb0\x9a\x73\x91\x1f\xeb\x7c\x3d\xe2\x39\xa6\xec\xb0\x4e\xcb\x3f\x76\x42\xa3\x12\xfe\x54\x10\xac\xa0\x25\xfd\x8f\x20\xaa\x12\x80\x91\xcd\xed\xae\x02\xf4\x16\x05\xda\x4d\x6b\x9e\xad\xa2\x9d\xcd\x2e\x3a\xe4\x79\x51\x2a\xd8\xc7\x3e\x36\xae\x87\x74\x06\x46\xd6\x4d\x15\x02\x07\x49\xe2\x44\xff\x17\x55\xaa\xca\xc2\xd6\x95\xaa\xcf\x8f\xfe\x2e\x64\x55\xb4\xbe\xba\x2a\x35\x4a\x75\xe2\x0f\xbb\x0c\x2e\xca\x59\x46\x93\x26\xee\x40\xee\x87\x5e\x91\xcc\xb1\x54\x20\xa6\xbe\x94\xde\xad\x29\x97\xcf\x56\x97\xa4\x87\x84\x2d\x4b\x20\xc3\xc1\x45\xce\xc9\x2e\x26\x64\x77\x5d\x97\x06\xd1\xe3\x50\x36\x75\x2a\x7a\x74\x8d\x0a\xf4\xc1\xd5\xe3\xa2\xe1\xe0\x82\xd0\xc5\x9f\xb0\x9d\x75\xe0\x5b\xe1\xc6\x1f\x9b\x6a\x70\xc3\x04\x6c\x15\xce\xaa\x70\xf1\xba\x8e\x3c\xa6\xbf\x83\x9c\xe0\x22\x1c\xf4\xb2\xf3\x7c\xa2\x05\xc2\xc1\xd5\xd0\xdf\xaa\x36\x76\xd5\xe5\x62\x37\x24\x2b\x10\xf6\x7d\xf5\x75\x44\xa2\xba\x20\x0c\xdf\x63\xe9\x64\xcc\x17\x1d\x88\x04\x2b\x4c\xa8\xbc\xf9\xe5\x7b\x40\x76\x1b\x45\x0d\x4e\xdc\xa3\x19\x03\x90\x16\xfd\xff\xfc\xc8\x7d\x51\xfb\x6e\xcc\x8e\xb0\xc2\xff\x8f\x61\xbe\x6e\xb5\x5e\x60\x43\xd4\x77\xed\xf4\xb1\x7e\x08\x80\x6d\x5a\x8f\xce\xe9\x37\x37\x8c\x5f\x9c\xfa\x36\xf5\x71\xad\x3b\xe7\xd7\x63\xe1\x37\xca\xdf\xfa\x42\xbe\xc8\x75\xae\x3d\xe1\x04\xb6\xbd\x96\xf7\x76\x77\x9b\x12\xf1\x06\xfa\xee\x87\x5b\xa5\x95\x3a\x1d\xea\xf4\xb2\xe5\xdd\x39\xf2\xb2\xfb\xdd\xf9\x44\x4e\x41\xf8\x5b\x7e\x21\xb9\xf2\x7f\xf1\x50\x0f\xf6\x4a\xbd\xdd\xc6\xf3\x12\xe3\x45\xe1\xf8\x05\x75\xee\x8f\xe4\xd1\x5f\x00\x83\x47\xeb\x77\x9d\x43\x3b\xf3\xab\x07\x7a\xc3\xce\xb8\xa1\xd5\x1b\x3e\xc3\x34\xaf\x7f\x43\x9b\x2a\xdc\xa6\xfd\x74\xea\x71\x5f\xab\xaa\x70\xba\x9e\x2f\xb8\xc5\x44\x80\x29\xff\x31\x2f\x45\x02\x66\x0e\xd0\x6c\x09\x27\x12\x58\x4a\x19\xec\x6d\x89\xc4\x57\x21\x20\x40\x9a\xb5\xb5\x50\x5c\xce\xe7\xf4\xce\xee\xc2\x31\x71\x4b\xd9\x47\x47\xaa\x5e\xd0\x33\xc3\x45\x72\x0d\x52\x09\xac\xb8\xe8\x18\x70\x1f\x6a\x0b\x55\xf5\x3d\xc3\x69\xcb\x4a\xc1\x79\xa6\x05\x8c\x85\x66\xe7\xdd\x52\xf8\xec\x23\xe2\xa7\x8f\x86\xd7\x7d\x88\xcf\x8f\xd6\x2c\xb2\x9e\x42\xd6\x6e\x18\x93\x6d\xb8\x18\xf6\x57\x81\xcd\x4c\x74\xc0\x43\x28\xb8\xc6\x82\xdc\x62\x01\x53\xc1\xe7\x34\x83\xf6\x96\x6c\x1b\xbd\xb1\x01\x6d\x9a\xe8\x7e\xe3\x55\x20\x6f\xb0\xdd\x9d\x2a\xfa\x23\xec\xf6\xec\xed\x31\x84\x36\xa6\x8f\x30\x7a\xc6\x37\x02\xed\x89\xb0\x3b\xc4\xbd\xea\x45\x85\xcb\x0d\x80\x24\xd6\xc5\xe2\xdb\xb0\xd4\x00\xbc\x7c\x07\xea\x43\x39\x03\xc1\x40\x81\xfc\x8d\x32\xc2\x6f\xe5\x41\x0a\x4c\xd9\xf7\x18\x3a\xad\xa3\xa1\x37\x99\x0e\x30\xc9\x29\xfb\x24\x9d\x7d\xfa\x09\xc2\x98\x70\x65\xc2\xd6\xaa\xd6\xc2\x14\x4b\x79\xcb\x45\x67\xf2\xee\x5a\xa8\x65\x36\x32\xac\x2a\x55\xfd\x80\x9a\xd3\xe9\x13\x98\xb6\xa7\x7d\x0c\x9a\xe3\x14\x3e\xc2\x1c\x04\xb0\xa4\xad\xaa\xdd\x34\x9f\x83\xe8\xdc\x17\x34\x34\x15\x4c\xa7\x5a\xa0\x7d\x36\x1d\xfd\xe5\x2c\xa3\xf2\xfa\x61\xe5\x69\x2d\xd4\x63\x40\xde\x94\x0f\xa9\xc6\x37\x65\x8f\xd2\x62\xc3\x05\xc7\x51\xac\x72\x6d\xeb\x25\xc6\xca\x73\x0d\x37\x3d\x62\x17\x0d\x53\x9d\xe0\xb4\xa8\x53\xed\xb1\xe0\xf9\x58\x23\xe8\xb3\x3f\x0a\x12\x9c\x5c\xdb\x97\x0d\xc1\x47\xc0\xe4\x37\x41\x15\x04\x8f\x5f\x51\xac\xf2\x33\x32\x3f\x0a\xf7\xb8\x24\x54\xde\x74\xde\xe1\x44\xc1\xe2\x9a\xf4\xbc\x17\x0a\x4a\x41\xdd\xcd\x88\x9a\x2b\xbb\xdf\x6f\x53\x7f\xcd\xdb\x54\xf4\xf0\x24\x76\x30\xac\x5e\x67\x1e\x31\x52\x70\xca\x94\x1c\xce\x32\x3e\x8b\x42\x4b\xbc\x6d\xbb\xd8\x6d\xc1\x42\x35\xa3\x87\x8b\x6b\xd2\x61\xf5\xba\xe5\xb6\x2f\x0e\x01\x0d\x4f\x63\x1d\xdb\xba\x62\xbf\xfb\x37\xfa\x7b\x27\xf8\x48\xf3\x50\x07\xc3\xd2\x13\xef\xe9\xe0\xdd\x52\xb7\xcd\xcb\xc4\xba\x81\x59\x50\xa1\x4a\x9c\x4d\x4c\x9e\x80\xd6\xbc\xec\xa9\xd3\xab\x17\x3b\xa3\x69\x54\x1f\xb8\x66\xb4\x90\xf9\x83\xf9\xf2\xe4\xb1\xf9\xd6\x2e\xdd\x87\x3b\x05\x4c\x9a\x5f\x25\x7c\x8b\xd6\x3b\x42\xe1\x7e\x22\xe1\x81\x31\xa6\x73\xad\x70\x8b\x73\xe7\x24\xae\x8f\xeb\xe3\xda\x46\x28\x4e\x04\x2d\xd4\x51\x7d\xb0\xb6\xe0\x7b\xcc\x48\x06\xc2\xe1\xec\x9b\xe1\x3f\x5d\x21\x5c\x2a\xfe\xa9\x48\x05\x26\x30\xa1\x8c\x3b\x92\xfe\x0f\x1f\x02\x09\x4a\x51\x96\xfa\xd3\x57\x7b\x20\x05\x89\x02\x12\x3b\x02\xad\xee\x31\xc7\x8c\x9c\xf1\xa3\x3b\x48\xf4\x59\xdc\x0b\x64\xc1\x6f\x41\xc8\x6b\xc8\xb2\x21\xdc\x01\xda\xb3\x32\x94\xb3\x29\xcf\x68\x72\x8f\x3e\x31\xa1\x6f\x65\x54\x2f\x80\xf6\x2a\x53\xe8\x32\x08\x23\x14\xbe\xc6\x22\x2d\x73\x60\x4a\xa2\x9f\x90\xcf\x49\x49\x59\x9a\xc1\xaf\x25\x57\x10\xea\x0a\x3b\x31\x6f\x6d\xc6\x53\xe4\xd5\xbd\x9b\xa6\xc1\x3c\x98\x8e\x63\x10\x0b\x2d\xa2\xe5\xd1\x9e\xee\x3d\x47\x4c\xea\x2f\x69\x02\xe3\xa2\xab\xe8\x3e\xb5\x3a\x76\x91\xe3\x5f\x47\x27\x96\x29\xbe\x8e\x7d\x71\x74\xfc\x99\xb0\x86\x47\x21\xda\xfb\xa5\x22\xb4\x2f\xbb\xa6\xb9\x96\x31\x6d\xef\x07\xb8\xf7\x65\x92\x8c\x82\xae\x16\xe6\x27\x27\x1f\xe0\xbe\x92\xfd\xbd\x14\xf0\x9e\x4b\xa5\x69\x8d\xfa\xef\x60\x2d\x36\x6f\xdd\xa7\xa0\xbd\x83\x83\xd1\xa1\x59\x76\x4c\x7c\xdb\xd2\x22\x31\x15\x94\x25\xb4\xc0\x59\x2d\x15\xfa\x6a\x31\x24\x02\xd4\x36\xaa\x56\x32\xf4\xeb\xa4\xe7\x54\x14\xa2\x7f\xb5\xbc\x5e\x75\xe8\x6e\x60\xd8\x71\x81\x11\xbf\x0c\xd0\xcf\xe8\x87\xf8\x3f\xf1\xd9\xd1\x64\xf4\x71\x7c\x7e\xf4\xc3\xe5\xa5\x81\x4b\x77\xe2\x97\x97\xeb\x7b\x45\x0c\xaa\x2c\xac\xfa\x30\xe3\x29\xfa\xf1\xe7\xbf\xbd\xf1\x1b\xfd\x1d\xf7\xef\x6a\xe7\xbf\x01\x00\x00\xff\xff\x41\xb3\x6c\x05\x57\x27\x00\x00")
+var _kuberneteswinagentresourcesvmasT = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5a\x5b\x6f\xdb\x38\xf6\x7f\xae\x3f\x05\x21\x74\xfe\x8a\x01\xc5\xf9\x77\xf6\x65\x91\xc5\x0c\x90\x8d\x93\xd6\xe8\x38\xf1\x54\x69\x06\x8b\x24\x0f\xb4\x74\x2c\x13\x91\x48\x95\xa4\x9c\x64\x04\x7f\xf7\x05\x75\x25\x75\xf1\x25\xad\xa7\x1d\x60\xa3\x97\x44\x3c\xe7\xf0\x9c\xdf\xb9\x92\x0a\x42\x08\xa5\x03\x94\xfd\x58\x38\x26\xb7\xc0\x05\x61\xd4\x3a\x45\xd6\xdd\x0a\x73\x82\xe7\x21\x88\x23\xbb\x5e\x19\xc3\x02\x27\xa1\xb4\x87\x0f\x96\x53\xf2\x79\x2c\x7e\xb1\x4e\x2b\x39\xd9\x9b\x84\xca\x4c\x88\x48\xe6\x47\x9a\xa0\x34\x1d\x5d\xe1\x08\xd6\xeb\x73\x96\x50\x69\x0f\x1d\xd4\xb5\x78\xbd\x58\x08\x90\xf6\x50\xdb\x04\x21\x8b\xe2\x08\x94\xcc\x90\xb1\xd8\x2a\x5e\xaf\x2b\x25\x7c\x88\x81\xfa\xe2\x5a\xe9\x7e\x37\x48\x53\xb2\x40\xa3\x89\x38\x4f\x84\x64\xd1\xed\xd5\xc5\xcd\x7a\x5d\x52\xea\x86\x51\x11\x4c\xc6\xca\x98\x41\x9a\x42\x28\xa0\x9b\x6a\x45\x41\xd6\x64\xd4\xaf\xa8\x1e\xaa\xed\x43\xe6\x61\xd9\x81\x5c\xf9\xde\x00\xac\xb4\xe4\xce\x63\xd4\xc3\xb2\x13\xa0\xdb\xa9\xc2\x62\xc6\x61\x41\x9e\x15\x4e\x36\x25\xde\xb1\xed\x20\x05\xf6\x84\xfa\xf0\x7c\xb4\x11\x39\x7d\xbb\x98\xb3\x18\xb8\x24\x20\x32\x2f\x75\x62\xf3\x46\x91\x5a\x14\xe4\x13\xe3\x8f\x2e\x78\x09\x27\xf2\xe5\x3d\x67\x49\x9c\xf1\xbc\xc9\xd7\x89\x6f\x9d\xf6\x01\xf8\xa6\xf0\x87\x89\x10\x42\x16\x89\xcf\x19\x5d\x90\x20\xe1\x19\x42\x4a\x89\xbb\x6a\x15\xa1\x34\xe5\x98\x06\x80\xde\x0a\xf8\x82\x4e\x7f\x41\xca\xbd\xe8\x1d\x1a\x4d\x66\x67\xbe\xcf\x41\x88\x2c\x54\x34\x81\x75\xc4\x36\xe0\x24\xb1\x97\x6d\x94\xa6\x4a\xd6\x7a\x6d\x39\x26\x5d\x03\x87\xf2\x7d\xa9\x06\x59\x20\xf8\x92\xab\xf1\xce\xd8\xae\x60\x26\x11\xe6\x2a\xce\x25\x4f\xc0\x94\x8c\x50\xd3\xe8\x9a\x69\x85\x25\x4c\x66\x67\x61\x19\x08\x53\x90\x4b\x96\xc1\x38\x7e\xa1\x38\x22\x5e\x43\x4b\x84\x2c\x91\xcc\x29\xc8\x0e\x1d\x3b\x3d\x90\xa6\x6f\xcb\x90\xa1\x20\xdd\x64\x5e\x07\x6b\xc9\x95\x3d\xeb\x41\xdf\x5f\xfa\xef\x19\x0c\xa1\xcc\x61\x78\xdb\x72\x82\xd3\x36\xb4\xf9\xe6\x21\x4f\x3e\xca\x24\x9a\x08\x15\x5d\x13\x2a\x21\xe0\x58\x82\x4e\x55\x1b\x6d\x01\x55\x96\x4c\x66\x97\x8c\x3f\x61\xee\x13\x1a\x14\x20\x37\x42\xa9\xce\x75\xf9\x12\x67\x0e\x9f\x12\x8f\x33\xc1\x16\x72\x74\x95\x07\xee\x49\x11\xc0\x6a\x4b\xbe\xc0\x1e\x88\x1c\x84\xb5\x53\x55\x84\x29\xa6\x38\x00\x7f\x4c\xc4\xa3\xc8\x45\x97\x28\x5b\xa5\x8b\x9a\x08\x6f\xce\xe1\xae\x34\x3c\x5b\x61\x12\xe2\x39\x09\x89\x7c\x71\xc1\xac\x96\xbb\x54\x59\x57\x32\x8e\x03\xd0\x75\xb5\xfb\x32\x7a\xd0\x93\x15\x71\x88\xe5\x82\xf1\xe8\x52\xd5\xeb\x31\x8b\x30\xa1\xe7\x65\x59\xfe\xd9\x72\xba\x89\x3f\xc7\x3e\x96\xd0\xa0\xfe\x87\xe5\x14\x05\x40\x3d\x56\x94\x6b\x65\xa1\x53\x64\xa9\x5c\xa8\xe3\x6c\xed\x0c\xfa\x5d\x74\xce\xa2\x38\x91\x70\x82\x4d\x6c\x74\x0f\xa9\x0a\x8c\x72\x37\x15\x08\x9c\x79\x9e\x96\xfd\xe9\x2b\x30\xdc\xb9\x53\x75\xf9\xd1\xd4\x42\x14\x4d\xab\x16\xb8\x67\x57\xaa\x98\xca\xc2\x6f\xb7\x23\x38\x4e\xe6\x21\xf1\xaa\xbc\x03\x71\x62\x1b\x4d\x32\xc2\x42\x02\x9f\x99\x54\x4a\xdb\xac\x5d\x1e\xac\x2f\x09\x03\x89\xbc\x2d\x81\xb0\x87\x77\x11\xf3\x8f\xb0\xef\x1f\xd5\x7d\x69\xe8\x6c\x87\xb2\xea\x53\xce\xd6\x3d\x0a\xd0\x87\x0f\xdb\x49\xed\xe1\x9d\x4f\x56\xdf\x41\x9d\x4a\x6c\x41\x5c\xf9\xa3\x33\x63\xf5\xf8\xc3\x39\xc3\x4d\x91\x2e\x69\xfa\x1e\xa4\xa9\x9b\x5a\x42\xa3\xf5\xda\xda\xa1\x0c\x16\x9c\x27\xa6\xea\x75\x8a\x55\x15\x7e\xf4\x01\x0b\xad\x04\xfe\xd0\x99\xe5\x63\x89\x7d\x22\x1e\x7f\xfb\x5f\x86\x15\x19\xa6\x71\x29\x70\x4c\x2c\x73\x4e\x17\xc0\x6f\xc4\xf3\x81\x62\x7f\x8f\x54\xfc\xa1\xf4\xae\xc4\x8e\xb1\xc4\x7f\x8b\xbc\xad\x87\xa1\xf4\xeb\x82\xef\x10\x23\x4b\xd7\xc1\xd0\x04\x6f\xed\x7c\xdd\x70\xd0\xb2\xbe\x7f\xa4\xdb\x5d\xed\x2d\x93\x56\xe3\x54\xf8\x7a\x2c\x74\xfd\xff\xfa\x23\xf3\x2a\x52\xc5\xf3\x8a\xf9\xd5\xb0\xd6\x57\x40\x4b\x4c\x5d\x23\x12\xd7\xeb\x8d\x95\xb5\x27\x7c\x4f\x6c\x67\x9f\xfa\xd6\x91\x43\xd9\x40\xa1\x9a\x5f\x67\xe2\x7e\xdb\x7a\xb0\xb5\x8e\x7d\x67\xfd\x2a\xb1\x5d\xb5\xaa\xb3\xa9\x1f\xd6\x5d\x87\x82\x64\x8f\xce\xb0\x07\x7a\x5b\xbd\xfb\xf7\x32\x67\x6b\xf3\x2a\xeb\x8d\x59\x77\x36\x0f\x46\xad\xc3\x73\x63\x30\x3a\xc0\xdd\x54\xb7\x42\x7d\xdd\xa0\x4f\x9f\x56\xef\xea\x9a\xd3\x24\x0e\xea\xd3\xb2\x5e\x78\x39\x64\xad\xd2\x65\x09\xf7\x20\x3b\xd5\x56\x2a\x61\x4f\x00\x0d\x08\x85\xe3\x1d\x91\x78\x15\x02\x1c\x44\xb6\xb7\x22\x72\x93\xc5\x82\x3c\xe7\x5a\x68\x22\x9e\x08\xfd\xa4\x51\x95\x1b\x1a\x62\x18\xf7\x96\x20\x24\xc7\x92\xf1\x96\x00\x7d\x51\xed\x53\x74\xaa\x1b\x1c\x34\xa4\xe0\x98\x44\xcc\x87\xf0\x03\x16\xcb\x96\x14\x7d\xb1\xc1\x17\x00\x85\xec\x92\xe7\x4c\xb6\xd8\xb4\xb5\x06\x57\xcc\x58\xa8\xd4\xc9\x58\x2a\x9c\xda\x4d\xea\x75\x73\x4e\xe1\xc6\x2e\x57\x7c\xbd\xe3\xfa\x47\x43\x33\x1a\x8d\xc5\xfa\x06\xaf\x74\xfa\xc4\xdf\x25\xf2\x6d\xa7\x4b\xad\x0d\x71\xaf\x81\x87\x90\xb5\xc4\xdc\x7f\xc2\x1c\x66\x9c\x2d\x48\x08\x4d\x95\x56\x91\x4b\xfe\xec\x1f\x0d\x6f\xa7\x6a\xd9\xee\x13\x5e\x94\x8d\x1e\xd9\xad\xa2\x62\x9c\xd3\xcc\x5c\xdc\x05\xa1\xde\x62\x65\x3b\x7b\xb8\x7b\xdf\x8a\xa5\xdb\xde\xbc\x32\x7d\xe8\x44\x85\x89\x1e\x40\xbc\xbc\xb8\xf1\xbf\x26\x4a\xd5\x93\xb5\xb5\x8f\xc9\x1c\x38\x05\x09\xe2\x0f\x42\x7d\xf6\x24\xce\x02\xa0\x32\xff\x06\xa0\x4e\x40\xaa\xc7\xe9\x6a\x62\x3f\x22\xf4\xb3\xd0\xf4\xd4\xb6\x7c\x2a\x44\xe8\x34\x66\x66\x97\x12\x66\x58\x88\x27\xc6\xfd\x4d\x12\x4a\x9a\xde\x08\x2b\x1a\x63\x37\xa0\x99\x75\xca\x82\x6c\x78\x6f\x9a\x41\x22\x1c\xc0\x27\x58\x00\x07\xea\x35\x59\x95\x9b\x16\x0b\xe0\x4d\xe5\xb0\x82\xa6\x80\xe9\x5a\x11\x34\x6d\x53\xd9\xaf\xee\x07\xc4\x72\x33\xf3\xac\x24\xea\x10\x20\x1e\x93\x4d\xac\xee\x63\xd2\xc1\xb4\xea\x39\x7a\x68\x8c\x45\x65\x37\xc0\x34\xe0\x54\x56\x67\x73\x62\x1b\x8d\xac\x17\xc2\x75\x5c\x96\xda\x4b\xce\xa2\x89\x42\x50\x17\x85\x90\x63\x79\xd8\x5b\xe6\x17\xf5\xd6\x27\xc0\xfe\x1f\x9c\x48\xb0\xb6\x1f\x1e\xd4\xe3\x1c\xb2\x3e\x3b\xf6\x31\x13\xea\xb2\xa8\x61\xbe\xda\x76\xb5\xf4\x5b\x16\x23\x64\x25\x9c\xe8\xca\xf0\x32\x56\x8e\x8a\x17\x5a\xed\xf9\x36\x83\xf3\x21\xa6\xcc\x3d\x46\xc7\xad\x93\xf0\x77\xd6\xaf\x12\x6b\x8e\xb5\x4e\xe7\x49\xbb\xd8\xda\x1e\x0e\x47\xc5\x07\xba\x0b\xea\xc7\x8c\x50\x29\x46\xf3\x90\xcd\x1d\x3b\x0f\x87\x5d\x27\xd9\x5d\xc3\x0c\x95\x71\x36\x5a\x2d\xcd\xba\xa5\x9e\x7a\xec\xce\x32\x82\x02\x1a\x5d\xbb\x2a\xe3\x54\x1f\x7d\xff\x6f\xf4\xff\xad\x94\xf0\xab\x45\x15\xa2\xa9\x41\xde\x31\xc5\xeb\x0d\x68\x3d\x68\x64\xf8\x86\xeb\x95\x15\xe1\x32\xc1\xe1\x34\xcb\x5e\xed\xe3\x98\xde\x86\x5f\x7b\xc3\xf1\xe3\xde\x69\x54\xac\x77\xed\x94\xee\x41\xe6\x1b\xc7\x4b\xd7\x79\x64\xaf\x91\x76\x67\x97\x9e\xc0\xb3\x04\xaa\x52\x43\xd4\xdc\x87\x2c\xb8\xc8\x3e\xf1\x04\xd8\xbb\x0c\xc6\x46\xcb\x6c\x59\x52\xf1\x6b\xe6\xe6\xe3\x89\xeb\x71\x12\xcb\x8b\xd2\xb0\x26\xe1\x07\x4c\xfd\x10\xb8\x16\xb3\xef\x46\xff\xd4\x89\x70\x22\xd9\xe7\x38\xe0\xd8\x87\x29\xa1\x4c\xa3\x34\x3f\xe5\x5b\x02\xa4\x24\x34\x30\x6f\x2b\x55\xaf\xe7\x4c\x82\x27\xc1\x77\x35\x82\x6a\x39\x0b\xf4\x28\xc2\xd4\xbf\x61\x17\xcf\xe0\x25\xd2\x00\xdb\x8e\xd9\x13\x70\xb1\x84\x30\x1c\xc1\x33\xa0\xe3\x9c\x86\x30\x3a\x63\x21\xf1\x5e\xd0\x67\xca\xd5\xc9\x8c\xa8\x0d\xd0\x71\x21\x0a\xdd\x5b\xb6\x83\xec\xb7\x98\x07\x49\x04\x54\x0a\xf4\x0b\x32\x63\x52\x10\x1a\x84\xf0\x7b\xc2\x24\xd8\x43\xc7\x3e\x9e\x66\x9f\x2d\x26\x33\x64\x74\xa3\xc7\x6a\xec\x3b\x9b\x4d\x5c\xe0\x2b\xe0\x93\x99\xa2\x47\xc7\x6a\x22\x1c\x53\xa1\x5e\x12\x0f\x26\x71\x9b\x51\x5f\xcd\x79\xf2\x4d\x2e\x7f\x1f\x5f\xe5\x91\x62\xf2\xe4\xdf\x26\x2f\xbf\xf8\xb4\x8a\x23\x1b\x1d\xff\x56\x04\xb4\x49\x5b\x87\xb9\x92\x9b\x0d\xa3\x1f\xe1\xc5\xa4\xf1\x42\x02\xaa\x5b\x64\xff\x44\xf1\x11\x5e\x0a\xda\x3f\x13\x0e\x1f\x98\x90\x2a\xac\x4d\x86\xbe\x68\xde\x35\x98\x95\x26\x67\xe3\xf3\x6c\xdb\x89\x6f\xca\x16\x39\x12\x33\x4e\xa8\x47\x62\x1c\x96\x54\xb6\xc9\xe6\x82\xc7\x41\xee\xc2\x9a\x53\xda\x43\xa7\xd7\xa9\xc8\x46\xff\x6a\x78\xbd\x98\x9b\xf5\xc4\xc8\xaf\x0c\x32\xf2\x7b\x0b\xfd\x8a\x7e\x72\xff\xe3\xde\x5c\x4c\xc7\x9f\x26\xb7\x17\x3f\xdd\xdf\x67\x70\xa9\xf9\xf8\xfe\xbe\x9e\xf6\x5d\x90\x49\x9c\xb3\x8f\x42\x16\xa0\x9f\x7f\xfd\xbf\x77\x46\x1b\xab\xba\xca\x00\x21\x84\xd6\x83\xff\x0e\x00\x80\xf3\x82\xcb\x29\x26\x00\x00")
 
 func kuberneteswinagentresourcesvmasTBytes() ([]byte, error) {
 	return bindataRead(
@@ -1067,7 +1088,7 @@ func kuberneteswinagentresourcesvmasT() (*asset, error) {
 	return a, nil
 }
 
-var _kuberneteswindowssetupPs1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x3b\x6b\x73\xdb\x36\xb6\xdf\x3d\xe3\xff\x70\x86\xf1\x07\x79\x36\x90\xed\x36\xd9\xed\x68\xae\xee\xad\x2a\x3b\xa9\x26\xb1\xac\x15\x55\x7b\xee\xad\x76\x6c\x98\x3c\x92\x50\x53\x00\x0b\x80\x52\xd4\x34\xff\xfd\x0e\x00\xbe\x49\xc9\x4e\x27\xf5\x4e\x37\x26\x71\x1e\x38\x87\xe7\x0d\xf8\xbf\x5e\x1d\x1f\x01\x00\x74\xfd\xff\x1d\xdf\x4c\xfc\x91\xef\x1e\xcd\xcf\x44\x8a\x0d\x53\x4c\x70\x05\xb7\xd7\x40\x15\x50\xf8\x90\x3c\xa2\xe4\xa8\x51\x01\x5d\x22\xd7\xdd\xe3\xa3\x14\xfd\xf2\xca\x1f\x4e\x47\x93\xd9\xe8\x66\xfc\xb5\x14\x5e\xfd\xf7\xf1\xd1\xaf\xc3\x75\x18\xa1\xfe\x89\xf1\x90\xf1\x65\xe7\x12\x17\x34\x89\xf4\x84\x4a\xba\x46\x8d\xd2\x47\x3d\xa6\x6b\xec\x7b\xbe\xa6\x3c\xa4\x32\xf4\x4e\xff\x73\x7c\x14\x9b\xe5\x8e\x63\xf7\xab\xd2\x92\xf1\xe5\x7f\xd2\xa7\x5b\x1a\xb1\x90\x6a\x1c\x0b\x3d\x4e\xa2\xe8\x46\x5e\xad\x63\xbd\xeb\x9c\xa6\xeb\x27\xd7\x54\x69\x94\xa3\xc9\xeb\x4c\x80\x5f\xe3\x8c\x57\x0e\xf4\x2c\x11\x23\xcb\x25\x57\x3e\xca\x0d\x0b\x70\x14\xb7\x11\xbb\x36\xfb\xd5\x42\xee\xfa\x27\x5a\x26\xf8\x62\xda\x6e\x83\xef\xfe\x7d\x39\x9e\x48\x5c\xb0\x4f\xdf\x92\xf6\x47\x11\x50\xcd\x04\xff\x96\x34\x07\xe6\x63\x7e\xc0\xdd\x37\xa5\xf9\x47\x22\xf1\x67\xa1\x34\xa7\x6b\xfc\xa6\x84\x07\x97\xc3\x88\x21\xd7\xa3\xf0\x6f\x21\xeb\x63\x20\x51\x1f\x1f\x9d\x1a\xe2\x27\xcb\x48\x3c\xd2\xa8\x37\x1c\x0c\x51\x6a\xb6\x60\x01\xd5\x08\x7d\xf0\x3e\x7f\xbe\x93\x34\x1e\xa8\x5b\x2a\x19\x7d\x8c\x10\xbc\x80\x96\x40\xbc\x2f\x5f\xbc\x02\xdb\xea\xf7\x79\x02\x96\xfd\x5e\x22\x97\x22\x78\x32\xce\x64\xed\xd5\x38\x94\xa1\xe2\x5e\x96\xa0\xa6\xd3\x81\x5f\x83\x99\xe2\x5a\x68\x1c\x04\x01\x2a\x55\x82\xb4\x0e\xc0\xa4\x81\x08\x7a\xf3\xa7\xda\xca\x4f\x8c\x53\xc9\x50\xf9\x03\xff\x97\xe9\xc7\xf6\x0d\x3f\x35\xe0\xaa\x3b\x2e\xd3\xb9\x45\x69\x62\xc9\xf3\x84\x52\xc0\x26\xa5\x08\xb5\xaf\xa9\xd4\xef\x58\x64\xc4\xaa\x8b\xf1\x0f\xf0\xe6\x4f\x0e\x4c\x19\xb0\x6e\xac\x2e\x6a\x14\x26\x52\x7c\xda\xbd\x84\x46\x6c\x00\xdb\xa8\x8c\xa9\x1e\xa3\xde\x0a\xf9\xe4\x22\x1a\xa7\xba\xb4\x3a\x93\x94\xab\x98\x4a\xe4\x55\x28\x5d\x79\xef\x95\xed\x6a\x86\x9c\x1a\x4b\x6e\xd7\x8b\x76\xab\x97\x55\x65\xf8\xc9\xa3\x0a\x24\x8b\x4d\x14\xd8\x87\xa9\x2a\x30\x55\xfc\x29\x2a\x91\xc8\x00\xdf\x4b\x91\xc4\xed\xe8\xb2\x0c\xd2\xe0\xce\x5d\x40\xdf\xcb\x39\x5d\xaf\xe1\x61\x90\x48\xa6\x77\x96\xe4\x7e\x74\xae\x96\x4d\xdc\xdb\xf1\x21\x8e\x1b\x26\x75\x42\xa3\x92\xca\x6b\xf2\x8a\x44\xe3\xcc\xc0\xee\xa7\x21\x2b\x30\x55\xfc\x89\x64\x6b\x2a\x77\x83\x0d\x65\x11\x7d\x64\x11\xd3\x3b\xff\xd0\x7e\xe2\x0a\x7c\x09\xbc\x4a\x76\x8c\x18\x4e\xa8\x0e\x56\x77\x8c\x8f\x07\x33\x63\x8e\x0b\x1a\x29\x34\xe6\xb1\x60\x91\x46\x09\x33\xb6\x46\xa5\xe9\x3a\x86\xcf\xde\x49\xe7\x3d\x6a\x72\x69\xa2\x07\x79\x27\xe4\x9a\x6a\x10\xa7\x3d\x38\xb9\xf7\xbe\x58\x8c\x84\x07\xe6\x63\x1f\x1f\xdd\x49\xa6\x91\x7c\x14\xcb\xce\xc9\x1a\x95\xa2\x4b\x3c\x3d\x3e\xfa\x9c\x46\xb9\xb5\x5a\x1a\x46\xe9\x02\xfc\x59\xb0\x70\x00\x0e\xf9\x26\xd1\x71\xa2\x2d\xf4\xf1\x51\x8d\xfc\xd5\xa7\x98\xf2\x90\xfc\xdf\x68\x62\x9c\xa8\x73\xb2\x60\x11\xbe\x86\x93\x10\x95\x66\xdc\x66\xa6\x12\x3b\xb5\xc2\x28\x82\x3e\x70\xdc\x12\xf1\xf8\x1b\x06\x1a\x48\x20\xd6\x60\xdf\x77\x69\x1c\x47\xcc\x65\xb3\x14\xfe\x0f\x66\x0c\xd2\xa1\x75\x8d\xca\xfc\x98\x06\x29\x93\x53\x07\xb3\x10\x12\x69\xb0\xea\x9c\x30\x8d\x6b\x60\xdc\x22\x75\xcd\x83\xea\x9c\xa6\x30\x9f\x8b\x2a\xa6\x44\x4b\x39\x5a\xe5\x9d\x76\x03\x11\xef\x56\x28\xd1\x91\x4b\xd1\xbf\x34\x84\x36\xaa\x2f\x47\xb3\x4e\x49\xc6\x3f\x58\xbc\x70\xe1\xc4\xc6\xd1\xee\x1f\x2c\xf6\xdc\xd2\x88\x6f\xc4\x13\x92\x3b\x7c\x9c\xe2\xef\x09\x2a\x0d\xe4\x17\xc9\xe0\x40\x98\x35\x9a\xb7\xb1\x29\xa3\xea\x08\x55\x75\x0e\xa4\x02\x02\xe4\xb2\x90\x08\x86\xbd\x79\x63\xf7\xd6\xca\x88\x33\x33\xcb\x70\x57\xde\xff\x96\x71\x4e\x75\x90\x48\xb9\x27\x22\x3a\x80\xae\xda\xa9\x54\x2e\xb6\x80\xce\x0c\x95\x26\x13\xaa\x57\x65\x02\x2d\xea\xdf\x6f\xec\x26\x3b\x97\x00\x1d\x15\xb5\x53\x46\x91\x27\xc8\x37\x3d\x7f\xa7\x34\xae\xa7\x42\xe8\xb9\xfb\xf5\xfb\xef\xe6\xa1\x64\x1b\x94\xaa\xb9\x27\xf3\xe3\x6b\x11\x93\x34\xf9\x81\x83\x28\x16\x35\x7d\x42\xb1\xe5\x70\xb6\x28\xf1\x2a\x96\x59\x40\x83\x48\x95\xb7\x71\xb6\x94\x94\x6b\xf0\x06\xe1\x9a\x71\xa6\xb4\x34\x55\x85\xea\x75\xde\x9d\x7a\x06\xa1\x40\x1d\x8a\x78\x47\x46\xc6\x1a\xcb\xba\x6c\x63\xf2\x18\x84\x18\x32\x0d\x67\x0a\x35\xcc\xae\xfc\x99\x3f\x7a\x3f\x1e\x8d\xdf\x43\xe6\x00\x4d\xcb\x73\x0e\x69\x8b\xa9\xa1\xe0\x0b\xb6\x2c\x7f\x3a\x5a\xbc\x3e\x90\xd1\x2c\x54\xf7\x37\x25\xb8\x97\xd5\x4b\x65\x4c\xe8\xc3\x8f\x5e\x4e\x33\xcb\x39\xa1\xd7\x03\xaf\x9e\xa5\xbc\xd7\x29\x50\x2d\xbd\x94\x40\xab\xc9\x29\x47\xa0\x34\xcc\x8a\x36\x0b\x5d\x2a\xe2\x9a\x30\xae\x02\xab\xc2\xa5\xef\x32\xd8\x6a\x82\x2a\xf1\xaf\x24\xb7\x1c\x3c\x4a\x8b\x66\x0b\x99\x55\xd0\x65\x69\xb2\x94\x55\x95\x24\x7b\x9b\x03\xd6\x73\x58\x05\xbe\xb1\x98\xa1\x6d\x5a\xa8\x67\x29\xad\x90\xa8\x9a\x81\xca\x22\x55\x57\x32\x84\x78\x6f\x5a\x2a\x23\xef\x4f\x5e\x9e\xb5\x35\xef\xc7\x56\x9b\xf8\x13\x6e\x12\xed\xe2\x0c\x41\x1e\x08\xd3\xd8\xc1\xc0\x1f\x8e\x46\x40\x4c\xd0\x89\x8d\xe7\x7b\x75\x03\x4c\x1d\xa3\xd5\x84\x8d\x45\x36\x2d\xf8\x29\x7f\x7b\xc0\x80\x03\x0b\x50\x18\x6f\x81\x94\xda\x2e\x21\xe4\xf8\x88\xc6\x2c\xad\x1d\x7b\xb0\xb9\x38\x3e\x0a\xa2\xc4\x74\x61\xaa\x77\x7c\x44\x20\x7d\xe8\x39\x0a\x41\x51\x63\x13\x9a\xe8\x95\x30\xdf\x8d\x84\x54\xd3\x92\xe6\x2a\x25\x7f\x1a\x65\x14\xca\x0d\xca\x1e\xac\xb4\x8e\x55\xef\xec\xec\xe4\x73\xd6\x8b\x7e\xe9\xbd\x79\xf3\xbd\x01\x32\xed\x8e\xa1\x52\xef\x01\xbd\xe3\xa3\x40\x70\x8d\x9f\x74\xba\x23\xf7\x90\xed\x28\xdd\x5f\x3b\xa2\x81\x48\x54\xfb\x32\xa1\x26\x3e\x79\xcf\xb1\x4e\xa4\x29\x3f\x49\xc6\xb5\x1d\xea\x89\xf1\xb0\x07\x4e\xb5\xc7\x47\x86\xa3\xdb\xeb\x3e\xc2\x25\xde\x76\x7b\x99\x2c\xcc\xb2\x2a\x29\xb9\xa6\xda\x7a\x3f\xe4\x55\x10\x9f\xb0\xf8\x16\x59\x67\xea\x55\x2c\xb5\x64\x00\x2f\x34\xd4\xaa\x9d\xb5\xdb\xe9\x18\xb7\x64\xc4\x17\x92\x0e\x05\xd7\x94\x71\x94\x25\x4b\x0d\xc2\xba\x69\xba\xf7\xa1\x6d\xc0\xe0\x31\x61\x51\x08\x44\xc3\x53\xf2\x18\xa1\xde\x32\x7e\x16\xd3\x44\x21\x74\x0f\xf9\x83\x9b\xa5\xe4\x1d\x89\xea\x9c\xc4\x22\x1c\x8e\x2e\xa7\x25\x0f\x49\x5b\x9f\x81\x5c\x7e\x64\x4a\x1b\x83\xef\x78\x84\xac\xd2\xc6\x9a\x88\x0d\x4a\xc9\x42\xec\x3f\xe4\xca\x2d\x37\xde\xde\x6b\x8f\x90\x58\x84\x84\x19\xc1\xec\xe7\xb7\x92\x11\xb6\xa6\x4b\xec\xd7\x76\x6b\xa1\x4d\x68\x8d\x36\x06\x74\xd1\xf7\xb2\x1f\xb3\x40\x63\x46\x9c\x03\xa8\x7e\xe6\x00\x0f\x27\x9f\x53\xb6\x15\x47\xb0\xf0\x46\xe7\xce\x75\xfb\xa6\x30\xca\xdc\xf8\xb4\x2a\xd8\x50\xac\xd7\x94\x87\x1f\x19\xc7\xd4\x9b\x2d\x70\xda\xca\x75\xf1\x13\xc2\xcb\xc5\x85\xaf\x10\x16\xea\xa2\x02\x21\x34\x8a\xc4\x96\xc4\x92\x6d\x58\x84\x4b\x0c\xfb\xa6\x48\x01\x42\x90\x9b\xf0\x4b\x42\x7c\x4c\x96\x4b\xc6\x97\x64\x45\x79\x18\xa1\x54\xf0\x55\x6a\x01\x42\x52\x47\x27\x21\x57\x85\x0c\xf5\x59\x54\x19\x4e\xac\x29\xe3\xfd\xf4\xb1\x6b\x12\x59\x04\xb0\x4f\xb9\x46\x55\x94\xc9\x98\x71\xb2\x16\x21\xf6\x63\x29\xd6\x4c\x05\x89\x48\x14\x79\x94\x2c\x5c\x1a\x61\x36\xfd\xef\xcc\xb6\x8d\xde\x4a\x3a\x92\xb8\x34\x45\xce\x8e\x94\xa9\x16\xa5\x43\xd9\xff\x4c\xfd\x77\xa8\xc7\x27\x1c\xc1\xbb\xe8\xbe\xed\x7e\xef\x01\xa1\x3c\x6c\xad\x78\x1b\xc0\xff\xf2\x5a\xca\xc7\x9a\xf5\xff\xa3\x0f\x5e\xfe\x35\x02\xc9\xfa\xb6\x63\xf2\x9a\x08\x65\xab\x32\x48\xd0\xc4\x02\xe2\xec\x82\xc4\x49\x14\x91\x58\x8a\xa5\x44\xa5\x48\x88\x34\x8c\x18\xc7\xfe\x77\xe7\x6b\xf3\x1d\x96\x26\x9d\x2b\x12\xa3\x24\xbf\x0b\x95\xa3\x22\x5f\x08\x19\x20\xe1\x22\x44\x6b\x36\x01\xd5\x86\x7e\xff\xc1\x7b\xf0\xbc\xbc\x9a\x6b\x11\xc2\xd7\x76\xe6\xf2\xe0\x79\xf0\x0f\xe8\xd4\x25\x24\xbf\x09\xc6\xcd\xea\xeb\x07\xcf\x3b\x35\xe9\xcf\x92\x3b\x44\xe9\xc7\x87\x3a\x15\x5f\xcb\x87\xd3\x6a\xbe\xb4\x41\xc6\x21\x18\x1f\x7b\x78\x6e\x66\x71\x78\xbd\x4c\xa1\xe2\x80\x7d\xaf\x3a\xf8\x2b\x03\x66\xae\xd0\xf7\xf2\x09\x6e\x79\xb9\x3e\x5b\x69\x7f\x5f\xc6\xa8\xbb\x4d\xdf\x6b\x4c\x75\xeb\xe0\x35\xeb\x2b\xb8\xb4\x2c\x7a\xcd\x1e\x70\x22\xc2\xf7\x54\xe3\x96\xee\x3a\x0f\xcd\x58\x2d\x51\x27\x92\x43\xbe\xd2\x35\x65\xb2\x9d\x6c\x77\xce\x5f\x17\x6f\x23\xaa\xf4\x88\x87\xf8\xe9\x66\xd1\xf1\xba\xde\xa9\xfd\xca\xdd\x0b\xaf\x91\x28\x7c\xd3\xf0\xdb\xfc\x92\x2a\xa0\x8d\xe9\x2b\x98\x19\xa6\x62\xb1\x80\x77\x4c\xe2\x96\x46\x11\x68\x01\xce\xda\x21\x16\xa1\x32\x8f\x9a\x46\x4f\xe6\x5f\x95\x76\x47\xc8\xc3\x58\x30\xae\x55\x17\x3a\xa9\xed\x80\x5a\x89\x24\x0a\x01\x37\xc8\x75\x42\xa3\x68\x07\xa1\x00\xbd\x62\x2a\xf5\x4b\x8e\x5a\xad\x80\x86\x9b\x45\xc6\xc7\xb4\x31\x34\x8a\x62\x29\x4c\xaa\x55\xa0\x34\xd5\x68\xb6\x92\x99\xde\xc3\x89\xcb\x8f\x55\x2b\xea\xa7\x49\x93\x3b\xa9\x20\x32\x61\xf4\xf7\x84\xa1\x06\x42\xd2\xd1\x87\x37\x1e\x5c\x5f\xf5\x9f\x31\xd3\x52\x3f\xda\xce\xa9\x1b\x21\x5f\xea\x15\x10\xfc\x1d\xce\x9b\xe1\xc5\xaa\xf3\xfd\x5d\xff\xc0\xa7\x2d\x80\x5f\x41\x20\xd1\xc8\xc7\x71\x0b\xa5\x91\x5e\x26\x46\x01\x59\x13\x2f\x45\x23\xc4\x35\xaf\xfd\x32\x2e\x21\xae\xf9\xe8\xe7\x3c\x81\x90\xa5\xdb\x47\x3f\xdd\x1e\x3c\xa3\x85\xf2\x26\xcb\xb2\x6d\xd6\x6a\xcb\x74\xb0\x82\x3e\x2c\x51\x93\xcd\xda\x77\x8f\xf0\x27\xfc\x0f\xb8\xdf\x67\xbb\x18\x81\x5c\xfd\x1b\xae\x3e\x69\x94\x9c\x46\x2d\xe2\x9a\xdc\x0b\x1b\xce\x02\x58\x08\x09\xe9\xde\x80\xc5\xc6\x9c\x16\x42\x6e\xa9\x0c\x41\xaf\xd0\xa8\x64\xb1\x60\x01\x98\x80\x9f\x0f\x51\x0d\x50\xc4\x94\x46\x0e\x26\x75\xc3\xed\x68\x52\xb0\x18\x84\x21\xb9\xbd\x4e\x25\x19\x84\x34\x36\x1f\x9e\x5c\x53\x4e\x97\xb8\x46\xae\x6f\x7c\x20\x76\xcc\x96\xb2\x31\xab\x6e\xdf\xf6\x6d\x21\x61\xb7\xae\x86\x57\x30\x50\x8a\x2d\x79\xbe\xdd\xd1\xc4\xec\xc4\x7c\x39\x9a\xf2\x31\xdb\x4c\x9d\x24\x25\x6f\x2a\x47\xc1\x9d\xbc\x29\x94\xea\x15\x34\x9d\xf9\x33\xae\x51\x2e\x68\x80\xc0\xe2\xcd\x1b\xa0\x61\x68\xfe\x33\x99\x03\xbc\xcd\x95\x5e\xd9\x9a\x0e\x3a\xf9\x8e\x4f\xbd\xcc\xca\xe0\xbb\xb7\x6f\xbb\xd9\x7f\xe7\xcf\xd0\x35\x9e\x55\xbc\xda\x47\x79\x21\x64\x1f\xf9\x5f\x26\xf5\xf3\xd8\x2f\x59\x54\x8d\x5e\xfb\x10\x6c\xe2\x8c\xb4\x54\x18\xe7\x86\xdb\xcf\xeb\xb6\x40\x47\x69\xdd\xb6\xa7\x4a\x59\xa2\x06\x93\x38\xd5\xd9\xc3\x49\xa7\x3d\x91\x74\x67\xe2\xa3\xd8\x9a\x12\xfc\x14\x88\x80\x20\x51\x5a\xac\x49\x20\xa2\x64\xcd\x55\xdf\xb0\x64\xa1\xec\x75\x55\x8c\x41\xb7\xf0\x1c\x2e\xc8\x0a\x69\x88\x52\xb5\x47\xe4\x86\x4c\x6e\x8c\x95\x0a\xf5\x92\x90\x9e\x05\x94\xa5\x86\xf3\x94\x9a\x96\xbb\x16\x6d\x94\xb4\x55\x5e\x62\xa1\xbc\x64\x2a\x30\xce\x80\x61\x7f\x2f\xfb\x2c\xb6\xb3\x85\xf5\xad\x4c\xc2\x15\x55\xc0\x85\x86\x1d\x6a\x78\x44\xe4\x40\xad\x99\x63\x68\x63\xfc\x8a\x29\xab\xd6\xd7\x60\xcf\x2e\x2c\x66\x5a\x47\x43\x2c\x45\x60\x8c\x54\x0b\xab\xfd\x12\xd1\xd7\xd6\x15\xf4\x0a\xb9\x01\x5a\xc7\x3a\xda\xc1\x13\x8b\x22\x60\xba\x5b\xc4\x57\x62\xd8\xb6\x88\xd0\x16\x54\x69\xde\xab\x34\xeb\x92\xb2\x97\x3e\x9c\x64\xbb\xea\x83\xad\x4f\xc8\x24\x7d\xb6\x0d\x9d\x9d\x2e\x36\x9b\x81\x09\x55\x6a\xb6\x92\x09\x90\x81\x5c\x26\x26\x4e\x58\x66\x39\xdb\x6a\x1c\x90\x09\xcf\x75\x90\x70\xcd\x22\x48\x45\x00\xa6\x20\xcc\xc5\x28\x50\x5c\x77\x66\xcc\x10\xbc\x2d\x65\xda\x44\x05\x2d\x72\x50\x83\x0e\x46\x6d\xa5\xa2\x73\xbb\x32\xed\xe7\x73\x2a\xaa\xa9\xa9\xce\xcb\x8f\x10\x63\xc3\xcc\x44\xd9\x8b\x73\x55\xfa\x2c\x2f\xdc\x06\xd8\x11\xa8\x51\xa3\xa5\x05\x44\x61\x00\x17\xe7\x55\x80\xea\xd3\x01\x73\xad\x82\xbc\xd0\x6c\x33\xa4\xb4\xf2\x2d\x7f\x08\xa5\x45\xdc\x6a\x91\x5c\x6c\x41\xaf\xa8\x86\x2d\xc2\x8a\x6e\x10\x44\x22\xc1\x19\xa6\x91\x36\x4b\x2f\x19\xb8\xb0\x67\x13\x6d\x46\xf4\xa7\x1b\x00\x4f\xf2\xc7\x9b\x44\x93\x71\x12\x45\x95\x6a\xdc\xfd\xff\xe1\xd2\x2a\xf3\x3e\xeb\x46\x89\xdb\x76\x5a\x39\x95\x7c\xf9\x70\xe1\xe0\xc0\x90\x6f\x7a\xc3\x9b\xf1\x6c\x30\x1a\x5f\x4d\xef\xc7\x57\xb3\xbb\x9b\xe9\x87\xbe\xf7\xb2\xba\xc6\xa1\x8f\x07\xb3\x16\xc4\x46\x55\x5c\x20\x4c\x6e\x2e\xef\xdf\xdf\x19\x58\xbb\xc9\xca\xda\xed\x68\x72\x6f\xbf\xb7\x77\x71\xde\xb5\xff\x3b\xfb\xa1\xd1\x5e\x94\x9a\x27\x1b\xe2\x02\xaa\x83\x55\x1e\xe2\x9c\xd1\x5e\x49\x29\x24\x3c\x9c\xdc\xe7\x53\x44\x68\xb4\x1a\x2f\x9a\xcd\xec\x3b\xe2\xad\x74\x2f\xc5\xc9\x6d\xb9\x85\x31\x02\x8d\xc6\xb3\xab\xe9\xbb\xc1\xf0\xea\x7e\x76\x73\x3f\xb8\xbc\xbc\xf7\xaf\xa6\xb7\xa3\xe1\xd5\xbd\xe9\x33\xda\xd3\x66\x69\xc2\x40\x6c\x91\x92\x26\xab\x4d\xdf\x34\xe8\xf6\x8d\xeb\x9e\xed\x00\x2c\x36\x59\xb3\x6d\x02\x71\x52\x9f\x3c\xb4\x27\xbb\xc6\xe0\xaa\x2a\xcb\x57\xeb\xa8\x7a\x88\xdd\x3a\xc4\x1a\xfb\xfe\xb5\x9f\x99\x6b\xde\x26\x28\x34\xa6\x9c\x7a\x5f\x3a\xd4\x32\xdb\xe4\x4a\xad\x81\x71\xa5\x4d\x45\x9f\xf5\x02\xc3\xde\xfc\x8e\xf1\x50\x6c\x55\x71\xf6\x92\xbe\x98\x98\xb4\xec\xaf\x30\x8a\xe6\x9b\x8b\xee\xf9\x3c\x36\xcf\xee\x94\x0d\x3f\x61\x9d\xb0\xa9\x3e\x32\xa2\x83\x38\xbe\x64\x12\x03\x2d\xe4\xae\x7d\x9a\xb6\x17\x2f\xbf\x4c\xa4\x0e\x18\xcc\x7e\x0a\x97\x4c\xc5\x11\xdd\xd9\xb2\xf1\x43\xbb\x06\x2a\xe0\x98\x9f\x61\xbc\x04\xdc\x6e\x01\x32\xc3\x1b\xfc\x32\xbb\xb9\xf7\x67\x83\xe9\xec\x10\xce\x8d\x3d\x0d\xb5\x1b\xfa\x28\x02\x1a\x39\x2d\x1f\xc2\xb0\xb5\x7a\xc6\xe4\x6e\x34\xfe\xfe\xbb\xfb\x9b\xbb\xf1\xfd\x64\x7a\x33\xbc\xf2\xfd\x67\xf4\x37\x5b\x49\xa1\x75\x84\x70\xf1\xf6\xfc\xfc\x19\x58\x5f\x87\x22\xb1\x26\x50\x64\xde\x48\x2c\x9f\xc7\x42\x29\xab\x58\x28\xe5\xcb\x30\x45\xa2\x87\xa6\xdb\x60\x82\x9b\x4f\x25\x14\xb3\xba\x7f\xf3\x22\x9e\x7f\x05\x73\x2a\x4c\xab\x6a\x87\xae\x70\xf1\x22\xd8\x1b\x1e\x31\x8e\x2f\x04\xf6\x4d\x20\x08\x15\xfc\xf0\xcf\x37\xcf\xaa\xdb\x61\xfc\xb4\xd3\x66\x2b\xe7\x6f\x7e\x78\xfb\xaf\x7f\x36\xc7\x6c\xf5\xf3\x53\xd3\xd0\xba\xeb\x02\xcd\x02\xcc\xc4\x3b\x57\x00\x56\x6c\xf7\x4b\x29\xaf\xe5\xa1\xc0\x46\xbb\x43\xc1\xc0\x75\x71\xdf\x3c\x1c\x38\xb2\x7f\x25\x20\xe4\x98\x7b\x42\x42\x3d\x3e\x1e\xa2\x52\x0f\x0b\xad\xda\xa8\xa1\x60\x8c\x3c\xbc\xe1\xd9\x31\xf3\x33\xe1\x21\x43\xaa\xc6\x93\x17\xf0\xf9\xda\x98\xe2\xb0\xbe\x2e\xaa\x38\x9c\xbf\x16\x57\xf2\xcf\xf0\xa2\xc8\x92\x43\xd7\x63\x8b\xcb\xbf\x07\x62\x44\x19\xb3\x12\x5f\xd2\xcc\x7d\x38\xc2\xe4\xd8\x2f\xf2\xf7\x1a\xf4\x73\x1e\x5f\x03\x7f\x91\xcf\xd7\x70\xfe\x36\xaf\x2f\x59\x58\xb3\x91\x37\xc5\xef\xd5\xa7\x38\x12\x12\x65\xa3\x40\xc0\x74\x01\x94\x70\x65\x39\xd3\xa6\x55\x4a\x14\x7d\xcc\xdc\xc9\x1e\x94\x69\x5c\x83\xbb\x00\xf2\xf3\x87\x8f\xd7\x3d\x6f\x3e\xf7\x6f\xde\xcd\xee\x06\xd3\xab\xf9\x7c\x22\x22\x16\x30\x54\xf3\xf9\x35\x0b\xa4\x50\x62\xa1\xe7\xf3\x11\xd7\xae\x16\xcb\x58\x7b\xdf\x94\xda\x7c\xfe\x93\x14\x5b\x85\xf2\x6a\x9d\x44\xee\x7c\xbf\x4a\x7f\x22\x45\x8c\x52\xef\xbe\x3d\x9f\x74\x4a\x35\xe2\x5a\x52\x6e\xcb\xe7\x98\x6a\xe6\x0e\xdb\xaf\x45\x88\x40\x6e\x69\x94\x20\x9c\x03\xb1\xce\x76\x79\x27\x64\xf8\x8d\x85\xbf\xa6\xec\x6f\x13\xd8\xd2\x4e\x85\xf4\x5c\x60\x9a\xd0\x25\x7a\xa9\x38\xbe\x1d\x71\x67\x42\xae\xb4\x8e\x7b\x67\x67\x8f\x8c\x2f\xbb\x81\x58\xb7\xcc\x48\x5e\x99\xee\xcb\x4e\x0f\xed\x59\x8a\xe9\x77\xf3\x83\xb5\x2e\xc0\x6c\xc5\x14\x6c\x59\x14\xa5\xad\x9e\xeb\xbe\x2c\x57\x17\x45\x41\x8b\x8c\x50\xd0\x9b\xdb\x2a\xfc\x92\x6a\x3a\x1f\xda\x19\x91\xf9\xd5\x37\x96\xec\x5b\x60\x13\x1d\x4a\xad\xf4\x4e\x24\x10\x50\x0e\xd3\xcb\x09\x64\x44\xec\xe8\xc4\x5e\x18\x32\xf9\x0c\xd6\x34\x58\x31\x8e\x0e\x49\x26\xdc\xf1\x77\x9c\xd7\x94\xbb\xc9\xb8\x16\xb0\x75\x5d\x51\x4a\x63\x85\xe9\x76\x4b\x33\x13\x77\x01\xb9\xec\xa4\xf9\xdd\x3c\xf0\xf2\xeb\xf5\x46\x77\x7b\xef\xf8\x76\xbb\x5d\xd8\x32\xbd\x82\xd1\x04\xca\xa7\x26\xad\x24\x43\xb1\xe5\x91\xa0\x61\xde\x62\x3f\xa6\x07\x1a\x56\x94\x84\x17\x37\xd3\xcc\x4f\xfd\x4e\xdb\x1e\xa2\xf6\x57\xb0\x07\x81\x90\x5f\xc4\xa8\xc2\x95\x6e\x27\x1d\x24\x62\xb6\xb5\x8f\x46\x71\x3d\x64\x0f\x89\xa1\x9b\x46\x1b\x45\x4f\xec\xf1\x6d\x7e\x4a\x5f\x3f\x73\x2f\xd1\x6e\x1e\xe9\xef\xa1\xbe\xcd\x37\x18\x65\x71\xd4\xde\xad\xb3\xba\xcf\x66\x2d\x20\x16\x90\x75\xf8\xad\x02\xd4\xcf\xf3\xa1\x98\x3b\xb6\x72\xcd\x0a\x2d\x23\x94\x69\xda\xb2\x21\x43\x4d\x82\x4a\x3f\xd7\x4a\xc8\x38\xd4\xbe\xf8\x0a\xe9\xb8\xa3\x88\xf8\xed\xf6\x68\xcc\x39\xbd\x35\xe7\x0c\x67\x57\x22\xd0\xbc\x42\xb8\x7f\x27\x49\x0c\x26\x04\x46\xa8\xcb\x82\x3c\x9b\xd7\xac\xb3\x3c\x33\x2a\xb3\x2c\xa6\xf8\x28\x84\xb6\x71\x23\x36\x44\x8c\x03\xa5\xfb\xd6\x02\x1e\x11\x70\xb1\xc0\x40\xb3\x0d\x5a\xbb\x77\xa1\x23\xfd\xb4\x67\xc5\xc9\x44\x53\xd7\xe6\x67\x8a\x16\x9e\x18\x09\x12\x8d\xb2\x31\xd3\x4a\xff\x41\x13\xba\x1a\x49\xf8\x15\x3c\x21\xc6\xd5\x90\x06\x71\x22\x63\xa1\x50\xb5\xea\xab\xbb\x27\x6c\xc5\xea\x02\x48\xe6\xf0\x85\xeb\x03\x69\xdc\x15\x68\x9c\x79\x66\x78\xc5\x3d\x9d\xe6\x5f\xa4\x00\xc9\x6e\xc1\x15\x7f\x51\x02\x24\xbb\x6d\x53\xfc\x45\x08\x90\xea\x94\xa3\x3e\xf4\x28\xdd\xe3\xab\xfc\x65\x46\x69\xc5\xdd\xdc\x6b\xfc\x81\x85\x57\xae\x4e\xf6\x4f\x98\xec\x80\xe9\xff\x03\x00\x00\xff\xff\x4c\x40\x07\xda\xe8\x34\x00\x00")
+var _kuberneteswindowssetupPs1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x3b\x6d\x53\xe3\x36\xb7\xdf\x99\xe1\x3f\x9c\xf1\xe6\x03\x4c\x57\x01\xb6\xdd\x3e\x1d\xe6\xe6\xde\xa6\x81\xdd\xcd\x74\x09\x79\x70\x0a\x73\x6f\x79\x06\x84\x7d\x92\xa8\x38\x92\x2b\xc9\xc9\xa6\x94\xff\x7e\xe7\xc8\xb2\x63\x3b\x2f\xd0\xce\xb6\x6c\x07\x62\x9d\x37\x1d\x9d\x77\x2b\xff\xf5\x66\x7f\x0f\x00\xa0\x1d\xfe\xef\xe0\x72\x18\xf6\xc3\xfc\x23\xfd\x0c\xb5\x9a\x0b\x23\x94\x34\x70\x7d\x01\xdc\x00\x87\x9f\xb3\x07\xd4\x12\x2d\x1a\xe0\x13\x94\xb6\xbd\xbf\xe7\xd1\xcf\xce\xc3\xde\x55\x7f\x38\xea\x5f\x0e\xfe\x2a\x85\x37\xff\xbd\xbf\xf7\x6b\x6f\x16\x27\x68\x7f\x12\x32\x16\x72\x72\x70\x86\x63\x9e\x25\x76\xc8\x35\x9f\xa1\x45\x1d\xa2\x1d\xf0\x19\x76\x82\xd0\x72\x19\x73\x1d\x07\x87\xff\xd9\xdf\x4b\x69\xf9\x20\x67\xf7\xab\xb1\x5a\xc8\xc9\x7f\xfc\xa7\x6b\x9e\x88\x98\x5b\x1c\x28\x3b\xc8\x92\xe4\x52\x9f\xcf\x52\xbb\x3c\x38\xf4\xeb\xad\x0b\x6e\x2c\xea\xfe\xf0\x6d\xb1\x81\x5f\xd3\x82\x57\x09\xf4\x22\x11\xd2\xc6\x99\x34\x21\xea\xb9\x88\xb0\x9f\x6e\x22\x76\x41\xf2\x5a\xa5\x97\x9d\x96\xd5\x19\xbe\x9a\x76\x2e\xe0\x87\x7f\x9f\x0d\x86\x1a\xc7\xe2\xcb\xd7\xa4\xfd\x59\x45\xdc\x0a\x25\xbf\x26\xcd\x2e\x99\xc3\xcf\xb8\xfc\xaa\x34\xff\xc8\x34\x7e\x52\xc6\x4a\x3e\xc3\xaf\x4a\xb8\x7b\xd6\x4b\x04\x4a\xdb\x8f\xff\x11\xb2\x21\x46\x1a\xed\xfe\xde\x21\x11\x6f\x4d\x12\xf5\xc0\x93\xd3\x5e\xb7\x87\xda\x8a\xb1\x88\xb8\x45\xe8\x40\xf0\xf4\x74\xa3\x79\xda\x35\xd7\x5c\x0b\xfe\x90\x20\x04\x11\xaf\x80\x04\xcf\xcf\xc1\x0a\xdb\xe9\xf7\x65\x02\x89\xa8\x83\xd5\x89\x9c\xa9\xe8\x91\x9c\xc9\xd9\xeb\x80\xcf\x1c\x95\xfc\x61\x05\xea\xea\xaa\x1b\x36\x60\xae\x70\xa6\x2c\x76\xa3\x08\x8d\xa9\x40\x3a\x07\x10\x9a\xa8\x44\xa7\xb7\x8f\x8d\x95\x9f\x84\xe4\x5a\xa0\x09\xbb\xe1\x2f\x57\x9f\x37\x0b\xfc\xb8\x06\x57\x97\xb8\x4a\xe7\x1a\x35\x45\xa3\x97\x09\x79\xc0\xed\x94\x7a\x53\x8c\x1e\x4d\x36\xcb\x49\x7d\x44\xbb\x8a\x4a\x5d\xd2\x30\x8f\x6c\x09\x12\x2c\x84\x8c\xd5\xc2\xb0\x07\x8f\xbc\x4e\x36\x41\x1b\x5a\xae\xed\x07\x91\x90\xb6\xaa\x4b\x67\x42\xc3\x37\x10\xdc\x92\x78\x09\x5a\x43\x60\xed\xd4\x9c\x34\x28\x0c\xb5\xfa\xb2\x7c\x0d\x8d\x94\x00\x37\x51\x19\x70\x3b\x40\xbb\x50\xfa\x91\xce\xb5\x13\x48\x6e\x2b\xab\x23\xcd\xa5\x49\xb9\x46\x59\x87\xb2\xb5\xe7\x41\xd5\x5c\x47\x28\x39\x39\xc8\x66\x75\xdb\x7c\xf5\xac\xae\x8c\x30\x7b\x30\x91\x16\x29\x05\x97\x6d\x98\xa6\x06\x53\xc7\xbf\x42\xa3\x32\x1d\xe1\x47\xad\xb2\x74\x33\xba\xae\x82\xac\x71\x97\x79\x9e\xd8\xca\xd9\xaf\x37\xf0\x30\xca\xb4\xb0\x4b\xc7\x75\x3b\xba\x34\x93\x75\xdc\xeb\xc1\x2e\x8e\x73\xa1\x6d\xc6\x93\x8a\xca\xeb\xd8\x57\x2a\xb3\x38\x22\xd8\xed\x34\x74\x0d\xa6\x8e\x3f\xd4\x62\xc6\xf5\xb2\x3b\xe7\x22\xe1\x0f\x22\x11\x76\x19\xee\x92\x27\xad\xc1\x57\xc0\xeb\x64\x07\x88\xf1\x90\xdb\x68\x7a\x23\xe4\xa0\x3b\x22\x93\x1e\xf3\xc4\x20\x99\xc7\x58\x24\x16\x35\x8c\xc4\x0c\x8d\xe5\xb3\x14\x9e\x82\xd6\xc1\x47\xb4\xec\x8c\x82\x12\xfb\xa0\xf4\x8c\x5b\x50\x87\xa7\xd0\xba\x0b\x9e\x1d\x46\x26\x23\x32\x88\xfd\xbd\x1b\x2d\x2c\xb2\xcf\x6a\x72\xd0\x9a\xa1\x31\x7c\x82\x87\xfb\x7b\x4f\x3e\x78\xce\xcc\x84\x18\xf9\x05\xf8\x73\xc5\x22\x07\xc8\x91\x2f\x33\x9b\x66\x16\x5a\x33\x33\xd9\xdf\x6b\x90\x3f\xff\x92\x72\x19\xb3\xff\xeb\x0f\xc9\x11\x0f\x5a\x63\x91\xe0\x5b\x68\xc5\x68\xac\x90\x2e\xe1\x55\xd8\x99\x29\x26\x09\x74\x40\xe2\x82\xa9\x87\xdf\x30\xb2\xc0\x22\x35\x03\xf7\xbc\xcd\xd3\x34\xa1\x30\xeb\xe8\x3a\xf8\x3f\x04\x19\x64\x2b\x5f\xa6\xd3\x0a\x53\x1e\x79\x26\x87\x39\xcd\xb1\xd2\xc8\xa3\xe9\x41\x4b\x58\x9c\x81\x90\xd0\xfa\x43\xa4\x6d\xfa\x60\x0e\x0e\x3d\x8c\x67\xbf\x12\xc1\xd1\x32\x39\xad\xaa\xa4\xed\x48\xa5\xcb\x29\x6a\xcc\xc9\x79\xf4\xe7\xb5\x4d\x93\xea\xab\xa1\xed\xa0\xb2\xc7\x3f\x44\x4a\x3a\x28\xc2\x73\xfb\x0f\x91\x06\xf9\x52\x5f\xce\xd5\x23\xb2\x1b\x7c\xb8\xc2\xdf\x33\x34\x16\xd8\x2f\x5a\xc0\xa6\x58\xe9\xa3\x37\xbb\xcc\xf2\xd8\x54\x50\xcd\x09\x89\x31\x1c\xec\x8c\xb0\x4c\x22\x04\x01\x30\x2e\x63\x70\x76\x42\x44\x3e\x71\x33\x05\xd6\x4d\x26\x4a\x0b\x3b\x9d\x41\xf8\xa9\xfb\xee\xfd\xf7\xc0\x86\xdc\x4e\x4b\x06\x87\xed\x1c\x4c\x22\xec\xe2\xb0\xae\x58\x3b\xd5\x6a\x01\x81\x27\x1a\x15\x92\xcc\x84\x99\x91\x51\xc3\x58\xe9\x1d\x3b\xf5\x1a\x7a\xce\x7f\xd5\x6d\x0a\x58\x4d\x05\xc0\xce\x56\x27\x06\xbd\xd3\xdb\xb5\xd3\x71\x5e\xc4\x72\x37\x72\xaa\x59\x56\xcf\x67\x21\xa4\xe4\x36\xca\xb4\xde\x12\xf1\x73\x80\xb6\x59\x1a\x2f\x15\xa9\x7b\x84\xc6\x7a\x4d\xad\x08\xac\x6b\x61\x87\x33\x53\x21\x5a\x01\xcc\xa9\x98\xa5\x21\x43\x69\xa1\x9c\x9f\x86\x4b\x63\x71\x76\xa5\x94\xbd\xcd\xff\xfc\xf6\xdd\x6d\xac\xc5\x1c\xb5\x59\x97\x89\x7e\x42\xab\x52\xe6\x6b\x06\xc8\x21\x56\x8b\x96\x3f\xa2\x5a\x48\x38\x1a\x57\x78\xad\x96\x45\xc4\xa3\xc4\x54\xc5\x38\x9a\x68\x2e\x2d\x04\xdd\x78\x26\xa4\x30\x56\x53\x31\x66\x4e\x0f\x3e\x1c\x06\x84\xb0\x42\xed\xa9\x74\xc9\xfa\xe4\x6d\x1e\x9b\x54\xb1\x91\xc9\x43\x14\x63\x2c\x2c\x1c\x19\xb4\x30\x3a\x0f\x47\x61\xff\xe3\xa0\x3f\xf8\x08\x4a\x6e\xf3\xac\x3c\xe0\xb8\x1a\xb4\xa7\xe4\x58\x4c\xaa\x47\xc7\x57\x8f\x77\x64\x6c\x07\xd5\xfe\xcd\x28\x19\xec\xef\xad\x63\x42\x07\x7e\x0c\x4a\x9a\x45\x4e\x8d\x83\x53\x08\x9a\x59\x38\x78\xeb\x81\x1a\xe9\xb3\x02\x5a\x4f\xbe\x25\x02\xe7\x71\x51\xeb\x3a\xc2\x65\x91\xba\x09\x26\x2f\x5c\xeb\x70\xfe\x59\x01\x5b\x4f\xc0\x15\xfe\xb5\xe4\x5d\x92\x4e\x7c\xaf\xe1\x68\x16\x8d\x47\xb9\x5a\x49\xc9\xf5\x9d\x14\x4f\x4b\xc0\x66\x8e\xae\x72\x0e\xd7\x16\x0b\xb4\xf9\x06\xea\xd7\x83\x06\xed\x46\x86\xad\x80\xd6\xf3\x73\x89\x90\x6e\x4d\xbb\x55\x3e\xdb\x93\x73\xe0\xe2\x44\xf0\xe3\x46\x9b\xf8\x13\x2e\x33\x9b\xc7\x19\x86\x32\x52\xd4\x0f\x43\x37\xec\xf5\xfb\xc0\x28\xee\xa6\xe4\xf9\x41\x15\x85\x60\xbd\x63\x6c\x34\x61\x8a\x28\xeb\x16\xfc\x98\x3d\xbc\x6c\xc0\x91\x43\x5b\x19\xef\x0a\xc9\xdb\x2e\x63\x6c\x7f\x8f\xa7\xc2\x97\xdc\xa7\x30\x3f\xd9\xdf\x8b\x92\x8c\x9a\x57\x73\xba\xbf\xc7\xc0\x7f\x38\xcd\xb7\x1a\xad\x5a\x13\xc6\x33\x3b\xa5\x04\xb0\x64\x31\xb7\xbc\xa2\xb9\x5a\xa7\xe4\xa3\x8c\x41\x3d\x47\x7d\x0a\x53\x6b\x53\x73\x7a\x74\xd4\x7a\x2a\x5a\xf8\xe7\xd3\x66\x09\x3f\xec\x53\x28\x42\x3d\x54\xda\x3e\xbb\x40\x4e\x0d\x24\x31\x68\x76\xd5\xc1\xfe\x5e\xa4\xa4\xc5\x2f\xd6\x0b\x9b\x7f\x28\x84\xf5\xa2\x6f\x46\xa4\xed\x64\x66\xf3\x32\xe3\x14\xba\x82\x97\x58\x67\x9a\x2a\x6f\xe6\x45\xd8\x02\xf5\x28\x64\x7c\x0a\xb9\xd6\xf7\xf7\x88\x63\x2e\xeb\x36\xc2\x15\xde\x99\x59\x29\xde\xf9\x3c\xab\xea\xbf\xa1\xf5\x66\x87\x19\xd4\x10\x1f\x71\x75\x4c\x45\xaf\x1f\xd4\x8c\xb8\x62\x1b\xaf\xb4\xe1\x15\xc6\x76\x13\x1e\xe0\x82\xf5\xe5\x58\xf3\x9e\x92\x96\x0b\x89\xba\x62\xc4\x51\xdc\xb4\xda\x5c\x96\xd8\xb5\xb4\xf0\x90\x89\x24\x06\x66\xe1\x31\x7b\x48\xd0\x2e\x84\x3c\x4a\x79\x66\x10\xda\xbb\x5c\x25\xef\x03\xcb\x66\xcc\x1c\xb4\x52\x15\xf7\xfa\x67\x57\x15\xe7\xf1\x5d\x5f\x57\x4f\x3e\x0b\x63\xc9\x17\x0e\x02\xc6\xa6\x7e\x54\xc1\xd4\x1c\xb5\x16\x31\x76\xee\x4b\xe5\x56\x47\x19\xc1\xdb\x80\xb1\x54\xc5\x4c\xd0\xc6\xdc\xf1\xbb\x9d\x31\x31\xe3\x13\xec\x34\xa4\x75\xd0\x14\x75\x93\x39\x81\x8e\x3b\x41\xf1\x1f\x2d\xf0\x54\xb0\xdc\x37\x4c\xa7\xf0\x8d\xfb\xd6\x93\x67\xfb\x5a\x1f\x71\x3c\xe8\x38\x22\x77\x1c\x1d\x2a\x17\x0b\xe7\xa7\x25\xa9\x62\x64\x09\x7f\xc0\xc4\x74\x1c\xa5\x5e\xee\x1a\x14\xcd\x3e\xd3\xe3\xe7\xe7\xc0\x97\x20\x85\x6e\x7a\x6a\x36\xe3\x32\xfe\x2c\x24\xfa\x58\xe1\x88\xfa\x46\xb8\x8d\x5f\x10\x5e\xaf\x31\xf8\x0b\xfa\x82\xa6\xb6\x80\x31\x9e\x24\x6a\xc1\x52\x2d\xe6\x22\xc1\x09\xc6\x1d\x2a\x81\x80\x31\x94\x14\xdc\x59\x8c\x0f\xd9\x64\x22\xe4\x84\x4d\xb9\x8c\x13\xd4\x06\xbe\x96\x66\x81\x31\x1f\x46\x58\x2c\xcd\x6a\x7b\xcd\xd9\x61\x15\x4e\xcd\xb8\x90\x1d\xff\xb1\x9d\xa8\x88\x27\x00\xdb\xce\x87\xb4\xc8\x85\x4e\x85\x64\x33\x15\x63\x27\xd5\x6a\x26\x4c\x94\xa9\xcc\xb0\x07\x2d\xe2\x09\xe9\x79\xde\x79\x47\x3b\x22\x95\x56\xd4\xa7\x71\x42\xd5\xd5\x92\x55\xa9\xae\x6a\x16\x78\xd5\xb9\x57\x63\xc0\xb6\x6e\xc0\x67\x07\x57\xcb\x07\x27\xed\xf7\xed\x6f\x7d\x47\xf0\x2a\xe0\x7f\x15\xa6\xe5\xbd\x6f\x93\x07\x7e\xd3\x81\xa0\x3c\xce\x48\x8b\x8e\x6b\x58\x83\x75\x84\xaa\x59\x12\x12\xac\x63\x01\xcb\x1d\x91\xa5\x59\x92\xb0\x54\xab\x89\x46\x63\x58\x8c\x3c\x4e\x84\xc4\xce\xbb\xe3\x19\x9d\xd6\x84\xaa\x0d\xc3\x52\xd4\xec\x77\x65\x4a\x54\x94\x63\xa5\x23\xcc\x55\xc7\x13\x3a\x3d\x4b\x52\x75\xee\x83\xfb\xa0\xde\x5c\x34\x36\x11\x5a\xea\x03\x08\x0a\xbe\x81\x83\xc6\x22\xb0\xdf\x94\x90\x10\xdc\x07\x6f\xef\x83\xe0\x90\xb2\xb3\x23\xb7\x8b\xd2\x8f\xf7\x4d\x2a\xa1\xd5\xf7\x87\xf5\x74\xee\x02\x5d\xce\x9a\x9c\xf4\xfe\xa5\x91\xd1\xee\xf5\x2a\x85\x9a\x07\x77\x82\xfa\x38\xb7\x0a\x58\xf8\x52\xa7\x48\x66\xfd\x61\x75\xb9\x39\xda\xda\xfc\xbc\x8a\xd1\x74\xae\x4e\xb0\x36\xab\x6f\x82\x37\xac\xaf\x13\xec\x58\x0c\xea\xa9\x83\xba\xda\xa1\x8a\x3f\x72\x8b\x0b\xbe\x3c\xb8\x5f\xcf\x17\x1a\x6d\xa6\x25\x94\x2b\x6d\xaa\xe2\xdd\xfb\x8a\x83\xe3\xb7\xab\xa7\x09\x37\xb6\x2f\x63\xfc\x72\x39\x3e\x08\xda\xc1\xa1\x3b\xe5\xf6\x49\xb0\x96\xac\x42\xb4\x2c\x1f\xdb\x7a\x05\x6c\x62\xfa\x06\x46\xc4\x54\x8d\xc7\xf0\x41\x68\x5c\xf0\x24\x01\xab\x20\xf7\x11\x48\x55\x6c\xe8\xa3\xe5\xc9\x23\xfd\x36\xbe\x79\x43\x19\xa7\x4a\x48\x6b\xda\x70\xe0\x6d\x07\xcc\x54\x65\x49\x0c\x38\x47\x49\xe3\xac\x64\x09\xb1\x02\x3b\x15\xc6\xfb\xa5\x44\x6b\xa6\xc0\xe3\xf9\xb8\xe0\x43\x5d\x16\x4f\x92\x54\x2b\x4a\xf7\x06\x8c\xa5\xe9\x90\x1a\x8f\x0b\xd3\xbb\x6f\xe5\x39\xba\x6e\x45\x1d\x9f\xb8\x65\xbe\x2b\x48\x28\x0e\xff\x9e\x09\xb4\xc0\x98\x9f\x3c\x05\x83\xee\xc5\x79\xe7\xfe\x45\x33\x2c\xe2\xd1\x66\x4e\xed\x04\xe5\xc4\x4e\x81\xe1\xef\x70\xbc\x1e\x5e\x9c\x3a\x3f\xde\x74\x76\x1c\xed\x0a\xf8\x0d\x44\x1a\x69\x7f\x12\x17\x50\x99\xa8\x16\xdb\x58\x41\x36\xb6\xe7\xd1\x18\xcb\x7b\xeb\x4e\x15\x97\xb1\xbc\x37\xea\x94\x3c\x81\xb1\x49\x2e\x47\xc7\x8b\x07\x2f\x68\xa1\x2a\xe4\xea\xaf\xfb\xd6\x7c\x66\x16\xc2\x46\x53\xe8\xc0\x04\x2d\x9b\xcf\xc2\xfc\x23\xfc\x09\xff\x03\xf9\xdf\xa3\x65\x8a\xc0\xce\xff\x0d\xe7\x5f\x2c\x6a\xc9\x93\x0d\xdb\x9d\x2a\x63\x61\x2e\x45\xe4\x26\x2a\x5e\x36\x10\x29\x99\xd3\x58\xe9\x05\xd7\x31\xd8\x29\x92\x4a\xc6\x63\x11\x01\x8d\x80\xca\x19\x36\x01\x25\xc2\x58\x94\x40\xd5\x12\x5c\xf7\x87\x2b\x16\xdd\x38\x66\xd7\x17\x7e\x27\xdd\x98\xa7\x74\xf0\xec\x82\x4b\x3e\xc1\x19\x4a\x7b\x19\x02\xa3\x63\x2e\xd8\xd0\x6a\x2e\xb7\x7b\xba\xda\x61\xbb\xa9\x86\x37\xd0\x35\x46\x4c\x64\x29\x6e\x7f\x48\x92\xd0\xc9\x71\xcf\x87\xc4\xf4\x4e\xe2\xc9\x53\x07\xa6\x64\xbe\x5f\x0f\x65\x7c\x59\xbd\x32\x7f\x21\x2d\xea\x31\x8f\x10\x44\x3a\xff\x0e\x78\x1c\xd3\xff\x94\x39\x20\x98\x9f\xdb\xa9\x2b\xbc\xe0\xa0\x94\xf8\x30\x28\xac\x0c\xde\xbd\x7f\xdf\x2e\xfe\x3f\x7e\x81\x2e\x79\xd6\xea\xd1\x36\xca\x63\xa5\x3b\x28\xff\x36\xa9\x4f\x83\xb0\x62\x51\x0d\x7a\x9b\x67\x90\xc3\xdc\x31\x2a\xc5\x79\x69\xb8\x9d\xb2\xf0\x8b\x6c\xe2\x0b\xbf\x2d\xb5\xcc\x04\x2d\x50\xe2\x34\x47\xf7\xad\x83\xcd\x89\xa4\x3d\x52\x9f\xd5\x82\xda\x80\x43\x60\x0a\xa2\xcc\x58\x35\x63\x91\x4a\xb2\x99\x34\x1d\x62\x29\x62\x7d\xda\x36\x29\x46\xed\x95\xe7\x48\xc5\xa6\xc8\x63\xd4\x66\x73\x44\x5e\xdb\x53\x3e\x65\xf3\x9b\xda\x10\x5d\xd7\x42\x7a\x11\x50\x26\x16\x8e\x3d\x35\xab\x97\x1b\xb4\x51\xd1\x56\x75\x49\xc4\xfa\x4c\x98\x88\x9c\x01\xe3\xce\x56\xf6\x45\x6c\x17\x63\xe7\x5b\x7e\x01\xa6\xdc\x80\x54\x16\x96\x68\xe1\x01\x51\x02\x77\x66\x8e\x31\x59\x37\x85\x6a\xa7\xd6\xb7\x14\x88\xb5\x75\x98\xbe\x10\x87\x54\x2b\x7a\xab\x47\x70\xa4\xfd\x0a\xd1\xb7\xce\x63\xed\x14\x25\x01\xcd\x52\x9b\x2c\xe1\x51\x24\x09\x08\xdb\x5e\xc5\x57\x46\x6c\x37\x6c\x61\x53\x50\xe5\xbe\x96\xe9\x6c\x28\x58\xaa\x5e\x7a\xdf\x2a\xa4\xea\x80\xab\x4f\xd8\xd0\x7f\x76\x83\x11\x37\xfc\x5c\xef\x26\x86\xdc\x98\xd1\x54\x67\xc0\xba\x7a\x92\x51\x9c\x20\xd2\x2b\xb6\x55\x0e\x6f\x40\x67\xb2\xd4\x41\x26\xad\x48\xc0\x6f\x01\x84\x81\xb8\xdc\xc6\x0a\x25\xef\x10\xc9\x0c\x21\x58\x70\x61\x29\x2a\x58\x55\x82\x12\x3a\xd0\x21\xf9\xdc\x43\xff\x16\x53\x1a\xe3\xbc\xa4\xa2\x86\x9a\x9a\xbc\xc2\x04\x31\x25\x66\x14\x65\x4f\x8e\x4d\xe5\x58\x5e\x29\x06\xfd\xcb\xd5\xe8\x68\x01\x33\x18\xc1\x49\x25\xd2\xd4\x13\xc4\x0b\xe6\x5a\xfc\x6c\xd8\xd0\x76\xb3\x2d\x90\x7c\xe5\xbb\x7a\xf0\x06\x8c\x55\xe9\x46\x8b\x94\x6a\x01\x76\xca\x2d\x2c\x10\xa6\x7c\x8e\xa0\x32\xed\x34\xfc\xd6\xed\xb6\x48\x2f\x05\xb8\x72\xaf\x86\x36\x19\xd1\x9f\xf9\x7c\xba\xb0\xa1\x7c\x3a\x41\xb7\x2e\x6a\xd5\x78\xfe\x61\x77\x69\x55\x78\x9f\x73\xa3\x2c\x17\xdb\x57\x4e\xfb\x7b\xaf\x2c\x1c\x72\x30\x1a\xb3\xf7\x2e\x07\xa3\x6e\x7f\x70\x7e\x75\x37\x38\x1f\xdd\x5c\x5e\xfd\xdc\x09\x5e\xc8\xe8\xfe\x50\x73\xf4\x41\x77\xb4\x01\x71\xc0\xb7\x22\x0c\x2f\xcf\xee\x3e\xde\x10\xac\x13\xb2\xb6\x76\xdd\x1f\xde\x91\x80\x9d\xe0\xe4\xb8\xed\x7e\x8e\x7e\x58\x6b\x2f\x2a\xcd\x93\x0b\x71\x11\xbd\xde\x28\x43\x5c\x6e\xb4\xe7\x5a\x2b\x0d\xf7\xad\xbb\x72\xc8\xb9\xde\x6a\xbc\x6a\x3e\x54\x6c\xc8\xf3\x2e\x07\x32\xa5\x50\x8f\xb5\x17\xe7\xd5\x16\x86\xb4\xd3\x1f\x8c\xce\xaf\x3e\x74\x7b\xe7\x77\xa3\xcb\xbb\xee\xd9\xd9\x5d\x78\x7e\x75\xdd\xef\x9d\xdf\x51\x9f\xb1\x39\x6d\x56\x46\x14\xd4\xf6\x7d\x59\xfa\x64\x35\xef\x7c\x4b\x03\x08\x7a\x92\xf7\xd8\x6e\x08\x47\xef\xec\x36\x8e\x30\xea\x8d\xce\xd6\x64\xb7\x36\x3c\xab\xef\xe5\x2f\xeb\x68\x85\x4e\x48\x6b\x09\x8d\x06\x69\x83\x30\xbc\xf0\x1d\x50\x79\x6a\x6f\xc0\xa0\xcd\xd2\xc2\xfb\xfc\x60\x8d\x82\xab\x34\x86\x5e\x63\x1a\x4b\x15\xbd\x3f\x05\x7a\xcb\x75\x93\x5f\x88\x58\xbd\x1a\xf2\x0f\x86\x94\x96\x43\x7a\xa9\x79\x3b\x3f\x69\x1f\xdf\xa6\xf4\x39\x7f\x61\x8a\x5f\xfc\x0b\xa7\x15\x61\xaa\x3e\x0a\xa2\xdd\x34\x3d\x13\x1a\x23\xba\x27\xb5\x79\xa2\xb7\x15\xaf\xbc\x22\x66\x76\x18\xcc\x76\x0a\x67\xc2\xa4\x09\x5f\x92\xab\x14\xcf\x76\x82\x63\xf9\x8a\xe5\x35\xe0\x4e\x04\x28\x0c\xaf\xfb\xcb\xe8\xf2\x2e\x1c\x75\xaf\x46\xbb\x70\x2e\xdd\xcb\x68\x27\x10\xbd\x2e\x49\x72\x2d\xef\xc2\x70\xb5\x7a\xc1\xe4\xa6\x3f\xf8\xf6\xdd\xdd\xe5\xcd\xe0\x6e\x78\x75\xd9\x3b\x0f\xc3\x5d\x98\xdd\x34\x1d\x4d\xb5\xb2\x36\x41\x38\x79\x7f\x7c\xfc\x02\x6c\x68\x63\x95\x59\xe8\x55\x33\x6f\xa2\x26\x2f\x63\xa1\xd6\x75\x2c\xd4\xfa\x75\x98\x2a\xb3\x3d\xea\x92\x84\x92\x74\x54\xca\x08\x2a\x39\xe1\xbb\x57\xf1\xfc\x3b\x98\x57\x8a\x5a\x55\xf2\x20\x03\x27\xaf\x82\xbd\x94\x34\x15\x7a\x25\x70\x48\x81\x20\x36\xf0\xc3\xf7\xdf\xbd\xa8\xee\x5c\x94\x9f\x96\x74\x57\xf2\xe4\xf8\xbb\x1f\xde\xff\xeb\xfb\x55\xd9\xb5\xed\xf5\x2e\x35\xb4\x2d\x37\x8b\xf2\xa9\xe6\xa9\xd6\x05\xf8\x02\xd0\x73\x29\xf3\xdf\x7a\x28\x70\xf1\x6f\x57\x30\x70\x00\x5f\x3f\x1c\xe4\x64\xff\x4e\x40\x28\x31\xb7\x84\x84\x66\x7c\xdc\x45\xa5\x19\x16\x36\x6a\xa3\x81\x82\x29\xca\xf8\x52\xfa\xf8\x5a\x1c\xe4\x4b\x48\xf5\x78\xf2\x0a\x3e\x7f\x35\xa6\x38\x92\x7f\x31\xaa\xe4\x38\x7f\x2f\xae\x94\xc7\xf0\xaa\xc8\x52\x42\x37\x63\x8b\x5b\xd8\x15\x23\xaa\x98\xb5\xf8\xe2\x33\xb7\xd6\xaf\xc2\x7e\x95\xbf\x37\xa0\x5f\xf2\xf8\x06\xf8\xab\x7c\xbe\x81\xf3\x8f\x79\x7d\xc5\xc2\xb6\x5d\x79\xa0\xca\xa5\x9b\x08\x6e\xea\xb7\x89\x68\xfc\x61\xfc\xbb\xe2\x97\xaf\x89\xa0\x8d\x6e\x1d\x06\x5d\x70\x78\xd2\x5c\x4e\x10\x3e\xa2\xfd\x54\x10\x39\x97\x96\x26\xaa\xcf\xbe\xf8\xa6\x89\x0f\xbd\xe1\x73\xa3\x2f\xd7\xdf\x55\xf8\xb1\x6b\x9e\x64\x48\x97\xe3\xda\xee\x9e\xda\xd3\x13\xca\xf8\x79\x5d\x7c\xaa\xdd\xcf\xbf\xa4\x89\xd2\xa8\xd7\xea\x1b\xf4\x0b\x60\xa8\x21\xe6\x16\x84\xa5\x4e\x2f\x33\x34\xe9\xc9\x85\xa0\x12\xc9\xdd\x26\xc9\xaf\xd7\x7c\xfa\xf9\xf3\xc5\x69\x70\x7b\x1b\x5e\x7e\x18\xdd\x74\xaf\xce\x6f\x6f\x87\x2a\x11\x91\x40\x73\x7b\x7b\x21\x22\xad\x8c\x1a\xdb\xdb\xdb\x3e\x4d\x55\xa8\x94\x2c\x58\x07\x5f\x95\xda\xed\xed\x4f\x5a\x2d\x0c\xea\xf3\x59\x96\xb8\x44\xd8\xa0\x3f\xd4\x2a\x45\x6d\x97\x5f\x9f\x8f\x1f\xb2\xf5\x25\x0d\x26\xd1\xf6\xd4\x2c\xe5\x56\xe4\xf7\x0c\x2f\x54\x5c\x1e\xcc\x31\x30\x17\x2b\xce\x6e\x94\x8e\xbf\xf2\xe6\x2f\xb8\xf8\xc7\x36\xec\x68\xfb\x4d\x06\x79\x5c\x1d\xf2\x09\x06\x7e\x3b\xa1\x9b\xd0\x17\x9b\xa4\x97\xab\xa7\x47\x47\x0f\x42\x4e\xda\x91\x9a\x6d\x18\xf1\xbc\x81\x90\x06\x28\x0a\x9c\x23\xd2\xcc\x0c\xca\x17\x8b\x6d\x80\x11\x4d\x61\x16\x22\x49\x7c\xa7\x9a\x37\x8f\x8e\x6b\x9e\x04\xc0\xaa\x82\x50\x74\x7a\xeb\x9a\x88\x33\x6e\xf9\x6d\xcf\x8d\xb8\xe8\xcf\x90\x2c\x39\x74\xc0\x14\xdc\x2a\x93\x80\xa5\xca\x20\xe2\x12\xae\xce\x86\xbe\x93\x7f\x43\x92\x10\x0b\x7f\x7f\x19\x66\x3c\x9a\x0a\x89\x39\x12\x4d\x3f\x68\xd1\x73\x9e\x71\x99\x0f\xf6\xad\x82\x05\xc5\x95\x92\xc6\x14\xbd\xb8\x95\x91\x4f\x7e\x2b\xbe\x1a\x63\xca\x9b\x9d\x10\x94\xdf\xf9\x20\xdd\x6d\xbd\x78\xde\x6e\xb7\x61\x21\xec\x14\xfa\xc3\xd5\x97\x31\x5c\xbf\xf9\xf4\x24\xc6\xf0\x89\x9b\x4a\x14\x7a\x7e\xde\xc8\xc9\xfd\xe9\xa7\xb2\x79\xb8\x0a\x9a\x70\x15\x22\x95\xb0\xb1\x91\x5a\xac\x16\x32\x51\x3c\x2e\x1a\x21\x28\xee\x7b\x3b\x7d\x65\x72\x75\x79\x92\x7e\x9a\xd7\x2e\xb7\x10\x75\x7f\x82\x7b\xa5\x0a\xe5\x5d\x9a\x3a\x5c\xe5\x82\xd9\x4e\x22\x24\xd6\x36\x1a\xab\x1b\x3e\x5b\x48\xb8\x4a\x18\x9d\xc5\x0d\xdd\x0d\x88\x5e\xf1\x12\xb8\x79\x37\xa2\x42\x7b\xfd\xea\xc5\x16\xea\x8b\x52\xc0\xa4\xc8\x35\xd4\xa1\xe6\x07\x5c\xcc\xa3\x40\x8d\xa1\x98\x82\x6c\xdc\x40\xf3\xde\x45\x09\xbd\x85\x6b\x51\x8c\xd2\xa6\xa8\xb1\x2d\x06\x31\x8d\x1d\xd4\x7a\xde\x8d\x84\xc8\x6b\xd7\xa2\x43\x85\x4a\x3d\xad\x6c\x24\x31\x24\x9f\xf1\x17\x1f\x73\xc3\x59\x56\x08\xac\xdf\x02\xdd\x2e\x49\x96\x02\xc5\xd9\x04\xcb\x0b\x38\xaf\xca\xfd\xce\x23\x57\xf0\x15\xd7\x6c\xb0\xb8\xc2\x07\xa5\xac\x0b\x4e\x29\x89\x45\x5e\xea\xe5\xb6\x0a\x1e\x10\x70\x3c\xc6\xc8\x8a\x39\x3a\xbb\x77\x87\x59\x1c\xed\x51\x59\x5e\x6d\xd0\x35\xfd\xbb\xa2\x0b\xdb\xda\x32\xda\x41\x66\xd1\x97\xea\xbe\xd6\xa8\xfc\x42\x8a\x8f\x6b\x85\xca\x1b\x78\xa4\xe9\x64\x2d\x6e\x42\x9a\xe9\x54\x39\xf7\xdd\xb0\x99\xf6\x96\xd8\x98\x9a\x13\x60\x45\x54\x59\xc5\x17\x60\xcd\xb7\xc0\xeb\xdf\xe1\x02\xd6\xbc\x4f\x05\xad\xb5\x27\xac\xb8\xc8\xb8\xfa\x2e\x15\xb0\xe2\x56\xd4\xea\xbb\x50\xc0\xea\x93\xa0\xe6\x60\xa8\x72\x15\xb3\xf6\x9d\xa4\xca\x4a\x7e\xf9\x72\xed\xab\x45\x5e\xef\xcf\x2f\x4c\xe1\x5a\x77\xfb\x7b\xcf\xff\x3f\x00\x21\x49\x34\xde\xe2\x37\x00\x00")
 
 func kuberneteswindowssetupPs1Bytes() ([]byte, error) {
 	return bindataRead(
@@ -1127,7 +1148,7 @@ func masterparamsT() (*asset, error) {
 	return a, nil
 }
 
-var _swarmagentresourcesclassicT = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x5a\x4b\x73\xda\x3a\x14\xde\xe7\x57\x68\xbc\x71\x98\x71\x49\x5f\x77\xd3\x5d\x1e\xbd\x1d\xa6\x21\x61\xe2\x36\x77\xc1\xb0\x10\xd6\x01\x34\xb1\x25\x8f\x24\xd3\x52\xc6\xff\xfd\x8e\x64\x8c\x2d\x5b\x06\xd2\x92\xdc\x0b\x0b\x26\x58\x3a\x0f\x7d\xe7\xe8\xe8\x7c\x72\xd6\x6b\x3a\x43\xfd\x81\x0c\x15\x17\x78\x0e\x97\x51\xc4\x33\xa6\xf2\xfc\x0c\x21\x84\xd6\xe6\x1b\x21\x0f\xa7\xf4\x11\x84\xa4\x9c\x79\x9f\x90\x37\x5e\x62\x41\xf1\x34\x06\x79\xee\x57\x23\x1b\x0d\x7e\x6f\xe2\x05\xa8\x14\x8c\x78\xba\xf2\x3e\x6d\x15\x99\x27\x19\x53\x4d\x2d\xeb\x75\xff\x0e\x27\x90\xe7\xb6\x1b\xf2\x5a\x7f\x5b\x1a\x11\xf2\x18\x4e\x40\x2b\x58\x26\xb7\x9c\xa7\x77\x9c\x80\xb7\x19\xcc\x2b\xc3\x04\x52\x60\x44\xde\x6b\x87\xc7\x95\xec\x38\xe2\x2c\xc2\xea\xdc\x1f\xd2\x48\x70\xc9\x67\xaa\x7f\x07\xea\x07\x17\x4f\x17\x69\x36\x8d\x69\x34\x18\x5d\x12\x22\x40\x4a\x90\x17\x7e\x80\x6a\x3e\x26\x58\x2a\x10\x23\x7b\x96\xf6\xda\xef\xf5\x26\xa5\x07\x93\xca\x83\x98\x47\x58\x39\x10\x2b\x9f\xdb\x40\x95\x8b\x2a\x1d\xac\x09\x48\x0b\x93\x91\x80\x19\xfd\x09\xd2\xef\x8d\x13\x4e\xce\x35\xc0\x03\x46\xe0\xe7\x79\x2f\xd8\x2b\xb3\x81\xb3\x37\xd9\x3f\xd5\xef\x8d\x09\x5d\x1e\x5d\xfd\x15\x96\x70\x1d\x63\x29\x69\x54\x40\x17\x54\x16\xde\xf5\x2c\x44\x52\xc1\x53\x10\x8a\x82\xb4\x13\x08\x17\x9a\xbe\xad\x52\x68\x42\xbb\x4c\x42\xfa\x0b\xe4\x10\xa7\x7e\xcf\x99\x5e\x8f\x43\x3d\xc1\xef\x4d\xfa\xb6\x5b\x5a\xd9\xc4\x91\x45\x6a\x63\xa4\xca\x96\x4d\x82\x5e\xd8\xf2\xb2\x90\xcd\x83\xb3\xf5\x1a\x18\xc9\xf3\x33\xb3\xaf\x06\xb2\x48\x17\xd4\x1f\x71\xa1\xe4\xef\xec\xaa\x1b\x98\xe1\x2c\xb6\xf7\xc0\xef\xa6\x96\x0b\x91\x46\x26\x1f\x12\x00\xc2\x64\x08\x4a\x51\x36\xb7\x07\xf4\x10\x4f\x30\x65\x5a\xd5\x2d\x9e\x42\xdc\x69\xf5\x33\x23\x29\xa7\x4c\xdd\xdc\x85\xfa\x41\x91\x40\x7e\xb5\x8d\xea\x41\xd0\x9e\x94\x9b\x2e\x2e\x57\x38\x04\xb5\xe0\x44\xeb\xbf\x59\x31\x9c\xd0\xe8\xa0\xe0\x75\x6e\xf5\x32\x7c\xe8\x48\x01\x3a\x7e\xf5\xe9\x0a\xd8\x31\x4b\x8f\xcb\xdc\xed\xf4\xf0\xc4\x98\xe2\xe8\x09\x18\xd9\xf8\x37\xe2\x3c\x96\xd6\xfa\x91\x95\x2c\xfb\x2d\x5f\x15\xfa\xb4\xa2\xd2\x89\x9a\x7c\xbe\xfd\x7b\x52\xcf\x95\x99\xe0\x4c\x01\x23\x83\xd1\x35\x67\x33\x3a\xcf\x84\x59\xf2\x9f\x79\x52\x2a\x6b\x61\xb1\x1b\x91\x66\xfa\x16\xc0\x38\xa6\x20\xe4\x51\x93\xcd\x63\x01\x92\x67\x22\x82\x01\x39\x28\x4b\xfc\xe0\x59\x39\xd2\xc6\xae\xf9\xab\x03\x55\xca\xa6\x3c\x63\xe4\x0e\xab\x87\x2c\x36\xab\x1c\x5b\xe3\x31\xc7\xe4\x0a\xc7\x98\x45\x94\xcd\xb7\x53\xea\x78\xaf\xcf\xbf\x80\xba\xbd\x32\x63\xc8\xf8\xb9\xa9\x8a\xbd\xbc\xc3\x66\x2a\xf8\xb4\x43\xcf\xc8\x0c\xb9\x14\x3c\xa7\x0c\x54\x4e\x83\xa8\x95\x80\xb2\x84\x17\x69\x52\x36\x48\x43\xcc\xf0\x1c\xc8\x0d\x95\x4f\x72\x6b\xf0\x39\x8d\x51\x5d\x81\xc9\x21\x6d\x28\x96\x90\xe7\xe8\x77\xeb\x4d\xe9\x2a\xfa\xef\xda\x9e\x9d\xfd\xa3\xfe\x04\x2e\xe3\x1d\xa7\x68\xc3\xf4\xce\xc6\xe7\xed\x4b\xb4\x3b\xc7\x51\xda\x6e\x72\xde\x99\x68\xed\x0a\xc7\x11\x10\x79\xf7\x12\x88\x1c\x47\x69\x1b\x91\xf7\x7f\x80\xc8\xa1\x80\xbc\x7f\x09\x40\x8e\xa3\xb4\x0d\xc8\x87\xd7\x48\x91\x0f\x2f\x81\xc8\x71\x94\xb6\x11\xf9\xf8\x1a\x88\x7c\x7c\x09\x44\x8e\xa3\xb4\x8d\xc8\x5f\xe6\x24\xb7\x39\x06\xe3\x4a\xd7\xdf\xeb\x4c\x2a\x9e\x3c\xde\x7d\xfe\xb6\xad\xbd\x81\xcd\x8d\x18\xa8\xc1\x8d\x5f\xd4\xec\x5d\x1c\x65\x7b\x02\x07\x9d\xdd\x90\xa5\x06\x6d\xfb\xcf\xed\x89\x8b\x35\x33\xd8\xfc\xaa\x53\x7f\x01\xa6\x17\x0b\x4d\x87\xe3\xa1\x1a\xdd\xf5\x71\x24\x81\xcd\x29\x83\x37\x1d\x7d\xef\xe3\xb0\xce\x14\x02\xe4\xbf\x59\x26\x52\xd6\x7a\xc2\x3c\x38\x3a\xf3\x3e\xc0\xf8\xfe\xae\x38\x4b\xe7\x02\x13\x18\xf1\x98\x46\xab\x26\x61\x4a\x38\x31\xc6\x2f\x33\xc5\x13\xac\x2a\x1e\xd3\x60\x40\x4b\x2a\x54\x86\xe3\x21\x8e\x16\x94\xc1\x48\xf0\x19\x8d\xa1\xa9\x8c\x15\x47\xba\x7b\xb4\x1a\x1f\x30\x05\x62\x86\x23\xd8\xd9\x20\xa3\x56\x93\x6c\xa1\xc5\x68\xd4\xec\x82\x77\xa1\x50\x9b\x41\xd3\xbd\x76\xbb\xac\xb7\x7d\xa0\x69\x64\x94\xb9\x7c\x71\x7b\xb4\x83\x98\x3b\xc5\xeb\x4d\xe2\xd5\x5e\x82\x73\xe8\x1a\x4a\x2c\xc8\xbe\xcc\x2b\x76\x5b\x80\xfc\x0b\x07\xbb\xea\xe2\x88\x0e\xea\xd4\xe6\x00\xf5\x4f\xf7\xfa\x27\x81\xd5\x0f\x3b\x57\x21\xb3\x29\x03\xd5\x11\xef\xe6\x5a\x9d\xdb\x8b\x81\x0a\x8d\x92\x4d\x69\x79\xae\x9f\xee\xe7\xae\xa7\x13\x67\xa2\x78\xa9\xa0\x09\x16\x7a\x7b\x7a\x4a\x64\xd0\xf6\xa0\xa9\xcb\xfe\x3d\xa9\x33\x28\xcb\x82\xc7\x65\xe7\x7e\xc4\x24\xa1\xec\xbb\x04\xe1\x62\x9f\xd6\xa0\x8b\x73\x46\x3c\x49\x33\x05\xa2\xaa\x4c\xdd\xf8\x0e\xed\x5b\x16\x43\x72\xcc\x36\x08\x7f\x60\x91\x0c\x39\x81\x46\x7c\xd7\xeb\x2f\xa0\x2e\xe7\xc0\xd4\x76\x46\x71\xc2\xdc\x60\x85\x75\xcd\x2f\xb9\xcb\x2e\xa9\x96\x44\x2b\x8d\xbc\x98\xb2\xec\xa7\x55\x0f\x5c\x04\x9a\x50\xa9\x97\x34\xc2\x52\xfe\xe0\x82\x5c\x66\x6a\x01\x4c\xd1\xaa\xca\x9b\x98\xb5\x22\xeb\x49\xb9\x70\x93\xed\x82\xf4\x7c\x85\x55\xd7\xfe\x75\x67\xb2\xf7\x04\x2b\xbd\x1c\x03\x74\x8a\x05\x4e\x40\x81\xd0\x07\xb7\x5c\x3c\x84\x97\xa3\x52\xab\x23\x5c\x95\x69\xac\x16\xcd\x40\x49\xb9\xf8\x0a\xab\x11\x56\x8b\x8e\xec\x6f\x67\xf2\xe4\x70\x46\x6f\xe7\xe3\xa6\xc7\xe8\x4c\x4a\x9a\xe0\x39\x3c\xc0\x0c\x04\xb0\xa8\x3d\xae\x33\x7a\x36\x03\xd1\x5c\x02\x97\x03\x2d\x78\xaf\xc7\x9c\xcb\x2f\x30\x97\x8b\x4e\xd1\x51\x39\xee\x16\x97\x4f\x59\x87\x60\xf8\xf5\xbb\x5b\x64\x59\x71\xe9\x18\x2b\x90\xca\x86\x36\x0f\xda\xc9\xab\xa3\x6b\x18\x3a\xea\x37\x53\x95\x4b\x3d\xe0\x02\x24\x32\xa7\xf2\x5c\xdb\x79\x00\x4c\xfe\x11\x54\xb5\x2a\x48\x50\x74\x3f\x70\x9f\x96\x39\xfb\xb7\xe0\x89\xf1\xff\x00\x12\x5d\xea\xa8\x5e\x7d\x48\xc9\x25\xd1\xfe\xb4\xe6\x2c\x17\xe4\x9a\x33\x85\x29\x03\xe1\x4c\xef\xed\x79\x23\xca\x28\x9f\xbf\x04\xfb\x3a\x31\x82\x6e\x9d\xa4\x8e\xb7\x5a\xa8\xd7\xdf\x1c\x12\xe5\xe5\xb5\xec\x4f\x63\x3e\x0d\x90\x5f\x44\xc2\xb7\xe8\xca\xeb\x62\x7d\x62\xd4\x7f\x1f\xd6\xff\x67\xa8\x4f\xec\x52\xe1\x94\xa1\x3e\xb1\xdb\x8a\x53\x86\xfa\xc4\xae\x41\x8e\x01\x75\x03\xe9\x49\xe3\x5a\x05\x50\xff\x3e\xd4\x27\x7e\x48\x7f\xc1\x97\x2b\xf4\xb6\x71\x1c\x07\xba\x2b\xdd\x0c\xea\xa6\x60\x6d\x4d\x37\x6a\xda\xfd\xae\xfb\x4d\x4b\xde\x7e\x69\x51\x74\x3b\xf5\xbb\x13\x9c\xe2\x88\xaa\x55\x67\x8f\xbf\xf3\x5f\x25\x76\xbf\x0c\xb7\x44\x14\x6d\x77\x68\x0d\x91\x6f\xb4\x68\xd2\x0e\x79\xd7\x72\x5d\xb0\x94\x0b\xfb\x02\x23\x8c\x70\x0c\x21\x6c\xdf\x9b\x9f\xfd\x1b\x00\x00\xff\xff\x76\xa0\xb9\xc0\x87\x22\x00\x00")
+var _swarmagentresourcesclassicT = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x5a\xcd\x6e\xdb\x38\x10\xbe\xfb\x29\x08\x5e\x14\x03\xaa\xd3\xb4\xdd\x4b\x6f\xf9\xe9\x16\x46\xe3\xc4\x88\xdb\xec\xc1\xf0\x81\x96\xc6\x36\x11\x89\x14\x48\xca\xad\xd7\xd0\xbb\x2f\x28\x8b\x92\x28\x51\xb6\xd3\x3a\xc1\xc6\x2a\x82\x58\xc3\x19\x0e\xbf\x19\x0e\xbf\x61\xb3\xdd\xd2\x05\x1a\x0c\xe5\x44\x71\x41\x96\x70\x19\x04\x3c\x65\x2a\xcb\x7a\x08\x21\xb4\xcd\x7f\x22\x84\x49\x42\x1f\x41\x48\xca\x19\xfe\x8c\xf0\x74\x4d\x04\x25\xf3\x08\xe4\x99\x57\x49\x0a\x0b\x5e\x7f\x86\x7d\x64\x14\x03\x9e\x6c\xf0\xe7\xd2\x50\xfe\x26\x65\xaa\x69\x65\xbb\x1d\xdc\x91\x18\xb2\xcc\x76\x43\x5e\xeb\x9f\x96\x45\x84\x30\x23\x31\x68\x03\xeb\xf8\x96\xf3\xe4\x8e\x87\x80\x0b\x61\x56\x4d\x1c\x42\x02\x2c\x94\xf7\xda\xe1\x69\xf1\x12\x21\x3c\x0d\x38\x0b\x88\x3a\xf3\x46\x34\x10\x5c\xf2\x85\x1a\xdc\x81\xfa\xc9\xc5\xd3\x79\x92\xce\x23\x1a\x0c\xc7\x97\x61\x28\x40\x4a\x90\xe7\x9e\x8f\x6a\x3e\xc6\x44\x2a\x10\x63\x7b\x94\xf6\xda\xeb\xf7\x67\xc6\x83\x59\xe5\x41\xc4\x03\xa2\x1c\x88\x99\xf7\x36\x50\x66\x51\xc6\xc1\x9a\x82\xb4\x30\x19\x0b\x58\xd0\x5f\x20\xbd\xfe\x34\xe6\xe1\x99\x06\x78\xc8\x42\xf8\x75\xd6\xf7\x0f\xea\x14\x70\xf6\x67\x87\x87\x7a\xfd\x69\x48\xd7\x27\x37\x7f\x45\x24\x5c\x47\x44\x4a\x1a\xec\xa0\xf3\xab\x19\x2e\xfa\x16\x22\x89\xe0\x09\x08\x45\x41\xda\x09\x44\x76\x93\x7e\xdf\x24\x79\x16\x6c\xb7\x5f\x41\xd9\x69\xa3\x45\x68\x90\x65\x8e\xb4\x50\x85\x56\x15\xfe\x42\xf5\xdc\xf6\x53\xee\x74\x33\xbf\xb7\xdd\x02\x0b\xb3\xac\x97\x6f\x94\xa1\xdc\xc5\x1f\x0d\xc6\x5c\x28\xf9\x3b\xdb\xe4\x06\x16\x24\x8d\xec\xa4\xfe\xdd\x5c\x71\xed\xa0\x46\x6a\x1e\x83\x68\xc8\xe4\x04\x94\xa2\x6c\x69\x0b\x10\xc2\x21\x8f\x09\x65\xda\xf2\x2d\x99\x43\xd4\x39\xeb\x17\x16\x26\x9c\x32\x75\x73\x37\xd1\x83\x77\x19\xe1\x55\xfb\xa2\x1e\x04\x84\x70\xb9\xd7\x22\xb3\xc2\x11\xa8\x15\x0f\xb5\xfd\x9b\x0d\x23\x31\x0d\x8e\x0a\x5e\xe7\xde\x35\xe1\x43\x27\x0a\xd0\xe9\xcb\x49\x57\xc0\x4e\x59\x4b\x5c\xd3\xdd\xce\x8f\x4f\x8c\x39\x09\x9e\x80\x85\x85\x7f\x63\xce\x23\x69\xad\xbf\x42\xf6\xb8\x99\xaf\x76\xf6\xb4\x21\xe3\x44\x4d\x3f\x2b\x7f\xaf\x56\x8e\x10\x5e\x08\xce\x14\xb0\x70\x38\xbe\xe6\x6c\x41\x97\xa9\xc8\x97\xfc\x67\x9e\x18\x63\x2d\x2c\xf6\x23\x62\xa4\x76\x6c\x1d\x43\x10\xc2\x34\xcf\xe6\xa9\x00\xc9\x53\x11\xc0\x30\x3c\x2a\x4b\x3c\xdf\xe5\x70\x67\x8e\xb4\xb1\x6b\x7e\xeb\x40\x95\xb2\x39\x4f\x59\x78\x47\xd4\x43\x1a\xe5\x71\x9f\x5a\xf2\x88\x93\xf0\x8a\x44\x84\x05\x94\x2d\xcb\x21\xa5\x1c\xa1\xed\xf6\xec\x2b\xa8\xdb\xab\x5c\x86\x72\x3f\x8b\xaa\xd8\xcf\x3a\xe6\x4c\x04\x9f\x77\xd8\x19\xe7\x22\x97\x81\xe7\x94\x81\xca\x69\x10\x65\x05\x47\x65\x09\xd7\xea\xdb\x9e\x61\x3c\x23\xc2\xc8\x12\xc2\x1b\x2a\x9f\x4c\x21\x3f\xb2\x42\x14\x47\x46\xdd\x40\x9e\x43\x7a\xa2\x48\x42\x96\xa1\xe7\x58\xab\xd7\x1b\xe3\x2a\x7a\xc1\xc2\x73\x80\xc7\xec\x25\x84\xfa\x9f\xef\x9a\xbc\xe3\x14\x6d\x4c\xed\xa6\x0d\x05\x93\x79\xef\x1f\x1c\xf9\x7c\xfe\xf2\xfe\x85\x58\xcb\x45\x1e\xad\x7d\xe1\x38\x01\x22\x17\x27\x71\xbe\x66\x54\x23\x72\xf1\x42\x88\x7c\xf8\x03\x44\x8e\x05\xe4\xc3\x49\x7c\xaf\x19\xd5\x80\x7c\x78\x21\x40\x3e\xbe\x46\x8a\x7c\x3c\x89\xf3\x35\xa3\x1a\x91\x8f\x2f\x84\xc8\xa7\xd7\x40\xe4\xd3\x49\x9c\xaf\x19\xd5\x88\x7c\x7a\x21\x44\xfe\xca\xd9\x5e\x79\x40\xe5\xb5\x97\x71\xa5\xeb\xef\x75\x2a\x15\x8f\x1f\xef\xbe\x7c\x2f\x6b\xaf\x6f\x9d\x20\x6b\x06\x6a\x78\xa3\x0f\x9e\x03\x3d\x8a\x39\x4b\x90\xdf\xc9\x86\x2c\x33\x66\xfc\xcc\xc4\x0a\x2b\xa2\x3b\x83\xe2\x5b\x45\x75\x70\x20\x20\xe7\x62\x93\x9c\xe1\x60\x54\xeb\x5f\x3d\x12\x48\x60\x4b\xca\xe0\x9d\x1d\xbc\x72\xda\xc7\x51\xbd\x53\xf0\x91\xf7\x6e\x1d\x4b\x59\xe3\x84\x99\xff\x87\xf4\xb7\x70\xe5\x79\x93\x1f\x66\xc5\x69\xb2\x14\x24\x84\x31\x8f\x68\x60\x5f\x6e\x20\x84\x63\x7d\x1d\xf1\x19\xe1\xcb\x54\xf1\x98\xa8\xaa\x8f\xa9\x53\x18\x84\xf0\x9a\x0a\x95\x92\x68\x44\x82\x15\x65\x30\x16\x7c\x41\x23\x68\x1a\x63\xbb\x23\xdd\x2d\xad\xe4\x43\xa6\x40\x2c\x48\x00\x7b\x09\x72\x9b\x24\x5b\x68\x31\x1a\x54\x6b\x3f\x96\x09\xeb\x07\xd3\xe4\xe0\xbc\x5d\xb3\xb7\x7d\xa0\x49\x90\x1b\x73\xf9\xe2\xf6\x68\x4f\x63\xee\x7a\x70\x9d\x24\x16\x0d\x49\x41\x82\x5c\x0d\xce\xb1\x6b\xb0\x49\xff\x9e\xcc\xdb\xed\x36\x1f\x79\xe7\x8e\xee\xaa\x51\xe8\xf6\xb5\x4e\xed\x1e\xa0\xfe\xe9\x5e\xff\xac\xba\xd2\x30\xaf\x9a\x1f\x2c\xd3\x39\x03\xd5\x11\xef\xe6\x5a\x5d\xfe\x3e\x32\x50\x93\x74\x5e\x55\x28\xa3\x74\xac\x9f\x59\xef\xd8\xb7\xf5\x0e\xa3\xfa\xe0\x44\xd0\x98\x08\xbd\x3d\xb1\x12\x69\x79\x43\xd8\x6d\xcb\xfe\x6e\xda\x8e\xe6\xbe\x45\x08\x73\xd9\xb9\x1f\x49\x18\x53\xf6\x43\x82\x30\x09\x5d\x07\xc7\x12\x5a\x95\xa6\xd0\x0e\x78\x9c\xa4\x0a\x44\x55\x99\x3a\xf1\xbd\x6e\x0d\x2d\x0c\x16\x9b\x61\xf2\x93\x88\x78\xc4\x43\x68\x44\x39\xbf\x2c\xbb\x5c\x02\x53\xe5\x88\xdd\x39\x73\x43\x14\xd1\x7d\x8b\xe9\x60\xf6\x69\xb5\x34\x5a\xc9\x84\x23\xca\xd2\x5f\x56\x55\x70\x24\x13\x0e\xa9\xd4\x89\x33\x26\x52\xfe\xe4\x22\xbc\x4c\xd5\x0a\x98\xa2\x55\xad\xcf\x23\xd7\x80\x49\xa7\xa7\x5c\x39\xcc\x95\x7d\xf9\x37\xd8\x74\xed\xe2\xb6\x8e\x7e\xf0\x13\x6c\xf4\x72\xf4\x94\xd3\x84\x08\x12\x83\x02\xa1\x4f\x7a\xb9\x7a\x98\x5c\x8e\x8d\x55\x47\xd0\xcc\x83\x13\xa2\x56\xcd\x70\x49\xb9\xfa\x06\x9b\x31\x51\xab\x8e\x3d\x60\xa3\xd6\xcc\xbb\xf6\x88\xac\x3b\x2b\x0b\xa6\xd1\x99\x9a\x34\x26\x4b\x78\x80\x05\x08\x60\x41\x5b\xae\xf3\x7a\xb1\x00\xd1\x5c\x02\x97\x43\xad\x78\xaf\x65\xce\xe5\xef\x30\x97\xab\x4e\xd5\xb1\x91\xbb\xd5\xe5\x53\xda\xa1\x38\xf9\xf6\xc3\xad\xb2\xae\x3a\xea\x88\x28\x90\xca\x86\x36\xf3\xdb\xc9\xab\xa3\x9b\x37\xfa\xfa\x5a\xd8\x12\x63\x2e\xb5\xc0\x05\x48\x90\x9f\xcd\x4b\x3d\xcf\x03\x90\xf0\x1f\x41\x55\xab\x8e\xf8\x3b\x0e\x04\xf7\x89\xc9\xd9\xbf\x05\x8f\x73\xff\x8f\x68\xa5\x8d\x0d\x53\x2e\x34\x07\xe1\x32\xd4\xfe\xb4\xc6\xac\x57\xe1\x35\x67\x8a\x50\x06\xc2\x99\xde\xe5\xa9\x23\x4c\x94\xcf\x9e\x43\xb0\x6b\x01\x70\x13\xdc\xb7\xd9\xa6\xfb\xc8\x79\xe9\x52\xe0\xe0\xf5\x51\x7f\x50\x1c\x15\xe6\x0a\x5b\x0e\xe6\x11\x9f\xfb\xc8\xdb\x45\xc2\xb3\x9a\x96\xd7\xc5\xfa\x8d\x5d\x00\x1c\xc2\xfa\xff\x0c\xf5\x1b\xbb\x5a\x78\xcb\x50\x9f\xe6\x7a\xe1\xd5\xee\x2c\xde\x32\xd4\x6f\xec\x32\xe4\x14\x50\x37\x90\x9e\x95\x04\x35\x3f\x8d\x19\xa0\xc1\xfd\x44\x9f\xf8\x13\xfa\x2f\x7c\xbd\x42\xef\x1b\xc7\xb1\x8f\xc3\x52\xa8\x49\xc1\xd6\x1a\x9e\x65\xce\xe6\x29\xeb\xb9\x7e\xcf\x7a\x4d\xa6\x56\xb0\x9d\x8a\x69\xe0\x80\x24\x24\xa0\x6a\xb3\x87\xe9\xef\xf9\x0b\x08\xa7\xc6\xe3\x48\x2f\xad\xa9\xa2\x28\x88\x03\x2a\xdf\xe9\x8e\xe3\xb5\xdd\x6e\xff\x8f\x4b\xd1\x80\x9c\xdb\xd7\x18\x93\x80\x44\x30\x01\x25\x71\x0f\x21\x84\xb2\xde\x7f\x03\x00\x36\x3b\x61\xde\x5e\x22\x00\x00")
 
 func swarmagentresourcesclassicTBytes() ([]byte, error) {
 	return bindataRead(
@@ -1147,7 +1168,7 @@ func swarmagentresourcesclassicT() (*asset, error) {
 	return a, nil
 }
 
-var _swarmagentresourcesvmasT = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5a\x4f\x6f\xdb\x3a\x12\x3f\xa7\x40\xbf\x03\xa1\x8b\x6c\x40\xb5\x17\xbb\xb7\x77\xcb\x9f\xf7\xf2\x8c\xc6\x89\x10\xb7\xb9\x04\x3e\xd0\xe2\xc8\x26\x22\x91\x02\x49\x39\xf1\x1a\xfe\xee\x0b\x52\x92\x2d\x4a\x94\x63\x37\x4e\x9b\x45\x9f\x2f\x01\x45\xce\x70\x38\x7f\x7e\x9c\x19\x06\x21\x84\xd6\x9f\x3f\x21\xf3\xf3\x70\x46\x1f\x40\x48\xca\x99\xf7\x07\xf2\x1e\x97\x58\x50\x3c\x4b\x40\xf6\xfc\xdd\xcc\x15\xc4\x38\x4f\x94\xdf\x9f\x7a\xc1\x96\x30\xe2\xd9\xca\xfb\x63\xc7\xc9\x7c\xca\x99\x6a\xb2\x59\xaf\x07\xb7\x38\x85\xcd\xe6\x52\xcf\x5a\x3c\x10\xf2\x18\x4e\x41\x53\x24\x9c\x67\x5e\xf5\x7d\xb3\xdb\x85\x40\x06\x8c\xc8\x3b\x2d\xdd\xe3\xe7\x4f\xeb\x35\x8d\x11\xe3\x0a\x0d\x46\xf2\x32\x97\x8a\xa7\x0f\xb7\x7f\x7e\xdb\x6c\xb6\xeb\xeb\x3b\x2f\x19\xa8\xd1\x95\xde\x51\x13\x02\x23\x7a\x9d\xe1\x30\x92\x61\x3e\x4b\x68\x84\x06\x21\x17\x4a\xea\xef\x67\x08\x05\x6e\xb9\x6f\x66\x2d\x26\xc5\x66\xd3\x9d\x98\x09\x8f\xb0\x72\xe8\xb0\xfa\x6e\xab\xae\x3a\xf4\x63\xc4\x59\x84\x55\xcf\xb5\xeb\xc3\x58\xff\x0d\x05\xc4\xf4\xc5\xef\x07\xc8\x67\x34\xfa\xe2\x07\x48\xab\x7d\xc4\x08\xbc\x38\xa9\xee\xe2\x58\x82\xf2\xfb\x7d\x6b\xbf\x4c\xf0\x0c\x84\xa2\x20\x1b\x06\xa3\xd9\x25\x67\x31\x9d\xe7\xc2\x48\x29\x0b\x25\xa3\xed\x6f\x5d\x1f\xd4\x04\xaf\xe8\x6e\x39\x81\xba\x39\x9d\xbb\x75\x69\x1c\x59\x3f\x2f\xe1\x98\x5c\xe0\x04\xb3\x08\xc4\x05\x8e\x9e\x80\x91\x73\x42\x04\x48\x19\x72\x9e\x94\xa2\x9d\x9d\xd5\x44\xab\x06\x67\xfa\x24\xa4\xae\x50\x7f\x28\xf3\x99\x8c\x04\xcd\xcc\xb1\x86\x7e\x80\xea\x1f\x7a\xfd\x41\x7d\x38\x22\x81\x3f\x14\x20\x79\x2e\x22\xb8\x16\x3c\xcf\x0c\x85\xf5\xa5\xd7\x1f\xe8\xc3\x07\xc8\x1f\x66\x82\x2f\x29\x01\x21\x87\x63\x1a\x09\x2e\x79\xac\x06\xb7\xa0\x9e\xb9\x78\x1a\xd6\x0f\x61\x98\xb8\x1d\x4a\xff\x35\x46\x1d\xce\xda\x27\x1d\xfa\x81\x9b\xaa\xd4\x8a\x5e\x54\x30\x30\x4e\xb9\x53\xc9\x66\x37\xd0\xae\xd9\xf0\xd6\x9a\x7d\xe8\x12\x2b\x18\x85\xe7\x49\xe5\x9e\x63\x50\x0b\x6e\x34\x78\xb5\x62\x38\xa5\x51\xd3\xa8\x08\x79\x32\x9f\x31\x50\xb6\x03\xed\x1c\x89\x74\x86\xfd\x03\x03\x35\x31\xb4\x55\x18\x59\xa4\x0d\xf9\xac\x61\x6d\x30\x75\x60\x83\x5a\x65\xc6\x1b\xdb\x66\x60\xc5\xdf\x11\x53\x20\x62\x1c\x81\x2c\x77\xdd\x04\xa5\x3b\x0e\x46\x72\x8c\x19\x9e\x03\xb9\xa2\xf2\x69\xeb\x8e\xc7\xc1\xe2\x44\x71\x81\xe7\x50\x67\x64\xc7\xf9\x0f\xa2\x82\x4b\x8b\xe7\x4b\x4c\x13\x3c\xa3\x09\x55\xab\x09\x34\xa0\xb8\x33\xbe\xb3\x04\xab\x98\x8b\xf4\x2f\x0d\xdf\x57\x3c\xc5\x94\x5d\x56\x18\xfd\x6f\x0b\x87\xab\x95\xdf\x33\x82\x15\x34\x96\xfe\xc7\x5a\x9a\x16\xe7\xd5\x13\x4a\xe4\xe0\x42\xed\xb6\x65\x2e\x79\x9a\xe5\x0a\x86\xd8\x3e\x87\x6d\x18\x48\x24\xa0\xc2\x3a\xa5\x6e\xcf\x23\x73\xa7\xbc\xc9\x3e\x6f\xbc\xb6\x6c\x49\xe4\xde\x5b\x6c\x99\xde\x70\x9e\x19\x54\x7c\xfd\x2e\xdb\x52\x6f\x51\xab\xed\xc8\x99\x01\xcd\x51\x58\xe2\x03\x34\x31\x25\xc5\x52\x81\x08\xed\x55\x35\x70\x28\x83\xe7\x1d\x2e\x2a\x69\xa9\xa5\xb8\xa7\x40\xfa\xfd\xc7\x94\x93\x1e\x26\xa4\xb7\xbb\xa8\xfa\x4e\x3c\xb3\xf5\xba\xbd\xb8\x82\x57\xf7\x28\x2d\xd0\x9f\xbe\xbe\xd4\xef\x3f\x12\xba\xfc\x05\xe2\xec\xe2\xb6\x58\xbc\x35\xc9\x01\x41\x8b\x0b\x92\x6f\x65\x0c\x59\x19\x4d\x3a\xa1\xff\x05\x39\xc6\x99\xdf\x77\x83\xed\x58\x2f\xf0\xfb\xd3\x81\x2d\xac\x66\x36\x3d\x2c\x52\x4b\x45\x0c\x6d\x06\xf5\x40\x35\x91\x68\x50\xf4\x6f\x2c\x2d\xfc\x44\xcd\x9c\xe1\x87\xe3\xb4\x23\x56\x4f\x13\xaf\x96\x6f\x13\xac\x30\xa1\xf2\xe9\xa6\x9e\x81\xda\x5a\xda\x17\xbd\x3f\x27\x82\xed\x28\x3e\x3e\x92\x4f\x18\xcd\x35\x2a\xad\xba\x89\x83\x72\x02\x40\x1a\xb1\xf3\xeb\xc3\xfe\x43\xc9\xbd\x65\x7b\x85\x15\xee\xc4\x88\xbd\x38\xf1\x93\xb0\xc2\x11\x09\xc7\x62\x86\x8d\x1b\xb5\xa4\xf4\x04\x55\xe8\xaf\x49\xb1\x4e\x90\xea\xec\xaf\x46\x3f\x9e\x76\x1a\xd8\x74\xd0\x4d\x46\x98\x9c\x80\x52\x94\xcd\x5b\xbe\xeb\x11\x93\x62\x6a\x66\x37\x78\x06\x49\xe7\xbe\x7f\x32\x92\x71\xca\xd4\xd5\xed\xa4\x5e\x10\x4f\x1d\xbe\x55\x08\x53\x21\xe9\x9e\xe2\xe6\xa8\x5a\xa2\x05\xe0\xcd\x9b\xf0\xed\x66\x7a\x87\xc4\xb0\xcb\x6e\x27\xcd\x0a\xf7\x15\xb7\x07\x39\x88\xa3\xfa\x3d\xb4\x05\xd1\xb1\x79\xab\x46\x6e\x20\x59\xad\xa2\xac\x7b\x4d\x2c\x38\x53\xc0\xc8\x28\xfc\xb1\x86\x48\x57\xe3\xa8\x64\xd7\x52\xc9\x2b\x8a\x69\xfa\x72\xa1\x9f\xfd\xb5\x77\xd5\xae\x18\x91\x83\xfc\xc5\xdd\x64\xd8\x9f\x81\xa0\x23\xea\xf5\xba\x76\x29\x9b\xf1\x9c\x91\x5b\xac\xee\xf3\xc4\x9c\xf5\xd1\x9a\xdf\x75\x4d\x28\x9b\x6f\x97\x58\x7a\x5f\xf7\xae\x41\xdd\x5c\x98\x49\x64\x84\x2d\xd1\xb2\xbf\xe9\xda\x35\x13\x7c\xd6\xc5\x29\x34\x73\x4e\x16\x47\x81\x83\xd5\xef\x71\x01\x7c\xe9\x34\xfb\x9a\x0e\x6f\xec\x35\x14\x65\xf3\x51\xcc\x6c\x10\x6a\xf4\x89\x4e\xdc\xd8\x3d\xaa\x24\xae\xd4\xe4\xae\xfe\x51\x07\x26\x76\x64\x1f\x76\x1f\xed\xd5\x0c\x57\x67\x8c\x07\x36\x57\x03\x2b\x7f\x7f\x79\x18\xcb\x10\x84\x2d\xf3\x07\x49\x7f\xff\x1f\x0f\xb5\xbf\x76\xee\x2e\x3c\xdf\xd3\x39\x3e\x94\x2e\x8f\x28\x5f\x4e\xe9\x4b\xbf\x81\x0e\x5e\x2f\xcb\x6a\x99\x7b\xa3\xbb\xbe\x2f\x5b\x6b\xf5\xa3\xbb\xb2\xb5\x13\xbe\xfa\xb8\x25\xea\x2a\x4e\xba\x04\x6a\x15\x45\xee\x04\x52\x61\x9d\xe2\x57\x43\xeb\xea\x10\x60\x52\xa9\x89\x49\x4e\x3c\x54\x7f\xa5\xc1\x91\x04\x36\xa7\x0c\xbe\x1c\xa8\x8e\xc3\xd5\xe0\xb8\x6b\xde\xff\x8d\xee\xa4\x6f\x73\x0d\x0b\xb5\x0a\xa8\xbd\x69\x5f\x97\x99\xdd\x59\xdf\x3e\x23\x37\xca\xab\x05\x16\xe4\x19\x0b\x08\x05\x8f\x69\x02\x2d\xa9\x8a\xae\x43\xf7\x63\x50\xd5\x73\xe8\xe2\x5f\x46\x4a\x17\xfb\xf6\xc3\x4e\xab\x19\xd7\x4e\xa3\x0f\x4a\x90\x5b\x9c\xfd\xe0\x1d\x5f\x67\x5b\x89\xb5\x75\x8f\x4d\x3b\x94\xc3\x65\x97\x5e\x30\x49\x29\xfb\x2e\x41\xb8\x0a\x12\x6b\xd2\xd5\x65\x35\xbe\x22\xde\xdd\xe3\xcb\x66\xc7\xe4\x19\x8b\x74\xcc\x09\x34\xdf\x26\xd7\xeb\x6b\x50\xe7\x73\x60\x6a\xbb\xa4\x78\xe3\xd7\x68\xbc\xd9\xa0\x66\xae\xdb\x41\xd7\xa6\x69\x3e\x83\x7a\x09\x65\xf9\x8b\x55\xe9\x39\xeb\x2f\x42\xa5\x3e\x52\x88\xa5\x7c\xe6\x82\x9c\xe7\x6a\x01\x4c\xd1\x1d\x8c\x98\xf7\x2f\xc7\x53\xa9\x5c\x74\xd4\x6a\x45\x19\xf6\x15\x56\x0e\xc7\xed\x70\xdf\x92\xf2\x09\x56\xfa\x48\xc6\x40\x19\x16\x38\x05\x05\x42\xdf\x6e\x72\x71\x3f\x39\x0f\x2b\xbe\x8e\x2a\x73\xb7\x39\x56\x8b\xa6\x6f\x48\xb9\xf8\x0a\xab\x10\xab\x85\xe3\x6d\xd6\xfc\x5a\x0f\xc8\x96\x7f\x3a\xd7\xb4\xec\x43\x63\xf4\x37\x96\x37\x5a\xeb\x13\x88\x04\xb8\xfe\x01\xc0\xa1\xc6\x62\x69\x0b\xaf\x35\x9b\x32\x10\x4a\x6e\x6d\xd9\x5b\x56\xb7\x02\xa9\x4c\x09\x3a\xa2\xc9\xf8\x93\x56\xb6\x49\x31\xd1\xa0\xe1\x3c\x34\xc5\x73\xb8\x87\x18\x04\xb0\xa8\x45\xac\xc3\x34\x8e\x41\x34\xa5\xe6\x72\xa4\xe9\xee\xf4\x9c\xb3\x15\xa0\x2d\x28\x17\x9d\x84\x61\x35\xef\x22\x96\x4f\x79\x07\xd9\xe4\xeb\x77\x17\xc1\xd2\x5d\x22\x96\x44\x65\x99\xe8\x68\x9e\xd4\x87\x81\xc7\x4d\x12\xee\x7a\xa1\xc1\xd1\x82\xb2\xb9\x66\x7f\x0f\x98\xdc\xb1\x64\xd5\xb0\x4f\x50\x24\x06\x70\x97\x55\xb1\xf4\x97\xe0\xa9\xd9\xdd\x3b\xa4\x0e\x2c\x78\xbc\x23\x64\x05\xfe\x17\x2e\x09\x95\x4f\x6d\xdf\x0a\xbc\xe5\x82\x38\x01\x23\x17\xb4\x2e\x8e\xa8\x9c\xa4\xf7\x4f\xd1\xfa\x7b\x16\xad\xc1\xfe\x07\xcf\xfe\x20\x13\x34\xc5\x62\x55\x75\xb9\xe5\x60\x96\xf0\x59\xe0\x17\xae\x77\x68\x95\x70\xa8\xb2\x50\xe5\xd3\x83\xe5\x82\xb4\xfd\x7a\xd3\x7c\x98\x60\x80\x06\x77\x13\x1d\xe3\x3a\x6b\xbb\xbe\x40\xff\x6a\x87\x20\xd9\xce\xea\x88\x58\x5b\xeb\x9d\x75\x92\x75\x3d\x6c\x0e\xea\xb6\x55\xd9\xec\x92\x0a\x95\xe3\x64\x6c\xd0\x65\xd7\x88\xff\xfc\xe9\x7f\x01\x00\x00\xff\xff\xa1\x32\xe0\x50\xd3\x28\x00\x00")
+var _swarmagentresourcesvmasT = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x59\x4d\x6f\xe2\x3a\x17\x5e\xb7\x52\xff\x83\x95\x4d\x40\xca\xc0\xab\xf7\xee\x66\xd7\x8f\x99\x0e\x9a\x7e\xa0\x32\xd3\x0d\x62\x61\xe2\x03\x58\x4d\xec\xc8\x76\xe8\x70\x11\xff\xfd\xca\x26\x09\x71\xe2\x40\x68\xe9\x9d\xb9\x93\x4a\x4c\x62\x9f\xe3\x93\xe7\x7c\xf8\x39\x0e\x42\x08\xad\x2f\xce\x91\xf9\xe7\xe1\x84\x3e\x83\x90\x94\x33\xef\x33\xf2\xc6\x4b\x2c\x28\x9e\x46\x20\x3b\xfe\x6e\xe4\x06\x66\x38\x8d\x94\xdf\x9d\x78\x41\x21\x18\xf2\x64\xe5\x7d\xde\x69\x32\x8f\x52\xa6\xaa\x6a\xd6\xeb\xde\x03\x8e\x61\xb3\xb9\xe6\x29\xb3\x75\x20\xe4\x31\x1c\x83\x96\x88\x38\x4f\xbc\xfc\xf9\x66\xb7\x0a\x81\x04\x18\x91\x8f\xda\xba\xf1\xc5\xf9\x7a\x4d\x67\x88\x71\x85\x7a\x03\x79\x9d\x4a\xc5\xe3\xe7\x87\x2f\x3f\x36\x9b\x62\x7e\x79\xe5\x25\x03\x35\xb8\xd1\x2b\x6a\x41\x60\x44\xcf\x33\x1a\x06\x72\x98\x4e\x23\x1a\xa2\xde\x90\x0b\x25\xf5\xf3\x33\x84\x02\xb7\xdd\x77\xd3\x9a\x12\xbd\x14\x42\x93\x9d\x99\x11\x0f\xb1\x72\x60\x98\x3f\xb7\xa1\xcb\x5f\x7a\x1c\x72\x16\x62\xd5\x71\xad\xfa\x7c\xaf\x7f\x87\x02\x66\xf4\x97\xdf\x0d\x90\xcf\x68\xf8\xc9\x0f\x90\x86\x7d\xc0\x08\xfc\x72\x4a\x3d\xce\x66\x12\x94\xdf\xed\x5a\xeb\x25\x82\x27\x20\x14\x05\x59\x71\x18\x4d\xae\x39\x9b\xd1\x79\x2a\x8c\xf5\x7a\x78\xbc\x1b\x2e\x85\x49\xc5\xf0\x5c\xee\x81\x13\xf0\x82\xca\xa4\xea\x6a\x4d\x88\x23\x64\x89\x45\x1c\x93\x2b\x1c\x61\x16\x82\xb8\xc2\xe1\x0b\x30\x72\x49\x88\x00\x29\x87\x9c\x47\x99\x69\x67\x67\xf9\xfc\xf5\xee\xe6\x4c\xbf\x09\x29\x03\xea\xf7\x65\x3a\x95\xa1\xa0\x89\x79\xad\xbe\x1f\xa0\xf2\x83\x4e\xb7\x57\xbe\x1d\x90\xc0\xef\x0b\x90\x3c\x15\x21\xdc\x0a\x9e\x26\x46\xc2\x7a\xd2\xe9\xf6\xb4\xd7\x02\xe4\xf7\x13\xc1\x97\x94\x80\x90\xfd\x7b\x1a\x0a\x2e\xf9\x4c\xf5\x1e\x40\xbd\x72\xf1\xd2\x2f\xbf\x84\x51\xe2\x72\xd2\xdd\x54\xff\x1a\xa7\xf6\xa7\xf5\x37\xed\xfb\x81\x5b\x2a\x43\x45\xc3\xa1\x1f\xf9\xda\xc9\x65\x48\x36\x3b\x48\x26\x41\x2d\x5a\xf3\xcb\x4b\x04\x5d\x62\x05\x83\xe1\x65\x94\x87\xe7\x3d\xa8\x05\x37\x08\xde\xac\x18\x8e\x69\x58\x75\x2a\x42\x9e\x4c\xa7\x0c\x94\x1d\x40\xf9\x95\xc3\xef\xb2\xfb\x99\x81\x1a\xa5\xd3\x52\x2e\xe6\x52\xb9\xd1\x8d\xb7\xa5\x9b\x89\xa3\x36\xa8\x55\x62\xa2\xb1\xee\x06\xb6\x75\xc7\x80\x29\x10\x33\x1c\x82\xcc\x56\xd5\xc2\x26\x1c\x7b\x03\x79\x8f\x19\x9e\x03\xb9\xa1\xf2\xa5\x08\xc7\xe3\xca\xe2\x48\x71\x81\xe7\x50\x56\x64\xe7\x79\x0e\x6f\x55\xc5\x81\xaa\xe0\x42\xf1\x72\x89\x69\x84\xa7\x34\xa2\x6a\x35\x02\xe5\xb7\xcb\xef\x24\xc2\x6a\xc6\x45\xfc\x55\x97\xef\x1b\x1e\x63\xca\x4c\x15\xd6\xcb\xfc\xdf\x0b\x1c\x33\x7f\x26\x04\x2b\xa8\x4c\xfd\xcb\x9a\x1a\x6f\xdf\x57\xeb\x50\x22\x05\xaf\x95\x67\xae\x79\x9c\xa4\x0a\xfa\xd8\x7e\x0f\xdb\x31\x10\x49\x40\x5b\xef\x64\xd8\x5e\x86\xa1\xb6\xf7\x5d\xfe\x79\xe7\xb6\x65\x5b\x22\xf7\xee\x62\xcb\xf8\x8e\xf3\xc4\x54\x45\x07\x2a\x95\xbd\xac\x90\x2e\xaa\x56\x3d\x90\x13\x53\x34\x07\xc3\xac\x3e\x40\xb5\xa6\xc4\x58\x2a\x10\x43\x7b\x56\xa9\x38\xec\xaa\xc1\xfb\x42\x32\xb3\xb0\x24\x20\x2d\x58\xb6\xfb\x14\x48\xbf\x3b\x8e\x39\xe9\x60\x42\x3a\xbb\x8d\xaa\x1b\x1c\xc6\xb5\xd8\xb8\x82\x83\x6b\x64\x1e\xe8\x4e\x0e\x4f\xf5\xbb\x63\x42\x97\xbf\xc1\x9c\x42\x6d\x36\xb9\x70\x49\x8b\xa4\xc5\x5b\x91\x1f\x59\x0e\xad\xd7\xb7\xa0\x6c\xeb\xf4\x10\xea\x6d\x36\xae\x20\xab\xa7\x5e\x26\xdb\xb7\xcd\x2f\x67\x9e\xfe\xdd\x96\xc5\x6f\x58\x5a\x05\xd1\x4a\xba\x77\x25\x5e\x43\xf2\x9d\x26\x01\xad\x60\x25\x58\x61\x42\xe5\xcb\x5d\x99\x52\xda\x28\xed\x4b\xc7\x7f\x27\x25\xed\xb4\x3c\x3e\x35\x4f\x98\x9e\x25\x29\x0d\x9d\x0d\xf7\x56\x72\x04\x40\x2a\xc9\xf0\x41\x89\x73\x44\x1e\xff\x51\x76\x17\x6a\x6f\xb0\xc2\x8d\x49\xbf\x37\xf1\xdf\x9a\xfc\x8e\xd0\x3e\xb6\x08\x94\x55\x58\xb4\xf1\xb8\x0d\xd7\xd9\x27\x1e\x15\xd6\xbb\x90\x76\x61\x7b\x04\x09\xda\x5b\x11\xdb\x91\x91\xfd\xfd\xe2\x9f\x87\x4e\xa5\xd8\x34\x63\x93\x3f\xd6\x35\x90\xc9\x11\x28\x45\xd9\xbc\x16\x8c\x1e\x31\x24\x50\xeb\xbe\xc3\x53\x88\x1a\xd7\xfd\xc2\x48\xc2\x29\x53\x37\x0f\xa3\x72\xcb\x3a\x71\xc4\x96\xbe\xbc\xa2\x80\xee\x69\x3f\x2e\xce\xab\x82\x0e\x37\x36\x56\xe4\x92\x1f\x4f\xe4\xa6\x0f\xa0\x6e\x4d\x7e\x3b\x29\x6f\xdb\xd7\x7e\xb6\x0a\x10\x47\x7f\x5a\xdd\x2e\x4b\xd3\xdb\x2c\x5e\xeb\x62\x27\x5e\x53\xcf\x57\xd8\x87\x90\x37\x13\x9c\x29\x60\x64\x30\x7c\xdb\x91\x45\x83\x35\xb9\xba\x1a\x24\x07\x80\xc9\x87\x6d\x27\xef\xef\x8e\xf3\x03\x85\x01\x69\x15\x2f\xee\x63\x80\xe6\x68\x71\x20\x58\xbb\x6d\x42\x97\xb2\x29\x4f\x19\x79\xc0\xea\x29\x8d\x4c\x10\x8c\xad\xf1\xdd\xb9\x06\x65\xf3\x62\xca\x6e\x82\xde\x36\x3a\xb7\xa0\xee\xae\xcc\x20\x32\xf8\x66\xd5\xb2\xbb\x69\x5a\x35\x11\x7c\xda\xa4\x69\x68\xc6\x9c\x2a\x8e\x2a\x0e\xd6\x89\x8c\xab\xc0\x67\x41\xb3\xef\x58\xa0\x55\xdd\x68\x3e\x0d\xd8\x36\xb6\x47\x29\xb3\x8b\x90\x65\xeb\x5b\x7a\xd8\x8c\xb2\x58\xe1\xfd\xb6\xa6\x35\x87\x69\x64\xf1\x88\xcd\x66\x7f\x4d\x6c\x60\x1f\xf6\x49\x97\x9b\x6d\x95\x28\xab\x83\x0c\x99\x06\x53\xb7\x42\xce\x5c\x39\x2d\xc5\x3b\x48\x4d\x7f\xb3\x7d\x85\xda\x6c\x72\x51\x20\x8c\xd3\x9b\xfb\xbb\x8f\x74\xd9\x47\xc1\x72\x04\xe1\x3f\x02\xc1\x83\x1e\xfe\x6f\xbd\xce\xe1\x9e\xa4\x28\x2c\x95\x0a\xe3\x0e\x89\xc6\xd3\xd5\x26\x66\x73\xc2\x6f\x18\x6e\x8b\x9a\x88\x7c\x93\x41\xb5\x06\xc2\x4d\xb6\x14\xd6\x74\x38\xbf\xb5\xca\xac\x00\x43\x3b\x46\xe6\x5b\x81\x87\x4a\xcd\xb7\x8f\x43\x09\x6c\x4e\x19\x7c\x6a\x09\x47\x7b\x18\x1c\x75\xf9\x8d\x84\x30\x33\xf6\xb4\xe6\xb5\xe0\x91\x15\x0f\xd9\xa3\x87\x28\x52\x93\x9b\xfd\xc0\x65\xd9\x3e\x27\x97\x21\x44\xc8\x5b\x60\x41\x5e\xb1\x80\xa1\xe0\x33\x1a\x41\xcd\xaa\x65\x3c\xa2\x7f\x37\xd3\xc7\xe7\x7b\x3d\xbc\xa7\xd5\xc9\x32\xa5\x49\x7d\x2d\x91\xaa\x54\xc8\x0a\xbe\x36\x48\x35\xa6\xa8\x1f\x7c\xe0\xb7\x46\x0b\x80\x1a\xe7\x9c\x34\x80\xc3\x65\x13\x2e\x98\xc4\x94\xfd\x94\x20\x8a\xb0\x2d\xad\x6f\x0d\x56\x38\x8d\xe1\x46\x26\x56\x44\x9b\x88\xcf\xe2\x4a\xbc\x23\xee\xb3\xe3\x81\xd1\x2b\x16\xf1\x3d\x27\x05\xcb\xcb\x2f\xb3\x5b\x5c\xce\x81\xa9\x62\xca\xf6\xbb\xb5\x3e\x27\xda\x6c\x50\x95\x1d\x36\xc8\xd5\x65\xec\x72\xad\x0b\x02\x65\xe9\x2f\xab\x37\xaa\xe2\xaa\x2f\x8f\x50\xa9\x5f\x69\x88\xa5\x7c\xe5\x82\x5c\xa6\x6a\x01\x4c\xd1\x5d\x31\x31\xdf\x74\x2c\x4c\xf5\x9f\x27\xe5\xc2\xa5\xaf\xe8\x81\xbe\xc3\xaa\xc6\xe4\xf3\xcb\x21\xa5\xff\xbc\x17\x58\xe9\x57\xd2\xab\x8e\x13\x2c\x70\x0c\x0a\x84\xde\xe3\xe4\xe2\x69\x74\x39\xcc\xf5\xd6\x9c\xbc\xbb\xbc\x04\xab\x45\x35\x42\xa4\x5c\x7c\x87\xd5\x10\xab\x45\xb5\xb9\xcc\xff\x55\xc0\xae\x44\xa9\x73\x4e\xe5\xd6\xf8\xfd\x1b\x96\x77\x1a\xf5\x11\x84\x02\x5c\x1f\xb5\x1d\x30\x6e\xa7\x56\x4d\x8e\xb4\x9a\x2c\x1d\x32\x6d\x75\xdb\x6b\x5e\xb7\xd2\x29\x23\x06\x0d\x39\x65\xe2\x49\x83\x6d\x1a\x13\xcd\x55\xca\xa3\x1e\x8d\xf1\x1c\x9e\x60\x06\x02\x58\x58\x13\x46\xc8\xe3\xb3\x19\x88\xaa\xd5\x5c\x0e\xb4\xdc\xa3\x1e\x73\x38\x69\x1b\x19\x72\xd1\x28\x38\xcc\xc7\x5d\xc2\xf2\x25\x6d\x10\x1b\x7d\xff\xe9\x12\x58\xba\x9b\xaa\x4c\x28\x6b\xac\xaa\xa8\x6e\x2e\xce\xcb\xb7\x81\xc7\x0d\x41\x76\x20\x10\xe2\x70\x41\xd9\x5c\xab\x7f\x02\x4c\x1e\x59\xb4\xaa\xf8\x27\xd8\xd2\x03\x78\x4c\xf2\x5c\xfa\x2a\x78\x6c\x56\xf7\xda\x74\x4e\xfa\x0a\x3e\x72\xab\x0e\xfc\x4f\x5c\xea\x4f\x23\xf5\xd8\x0a\xbc\xe5\x82\xd4\xdf\x1a\x21\x2f\x15\xb4\x6c\x8e\xc8\x83\xa4\x93\x3d\x28\xed\x3e\xa7\xe9\x19\x3e\x82\x60\x1f\xc1\x9a\x0f\x36\x01\xbf\xd9\xbe\x42\xad\xcd\xe8\x03\xe7\x39\x42\xb6\xb4\xdf\xed\xf6\x12\x41\x63\x2c\x56\xf9\x69\xad\xec\x4d\x23\x3e\x0d\xfc\x6d\x40\xb4\x65\xf0\x6d\x03\x0d\xe5\x91\xd6\x5b\x2e\x48\x3d\xda\xca\x1d\x87\xc9\x0b\x06\xa8\xf7\x38\xd2\x99\xa7\x19\xd5\xed\x15\xfa\x5f\x3d\x31\x48\x31\xaa\xe3\x74\x6d\xcd\x77\xf6\x30\x56\xd1\x2e\xfe\xbb\xf7\xd4\x28\x67\x9a\x4b\x2a\x54\x8a\xa3\x7b\x93\xf3\xbb\x03\xe5\x8b\xf3\x7f\x06\x00\xaf\xb3\xce\xc4\x3d\x27\x00\x00")
 
 func swarmagentresourcesvmasTBytes() ([]byte, error) {
 	return bindataRead(
@@ -1167,7 +1188,7 @@ func swarmagentresourcesvmasT() (*asset, error) {
 	return a, nil
 }
 
-var _swarmagentresourcesvmssT = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5a\x4b\x6f\xdb\xb8\x13\x3f\x37\x9f\x82\xd0\x45\x36\xe0\x3a\xfd\xff\x77\x4f\xbd\xe5\xd1\xed\x1a\x8d\x13\x23\x6e\xb3\x07\xc3\x07\x5a\x1c\xd9\x44\x24\x52\x20\x29\x37\xae\xa1\xef\xbe\xa0\x5e\x16\x45\xca\x76\xba\xd9\x24\x45\x36\x07\x23\xd6\x70\x1e\xfc\xcd\x0c\x39\x33\xf2\x76\x4b\x43\x34\x1c\xc9\xa9\xe2\x02\x2f\xe1\x2c\x08\x78\xca\x54\x96\x9d\x20\x84\xd0\x36\xff\x44\xc8\xc3\x09\xbd\x03\x21\x29\x67\xde\x47\xe4\xcd\xd6\x58\x50\xbc\x88\x40\xf6\xfc\x1d\xa5\x94\xe0\xf7\xe7\xde\xa0\xe2\x0b\x78\xb2\xf1\x3e\xd6\x72\xf2\x27\x29\x53\x6d\x21\xdb\xed\xf0\x1a\xc7\x90\x65\xa6\x15\xf2\x42\x7f\x36\x05\x22\xe4\x31\x1c\x83\xe6\x5f\xc7\x57\x9c\x27\xd7\x9c\x80\x57\x12\xb3\x5a\x2d\x81\x04\x18\x91\x37\xda\xda\xd9\x8e\x75\x16\x70\x16\x60\xd5\xf3\xc7\x34\x10\x5c\xf2\x50\x0d\xaf\x41\x7d\xe7\xe2\xfe\x34\x49\x17\x11\x0d\x46\x93\x33\x42\x04\x48\x09\xf2\xd4\x1f\xa0\x86\x85\x31\x96\x0a\xc4\xc4\x5c\xa5\x6d\xf6\xfb\xfd\x79\x65\xc0\xbc\x36\x20\xe2\x01\x56\x0e\xb4\xaa\xe7\x06\x48\xd5\x8e\x2a\xf3\x1a\xeb\xa5\x81\xc7\x44\x40\x48\x1f\x40\xfa\xfd\x59\xcc\x49\x0f\x13\xd2\xd3\x00\x8f\x18\x81\x87\x5e\x7f\x70\x18\xd0\x9b\x30\x94\xa0\xfc\xbe\xb1\xd6\xad\xa3\x84\xbe\x3f\x3f\xbc\xd4\xef\xcf\x08\x5d\xbf\x80\x39\xb5\xd8\x72\x71\xed\x8f\x1a\xda\x44\xf0\x04\x84\xa2\x20\xcd\x28\xc4\x05\xc3\xd7\x4d\x02\x6d\x17\xad\xe3\x29\xfd\x01\x72\x8c\x13\xbf\xef\x8c\xd1\xbb\xb1\x5e\xe0\xf7\xe7\x43\xd3\x54\x2d\x6c\x6e\xc7\xa2\x2a\x75\xec\x62\xae\x04\xe1\xd4\x64\x97\x05\x6b\x36\x38\xd9\x6e\x81\x91\x2c\x3b\xc9\x53\x73\x24\x8b\xa0\x43\xc3\x09\x17\x4a\xfe\x4c\x62\x5e\x42\x88\xd3\xc8\xc8\xa3\x9f\x0c\x50\x17\x1c\xad\x6c\x38\x02\x7c\xc2\xe4\x14\x94\xa2\x6c\x69\x12\x34\x89\xc7\x98\x32\x2d\xe9\x0a\x2f\x20\xea\x54\xfa\x89\x91\x84\x53\xa6\x2e\xaf\xa7\xfa\x41\x11\x25\xfe\x2e\x13\x1b\x0e\xd0\x86\x54\x69\x1b\x55\xdb\x1b\x83\x5a\x71\xa2\xc5\x5f\x6e\x18\x8e\x69\x70\x8c\xdf\x3a\xcf\x8a\xda\x73\x4f\xe2\x9a\xa7\x3f\xbc\xba\x7c\xf5\x74\x27\x97\x4b\xd9\xd5\xe2\xe8\x88\x58\xe0\xe0\x1e\x18\x29\x8d\x9b\x70\x1e\x49\x63\xf3\xc8\x88\x92\xc3\x8a\xcf\x0b\x79\x5a\x50\x65\x43\x83\x3f\xab\xff\x9f\x37\x82\x24\x14\x9c\x29\x60\x64\x34\xb9\xe0\x2c\xa4\xcb\x54\xe4\xfb\xfd\x67\x86\x54\xc2\xda\x48\xec\xc7\xa3\x1d\xb6\x05\x2c\x8e\x25\x08\x79\x34\x8f\xe2\x99\x00\xc9\x53\x11\xc0\x88\x1c\x15\x20\xbe\xf3\x18\xed\x0c\x0f\x1b\xb9\xf6\x37\x37\xa6\x94\x2d\x78\xca\xc8\x35\x56\xb7\x69\x94\x6f\x72\xd6\x24\x47\x1c\x93\x73\x1c\x61\x16\x50\xb6\xac\x57\x34\xc1\xde\xf6\x3e\x83\xba\x3a\xcf\x69\x28\xb7\xb2\x3c\x07\xfb\x99\x5b\x63\x22\xf8\xa2\x43\xcc\x24\x27\xb9\xf8\x1f\x91\xfb\x3b\x93\x41\xd8\x27\x76\x11\x20\x55\x49\x35\xc6\x0c\x2f\x81\x5c\x52\x79\x2f\x6b\x75\x8f\x29\xa5\x9a\x02\x8a\xe8\xd9\x6e\x21\x92\xf0\x38\x61\xc6\x19\xd3\xb4\xf4\x25\x0a\xa5\xbd\xe5\xa6\xfe\x1b\xb8\x94\x77\xdc\x98\x2d\xd5\x07\x8b\xa5\x0f\xaf\xa1\x44\x7a\x0e\x23\x0e\x16\x46\x6e\x1f\x3f\x11\xcc\xff\x7b\x0d\x30\x3f\x87\x11\x7b\x60\x7e\xf7\xee\x5f\x04\xf8\xff\xaf\x01\xe0\xe7\x30\xe2\x65\xe3\xf8\xb7\xd7\x00\xf3\x73\x18\xf1\xb2\x30\xff\xfe\x1a\x60\x7e\x0e\x23\x3a\x61\x36\x3b\x3e\xc6\x95\xbe\x21\x2f\x52\xa9\x78\x7c\x77\xfd\xe9\x6b\x7d\x3b\x0e\xcc\x46\x95\x81\x1a\x5d\xfa\x16\xbf\xbb\x63\xb4\xd8\x9b\x55\xaa\x29\xa5\x55\x55\x79\x0a\xeb\x46\xad\xfc\xd6\x1c\xe5\x08\xc8\x4b\xe4\x69\x5e\x79\x7a\xa8\x31\xc2\xf0\x71\x20\x81\x2d\x29\x83\xf7\x1d\xad\xc8\xdd\xb8\xd9\xb8\x0d\x90\xff\x7e\x1d\x4b\xd9\xa8\xd4\xb3\x27\x1f\xa6\x1c\xa1\xfb\x60\xa7\x92\x26\x4b\x81\x09\x4c\x78\x44\x83\x4d\xbb\x7b\x8d\x39\xc9\x75\x9f\xa5\x8a\xc7\x58\xed\xba\x4a\xb3\x1d\x5d\x53\xa1\x52\x1c\x8d\x71\xb0\xa2\x0c\x26\x82\x87\x34\x82\xb6\x2c\x56\xd4\x5b\x6e\xea\x8e\x3e\x62\x0a\x44\x88\x03\xd8\xdb\xb4\x20\xab\x71\x31\xb0\x62\x34\x68\x75\x26\xfb\x30\x68\xac\xa0\xc9\x41\xb5\x5d\xca\x6d\x13\x68\x12\xe4\xc2\x1c\xa6\xb8\x0d\xda\x1f\xed\x36\x7b\xb3\x76\x3f\x3f\xd8\x71\x1e\xbb\x85\x0a\x0a\x72\x28\xea\x8a\x3c\x1b\x20\xff\xd4\xd1\xee\x76\x75\xec\x8e\x5e\xd6\x6e\xcb\x9a\x7f\xdd\xfb\x9f\xb7\xab\x7f\xc7\x2e\x64\xba\x60\xa0\x3a\xdc\xdd\xde\xab\x33\xb5\x18\xa8\x69\x2e\xa4\x3c\x54\x1e\x6b\xa7\xfb\xb9\xeb\xe9\xdc\x15\x27\x5e\x22\x68\x8c\x85\x4e\x4d\x4f\x89\x14\x6c\x03\xda\xa2\xcc\xef\xf3\x66\x4f\xdb\x54\xe0\x71\xd9\x99\x8b\x98\xc4\x94\x7d\x93\x20\x5c\xd3\x00\x83\xe8\x98\x01\x04\x3c\x4e\x52\x05\x62\x77\x24\x75\x83\x3b\x36\x87\x5d\x83\x2a\x05\xa6\xdf\xb1\x88\xc7\x9c\x40\xcb\xb7\xdb\xed\x67\x50\x67\x4b\x60\xaa\x5e\x51\x5c\x2b\x97\x58\xe1\x2c\x43\xed\x7e\xd2\xc9\x65\x71\x58\x21\xe4\x45\x94\xa5\x0f\xc6\x51\xe0\x9a\x67\x10\x2a\xf5\x8e\x26\x58\xca\xef\x5c\x90\xb3\x54\xad\x80\x29\xba\x3b\xdc\x73\x87\xb5\xbd\xea\x49\xb9\x72\x8f\x3e\x8a\x56\xf4\x0b\x6c\xba\x52\xd7\x1d\xc4\xde\x3d\x6c\xf4\x6e\x72\x98\x13\x2c\x70\x0c\x0a\x84\xbe\xca\xe5\xea\x76\x7a\x36\xa9\xa4\xda\xbe\xda\x69\xc6\x6a\xd5\xf6\x92\x94\xab\x2f\xb0\x99\x60\xb5\xea\x88\x7b\x3b\x86\xe7\x47\x8f\x57\xb4\x53\x68\x88\xfe\xc4\xf2\x4a\x43\x3d\x85\x40\x80\xe3\xb0\xb3\xb1\x2b\x16\x5a\x37\xa7\x16\x52\x86\x73\x29\xcb\x32\xda\x76\xb4\x99\x0f\x65\xe1\xd3\x99\x14\x34\xc6\x4b\xb8\x85\x10\x04\xb0\xc0\xa6\xeb\x8c\x0a\x43\x10\x6d\xd3\xb8\x1c\x69\xc6\x1b\x4d\x73\x79\xa0\xf0\xba\x5c\x75\x72\x4e\x2a\xba\x93\x5b\xde\xa7\x1d\x7c\xd3\x2f\xdf\x9c\x1c\x6b\xf7\x7c\xa5\xe4\x2a\x67\x2c\x16\x7a\xd9\xc0\x4e\x29\x1d\x74\xf9\x2c\x07\x0d\xdb\x09\xc4\xa5\x26\xb8\x40\x0a\xf2\x2a\x61\xa9\xd5\xdf\x02\x26\x7f\x09\xaa\xac\x43\x6d\x50\x94\x62\x70\x93\x54\x99\xf4\x87\xe0\x71\x6e\xdf\x11\x13\x97\x4a\xc6\xee\xc5\x9a\x94\x5c\x12\x6d\x8f\xb5\x66\xbd\x22\x17\x9c\x29\x4c\x19\x08\x77\xd6\xd5\x57\xa0\xa8\x5c\xdf\x7b\x4c\xe7\xf0\xdf\x38\xe7\xf8\xc6\xc1\xa8\x18\x1c\xef\x61\x51\x7f\x58\xde\x86\xd5\xeb\x12\x39\x5c\x44\x7c\x31\x40\x7e\xe1\x5f\xf7\x09\xf7\x8c\x1e\x7c\xeb\x93\xa2\x43\x1e\x7c\xf5\x0e\x7c\xeb\x93\xa8\x5f\xde\x81\x6f\x7d\xc6\xf5\xcb\x3b\xf0\xad\x4f\xcf\x9e\xc2\x81\x2d\xff\xcd\x5b\xf3\x38\x40\xc3\x9b\xa9\xae\xcf\xa6\xf4\x07\x7c\x3e\x47\x1f\x5a\xc5\xd3\x40\x77\x36\x25\x51\x97\x70\x5b\x63\x79\x2e\xc6\xee\x99\xdc\x2f\x4f\x33\x6b\xf8\x55\x14\xac\xcd\xa9\x1b\x4e\x70\x40\xd5\xa6\xb3\x4b\xdc\xf7\xa3\xa9\xfd\x3f\x68\x69\x72\x28\x6a\x57\xd8\x2d\x8e\xaf\xb4\x28\xb2\x8f\x78\x7d\x7a\x51\x34\xb9\xa7\xe6\xe8\x6b\x1a\x60\xdd\x7f\xd4\x3f\x7d\x39\xf9\x3b\x00\x00\xff\xff\x3f\xf7\xa2\xdf\x8d\x26\x00\x00")
+var _swarmagentresourcesvmssT = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x59\xcb\x6f\xdb\x3c\x12\x3f\xd7\x7f\x05\xc1\x8b\x62\x40\x75\xfa\x3a\xf5\x96\x47\xb7\x6b\x34\x0f\x23\x6e\xb3\x07\x23\x07\x5a\x1c\xdb\x44\x24\x52\x20\x29\xb7\x5e\x41\xff\xfb\x82\xb2\x5e\x94\x28\xdb\x4d\xb3\xce\xf7\x7d\xad\x1d\x04\xb6\x86\x33\x1c\xfe\xe6\xc1\x99\x71\x9a\xb2\x05\x1a\x8d\xd5\x54\x0b\x49\x96\x70\x16\x04\x22\xe1\x3a\xcb\x06\x08\x21\x94\xe6\xff\x11\xc2\x24\x66\xf7\x20\x15\x13\x1c\x7f\x44\x78\xb6\x26\x92\x91\x79\x08\xea\xc4\xab\x29\x85\x04\x6f\xf8\x80\xfd\x92\x2f\x10\xf1\x06\x7f\xac\xe4\xe4\x4f\x12\xae\xdb\x42\xd2\x74\x74\x43\x22\xc8\x32\x5b\x0b\x75\x61\xfe\x37\x05\x22\x84\x39\x89\xc0\xf0\xaf\xa3\x2b\x21\xe2\x1b\x41\x01\x17\xc4\xac\xda\x96\x42\x0c\x9c\xaa\x5b\xa3\xed\xac\x78\x88\x10\x9e\x05\x82\x07\x44\x9f\x78\xd7\x2c\x90\x42\x89\x85\x1e\xdd\x80\xfe\x2e\xe4\xe3\x69\x9c\xcc\x43\x16\x8c\x27\x67\x94\x4a\x50\x0a\xd4\xa9\xe7\xa3\x86\x86\x11\x51\x1a\xe4\xc4\x5e\x65\x74\xf6\x86\xc3\x87\x52\x81\x87\x4a\x81\x50\x04\x44\x3b\xd0\x2a\x9f\x5b\x20\x95\x27\x2a\xd5\x6b\xac\x57\x16\x1e\x13\x09\x0b\xf6\x03\x94\x37\x9c\x45\x82\x9e\x10\x4a\x4f\x0c\xc0\x63\x4e\xe1\xc7\xc9\xd0\xdf\x0f\xe8\xed\x62\xa1\x40\x7b\xc3\xa1\xbf\x77\x8f\x02\xfa\xe1\xc3\xfe\xa5\xde\x70\x46\xd9\xfa\x05\xd4\xa9\xc4\x16\x8b\x2b\x7b\x54\xd0\xc6\x52\xc4\x20\x35\x03\x65\x7b\x21\xd9\x32\x7c\xdd\xc4\xb9\x2f\xa5\xe9\x67\xd0\xb6\x6e\x86\x84\x46\x59\xd6\x75\x2e\x5d\x30\xd5\x4e\x54\x70\x9e\xda\xaa\xab\x2d\x6b\xe6\x0f\xd2\x14\x38\xcd\xb2\x41\x1e\x6b\x63\xb5\xf5\x22\x34\x9a\x08\xa9\xd5\x53\x22\xed\x12\x16\x24\x09\xad\xc0\x78\xa2\xc7\xb9\xc0\x6c\xb9\xf7\x01\x68\x52\xae\xa6\xa0\x35\xe3\x4b\x9b\x60\x48\x22\x22\x8c\x1b\xc1\x57\x64\x0e\x61\xef\xa6\x9f\x38\x8d\x05\xe3\xfa\xf2\x66\x6a\x16\x6f\xcd\xee\xd5\xa1\xd5\x30\x80\x51\xa4\x8c\xc3\xb0\x3c\xde\x35\xe8\x95\xa0\x46\xfc\xe5\x86\x93\x88\x05\x87\xd8\xad\x37\xf8\x2b\xcb\x3d\x8b\x69\x9e\x3f\x1b\xf5\xd9\xea\xf9\x52\x91\x6b\xb3\xab\xf9\xc1\x1e\x31\x27\xc1\x23\x70\x5a\x28\x37\x11\x22\x54\xd6\xe1\x6b\x54\x0f\xdb\xf8\x7c\x2b\xcf\x08\x2a\x75\x68\xf0\x67\xd5\xe7\xea\xd8\x08\xe1\x85\x14\x5c\x03\xa7\xe3\xc9\x85\xe0\x0b\xb6\x4c\x64\x9e\x92\x7f\x4d\x91\x52\x58\x1b\x89\xdd\x78\x94\x54\xdb\xac\x8e\x25\x08\x61\x96\x7b\xf1\x4c\x82\x12\x89\x0c\x60\x4c\x0f\x72\x10\xcf\x99\x17\x7b\xdd\xa3\x8b\x5c\xfb\x9b\x1b\x53\xc6\xe7\x22\xe1\xf4\x86\xe8\xbb\x24\xcc\x93\xea\xac\x49\x0e\x05\xa1\xe7\x24\x24\x3c\x60\x7c\x59\xad\xa8\xe8\x08\xa5\xe9\xc9\x67\xd0\x57\xe7\x39\x0d\xe5\x5a\x16\x79\x70\x98\xb9\x77\x8c\xa5\x98\xf7\x88\x99\xe4\x24\x17\xff\x4f\xc4\x7e\xad\x32\xc8\x6e\xc6\x36\xcc\xe9\xa0\xac\x91\xae\x09\x27\x4b\xa0\x97\x4c\x3d\x96\x79\xfb\xc0\xb4\x50\xdc\x10\x4d\x01\x5b\xef\x49\x53\x08\x15\x64\xd9\x93\x73\x4c\x53\xd3\x97\xa8\x7c\x76\xd6\x8f\xe6\xcf\x77\x6d\xde\x73\x63\xb6\xb6\x76\x97\x02\x8d\xea\xe7\x8d\xd3\xeb\x9f\xb7\xc8\xd8\x5b\xf3\x1c\x43\x89\x4a\x6c\xb1\xb8\xc2\xdf\xdf\x69\xe3\x67\x82\xf9\xed\x11\x4e\xb8\x17\xe6\xb7\x2f\x0b\xf3\xab\x57\xff\x47\x80\xdf\x1d\xe1\x6c\x7b\x01\x7e\xf7\xb2\x00\x1f\xc1\x8f\xdf\x1f\xe1\x84\x7b\x61\x7e\xff\x8f\x87\xf9\xc3\x11\x4e\xb8\x17\xe6\x0f\x2f\x09\xb3\xdd\xf1\x71\xa1\xcd\x0d\x79\x91\x28\x2d\xa2\xfb\x9b\x4f\x5f\xab\xdb\xd1\xb7\x6e\xf8\x35\x07\x3d\xbe\xf4\x3a\xfc\xee\x8e\xb1\xc3\x5e\x69\x73\x35\x6f\x49\x69\x55\x55\x58\x13\xd3\xa8\x15\xdf\xea\x12\x14\x07\x12\xf2\x12\x79\x9a\x57\x9e\x18\x35\x66\x12\x1e\x09\x14\xf0\x25\xe3\xf0\xda\xf6\x86\x6a\xd7\xfb\xeb\x66\xe3\xe6\x23\xef\xf5\x3a\x52\xaa\x51\xa9\x67\xbf\xd8\x92\x14\x9a\xfc\xdc\xde\xfe\x60\x77\x65\x8e\x93\x78\x29\x09\x85\x89\x08\x59\x60\x8f\xaa\x10\xc2\x91\x99\x2e\x7d\x44\xf8\x2c\xd1\x22\x22\xba\xee\x2a\x1b\xa7\x41\x08\xaf\x99\xd4\x09\x09\xaf\x49\xb0\x62\x1c\x26\x52\x2c\x58\x08\x6d\x59\x7c\x5b\x6f\xb9\xa9\x35\x7d\xcc\x35\xc8\x05\x09\x60\x67\xd3\xd2\x6d\x5c\x2c\xac\x38\x0b\xaa\x93\x1f\xda\x9d\x98\x37\x66\xf1\xde\x6d\xfb\x36\xef\xaa\xc0\xe2\x20\x17\x86\xfd\xbe\xc5\x2d\x85\x76\x7b\x7b\xe7\xd5\x68\x37\x40\x16\x1d\x62\x51\x9f\xba\x3a\xce\x43\x8f\x60\xf7\x61\x3b\xbc\x6e\x1b\x67\x3e\xf2\x4e\x1d\xed\x6e\x2b\x69\xee\xea\x65\xbb\x6d\x59\xf3\xd5\x7f\xfe\x87\x76\xf5\xdf\x7d\x61\x95\xcc\x39\xe8\x1e\x73\xb7\xcf\xea\xd2\xf7\x9e\x83\x9e\x26\xf3\x3a\x35\x95\x4c\x87\xea\x99\x0d\x0e\x7d\xda\x68\xfb\xea\x37\x8e\x25\x8b\x88\x34\xa1\x89\xb5\x4c\xaa\x61\x6f\xbf\x28\xfb\x7b\xd9\x0b\xb6\x62\x16\x21\x2c\x54\x6f\x2c\x12\x1a\x31\xfe\x4d\x81\x2c\xbd\xb9\x09\x8d\x45\x6c\xe6\x98\x82\x39\x10\x51\x9c\x68\x90\x75\x4a\xea\x05\xf7\xa2\xb3\xb4\x6c\xea\xf2\x40\x98\x7e\x27\x32\xba\x16\xb4\xee\x0f\x0b\xcf\x35\xe3\xca\xb3\x25\x70\x5d\xad\xd8\x5e\x2e\x97\x44\x93\x2c\x43\xed\xae\xd2\xc9\xd5\xe1\xe8\x38\x12\x0e\x19\x4f\x7e\x58\x09\xc1\xe1\x48\x98\x32\x65\x9c\x66\x42\x94\xfa\x2e\x24\x3d\x4b\xf4\x0a\xb8\x66\x75\x8a\xcf\xcd\x66\x83\x64\x3c\x53\xad\x1c\xd2\xaa\x29\xc9\x17\xd8\xf4\x05\x70\x97\xc7\xbc\xf1\x23\x6c\xcc\x69\xcc\x8e\xb3\x98\x48\x12\x81\x06\x69\x2e\x74\xb5\xba\x9b\x9e\x4d\x4a\xa9\x5d\x8b\x95\x2f\x1c\x13\xbd\x6a\xdb\x4a\xa9\xd5\x17\xd8\x4c\x88\x5e\xf5\x78\xbf\x8d\x59\xdb\xe5\xba\x2b\xec\x6f\xb9\xa5\xff\x4d\xd4\x95\x81\x7a\x0a\x81\x04\x47\xca\xeb\x62\xb7\x5d\xd8\xd6\x35\xb7\x57\xe1\xd4\x85\xac\x8e\xd2\x5d\x43\xdb\x51\x51\x94\x3f\xbd\xa1\xc1\x22\xb2\x84\x3b\x58\x80\x04\x1e\x74\xe9\x26\xae\x16\x0b\x90\x6d\xd5\x84\x1a\x1b\xc6\x5b\x43\x73\x59\x60\x6b\x75\xb5\xea\xe5\x9c\x94\x74\x27\xb7\x7a\x4c\x7a\xf8\xa6\x5f\xbe\x39\x39\xd6\xee\x29\x4b\xc1\x55\x4c\x5a\x3a\xe8\x65\x7e\x37\xa4\x8c\xd3\xe5\x23\x21\xf3\x73\x81\x45\xc6\x42\x19\x82\x0b\xa4\x20\xaf\x15\x96\x66\xfb\x3b\x20\xf4\x3f\x92\xe9\x4e\x6a\xf3\xb7\x05\x19\xdc\xc6\x65\x24\xfd\x4b\x8a\x28\xd7\xef\x80\xb9\x4b\x29\xa3\x4c\x61\xa6\x22\x12\x8a\x1a\x7d\x3a\x6b\xd6\x2b\x7a\x21\xb8\x26\x8c\x83\x74\x47\x5d\x75\x11\xca\xd2\xf4\x27\x3f\xd3\x3f\x34\x10\x76\xd7\xd7\x7f\x86\x3a\xd5\x50\xc7\x47\xce\xa9\x5f\xb1\xb7\x37\x44\xc3\x51\x71\x27\x96\x3f\x9a\xa8\xd1\x3c\x14\x73\x1f\x79\x5b\xfb\xba\xfc\xfd\xa8\x16\xfc\xdd\xe7\x45\xfb\x2c\xf8\x97\x37\xe0\xef\x3e\x8f\xfa\xdb\x1b\xf0\x18\x43\xa6\xbd\x06\x7c\xff\xc7\x80\x4f\x36\xe0\xef\x3e\x43\x7b\x0e\x03\xb6\xec\xf7\x50\x35\x39\x79\xed\xc4\x01\x8d\x6e\xa7\xa6\x3e\x9b\xb2\xff\xc2\xe7\x73\xf4\xa6\x55\x3c\xf9\x98\x56\x44\x53\xc2\xa5\xd6\xf2\x5c\x4c\xbb\x94\xb6\xab\xfb\x6c\xd0\xfe\x54\x95\x8f\x45\xc1\x5a\x97\x85\x38\x20\x31\x09\x98\xde\xb4\x0b\xd2\x0a\x9f\x02\xbd\xa6\x5f\x56\xb5\x9d\x93\xe1\xfe\xda\x1c\xac\xc5\xa1\x19\xc8\x3d\x1c\x5f\xd9\xb6\x44\xef\xe8\xdc\xfd\x11\xb5\xe8\x5f\x4f\xed\x01\xd8\x34\x20\x21\x4c\x41\x2b\x3c\x40\x08\xa1\x6c\xf0\xbf\x01\x00\xad\xbe\x17\xe6\x64\x26\x00\x00")
 
 func swarmagentresourcesvmssTBytes() ([]byte, error) {
 	return bindataRead(
@@ -1187,7 +1208,7 @@ func swarmagentresourcesvmssT() (*asset, error) {
 	return a, nil
 }
 
-var _swarmagentvarsT = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x55\xcd\x6e\xdb\x3c\x10\xbc\xe7\x29\x08\x5f\x28\x01\xb2\xf3\x7d\xcd\xa5\xed\xcd\x49\x7c\x10\x60\xbb\x42\x14\xb8\x87\xc0\x28\x28\x71\xe5\x12\x91\x48\x83\xa4\x6c\xb7\x82\xdf\xbd\xd0\x8f\xad\x1f\xd3\x4a\x1c\xb8\x3d\x49\xc0\x72\x67\x87\xb3\xc3\x5d\x84\x10\x1a\x64\xd9\x68\x4e\x12\xd8\xef\x1f\x44\xca\xf5\xe0\x2b\x1a\xbc\xac\x89\x24\x09\x68\x90\xca\xc2\xed\x30\xb6\x97\x03\x07\xdd\xb4\x13\x17\xb3\xfc\xeb\x49\x88\xd8\xae\xc8\x0f\x05\x0f\x89\xb6\x36\x44\x32\x12\xc4\xa0\x2c\x2c\x64\xf8\x13\x94\x96\x44\x0b\x99\x1f\xc6\xb6\x83\xf0\xf0\x88\x30\xc4\x0e\x6a\x9c\xe6\x24\x01\x3f\x8d\x22\xb6\xc3\xb6\xb9\xa0\xcf\x7e\xc3\x79\xaa\x65\xfc\x0c\xd7\x3c\xf4\xcc\x40\x16\xe9\x6a\x1d\x33\x6d\xf5\x82\x38\xf8\x07\xb6\x5f\xfe\x5b\x2e\x07\xce\x4d\x96\xb1\x08\x8d\x5c\x35\xde\x10\x16\x93\x80\xc5\x4c\xff\xf2\x41\xab\xfd\xbe\x28\x73\x08\xfb\x5a\x48\xb2\x82\x71\x18\xe6\x9a\x55\xc1\x9a\x43\x3b\xac\x6a\xdd\x09\xa5\x16\x65\x9b\xa6\x70\x5d\xf9\x5b\x3a\x25\x64\xb7\x98\x29\x0f\x64\x1b\x11\xdb\xb6\x83\x12\x41\xad\x1c\x2f\xff\x5e\x01\xef\x93\xed\xa0\x2b\xc2\xfd\x6f\x17\x8d\xed\x15\xe6\x5b\x14\x29\x28\x85\x49\xd2\xd8\x6a\x03\x77\x34\xf4\x40\x8e\x57\x50\x30\x30\xb1\x73\x39\x85\xca\x4b\x55\xa3\x80\xd3\x93\xbe\x74\xba\xda\xb4\x72\x0d\x35\x24\xed\x53\x6f\x58\xb7\x53\xa2\x71\x27\xa3\xe9\xca\x78\xe1\xdc\x9b\x2c\x83\x58\xc1\x95\xac\xf5\x4e\xf5\xba\x02\xd5\xdf\xb2\xfc\x43\xaa\xb4\x48\x16\xf3\xc9\xf3\x49\xe9\x05\x07\xed\xa7\x01\x07\xed\x3e\xf6\x3c\xcd\xc6\x29\xc3\x35\x1b\x37\x29\x0e\x9d\x07\x2a\xe3\xd8\xe4\xa2\x22\x92\xff\x7f\x6c\x18\xa9\x1a\xf9\x64\x7a\x74\xef\x78\x0a\xbe\xa9\xae\xe6\xe0\xdb\x12\x48\xdd\x62\xa3\x27\x6b\x9a\x95\x53\x5a\x5a\xbb\xca\x4b\x83\x98\x85\x68\xe4\x09\x79\x1c\x30\x35\x93\x09\xa7\x6b\xc1\xb8\x7e\x9c\xfb\x9d\xe1\xab\x45\x2c\xb6\x20\xcd\x43\xcd\x98\x66\x74\xaa\xeb\x8d\x29\x95\xa0\xd4\xc5\x42\x92\xdc\x4a\x43\xb6\xee\xbc\x8c\xb7\x38\xe4\xa9\x17\xbd\xa5\x69\x70\x4f\xc2\x57\xe0\xd4\x13\x22\xfe\x78\xbb\x2f\xdc\x3d\xd3\xa0\x6a\xbd\x04\x25\x52\x19\x82\x4b\x2d\x3c\x63\xa1\x14\x4a\x44\x7a\x34\x07\xbd\x15\xf2\xf5\x36\x16\x84\xde\x93\x98\xf0\x10\xa4\x32\x1b\x60\x1a\xd4\xcd\x37\x54\xf1\x1e\x04\x8f\xd8\xea\xac\xd1\x5a\x8c\x0a\xbf\x45\x52\x70\x0d\x9c\x1e\x52\x53\x49\x34\x13\x3c\x37\xa0\xb1\x11\x75\x91\xf7\x10\xf9\x87\x0a\xff\xb5\x52\x45\xa5\xe3\x2c\xfd\xce\x38\x15\xdb\xc3\xdb\x6a\x93\xa8\x62\x4f\x8f\xde\x9c\xe8\x27\xc2\x57\xe0\x6b\x22\xf3\x79\x74\x77\xf7\xf9\x8b\xd3\x9b\x31\xe1\xb4\xca\x10\xeb\xe3\x5e\x37\x35\xe0\x4c\x11\x5c\xed\xda\xbe\x3d\xdb\x13\x33\xac\xb9\xc3\xcf\x9f\x00\x00\x00\xff\xff\xc6\x1f\x4f\xa1\xf1\x09\x00\x00")
+var _swarmagentvarsT = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x56\x4b\x6f\xda\x4c\x14\xdd\xf3\x2b\x46\xde\x0c\x48\x06\x3e\x29\x9b\xaf\xdd\x11\x12\x55\x96\x08\xb5\xe2\x88\x2e\x10\xaa\xc6\x9e\x6b\x3a\x8a\x3d\x63\xcd\x8c\x81\xd6\xf2\x7f\xaf\xfc\x00\x3f\x18\x20\x89\xda\xae\x1c\xe9\x3e\xce\x3d\xe7\x9e\xb9\x01\x21\x84\xac\x2c\x9b\x2c\x49\x0c\x79\x3e\x17\x29\xd7\xd6\x67\x64\xad\x13\x22\x49\x0c\x1a\xa4\x1a\xe2\x6e\x18\x8f\x36\x96\x8d\x06\xdd\xc2\xd5\x53\xf1\x75\x25\x84\xec\x50\xd6\x07\x82\x07\x44\x0f\x77\x44\x32\xe2\x47\xa0\x86\x58\xc8\xe0\x07\x28\x2d\x89\x16\xb2\x48\xc6\x23\x1b\xe1\xf1\xa9\xc3\x18\xdb\xa8\x95\xcd\x49\x0c\x5e\x1a\x86\xec\x80\x47\x26\xc0\xb9\x88\x93\x54\x83\xec\xc2\x66\xd9\x17\xd0\xb3\x2d\x70\x7d\x1e\x47\x93\x3c\xb7\xec\xb3\xb9\x3d\xf6\x0b\x2e\x33\xae\xe2\x17\x28\x17\xa1\x17\x06\xb2\x2c\x57\x49\xc4\xf4\xf0\x6a\x13\x1b\x7f\xc7\xa3\xf5\x7f\x9b\x8d\x65\x0f\xb2\x8c\x85\x68\xe2\xa8\xd9\x8e\xb0\x88\xf8\x2c\x62\xfa\xa7\x07\x5a\xe5\x79\x09\x73\x0c\x7b\x5a\x48\xb2\x85\x59\x10\x14\xd2\xe7\x79\x6f\x86\x6e\x58\x9d\xd6\x57\xea\x60\x0a\x1a\x45\xe8\x26\x7e\x0d\x43\x05\x65\x97\x75\x9c\x46\xed\x0d\xc6\xe4\xd0\xeb\xe9\x82\x2c\xd5\xc6\x23\xbb\x95\x77\x6a\xec\x70\x0a\xf5\xfe\x6a\x56\xc0\xe9\x19\x89\x9e\x04\x6d\xfb\x34\x1a\x8e\x49\x37\xeb\x86\x5d\x7a\x10\x2d\x4e\xc6\x0d\x55\xf1\x72\xcd\x83\x2c\x83\x48\xc1\x9f\xd9\xc3\xfa\x8d\xea\xf5\x05\x6a\xbe\x95\x0d\xe6\xa9\xd2\x22\x5e\x2d\x1f\x5f\xce\xa0\x57\x1c\xb4\x97\xfa\x1c\xb4\xf3\x70\xc5\xc7\xad\x2c\x03\xcd\x16\x93\x32\xe9\x72\xa3\x0a\xaa\x99\xb8\x5f\x59\xfc\xfd\xb1\x03\xa0\x9a\xce\xe8\x16\xc7\xf3\xe6\xbb\x9a\x9a\x8d\xa7\x55\x23\x35\xc5\x46\x4f\x36\x63\xd6\x4e\xe9\x68\xed\x28\x37\xf5\x23\x16\xa0\x89\x2b\xe4\xe9\x35\x36\x93\x3c\x72\x9a\x08\xc6\xf5\xc3\xd2\xeb\x5e\x9e\xb5\x16\x91\xd8\x83\x34\x5f\x00\x63\x99\xd1\xa9\x8e\x3b\xa3\x54\x82\x52\xef\x16\x92\x14\x0f\x71\xcc\x92\xde\xcb\xb8\x35\x43\x71\x84\xdf\xf5\x96\x16\xfe\x3d\x09\x5e\x81\x53\x57\x88\xe8\xe3\xeb\xbe\x01\xda\xb7\xc0\xc2\xaf\x57\x2f\x41\x89\x54\x06\xe0\xd0\x21\x7e\x62\x81\x14\x4a\x84\x7a\xb2\x04\xbd\x17\xf2\x75\x1a\x09\x42\xef\x49\x44\x78\x00\x52\x99\x0d\xb0\xf0\x9b\xe5\x1b\x50\xdc\xb9\xe0\x21\xdb\x5e\x34\x5a\x3b\xb7\xf2\x5b\x28\x05\xd7\xc0\xe9\xb1\x34\x95\x44\x33\xc1\xd5\xb4\xcb\xd0\x00\xf2\x96\x41\xfe\xa1\xc2\x7f\x0d\x6a\x80\xda\xb7\xf4\x1b\xe3\x54\xec\x8f\x6f\xab\x3b\x44\x1d\x7b\x7e\x70\x97\x44\x3f\x13\xbe\x05\x4f\x13\x59\xfc\x37\xba\xbb\xfb\xff\x93\x7d\xb5\xe2\x91\xd3\xba\x42\x24\x25\x0d\x42\x69\x9b\xc3\x2d\x90\xe2\x2d\x5c\x2a\xa9\x7f\xf9\xd8\x57\x62\x0d\xd3\xe3\x45\x41\x59\x06\x9c\xe6\xf9\xe0\xf7\x00\xc0\xa7\xec\xbe\x65\x09\x00\x00")
 
 func swarmagentvarsTBytes() ([]byte, error) {
 	return bindataRead(
@@ -1207,7 +1228,7 @@ func swarmagentvarsT() (*asset, error) {
 	return a, nil
 }
 
-var _swarmbaseT = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x54\x41\x6f\xdb\x3c\x0c\xbd\xe7\x57\x08\xfe\x02\xa4\x01\x5c\x27\xf9\x80\x5d\x02\xec\x90\xa1\x87\xf5\xb2\x05\xe8\xb0\x1d\x8a\x1e\x58\x87\x49\xb5\x59\x52\x20\xd2\x49\x3b\x43\xff\x7d\x90\xec\xda\x72\x1c\x74\x58\x36\xe4\x12\x99\xe4\x7b\x8f\xe2\x13\xab\x91\x10\xc9\x98\xf2\x27\x54\x90\x2c\x45\xf2\xc4\xbc\xa7\xe5\x6c\x56\x7f\xc9\x14\x68\xd8\xa1\x42\xcd\x19\xfc\x2c\x2d\x66\xb9\x51\x4d\x8c\x66\xff\xcf\x17\xef\xae\xe7\x8b\xeb\xf9\x62\xb6\xc1\x7d\x61\x5e\x7c\xde\x17\x54\xfb\x02\x18\xb3\xef\x64\xf4\x7f\x49\xea\xf1\x73\xa3\x19\x35\x7f\x45\x4b\xd2\x68\x4f\xb3\xc8\xe6\xfe\x57\x87\xf7\x60\x41\x21\xa3\xa5\x64\x29\xbc\x20\x21\xaa\xca\x82\xde\xa1\xc8\x56\x3b\xd4\xbc\x36\xa6\x58\x5b\xb3\x95\x05\x92\x73\x55\xc5\x0d\x87\x48\xc0\x87\x43\x3d\x65\x9c\x88\xcc\xb9\xb4\xaa\x50\x6f\x9c\x6b\x60\xe4\x56\x64\x1f\x81\xbe\x49\xbd\x31\x47\x6a\x3e\xfb\x40\x87\x71\xac\x63\x2d\x8a\x73\x69\x53\x1c\x03\x75\xf9\x0a\x88\xd1\xf6\x48\x47\x42\x84\xa2\xe4\x00\x56\xc2\x63\x81\xc3\x4e\xc6\x52\x6f\xf0\x39\x15\xe3\x20\x59\x2c\xdf\x9f\xed\xad\x91\x27\x44\x52\x55\xd9\x27\x50\xe8\xdc\xad\xaf\xf3\x70\x55\x0d\xf1\x2a\xef\x54\x16\x1d\xc1\xaa\x00\x7e\x00\x1b\x09\x7b\xcd\xf4\x37\x71\x4b\x77\x6c\x2c\xec\x70\x95\xe7\xa6\xd4\x1c\x25\xc4\x94\xfd\xa4\xcf\xdb\x2d\x21\xfb\xa9\xdd\xab\xb2\xb8\x6a\x5b\xbc\x9a\x28\x78\xee\xa7\xd2\x1a\x6d\xe8\x6a\x32\x4d\x3b\xbd\xd3\x87\x24\x3d\xcb\xd3\x54\xf9\x43\x80\xcf\x8d\xce\x81\x63\x06\xea\xc1\x7f\x00\x42\x9f\x3c\x99\xa6\x62\x02\x3b\xcd\x1d\xc7\xe4\x84\xa4\x9d\xfc\x8d\xa4\x1f\xd4\xeb\x33\x56\x70\x03\x0c\x7f\xa5\x62\x03\x0c\x6f\xa9\xe8\x2c\x34\xb4\x54\xfd\x7f\xe0\xaf\x30\xc8\xda\x64\xfd\x49\xd6\x16\xb3\x48\xa6\xb4\x79\xb0\xd8\xfd\x6f\x1f\xcb\xa8\x3f\xfe\xd3\x77\xd0\x45\x56\x07\x90\x05\x3c\xca\x42\xf2\xcb\x1d\x72\xff\xca\x06\xf2\x8e\x52\x07\xab\xb5\x62\x0e\x0a\xda\x17\x18\xb7\x5b\x10\xfe\x31\x12\x9d\x45\x8a\x2e\x72\x80\x7b\x59\x13\xff\xa8\x83\x4b\xe4\xfb\x53\x7d\x78\x73\xd5\x44\x56\x68\x09\x3a\x3f\x3c\x04\x3f\x98\x92\xf7\x25\x5f\xb8\x3a\x9b\xe2\x78\x5b\x34\x5a\x86\x1b\x6f\x90\xeb\x46\x6e\xf4\x2b\x00\x00\xff\xff\x62\x8f\x34\x24\x40\x06\x00\x00")
+var _swarmbaseT = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x94\x4d\x6b\xdb\x40\x10\x86\xef\xfa\x15\xc3\x36\x90\x04\x14\xd9\x2e\xf4\x62\xe8\xc1\x10\x68\x73\x69\x0d\x29\xed\x21\xe4\x30\x91\xc7\xce\xb6\xda\x5d\xb3\x3b\xb2\x93\x2e\xfb\xdf\xcb\x4a\xb2\x3e\x6c\x95\xb6\xa6\xf8\x22\x6b\x66\x9e\xf7\x9d\x0f\xdb\x27\x00\xe2\xc2\xe5\xcf\xa4\x50\xcc\x41\x3c\x33\x6f\xdd\x7c\x32\xa9\xdf\x64\x0a\x35\x6e\x48\x91\xe6\x0c\x7f\x96\x96\xb2\xdc\xa8\x26\xe6\x26\x6f\xa7\xb3\x77\x37\xd3\xd9\xcd\x74\x36\x59\xd1\xb6\x30\xaf\x31\xef\x0b\xa9\x6d\x81\x4c\xd9\x77\x67\xf4\x1b\x91\x46\x7e\x6e\x34\x93\xe6\xaf\x64\x9d\x34\x3a\xca\xcc\xb2\x69\xfc\xd4\xe1\x2d\x5a\x54\xc4\x64\x9d\x98\x43\x34\x04\xe0\xbd\x45\xbd\x21\xc8\x16\x1b\xd2\xbc\x34\xa6\x58\x5a\xb3\x96\x05\xb9\x10\xbc\xe7\x46\x03\x04\xc6\x70\x55\xef\x32\x16\x90\x85\x90\x7a\x4f\x7a\x15\x42\x83\x91\x6b\xc8\x3e\xa2\xfb\x26\xf5\xca\xec\x5d\xf3\x1a\xa0\xcf\xd8\xd7\xb1\x96\x12\x42\xda\x14\xf7\x41\x5d\xbe\x42\xc7\x64\x07\xa2\x09\x40\x55\x24\x76\x68\x25\x3e\x15\x74\xda\xc9\x85\xd4\x2b\x7a\x49\xe1\xa2\xb2\x0c\xf3\xf7\xa3\xbd\x35\xf6\x00\x84\xf7\xd9\x27\x54\x14\xc2\x5d\xac\x8b\x38\x5f\x23\x0e\xf6\x8e\x6d\xb9\x3d\x5a\x55\xc1\x77\x68\x7b\xc6\x0e\x99\x71\x12\x77\xee\x9e\x8d\xc5\x0d\x2d\xf2\xdc\x94\x9a\x7b\x09\x7d\xc9\x61\xd2\xe7\xf5\xda\x11\xc7\xad\x3d\xa8\xb2\xb8\x6a\x5b\xbc\xba\x54\xf8\x32\x4c\x75\x4b\xb2\x55\x57\x97\xd7\x69\xe7\xf7\xfa\x51\xa4\xa3\x3a\x4d\x55\x14\x8d\x78\xef\x3f\x10\x0f\x81\x31\x14\xb7\xac\x59\x34\x13\x0c\x61\x00\x6b\x37\x7c\x2b\xdd\x8f\xfe\x00\x87\x4a\xb7\xc8\xf8\xb7\x6a\x2b\x64\xfc\xad\x5a\x77\x12\xa7\x27\x52\x3f\x27\xa3\x8b\xa9\x8f\x66\xb8\x99\x6a\x91\xc2\x92\x33\xa5\xcd\xab\x93\x79\xf8\xe3\xf1\x27\xc3\x75\x1e\xdf\x75\x17\x59\xec\x50\x16\xf8\x24\x0b\xc9\xaf\xf7\xc4\xfd\x94\x11\x7b\x7b\x19\x7f\xe7\x9a\x5b\x33\x3b\x85\x07\xa7\xdd\x00\xbc\xa7\xc2\xd1\x3f\x93\xdc\x28\xa9\x37\xc8\x13\xee\x79\x4d\xfc\xa7\x0e\xce\xb1\x1f\xbf\x55\xcf\x90\x1c\xc7\x4f\xf8\xf5\x29\xb4\x02\x0d\x3c\x01\x78\x8c\xf3\x11\xa6\xe4\x6d\xc9\x67\xfe\x15\x36\xc5\x1d\xb3\xf5\xd2\x4f\xad\x1d\x9c\xe4\x86\x24\x24\xbf\x06\x00\x4a\xb9\x18\xe3\x10\x06\x00\x00")
 
 func swarmbaseTBytes() ([]byte, error) {
 	return bindataRead(
@@ -1247,7 +1268,7 @@ func swarmmasterresourcesT() (*asset, error) {
 	return a, nil
 }
 
-var _swarmmastervarsT = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x59\x7b\x73\xdb\xb8\x11\xff\xdf\x9f\x02\x65\x7d\x47\x69\x86\xd4\xc3\x76\x92\x8b\x3a\x97\x19\xc7\x76\x72\x9a\xc4\xb6\x6a\xc6\xee\xb4\x96\xa7\x03\x91\x90\x84\x33\x09\xf0\x00\x50\xb2\xa3\xd3\x77\xef\x2c\x9f\xe0\x4b\x8e\xdd\x36\x9d\x76\xea\x3f\x76\x2c\xee\xe3\xb7\xbb\x00\x76\xf1\x40\x08\x21\x03\x7b\x01\x65\xd7\x92\x08\x86\x03\x62\x8c\x90\x71\x1b\x62\x81\x03\xa2\x88\x90\x1d\xd3\xa7\x2c\x7a\x38\xd6\x45\xcc\xee\x9d\x61\xed\xc5\xaa\x01\x7e\xb8\x39\x97\x13\x22\x26\x9c\xfb\xc6\x08\x0d\x07\x83\x94\x83\x43\x7a\x43\x84\xa4\x9c\x9d\x92\x39\x8e\x7c\x05\x86\x0f\x06\xc3\xd7\xf6\xe0\xd0\x3e\x1c\x18\x16\xda\xdb\x6c\xe8\x1c\xf5\x2e\x85\xbb\x24\x52\x09\xac\xb8\x98\x08\x3e\xa7\x3e\xe9\x8d\xa5\xb3\xc6\x22\x38\xe7\x1e\xd9\x6e\x13\x7b\x2e\x67\x73\xba\x88\x04\x39\xf1\x23\xa9\x88\x70\x5c\x41\x43\xf5\x81\xfa\xb1\xc7\x39\xd7\x96\xa0\x18\x70\x8f\xd8\x6e\x22\xd8\x93\x4b\xc3\xda\xdb\x6c\x88\x2f\x5f\x66\xac\x66\x88\x79\x99\x1d\xbc\x20\x4c\x9d\x44\x52\xf1\x20\x31\x11\x67\xcf\xe5\xcc\xc5\xaa\x63\xf6\x23\x29\xfa\x33\xca\xfa\x8c\x2f\xa3\x10\xc5\xff\xce\xb0\x5c\x22\xdb\x45\x53\xa3\xf8\xd9\xe7\xa1\xea\xe3\xaf\x91\x20\x7d\x97\x33\x85\x29\x23\x42\xf6\x4d\x6b\x85\x05\xc5\x33\x9f\xc8\x8e\xd9\xee\xb0\xd9\xb5\x90\x89\xca\xc2\x89\xcc\x98\x49\x85\x7d\x7f\x92\x8f\xa5\xd9\xb5\x4c\xf4\xee\x1d\xea\xaf\xb0\xe8\xfb\x7c\x91\x61\x26\xe2\xf6\x8c\x73\x05\xe3\x10\xf6\x7c\xbe\x40\x07\xef\x7e\x1c\xa2\x1f\xa7\x06\xfa\x51\x1b\xee\x38\xde\xab\x88\x9d\x04\x5e\x29\x52\x11\x31\x37\xf0\x46\x53\x86\x6c\x84\x6e\x8b\x48\xad\x96\xd8\x68\xe2\x9a\x96\x59\x74\x37\x65\x53\x16\x43\xa1\x1a\x56\x36\x30\x39\x1e\xc0\x80\x39\xc2\xd4\x08\xfd\x3e\x65\x28\xfd\xfb\xe3\x1f\x72\xe8\xe2\xa3\x69\x99\x32\xf2\x38\x0a\xee\x3d\x2a\x90\x1d\x56\xe2\xd7\x05\xb5\x24\xd6\x86\x16\xb2\x97\xc8\x86\x58\x2d\x47\xdf\x1c\x59\xaa\x43\x44\x40\x25\x2c\x07\x39\x42\x53\x63\xf0\xe6\xe8\x68\x6a\xa4\x01\x6b\xf1\x9e\xc7\xeb\xa9\xb4\x90\xda\x46\x53\x4f\x88\xe6\x77\x80\x41\xfa\x84\x47\x4c\x35\x4c\x8d\x84\x7b\x73\x7e\x81\x03\x32\x11\x64\x4e\x1f\x5a\x85\x3e\x50\x21\xd5\xb1\xe7\x89\x4b\x57\x11\x75\xd4\x20\x87\xcb\x45\xc1\xaa\xb0\x43\x2e\x55\xea\x75\x92\xc1\xeb\xab\x71\x5d\xaa\x02\x96\x39\x15\xe7\x25\x29\x10\x9f\xa1\x00\x65\x95\xe1\x17\x2c\x1d\xe2\x0a\xa2\x64\xb6\x06\x7d\x8d\x9d\xb2\x0c\x34\xda\x4b\x86\xf4\x76\x0f\xe5\x7f\x9b\x8d\xc0\x6c\x41\x10\xda\x5f\x8d\x99\x47\x1e\x2c\xb4\xbf\x82\xc2\x84\x46\x3f\x57\x40\xca\x08\x85\x3e\x9d\x67\xba\xdb\x2d\xb2\x90\x5e\x0a\x34\xb1\xca\x6f\x84\x0c\xc9\x23\xe1\x92\x9b\xa4\x0a\xd6\xf9\x08\x19\xd4\x33\x46\x0d\x65\xf7\x13\x79\x8c\xb5\xc6\xa7\x9b\x4d\x8e\x0c\x73\xa6\x66\x63\x6b\xd5\x61\xe3\xe8\x4e\x88\x50\x74\x4e\x5d\xac\x88\x34\x46\xb7\x35\xa9\x2c\x2b\xfb\x6e\x96\x14\x97\x88\x38\x27\x49\x76\x7a\x37\x55\x2b\xb5\x88\x8b\xe4\xb8\x4f\x25\xa7\x39\x41\xb1\xb7\x6e\x01\x71\x2d\x7c\x03\x7d\x73\x3e\x34\xdf\xae\xaf\x3e\x6f\x36\xb9\x17\x8d\x89\x42\xa8\xee\x53\x9b\xaf\x77\x7b\x6d\x9a\x65\x8d\xbb\xb8\x99\x69\x7d\x21\x99\xd4\xc7\x2b\x4c\x7d\x3c\xa3\x3e\x55\x8f\x0e\x51\x2d\x0b\x96\x6b\xfd\xef\x22\x59\x48\xc8\xb4\x13\x0b\x36\x2e\x9b\xb0\x4d\x0b\x69\xaa\xb0\xee\x9c\x68\x9e\x2f\x98\xb4\xa5\xa2\x8f\x44\x9d\xf8\x58\x4a\xea\xea\x1d\x54\xab\x0c\xb5\x26\x5f\xaa\x1a\x77\xb5\x7e\x59\x56\xdd\x6c\x7a\xe7\xf1\x87\x6c\xbd\xc4\x8c\xed\xb6\xc8\x02\x2a\xa9\xb5\xf6\xc7\xff\xba\x86\x38\x35\xf4\x0e\x95\x84\x77\xc6\xbc\x90\x53\xa6\x4e\x2f\x9c\xa2\xaa\xc6\x71\x2a\xee\xf3\x35\x11\x9d\x7a\xa2\x1b\x75\xb2\x31\xd4\x6c\x7f\x9e\xbd\xc7\xee\x3d\x61\x1e\x6c\xaf\x2e\xb2\xdd\xd9\x33\xe7\x50\xc8\xb9\xff\xe4\xc4\x29\x81\x8e\x4f\x63\x1c\x41\x92\xc2\x35\xf6\x3a\xe6\x39\x75\x05\x97\x7c\xae\x7a\x17\x44\xad\xb9\xb8\xef\xfb\x1c\x7b\xef\xb1\x8f\x99\x0b\x99\xac\x97\xf4\xcf\xb3\xc4\x93\x26\xfb\x93\x93\x78\xf8\x52\x9c\xb6\x26\x06\x8e\xc0\x10\xf5\xe7\x22\xee\xf5\x5e\xa6\x17\x09\xac\xa0\x93\xf6\xcb\x51\x55\xcd\x3f\x89\xff\xd2\x8c\xfa\xb3\x0f\xe0\xd1\x19\xf3\x9e\x97\xd7\x97\xe3\x3d\x07\x67\x12\xcd\x7c\xea\x8e\x27\xd0\x4e\x89\x94\x2f\x05\xa5\xa1\xdd\x94\xde\x96\xa9\x0b\x8a\xbb\x7d\x4c\xbb\x79\xb9\x72\x8c\x65\x52\x1d\x6e\x2e\xce\xbe\x94\x8b\xcd\x0d\x23\xca\x89\x66\x8c\xa8\x74\x96\xd4\x57\x91\x2e\xd2\x5e\xb5\x12\x89\x16\x13\x09\xb3\x74\xa0\x29\x3e\xbf\x30\x73\x35\x9b\xab\x22\x88\xdd\x4b\x6a\x45\x85\x8a\xb0\x9f\xfe\x2c\x2f\xaa\x32\xaf\x98\xda\x3b\x73\x56\x77\x7c\x95\x66\xcb\x32\xfb\x32\x96\xac\xd4\xd5\x6a\xfc\x3a\x48\xdd\x85\x67\x65\x07\xa0\x9f\x9e\x23\xb5\x2e\x5a\xde\x87\x26\xdb\x5e\x19\xfa\x54\x95\xea\xea\x1c\xa4\x4e\x38\x93\xc4\x8d\x14\x5d\x11\x47\x61\x05\x8b\x00\x22\xed\xd5\xc6\xb7\xb2\xb7\x8d\x6d\x3e\xb1\x01\x96\x66\xf7\xf6\xf0\xae\xcd\x8e\x56\xf5\xdb\xaa\x59\xdd\xdc\xe0\x0e\x7c\x7b\x6a\xe7\x0d\x92\xc3\x6f\x96\x3c\xb8\x6b\x8a\x57\xdf\xf0\xbf\xa4\x16\xb4\x8f\x5a\xbc\xee\x6b\x25\xe8\xe6\xdc\xa1\x5f\xeb\x57\x09\x3a\x53\x57\x2a\x0c\xd6\x54\x74\xac\x2a\x8a\x23\x97\x63\x36\xe3\x11\xf3\x2e\xb0\xba\x8a\x7c\x32\xf6\xbe\x61\x1c\xf2\xae\x42\x4b\xba\xb2\xef\x38\xbf\xd8\x4f\x1e\x96\x6a\x95\x42\x2e\x27\x5c\xa8\x83\x83\xb2\x27\x4f\xa6\xbb\xdc\x23\x2d\x13\xd0\x13\x43\xff\x32\x1f\xfe\xe9\x6c\x3c\xdb\x1f\xad\xc3\x96\xad\x15\x07\x8f\xe2\x00\xa2\x1f\x03\xe2\xf3\xcf\x0e\x2f\x5b\x47\x1a\x5c\x1f\x94\xf6\xf9\xda\x41\xe8\x99\x08\xbb\x72\xd8\x80\x93\xef\xfd\xff\x7d\x91\x0d\xbf\x3b\xe2\xc1\x77\x47\x3c\xfc\xee\x88\x47\xcd\x88\x7b\x1a\xae\xc1\xe5\x38\xc0\x0b\x72\x39\x9f\x13\x01\x48\xd7\xb3\x88\xa9\xc8\x21\x62\x45\x44\x5e\x85\x52\xa1\x78\xbf\x25\x97\x89\xe0\x09\x66\x9c\x51\x17\xfb\xcf\xbe\xe0\xac\x16\x60\xb0\x96\x5c\x66\x56\x01\x9d\x4f\xd7\xc0\x1c\xbe\xee\x0d\x8e\xec\xcf\x5f\x9c\x2a\x3f\xbd\x77\xcd\x65\x7a\x07\x83\xe1\x9b\xc1\xeb\xe1\xdb\x61\x6d\x8b\xd4\x0a\xda\x86\x79\x04\xf6\x5e\x25\xa8\xad\xa0\x47\x3a\xe8\xc0\xb0\x50\xe5\x78\xec\x73\x37\xd9\xc0\x1b\x23\xfd\x86\xa6\xd8\x1e\x7d\x14\x3c\x0a\x3b\xdd\x5e\x26\x98\x17\x98\x44\xac\x74\x2f\x90\x8a\xe4\x83\x9a\x0d\x61\xc6\xa8\x36\xf7\x1c\xdc\xec\xde\x06\xdc\xeb\x60\xcf\xeb\x1c\x58\x3e\x61\x0b\xb5\xec\x34\x5b\xee\x76\x2d\x90\x1a\x3e\x25\xd5\x2d\xf6\x07\x4d\x97\x5f\xe0\x89\x47\x25\xf8\xe1\xe5\xf9\x95\x72\xf9\x89\x3c\x4e\xb0\x5a\x96\xcf\xc6\x4b\x1e\x90\xca\xf9\xa6\x7a\xdf\x86\xcc\x7e\x4f\xca\x65\x1f\x47\x6a\xc9\x05\xfd\x4a\xbc\xbf\xdf\x93\x47\x69\x6a\x57\x01\xf1\x7d\x99\xe2\x02\x2f\xc8\xb1\xeb\xc2\x31\xfd\x94\xca\xfb\xfc\xee\xac\xb8\xa4\x4f\x85\xd2\x4b\xfa\x57\xf6\xe0\xb5\x3d\x7c\x55\xbb\xe5\x2f\x9b\x32\x46\xe8\x60\x50\x88\x94\x99\x20\x7e\xbc\x20\xe9\x5d\x83\x47\x57\xe5\x55\xaa\x3d\x1b\x98\x5d\xab\x89\x55\x36\xa7\x77\x3d\x0f\x2b\x5c\xe6\x26\x8b\xdb\x21\x04\xca\xc2\xdb\x37\x79\x6e\x1b\x84\x48\x3c\xe9\x90\x01\xd3\xd2\x78\x0d\xc4\x05\x42\x81\x70\x20\x11\x90\x21\x90\x37\x40\x60\xa4\x8c\x5f\x81\x84\x40\x56\x40\x0e\x80\xfc\x04\x84\x00\xb9\x07\xf2\x1b\x90\x35\x90\x43\x20\x6f\x81\xcc\x81\x40\x25\x30\xa0\x68\x18\x0f\x40\x8e\x80\x60\x20\x0b\x20\xb0\xd0\x0c\x09\xe4\x11\xc8\x2b\x20\x33\x20\x4b\x20\x0c\x88\x02\xf2\xd5\xc8\x27\x76\x73\x54\xc5\xbd\x4e\x3a\x49\xb5\x9c\x36\x6b\x94\x8e\x8e\xab\x60\xf7\xf8\x96\x4d\xbc\xc7\x92\xe4\xfb\xff\x88\xd1\xdf\x22\xe2\x28\x41\xd9\xa2\xd3\x56\x91\xdb\x8e\x8d\x0d\x0b\x13\x56\x52\x36\xd6\x9b\xcd\x47\xa2\x60\xbb\x78\x8e\xc3\xed\xb6\x5a\xbd\xda\xc7\xb7\x39\x57\xba\xdb\xda\x61\x23\x5f\x29\xe7\x98\xe1\x05\xf1\x76\x2f\x11\x5d\xa8\x78\xd3\x3a\xb2\x0f\x07\x76\x28\xc8\x8a\x92\x75\xcd\x74\xf5\xcc\x5b\x4e\x73\xe5\xb8\x5a\xe2\xed\x38\x64\x35\x87\x16\x97\x85\x81\x59\x3f\x4c\x49\xb9\xbc\x72\x8e\x93\xbb\x81\x4f\xe4\xb1\xb6\xd1\xae\xf0\xe3\x72\x9a\x27\xe6\x2f\x94\x79\x7c\x9d\xe5\xc4\x32\xd6\xc9\xef\xe3\x9d\x0f\x87\x4d\x42\xda\xc9\x44\x67\x4f\xb0\x94\x6b\x2e\xbc\x9d\x36\x32\xa1\xea\x9b\x49\xea\x5c\xa9\x0d\xe7\x67\xeb\x94\x99\x75\xee\xba\x5a\xde\xe2\x9f\x94\x74\xee\xa3\x7c\xbc\x4f\xb1\xc2\x2e\x61\x70\x36\x5a\x53\xb5\xb4\x4f\xf2\x6b\xca\x26\x4d\xad\x37\xfa\x58\x11\xa9\x32\x21\x49\xd9\xc2\x27\x7f\x8e\xb8\x8a\xd3\x67\x56\x72\xa3\xdf\x9c\x1e\x8b\x45\x14\x10\xa6\x4a\xef\x3e\xe6\x3e\xce\x3e\xa3\x9f\x51\xb9\x5b\x68\xb6\x61\xd7\x63\x27\x1b\x8e\x78\x8e\x8d\x27\xa8\xe9\x6a\xa7\xf6\x08\xb3\x4b\xa6\x78\x15\x6a\xc5\x44\x26\xfa\x13\xaa\x8f\xb8\x1e\x55\x71\xea\x43\xfb\x94\x85\x51\x7c\x83\x0b\xa1\xfc\xe0\xfc\xd5\xf9\x72\x76\x7e\x7a\x35\xbe\x39\xfb\x61\x3a\x3d\xfe\x1a\x09\x02\x49\x9f\x4e\x13\x75\xf8\xbf\x37\xa3\x0c\x20\xf6\x79\xa4\x9e\xa9\xea\x10\x15\x85\x89\x0b\xbd\x50\x0e\x63\x2b\x31\xbe\xa3\x04\xc1\x01\xfa\x19\x5d\x90\xb5\x7d\x39\xfb\x95\xb8\x0a\x39\x8f\x52\x91\xa0\x37\xbe\xec\x01\x44\x2a\x51\xb8\x6b\xa1\xce\x6d\xca\x83\xfd\xdc\xdd\x68\x74\x19\x12\xd6\xd5\x3e\x1f\xbb\x2e\x91\xf2\x6e\x34\xba\x22\xd8\xd3\x19\xce\x12\x0b\x92\x7d\x07\x1f\xa4\x68\x83\x4e\x60\x41\x90\x88\x4e\xa3\xc4\x09\x0f\x42\x41\xe2\x37\xc6\xde\xc7\xbf\xd1\x30\xd1\xe8\xe8\x71\x59\xe8\xb6\x59\x5e\xfb\x3f\x8d\xe1\x94\xb8\xe9\xb7\x6e\xea\x59\x0f\xc0\xbf\xf0\x33\xe6\x75\xba\xe8\x77\x74\x19\x29\x1b\x62\xe8\x68\xe9\x07\xc9\x31\x5b\xf1\x7b\x62\x9f\x3d\x64\x06\x3b\xe6\x66\xb0\x45\x9b\xe1\xd6\x44\xf6\x5c\x1f\x2c\x0b\x15\xd3\x17\x34\x77\xcc\x93\xd2\xa4\x0f\xf9\x9a\x08\xb9\x24\xbe\xdf\x23\x0f\x04\xd9\x67\x0f\xf1\xa5\x0f\x67\x13\xee\x53\xf7\x11\x5d\x33\x01\x7b\x5a\xea\x2a\xe2\x21\xdb\xe5\x41\x80\x99\x87\xa6\x46\x79\xce\xef\x5a\x63\x95\x69\xdd\x3a\x71\xe3\x49\x3e\x35\xd0\x3b\xf4\xdc\x49\x97\xbd\x28\xb4\x14\xb3\xfc\xda\x5f\x40\xe8\x87\x87\x3f\xbd\x4d\xdb\x21\x14\xe4\xac\xe0\xb5\x3d\x89\x96\xd3\xf8\xbf\xf7\x34\x9a\x06\xf6\xff\xc7\xd1\x6f\xca\xc8\xce\xe7\xd1\x7a\x62\xaa\x70\xb0\x1f\x21\x2f\x05\x8c\x95\xff\x23\x2f\xb2\x68\xaf\xfc\x39\x67\xff\x23\x00\x00\xff\xff\x02\xdd\x13\x40\xf2\x24\x00\x00")
+var _swarmmastervarsT = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5a\x7d\x73\xdb\xb8\xd1\xff\xdf\x9f\x02\x0f\x1f\xdf\x51\x9a\x21\xf5\x62\x3b\xce\x45\x9d\xcb\x8c\xcf\x76\x12\x4d\x62\x5b\x35\x63\x77\x5a\x4b\xd3\x81\x48\x48\xc2\x99\x04\x78\x00\x28\xdb\xd1\xe9\xbb\x77\x96\x6f\x02\xdf\x24\xdb\xbd\xa6\xd3\x4e\x75\x9e\x3d\x89\x58\xec\x6f\x77\xb1\xd8\x5d\x02\x41\x08\x21\x03\x7b\x01\x65\x37\x92\x08\x86\x03\x62\x0c\x90\x71\x17\x62\x81\x03\xa2\x88\x90\x2d\xd3\xa7\x2c\x7a\x3c\xd1\x59\xcc\xf6\xc4\xb0\xf6\xe2\xa9\x01\x7e\xbc\xbd\x90\x23\x22\x46\x9c\xfb\xc6\x00\xf5\x7b\xbd\x74\x04\x87\xf4\x96\x08\x49\x39\x3b\x23\x33\x1c\xf9\x0a\x04\x1f\xf4\xfa\xc7\x76\xef\xd0\x3e\xec\x19\x16\xda\x5b\xad\xe8\x0c\x75\xae\x84\xbb\x20\x52\x09\xac\xb8\x18\x09\x3e\xa3\x3e\xe9\x0c\xa5\xf3\x80\x45\x70\xc1\x3d\xb2\x5e\x27\xf2\x5c\xce\x66\x74\x1e\x09\x72\xea\x47\x52\x11\xe1\xb8\x82\x86\xea\x03\xf5\x63\x8d\xf3\x51\x5b\xc2\xc4\x80\x7b\xc4\x76\x13\xc6\x8e\x5c\x18\xd6\xde\x6a\x45\x7c\xf9\x3a\x61\x15\x41\xcc\xcb\xe4\xe0\x39\x61\xea\x34\x92\x8a\x07\x89\x3e\xa0\xcb\x9d\xcb\x99\x8b\x55\xcb\xec\x46\x52\x74\xa7\x94\x75\x19\x5f\x44\x21\x8a\xbf\x4e\xb1\x5c\x20\xdb\x45\x63\x63\xf3\xb3\xcb\x43\xd5\xc5\xdf\x22\x41\xba\x2e\x67\x0a\x53\x46\x84\xec\x9a\xd6\x12\x0b\x8a\xa7\x3e\x91\x2d\xb3\x59\x61\xb3\x6d\x21\x13\x15\x99\x13\x9e\x21\x93\x0a\xfb\xfe\x28\x5f\x4b\xb3\x6d\x99\xe8\xfd\x7b\xd4\x5d\x62\xd1\xf5\xf9\x3c\xc3\x4c\xd8\xed\x29\xe7\x0a\xd6\x21\xec\xf8\x7c\x8e\x0e\xde\xff\xd8\x47\x3f\x8e\x0d\xf4\xa3\xb6\xdc\xb1\xbd\xd7\x11\x3b\x0d\xbc\x82\xa5\x22\x62\x6e\xe0\x0d\xc6\x0c\xd9\x08\xdd\x6d\x2c\xb5\x1a\x6c\xa3\x89\x6a\x9a\x67\xd1\x64\xcc\xc6\x2c\x86\x42\x15\xac\x6c\x61\x72\x3c\x80\x01\x71\x84\xa9\x01\xfa\x7d\xcc\x50\xfa\xf9\xff\xff\xcb\xa1\x37\x0f\x4d\xcb\x94\x91\xc7\x51\x70\xef\x51\x81\xec\xb0\x64\xbf\xce\xa8\x39\xb1\xb2\xb4\xe0\xbd\x84\x37\xc4\x6a\x31\x78\xb6\x65\xe9\x1c\x22\x02\x2a\x61\x3b\xc8\x01\x1a\x1b\xbd\xb7\x47\x47\x63\x63\xcc\xca\xbe\xbd\x88\xf7\x53\x61\x23\x35\xad\xa6\xee\x10\x4d\xef\x00\x03\xee\x29\x8f\x98\xaa\x09\x8d\x64\xf4\xf6\xe2\x12\x07\x64\x24\xc8\x8c\x3e\x36\x32\x7d\xa0\x42\xaa\x13\xcf\x13\x57\xae\x22\xea\xa8\x86\xaf\x90\x37\xc0\x3d\xc5\xe1\x90\x4b\x95\x6a\x9d\x84\xeb\xcd\xf5\xb0\xca\x55\x02\xcb\x94\x82\x40\x48\x13\xc4\x17\x48\x40\x59\x66\xf8\x84\xa5\x43\x5c\x41\x94\xcc\xf6\xa0\xaf\x0d\xa7\x43\x06\x1a\xc4\x63\x08\xdd\xa5\xff\x87\xbf\xd5\x4a\x60\x36\x27\x08\xed\x2f\x87\xcc\x23\x8f\x16\xda\x5f\x42\x62\x42\x83\x9f\x4b\x20\x45\x84\xec\x13\x6b\x93\xce\x5d\xaf\x91\x85\xf4\x54\xb0\xf9\xac\x4a\xbf\x11\x32\x24\x8f\x84\x4b\x6e\x01\xcc\x18\x54\xc7\x11\x32\xa8\x67\x0c\x6a\xd2\xee\x67\xf2\x14\xcf\x1a\x9e\xad\x56\x39\x32\xc4\x4c\x45\xc6\xda\xaa\x3c\x32\x62\xeb\x4e\x89\x50\x74\x46\x5d\xac\x88\x34\x06\xba\x3f\x32\xab\x12\xaf\xec\xbb\x99\x53\x5c\x22\x62\x9f\x24\xde\xe9\xdc\x96\xa5\x54\x2c\xde\x38\xc7\xdd\xe5\x9c\x7a\x07\xc1\x7f\x86\xbb\x81\xb8\x11\xbe\x81\x9e\xed\x0f\x4d\xb7\x9b\xeb\x2f\xab\xd5\xbe\xbb\xcd\x51\x08\x55\x75\x6a\xd2\x75\xb2\xd7\x34\xb3\x38\x63\x12\x17\xb3\xcd\x13\x23\x09\xea\x93\x25\xa6\x3e\x9e\x52\x9f\xaa\x27\x87\x14\x6a\x83\xb6\x01\xb8\x56\xff\x60\x5b\xc6\x1b\xcd\x4e\x24\xd8\xb8\x28\xc2\x36\x2d\xa4\x4d\x85\x62\xec\x44\xb3\x7c\xc3\xa4\x25\x15\x7d\x24\xea\xd4\xc7\x52\x52\x57\xaf\xa0\x5a\x66\xa8\x14\xf9\x42\xd6\x98\x54\xea\x65\x71\xea\x6a\xd5\xb9\x88\x1f\x64\xfb\x25\x1e\x58\xaf\x37\x5e\x40\x85\x69\x8d\xf5\xf1\x3f\xae\x20\x8e\x0d\xbd\x42\x25\x5e\x39\x67\x5e\xc8\x29\x53\x67\x97\xce\x26\xab\xc6\x0e\x56\xdc\xe7\x0f\x44\xb4\xaa\x8e\xae\x9d\x63\xb6\x2b\xb2\xbf\x4c\x7f\xc1\xee\x3d\x61\x1e\xb4\x57\x97\x59\x77\xf6\xc2\x18\x0a\x39\xf7\x77\x06\x4e\x01\x74\x78\x16\xe3\x08\x92\x24\xae\xa1\xd7\x32\x2f\xa8\x2b\xb8\xe4\x33\xd5\xb9\x24\xea\x81\x8b\xfb\xae\xcf\xb1\xf7\x0b\xf6\x31\x73\xa1\xb5\xd0\xdd\x9d\x89\x49\x34\xa9\x93\x3f\x3a\x8d\x97\x6f\x78\xd6\x60\x4f\xce\x78\x06\x4b\xd4\x9d\x89\xb8\xd6\x7b\xd9\xbc\x48\x60\x05\x95\xb4\x5b\xb4\xaa\x2c\x7e\x27\xfe\x6b\x3d\xea\x4f\x3f\x80\x46\xe7\xcc\x7b\x99\x5f\x5f\x8f\xf7\x12\x9c\x51\x34\xf5\xa9\x3b\x1c\x41\xed\x26\x52\xbe\x16\x94\x86\x25\xd0\xad\xa1\x0b\xf1\xb6\x5d\xc7\xb4\x9a\x17\x33\xc7\x50\x26\xdd\xf3\xed\xe5\xf9\xd7\x62\xb2\xb9\x65\x44\x39\xd1\x94\x11\x35\x3c\x6b\x48\x57\x3a\x4b\x73\xd6\x4a\x38\x1a\x44\x24\x83\x5a\x17\xa6\x3f\x7e\xa5\xe7\x2a\x32\x97\x1b\x23\xb6\x6f\xa9\x25\x15\x2a\xc2\x7e\xfa\xb3\xb8\xa9\x8a\x63\x9b\xd0\xde\xea\xb3\xaa\xe2\xcb\xd4\x5b\x96\xd9\x95\xb1\x9e\xa5\xbc\x5a\xb6\x5f\x07\xa9\xaa\xf0\x22\xef\x00\xf4\xee\x18\xa9\x54\xd1\x62\x1f\x2a\x63\x48\x19\xfa\x54\x15\xf2\xea\x0c\xb8\x4e\x39\x93\xc4\x8d\x14\x5d\x12\x47\x61\x05\x9b\x00\x2c\xed\x54\xd6\xb7\x28\xf3\x28\x96\xa9\xa9\x55\x0b\x6c\xb6\xef\x0e\x27\x4d\x72\xb4\xac\x5f\x75\x47\x93\xb8\xde\x04\x74\xb3\x9e\xc1\xd9\x7f\x36\xe7\xc1\xa4\xce\x5e\xbd\xe1\x7f\x4d\x2e\x68\x5e\xb5\x78\xdf\x57\x52\xd0\xed\x85\x43\xbf\x55\x8f\x12\xf4\x41\xbd\x90\x6e\x04\x56\xa6\xe8\x58\x65\x14\x47\x2e\x86\x6c\xca\x23\xe6\x5d\x62\x75\x1d\xf9\x64\xe8\x3d\x63\x1d\xf2\xaa\x42\x0b\x73\x65\xd7\x71\x3e\xd9\x3b\x5f\x96\xca\x9e\x75\xe4\x62\xc4\x85\x3a\x38\x28\x6a\xb2\xd3\xdd\x7a\x49\x88\xb5\x71\x9c\x4f\x89\xa0\x3f\x4c\x87\x7f\xda\x1b\x2f\xd6\x27\x53\xe8\xcb\xb4\xa8\x89\xf6\xe2\x71\xb7\x57\xf7\x1a\x10\xbf\xff\x6c\xd1\xb2\x71\xa5\xc1\x75\xbd\x42\x9f\xbf\xb6\x5e\x8b\xb0\xcd\x87\x35\x38\xe9\xb7\x89\xf5\xaf\xb3\xac\xff\xdd\x11\x0f\xbe\x3b\xe2\xe1\x77\x47\x3c\xfa\xee\x88\x6f\xbe\x3b\xe2\x71\x3d\xe2\x9e\x86\x6b\x70\x39\x0c\xf0\x9c\x5c\xcd\x66\x44\xc0\xde\xb8\x99\x46\x4c\x45\x0e\x11\x4b\x22\xf2\x4c\x9b\x32\xc5\x3d\xa5\x5c\x24\x8c\xa7\x98\x71\x46\x5d\xec\xbf\xf8\x10\xb7\x5c\x64\x40\x5a\x72\x60\x5b\x06\x74\x3e\xdf\xc0\x60\xff\xb8\xd3\x3b\xb2\xbf\x7c\x75\xca\xe3\xe9\xd9\x72\xce\xd3\x39\xe8\xf5\xdf\xf6\x8e\xfb\xef\xfa\x95\x36\xb0\x11\xb4\x09\xf3\x08\xe4\xbd\x49\x50\x1b\x41\x8f\x74\x50\x38\xce\x2e\x1d\x01\xf8\xdc\x4d\x5e\x52\x8c\x81\xb6\xc0\x5a\x0b\xf8\x51\xf0\x28\x6c\xb5\x3b\x19\x63\x9e\x44\xe1\xaf\x58\x04\x33\x96\x7c\x51\xb3\x25\xcc\x06\xca\x0d\x4c\xf6\x1c\x7a\x87\x80\x7b\x2d\xec\x79\xad\x03\xcb\x27\x6c\xae\x16\x85\xb6\x29\x63\x34\xdb\xed\xb6\x05\x5c\xfd\x5d\x5c\xed\x4d\x0f\x54\x77\xc0\x07\x9a\x78\x54\x42\x6e\xf5\x72\xff\x4a\xb9\xf8\x4c\x9e\x46\x58\x2d\xf4\x88\x36\xbb\x0b\x1e\x90\xd2\x3b\x5c\xf9\x4c\x11\x99\xdd\x8e\x94\x8b\x2e\x8e\xd4\x82\x0b\xfa\x8d\x78\x7f\xbf\x27\x4f\x32\x6d\x06\x92\xe0\x83\x33\x41\xc5\x05\x9e\x93\x13\xd7\x85\xa3\x88\x33\x2a\xef\xf3\xf3\xc1\xcd\x45\x44\xca\x94\x5e\x44\xbc\xb1\x7b\xc7\x76\xff\x4d\xe5\x26\xa3\x28\xca\x18\xa0\x83\xec\x24\x36\xc0\x8f\xc5\x41\xb8\xf8\x38\x81\x63\x5b\x10\x79\xe7\xd1\x65\x71\x97\xa6\x02\xe1\xdd\xdd\x6c\x5b\x75\x43\x45\x71\x7a\x65\xf7\xb0\xc2\xc5\xd1\xa4\x10\x39\x84\x40\x5a\x78\xf7\x36\xf7\x6d\x0d\x13\x81\x2e\xf9\x0e\x19\x10\x96\xc6\x31\x10\x17\x08\x05\xc2\x81\x44\x40\xfa\x40\xde\x02\x81\x95\x32\x7e\x05\x12\x02\x59\x02\x39\x00\xf2\x13\x10\x02\xe4\x1e\xc8\x6f\x40\x1e\x80\x1c\x02\x79\x07\x64\x06\x04\x32\x81\x01\x49\xc3\x78\x04\x72\x04\x04\x03\x99\x03\x09\x80\x48\x20\x4f\x40\xde\x00\x99\x02\x59\x00\x61\x40\x14\x90\x6f\x46\x9e\x9b\xea\xad\xda\x9c\x5d\xa5\x41\xaa\xf9\xb4\x7e\x46\xe1\xf5\x78\x19\x34\xae\x6f\x1e\x48\x37\x8c\xfe\x16\x91\x94\x07\xb2\x45\x1e\x47\x45\x80\x5f\xb0\x24\x59\x32\xb9\x8b\xd2\x49\x82\xb2\x79\x2b\x8d\x6e\x19\x4d\x65\xbc\x29\x28\x67\xad\x76\x47\xff\x39\xf4\xac\x72\x12\x80\x36\xd7\x7a\xf6\xbb\xb6\xc6\xa8\x6f\xcd\xea\x1b\xf0\x0b\x75\xfe\x83\x14\xc8\x93\xe0\x6a\xf5\x91\x28\x87\x7e\x23\x17\x38\x5c\xaf\xb7\x2b\x97\xad\x18\x44\xee\xc4\xda\xa5\xbe\x06\x94\x2f\xdd\x05\x66\x78\x4e\xbc\xed\x9b\x5f\x67\xda\xdc\x48\x1e\xd9\x87\x3d\x3b\x14\x64\x49\xc9\x43\x45\x74\xf9\xc4\xc2\x29\x68\x95\x21\xa5\x45\xb9\x30\x96\x69\x9b\xf8\xa1\x32\x84\x0c\x03\xf5\xd6\x6b\x0d\x30\xcb\x96\xd7\xce\x49\x5c\x71\xdd\xcf\xe4\xa9\xf2\x4a\x54\x1a\x87\x54\x98\x6a\xfa\x09\xcb\xbf\x50\xe6\xf1\x87\xcc\x7e\xcb\x78\x48\x7e\x17\xee\x6f\x2b\x12\xeb\x98\xb4\x77\x48\x7d\x78\x84\xa5\x7c\xe0\xc2\xdb\x2a\x23\x63\xca\x0e\x65\x60\x81\x52\xc5\x32\x37\x7e\xc2\xe9\xc9\x4f\x5c\x58\x33\xd3\xe3\x4b\xb7\x8c\x53\x6f\x39\x56\xab\xb2\x80\x62\x5b\xb2\x5e\x17\xae\xd1\x52\xde\xbc\xb5\x69\x98\x1e\x8f\xd7\x4f\x75\xee\xa3\x2d\x13\x9d\xfb\xa8\x7e\x5a\x1a\x6c\xf5\x53\x3f\x12\xa5\xb7\x11\xeb\x75\x65\xbf\x36\xda\x9f\x9f\x15\xa5\x83\x59\x97\xb6\xc5\xe6\x9d\x9c\xa9\x89\xf1\x0e\x38\xc3\x0a\xbb\x84\xc1\xbb\xfe\x03\x55\x0b\xfb\x34\x3f\x76\xdf\x61\xa5\x0f\xf7\x4a\xaa\x12\xc2\x94\xcd\x7d\xf2\xe7\x88\xab\xf8\x10\xc0\x2c\x45\x92\x7e\x23\x70\x22\xe6\x51\x40\x58\x7a\xb0\x93\x26\x22\x73\x1f\x67\x8f\xd1\xcf\xa8\xd8\x21\x68\xb2\xe1\xad\xcc\x4e\x9a\xcc\x78\xf7\x0d\x47\x25\xde\xd2\x29\x49\x9e\xbf\xd0\x73\x6e\x3b\x1b\x31\x91\x89\xfe\x84\xaa\xfb\x43\xb7\x6a\x73\x9a\x81\xf6\x29\x0b\xa3\xf8\x66\x02\x4c\xf9\xc1\xf9\xab\xf3\xf5\xfc\xe2\xec\x7a\x78\x7b\xfe\xc3\x78\x7c\x02\x17\x0e\xe0\xfc\xf1\x38\x99\x0e\xdf\x3b\x53\xca\x00\x62\x9f\x47\xea\x85\x53\x1d\xa2\xa2\x30\x51\xa1\x13\xca\x7e\x2c\x25\xc6\x77\x94\x20\x38\x40\x3f\xa3\x4b\xf2\x60\x5f\x4d\x7f\x25\xae\x42\xce\x93\x54\x24\xe8\x0c\xaf\x3a\xa0\x5d\xca\xb1\x51\xd7\x42\xad\xbb\x74\x0c\xae\x91\x26\x83\xc1\x55\x48\x58\x5b\x7b\x7c\xe2\xba\x44\xca\xc9\x60\x70\x4d\xb0\xa7\x0f\x38\x0b\x2c\x48\xf6\x1c\x74\x90\xa2\x09\x3a\x81\x05\x01\x44\xb4\x6a\x39\x4e\x79\x10\x0a\x12\xdf\x9d\x77\x3e\xfe\x8d\x86\xc9\x8c\x96\x6e\x97\x85\xee\xea\xf9\xb5\xef\xa9\x0d\x67\xc4\x4d\x9f\xb5\x53\xcd\x3a\x00\xfe\x95\x9f\x33\xaf\xd5\x46\xbf\xa3\xab\x48\xd9\x60\x43\x4b\x73\x3f\x70\x0e\xd9\x92\xdf\x13\xfb\xfc\x31\x13\xd8\x32\x57\xbd\x35\x5a\xf5\xd7\x26\xb2\x67\xfa\x62\x59\x68\x13\xbe\x30\x73\x4b\x9c\x14\x82\x3e\x84\xdb\x22\xb9\x20\xbe\xdf\x21\x8f\x04\xd9\xe7\x8f\xf1\x61\x26\x67\x23\xee\x53\xf7\x09\xdd\x30\x01\xef\x31\xd4\x55\xc4\x43\xb6\xcb\x83\x00\x33\x0f\x8d\x8d\x62\xcc\x6f\xdb\x63\x66\x7b\x17\xab\x76\xae\x37\x36\xd0\x7b\xf4\xd2\xa0\xcb\x6e\xca\xb4\xed\xa1\x67\x8e\xfc\x3a\x4b\x40\x1f\x77\x78\xf8\xd3\xbb\xb4\x51\xa8\x2f\x11\xc5\x8b\xf8\xa2\x1b\x53\xb6\xff\xa2\x2b\xff\xd4\xb0\xff\x5d\xfa\xe7\x97\xfe\xdb\x3c\xb2\xf5\xda\xdf\xda\x09\x07\x5d\x1c\x79\x2d\x60\x3c\xf9\xdf\xf2\x2f\x0d\xd0\x5e\xf1\xf1\x6a\x45\x98\xb7\x5e\xef\xa1\x7f\x0c\x00\x8a\x2d\x43\x0f\xca\x27\x00\x00")
 
 func swarmmastervarsTBytes() ([]byte, error) {
 	return bindataRead(
@@ -1267,7 +1288,7 @@ func swarmmastervarsT() (*asset, error) {
 	return a, nil
 }
 
-var _swarmwinagentresourcesvmasT = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5a\x4f\x6f\xdb\x3a\x12\x3f\x6f\x81\x7e\x07\xc2\x17\xc5\x80\x6a\x77\x77\xb1\xc0\xee\xbb\xa5\x49\x5e\x6b\x20\x4e\x8c\xb8\xed\x1e\x82\x1c\x68\x71\xec\x10\x91\x48\x81\xa4\x9c\x64\x0d\x7f\xf7\x05\xf5\xcf\xa4\x48\xaa\x76\xe3\xbc\x66\xf1\x36\x97\xc0\xd2\x70\x38\x33\x9c\x7f\xbf\xa1\x10\x42\x68\xf3\xfe\x1d\x2a\xff\x06\x38\xa7\xdf\x41\x48\xca\xd9\xe0\x37\x34\xb8\x5d\x63\x41\xf1\x22\x05\x79\x12\xed\xde\x9c\xc3\x12\x17\xa9\x8a\x86\x77\x83\x18\xb5\x2b\x13\x9e\x3f\x0f\x7e\xdb\xb1\x2a\x1f\x15\x4c\x95\x7c\x64\xb1\x38\x31\x78\x6d\x36\xa3\x2b\x9c\xc1\x76\x7b\xa6\x29\xa2\x61\x8c\x7c\x2f\xaf\x97\x4b\x09\x2a\x1a\x5a\xfb\x20\x34\x60\x38\x03\xcd\x35\xe5\x3c\x1f\x34\xcf\xb7\x86\x28\x04\x72\x60\x44\x5e\x6b\x1d\x6e\xdf\xbf\xdb\x6c\xe8\x12\x31\xae\xd0\x68\x22\xcf\x0a\xa9\x78\xf6\xfd\xea\xe2\xeb\x76\xdb\xd2\x9b\x6a\xae\x19\xa8\xc9\xb9\xd6\x4d\x2f\x04\x46\x34\x5d\xc9\x61\x22\x67\xc5\x22\xa5\x09\x1a\xcd\xb8\x50\x52\x3f\xff\x0b\x42\xb1\xb5\xba\x95\xfd\x72\xe1\x30\xa9\x36\xbb\x33\xe4\x4c\x79\x82\x95\xc7\xd4\xcd\xf3\x8e\x85\x1b\xbd\x6f\x13\xce\x12\xac\xbc\x06\xfd\x3e\xd5\xff\x67\x02\x96\xf4\x49\xdb\x35\x62\x34\xf9\x10\xc5\x48\x9f\xce\x84\x11\x78\xf2\xae\x6a\x2d\xad\x37\x6c\xf7\xcb\x05\xcf\x41\x28\x0a\xb2\x73\xae\x34\x3f\xe3\x6c\x49\x57\x85\x28\xc5\x94\x95\x99\x51\xfb\xb7\x31\x7f\x18\x82\x37\xeb\xae\x38\x01\xeb\x48\xbd\xdb\x85\x8c\x8e\xac\xbf\x41\xca\x31\xf9\x84\x53\xcc\x12\x10\x9f\x70\xf2\x00\x8c\x9c\x12\x22\x40\xca\x19\xe7\xa9\x23\x9b\x57\xc2\x46\x2f\x62\x9a\x37\x1a\xcb\x62\x21\x13\x41\xf3\x52\xc9\x71\x14\x23\xf3\xc1\xc9\x70\x64\xfe\x9c\x90\x38\x1a\x0b\x90\xbc\x10\x09\x7c\x16\xbc\xc8\xcb\x15\xd6\x93\x93\xe1\x48\x9b\x22\x46\xd1\x38\x17\x7c\x4d\x09\x08\x39\x9e\xd2\x44\x70\xc9\x97\x6a\x74\x05\xea\x91\x8b\x87\xb1\xa9\x51\xc9\xc4\xef\x60\xfa\x7f\x79\xc4\xe3\x85\xab\xf6\x38\x8a\xfd\xab\x6a\x13\x69\xa2\x8a\x41\xe9\xa4\x5d\x53\x38\x66\xbe\x8b\x7b\x0d\x3f\x61\x0b\x5e\x30\x72\x85\xd5\x0b\x8d\x1e\x8e\x25\xad\x28\xb5\xb7\xd1\xb6\x89\x6e\xce\x67\x1f\x02\x36\xb2\x83\x61\x7f\x45\xeb\x90\x45\xc8\xd1\x39\x17\x74\x8d\x15\x4c\x66\xa7\x69\x13\xa4\x53\x50\xf7\xbc\xd4\xe1\xfc\x99\xe1\x8c\x26\x8e\x67\x23\x34\x90\xc5\x82\x81\xb2\xc3\xa8\x6b\x01\xaf\x06\x0c\xd4\xbc\x5c\xdb\xa4\x93\x3e\xe9\xad\x9f\xc6\x8f\x3b\x5f\x92\x54\xcf\x79\x19\x94\xae\xff\xb1\xea\xff\x84\x29\x10\x4b\x9c\x80\xac\xb7\xdd\xc6\x75\x50\x8e\x26\x72\x8a\x19\x5e\x01\x39\xa7\xf2\xa1\x0d\xca\xc3\xaa\xc8\x5c\x71\x81\x57\x60\x32\xea\xe4\xbb\x9f\x4d\x8f\x3e\x43\x9e\xae\x31\x4d\xf1\x82\xa6\x54\x3d\xcf\xa1\x5b\xbb\xec\xd4\x63\xd6\x9a\x3c\xc5\x6a\xc9\x45\xf6\xbb\xae\x78\xe7\x3c\xc3\x94\x9d\x35\x55\xed\x6f\x76\x59\x6a\x48\xbf\xe5\x04\x2b\xe8\xd0\xfe\x7d\x60\x04\xd0\x20\xab\x94\xd6\x2f\x94\x28\xc0\x28\x62\x3d\xc7\x73\xc6\xb3\xbc\x50\x30\xc6\xb6\x2a\xf6\xe9\x40\x2a\x01\x55\x47\x54\x1b\xf8\x34\x29\xcb\xf0\x8b\x0e\xe9\x90\x52\xef\xb3\xbe\x2d\x8a\xac\xab\x7e\xa0\xac\xaf\xb3\x4b\xce\xf3\xb2\x46\xec\x51\xdc\xdb\xe5\x6d\xda\x76\x1d\x3a\x2f\x4b\xc8\x64\x56\x27\x48\xe8\x26\xd5\x0c\x4b\x05\x62\x66\x53\x39\xd9\xf1\x75\x2a\xb7\xb4\x4c\x53\xe5\x2a\x90\xd1\xf0\x36\xe3\xe4\x04\x13\x72\xb2\xab\xdc\x43\x6f\x4a\xb7\x6d\xdb\x56\xf2\xf8\x87\x7b\xd4\xa7\x30\xbc\xfb\x31\x69\x34\xbc\x25\x74\xfd\x0b\xc4\xd9\xc5\x6f\x45\xdc\x1e\x4a\x30\x78\x0d\x8f\xc0\xd5\x9a\xaf\x75\x24\x59\x5d\x5e\x36\xa7\xff\x01\x39\xc5\x79\x34\xf4\x27\xde\xa9\x26\x88\x86\x77\x23\x5b\x5a\xcd\xec\xce\xeb\x97\x6e\xc0\xd6\xa6\x18\xdb\x1c\xcc\x78\x45\xa8\xca\xa7\x5f\xb0\xb4\x32\x29\xb2\xf5\xf8\xd9\x40\xf5\x86\xea\x51\x82\xd5\xf0\x69\x82\x15\x26\x54\x3e\x5c\x9a\xdd\xb8\x65\x9a\x70\xd0\xfe\x11\x61\x6b\x05\xee\xc1\xa1\x7b\xb4\xe0\x35\x56\x69\x8b\xcd\x3d\x2b\xe7\x00\xa4\x13\x2a\xbf\x3e\xca\xdf\x94\xdc\x2d\xdb\x73\xac\x70\x38\x25\xf4\x24\x85\x3f\x24\x2d\x74\xbd\xff\xd0\xd4\xd0\xae\xb7\xb0\xe3\x31\x40\xfa\xaf\x6a\xa9\xfa\x13\xe5\x7e\x9d\x4d\x3f\x14\x7f\x8b\x16\xea\x24\xa5\xfd\xaa\x16\x61\x72\x0e\x4a\x51\xb6\x72\x5d\x97\x94\x4d\xa5\xe6\x76\x89\x17\x90\x06\x37\xbe\x60\x24\xe7\x94\xa9\xf3\xab\xb9\x89\x80\xc2\x1e\xda\xa6\xda\x1e\x58\x73\x18\x88\x70\x92\x77\x7b\x98\xe8\x78\x87\xf5\x0a\xbd\x60\xe8\xf0\x8e\xdc\x08\xf6\x41\xfa\xfd\xfc\xc4\x03\xfa\xf7\x9d\xc3\x04\x76\x77\x46\x03\x36\xde\xdc\x86\x2a\xea\x52\x70\xa6\x80\x91\xc9\xec\xe7\xc6\x42\xa1\x01\x5a\xcd\xce\x35\xca\x0f\x4c\xd3\xf5\xe9\xca\x42\xfd\xe0\xbb\x99\xd3\x4c\xc8\x5e\x4e\xe3\x9f\xae\xf4\x35\x21\x8e\x0d\x9d\x9f\x96\x7d\xcd\x69\x5b\xff\x6c\x25\x68\xd5\x26\x06\x0e\x19\x90\xc4\x87\x99\x38\x70\xf2\x87\xcf\x39\x76\xa7\xed\x9d\x73\x18\xc0\xd8\x14\x4d\xf1\x84\x97\x49\x50\x25\x79\x57\x74\x43\x3a\x5d\x23\x6e\x30\x5b\xc1\x5c\x61\x11\xee\x7b\xff\x4d\x19\xe1\x8f\xf2\xe6\x7c\x76\x85\x0d\xfa\xc8\x35\x8b\x8f\xf7\x05\x0b\x6b\xb7\xe3\x7c\xc1\x48\xcd\x99\xe7\x7e\xc6\x8b\x26\x08\x5d\x49\xf1\x0a\x98\xaa\x79\x7d\xda\x91\xb9\xf6\x0a\xbb\x95\xdd\x08\x37\xf3\x3b\xca\x56\x37\x45\x0a\xae\x6b\x6d\x4e\x3e\x83\xba\xfc\x54\xbe\x44\xa5\x32\x75\xc5\x1d\x6e\x83\x6c\x73\xc1\x17\x21\x56\xb3\xf2\x9d\x97\xc7\x61\xd5\xc5\x1a\x91\x9a\x95\xa5\xdb\x31\xf5\xcd\xab\x5e\x34\xa6\x6a\x86\x2d\x07\x31\x33\xab\x98\x73\x33\xf0\x0b\x6e\x50\x0e\x1b\xb5\x34\xb6\xf4\x0f\x96\x50\xa0\xf0\x06\x5a\x5d\x7b\x40\xfd\x43\x20\xa5\x81\xc9\x9e\x77\x18\xb1\x05\x11\x9f\xbe\x4f\xe5\x0c\x84\x2d\xf3\x1b\x41\x59\xff\x8b\x4a\x85\x27\x32\x7d\xe3\x8c\xd7\x75\x8e\x37\x65\xcb\x03\x50\xf2\x31\x7d\xe9\x4f\x60\x83\x7e\xf4\x6f\xe1\xe5\x4e\x6a\xed\x87\x04\xce\x7d\x47\x08\x12\x1c\xe1\x72\x15\xf5\x8b\x14\x42\xc2\x21\x89\x1c\x0c\x6e\xc1\x94\x5d\x21\xc5\x1a\x4c\xfa\x66\x8b\x89\x80\xb2\x65\x9b\x97\xdd\xef\x00\x99\x8d\x23\x4e\x24\xb0\x15\x65\xb0\x5f\xfb\x78\x88\x1d\x3c\x17\x1e\xfb\x21\xa9\x97\xdd\x86\x1f\xf5\x16\xbc\x73\x46\x0e\x58\xef\x45\x16\xa1\x83\xf6\x03\x8b\xbe\x63\xee\x42\xf9\x7b\x2c\xc8\x23\x16\x30\x13\x7c\x49\x53\x70\xc4\xaa\x66\x5c\xe1\x1b\xc7\x66\xc2\x15\xdc\xa0\x0e\x97\x10\x7f\xf7\xf6\xd0\xb9\x0c\x76\x81\xc4\x5e\x28\xcc\xe1\x1c\xc5\xaf\xf8\x25\x44\x7f\x57\x7d\x17\xb2\x0e\x97\x21\xc3\x24\xd5\x91\x8b\xae\xe3\xca\x62\x21\x95\xa0\x6c\x65\x0a\xa4\x89\xe6\xc5\xb2\x96\xfd\x63\x8c\xfe\xa1\x55\xc0\x89\xdc\x5f\x83\x18\xe9\x9a\xf0\xaf\x8f\x1f\xfd\x58\x55\x33\xe8\xf8\x7a\xe5\xd6\x24\xa3\xec\x9b\x34\xe4\x34\x96\x3f\x56\xe8\xe3\xd4\xa4\x71\x70\x4c\xc5\x62\x86\xa5\x7c\xe4\xc2\x41\x45\x26\x8b\x86\xc6\x61\x51\x4f\xf9\xe6\x8f\x58\x64\x53\x4e\xa0\xfb\xdd\xc7\x66\xf3\x19\x34\x14\x3a\xd5\x90\xa8\xa5\xaa\x3e\xee\xd1\xd5\x61\xbb\x35\x88\x6d\xc6\x66\xd3\x1e\x66\x67\xb2\xea\xac\xb7\x0b\x4b\x2d\xeb\x17\x2c\x6b\x64\x36\x87\x44\x80\xef\x4b\x15\x17\xeb\xc9\x8a\x34\x60\xa1\xda\x8f\x6a\x7e\x5d\x94\xe7\x08\x62\xbb\x61\x5d\x56\x03\xbe\x58\xea\xab\x95\x2b\xdb\x34\x34\xea\x34\x6a\x34\xc3\x2b\xb8\x81\x25\x08\x60\x89\xb3\xb8\x19\xad\xc8\x7b\x10\x7d\x10\x75\xd6\x10\x79\x80\xee\x80\x2f\x97\xfd\xab\xaf\x35\x81\x6f\xa5\x7c\x28\xfa\xd6\xcd\x1f\x0a\xdf\xaa\xf5\x0e\x9a\xa5\x58\x81\x54\xa1\xd1\x16\x42\xf1\x80\x97\xed\xab\x47\xef\x04\x27\xf7\x94\xad\x34\x97\x1b\xc0\xe4\x9a\xa5\xcf\x9d\x24\x11\x57\x15\x15\xae\xf3\xa6\x96\xfd\x2e\x78\x36\xd1\x06\x1d\xec\x83\xa0\x2a\x1e\xaf\x58\xdb\xe2\xe8\x03\x97\x84\xca\x07\x77\x6c\x10\x0f\xd6\xf7\xc4\x3b\xe7\x29\x04\x35\xc5\x11\x8d\x6b\x9c\xfc\x1f\xee\xfd\x39\xe1\x5e\xdc\x7f\x09\x3d\x1c\xe5\x82\x66\x58\x3c\x37\x17\x11\x72\xb4\x48\xf9\x22\x8e\x2a\xd7\xdb\xb7\xbf\xde\xbb\xd0\x35\x3e\x3d\x5a\xdf\x13\xef\x38\xcc\xbe\x3e\x62\x80\x46\xd7\x73\x1d\xe3\xba\xd7\xf9\xfc\x09\x7d\x74\x43\x90\xb4\x6f\x75\x44\x6c\x2c\x7a\x2f\xc2\xb0\xb2\xc8\x76\xbf\x71\x56\xd3\x05\xae\xa9\x50\x05\x4e\xa7\x65\x7a\x79\x9d\xab\x92\xb7\x3e\x5c\x6a\x97\xf7\xa0\xa2\x8e\x99\x8e\xec\x46\xaf\xfe\xa9\xcf\x11\xfc\x7c\x9c\x48\xbb\xdf\x0a\xc3\x13\xab\x46\x3b\xb6\xb4\x3e\x47\x6b\x1c\xb3\x6a\x7a\xe6\xe5\x27\xad\x17\x4f\x0a\x58\xe9\x68\x5d\xca\x2f\x98\x91\x14\x84\xe1\x87\x7f\x1d\xfd\xd3\xa2\xc2\x85\xe2\xdf\xf2\x95\xc0\x04\xa6\x94\x71\x83\x54\x89\x02\x4c\x4a\x19\xba\xe9\x4c\x78\x96\x61\x46\xbe\xf2\x8b\x27\x48\xb4\xbc\xfe\x1e\xc9\x94\xb8\x83\x59\x5e\x14\x81\x63\x68\xd4\x6f\x83\xf1\xfd\xbb\xff\x06\x00\x00\xff\xff\x1e\x0b\xd6\x29\x2a\x2f\x00\x00")
+var _swarmwinagentresourcesvmasT = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x5a\x4b\x6f\xdb\x3a\x16\x5e\x4f\x80\xfc\x07\x42\x1b\xd9\x80\x6a\x77\x66\x36\x33\x77\x97\xd6\xb9\xad\x81\x3c\x8c\xb8\xed\x5d\x04\x59\xd0\xe2\xb1\x43\x44\x22\x05\x92\x72\x9a\x31\xfc\xdf\x07\x94\x25\x99\x94\x48\x59\x6e\xed\x69\xe7\x3a\xc0\xad\xf8\x38\x3c\xfc\xce\xfb\x48\x08\x21\xb4\xb9\xbc\x40\xc5\x7f\x01\xce\xe8\x37\x10\x92\x72\x16\xfc\x81\x82\xc7\x35\x16\x14\x2f\x12\x90\x83\x70\x3f\x33\x81\x25\xce\x13\x15\x0e\x9f\x82\x08\xd5\x3b\x63\x9e\xbd\x05\x7f\xec\x49\x15\x43\x39\x53\x05\x1d\x99\x2f\x06\x06\xad\xcd\x66\x74\x87\x53\xd8\x6e\x3f\xf2\x9c\xa9\x70\x18\x21\xd7\xe4\xfd\x72\x29\x41\x85\x43\xeb\x1c\x84\x02\x86\x53\xd0\x54\x13\xce\xb3\xa0\x1a\xdf\x1a\xac\x10\xc8\x80\x11\x79\xaf\xef\xf0\x78\x79\xb1\xd9\xd0\x25\x62\x5c\xa1\xd1\x54\x7e\xcc\xa5\xe2\xe9\xb7\xbb\xeb\x2f\xdb\x6d\xbd\xde\xbc\xe6\x9a\x81\x9a\x4e\xf4\xdd\xf4\x46\x60\x44\xaf\x2b\x28\x4c\xe5\x2c\x5f\x24\x34\x46\xa3\x19\x17\x4a\xea\xf1\xbf\x21\x14\x05\x8f\x2e\xde\x6f\x16\x2d\x22\xfa\x28\x84\x9e\x0c\x3e\x13\x1e\x63\xe5\x80\xba\x1a\x6f\x20\x5c\xdd\xfb\x31\xe6\x2c\xc6\xca\x09\xe8\xb7\x5b\x7d\xfe\x4c\xc0\x92\x7e\xd7\xb8\x86\x8c\xc6\xef\xc2\x08\x69\xe9\x4c\x19\x81\xef\x83\x4e\xa4\xf5\x81\xf5\x79\x99\xe0\x19\x08\x45\x41\x36\xe4\x4a\xb3\x8f\x9c\x2d\xe9\x2a\x17\x05\xfb\x7a\xfa\x71\x3f\x6d\xa8\x53\x83\xf1\x6a\xdf\x1d\x27\x60\x89\xd4\x79\x9c\x0f\x74\x84\xac\x6d\x09\xc7\xe4\x03\x4e\x30\x8b\x41\x7c\xc0\xf1\x0b\x30\x72\x45\x88\x00\x29\x67\x9c\x27\x2d\xde\x9c\x1c\x96\xa4\x28\x31\xe1\x0d\xc7\x32\x5f\xc8\x58\xd0\xac\xb8\xe4\x38\x8c\x90\x39\x30\x18\x8e\xcc\xc7\x29\x89\xc2\xb1\x00\xc9\x73\x11\xc3\x27\xc1\xf3\xac\xd8\x61\x8d\x0c\x86\x23\x2d\xc3\x08\x85\xe3\x4c\xf0\x35\x25\x20\xe4\xf8\x96\xc6\x82\x4b\xbe\x54\xa3\x3b\x50\xaf\x5c\xbc\x8c\xcd\x1b\x15\x44\x5c\x22\xbb\x59\xe8\xff\x17\x22\x1e\x2f\xda\xd7\x1e\x87\x91\x7b\x57\x09\x91\xc6\x46\x0f\x15\xd6\xd5\x06\xa3\x05\xf3\x53\xd4\x1c\x09\x4c\x36\xa7\x6c\xc1\x73\x46\xee\xb0\xfa\x49\xd0\xdd\x4c\x6b\x5b\xd2\x17\xa5\xf6\x31\x1a\x9b\xf0\x61\x32\x7b\xe7\xc1\xc8\x36\x86\xfe\x17\x2d\x4d\x16\x35\x34\xb4\xd0\x51\xba\xc6\x0a\xa6\xb3\xab\xa4\x32\xd2\x5b\x50\xcf\xbc\xb8\xc3\xe4\x8d\xe1\x94\xc6\x2d\xcd\x46\x28\x90\xf9\x82\x81\xb2\xcd\xa8\x89\x80\xf3\x06\x0c\xd4\x3c\x5f\x18\x3e\xa9\x8b\x7b\xeb\xd1\x78\x78\x72\x39\x49\xf5\x96\x15\x46\xd9\xd6\x3f\xb6\xd3\xc3\x29\x53\x20\x96\x38\x06\x59\x1e\xbb\x8d\x4a\xa3\x1c\x4d\xe5\x2d\x66\x78\x05\x64\x42\xe5\x4b\x6d\x94\xc7\x45\x91\xb9\xe2\x02\xaf\xc0\x24\xd4\xf0\x77\x15\xc2\x4d\x1a\x87\xdc\xa3\x0b\xc8\xab\x35\xa6\x09\x5e\xd0\x84\xaa\xb7\x39\x34\x63\x97\xed\x7a\xea\x71\x3d\x93\x60\xb5\xe4\x22\xfd\x53\x47\xbc\x09\x4f\x31\x65\x45\xcc\xd2\x3c\xfd\x23\x88\x5c\x4b\xbf\x66\x04\x2b\x68\xac\xfd\xe7\xde\xaf\x22\x14\xa4\xbb\x4b\x6b\x22\x4a\xe4\x50\x8b\x75\x1b\x75\x88\xe7\x23\x4f\xb3\x5c\xc1\x18\xdb\x57\xb1\xa5\x03\x89\x04\xb4\x13\x51\x09\xf0\x55\x1c\x6b\x86\x7f\x4a\x48\xc7\x84\x7a\x17\xfa\x36\x2b\xb2\x8c\xfa\x26\x4d\x43\x7e\xeb\xf4\x86\xf3\xac\x88\x11\x2e\xbd\x6d\x04\xf7\x7a\x7b\xed\xb6\xdb\x0a\x9d\x15\x21\x64\x3a\x2b\x1d\x24\x34\x9d\x6a\x8a\xa5\x02\x31\xb3\x57\xb5\xbc\xe3\x79\x22\xb7\xb4\xa0\xd9\xf9\x2a\x90\xe1\xf0\x31\xe5\x64\x80\x09\x19\xec\x23\xf7\x30\x3a\x8c\x6d\x1d\xc9\xa3\x83\x67\x94\x52\x18\x3e\x1d\x5e\x1a\x0e\x1f\x09\x5d\xff\x02\x76\x6a\xb2\xe5\xe2\x5a\x28\x5e\xe3\xad\x86\x75\x2e\xbb\xdb\xf3\xa5\xb4\xa4\xcd\xe6\x13\x28\x9b\x3d\x3d\x85\x46\xdb\xad\x53\xd1\xda\x16\x58\x6e\x1e\xdb\x17\x30\x0d\x10\xa1\x9d\x83\xfc\x8c\xa5\xe5\x1a\x0d\xbb\xfb\x19\xcb\x73\xda\xde\x49\xac\xcf\x50\x52\x82\x15\x26\x54\xbe\xdc\x98\xe9\xb5\x05\x8d\xdf\x0a\xff\x17\x76\x68\x59\xe2\xd1\xb6\x78\x32\x6b\x34\x76\x69\xc4\x6c\x90\x77\x3b\xe7\x00\xa4\xa1\xfb\x67\xb2\x93\x23\xcc\xf6\xb7\xe2\xbb\x26\x3b\xc1\x0a\xfb\x6d\xbc\xc3\xca\x7f\xcc\xce\x9b\xea\x7c\xac\xad\xd7\xfb\xad\xea\xee\xb8\xd8\xea\x2e\xa3\x8f\xd3\xe6\xbd\x2e\xbb\x40\x3d\x26\xe9\x31\xf0\xf8\xe1\xdc\xa3\xbb\x58\xfe\x1d\x11\x6a\x78\x99\x0e\x7c\xaa\x61\xed\xfb\x98\x9c\x83\x52\x94\xad\xda\xba\x48\x8a\xb4\x4f\x13\xbf\xc1\x0b\x48\xbc\x07\x5f\x33\x92\x71\xca\xd4\xe4\x6e\x6e\xd6\x28\xa6\x97\x33\x24\xa2\xb9\xa9\xbc\x62\x47\xe1\x71\x79\xd1\xda\xe9\x90\xa5\xd7\x1b\xd7\xc2\x44\xa7\x13\xd6\x19\xb2\x35\x9f\xf0\x4e\x9c\xaa\x75\x15\xdd\xfd\xf4\xc4\x51\x96\x37\xa3\xa5\xb1\xbc\xcf\xe9\xad\xe2\xfd\x29\xf0\x95\x7c\x7b\x06\x11\x0a\x96\x82\x33\x05\x8c\x4c\x67\x3f\xd6\xb8\xf1\xb0\x53\x91\x6b\x83\x72\x00\x9a\x6a\xda\x96\x74\x77\x79\x5c\x75\x52\xa6\xa4\x97\xd2\xb8\xfb\x1f\x7e\x95\x71\x60\xd8\x7a\x34\x1e\xcc\x7e\x48\xd0\x68\x4b\xf4\x46\xb5\xb2\x81\x63\x5a\x18\xd1\x71\x10\x7b\x24\xdf\x0d\x75\xb7\xb4\x9d\x9d\x88\x32\xfb\x35\x7f\x5a\xfa\x8a\xc7\xbc\x70\x82\x2a\xce\x82\xc8\xcb\x9d\x6e\xa8\x3e\x60\xb6\x82\xb9\xc2\xc2\x9f\xc8\xfe\x45\x19\xe1\xaf\xf2\x61\x32\xbb\xc3\xc6\xfa\x70\xf8\xd4\x87\xf6\x35\x23\x3d\x28\x5f\x33\x52\x52\xe6\x99\x9b\x70\x69\xd6\x33\xde\xe6\x14\xaf\x80\xa9\x92\x56\x6d\xab\x42\xb5\xf1\xda\xf6\x32\xdb\x7d\x87\x8d\xb2\xd5\x43\x9e\x40\xdb\x60\x37\x83\x4f\xa0\x6e\x3e\x14\x93\xa8\xd0\x99\x32\xe2\x0e\xb7\x5e\xb2\x99\xe0\x0b\x1f\xa9\x59\x31\xe7\xa4\x71\x5c\x74\x31\xbb\x83\xfb\x34\x01\x19\x79\x42\x69\x1a\x5d\x1d\xa5\x5e\x81\xc7\xd7\x48\xaa\xda\x21\x47\x11\x33\xa3\x58\x83\xd7\x5f\xf2\x8e\xe3\xb8\x66\x48\x85\xe5\xdc\x4a\x5a\xb7\xdb\xee\xc0\xeb\x49\x75\xed\x16\xb2\x3b\xa9\x37\x2a\x23\x47\xda\x5d\xb4\x2d\x74\x7d\xed\xf4\xc5\xa7\xad\x24\x0e\x56\x40\xbf\x98\xbf\x9a\x6c\xb9\xb8\x0e\x40\x9d\x5d\x83\xf3\x8a\xec\x5c\xb0\x1c\x51\x57\x1e\x81\xe0\x41\x09\xff\x7f\x5d\xa7\xbb\xf4\xb5\x6a\xcb\x86\x1b\x72\xab\x84\xb7\x7b\x3f\xee\x95\x5a\xfc\xd0\xab\x42\xd4\xcd\x92\xaf\x6a\xf4\x71\xd4\xaa\x57\x8d\xb8\xb9\x4f\xb8\x02\x85\x75\xe1\x55\x3d\x5a\xce\x58\x40\x91\xde\xcc\x8b\xb7\x70\x01\x32\x93\x2c\x1c\x4b\x60\x2b\xca\xa0\x5f\xaa\x75\x0c\x0e\x97\x17\xcd\x1c\xa8\x67\xd5\xb1\x77\xe4\xcd\x9c\xf0\xb4\x0c\xee\xf9\xf2\xa5\x8b\x41\x43\x46\xf6\xec\xa1\x2c\xdc\x27\xe8\x30\x72\x71\xd6\x25\x66\x2b\xbc\x21\x14\x3c\x63\x41\x5e\xb1\x80\x99\xe0\x4b\x9a\x40\x8b\xad\x75\x3a\xa7\xff\xf1\xd7\x28\xdf\x6e\xf5\x74\xd8\x71\x40\x69\x2e\x3e\xfa\x2d\x6b\x6a\xa6\x4d\x96\x02\xf6\xc1\xca\x6b\xa7\x61\x74\xc6\xf7\xfa\xdd\x19\xe8\x93\x0f\x1d\x2e\x7d\xc0\xc4\x3b\x91\x8b\x3e\x8a\x5b\xaa\x87\xf8\x21\xf5\x8d\x90\xf6\xeb\xff\x7e\xff\xde\xa9\x4b\x05\x81\x86\x92\xeb\xbf\x00\x93\x94\xb2\xaf\xd2\x60\xd0\xd8\xfe\xba\x4b\xd1\xaf\xcc\x35\x65\xca\xd7\x24\x31\xc3\x52\xbe\x72\x41\xba\x48\x54\x6b\x5a\x24\xca\x56\xd8\xfc\x15\x8b\xf4\x96\x93\x3a\x15\xad\x7e\x45\xb4\xfa\x8b\xb2\x2b\x5d\x37\xd4\xab\x76\xdf\xa8\xe8\x8e\xe8\x76\x5b\xad\x44\xf6\xcb\x68\x3b\xb3\xf5\x93\x33\x49\x35\xf6\xdb\x11\xa5\xe4\xf5\x33\x96\x65\xf9\x32\x87\x58\x80\xeb\x83\x0b\xeb\x82\xfa\x2f\x90\xbb\xa5\x1e\x84\x4a\x05\x2a\xe9\x35\x4b\xa1\x16\x23\xb6\xfe\x95\xf1\xd4\xa3\x84\xc5\x7d\xf5\xe5\x8a\xac\x49\x87\x78\x73\x36\xa0\x29\x5e\xc1\x03\x2c\x41\x00\x8b\x5b\x9b\xab\xfe\x83\x7c\x06\xd1\x55\xc7\xcd\xaa\x45\x2d\xe9\x6a\xfb\x58\x2e\xbb\x77\xdf\x2f\x97\xee\x9d\xf2\x25\xef\xda\x37\x7f\xc9\x5d\xbb\xd6\x9e\xfa\xc5\xd8\x59\x16\x32\x4d\xa0\x2d\x68\xa2\x80\x17\xef\xa7\x1c\x98\xc4\x38\x7e\xa6\x6c\xa5\x4f\x78\x00\x4c\xee\x59\xf2\x66\xd1\xd1\x5f\x3c\x15\x61\x16\xee\xb3\x2a\xc0\xfd\x29\x78\x3a\xd5\x60\x07\x7d\x4a\x10\xfd\x8b\xce\x19\xf0\xa2\xf0\x1d\x97\xfa\x0d\x56\x13\x03\x7d\xee\xfa\x99\xb4\x6f\x8d\x50\x90\x0b\x6a\xb2\x23\x2a\xb5\x19\x94\x03\x86\x07\x3f\x4d\xf2\x7d\x8e\x4c\xf5\x88\xf4\xf3\x60\x36\xfd\x8b\xf9\xab\xc9\xda\xa9\x71\xe4\xac\xda\xcb\xa3\xc3\xe1\x70\x94\x09\x9a\x62\xf1\x56\xf5\xd7\xe5\x68\x91\xf0\x45\x14\xee\x14\xa2\x6f\x2a\xdc\x3b\x34\x55\x9a\x36\x5a\x3f\x93\xb6\xb6\x99\xa9\x7b\x61\x17\x0c\xd0\xe8\x7e\xae\x2d\x4f\xa7\x25\x9f\x3e\xa0\xf7\x6d\xc3\x20\xf5\xac\xd6\xd3\x8d\xb5\xde\x59\x0c\x58\xb6\x5d\xff\xb3\xbb\x4b\x53\x25\x6c\x6b\x2a\x54\x8e\x93\xdb\xc2\xe8\xcf\xf3\x06\xe0\x77\xef\x99\xd4\xdb\x1f\xdb\x96\xee\x81\xe9\xc4\x6a\x64\xe8\xcd\x29\x5e\x5c\x94\xd7\x38\x21\x83\xfa\xd3\xbb\x58\x42\xd8\xaf\x92\xb0\xa2\x6a\x0b\x4b\x33\xa4\xd5\x8a\xb9\x4b\x53\xe6\xc5\xb7\x94\xd7\xdf\x15\x30\xad\x4e\xad\x95\x9f\x31\x23\x09\x08\x43\x0f\xff\x3e\xfa\x97\xb5\x0a\xe7\x8a\x7f\xcd\x56\x02\x13\xb8\xa5\x8c\x1b\x4b\xf5\x67\x56\xe6\x4a\xe9\x7b\x81\x17\xf3\x34\xc5\x8c\x7c\xe1\xd7\xdf\x21\xd6\xfc\xba\xb3\x1a\x93\x63\xdb\xf0\x7f\xce\x02\xc7\x50\x5d\x5f\x06\x97\x17\x08\x21\xb4\xbd\xbc\xf8\xef\x00\xda\x29\xe5\x40\xa3\x2d\x00\x00")
 
 func swarmwinagentresourcesvmasTBytes() ([]byte, error) {
 	return bindataRead(
@@ -1287,7 +1308,7 @@ func swarmwinagentresourcesvmasT() (*asset, error) {
 	return a, nil
 }
 
-var _swarmwinagentresourcesvmssT = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5a\x5d\x4f\xe3\x38\x17\xbe\x1e\x7e\x45\x94\x9b\x50\x29\x53\x66\xde\x77\x57\x5a\xcd\x1d\x50\x76\xa6\x12\x85\x8a\x30\xcc\x45\xd5\x0b\xd7\x3e\x2d\x16\x89\x1d\xd9\x4e\x81\xa9\xfa\xdf\x57\xce\xa7\x93\x38\xfd\x60\x19\x60\xc4\x72\x81\xa0\x3e\x3e\x3e\x7e\xce\x63\xe7\x9c\x27\x5d\xad\xe8\xdc\xe9\x0f\x65\xa0\xb8\x40\x0b\x38\xc6\x98\x27\x4c\xad\xd7\x8e\xe3\x38\x07\xfa\xd7\x2a\xfd\xed\x38\x2e\x8a\xe9\x0d\x08\x49\x39\x73\xbf\x38\xee\x64\x89\x04\x45\xb3\x10\xe4\xa1\x57\x8d\xe4\x5e\xbc\xde\xd4\xf5\x9d\x62\x22\xe6\xf1\xa3\xfb\xa5\x74\x94\x7e\x92\x30\xd5\xf4\xb2\x5a\xf5\x2f\x50\x04\xeb\x75\x3d\x14\x79\xaa\x7f\xd7\x3c\x3a\x8e\xcb\x50\x04\xda\xc1\x32\x3a\xe7\x3c\xbe\xe0\x04\xdc\x7c\x70\x5d\x2d\x4c\x20\x06\x46\xe4\xa5\x0e\x78\x52\xcd\x9d\x60\xce\x30\x52\x87\xde\x88\x62\xc1\x25\x9f\xab\xfe\x05\xa8\x7b\x2e\xee\x8e\xe2\x64\x16\x52\x3c\x1c\x1f\x13\x22\x40\x4a\x90\x47\x9e\xef\x18\x31\x46\x48\x2a\x10\xe3\xba\x95\x8e\xda\xeb\xf5\xa6\x45\x04\xd3\x2a\x82\x90\x63\xa4\x2c\x88\x15\x9f\xd7\x81\x2a\x36\x55\x04\x68\x4c\x90\x35\x4c\xc6\x02\xe6\xf4\x01\xa4\xd7\x9b\x44\x9c\x1c\x22\x42\x0e\x35\xc8\x43\x46\xe0\xe1\xb0\xe7\x6f\x07\xf5\x72\x3e\x97\xa0\xbc\x5e\xcd\xd6\xbe\x46\x0e\x7f\x6f\xba\xdd\xd4\xeb\x4d\x08\x5d\xbe\x42\x38\xa5\xdb\xdc\xb8\xcc\x48\x85\x6d\x2c\x78\x0c\x42\x51\x90\x75\x2a\xa2\x6c\xc6\xf5\x63\x0c\xcd\x24\x2d\xa3\x80\xfe\x04\x39\x42\xb1\xd7\xb3\x12\xf5\x66\xa4\x0d\xbc\xde\xb4\x5f\x8f\x55\x3b\x9b\x5a\xf8\xa8\xf2\x45\x2a\xde\xe5\x30\x1c\xd5\xe7\xcb\x6c\xee\xda\x3f\x58\xad\x80\x91\xf5\xfa\x20\x3d\xa5\x43\x99\x11\xcf\xe9\x8f\xb9\x50\x72\xbd\xde\xff\x7c\x0e\x60\x8e\x92\xb0\x7e\x9a\x9e\x4a\x52\x1b\x22\x8d\x33\xb1\x4b\x02\x08\x93\x01\x28\x45\xd9\xa2\x3e\xa0\x87\x78\x84\x28\xd3\xae\xce\xd1\x0c\xc2\xce\x55\xcf\x18\x89\x39\x65\x6a\x70\x11\xe8\x0f\x32\xaa\x78\xd5\x81\x34\x93\xa0\x23\x29\x8e\x6f\x58\xec\x70\x04\xea\x96\x13\xed\x7f\xf0\xc8\x50\x44\xf1\x4e\xc9\xeb\xbc\x34\x8a\xf4\x3d\xe1\x02\xb5\x26\xe8\xf9\xef\xb1\xae\x84\x3d\xe7\x25\x66\x5b\xee\x7c\xb6\x3b\x31\x66\x08\xdf\x01\x23\x79\x7c\x63\xce\x43\x59\xdb\xbf\x53\x23\xcb\xf6\x95\x4f\x32\x7f\xda\x51\x11\x84\x31\x7f\x5d\xfe\x3d\x35\xb9\x32\x17\x9c\x29\x60\x64\x38\x3e\xe5\x6c\x4e\x17\x89\x48\xb7\xfc\xef\x22\x29\x9c\xb5\xb0\xd8\x8c\x48\x93\xbe\x19\x30\x16\x13\xc7\x71\x69\xca\xe6\x89\x00\xc9\x13\x81\x61\x48\x76\x62\x89\x67\xbd\x54\x3b\x39\xd2\xc6\xae\xf9\x5f\x07\xaa\x94\xcd\x78\xc2\xc8\x05\x52\x57\x49\x98\xee\x72\x52\x1b\x0f\x39\x22\x27\x28\x44\x0c\x53\xb6\x28\x4d\x4c\xbc\x57\x87\x5f\x41\x9d\x9f\xa4\x63\x4e\x1a\x67\x7e\x2b\xf6\xd6\x1d\x6b\xc6\x82\xcf\x3a\xfc\x8c\xd3\x21\xab\x03\x5b\xcc\xfb\x50\xb1\x38\xa2\x57\x83\xf1\xc7\x8e\x23\x78\x33\x32\x2f\x2d\x4d\x87\x7d\xd8\xd0\x41\xd0\x8d\xac\xd8\xcc\xca\xe1\xc0\xb3\xa4\xd8\x6f\xd1\x50\x70\xc5\x31\x4f\x6f\x65\x85\x63\xb7\x65\x50\x44\xa6\x51\xbd\x42\x6c\x01\x81\x42\xa2\xbb\xea\xfb\x41\x19\xe1\xf7\xf2\x6a\x30\xd6\xb4\x28\xed\xbd\x16\x20\x36\xd7\x67\xac\x7b\x67\x95\xe3\x33\x46\x72\xc7\x3c\xb6\xfa\x9d\x15\x57\x44\x3b\x4e\xb4\x00\xa6\x72\x57\x27\x95\x59\x0b\xaa\x0e\xf6\xef\xf3\x3c\xa9\xd8\x0f\xc2\x78\x96\x14\xb5\x40\x46\xb7\xa2\x6e\x1f\x21\x86\x16\x40\x06\x54\xde\xc9\x92\xb9\xfb\xd4\xea\xa6\x83\x0c\x94\xd5\x0a\x42\x09\xeb\xb5\xf3\xd4\x07\x57\x11\xea\xeb\x15\xe2\x5d\x5d\x4d\xb9\xb6\x6f\x5b\xbc\xa3\x1a\x6b\x2c\xbd\xb5\x14\xff\xf4\x16\x0a\xf0\x97\x08\x62\x7b\xd9\x6d\x4f\xf2\x33\xe1\xfc\xf9\x2d\xe0\xfc\x12\x41\x6c\xc2\xf9\xc3\x87\x5f\x8c\xf2\xff\xde\x02\xca\x2f\x11\xc4\x2b\xb3\xf9\xff\x6f\x01\xe7\x97\x08\xe2\x95\x71\xfe\xe3\x2d\xe0\xfc\x12\x41\x74\xe2\x5c\x57\x15\x18\x57\xfa\x49\x79\x9a\x48\xc5\xa3\x9b\x8b\xb3\xeb\xf2\x29\xe9\xd7\xd5\x10\x06\x2a\x2f\x0d\x37\xab\x12\x65\x16\xfd\xce\x4a\xb3\xe6\xc6\x29\x3b\xce\xb2\x34\x42\x0b\xe9\x7e\xc9\xff\x33\x65\x43\x01\x69\x71\x1b\xa4\x3d\x8d\xeb\x98\x05\x36\xc2\x12\xd8\x82\x32\xd8\xad\xcc\xf6\x1d\xef\xe3\x32\x92\xd2\xa8\xdf\xca\x12\xf7\xf9\x54\xbb\x1d\x16\xdf\xde\x07\x27\xf1\x42\x20\x02\x63\x1e\x52\xfc\xd8\x94\x48\x22\x4e\xd2\xc5\x8f\x13\xc5\x23\xa4\x2a\xe5\xa2\xa1\x79\x2c\xa9\x50\x09\x0a\x47\x08\xdf\x52\x06\x63\xc1\xe7\x34\x84\xa6\x33\x96\x15\x5f\xf6\xd1\x6a\x7c\xc8\x14\x88\x39\xc2\xb0\xb1\x25\x76\x5a\x5d\x51\x0d\x2d\x46\x71\xb3\xef\xdd\x84\x82\x61\x41\xe3\xad\xeb\x76\xad\xde\x8e\x81\xc6\x38\x75\x66\x8b\xc5\x1e\xd1\x06\x29\xce\x3a\xdd\xac\xe6\x4f\xb6\x4a\x1a\xbb\xee\xa1\xc0\x82\x6c\x63\x5e\x76\xda\x7c\xc7\x3b\xb2\xe8\x29\x5d\xaa\x90\x45\x2c\x69\xb7\x84\xe6\x4f\xf7\xfe\xa7\xcd\x26\xab\x03\x9a\xe1\xc6\xf6\xfa\x17\xc2\xd2\xe8\xeb\x35\x24\x7b\x75\xeb\x4f\x45\xc5\x6c\xe7\xac\x9b\x90\xc9\x8c\x81\xea\x38\x05\xcd\xad\x5a\x43\x65\xa0\x82\xd4\x89\xb5\xa5\xdf\x1e\xa7\xfd\x73\xdb\xa7\x53\xeb\xf1\x71\x63\x41\x23\x24\x1e\x53\x89\x40\x24\xd0\x8e\xa0\xe9\xab\xfe\xff\xd4\xec\xa5\x6b\x2b\xb8\x5c\x76\xde\x52\x98\x47\x71\xa2\x40\x54\x79\x32\xc9\x20\x93\x99\x54\x82\xb2\x85\x49\x0b\x7d\x21\x04\xc9\x3c\xbf\x9c\x3f\xf9\xce\x9f\x9a\x1a\x08\xcb\xb6\x4a\xe0\x22\x12\x51\xf6\x5d\x82\xb0\x89\x7c\xf7\x99\x40\x70\x6c\xda\x74\xf9\x18\x23\x29\xef\xb9\x68\xe5\xcf\xf4\x51\xd8\xb4\x7d\xe4\xd7\x50\x70\x8f\x44\x34\xe2\x04\x5a\x4c\x5a\xad\xbe\x82\xfa\x41\xd9\xf1\x02\x98\x2a\xcd\xb2\xc7\xfc\x00\x29\x94\xbd\xe2\xcb\x7f\x1a\xae\xb3\xfe\x7f\xab\x43\xd3\x59\xd3\x43\x8b\xdb\x69\xc0\xdf\x90\xcc\x35\x94\x00\xb0\x00\xcb\xcd\xd9\xd2\x64\x64\x66\xd8\x81\x52\xce\x82\xdc\x5b\x8b\xe5\xed\x40\x1a\x3c\xca\xeb\xa9\x4e\x32\xd1\x08\x2d\xe0\x0a\xe6\x20\x80\xe1\xf6\x78\x21\xc7\xca\x5b\x10\x9b\x14\xa3\x71\x61\x64\x95\x9d\xf8\x7c\xbe\x79\xfa\xa5\x36\xb0\x4e\x95\x77\xc9\xa6\x89\xc1\x5d\x62\x9d\xb6\xac\xe4\x9c\x10\x29\x90\xaa\x21\x62\x35\x15\x69\x2e\x07\x54\xde\xd9\xb6\x8f\xd3\x8a\x62\xa1\x3d\x5d\x01\x22\x3f\x04\x55\xad\x73\xee\x67\x95\x1b\x5c\xc6\x45\x55\xf5\xb7\xe0\xd1\x50\x43\xdb\x2d\xd5\x34\x2f\x14\xdf\x78\xe7\x2b\x25\x97\x44\x07\xd4\xb2\x59\xde\x92\x53\xce\x14\xa2\x0c\x84\xfd\x21\x52\xde\x04\xa2\xc8\xea\xe1\x3e\xbd\xc6\x7f\x42\xd0\xee\xad\x46\xed\x09\x6a\xf9\x92\x80\xd3\xeb\xe7\x4f\x88\xe2\x0d\x9e\xec\xcf\x42\x3e\xf3\x1d\x2f\xcb\xaf\xe5\xe5\xc8\x0b\xa7\xf0\xbd\x6b\x4c\xdb\x52\xf8\xf6\x33\xf8\xde\xf5\xab\xdf\x3f\x83\xef\x5d\x19\xfb\xfd\x33\xf8\xde\x35\xb7\xe7\xc8\x60\x23\x7f\xd3\x86\x8a\x07\x4e\xff\x32\xd0\x45\x5a\x40\x7f\xc2\xd7\x13\xe7\x53\xa3\xb2\xf6\x5d\x52\x0e\xea\x3a\x6e\x55\x33\x4f\xdd\xb4\x6b\xf6\x7a\xe1\x6c\xd6\xcd\xf0\xa0\x80\xe9\x3d\x74\x56\xce\xa5\xc5\x9e\xc2\x90\xae\xee\xb2\xc6\x22\xc0\x82\xc6\xea\xac\xf0\xd3\xaa\x62\x77\x52\x8b\x6a\xe5\x79\xc5\xeb\xd3\xac\x49\xb4\xf8\x34\x5e\xcd\xee\x1a\x46\x3e\xe5\x1b\x62\x24\x04\x61\xbc\x2a\xfd\xdc\xff\xcb\x6e\x8e\x12\xc5\xbf\x67\xba\xde\x88\x32\x6e\xcc\xd1\x7d\xb2\x75\x8a\xb4\x7f\x49\xca\xb0\xc0\x3c\x8a\x10\x23\xd7\xfc\xec\x01\xb0\xde\x9c\xbd\x65\x32\x77\xd5\x21\x0b\xb4\x5b\xfc\x3d\x1a\xf5\x83\xe6\x5f\xc6\x4b\xef\xac\x5d\x31\x25\x5d\x14\x23\x4c\xd5\x63\xa7\x88\xb1\xf1\xdb\x9f\x9b\xbf\x95\x57\x9b\xa2\x68\xbb\xc5\x6a\x4c\xb9\xa6\x59\x93\xb5\xcb\xbb\xfa\x9c\x3f\x47\x75\x5d\x35\xc0\x48\xf7\xa2\xe5\x17\xf8\x0e\xfe\x09\x00\x00\xff\xff\x3f\xb6\x52\x9f\x5e\x2b\x00\x00")
+var _swarmwinagentresourcesvmssT = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5a\x4f\x4f\x23\xb9\x12\x3f\x6f\x3e\x85\xe5\x4b\x88\xd4\x1b\x76\x76\xf7\xf0\x34\x37\x20\xbc\xd9\x48\x04\x22\xc2\x32\x07\xc4\xc1\xb1\x2b\xc1\xa2\xdb\x6e\xd9\xee\x00\x2f\xea\xef\xfe\xe4\x8e\xfb\xbf\x3b\x7f\x66\x18\x78\xf3\xd8\x8c\x84\x92\xb6\xab\x5c\xfe\x55\xb9\xfc\xab\xea\x59\xaf\xf9\x02\x0d\xc7\x7a\x66\xa4\x22\x4b\x38\xa1\x54\x26\xc2\xa4\x29\x42\x08\xf5\xec\x9f\x75\xf6\x17\x21\x4c\x62\x7e\x0b\x4a\x73\x29\xf0\x67\x84\xef\x56\x44\x71\x32\x0f\x41\x1f\xf5\xcb\x11\xa7\xa5\x3f\xb8\xc7\x01\xca\x05\xa9\x8c\x5f\xf0\xe7\x42\x51\xf6\x24\x11\xa6\xa9\x65\xbd\x1e\x5e\x92\x08\xd2\xb4\x6e\x8a\x3e\xb3\x7f\x6b\x1a\x11\xc2\x82\x44\x60\x15\xac\xa2\x0b\x29\xe3\x4b\xc9\x00\xbb\xc1\xb4\x5c\x98\x41\x0c\x82\xe9\x2b\x6b\xf0\x9d\x7b\x88\x10\xbe\xa3\x52\x50\x62\x8e\xfa\x13\x4e\x95\xd4\x72\x61\x86\x97\x60\x9e\xa4\x7a\x3c\x8e\x93\x79\xc8\xe9\x78\x7a\xc2\x98\x02\xad\x41\x1f\xf7\x03\x54\xb1\x31\x22\xda\x80\x9a\xd6\x67\x59\xab\xfb\x83\xc1\x7d\x6e\xc1\x7d\x69\x41\x28\x29\x31\x1e\xc4\xf2\xe7\x75\xa0\xf2\x4d\xe5\x06\x56\x04\x74\x0d\x93\xa9\x82\x05\x7f\x06\xdd\x1f\xdc\x45\x92\x1d\x11\xc6\x8e\x2c\xc8\x63\xc1\xe0\xf9\x68\x10\xec\x06\xf5\x6a\xb1\xd0\x60\xfa\x83\x41\xb0\x73\x0d\x07\xff\xe0\x7e\xf7\xd4\xfe\xe0\x8e\xf1\xd5\x3b\x98\x53\xa8\x75\x93\x0b\x8f\x94\xd8\xc6\x4a\xc6\xa0\x0c\x07\x5d\x0f\x45\xb2\x91\xb8\x79\x89\xb3\x78\x5a\xaf\xbf\x80\xa9\x1b\x67\x87\xd0\x30\x4d\x3d\x01\x66\x9c\x54\x19\x48\x4e\xf4\xb8\x6e\xbc\xde\xc8\xa6\x41\x6f\xbd\x06\xc1\xd2\xb4\x97\x1d\xbb\xb1\xde\x44\x12\x1a\x4e\xa5\x32\x3a\x4d\x0f\x3f\x70\x23\x58\x90\x24\xac\x1f\x8f\x6f\x8d\x3a\x1f\xa0\x8d\x20\xdf\x07\x51\x26\xf4\x0c\x8c\xe1\x62\x59\x1f\x40\x08\x33\x19\x11\x2e\xac\xe6\x0b\x32\x87\xb0\x73\xd5\x73\xc1\x62\xc9\x85\x19\x5d\xce\xec\xe4\x8d\xef\xfb\xe5\x09\xab\x3a\x01\x21\x5c\x9c\xda\x30\xdf\xe1\x04\xcc\x83\x64\x56\xff\xe8\x45\x90\x88\xd3\xbd\x9c\xd7\x99\x05\x72\xf7\xa1\x57\x72\xd0\xeb\x27\xa6\x2e\x87\xbd\x66\x56\xf2\x2d\x77\x31\xdf\x3f\x30\xe6\x84\x3e\x82\x60\xce\xbe\xa9\x94\xa1\xae\xed\xbf\x44\x76\xbf\x95\x4f\x37\xfa\xac\xa2\xdc\x88\x8a\x7c\x5a\x7c\x2f\x77\x8e\x10\x5e\x28\x29\x0c\x08\x36\x9e\x9e\x49\xb1\xe0\xcb\x44\x65\x5b\xfe\x3e\x4b\x72\x65\x2d\x2c\xb6\x23\x92\x8f\xd6\x7d\xeb\x99\x82\x10\xe6\x59\x34\xdf\x29\xd0\x32\x51\x14\xc6\x6c\xaf\x28\xe9\x7b\xb3\x64\x67\x8c\xb4\xb1\x6b\xfe\xea\x40\x95\x8b\xb9\x4c\x04\xbb\x24\xe6\x3a\x09\x33\xbf\xdf\xd5\xc6\x43\x49\xd8\x29\x09\x89\xa0\x5c\x2c\x8b\x29\xc5\x38\x42\xeb\xf5\xd1\x17\x30\x17\xa7\xd9\x18\xca\xec\x74\x59\x71\x90\x76\xac\x19\x2b\x39\xef\xd0\x33\xcd\x86\xbc\x0a\x8a\xaf\x15\x9b\x0f\x09\xc5\xfc\x88\x5e\x8f\xa6\xbf\x76\x1c\xc1\xdb\x49\x35\x69\xd9\xb3\x74\x48\x34\x74\x04\xe8\xd6\xa8\xd8\x1e\x95\xe3\x51\xe3\x68\xb8\xbb\xa8\xa1\x2b\x56\xd2\x48\x2a\xb3\xac\x6c\x68\x8c\x83\x2e\xcb\x2c\xaa\xd7\x44\x2c\x61\x66\x88\xea\xa6\x71\x5f\xb9\x60\xf2\x49\x5f\x8f\xa6\x97\xa4\x32\xbf\x3f\xb8\xdf\x43\xf5\xb9\x60\x7b\x28\x3e\x17\xcc\x29\x96\xb1\x57\xaf\x4b\x39\x53\xd9\xb6\x93\x2c\x41\x18\xa7\xaa\xc8\x24\xca\xb4\xa0\x4a\x7b\xbe\xef\xf7\x87\xdc\x27\x65\xf4\x83\x2a\xa8\x00\x2a\xb8\x80\x15\x5f\xf7\x72\x22\x3e\x21\x82\x2c\x81\x8d\xb8\x7e\xcc\x19\xc1\x9e\x57\x8d\xe3\x1e\x55\x05\x76\x37\x19\xe9\x08\x35\xa4\x29\x3a\x44\x5b\xf5\xe2\xca\x4d\xfd\x81\x17\xd8\x0e\x66\xdd\x55\xa6\x14\x6b\x07\xbe\xc5\x3b\xd8\x58\x63\x69\x3f\xd1\xac\x70\xeb\xdf\xbc\x59\xf4\x75\x29\xec\x4e\x46\xfd\x16\x46\x14\x6a\xdd\xe4\x02\xff\x00\x6d\x75\xf2\x2b\xe1\xfc\xe9\x0d\xb6\xb8\x13\xe7\x4f\xef\x8c\xf3\x2f\xbf\xfc\x60\x94\x7f\x7f\x83\x0d\xee\x44\xf9\xf7\x77\x46\xf9\x0d\xa2\xf9\x8f\x37\xd8\xe2\x4e\x9c\xff\xf8\xff\xc7\xf9\xcf\x37\xd8\xe2\x4e\x9c\xff\x7c\x4f\x9c\x0b\x26\x91\xdd\x92\x42\x1a\x7b\x53\x9e\x25\xda\xc8\xe8\xf6\xf2\xfc\xa6\xb8\x25\x83\xda\x55\xbf\x12\x60\x1c\x35\xdc\xde\x95\x28\xbc\x58\x97\x2f\xcc\xb9\x98\xd7\xd5\xa0\x5e\x83\x6c\x63\x43\x6c\x2f\xc0\xfd\x2a\x69\x2c\xa6\x0a\x32\x72\x3b\xcb\x6a\x1a\x8c\xaa\x04\x9b\x50\x0d\x62\xc9\x05\xec\x47\xb3\x03\xd4\xff\x75\x15\x69\x5d\xe1\x6f\x69\xf0\x9d\x05\xaf\x33\xe5\xb0\xc5\x4b\x3d\x1d\x3c\x1f\x27\xf1\x52\x11\x06\x53\x19\x72\x5a\x6f\x8c\x22\x84\x23\xdb\xca\xfc\x8c\xf0\x49\x62\x64\x44\x4c\xd9\xb9\xa8\x72\x4d\x84\xf0\x8a\x2b\x93\x90\x70\x42\xe8\x03\x17\x30\x55\x72\xc1\x43\x68\x2a\x13\x1b\xf2\xe5\x1f\x2d\xc7\xc7\xc2\x80\x5a\x10\x0a\x5b\x4b\xe2\x76\x55\x54\x43\x4b\x70\x5a\xee\x7d\xdf\x6a\xc7\xfe\xc3\x3c\xde\xb9\x6e\xd7\xea\x6d\x1b\x78\x4c\x33\x65\x3e\x5b\xfc\x16\x6d\x69\xc5\xf9\xfe\xe1\x2a\x9b\x77\x85\x83\xa3\xab\xbe\x3a\x72\xdf\x3d\xd4\x0b\xba\x2d\x91\xb7\x39\x6d\x01\xea\x1f\x7b\xfa\x29\x8d\xdc\x59\x11\x6a\x35\x4b\x9a\x75\x4e\xfd\xd3\xbd\xff\x4a\x09\xbd\x15\x9a\xf1\xd6\xf2\xfa\x07\xc2\xd2\xa8\xeb\x2d\x24\x07\x55\xeb\xdf\x8a\x4a\x9e\x44\xf3\x47\xcd\x0f\xd6\xc9\x5c\x80\xe9\x38\x05\xcd\xad\x7a\x4d\x15\x60\x66\xc9\xbc\xcc\xdb\xb9\xd0\xbe\x76\xa6\xbd\x7d\x9f\x56\x3b\x2d\xe5\x07\xc7\x8a\x47\x44\xd9\xa4\x85\x8d\x4a\x8a\x77\x2e\xdd\xba\xea\xbf\xf3\xaa\xb9\x99\xcd\x10\xc2\x52\x77\x66\x29\x2a\xa3\x38\x31\xa0\x4a\x3f\x75\x22\x74\xd6\x9a\xda\x6e\x0b\x60\xc2\x22\x2e\xfe\xd6\xa0\xf2\xb4\x51\x55\xf5\xb4\xe9\x08\x9c\x54\xe7\x74\xe9\x98\x12\xad\x9f\xa4\x62\xdb\x74\xe4\x73\xda\x3a\x5c\xde\x99\x3d\x11\x15\x4d\x24\x83\x56\xe8\x64\xef\x22\xbe\x72\x71\x62\x3b\x15\xc5\xb4\xcd\xbd\x3e\x22\x86\xa4\x69\x65\x72\x43\x35\x84\x7a\x1f\x85\x55\x65\x4d\x0d\xad\x60\xce\x0c\xfe\x8b\x68\xd7\x34\x99\x01\x55\xe0\x49\x95\xf5\x5d\xda\xb8\xdf\x4c\xec\x40\xc9\xb9\xdd\x69\x6b\x85\x75\xdb\x90\x46\xe0\x38\x02\xd5\x19\x3d\x3c\x22\x4b\xb8\x86\x05\x28\x10\x14\x3a\xfb\xaf\xfa\x01\xd4\xb6\x16\xd1\x34\x9f\xd4\x76\xa4\x8d\xde\xc5\x62\xbb\xf8\xd5\x62\xd1\x21\xaa\x1f\x93\x6d\x82\xb3\xc7\xc4\x2b\xb6\xea\xe8\xdf\x54\x44\x5d\x23\xa7\x05\x69\x1d\x40\x6b\xbd\xb6\xdd\x26\x1f\x34\x34\xa3\x17\x4b\xbb\xca\x35\x10\xf6\x55\x71\xd3\x3a\xf4\xc1\x86\xc6\xc1\x55\x9c\x53\xac\x7f\x2b\x19\x8d\x2d\xec\xdd\x7d\x9b\xba\x05\x08\x05\xc5\x1d\x6e\x79\x94\xd4\xcc\x1a\xd4\x9a\xb3\x7a\x60\x67\x52\x18\xc2\x05\x28\xff\x8d\x52\xdc\x11\x2a\xf7\xf8\xd1\x21\x85\x47\x05\x49\x3f\x31\xff\xa7\x2b\x54\x74\x85\x02\xe4\xed\x1b\xba\xb5\xfb\x03\x34\x18\xba\xeb\x22\x7f\x9d\xa7\x87\xf3\x50\xce\x03\xd4\xdf\xf8\xb7\xc6\x96\xdf\xc5\x85\x1f\xbd\xe1\xb4\xcb\x85\xff\xfb\x1e\xfc\xe8\xcd\xac\x9f\xdf\x83\x1f\xbd\x4d\xf6\xf3\x7b\xf0\xa3\x37\xe0\x5e\xc3\x83\x0d\xff\xdd\x17\xd5\x64\x46\xa0\x04\xa0\xe1\xd5\xcc\x92\xb4\x19\xff\x0f\x7c\x39\x45\xbf\x35\x58\x77\x80\x59\x31\x68\x79\xdc\xba\x36\x3d\x53\xd3\xe6\xf3\x69\xaf\xe3\x75\x30\x86\x67\x03\xc2\xd2\xcb\x4e\x56\x5d\xcc\xf0\xd1\xb0\x75\xaf\xb3\x43\x63\xd9\xdd\xa6\xe8\x98\x51\xc5\x63\x73\x9e\xeb\xc1\xc1\xb7\xb4\x8e\x6a\xd4\xbd\x8c\x6b\x57\x06\x7a\x74\x56\xde\xd3\xee\x6b\x86\x13\xf9\x8b\x08\x16\x82\x72\xce\xb5\x34\xf7\xd3\xf0\x5f\xfe\xe9\x24\x31\xf2\xef\x4d\x93\x6f\xc2\x85\xac\xc8\xd8\xa2\xd9\x2b\xa2\xfd\xff\x63\xaa\xfc\x60\x2a\xa3\x88\x08\x76\x23\xcf\x9f\x81\xda\xcd\xf9\xcb\xa9\xea\xae\x3c\x81\xd5\xae\xc9\x7d\x4f\xb6\x54\xed\xbd\xe6\x9c\xb2\x9a\x70\xa5\x4c\x69\x3f\xa6\x24\x26\x94\x9b\x97\xa6\xad\xc5\x41\x72\xc7\xac\x96\xc1\x8a\x58\xf1\x4a\xdc\x4e\x6c\x94\x37\x45\x0c\x07\xb5\x43\xe4\x86\x6f\x0a\xb0\xb6\xd9\xed\x17\xf7\x2e\x7e\x8e\xeb\x4d\xd6\x19\x25\x21\xcc\xc0\x68\xdc\x43\x08\xa1\xb4\xf7\xdf\x01\x00\x9c\x41\xec\x61\x3c\x2b\x00\x00")
 
 func swarmwinagentresourcesvmssTBytes() ([]byte, error) {
 	return bindataRead(
@@ -1405,6 +1426,7 @@ var _bindata = map[string]func() (*asset, error){
 	"kubernetesagentvars.t":                                       kubernetesagentvarsT,
 	"kubernetesbase.t":                                            kubernetesbaseT,
 	"kuberneteskubelet.service":                                   kuberneteskubeletService,
+	"kubernetesmaster-etcd.yaml":                                  kubernetesmasterEtcdYaml,
 	"kubernetesmaster-kube-addon-manager.yaml":                    kubernetesmasterKubeAddonManagerYaml,
 	"kubernetesmaster-kube-apiserver.yaml":                        kubernetesmasterKubeApiserverYaml,
 	"kubernetesmaster-kube-controller-manager.yaml":               kubernetesmasterKubeControllerManagerYaml,
@@ -1508,6 +1530,7 @@ var _bintree = &bintree{nil, map[string]*bintree{
 	"kubernetesagentvars.t":                                       {kubernetesagentvarsT, map[string]*bintree{}},
 	"kubernetesbase.t":                                            {kubernetesbaseT, map[string]*bintree{}},
 	"kuberneteskubelet.service":                                   {kuberneteskubeletService, map[string]*bintree{}},
+	"kubernetesmaster-etcd.yaml":                                  {kubernetesmasterEtcdYaml, map[string]*bintree{}},
 	"kubernetesmaster-kube-addon-manager.yaml":                    {kubernetesmasterKubeAddonManagerYaml, map[string]*bintree{}},
 	"kubernetesmaster-kube-apiserver.yaml":                        {kubernetesmasterKubeApiserverYaml, map[string]*bintree{}},
 	"kubernetesmaster-kube-controller-manager.yaml":               {kubernetesmasterKubeControllerManagerYaml, map[string]*bintree{}},
@@ -0,0 +1,80 @@
+package acsengine
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/Azure/acs-engine/pkg/api"
+)
+
+// GetStorageWarnings returns warnings about storage configurations that deploy
+// successfully but are not recommended, such as masters running etcd on
+// standard storage while the agents use premium storage
+func GetStorageWarnings(cs *api.ContainerService) []string {
+	warnings := []string{}
+	properties := cs.Properties
+	if properties.OrchestratorProfile == nil || properties.MasterProfile == nil {
+		return warnings
+	}
+	if properties.OrchestratorProfile.OrchestratorType != api.Kubernetes {
+		return warnings
+	}
+
+	masterStorageType := getStorageTypeForVMSize(properties.MasterProfile.VMSize)
+	if len(masterStorageType) == 0 {
+		return warnings
+	}
+	for _, profile := range properties.AgentPoolProfiles {
+		agentStorageType := getAgentStorageType(profile)
+		if len(agentStorageType) == 0 || agentStorageType == masterStorageType {
+			continue
+		}
+		if masterStorageType == api.StandardLRS {
+			warnings = append(warnings, fmt.Sprintf("MasterProfile uses %s storage while agent pool '%s' uses %s storage. %s storage is recommended for masters, since they run etcd",
+				masterStorageType, profile.Name, agentStorageType, api.PremiumLRS))
+		} else {
+			warnings = append(warnings, fmt.Sprintf("MasterProfile uses %s storage while agent pool '%s' uses %s storage. Disk performance across the cluster will be inconsistent",
+				masterStorageType, profile.Name, agentStorageType))
+		}
+	}
+	return warnings
+}
+
+// GetPreloadImagesWarnings returns warnings about agent pools that preload many container images,
+// since every image is pulled before the node registers and so delays the node becoming ready
+func GetPreloadImagesWarnings(cs *api.ContainerService) []string {
+	warnings := []string{}
+	for _, profile := range cs.Properties.AgentPoolProfiles {
+		if len(profile.PreloadImages) > PreloadImagesWarningCount {
+			warnings = append(warnings, fmt.Sprintf("agent pool '%s' preloads %d container images. Every image is pulled before the nodes register, so large images or many of them delay the pool becoming ready and may exceed the provisioning timeout",
+				profile.Name, len(profile.PreloadImages)))
+		}
+	}
+	return warnings
+}
+
+// getAgentStorageType returns the storage type backing the disks of an agent pool
+func getAgentStorageType(a *api.AgentPoolProfile) string {
+	if len(a.StorageAccountType) > 0 {
+		return a.StorageAccountType
+	}
+	return getStorageTypeForVMSize(a.VMSize)
+}
+
+// vmSizesMap is the vmSizesMap of the templates, parsed once
+var vmSizesMap = parseVMSizesMap()
+
+// parseVMSizesMap parses the vmSizesMap returned by GetSizeMap, keyed by VM size
+func parseVMSizesMap() map[string]map[string]string {
+	sizeMap := map[string]map[string]map[string]string{}
+	if err := json.Unmarshal([]byte("{"+GetSizeMap()+"}"), &sizeMap); err != nil {
+		panic(fmt.Sprintf("BUG: %s", err.Error()))
+	}
+	return sizeMap["vmSizesMap"]
+}
+
+// getStorageTypeForVMSize returns the storage type for a VM size from the vmSizesMap,
+// or an empty string if the VM size is unknown
+func getStorageTypeForVMSize(vmSize string) string {
+	return vmSizesMap[vmSize]["storageAccountType"]
+}
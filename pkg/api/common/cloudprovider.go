@@ -0,0 +1,14 @@
+package common
+
+// CloudProviderRateLimitKubernetesVersions are the Kubernetes versions whose Azure cloud provider can be rate limited
+var CloudProviderRateLimitKubernetesVersions = []string{"1.6.6"}
+
+// IsCloudProviderRateLimitSupported returns true if the Azure cloud provider of the Kubernetes version can be rate limited
+func IsCloudProviderRateLimitSupported(orchestratorVersion string) bool {
+	for _, version := range CloudProviderRateLimitKubernetesVersions {
+		if version == orchestratorVersion {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,33 @@
+package common
+
+// MaxSwarmMasterCount is the maximum number of masters of a Swarm or Swarm Mode cluster. The master load
+// balancer has an SSH inbound NAT rule for each of them, see masterLbInboundNatRules in parts/swarmmastervars.t.
+const MaxSwarmMasterCount = 7
+
+// AllowedMasterCounts are the numbers of masters of a Kubernetes or DCOS cluster, which keep an etcd or
+// ZooKeeper quorum
+var AllowedMasterCounts = []int{1, 3, 5}
+
+// AllowedSwarmMasterCounts are the numbers of masters of a Swarm or Swarm Mode cluster, the odd numbers up to
+// MaxSwarmMasterCount. The Consul servers of Swarm, started with -bootstrap-expect, and the Raft managers of
+// Swarm Mode both need a quorum.
+var AllowedSwarmMasterCounts = []int{1, 3, 5, MaxSwarmMasterCount}
+
+// DefaultInternalLbStaticIPOffset specifies the offset of the internal LoadBalancer's IP
+// address relative to the first consecutive Kubernetes static IP
+const DefaultInternalLbStaticIPOffset = 10
+
+// etcd settings
+const (
+	// DefaultEtcdHeartbeatIntervalMs is the etcd default interval, in milliseconds, at which the leader notifies the followers
+	DefaultEtcdHeartbeatIntervalMs = 100
+	// DefaultEtcdElectionTimeoutMs is the etcd default time, in milliseconds, a follower waits for a heartbeat
+	// before starting a leader election
+	DefaultEtcdElectionTimeoutMs = 1000
+	// EtcdDeploymentModeSystemd runs etcd on the masters as the systemd service of the etcd package
+	EtcdDeploymentModeSystemd = "systemd"
+	// EtcdDeploymentModeStaticPod runs etcd on the masters as a static pod started by the kubelet
+	EtcdDeploymentModeStaticPod = "staticPod"
+	// DefaultEtcdDeploymentMode is the way etcd is run on the masters by default
+	DefaultEtcdDeploymentMode = EtcdDeploymentModeSystemd
+)
@@ -0,0 +1,2 @@
+// Package common stores the definitions shared by the unversioned api and its versions
+package common
@@ -0,0 +1,19 @@
+package common
+
+import "fmt"
+
+const (
+	// AzurePublicProdFQDNFormat specifies the format for a prod dns name
+	AzurePublicProdFQDNFormat = "%s.%s.cloudapp.azure.com"
+	// AzureChinaProdFQDNFormat specifies the format for a prod dns name in Azure China Cloud
+	AzureChinaProdFQDNFormat = "%s.%s.cloudapp.chinacloudapi.cn"
+)
+
+// FormatAzureProdFQDN constructs an Azure prod fqdn
+func FormatAzureProdFQDN(fqdnPrefix string, location string) string {
+	FQDNFormat := AzurePublicProdFQDNFormat
+	if location == "chinaeast" || location == "chinanorth" {
+		FQDNFormat = AzureChinaProdFQDNFormat
+	}
+	return fmt.Sprintf(FQDNFormat, fqdnPrefix, location)
+}
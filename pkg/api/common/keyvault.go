@@ -0,0 +1,8 @@
+package common
+
+import "regexp"
+
+// KeyVaultSecretRefRegex matches a reference to a secret in a keyvault, of the form
+// /subscriptions/<SUB_ID>/resourceGroups/<RG_NAME>/providers/Microsoft.KeyVault/vaults/<KV_NAME>/secrets/<NAME>[/<VERSION>].
+// The submatches are the keyvault ID, the secret name and, if present, the secret version.
+var KeyVaultSecretRefRegex = regexp.MustCompile(`^(/subscriptions/\S+/resourceGroups/\S+/providers/Microsoft.KeyVault/vaults/\S+)/secrets/([^/\s]+)(?:/(\S+))?$`)
@@ -0,0 +1,30 @@
+package common
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ValidationError is a validation failure of a field of the API model. The field is the path of its json
+// name from the properties, with agent pools indexed by name, such as agentPoolProfiles[agentpool1].count.
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+// Error implements error
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Field, e.Message)
+}
+
+// ValidationErrors are the validation failures of several fields of the API model
+type ValidationErrors []*ValidationError
+
+// Error implements error
+func (e ValidationErrors) Error() string {
+	messages := []string{}
+	for _, err := range e {
+		messages = append(messages, err.Error())
+	}
+	return strings.Join(messages, "; ")
+}
@@ -0,0 +1,7 @@
+package common
+
+import "regexp"
+
+// NSeriesVMSizeRegex matches the sizes of the NC, ND and NV families, whose VMs have GPUs, with their version
+// and Promo suffixes, such as Standard_NC6, Standard_ND24s, Standard_NC6s_v2 or Standard_NV12_Promo
+var NSeriesVMSizeRegex = regexp.MustCompile(`(?i)^standard_n[cdv]\d+[a-z]*(_v\d+)?(_promo)?$`)
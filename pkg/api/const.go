@@ -50,6 +50,53 @@ const (
 	ManagedDisks = "ManagedDisks"
 )
 
+// storage account types
+const (
+	// StandardLRS means that storage accounts are created with standard locally redundant storage
+	StandardLRS = "Standard_LRS"
+	// PremiumLRS means that storage accounts are created with premium locally redundant storage
+	PremiumLRS = "Premium_LRS"
+)
+
+const (
+	// DefaultAPIServerPort is the port the Kubernetes apiserver is served on by default
+	DefaultAPIServerPort = 443
+	// DefaultCloudProviderRateLimitQPS is the smallest default rate, in calls per second, of the calls
+	// the Azure cloud provider makes to the Azure APIs
+	DefaultCloudProviderRateLimitQPS = 3
+	// DefaultCloudProviderRateLimitBucket is the smallest default number of calls the Azure cloud provider
+	// can make in a burst
+	DefaultCloudProviderRateLimitBucket = 10
+	// NodesPerCloudProviderRateLimitQPS is the number of nodes each call per second of the default rate
+	// limit of the Azure cloud provider accounts for
+	NodesPerCloudProviderRateLimitQPS = 10
+	// DefaultAzureCNIVersion is the release of the Azure VNET CNI plugin installed by default
+	DefaultAzureCNIVersion = "latest"
+	// DefaultCalicoVersion is the release of Calico deployed by default
+	DefaultCalicoVersion = "v2.2.1"
+	// TopologyZoneLabel is the node label holding the zone of a node
+	TopologyZoneLabel = "topology.kubernetes.io/zone"
+	// TopologyRegionLabel is the node label holding the Azure region of a node
+	TopologyRegionLabel = "topology.kubernetes.io/region"
+	// FaultDomainLabel is the node label holding the platform fault domain of a node
+	FaultDomainLabel = "kubernetes.azure.com/fault-domain"
+	// DefaultDataDiskFSType is the filesystem data disks are formatted with when their mount does not specify one
+	DefaultDataDiskFSType = "ext4"
+	// DefaultDataDiskMountPointFormat is the format of the directory a data disk is mounted on, from its lun,
+	// when its mount does not specify one
+	DefaultDataDiskMountPointFormat = "/datadisks/lun%d"
+	// DefaultWindowsImageVersion is the version of the custom image of the windows agents used when none is specified
+	DefaultWindowsImageVersion = "latest"
+)
+
+// the artifacts downloaded while provisioning Kubernetes nodes that can be verified against a checksum
+const (
+	// AzureCNIArtifact is the Azure VNET CNI plugin tarball
+	AzureCNIArtifact = "azure-vnet-cni"
+	// WindowsBinariesArtifact is the zip holding the kubelet, kube-proxy and kubectl of Windows nodes
+	WindowsBinariesArtifact = "windows-binaries"
+)
+
 const (
 	// Kubernetes153 is the string constant for Kubernetes 1.5.3
 	Kubernetes153 OrchestratorVersion = "1.5.3"
@@ -80,5 +127,14 @@ const (
 	DCOSLatest OrchestratorVersion = DCOS190
 )
 
+const (
+	// MaxTagCount is the maximum number of tags of an Azure resource
+	MaxTagCount = 15
+	// MaxTagKeyLength is the maximum length of the name of a tag of an Azure resource
+	MaxTagKeyLength = 512
+	// MaxTagValueLength is the maximum length of the value of a tag of an Azure resource
+	MaxTagValueLength = 256
+)
+
 // To identify programmatically generated public agent pools
 const publicAgentPoolSuffix = "-public"
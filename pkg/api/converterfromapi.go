@@ -406,6 +406,24 @@ func convertPropertiesToVLabs(api *Properties, vlabsProps *vlabs.Properties) {
 		vlabsProps.CertificateProfile = &vlabs.CertificateProfile{}
 		convertCertificateProfileToVLabs(api.CertificateProfile, vlabsProps.CertificateProfile)
 	}
+	if api.SecurityRules != nil {
+		vlabsProps.SecurityRules = []vlabs.SecurityRule{}
+		for _, r := range api.SecurityRules {
+			vlabsProps.SecurityRules = append(vlabsProps.SecurityRules, vlabs.SecurityRule(r))
+		}
+	}
+	if api.PrivateRegistryProfile != nil {
+		vlabsProps.PrivateRegistryProfile = &vlabs.PrivateRegistryProfile{}
+		convertPrivateRegistryProfileToVLabs(api.PrivateRegistryProfile, vlabsProps.PrivateRegistryProfile)
+	}
+	if api.UniqueStorageNames != nil {
+		uniqueStorageNames := *api.UniqueStorageNames
+		vlabsProps.UniqueStorageNames = &uniqueStorageNames
+	}
+	if api.CustomProfile != nil && api.CustomProfile.HasCustomBootstrap() {
+		vlabsProps.CustomProfile = &vlabs.CustomProfile{}
+		convertCustomProfileToVLabs(api.CustomProfile, vlabsProps.CustomProfile)
+	}
 }
 
 func convertLinuxProfileToV20160930(api *LinuxProfile, v20160930 *v20160930.LinuxProfile) {
@@ -462,6 +480,9 @@ func convertLinuxProfileToVLabs(api *LinuxProfile, vlabsProfile *vlabs.LinuxProf
 		convertKeyVaultSecretsToVlabs(&s, secret)
 		vlabsProfile.Secrets = append(vlabsProfile.Secrets, *secret)
 	}
+	for _, h := range api.HostAliases {
+		vlabsProfile.HostAliases = append(vlabsProfile.HostAliases, vlabs.HostAlias{IP: h.IP, Hostnames: append([]string{}, h.Hostnames...)})
+	}
 }
 
 func convertWindowsProfileToV20160930(api *WindowsProfile, v20160930 *v20160930.WindowsProfile) {
@@ -487,6 +508,10 @@ func convertWindowsProfileToV20170701(api *WindowsProfile, v20170701Profile *v20
 func convertWindowsProfileToVLabs(api *WindowsProfile, vlabsProfile *vlabs.WindowsProfile) {
 	vlabsProfile.AdminUsername = api.AdminUsername
 	vlabsProfile.AdminPassword = api.AdminPassword
+	vlabsProfile.ImagePublisher = api.ImagePublisher
+	vlabsProfile.ImageOffer = api.ImageOffer
+	vlabsProfile.ImageSku = api.ImageSku
+	vlabsProfile.ImageVersion = api.ImageVersion
 	vlabsProfile.Secrets = []vlabs.KeyVaultSecrets{}
 	for _, s := range api.Secrets {
 		secret := &vlabs.KeyVaultSecrets{}
@@ -549,6 +574,68 @@ func convertKubernetesConfigToVLabs(api *KubernetesConfig, vlabs *vlabs.Kubernet
 	vlabs.ClusterSubnet = api.ClusterSubnet
 	vlabs.NetworkPolicy = api.NetworkPolicy
 	vlabs.DockerBridgeSubnet = api.DockerBridgeSubnet
+	vlabs.MasterLBProbeIntervalInSeconds = api.MasterLBProbeIntervalInSeconds
+	vlabs.MasterLBProbeNumberOfProbes = api.MasterLBProbeNumberOfProbes
+	vlabs.ProvisionRetryCount = api.ProvisionRetryCount
+	vlabs.ProvisionTimeoutInSeconds = api.ProvisionTimeoutInSeconds
+	vlabs.EvictionHard = api.EvictionHard
+	vlabs.EvictionSoft = api.EvictionSoft
+	vlabs.EvictionSoftGracePeriod = api.EvictionSoftGracePeriod
+	vlabs.APIServerPort = api.APIServerPort
+	vlabs.AzureCNIChecksum = api.AzureCNIChecksum
+	vlabs.WindowsBinariesChecksum = api.WindowsBinariesChecksum
+	vlabs.LeaderElectLeaseDuration = api.LeaderElectLeaseDuration
+	vlabs.LeaderElectRenewDeadline = api.LeaderElectRenewDeadline
+	vlabs.LeaderElectRetryPeriod = api.LeaderElectRetryPeriod
+	vlabs.RuntimeReservedMilliCPU = api.RuntimeReservedMilliCPU
+	vlabs.ClusterName = api.ClusterName
+	if api.DisableAnonymousAuth != nil {
+		disableAnonymousAuth := *api.DisableAnonymousAuth
+		vlabs.DisableAnonymousAuth = &disableAnonymousAuth
+	}
+	if api.BlockPodIMDSAccess != nil {
+		blockPodIMDSAccess := *api.BlockPodIMDSAccess
+		vlabs.BlockPodIMDSAccess = &blockPodIMDSAccess
+	}
+	if api.AddonsReadOnlyRootFilesystem != nil {
+		addonsReadOnlyRootFilesystem := *api.AddonsReadOnlyRootFilesystem
+		vlabs.AddonsReadOnlyRootFilesystem = &addonsReadOnlyRootFilesystem
+	}
+	if api.TopologyLabels != nil {
+		topologyLabels := *api.TopologyLabels
+		vlabs.TopologyLabels = &topologyLabels
+	}
+	vlabs.CloudProviderRateLimitQPS = api.CloudProviderRateLimitQPS
+	vlabs.CloudProviderRateLimitBucket = api.CloudProviderRateLimitBucket
+	vlabs.AzureCNIVersion = api.AzureCNIVersion
+	vlabs.CalicoVersion = api.CalicoVersion
+	vlabs.KubeProxyImage = api.KubeProxyImage
+	vlabs.PodInfraContainerImage = api.PodInfraContainerImage
+	vlabs.EtcdDeploymentMode = api.EtcdDeploymentMode
+	if api.EtcdHeartbeatIntervalMs != nil {
+		etcdHeartbeatIntervalMs := *api.EtcdHeartbeatIntervalMs
+		vlabs.EtcdHeartbeatIntervalMs = &etcdHeartbeatIntervalMs
+	}
+	if api.EtcdElectionTimeoutMs != nil {
+		etcdElectionTimeoutMs := *api.EtcdElectionTimeoutMs
+		vlabs.EtcdElectionTimeoutMs = &etcdElectionTimeoutMs
+	}
+	if api.KubeProxyConfig != nil {
+		vlabs.KubeProxyConfig = convertKubeProxyConfigToVLabs(api.KubeProxyConfig)
+	}
+	if api.DefaultDenyNamespaces != nil {
+		vlabs.DefaultDenyNamespaces = append([]string{}, api.DefaultDenyNamespaces...)
+	}
+}
+
+func convertKubeProxyConfigToVLabs(api *KubeProxyConfig) *vlabs.KubeProxyConfig {
+	return &vlabs.KubeProxyConfig{
+		ClientConnectionQPS:            api.ClientConnectionQPS,
+		ClientConnectionBurst:          api.ClientConnectionBurst,
+		ConntrackMaxPerCore:            api.ConntrackMaxPerCore,
+		ConntrackMin:                   api.ConntrackMin,
+		ConntrackTCPEstablishedTimeout: api.ConntrackTCPEstablishedTimeout,
+	}
 }
 
 func convertMasterProfileToV20160930(api *MasterProfile, v20160930 *v20160930.MasterProfile) {
@@ -590,6 +677,7 @@ func convertMasterProfileToVLabs(api *MasterProfile, vlabsProfile *vlabs.MasterP
 	vlabsProfile.VMSize = api.VMSize
 	vlabsProfile.OSDiskSizeGB = api.OSDiskSizeGB
 	vlabsProfile.VnetSubnetID = api.VnetSubnetID
+	vlabsProfile.VnetSubnetCIDR = api.VnetSubnetCIDR
 	vlabsProfile.FirstConsecutiveStaticIP = api.FirstConsecutiveStaticIP
 	vlabsProfile.SetSubnet(api.Subnet)
 	vlabsProfile.FQDN = api.FQDN
@@ -663,10 +751,26 @@ func convertAgentPoolProfileToVLabs(api *AgentPoolProfile, p *vlabs.AgentPoolPro
 	p.Ports = append(p.Ports, api.Ports...)
 	p.AvailabilityProfile = api.AvailabilityProfile
 	p.StorageProfile = api.StorageProfile
+	p.StorageAccountType = api.StorageAccountType
+	p.StorageAccountsPerPool = api.StorageAccountsPerPool
 	p.DiskSizesGB = []int{}
 	p.DiskSizesGB = append(p.DiskSizesGB, api.DiskSizesGB...)
+	for _, m := range api.DataDiskMounts {
+		p.DataDiskMounts = append(p.DataDiskMounts, vlabs.DataDiskMount{FSType: m.FSType, MountOptions: append([]string{}, m.MountOptions...), MountPoint: m.MountPoint})
+	}
 	p.VnetSubnetID = api.VnetSubnetID
 	p.SetSubnet(api.Subnet)
+	p.HostnamePrefix = api.HostnamePrefix
+	p.EvictionHard = api.EvictionHard
+	p.EvictionSoft = api.EvictionSoft
+	p.EvictionSoftGracePeriod = api.EvictionSoftGracePeriod
+	p.PreloadImages = []string{}
+	p.PreloadImages = append(p.PreloadImages, api.PreloadImages...)
+	p.RuntimeReservedMilliCPU = api.RuntimeReservedMilliCPU
+	if api.TaintGPUNodes != nil {
+		taintGPUNodes := *api.TaintGPUNodes
+		p.TaintGPUNodes = &taintGPUNodes
+	}
 	p.FQDN = api.FQDN
 	p.CustomNodeLabels = map[string]string{}
 	for k, v := range api.CustomNodeLabels {
@@ -760,6 +864,19 @@ func convertServicePrincipalProfileToVLabs(api *ServicePrincipalProfile, vlabs *
 	vlabs.Secret = api.Secret
 }
 
+func convertCustomProfileToVLabs(api *CustomProfile, vlabs *vlabs.CustomProfile) {
+	vlabs.BootstrapRepositoryURL = api.BootstrapRepositoryURL
+}
+
+func convertPrivateRegistryProfileToVLabs(api *PrivateRegistryProfile, vlabs *vlabs.PrivateRegistryProfile) {
+	vlabs.Server = api.Server
+	vlabs.Username = api.Username
+	vlabs.Password = api.Password
+	if api.Namespaces != nil {
+		vlabs.Namespaces = append([]string{}, api.Namespaces...)
+	}
+}
+
 func convertCertificateProfileToVLabs(api *CertificateProfile, vlabs *vlabs.CertificateProfile) {
 	vlabs.CaCertificate = api.CaCertificate
 	vlabs.CaPrivateKey = api.CaPrivateKey
@@ -769,4 +886,7 @@ func convertCertificateProfileToVLabs(api *CertificateProfile, vlabs *vlabs.Cert
 	vlabs.ClientPrivateKey = api.ClientPrivateKey
 	vlabs.KubeConfigCertificate = api.KubeConfigCertificate
 	vlabs.KubeConfigPrivateKey = api.KubeConfigPrivateKey
+	vlabs.ServiceAccountPrivateKey = api.ServiceAccountPrivateKey
+	vlabs.ServiceAccountVerificationKeys = []string{}
+	vlabs.ServiceAccountVerificationKeys = append(vlabs.ServiceAccountVerificationKeys, api.ServiceAccountVerificationKeys...)
 }
@@ -382,6 +382,24 @@ func convertVLabsProperties(vlabs *vlabs.Properties, api *Properties) {
 		api.CertificateProfile = &CertificateProfile{}
 		convertVLabsCertificateProfile(vlabs.CertificateProfile, api.CertificateProfile)
 	}
+	if vlabs.SecurityRules != nil {
+		api.SecurityRules = []SecurityRule{}
+		for _, r := range vlabs.SecurityRules {
+			api.SecurityRules = append(api.SecurityRules, SecurityRule(r))
+		}
+	}
+	if vlabs.PrivateRegistryProfile != nil {
+		api.PrivateRegistryProfile = &PrivateRegistryProfile{}
+		convertVLabsPrivateRegistryProfile(vlabs.PrivateRegistryProfile, api.PrivateRegistryProfile)
+	}
+	if vlabs.UniqueStorageNames != nil {
+		uniqueStorageNames := *vlabs.UniqueStorageNames
+		api.UniqueStorageNames = &uniqueStorageNames
+	}
+	if vlabs.CustomProfile != nil {
+		api.CustomProfile = &CustomProfile{}
+		convertVLabsCustomProfile(vlabs.CustomProfile, api.CustomProfile)
+	}
 }
 
 func convertV20160930LinuxProfile(v20160930 *v20160930.LinuxProfile, api *LinuxProfile) {
@@ -438,6 +456,9 @@ func convertVLabsLinuxProfile(vlabs *vlabs.LinuxProfile, api *LinuxProfile) {
 		convertVLabsKeyVaultSecrets(&s, secret)
 		api.Secrets = append(api.Secrets, *secret)
 	}
+	for _, h := range vlabs.HostAliases {
+		api.HostAliases = append(api.HostAliases, HostAlias{IP: h.IP, Hostnames: append([]string{}, h.Hostnames...)})
+	}
 }
 
 func convertV20160930WindowsProfile(v20160930 *v20160930.WindowsProfile, api *WindowsProfile) {
@@ -463,6 +484,10 @@ func convertV20170701WindowsProfile(v20170701 *v20170701.WindowsProfile, api *Wi
 func convertVLabsWindowsProfile(vlabs *vlabs.WindowsProfile, api *WindowsProfile) {
 	api.AdminUsername = vlabs.AdminUsername
 	api.AdminPassword = vlabs.AdminPassword
+	api.ImagePublisher = vlabs.ImagePublisher
+	api.ImageOffer = vlabs.ImageOffer
+	api.ImageSku = vlabs.ImageSku
+	api.ImageVersion = vlabs.ImageVersion
 	api.Secrets = []KeyVaultSecrets{}
 	for _, s := range vlabs.Secrets {
 		secret := &KeyVaultSecrets{}
@@ -489,11 +514,7 @@ func convertV20160330OrchestratorProfile(v20160330 *v20160330.OrchestratorProfil
 
 func convertV20170131OrchestratorProfile(v20170131 *v20170131.OrchestratorProfile, api *OrchestratorProfile) {
 	api.OrchestratorType = OrchestratorType(v20170131.OrchestratorType)
-	if api.OrchestratorType == Kubernetes {
-		api.OrchestratorVersion = KubernetesLatest
-	} else if api.OrchestratorType == DCOS {
-		api.OrchestratorVersion = DCOS190
-	}
+	api.OrchestratorVersion = DefaultOrchestratorVersion(api.OrchestratorType)
 }
 
 func convertV20170701OrchestratorProfile(v20170701cs *v20170701.OrchestratorProfile, api *OrchestratorProfile) {
@@ -511,7 +532,7 @@ func convertV20170701OrchestratorProfile(v20170701cs *v20170701.OrchestratorProf
 		case v20170701.Kubernetes157:
 			api.OrchestratorVersion = Kubernetes157
 		default:
-			api.OrchestratorVersion = KubernetesLatest
+			api.OrchestratorVersion = DefaultOrchestratorVersion(Kubernetes)
 		}
 	} else if api.OrchestratorType == DCOS {
 		switch v20170701cs.OrchestratorVersion {
@@ -522,7 +543,7 @@ func convertV20170701OrchestratorProfile(v20170701cs *v20170701.OrchestratorProf
 		case v20170701.DCOS190:
 			api.OrchestratorVersion = DCOS190
 		default:
-			api.OrchestratorVersion = DCOSLatest
+			api.OrchestratorVersion = DefaultOrchestratorVersion(DCOS)
 		}
 	}
 }
@@ -547,7 +568,7 @@ func convertVLabsOrchestratorProfile(vlabscs *vlabs.OrchestratorProfile, api *Or
 		case vlabs.Kubernetes153:
 			api.OrchestratorVersion = Kubernetes153
 		default:
-			api.OrchestratorVersion = KubernetesLatest
+			api.OrchestratorVersion = DefaultOrchestratorVersion(Kubernetes)
 		}
 	} else if api.OrchestratorType == DCOS {
 		switch vlabscs.OrchestratorVersion {
@@ -562,7 +583,7 @@ func convertVLabsOrchestratorProfile(vlabscs *vlabs.OrchestratorProfile, api *Or
 		case vlabs.DCOS190:
 			api.OrchestratorVersion = DCOS190
 		default:
-			api.OrchestratorVersion = DCOSLatest
+			api.OrchestratorVersion = DefaultOrchestratorVersion(DCOS)
 		}
 	}
 }
@@ -572,6 +593,64 @@ func convertVLabsKubernetesConfig(vlabs *vlabs.KubernetesConfig, api *Kubernetes
 	api.ClusterSubnet = vlabs.ClusterSubnet
 	api.NetworkPolicy = vlabs.NetworkPolicy
 	api.DockerBridgeSubnet = vlabs.DockerBridgeSubnet
+	api.MasterLBProbeIntervalInSeconds = vlabs.MasterLBProbeIntervalInSeconds
+	api.MasterLBProbeNumberOfProbes = vlabs.MasterLBProbeNumberOfProbes
+	api.ProvisionRetryCount = vlabs.ProvisionRetryCount
+	api.ProvisionTimeoutInSeconds = vlabs.ProvisionTimeoutInSeconds
+	api.EvictionHard = vlabs.EvictionHard
+	api.EvictionSoft = vlabs.EvictionSoft
+	api.EvictionSoftGracePeriod = vlabs.EvictionSoftGracePeriod
+	api.APIServerPort = vlabs.APIServerPort
+	api.AzureCNIChecksum = vlabs.AzureCNIChecksum
+	api.WindowsBinariesChecksum = vlabs.WindowsBinariesChecksum
+	api.LeaderElectLeaseDuration = vlabs.LeaderElectLeaseDuration
+	api.LeaderElectRenewDeadline = vlabs.LeaderElectRenewDeadline
+	api.LeaderElectRetryPeriod = vlabs.LeaderElectRetryPeriod
+	api.RuntimeReservedMilliCPU = vlabs.RuntimeReservedMilliCPU
+	api.ClusterName = vlabs.ClusterName
+	if vlabs.DisableAnonymousAuth != nil {
+		disableAnonymousAuth := *vlabs.DisableAnonymousAuth
+		api.DisableAnonymousAuth = &disableAnonymousAuth
+	}
+	if vlabs.BlockPodIMDSAccess != nil {
+		blockPodIMDSAccess := *vlabs.BlockPodIMDSAccess
+		api.BlockPodIMDSAccess = &blockPodIMDSAccess
+	}
+	if vlabs.AddonsReadOnlyRootFilesystem != nil {
+		addonsReadOnlyRootFilesystem := *vlabs.AddonsReadOnlyRootFilesystem
+		api.AddonsReadOnlyRootFilesystem = &addonsReadOnlyRootFilesystem
+	}
+	if vlabs.TopologyLabels != nil {
+		topologyLabels := *vlabs.TopologyLabels
+		api.TopologyLabels = &topologyLabels
+	}
+	api.CloudProviderRateLimitQPS = vlabs.CloudProviderRateLimitQPS
+	api.CloudProviderRateLimitBucket = vlabs.CloudProviderRateLimitBucket
+	api.AzureCNIVersion = vlabs.AzureCNIVersion
+	api.CalicoVersion = vlabs.CalicoVersion
+	api.KubeProxyImage = vlabs.KubeProxyImage
+	api.PodInfraContainerImage = vlabs.PodInfraContainerImage
+	api.EtcdDeploymentMode = vlabs.EtcdDeploymentMode
+	if vlabs.EtcdHeartbeatIntervalMs != nil {
+		etcdHeartbeatIntervalMs := *vlabs.EtcdHeartbeatIntervalMs
+		api.EtcdHeartbeatIntervalMs = &etcdHeartbeatIntervalMs
+	}
+	if vlabs.EtcdElectionTimeoutMs != nil {
+		etcdElectionTimeoutMs := *vlabs.EtcdElectionTimeoutMs
+		api.EtcdElectionTimeoutMs = &etcdElectionTimeoutMs
+	}
+	if vlabs.KubeProxyConfig != nil {
+		api.KubeProxyConfig = &KubeProxyConfig{
+			ClientConnectionQPS:            vlabs.KubeProxyConfig.ClientConnectionQPS,
+			ClientConnectionBurst:          vlabs.KubeProxyConfig.ClientConnectionBurst,
+			ConntrackMaxPerCore:            vlabs.KubeProxyConfig.ConntrackMaxPerCore,
+			ConntrackMin:                   vlabs.KubeProxyConfig.ConntrackMin,
+			ConntrackTCPEstablishedTimeout: vlabs.KubeProxyConfig.ConntrackTCPEstablishedTimeout,
+		}
+	}
+	if vlabs.DefaultDenyNamespaces != nil {
+		api.DefaultDenyNamespaces = append([]string{}, vlabs.DefaultDenyNamespaces...)
+	}
 }
 
 func convertV20160930MasterProfile(v20160930 *v20160930.MasterProfile, api *MasterProfile) {
@@ -624,6 +703,7 @@ func convertVLabsMasterProfile(vlabs *vlabs.MasterProfile, api *MasterProfile) {
 	api.VMSize = vlabs.VMSize
 	api.OSDiskSizeGB = vlabs.OSDiskSizeGB
 	api.VnetSubnetID = vlabs.VnetSubnetID
+	api.VnetSubnetCIDR = vlabs.VnetSubnetCIDR
 	api.FirstConsecutiveStaticIP = vlabs.FirstConsecutiveStaticIP
 	api.Subnet = vlabs.GetSubnet()
 	api.IPAddressCount = vlabs.IPAddressCount
@@ -706,11 +786,27 @@ func convertVLabsAgentPoolProfile(vlabs *vlabs.AgentPoolProfile, api *AgentPoolP
 	api.Ports = append(api.Ports, vlabs.Ports...)
 	api.AvailabilityProfile = vlabs.AvailabilityProfile
 	api.StorageProfile = vlabs.StorageProfile
+	api.StorageAccountType = vlabs.StorageAccountType
+	api.StorageAccountsPerPool = vlabs.StorageAccountsPerPool
 	api.DiskSizesGB = []int{}
 	api.DiskSizesGB = append(api.DiskSizesGB, vlabs.DiskSizesGB...)
+	for _, m := range vlabs.DataDiskMounts {
+		api.DataDiskMounts = append(api.DataDiskMounts, DataDiskMount{FSType: m.FSType, MountOptions: append([]string{}, m.MountOptions...), MountPoint: m.MountPoint})
+	}
 	api.VnetSubnetID = vlabs.VnetSubnetID
 	api.Subnet = vlabs.GetSubnet()
 	api.IPAddressCount = vlabs.IPAddressCount
+	api.HostnamePrefix = vlabs.HostnamePrefix
+	api.EvictionHard = vlabs.EvictionHard
+	api.EvictionSoft = vlabs.EvictionSoft
+	api.EvictionSoftGracePeriod = vlabs.EvictionSoftGracePeriod
+	api.PreloadImages = []string{}
+	api.PreloadImages = append(api.PreloadImages, vlabs.PreloadImages...)
+	api.RuntimeReservedMilliCPU = vlabs.RuntimeReservedMilliCPU
+	if vlabs.TaintGPUNodes != nil {
+		taintGPUNodes := *vlabs.TaintGPUNodes
+		api.TaintGPUNodes = &taintGPUNodes
+	}
 	api.FQDN = vlabs.FQDN
 	api.CustomNodeLabels = map[string]string{}
 	for k, v := range vlabs.CustomNodeLabels {
@@ -803,6 +899,19 @@ func convertVLabsServicePrincipalProfile(vlabs *vlabs.ServicePrincipalProfile, a
 	api.Secret = vlabs.Secret
 }
 
+func convertVLabsCustomProfile(vlabs *vlabs.CustomProfile, api *CustomProfile) {
+	api.BootstrapRepositoryURL = vlabs.BootstrapRepositoryURL
+}
+
+func convertVLabsPrivateRegistryProfile(vlabs *vlabs.PrivateRegistryProfile, api *PrivateRegistryProfile) {
+	api.Server = vlabs.Server
+	api.Username = vlabs.Username
+	api.Password = vlabs.Password
+	if vlabs.Namespaces != nil {
+		api.Namespaces = append([]string{}, vlabs.Namespaces...)
+	}
+}
+
 func convertV20160930CustomProfile(v20160930 *v20160930.CustomProfile, api *CustomProfile) {
 	api.Orchestrator = v20160930.Orchestrator
 }
@@ -824,6 +933,9 @@ func convertVLabsCertificateProfile(vlabs *vlabs.CertificateProfile, api *Certif
 	api.ClientPrivateKey = vlabs.ClientPrivateKey
 	api.KubeConfigCertificate = vlabs.KubeConfigCertificate
 	api.KubeConfigPrivateKey = vlabs.KubeConfigPrivateKey
+	api.ServiceAccountPrivateKey = vlabs.ServiceAccountPrivateKey
+	api.ServiceAccountVerificationKeys = []string{}
+	api.ServiceAccountVerificationKeys = append(api.ServiceAccountVerificationKeys, vlabs.ServiceAccountVerificationKeys...)
 }
 
 func addDCOSPublicAgentPool(api *Properties) {
@@ -1,7 +1,10 @@
 package api
 
 import (
+	"reflect"
 	"testing"
+
+	"github.com/Azure/acs-engine/pkg/api/v20170701"
 )
 
 func TestAddDCOSPublicAgentPool(t *testing.T) {
@@ -71,3 +74,88 @@ func getProperties(profiles []*AgentPoolProfile, master *MasterProfile) *Propert
 		MasterProfile:     master,
 	}
 }
+
+func TestConvertV20170701ContainerServiceRoundTrip(t *testing.T) {
+	v20170701CS := &v20170701.ContainerService{
+		ID:       "id",
+		Location: "westus2",
+		Name:     "name",
+		Plan: &v20170701.ResourcePurchasePlan{
+			Name:          "plan",
+			Product:       "product",
+			PromotionCode: "code",
+			Publisher:     "publisher",
+		},
+		Tags: map[string]string{"key": "value"},
+		Type: "type",
+		Properties: &v20170701.Properties{
+			ProvisioningState: v20170701.Succeeded,
+			OrchestratorProfile: &v20170701.OrchestratorProfile{
+				OrchestratorType:    v20170701.Kubernetes,
+				OrchestratorVersion: v20170701.Kubernetes166,
+			},
+			MasterProfile: &v20170701.MasterProfile{
+				Count:                    3,
+				DNSPrefix:                "master",
+				VMSize:                   "Standard_D2_v2",
+				OSDiskSizeGB:             128,
+				VnetSubnetID:             "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/virtualNetworks/vnet/subnets/master",
+				FirstConsecutiveStaticIP: "10.240.255.5",
+				StorageProfile:           v20170701.ManagedDisks,
+				FQDN:                     "master.westus2.cloudapp.azure.com",
+			},
+			AgentPoolProfiles: []*v20170701.AgentPoolProfile{
+				{
+					Name:           "agentpool1",
+					Count:          2,
+					VMSize:         "Standard_D2_v2",
+					OSDiskSizeGB:   64,
+					DNSPrefix:      "agents",
+					FQDN:           "agents.westus2.cloudapp.azure.com",
+					Ports:          []int{80, 443},
+					StorageProfile: v20170701.StorageAccount,
+					VnetSubnetID:   "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/virtualNetworks/vnet/subnets/agents",
+					OSType:         v20170701.Linux,
+				},
+			},
+			LinuxProfile: &v20170701.LinuxProfile{
+				AdminUsername: "azureuser",
+			},
+			WindowsProfile: &v20170701.WindowsProfile{
+				AdminUsername: "azureuser",
+				AdminPassword: "password",
+			},
+			ServicePrincipalProfile: &v20170701.ServicePrincipalProfile{
+				ClientID: "clientID",
+				Secret:   "secret",
+			},
+			CustomProfile: &v20170701.CustomProfile{
+				Orchestrator: "orchestrator",
+			},
+		},
+	}
+	v20170701CS.Properties.LinuxProfile.SSH.PublicKeys = []struct {
+		KeyData string `json:"keyData"`
+	}{{KeyData: "ssh-rsa key"}}
+	v20170701CS.Properties.MasterProfile.SetSubnet("10.240.255.0/24")
+	v20170701CS.Properties.AgentPoolProfiles[0].SetSubnet("10.240.0.0/16")
+
+	cs := ConvertV20170701ContainerService(v20170701CS)
+	if cs.Properties.OrchestratorProfile.OrchestratorVersion != Kubernetes166 {
+		t.Errorf("expected orchestrator version %s, got %s", Kubernetes166, cs.Properties.OrchestratorProfile.OrchestratorVersion)
+	}
+	if cs.Properties.MasterProfile.Subnet != "10.240.255.0/24" {
+		t.Errorf("expected master subnet to be converted, got %q", cs.Properties.MasterProfile.Subnet)
+	}
+	if cs.Properties.AgentPoolProfiles[0].Subnet != "10.240.0.0/16" {
+		t.Errorf("expected agent pool subnet to be converted, got %q", cs.Properties.AgentPoolProfiles[0].Subnet)
+	}
+	if cs.Properties.AgentPoolProfiles[0].AvailabilityProfile != AvailabilitySet {
+		t.Errorf("expected agent pool availability profile %s, got %s", AvailabilitySet, cs.Properties.AgentPoolProfiles[0].AvailabilityProfile)
+	}
+
+	roundTripped := ConvertContainerServiceToV20170701(cs)
+	if !reflect.DeepEqual(v20170701CS, roundTripped) {
+		t.Errorf("expected the round-tripped container service to equal the original:\n%+v\ngot:\n%+v", v20170701CS, roundTripped)
+	}
+}
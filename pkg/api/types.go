@@ -1,8 +1,19 @@
 package api
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
 	neturl "net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
 
+	"github.com/Azure/acs-engine/pkg/api/common"
 	"github.com/Azure/acs-engine/pkg/api/v20160330"
 	"github.com/Azure/acs-engine/pkg/api/v20160930"
 	"github.com/Azure/acs-engine/pkg/api/v20170131"
@@ -50,6 +61,9 @@ type Properties struct {
 	JumpboxProfile          *JumpboxProfile          `json:"jumpboxProfile,omitempty"`
 	ServicePrincipalProfile *ServicePrincipalProfile `json:"servicePrincipalProfile,omitempty"`
 	CertificateProfile      *CertificateProfile      `json:"certificateProfile,omitempty"`
+	SecurityRules           []SecurityRule           `json:"securityRules,omitempty"`
+	PrivateRegistryProfile  *PrivateRegistryProfile  `json:"privateRegistryProfile,omitempty"`
+	UniqueStorageNames      *bool                    `json:"uniqueStorageNames,omitempty"`
 	CustomProfile           *CustomProfile           `json:"customProfile,omitempty"`
 }
 
@@ -59,6 +73,32 @@ type ServicePrincipalProfile struct {
 	Secret   string `json:"servicePrincipalClientSecret,omitempty"`
 }
 
+// PrivateRegistryProfile contains the credentials of a private docker registry.
+// They are stored in an image pull secret that is added to the default service
+// account of each namespace listed.
+type PrivateRegistryProfile struct {
+	Server     string   `json:"server"`
+	Username   string   `json:"username"`
+	Password   string   `json:"password"`
+	Namespaces []string `json:"namespaces,omitempty"`
+}
+
+// SecurityRule represents a network security group rule that is merged into
+// the network security groups generated for the cluster, alongside the rules
+// required by the orchestrator
+type SecurityRule struct {
+	Name                     string `json:"name"`
+	Description              string `json:"description,omitempty"`
+	Priority                 int    `json:"priority"`
+	Direction                string `json:"direction"`
+	Access                   string `json:"access"`
+	Protocol                 string `json:"protocol"`
+	SourceAddressPrefix      string `json:"sourceAddressPrefix,omitempty"`
+	SourcePortRange          string `json:"sourcePortRange,omitempty"`
+	DestinationAddressPrefix string `json:"destinationAddressPrefix,omitempty"`
+	DestinationPortRange     string `json:"destinationPortRange,omitempty"`
+}
+
 // CertificateProfile represents the definition of the master cluster
 type CertificateProfile struct {
 	// CaCertificate is the certificate authority certificate.
@@ -77,6 +117,11 @@ type CertificateProfile struct {
 	KubeConfigCertificate string `json:"kubeConfigCertificate,omitempty"`
 	// KubeConfigPrivateKey is the client private key used for kubectl cli and signed by the CA
 	KubeConfigPrivateKey string `json:"kubeConfigPrivateKey,omitempty"`
+	// ServiceAccountPrivateKey is the key service account tokens are signed with, the apiserver private key unless specified
+	ServiceAccountPrivateKey string `json:"serviceAccountPrivateKey,omitempty"`
+	// ServiceAccountVerificationKeys are RSA public keys service account tokens are verified with besides
+	// the signing key, such as the next or the previous signing key while it is rotated
+	ServiceAccountVerificationKeys []string `json:"serviceAccountVerificationKeys,omitempty"`
 }
 
 // LinuxProfile represents the linux parameters passed to the cluster
@@ -87,14 +132,25 @@ type LinuxProfile struct {
 			KeyData string `json:"keyData"`
 		} `json:"publicKeys"`
 	} `json:"ssh"`
-	Secrets []KeyVaultSecrets `json:"secrets,omitempty"`
+	Secrets     []KeyVaultSecrets `json:"secrets,omitempty"`
+	HostAliases []HostAlias       `json:"hostAliases,omitempty"`
+}
+
+// HostAlias is an entry added to the hosts file of the nodes, resolving the host names to the IP address
+type HostAlias struct {
+	IP        string   `json:"ip"`
+	Hostnames []string `json:"hostnames"`
 }
 
 // WindowsProfile represents the windows parameters passed to the cluster
 type WindowsProfile struct {
-	AdminUsername string            `json:"adminUsername"`
-	AdminPassword string            `json:"adminPassword"`
-	Secrets       []KeyVaultSecrets `json:"secrets,omitempty"`
+	AdminUsername  string            `json:"adminUsername"`
+	AdminPassword  string            `json:"adminPassword"`
+	ImagePublisher string            `json:"imagePublisher,omitempty"`
+	ImageOffer     string            `json:"imageOffer,omitempty"`
+	ImageSku       string            `json:"imageSku,omitempty"`
+	ImageVersion   string            `json:"imageVersion,omitempty"`
+	Secrets        []KeyVaultSecrets `json:"secrets,omitempty"`
 }
 
 // ProvisioningState represents the current state of container service resource.
@@ -116,6 +172,20 @@ const (
 	Migrating ProvisioningState = "Migrating"
 )
 
+// IsTerminal returns true if the resource reached a state it only leaves on the next operation
+func (s ProvisioningState) IsTerminal() bool {
+	return s == Succeeded || s == Failed
+}
+
+// IsTransient returns true if an operation on the resource is in progress
+func (s ProvisioningState) IsTransient() bool {
+	switch s {
+	case Creating, Updating, Deleting, Migrating:
+		return true
+	}
+	return false
+}
+
 // OrchestratorProfile contains Orchestrator properties
 type OrchestratorProfile struct {
 	OrchestratorType    OrchestratorType    `json:"orchestratorType"`
@@ -126,10 +196,49 @@ type OrchestratorProfile struct {
 // KubernetesConfig contains the Kubernetes config structure, containing
 // Kubernetes specific configuration
 type KubernetesConfig struct {
-	KubernetesImageBase string `json:"kubernetesImageBase,omitempty"`
-	ClusterSubnet       string `json:"clusterSubnet,omitempty"`
-	NetworkPolicy       string `json:"networkPolicy,omitempty"`
-	DockerBridgeSubnet  string `json:"dockerBridgeSubnet,omitempty"`
+	KubernetesImageBase            string           `json:"kubernetesImageBase,omitempty"`
+	ClusterSubnet                  string           `json:"clusterSubnet,omitempty"`
+	NetworkPolicy                  string           `json:"networkPolicy,omitempty"`
+	DockerBridgeSubnet             string           `json:"dockerBridgeSubnet,omitempty"`
+	MasterLBProbeIntervalInSeconds int              `json:"masterLBProbeIntervalInSeconds,omitempty"`
+	MasterLBProbeNumberOfProbes    int              `json:"masterLBProbeNumberOfProbes,omitempty"`
+	ProvisionRetryCount            int              `json:"provisionRetryCount,omitempty"`
+	ProvisionTimeoutInSeconds      int              `json:"provisionTimeoutInSeconds,omitempty"`
+	EvictionHard                   string           `json:"evictionHard,omitempty"`
+	EvictionSoft                   string           `json:"evictionSoft,omitempty"`
+	EvictionSoftGracePeriod        string           `json:"evictionSoftGracePeriod,omitempty"`
+	DisableAnonymousAuth           *bool            `json:"disableAnonymousAuth,omitempty"`
+	APIServerPort                  int              `json:"apiServerPort,omitempty"`
+	AzureCNIChecksum               string           `json:"azureCNIChecksum,omitempty"`
+	WindowsBinariesChecksum        string           `json:"windowsBinariesChecksum,omitempty"`
+	LeaderElectLeaseDuration       string           `json:"leaderElectLeaseDuration,omitempty"`
+	LeaderElectRenewDeadline       string           `json:"leaderElectRenewDeadline,omitempty"`
+	LeaderElectRetryPeriod         string           `json:"leaderElectRetryPeriod,omitempty"`
+	RuntimeReservedMilliCPU        int              `json:"runtimeReservedMilliCPU,omitempty"`
+	ClusterName                    string           `json:"clusterName,omitempty"`
+	BlockPodIMDSAccess             *bool            `json:"blockPodIMDSAccess,omitempty"`
+	DefaultDenyNamespaces          []string         `json:"defaultDenyNamespaces,omitempty"`
+	CloudProviderRateLimitQPS      float64          `json:"cloudProviderRateLimitQPS,omitempty"`
+	CloudProviderRateLimitBucket   int              `json:"cloudProviderRateLimitBucket,omitempty"`
+	AzureCNIVersion                string           `json:"azureCNIVersion,omitempty"`
+	CalicoVersion                  string           `json:"calicoVersion,omitempty"`
+	KubeProxyImage                 string           `json:"kubeProxyImage,omitempty"`
+	KubeProxyConfig                *KubeProxyConfig `json:"kubeProxyConfig,omitempty"`
+	EtcdHeartbeatIntervalMs        *int             `json:"etcdHeartbeatIntervalMs,omitempty"`
+	EtcdElectionTimeoutMs          *int             `json:"etcdElectionTimeoutMs,omitempty"`
+	AddonsReadOnlyRootFilesystem   *bool            `json:"addonsReadOnlyRootFilesystem,omitempty"`
+	PodInfraContainerImage         string           `json:"podInfraContainerImage,omitempty"`
+	EtcdDeploymentMode             string           `json:"etcdDeploymentMode,omitempty"`
+	TopologyLabels                 *bool            `json:"topologyLabels,omitempty"`
+}
+
+// KubeProxyConfig overrides settings of kube-proxy, which runs as a daemonset on the Linux nodes
+type KubeProxyConfig struct {
+	ClientConnectionQPS            float64 `json:"clientConnectionQPS,omitempty"`
+	ClientConnectionBurst          int     `json:"clientConnectionBurst,omitempty"`
+	ConntrackMaxPerCore            int     `json:"conntrackMaxPerCore,omitempty"`
+	ConntrackMin                   int     `json:"conntrackMin,omitempty"`
+	ConntrackTCPEstablishedTimeout string  `json:"conntrackTCPEstablishedTimeout,omitempty"`
 }
 
 // MasterProfile represents the definition of the master cluster
@@ -139,6 +248,7 @@ type MasterProfile struct {
 	VMSize                   string `json:"vmSize"`
 	OSDiskSizeGB             int    `json:"osDiskSizeGB,omitempty"`
 	VnetSubnetID             string `json:"vnetSubnetID,omitempty"`
+	VnetSubnetCIDR           string `json:"vnetSubnetCIDR,omitempty"`
 	FirstConsecutiveStaticIP string `json:"firstConsecutiveStaticIP,omitempty"`
 	Subnet                   string `json:"subnet"`
 	IPAddressCount           int    `json:"ipAddressCount,omitempty"`
@@ -152,24 +262,42 @@ type MasterProfile struct {
 
 // AgentPoolProfile represents an agent pool definition
 type AgentPoolProfile struct {
-	Name                string `json:"name"`
-	Count               int    `json:"count"`
-	VMSize              string `json:"vmSize"`
-	OSDiskSizeGB        int    `json:"osDiskSizeGB,omitempty"`
-	DNSPrefix           string `json:"dnsPrefix,omitempty"`
-	OSType              OSType `json:"osType,omitempty"`
-	Ports               []int  `json:"ports,omitempty"`
-	AvailabilityProfile string `json:"availabilityProfile"`
-	StorageProfile      string `json:"storageProfile,omitempty"`
-	DiskSizesGB         []int  `json:"diskSizesGB,omitempty"`
-	VnetSubnetID        string `json:"vnetSubnetID,omitempty"`
-	Subnet              string `json:"subnet"`
-	IPAddressCount      int    `json:"ipAddressCount,omitempty"`
+	Name                    string          `json:"name"`
+	Count                   int             `json:"count"`
+	VMSize                  string          `json:"vmSize"`
+	OSDiskSizeGB            int             `json:"osDiskSizeGB,omitempty"`
+	DNSPrefix               string          `json:"dnsPrefix,omitempty"`
+	OSType                  OSType          `json:"osType,omitempty"`
+	Ports                   []int           `json:"ports,omitempty"`
+	AvailabilityProfile     string          `json:"availabilityProfile"`
+	StorageProfile          string          `json:"storageProfile,omitempty"`
+	StorageAccountType      string          `json:"storageAccountType,omitempty"`
+	StorageAccountsPerPool  int             `json:"storageAccountsPerPool,omitempty"`
+	DiskSizesGB             []int           `json:"diskSizesGB,omitempty"`
+	DataDiskMounts          []DataDiskMount `json:"dataDiskMounts,omitempty"`
+	VnetSubnetID            string          `json:"vnetSubnetID,omitempty"`
+	Subnet                  string          `json:"subnet"`
+	IPAddressCount          int             `json:"ipAddressCount,omitempty"`
+	HostnamePrefix          string          `json:"hostnamePrefix,omitempty"`
+	EvictionHard            string          `json:"evictionHard,omitempty"`
+	EvictionSoft            string          `json:"evictionSoft,omitempty"`
+	EvictionSoftGracePeriod string          `json:"evictionSoftGracePeriod,omitempty"`
+	PreloadImages           []string        `json:"preloadImages,omitempty"`
+	RuntimeReservedMilliCPU int             `json:"runtimeReservedMilliCPU,omitempty"`
+	TaintGPUNodes           *bool           `json:"taintGPUNodes,omitempty"`
 
 	FQDN             string            `json:"fqdn,omitempty"`
 	CustomNodeLabels map[string]string `json:"customNodeLabels,omitempty"`
 }
 
+// DataDiskMount describes how a data disk of the agents, at the same index in DiskSizesGB, is formatted
+// and mounted. Data disks without a DataDiskMount are attached unformatted.
+type DataDiskMount struct {
+	FSType       string   `json:"fsType,omitempty"`
+	MountOptions []string `json:"mountOptions,omitempty"`
+	MountPoint   string   `json:"mountPoint,omitempty"`
+}
+
 // DiagnosticsProfile setting to enable/disable capturing
 // diagnostics for VMs hosting container cluster.
 type DiagnosticsProfile struct {
@@ -237,7 +365,8 @@ type OSType string
 // CustomProfile specifies custom properties that are used for
 // cluster instantiation.  Should not be used by most users.
 type CustomProfile struct {
-	Orchestrator string `json:"orchestrator,omitempty"`
+	Orchestrator           string `json:"orchestrator,omitempty"`
+	BootstrapRepositoryURL string `json:"bootstrapRepositoryURL,omitempty"`
 }
 
 // VlabsARMContainerService is the type we read and write from file
@@ -293,6 +422,114 @@ type UpgradeContainerService struct {
 	OrchestratorProfile *OrchestratorProfile `json:"orchestratorProfile,omitempty"`
 }
 
+// GetAPIModelHash returns the hex encoded SHA256 hash of the model with its secrets, keys and
+// certificates removed. The model is serialized to JSON, which sorts map keys, so equal models hash equally.
+func (cs *ContainerService) GetAPIModelHash() string {
+	// the copy keeps the redaction from modifying the model
+	redacted := cs.DeepCopy()
+	if redacted == nil {
+		redacted = &ContainerService{}
+	}
+	if p := redacted.Properties; p != nil {
+		p.CertificateProfile = nil
+		if p.ServicePrincipalProfile != nil {
+			p.ServicePrincipalProfile.Secret = ""
+		}
+		if p.WindowsProfile != nil {
+			p.WindowsProfile.AdminPassword = ""
+		}
+		if p.PrivateRegistryProfile != nil {
+			p.PrivateRegistryProfile.Password = ""
+		}
+	}
+	b, err := json.Marshal(redacted)
+	if err != nil {
+		return ""
+	}
+	hash := sha256.Sum256(b)
+	return hex.EncodeToString(hash[:])
+}
+
+// ValidateTags checks that the tags of the container service are within the limits Azure puts on the tags of a
+// resource: at most MaxTagCount tags, with names of at most MaxTagKeyLength and values of at most
+// MaxTagValueLength characters
+func (cs *ContainerService) ValidateTags() error {
+	if len(cs.Tags) > MaxTagCount {
+		return &common.ValidationError{Field: "tags", Message: fmt.Sprintf("%d tags are specified, at most %d tags are allowed", len(cs.Tags), MaxTagCount)}
+	}
+	keys := []string{}
+	for k := range cs.Tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if utf8.RuneCountInString(k) > MaxTagKeyLength {
+			return &common.ValidationError{Field: "tags", Message: fmt.Sprintf("the name of a tag is longer than %d characters", MaxTagKeyLength)}
+		}
+		if utf8.RuneCountInString(cs.Tags[k]) > MaxTagValueLength {
+			return &common.ValidationError{Field: fmt.Sprintf("tags.%s", k), Message: fmt.Sprintf("the value is longer than %d characters", MaxTagValueLength)}
+		}
+	}
+	return nil
+}
+
+// MergeTags returns the tags of the container service combined with the extra tags, which take precedence
+// over the tags of the same name. The tags of the container service are left unmodified.
+func (cs *ContainerService) MergeTags(extra map[string]string) map[string]string {
+	tags := map[string]string{}
+	for k, v := range cs.Tags {
+		tags[k] = v
+	}
+	for k, v := range extra {
+		tags[k] = v
+	}
+	return tags
+}
+
+// DeepCopy returns a copy of the container service that shares no pointers, slices or maps with it,
+// so the copy can be modified without modifying the container service
+func (cs *ContainerService) DeepCopy() *ContainerService {
+	if cs == nil {
+		return nil
+	}
+	c := &ContainerService{}
+	deepCopyJSON(cs, c)
+	return c
+}
+
+// DeepCopy returns a copy of the properties that shares no pointers, slices or maps with them,
+// so the copy can be modified without modifying the properties
+func (p *Properties) DeepCopy() *Properties {
+	if p == nil {
+		return nil
+	}
+	c := &Properties{}
+	deepCopyJSON(p, c)
+	return c
+}
+
+// deepCopyJSON copies src into dst through their JSON serialization. Every field of the API model,
+// including the subnets, is serialized, and serializing it cannot fail.
+func deepCopyJSON(src interface{}, dst interface{}) {
+	b, err := json.Marshal(src)
+	if err != nil {
+		panic(err)
+	}
+	if err = json.Unmarshal(b, dst); err != nil {
+		panic(err)
+	}
+}
+
+// HasServiceAccountPrivateKey returns true if service account tokens are signed with a key other than the apiserver private key
+func (c *CertificateProfile) HasServiceAccountPrivateKey() bool {
+	return len(c.ServiceAccountPrivateKey) > 0
+}
+
+// HasServiceAccountVerificationKeys returns true if service account tokens are also verified with keys other than the signing key
+func (c *CertificateProfile) HasServiceAccountVerificationKeys() bool {
+	return len(c.ServiceAccountVerificationKeys) > 0
+}
+
 // HasWindows returns true if the cluster contains windows
 func (p *Properties) HasWindows() bool {
 	for _, agentPoolProfile := range p.AgentPoolProfiles {
@@ -303,37 +540,197 @@ func (p *Properties) HasWindows() bool {
 	return false
 }
 
-// HasManagedDisks returns true if the cluster contains Managed Disks
+// HasLinux returns true if the cluster contains linux agents, agent pools without an OS type run linux
+func (p *Properties) HasLinux() bool {
+	for _, agentPoolProfile := range p.AgentPoolProfiles {
+		if agentPoolProfile.OSType == Linux || agentPoolProfile.OSType == "" {
+			return true
+		}
+	}
+	return false
+}
+
+// IsHybrid returns true if the cluster contains both linux and windows agents, which bootstrap differently
+func (p *Properties) IsHybrid() bool {
+	return p.HasLinux() && p.HasWindows()
+}
+
+// HasPrivateRegistry returns true if an image pull secret for a private registry
+// is created while bootstrapping the cluster
+func (p *Properties) HasPrivateRegistry() bool {
+	return p.PrivateRegistryProfile != nil && p.OrchestratorProfile.OrchestratorType == Kubernetes
+}
+
+// HasCustomBootstrap returns true if the DCOS bootstrap is downloaded from a custom repository
+func (c *CustomProfile) HasCustomBootstrap() bool {
+	return c != nil && c.BootstrapRepositoryURL != ""
+}
+
+// GetClusterName returns the value of the cluster-name label applied to the Kubernetes nodes,
+// the configured cluster name or the master DNS prefix when none is set
+func (p *Properties) GetClusterName() string {
+	if p.OrchestratorProfile != nil && p.OrchestratorProfile.KubernetesConfig != nil && p.OrchestratorProfile.KubernetesConfig.ClusterName != "" {
+		return p.OrchestratorProfile.KubernetesConfig.ClusterName
+	}
+	if p.MasterProfile != nil {
+		return p.MasterProfile.DNSPrefix
+	}
+	return ""
+}
+
+// TotalNodes returns the number of masters and agents of the cluster
+func (p *Properties) TotalNodes() int {
+	nodes := p.TotalAgents()
+	if p.MasterProfile != nil {
+		nodes += p.MasterProfile.Count
+	}
+	return nodes
+}
+
+// TotalAgents returns the number of agents across all agent pools
+func (p *Properties) TotalAgents() int {
+	agents := 0
+	for _, profile := range p.AgentPoolProfiles {
+		agents += profile.Count
+	}
+	return agents
+}
+
+// DistinctVMSizes returns the sorted VM sizes of the masters and agent pools, each listed once
+func (p *Properties) DistinctVMSizes() []string {
+	seen := map[string]bool{}
+	vmSizes := []string{}
+	add := func(vmSize string) {
+		if vmSize != "" && !seen[vmSize] {
+			seen[vmSize] = true
+			vmSizes = append(vmSizes, vmSize)
+		}
+	}
+	if p.MasterProfile != nil {
+		add(p.MasterProfile.VMSize)
+	}
+	for _, profile := range p.AgentPoolProfiles {
+		add(profile.VMSize)
+	}
+	sort.Strings(vmSizes)
+	return vmSizes
+}
+
+// GetCloudProviderRateLimit returns the rate, in calls per second, and the burst size of the calls the Azure
+// cloud provider makes to the Azure APIs. Unless configured, the limits grow with the number of nodes of the
+// cluster: one call per second for every NodesPerCloudProviderRateLimitQPS nodes and a burst of one call per
+// node, but no less than the defaults. Both are zero for Kubernetes versions without rate limiting.
+func (p *Properties) GetCloudProviderRateLimit() (float64, int) {
+	if !common.IsCloudProviderRateLimitSupported(string(p.OrchestratorProfile.OrchestratorVersion)) {
+		return 0, 0
+	}
+	nodes := p.TotalNodes()
+	qps := math.Max(DefaultCloudProviderRateLimitQPS, float64(nodes)/NodesPerCloudProviderRateLimitQPS)
+	bucket := DefaultCloudProviderRateLimitBucket
+	if nodes > bucket {
+		bucket = nodes
+	}
+	if k := p.OrchestratorProfile.KubernetesConfig; k != nil {
+		if k.CloudProviderRateLimitQPS > 0 {
+			qps = k.CloudProviderRateLimitQPS
+		}
+		if k.CloudProviderRateLimitBucket > 0 {
+			bucket = k.CloudProviderRateLimitBucket
+		}
+	}
+	return qps, bucket
+}
+
+// HasUniqueStorageNames returns true if the storage account names are derived from the subscription and
+// resource group the cluster is deployed to, as well as from its master DNS prefix and location
+func (p *Properties) HasUniqueStorageNames() bool {
+	return p.UniqueStorageNames != nil && *p.UniqueStorageNames
+}
+
+// HasManagedDisks returns true if the master or any agent pool of the cluster uses Managed Disks
 func (p *Properties) HasManagedDisks() bool {
-	if p.MasterProfile.StorageProfile == ManagedDisks {
+	if p.MasterProfile != nil && p.MasterProfile.IsManagedDisks() {
 		return true
 	}
 	for _, agentPoolProfile := range p.AgentPoolProfiles {
-		if agentPoolProfile.StorageProfile == ManagedDisks {
+		if agentPoolProfile.IsManagedDisks() {
 			return true
 		}
 	}
 	return false
 }
 
-// HasStorageAccountDisks returns true if the cluster contains Storage Account Disks
+// HasStorageAccountDisks returns true if the master or any agent pool of the cluster uses Storage Account Disks
 func (p *Properties) HasStorageAccountDisks() bool {
-	if p.MasterProfile.StorageProfile == StorageAccount {
+	if p.MasterProfile != nil && p.MasterProfile.IsStorageAccount() {
 		return true
 	}
 	for _, agentPoolProfile := range p.AgentPoolProfiles {
-		if agentPoolProfile.StorageProfile == StorageAccount {
+		if agentPoolProfile.IsStorageAccount() {
 			return true
 		}
 	}
 	return false
 }
 
+// SetDefaults makes the implicit defaults of the agent pools explicit: pools without an OSType run Linux
+// and pools without a StorageProfile use storage accounts. Profiles already set are left untouched.
+func (p *Properties) SetDefaults() {
+	for _, agentPoolProfile := range p.AgentPoolProfiles {
+		if agentPoolProfile.OSType == "" {
+			agentPoolProfile.OSType = Linux
+		}
+		if agentPoolProfile.StorageProfile == "" {
+			agentPoolProfile.StorageProfile = StorageAccount
+		}
+	}
+}
+
+// keyVaultIDRegex captures the subscription, resource group and name of the keyvault ID of a well-formed
+// keyvault secret reference
+var keyVaultIDRegex = regexp.MustCompile(`^/subscriptions/([^/\s]+)/resourceGroups/([^/\s]+)/providers/Microsoft.KeyVault/vaults/([^/\s]+)$`)
+
+// IsKeyVaultSecret returns true if the secret references a secret in a keyvault,
+// "/subscriptions/<SUB_ID>/resourceGroups/<RG_NAME>/providers/Microsoft.KeyVault/vaults/<KV_NAME>/secrets/<NAME>[/<VERSION>]",
+// rather than being the plain text secret
+func (s *ServicePrincipalProfile) IsKeyVaultSecret() bool {
+	return common.KeyVaultSecretRefRegex.MatchString(s.Secret)
+}
+
+// ParseKeyVaultSecretRef returns the subscription, resource group, keyvault, name and version of the keyvault
+// secret the secret references. The version is empty when the reference has none, meaning the latest version.
+func (s *ServicePrincipalProfile) ParseKeyVaultSecretRef() (subID, rg, vault, name, version string, err error) {
+	parts := common.KeyVaultSecretRefRegex.FindStringSubmatch(s.Secret)
+	if parts == nil {
+		return "", "", "", "", "", fmt.Errorf("servicePrincipalClientSecret does not reference a keyvault secret")
+	}
+	vaultParts := keyVaultIDRegex.FindStringSubmatch(parts[1])
+	if vaultParts == nil || strings.Contains(parts[3], "/") {
+		return "", "", "", "", "", fmt.Errorf("servicePrincipalClientSecret %s is not a valid keyvault secret reference", s.Secret)
+	}
+	return vaultParts[1], vaultParts[2], vaultParts[3], parts[2], parts[3], nil
+}
+
 // IsCustomVNET returns true if the customer brought their own VNET
 func (m *MasterProfile) IsCustomVNET() bool {
 	return len(m.VnetSubnetID) > 0
 }
 
+// GetExpectedFQDN returns the FQDN Azure assigns to the master endpoint in the location. Unlike FQDN,
+// which is only returned on GET, it is known before the deployment. Returns "" without a DNS prefix.
+func (m *MasterProfile) GetExpectedFQDN(location string) string {
+	return getExpectedFQDN(m.DNSPrefix, location)
+}
+
+// getExpectedFQDN returns the FQDN Azure assigns to a public IP address with the DNS prefix in the location
+func getExpectedFQDN(dnsPrefix, location string) string {
+	if dnsPrefix == "" {
+		return ""
+	}
+	location = strings.Replace(strings.ToLower(location), " ", "", -1)
+	return common.FormatAzureProdFQDN(strings.ToLower(dnsPrefix), location)
+}
+
 // IsManagedDisks returns true if the master specified managed disks
 func (m *MasterProfile) IsManagedDisks() bool {
 	return m.StorageProfile == ManagedDisks
@@ -379,21 +776,96 @@ func (a *AgentPoolProfile) HasDisks() bool {
 	return len(a.DiskSizesGB) > 0
 }
 
+// IsDCOSPublicPool returns true if the agent pool exposes ports publicly, which
+// makes it a public agent pool behind a load balancer in a DCOS cluster
+func (a *AgentPoolProfile) IsDCOSPublicPool() bool {
+	return len(a.Ports) > 0
+}
+
+// GetDataDiskMount returns how the data disk at the lun is formatted and mounted, with the defaults applied,
+// and false if the disk is attached unformatted
+func (a *AgentPoolProfile) GetDataDiskMount(lun int) (DataDiskMount, bool) {
+	if lun < 0 || lun >= len(a.DiskSizesGB) || lun >= len(a.DataDiskMounts) {
+		return DataDiskMount{}, false
+	}
+	mount := DataDiskMount{
+		FSType:       a.DataDiskMounts[lun].FSType,
+		MountOptions: append([]string{}, a.DataDiskMounts[lun].MountOptions...),
+		MountPoint:   a.DataDiskMounts[lun].MountPoint,
+	}
+	if mount.FSType == "" {
+		mount.FSType = DefaultDataDiskFSType
+	}
+	if len(mount.MountOptions) == 0 {
+		mount.MountOptions = []string{"defaults"}
+	}
+	if mount.MountPoint == "" {
+		mount.MountPoint = fmt.Sprintf(DefaultDataDiskMountPointFormat, lun)
+	}
+	return mount, true
+}
+
+// HasPreloadImages returns true if the customer specified container images to pull while provisioning the agents
+func (a *AgentPoolProfile) HasPreloadImages() bool {
+	return len(a.PreloadImages) > 0
+}
+
+// GetExpectedFQDN returns the FQDN Azure assigns to the endpoint of a public agent pool in the location. Unlike
+// FQDN, which is only returned on GET, it is known before the deployment. Returns "" without a DNS prefix.
+func (a *AgentPoolProfile) GetExpectedFQDN(location string) string {
+	return getExpectedFQDN(a.DNSPrefix, location)
+}
+
+// IsNSeriesVMSize returns true if the agent pool VM size is of the N-series, whose VMs have GPUs
+func (a *AgentPoolProfile) IsNSeriesVMSize() bool {
+	return common.NSeriesVMSizeRegex.MatchString(a.VMSize)
+}
+
+// IsGPUTainted returns true if the agent pool nodes register with a taint that keeps
+// pods which do not tolerate it off the GPU nodes
+func (a *AgentPoolProfile) IsGPUTainted() bool {
+	return a.TaintGPUNodes != nil && *a.TaintGPUNodes
+}
+
 // HasSecrets returns true if the customer specified secrets to install
 func (w *WindowsProfile) HasSecrets() bool {
 	return len(w.Secrets) > 0
 }
 
+// HasCustomImage returns true if the customer specified an image for the windows agents rather than the default
+// Windows Server 2016 image
+func (w *WindowsProfile) HasCustomImage() bool {
+	return w.ImagePublisher != "" || w.ImageOffer != "" || w.ImageSku != "" || w.ImageVersion != ""
+}
+
+// GetImageVersion returns the version of the custom image of the windows agents, the latest unless specified
+func (w *WindowsProfile) GetImageVersion() string {
+	if w.ImageVersion == "" {
+		return DefaultWindowsImageVersion
+	}
+	return w.ImageVersion
+}
+
 // HasSecrets returns true if the customer specified secrets to install
 func (l *LinuxProfile) HasSecrets() bool {
 	return len(l.Secrets) > 0
 }
 
+// HasHostAliases returns true if entries are added to the hosts file of the nodes
+func (l *LinuxProfile) HasHostAliases() bool {
+	return len(l.HostAliases) > 0
+}
+
 // IsSwarmMode returns true if this template is for Swarm Mode orchestrator
 func (o *OrchestratorProfile) IsSwarmMode() bool {
 	return o.OrchestratorType == SwarmMode
 }
 
+// IsSwarm returns true if this template is for Swarm orchestrator
+func (o *OrchestratorProfile) IsSwarm() bool {
+	return o.OrchestratorType == Swarm
+}
+
 // IsKubernetes returns true if this template is for Kubernetes orchestrator
 func (o *OrchestratorProfile) IsKubernetes() bool {
 	return o.OrchestratorType == Kubernetes
@@ -404,6 +876,160 @@ func (o *OrchestratorProfile) IsDCOS() bool {
 	return o.OrchestratorType == DCOS
 }
 
+// DefaultOrchestratorVersion returns the version deployed for the orchestrator type when the model
+// does not specify one. Swarm and SwarmMode, which DockerCE converts to, are not versioned, so an
+// empty version is returned for them as it is for unknown types.
+func DefaultOrchestratorVersion(t OrchestratorType) OrchestratorVersion {
+	switch t {
+	case Kubernetes:
+		return KubernetesLatest
+	case DCOS:
+		return DCOSLatest
+	default:
+		return ""
+	}
+}
+
+// GetAPIServerPort returns the port the apiserver is served on, 443 unless specified
+func (k *KubernetesConfig) GetAPIServerPort() int {
+	if k.APIServerPort == 0 {
+		return DefaultAPIServerPort
+	}
+	return k.APIServerPort
+}
+
+// GetEtcdHeartbeatIntervalMs returns the interval, in milliseconds, at which the etcd leader notifies the followers
+func (k *KubernetesConfig) GetEtcdHeartbeatIntervalMs() int {
+	if k.EtcdHeartbeatIntervalMs == nil {
+		return common.DefaultEtcdHeartbeatIntervalMs
+	}
+	return *k.EtcdHeartbeatIntervalMs
+}
+
+// GetEtcdDeploymentMode returns the way etcd is run on the masters, systemd or staticPod
+func (k *KubernetesConfig) GetEtcdDeploymentMode() string {
+	if k.EtcdDeploymentMode == "" {
+		return common.DefaultEtcdDeploymentMode
+	}
+	return k.EtcdDeploymentMode
+}
+
+// IsEtcdStaticPod returns true if etcd runs on the masters as a static pod rather than a systemd service
+func (k *KubernetesConfig) IsEtcdStaticPod() bool {
+	return k.GetEtcdDeploymentMode() == common.EtcdDeploymentModeStaticPod
+}
+
+// GetEtcdElectionTimeoutMs returns the time, in milliseconds, an etcd follower waits for a heartbeat before
+// starting a leader election
+func (k *KubernetesConfig) GetEtcdElectionTimeoutMs() int {
+	if k.EtcdElectionTimeoutMs == nil {
+		return common.DefaultEtcdElectionTimeoutMs
+	}
+	return *k.EtcdElectionTimeoutMs
+}
+
+// GetArtifactChecksums returns the expected SHA256 checksums of the artifacts downloaded
+// during provisioning, keyed by artifact name. Artifacts without a checksum are not verified.
+func (k *KubernetesConfig) GetArtifactChecksums() map[string]string {
+	checksums := map[string]string{}
+	for name, checksum := range map[string]string{
+		AzureCNIArtifact:        k.AzureCNIChecksum,
+		WindowsBinariesArtifact: k.WindowsBinariesChecksum,
+	} {
+		if checksum != "" {
+			checksums[name] = strings.ToLower(checksum)
+		}
+	}
+	return checksums
+}
+
+// GetAzureCNIVersion returns the release of the Azure VNET CNI plugin installed when networkPolicy is azure
+func (k *KubernetesConfig) GetAzureCNIVersion() string {
+	if k.AzureCNIVersion == "" {
+		return DefaultAzureCNIVersion
+	}
+	return k.AzureCNIVersion
+}
+
+// GetCalicoVersion returns the release of Calico deployed when networkPolicy is calico
+func (k *KubernetesConfig) GetCalicoVersion() string {
+	if k.CalicoVersion == "" {
+		return DefaultCalicoVersion
+	}
+	return k.CalicoVersion
+}
+
+// GetKubeProxyArgs returns the command line flags the kube-proxy config overlay adds to kube-proxy.
+// Settings left unset keep the kube-proxy defaults.
+func (k *KubernetesConfig) GetKubeProxyArgs() []string {
+	args := []string{}
+	c := k.KubeProxyConfig
+	if c == nil {
+		return args
+	}
+	if c.ClientConnectionQPS != 0 {
+		args = append(args, "--kube-api-qps="+strconv.FormatFloat(c.ClientConnectionQPS, 'f', -1, 64))
+	}
+	if c.ClientConnectionBurst != 0 {
+		args = append(args, "--kube-api-burst="+strconv.Itoa(c.ClientConnectionBurst))
+	}
+	if c.ConntrackMaxPerCore != 0 {
+		args = append(args, "--conntrack-max-per-core="+strconv.Itoa(c.ConntrackMaxPerCore))
+	}
+	if c.ConntrackMin != 0 {
+		args = append(args, "--conntrack-min="+strconv.Itoa(c.ConntrackMin))
+	}
+	if c.ConntrackTCPEstablishedTimeout != "" {
+		args = append(args, "--conntrack-tcp-timeout-established="+c.ConntrackTCPEstablishedTimeout)
+	}
+	return args
+}
+
+// GetRuntimeReservedMilliCPU returns the CPU reserved for the container runtime on the nodes
+// of an agent pool, or on the masters when the profile is nil. A pool's reservation overrides the cluster's.
+func (k *KubernetesConfig) GetRuntimeReservedMilliCPU(profile *AgentPoolProfile) int {
+	if profile != nil && profile.RuntimeReservedMilliCPU != 0 {
+		return profile.RuntimeReservedMilliCPU
+	}
+	return k.RuntimeReservedMilliCPU
+}
+
+// IsAnonymousAuthDisabled returns true if the apiserver rejects anonymous requests
+func (k *KubernetesConfig) IsAnonymousAuthDisabled() bool {
+	return k.DisableAnonymousAuth != nil && *k.DisableAnonymousAuth
+}
+
+// IsAddonsRootFilesystemReadOnly returns true if the containers of the kube-dns, heapster and dashboard
+// addons run with a read-only root filesystem
+func (k *KubernetesConfig) IsAddonsRootFilesystemReadOnly() bool {
+	return k.AddonsReadOnlyRootFilesystem != nil && *k.AddonsReadOnlyRootFilesystem
+}
+
+// IsTopologyLabelsEnabled returns true if the nodes are labeled with their region and fault domain
+func (k *KubernetesConfig) IsTopologyLabelsEnabled() bool {
+	return k.TopologyLabels != nil && *k.TopologyLabels
+}
+
+// GetTopologyLabels returns the topology labels of a node in the given Azure region and platform fault domain.
+// The nodes are in availability sets rather than availability zones, so the zone of a node is its fault domain.
+func GetTopologyLabels(region, faultDomain string) map[string]string {
+	return map[string]string{
+		TopologyRegionLabel: region,
+		TopologyZoneLabel:   faultDomain,
+		FaultDomainLabel:    faultDomain,
+	}
+}
+
+// IsPodIMDSBlocked returns true if pods are blocked from reaching the instance metadata service of their node
+func (k *KubernetesConfig) IsPodIMDSBlocked() bool {
+	return k.BlockPodIMDSAccess != nil && *k.BlockPodIMDSAccess
+}
+
+// HasDefaultDenyNamespaces returns true if namespaces deny ingress traffic to their pods unless a network policy allows it
+func (k *KubernetesConfig) HasDefaultDenyNamespaces() bool {
+	return len(k.DefaultDenyNamespaces) > 0
+}
+
 // IsVNETIntegrated returns true if Azure VNET integration is enabled
 func (o *OrchestratorProfile) IsVNETIntegrated() bool {
 	switch o.OrchestratorType {
@@ -1,7 +1,12 @@
 package api
 
 import (
+	"fmt"
+	"reflect"
+	"strings"
 	"testing"
+
+	"github.com/Azure/acs-engine/pkg/api/common"
 )
 
 func TestIsDCOS(t *testing.T) {
@@ -20,3 +25,487 @@ func TestIsDCOS(t *testing.T) {
 		t.Fatalf("unexpectedly detected DCOS orchestrator profile from OrchestratorType=%s", kubernetesProfile.OrchestratorType)
 	}
 }
+
+func TestOrchestratorProfilePredicates(t *testing.T) {
+	cases := []struct {
+		orchestratorType OrchestratorType
+		isDCOS           bool
+		isKubernetes     bool
+		isSwarm          bool
+		isSwarmMode      bool
+	}{
+		{orchestratorType: DCOS, isDCOS: true},
+		{orchestratorType: Kubernetes, isKubernetes: true},
+		{orchestratorType: Swarm, isSwarm: true},
+		{orchestratorType: SwarmMode, isSwarmMode: true},
+		{orchestratorType: ""},
+	}
+	for _, c := range cases {
+		o := &OrchestratorProfile{OrchestratorType: c.orchestratorType}
+		if o.IsDCOS() != c.isDCOS {
+			t.Errorf("IsDCOS() for OrchestratorType=%q should be %t", c.orchestratorType, c.isDCOS)
+		}
+		if o.IsKubernetes() != c.isKubernetes {
+			t.Errorf("IsKubernetes() for OrchestratorType=%q should be %t", c.orchestratorType, c.isKubernetes)
+		}
+		if o.IsSwarm() != c.isSwarm {
+			t.Errorf("IsSwarm() for OrchestratorType=%q should be %t", c.orchestratorType, c.isSwarm)
+		}
+		if o.IsSwarmMode() != c.isSwarmMode {
+			t.Errorf("IsSwarmMode() for OrchestratorType=%q should be %t", c.orchestratorType, c.isSwarmMode)
+		}
+	}
+}
+
+func TestDefaultOrchestratorVersion(t *testing.T) {
+	cases := []struct {
+		orchestratorType OrchestratorType
+		expected         OrchestratorVersion
+	}{
+		{orchestratorType: Kubernetes, expected: KubernetesLatest},
+		{orchestratorType: DCOS, expected: DCOSLatest},
+		{orchestratorType: Swarm, expected: ""},
+		{orchestratorType: SwarmMode, expected: ""},
+		{orchestratorType: "Mesos", expected: ""},
+		{orchestratorType: "", expected: ""},
+	}
+	for _, c := range cases {
+		if v := DefaultOrchestratorVersion(c.orchestratorType); v != c.expected {
+			t.Errorf("DefaultOrchestratorVersion(%q) should be %q, got %q", c.orchestratorType, c.expected, v)
+		}
+	}
+}
+
+func TestGetAPIModelHash(t *testing.T) {
+	cs := &ContainerService{
+		Properties: &Properties{
+			ServicePrincipalProfile: &ServicePrincipalProfile{ClientID: "client", Secret: "secret"},
+			AgentPoolProfiles:       []*AgentPoolProfile{{Name: "agentpool1", Count: 3}},
+		},
+	}
+	hash := cs.GetAPIModelHash()
+	if len(hash) != 64 {
+		t.Fatalf("expected a hex encoded SHA256 hash, got %s", hash)
+	}
+
+	cs.Properties.ServicePrincipalProfile.Secret = "rotated"
+	if cs.GetAPIModelHash() != hash {
+		t.Fatalf("the hash should not depend on the service principal secret")
+	}
+	if cs.Properties.ServicePrincipalProfile.Secret != "rotated" {
+		t.Fatalf("computing the hash should not redact the model")
+	}
+
+	cs.Properties.AgentPoolProfiles[0].Count = 4
+	if cs.GetAPIModelHash() == hash {
+		t.Fatalf("the hash should change with the agent pool count")
+	}
+}
+
+func TestGetKubeProxyArgs(t *testing.T) {
+	k := &KubernetesConfig{}
+	if args := k.GetKubeProxyArgs(); len(args) != 0 {
+		t.Errorf("should not add kube-proxy flags without a config overlay, got %v", args)
+	}
+	k.KubeProxyConfig = &KubeProxyConfig{
+		ClientConnectionQPS:            7.5,
+		ConntrackMin:                   131072,
+		ConntrackTCPEstablishedTimeout: "12h",
+	}
+	expected := []string{"--kube-api-qps=7.5", "--conntrack-min=131072", "--conntrack-tcp-timeout-established=12h"}
+	if args := k.GetKubeProxyArgs(); !reflect.DeepEqual(args, expected) {
+		t.Errorf("kube-proxy flags should be %v, got %v", expected, args)
+	}
+}
+
+func TestGetEtcdDeploymentMode(t *testing.T) {
+	k := &KubernetesConfig{}
+	if mode := k.GetEtcdDeploymentMode(); mode != common.EtcdDeploymentModeSystemd {
+		t.Errorf("etcd should run as a systemd service by default, got %s", mode)
+	}
+	if k.IsEtcdStaticPod() {
+		t.Errorf("etcd should not run as a static pod by default")
+	}
+	k.EtcdDeploymentMode = common.EtcdDeploymentModeStaticPod
+	if !k.IsEtcdStaticPod() {
+		t.Errorf("etcd should run as a static pod when its deployment mode is %s", common.EtcdDeploymentModeStaticPod)
+	}
+}
+
+func TestGetTopologyLabels(t *testing.T) {
+	expected := map[string]string{
+		TopologyRegionLabel: "westus2",
+		TopologyZoneLabel:   "1",
+		FaultDomainLabel:    "1",
+	}
+	if labels := GetTopologyLabels("westus2", "1"); !reflect.DeepEqual(labels, expected) {
+		t.Errorf("topology labels should be %v, got %v", expected, labels)
+	}
+}
+
+func TestGetCloudProviderRateLimit(t *testing.T) {
+	p := &Properties{
+		OrchestratorProfile: &OrchestratorProfile{OrchestratorType: Kubernetes, OrchestratorVersion: Kubernetes166},
+		MasterProfile:       &MasterProfile{Count: 3},
+		AgentPoolProfiles:   []*AgentPoolProfile{{Count: 2}, {Count: 0}},
+	}
+	if qps, bucket := p.GetCloudProviderRateLimit(); qps != DefaultCloudProviderRateLimitQPS || bucket != DefaultCloudProviderRateLimitBucket {
+		t.Errorf("expected the default rate limit for a small cluster, got %v and %d", qps, bucket)
+	}
+
+	p.AgentPoolProfiles = append(p.AgentPoolProfiles, &AgentPoolProfile{Count: 95})
+	if qps, bucket := p.GetCloudProviderRateLimit(); qps != 10 || bucket != 100 {
+		t.Errorf("expected a rate limit of 10 and a burst of 100 for 100 nodes, got %v and %d", qps, bucket)
+	}
+
+	p.OrchestratorProfile.KubernetesConfig = &KubernetesConfig{CloudProviderRateLimitQPS: 1.5, CloudProviderRateLimitBucket: 5}
+	if qps, bucket := p.GetCloudProviderRateLimit(); qps != 1.5 || bucket != 5 {
+		t.Errorf("expected the configured rate limit, got %v and %d", qps, bucket)
+	}
+
+	p.OrchestratorProfile.OrchestratorVersion = Kubernetes162
+	if qps, bucket := p.GetCloudProviderRateLimit(); qps != 0 || bucket != 0 {
+		t.Errorf("expected no rate limit for Kubernetes %s, got %v and %d", Kubernetes162, qps, bucket)
+	}
+}
+
+func TestTotalNodes(t *testing.T) {
+	p := &Properties{}
+	if p.TotalNodes() != 0 || p.TotalAgents() != 0 {
+		t.Errorf("expected no nodes without profiles, got %d nodes and %d agents", p.TotalNodes(), p.TotalAgents())
+	}
+
+	p.MasterProfile = &MasterProfile{Count: 3}
+	p.AgentPoolProfiles = []*AgentPoolProfile{
+		{Name: "linuxpool", Count: 4},
+		{Name: "windowspool", Count: 2, OSType: Windows},
+		{Name: "emptypool", Count: 0},
+	}
+	if p.TotalNodes() != 9 {
+		t.Errorf("expected 9 nodes, got %d", p.TotalNodes())
+	}
+	if p.TotalAgents() != 6 {
+		t.Errorf("expected 6 agents, got %d", p.TotalAgents())
+	}
+}
+
+func TestGetExpectedFQDN(t *testing.T) {
+	cases := []struct {
+		dnsPrefix string
+		location  string
+		expected  string
+	}{
+		{dnsPrefix: "mycluster", location: "westus2", expected: "mycluster.westus2.cloudapp.azure.com"},
+		{dnsPrefix: "MyCluster", location: "West Europe", expected: "mycluster.westeurope.cloudapp.azure.com"},
+		{dnsPrefix: "mycluster", location: "chinaeast", expected: "mycluster.chinaeast.cloudapp.chinacloudapi.cn"},
+		{dnsPrefix: "", location: "westus2", expected: ""},
+	}
+	for _, c := range cases {
+		m := &MasterProfile{DNSPrefix: c.dnsPrefix}
+		if fqdn := m.GetExpectedFQDN(c.location); fqdn != c.expected {
+			t.Errorf("GetExpectedFQDN(%q) with DNSPrefix %q should be %q, got %q", c.location, c.dnsPrefix, c.expected, fqdn)
+		}
+		a := &AgentPoolProfile{Name: "agentpool1", DNSPrefix: c.dnsPrefix}
+		if fqdn := a.GetExpectedFQDN(c.location); fqdn != c.expected {
+			t.Errorf("agent pool GetExpectedFQDN(%q) with DNSPrefix %q should be %q, got %q", c.location, c.dnsPrefix, c.expected, fqdn)
+		}
+	}
+}
+
+func TestGetDataDiskMount(t *testing.T) {
+	a := &AgentPoolProfile{
+		DiskSizesGB: []int{128, 256, 512},
+		DataDiskMounts: []DataDiskMount{
+			{FSType: "xfs", MountOptions: []string{"noatime", "discard"}, MountPoint: "/var/lib/data"},
+			{},
+		},
+	}
+	expected := []DataDiskMount{
+		{FSType: "xfs", MountOptions: []string{"noatime", "discard"}, MountPoint: "/var/lib/data"},
+		{FSType: DefaultDataDiskFSType, MountOptions: []string{"defaults"}, MountPoint: "/datadisks/lun1"},
+	}
+	for lun, e := range expected {
+		mount, ok := a.GetDataDiskMount(lun)
+		if !ok || !reflect.DeepEqual(mount, e) {
+			t.Errorf("GetDataDiskMount(%d) should be %v, got %v (%t)", lun, e, mount, ok)
+		}
+	}
+	if _, ok := a.GetDataDiskMount(2); ok {
+		t.Errorf("GetDataDiskMount(2) should leave a data disk without a mount unformatted")
+	}
+}
+
+func TestSetDefaults(t *testing.T) {
+	p := &Properties{
+		AgentPoolProfiles: []*AgentPoolProfile{
+			{Name: "windowspool", OSType: Windows, StorageProfile: ManagedDisks},
+			{Name: "agentpool"},
+		},
+	}
+	p.SetDefaults()
+	if p.AgentPoolProfiles[0].OSType != Windows || p.AgentPoolProfiles[0].StorageProfile != ManagedDisks {
+		t.Errorf("SetDefaults should not change a populated agent pool, got OSType=%s StorageProfile=%s", p.AgentPoolProfiles[0].OSType, p.AgentPoolProfiles[0].StorageProfile)
+	}
+	if p.AgentPoolProfiles[1].OSType != Linux || p.AgentPoolProfiles[1].StorageProfile != StorageAccount {
+		t.Errorf("SetDefaults should default an empty agent pool to OSType=%s StorageProfile=%s, got OSType=%s StorageProfile=%s", Linux, StorageAccount, p.AgentPoolProfiles[1].OSType, p.AgentPoolProfiles[1].StorageProfile)
+	}
+
+	expected := []AgentPoolProfile{*p.AgentPoolProfiles[0], *p.AgentPoolProfiles[1]}
+	p.SetDefaults()
+	for i, profile := range p.AgentPoolProfiles {
+		if !reflect.DeepEqual(*profile, expected[i]) {
+			t.Errorf("SetDefaults should be idempotent, agent pool %s changed on the second call", profile.Name)
+		}
+	}
+}
+
+func TestHasStorageProfiles(t *testing.T) {
+	p := &Properties{}
+	if p.HasManagedDisks() || p.HasStorageAccountDisks() {
+		t.Errorf("expected no disks without a master or agent pools")
+	}
+
+	p.MasterProfile = &MasterProfile{StorageProfile: ManagedDisks}
+	p.AgentPoolProfiles = []*AgentPoolProfile{{Name: "agentpool1", StorageProfile: StorageAccount}}
+	if !p.HasManagedDisks() {
+		t.Errorf("expected managed disks with a managed disks master")
+	}
+	if !p.HasStorageAccountDisks() {
+		t.Errorf("expected storage account disks with storage account agents")
+	}
+
+	p.AgentPoolProfiles[0].StorageProfile = ManagedDisks
+	if p.HasStorageAccountDisks() {
+		t.Errorf("expected no storage account disks when all profiles use managed disks")
+	}
+}
+
+func TestHasLinux(t *testing.T) {
+	p := &Properties{AgentPoolProfiles: []*AgentPoolProfile{{Name: "windowspool", OSType: Windows}}}
+	if p.HasLinux() {
+		t.Errorf("expected no linux agents in a windows only cluster")
+	}
+
+	p.AgentPoolProfiles = append(p.AgentPoolProfiles, &AgentPoolProfile{Name: "defaultpool"})
+	if !p.HasLinux() {
+		t.Errorf("expected an agent pool without OS type to count as linux")
+	}
+	if !p.HasWindows() {
+		t.Errorf("expected the windows agent pool to be detected")
+	}
+
+	p.AgentPoolProfiles = []*AgentPoolProfile{{Name: "linuxpool", OSType: Linux}}
+	if !p.HasLinux() || p.HasWindows() {
+		t.Errorf("expected a linux only cluster, got HasLinux %t and HasWindows %t", p.HasLinux(), p.HasWindows())
+	}
+}
+
+func TestParseKeyVaultSecretRef(t *testing.T) {
+	vaultID := "/subscriptions/sub-id/resourceGroups/rg-name/providers/Microsoft.KeyVault/vaults/kv-name"
+	tests := []struct {
+		secret  string
+		name    string
+		version string
+	}{
+		{vaultID + "/secrets/sp-secret", "sp-secret", ""},
+		{vaultID + "/secrets/sp-secret/0123456789abcdef", "sp-secret", "0123456789abcdef"},
+	}
+	for _, test := range tests {
+		s := &ServicePrincipalProfile{ClientID: "client-id", Secret: test.secret}
+		if !s.IsKeyVaultSecret() {
+			t.Fatalf("expected %s to be a keyvault secret", test.secret)
+		}
+		subID, rg, vault, name, version, err := s.ParseKeyVaultSecretRef()
+		if err != nil {
+			t.Fatalf("unexpected error parsing %s: %s", test.secret, err.Error())
+		}
+		if subID != "sub-id" || rg != "rg-name" || vault != "kv-name" || name != test.name || version != test.version {
+			t.Errorf("unexpected components of %s: %s %s %s %s %s", test.secret, subID, rg, vault, name, version)
+		}
+	}
+
+	s := &ServicePrincipalProfile{ClientID: "client-id", Secret: "plain-text-secret"}
+	if s.IsKeyVaultSecret() {
+		t.Errorf("expected a plain text secret not to be a keyvault secret")
+	}
+	if _, _, _, _, _, err := s.ParseKeyVaultSecretRef(); err == nil {
+		t.Errorf("expected an error parsing a plain text secret")
+	}
+
+	s.Secret = "/subscriptions/sub-id/extra/resourceGroups/rg-name/providers/Microsoft.KeyVault/vaults/kv-name/secrets/sp-secret/v1/v2"
+	if !s.IsKeyVaultSecret() {
+		t.Fatalf("expected %s to be a keyvault secret", s.Secret)
+	}
+	if _, _, _, _, _, err := s.ParseKeyVaultSecretRef(); err == nil {
+		t.Errorf("expected an error parsing a malformed keyvault secret reference")
+	}
+}
+
+func TestDeepCopy(t *testing.T) {
+	cs := &ContainerService{
+		Location: "westus2",
+		Tags:     map[string]string{"owner": "team-a"},
+		Properties: &Properties{
+			OrchestratorProfile: &OrchestratorProfile{OrchestratorType: Kubernetes, KubernetesConfig: &KubernetesConfig{}},
+			MasterProfile:       &MasterProfile{Count: 1, DNSPrefix: "mydns", VMSize: "Standard_D2_v2", Subnet: "10.240.255.0/24"},
+			AgentPoolProfiles:   []*AgentPoolProfile{{Name: "agentpool1", Count: 3, VMSize: "Standard_D2_v2", Subnet: "10.240.0.0/16"}},
+		},
+	}
+
+	c := cs.DeepCopy()
+	if !reflect.DeepEqual(cs, c) {
+		t.Fatalf("expected the copy to equal the container service")
+	}
+	c.Tags["owner"] = "team-b"
+	c.Properties.AgentPoolProfiles[0].Count = 5
+	c.Properties.MasterProfile.Subnet = "10.0.0.0/24"
+	if cs.Tags["owner"] != "team-a" {
+		t.Errorf("expected modifying the copied tags not to modify the original, got %s", cs.Tags["owner"])
+	}
+	if cs.Properties.AgentPoolProfiles[0].Count != 3 {
+		t.Errorf("expected modifying the copied agent pool not to modify the original, got count %d", cs.Properties.AgentPoolProfiles[0].Count)
+	}
+	if cs.Properties.MasterProfile.Subnet != "10.240.255.0/24" {
+		t.Errorf("expected modifying the copied master not to modify the original, got subnet %s", cs.Properties.MasterProfile.Subnet)
+	}
+
+	p := cs.Properties.DeepCopy()
+	p.AgentPoolProfiles = append(p.AgentPoolProfiles, &AgentPoolProfile{Name: "agentpool2"})
+	if len(cs.Properties.AgentPoolProfiles) != 1 {
+		t.Errorf("expected modifying the copied properties not to modify the original")
+	}
+
+	var nilCS *ContainerService
+	if nilCS.DeepCopy() != nil {
+		t.Errorf("expected the copy of a nil container service to be nil")
+	}
+}
+
+func TestIsNSeriesVMSize(t *testing.T) {
+	tests := map[string]bool{
+		"Standard_NC6":        true,
+		"Standard_NC24r":      true,
+		"Standard_NV12":       true,
+		"Standard_ND24s":      true,
+		"Standard_NC6s_v2":    true,
+		"Standard_NC6_Promo":  true,
+		"Standard_NV24_Promo": true,
+		"standard_nc12":       true,
+		"Standard_D2_v2":      false,
+		"Standard_DS2_v2":     false,
+		"Standard_NP10s":      false,
+		"":                    false,
+	}
+	for vmSize, expected := range tests {
+		a := &AgentPoolProfile{VMSize: vmSize}
+		if a.IsNSeriesVMSize() != expected {
+			t.Errorf("expected IsNSeriesVMSize of %s to be %t", vmSize, expected)
+		}
+	}
+}
+
+func TestDistinctVMSizes(t *testing.T) {
+	p := &Properties{
+		AgentPoolProfiles: []*AgentPoolProfile{
+			{Name: "agentpool1", VMSize: "Standard_D2_v2"},
+			{Name: "agentpool2", VMSize: "Standard_A2"},
+			{Name: "agentpool3"},
+		},
+	}
+	if vmSizes := p.DistinctVMSizes(); !reflect.DeepEqual(vmSizes, []string{"Standard_A2", "Standard_D2_v2"}) {
+		t.Errorf("unexpected VM sizes without a master: %v", vmSizes)
+	}
+
+	p.MasterProfile = &MasterProfile{VMSize: "Standard_D2_v2"}
+	if vmSizes := p.DistinctVMSizes(); !reflect.DeepEqual(vmSizes, []string{"Standard_A2", "Standard_D2_v2"}) {
+		t.Errorf("expected the master VM size shared with agentpool1 to be listed once, got %v", vmSizes)
+	}
+}
+
+func TestProvisioningState(t *testing.T) {
+	tests := []struct {
+		state     ProvisioningState
+		terminal  bool
+		transient bool
+	}{
+		{Creating, false, true},
+		{Updating, false, true},
+		{Failed, true, false},
+		{Succeeded, true, false},
+		{Deleting, false, true},
+		{Migrating, false, true},
+		{"", false, false},
+		{"Canceled", false, false},
+	}
+	for _, test := range tests {
+		if test.state.IsTerminal() != test.terminal {
+			t.Errorf("expected IsTerminal of state '%s' to be %t", test.state, test.terminal)
+		}
+		if test.state.IsTransient() != test.transient {
+			t.Errorf("expected IsTransient of state '%s' to be %t", test.state, test.transient)
+		}
+	}
+}
+
+func TestValidateTags(t *testing.T) {
+	cs := &ContainerService{}
+	if err := cs.ValidateTags(); err != nil {
+		t.Errorf("unexpected error without tags: %s", err.Error())
+	}
+
+	cs.Tags = map[string]string{}
+	for i := 0; i < MaxTagCount; i++ {
+		cs.Tags[fmt.Sprintf("tag%d", i)] = "value"
+	}
+	if err := cs.ValidateTags(); err != nil {
+		t.Errorf("unexpected error with %d tags: %s", MaxTagCount, err.Error())
+	}
+	cs.Tags["onetoomany"] = "value"
+	if err := cs.ValidateTags(); err == nil {
+		t.Errorf("expected an error with %d tags", len(cs.Tags))
+	}
+
+	cs.Tags = map[string]string{strings.Repeat("k", MaxTagKeyLength+1): "value"}
+	if err := cs.ValidateTags(); err == nil {
+		t.Errorf("expected an error with a tag name longer than %d characters", MaxTagKeyLength)
+	}
+
+	cs.Tags = map[string]string{"owner": strings.Repeat("v", MaxTagValueLength+1)}
+	if err := cs.ValidateTags(); err == nil {
+		t.Errorf("expected an error with a tag value longer than %d characters", MaxTagValueLength)
+	}
+}
+
+func TestMergeTags(t *testing.T) {
+	cs := &ContainerService{Tags: map[string]string{"owner": "team-a", "env": "dev"}}
+	tags := cs.MergeTags(map[string]string{"env": "prod", "costcenter": "1234"})
+	expected := map[string]string{"owner": "team-a", "env": "prod", "costcenter": "1234"}
+	if !reflect.DeepEqual(tags, expected) {
+		t.Errorf("expected merged tags %v, got %v", expected, tags)
+	}
+	if cs.Tags["env"] != "dev" || len(cs.Tags) != 2 {
+		t.Errorf("expected merging not to modify the tags of the container service, got %v", cs.Tags)
+	}
+
+	cs.Tags = nil
+	if tags := cs.MergeTags(map[string]string{"env": "prod"}); !reflect.DeepEqual(tags, map[string]string{"env": "prod"}) {
+		t.Errorf("unexpected tags merged into nil tags: %v", tags)
+	}
+}
+
+func TestIsHybrid(t *testing.T) {
+	p := &Properties{AgentPoolProfiles: []*AgentPoolProfile{{Name: "linuxpool", OSType: Linux}, {Name: "defaultpool"}}}
+	if p.IsHybrid() {
+		t.Errorf("expected a linux only cluster not to be hybrid")
+	}
+
+	p.AgentPoolProfiles = []*AgentPoolProfile{{Name: "windowspool", OSType: Windows}}
+	if p.IsHybrid() {
+		t.Errorf("expected a windows only cluster not to be hybrid")
+	}
+
+	p.AgentPoolProfiles = append(p.AgentPoolProfiles, &AgentPoolProfile{Name: "defaultpool"})
+	if !p.IsHybrid() {
+		t.Errorf("expected a cluster with windows and linux agents to be hybrid")
+	}
+}
@@ -169,6 +169,20 @@ func (o *OrchestratorType) UnmarshalText(text []byte) error {
 	return nil
 }
 
+// MarshalText encodes OrchestratorType text with the casing of the defined OrchestratorType
+// constant, so that decoding and re-encoding any casing yields the canonical one. An empty
+// OrchestratorType, as in a profile that is not filled in yet, is encoded as empty.
+func (o OrchestratorType) MarshalText() ([]byte, error) {
+	if o == "" {
+		return []byte{}, nil
+	}
+	var canonical OrchestratorType
+	if err := canonical.UnmarshalText([]byte(o)); err != nil {
+		return nil, err
+	}
+	return []byte(canonical), nil
+}
+
 // OSType represents OS types of agents
 type OSType string
 
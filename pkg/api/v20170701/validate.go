@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net"
 	"regexp"
+	"strings"
 )
 
 // Validate implements APIObject
@@ -294,9 +295,9 @@ func validateVNET(a *Properties) error {
 			if err != nil {
 				return err
 			}
-			if agentSubID != subscription ||
-				agentRG != resourcegroup ||
-				agentVNET != vnetname {
+			if !strings.EqualFold(agentSubID, subscription) ||
+				!strings.EqualFold(agentRG, resourcegroup) ||
+				!strings.EqualFold(agentVNET, vnetname) {
 				return errors.New("Multipe VNETS specified.  The master profile and each agent pool must reference the same VNET (but it is ok to reference different subnets on that VNET)")
 			}
 		}
@@ -311,14 +312,15 @@ func validateVNET(a *Properties) error {
 
 // GetVNETSubnetIDComponents extract subscription, resourcegroup, vnetname, subnetname from the vnetSubnetID
 func GetVNETSubnetIDComponents(vnetSubnetID string) (string, string, string, string, error) {
-	vnetSubnetIDRegex := `^\/subscriptions\/([^\/]*)\/resourceGroups\/([^\/]*)\/providers\/Microsoft.Network\/virtualNetworks\/([^\/]*)\/subnets\/([^\/]*)$`
+	// resource IDs are case insensitive, and Azure often returns them as /resourcegroups/
+	vnetSubnetIDRegex := `(?i)^\/subscriptions\/([^\/]*)\/resourceGroups\/([^\/]*)\/providers\/Microsoft.Network\/virtualNetworks\/([^\/]*)\/subnets\/([^\/]*)$`
 	re, err := regexp.Compile(vnetSubnetIDRegex)
 	if err != nil {
 		return "", "", "", "", err
 	}
 	submatches := re.FindStringSubmatch(vnetSubnetID)
-	if len(submatches) != 4 {
-		return "", "", "", "", err
+	if len(submatches) != 5 {
+		return "", "", "", "", fmt.Errorf("vnetSubnetID %s is invalid, it must be of the form /subscriptions/<SUB_ID>/resourceGroups/<RG_NAME>/providers/Microsoft.Network/virtualNetworks/<VNET_NAME>/subnets/<SUBNET_NAME>", vnetSubnetID)
 	}
 	return submatches[1], submatches[2], submatches[3], submatches[4], nil
 }
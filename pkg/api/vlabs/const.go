@@ -1,5 +1,7 @@
 package vlabs
 
+import "github.com/Azure/acs-engine/pkg/api/common"
+
 const (
 	// APIVersion is the version of this API
 	APIVersion = "vlabs"
@@ -29,6 +31,10 @@ const (
 	MinAgentCount = 1
 	// MaxAgentCount are the maximum number of agents per agent pool
 	MaxAgentCount = 100
+	// MinDNSPrefixLength specifies the shortest DNS prefix of a public endpoint
+	MinDNSPrefixLength = 3
+	// MaxDNSPrefixLength specifies the longest DNS prefix of a public endpoint
+	MaxDNSPrefixLength = 45
 	// MinPort specifies the minimum tcp port to open
 	MinPort = 1
 	// MaxPort specifies the maximum tcp port to open
@@ -43,8 +49,83 @@ const (
 	MinIPAddressCount = 1
 	// MaxIPAddressCount specifies the maximum number of IP addresses per network interface
 	MaxIPAddressCount = 256
+	// MaxLinuxHostnameLength specifies the maximum length of a Linux computer name
+	MaxLinuxHostnameLength = 63
+	// MaxWindowsHostnameLength specifies the maximum length of a Windows computer name
+	MaxWindowsHostnameLength = 15
+	// MaxHostnameLength specifies the maximum length of a fully qualified host name
+	MaxHostnameLength = 253
+	// HostnameSuffixLength specifies the number of characters appended to a hostname prefix
+	// to form the computer name of each VM, such as the instance index or the scale set instance id
+	HostnameSuffixLength = 6
+	// MinWindowsPasswordLength specifies the shortest Windows admin password Azure accepts
+	MinWindowsPasswordLength = 8
+	// MaxWindowsPasswordLength specifies the longest Windows admin password Azure accepts
+	MaxWindowsPasswordLength = 123
+	// MinWindowsPasswordCharacterClasses specifies how many of the uppercase, lowercase, digit and
+	// special character classes a Windows admin password must contain
+	MinWindowsPasswordCharacterClasses = 3
+	// MaxDCOSPublicAgentPools specifies the maximum number of public agent pools in a DCOS cluster
+	MaxDCOSPublicAgentPools = 1
+	// MinLBProbeIntervalInSeconds specifies the shortest interval Azure allows between load balancer probes
+	MinLBProbeIntervalInSeconds = 5
+	// MinLBProbeNumberOfProbes specifies the smallest number of failed probes Azure allows
+	// before a load balancer backend is taken out of rotation
+	MinLBProbeNumberOfProbes = 2
+	// MinEtcdElectionTimeoutHeartbeats specifies how many heartbeat intervals the etcd election timeout must
+	// at least last, as recommended by etcd
+	MinEtcdElectionTimeoutHeartbeats = 5
+	// MaxEtcdElectionTimeoutMs specifies the longest election timeout, in milliseconds, etcd accepts
+	MaxEtcdElectionTimeoutMs = 50000
+	// MaxProvisionRetryCount specifies the maximum number of attempts for each download during provisioning
+	MaxProvisionRetryCount = 50
+	// MaxProvisionTimeoutInSeconds specifies the maximum timeout of each download attempt during provisioning
+	MaxProvisionTimeoutInSeconds = 600
+	// AzureReservedSubnetAddresses is the number of addresses Azure reserves at the start of each subnet
+	AzureReservedSubnetAddresses = 4
+	// MaxSubnetPrefixLength is the prefix length of the smallest subnet Azure supports
+	MaxSubnetPrefixLength = 29
+	// LeaderElectJitterFactor is the jitter Kubernetes applies to the leader election retry period
+	LeaderElectJitterFactor = 1.2
+	// MinNodePort specifies the start of the Kubernetes NodePort service range
+	MinNodePort = 30000
+	// MaxNodePort specifies the end of the Kubernetes NodePort service range
+	MaxNodePort = 32767
+	// MaxVMsPerStorageAccount specifies the maximum number of VMs placed in a single storage account
+	MaxVMsPerStorageAccount = 20
+	// MaxStorageAccountsPerPool specifies the maximum number of storage accounts reserved for an agent pool
+	MaxStorageAccountsPerPool = MaxAgentCount / MaxVMsPerStorageAccount
 )
 
+// network security group rules
+const (
+	// MinSecurityRulePriority specifies the lowest network security group rule priority allowed by Azure
+	MinSecurityRulePriority = 100
+	// MaxSecurityRulePriority specifies the highest network security group rule priority allowed by Azure
+	MaxSecurityRulePriority = 4096
+	// MaxReservedSecurityRulePriority specifies the end of the priority range, starting at
+	// MinSecurityRulePriority, that is reserved for the rules generated by acs-engine
+	MaxReservedSecurityRulePriority = 999
+	// Inbound means the security rule applies to incoming traffic
+	Inbound = "Inbound"
+	// Outbound means the security rule applies to outgoing traffic
+	Outbound = "Outbound"
+	// Allow means the security rule allows the matching traffic
+	Allow = "Allow"
+	// Deny means the security rule denies the matching traffic
+	Deny = "Deny"
+)
+
+// ReservedMasterPorts holds the ports used on the masters by etcd, the internal load balancer,
+// the insecure apiserver port and the kubelet, kube-proxy and control plane components
+var ReservedMasterPorts = [...]int{2379, 2380, 4443, 8080, 10248, 10249, 10250, 10251, 10252, 10255, 10256}
+
+// EvictionSignalValues holds the kubelet eviction signals that thresholds may be specified for
+var EvictionSignalValues = [...]string{"memory.available", "nodefs.available", "nodefs.inodesFree", "imagefs.available", "imagefs.inodesFree"}
+
+// SecurityRuleProtocolValues holds the valid values for a security rule protocol
+var SecurityRuleProtocolValues = [...]string{"Tcp", "Udp", "*"}
+
 // Availability profiles
 const (
 	// AvailabilitySet means that the vms are in an availability set
@@ -61,6 +142,24 @@ const (
 	ManagedDisks = "ManagedDisks"
 )
 
+// windowsSupportMatrix lists the orchestrators that support Windows agent pools, along with
+// the orchestrator versions that support them. An empty list means all versions are supported.
+// Windows nodes need the Windows Server container networking of Kubernetes 1.6, so the 1.5
+// releases are left out.
+var windowsSupportMatrix = map[OrchestratorType][]OrchestratorVersion{
+	Swarm:      {},
+	SwarmMode:  {},
+	Kubernetes: {Kubernetes160, Kubernetes162, Kubernetes166},
+}
+
+// storage account types
+const (
+	// StandardLRS means that storage accounts are created with standard locally redundant storage
+	StandardLRS = "Standard_LRS"
+	// PremiumLRS means that storage accounts are created with premium locally redundant storage
+	PremiumLRS = "Premium_LRS"
+)
+
 // Network policy
 var (
 	NetworkPolicyValues = [...]string{"", "none", "azure", "calico"}
@@ -95,3 +194,75 @@ const (
 	// KubernetesLatest is the string constant for latest Kubernetes version
 	KubernetesLatest OrchestratorVersion = Kubernetes166
 )
+
+// SupportedOrchestratorVersions are the versions that can be selected for each orchestrator.
+// An empty version selects the default version of the orchestrator. Swarm and Swarm Mode have no
+// selectable version, and the version of their existing API models is ignored.
+var SupportedOrchestratorVersions = map[OrchestratorType][]OrchestratorVersion{
+	DCOS:       {DCOS173, DCOS184, DCOS187, DCOS188, DCOS190},
+	Kubernetes: {Kubernetes153, Kubernetes157, Kubernetes160, Kubernetes162, Kubernetes166},
+	Swarm:      {},
+	SwarmMode:  {},
+}
+
+// CalicoKubernetesVersions are the Kubernetes versions each supported Calico release can be deployed to
+var CalicoKubernetesVersions = map[string][]OrchestratorVersion{
+	"v2.2.1": {Kubernetes153, Kubernetes157, Kubernetes160, Kubernetes162, Kubernetes166},
+	"v2.3.0": {Kubernetes160, Kubernetes162, Kubernetes166},
+}
+
+// EtcdDeploymentModeKubernetesVersions are the Kubernetes versions each way of running etcd can be deployed with.
+// Running etcd as a static pod is only supported from Kubernetes 1.6.
+var EtcdDeploymentModeKubernetesVersions = map[string][]OrchestratorVersion{
+	common.EtcdDeploymentModeSystemd:   {Kubernetes153, Kubernetes157, Kubernetes160, Kubernetes162, Kubernetes166},
+	common.EtcdDeploymentModeStaticPod: {Kubernetes160, Kubernetes162, Kubernetes166},
+}
+
+// ForbiddenWindowsAdminUsernames are the admin user names Azure refuses for Windows VMs
+var ForbiddenWindowsAdminUsernames = []string{
+	"administrator", "admin", "user", "user1", "test", "user2", "test1", "user3", "admin1", "1", "123", "a",
+	"actuser", "adm", "admin2", "aspnet", "backup", "console", "david", "guest", "john", "owner", "root",
+	"server", "sql", "support", "support_388945a0", "sys", "test2", "test3", "user4", "user5",
+}
+
+// VMSizeCores holds the number of vCPUs of the VM sizes Kubernetes agents may use
+var VMSizeCores = map[string]int{
+	"Standard_A0": 1, "Standard_A1": 1, "Standard_A2": 2, "Standard_A3": 4, "Standard_A4": 8, "Standard_A5": 2, "Standard_A6": 4, "Standard_A7": 8, "Standard_A8": 8, "Standard_A9": 16, "Standard_A10": 8, "Standard_A11": 16,
+	"Standard_A1_v2": 1, "Standard_A2_v2": 2, "Standard_A4_v2": 4, "Standard_A8_v2": 8, "Standard_A2m_v2": 2, "Standard_A4m_v2": 4, "Standard_A8m_v2": 8,
+	"Standard_D1": 1, "Standard_D2": 2, "Standard_D3": 4, "Standard_D4": 8, "Standard_D11": 2, "Standard_D12": 4, "Standard_D13": 8, "Standard_D14": 16,
+	"Standard_D1_v2": 1, "Standard_D2_v2": 2, "Standard_D3_v2": 4, "Standard_D4_v2": 8, "Standard_D5_v2": 16, "Standard_D11_v2": 2, "Standard_D12_v2": 4, "Standard_D13_v2": 8, "Standard_D14_v2": 16, "Standard_D15_v2": 20,
+	"Standard_D2_v2_Promo": 2, "Standard_D3_v2_Promo": 4, "Standard_D4_v2_Promo": 8, "Standard_D5_v2_Promo": 16, "Standard_D11_v2_Promo": 2, "Standard_D12_v2_Promo": 4, "Standard_D13_v2_Promo": 8, "Standard_D14_v2_Promo": 16,
+	"Standard_DS1": 1, "Standard_DS2": 2, "Standard_DS3": 4, "Standard_DS4": 8, "Standard_DS11": 2, "Standard_DS12": 4, "Standard_DS13": 8, "Standard_DS14": 16,
+	"Standard_DS1_v2": 1, "Standard_DS2_v2": 2, "Standard_DS3_v2": 4, "Standard_DS4_v2": 8, "Standard_DS5_v2": 16, "Standard_DS11_v2": 2, "Standard_DS12_v2": 4, "Standard_DS13_v2": 8, "Standard_DS14_v2": 16, "Standard_DS15_v2": 20,
+	"Standard_DS2_v2_Promo": 2, "Standard_DS3_v2_Promo": 4, "Standard_DS4_v2_Promo": 8, "Standard_DS5_v2_Promo": 16, "Standard_DS11_v2_Promo": 2, "Standard_DS12_v2_Promo": 4, "Standard_DS13_v2_Promo": 8, "Standard_DS14_v2_Promo": 16,
+	"Standard_F1": 1, "Standard_F1s": 1, "Standard_F2": 2, "Standard_F2s": 2, "Standard_F4": 4, "Standard_F4s": 4, "Standard_F8": 8, "Standard_F8s": 8, "Standard_F16": 16, "Standard_F16s": 16,
+	"Standard_G1": 2, "Standard_G2": 4, "Standard_G3": 8, "Standard_G4": 16, "Standard_G5": 32,
+	"Standard_GS1": 2, "Standard_GS2": 4, "Standard_GS3": 8, "Standard_GS4": 16, "Standard_GS5": 32,
+	"Standard_H8": 8, "Standard_H8m": 8, "Standard_H16": 16, "Standard_H16m": 16, "Standard_H16r": 16, "Standard_H16mr": 16,
+	"Standard_L4s": 4, "Standard_L8s": 8, "Standard_L16s": 16, "Standard_L32s": 32,
+	"Standard_M64ms": 64, "Standard_M128s": 128, "Standard_M128ms": 128,
+	"Standard_NC6": 6, "Standard_NC12": 12, "Standard_NC24": 24, "Standard_NC24r": 24, "Standard_NV6": 6, "Standard_NV12": 12, "Standard_NV24": 24,
+}
+
+// SupportedDataDiskFSTypes are the filesystems the data disks of the agents can be formatted with
+var SupportedDataDiskFSTypes = map[string]bool{
+	"ext4": true,
+	"xfs":  true,
+}
+
+// RecognizedDataDiskMountOptions are the options the data disks of the agents can be mounted with
+var RecognizedDataDiskMountOptions = map[string]bool{
+	"defaults":   true,
+	"noatime":    true,
+	"nodiratime": true,
+	"relatime":   true,
+	"discard":    true,
+	"nodiscard":  true,
+	"barrier":    true,
+	"nobarrier":  true,
+	"noexec":     true,
+	"nosuid":     true,
+	"nodev":      true,
+	"ro":         true,
+	"rw":         true,
+}
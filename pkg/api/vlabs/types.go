@@ -3,6 +3,8 @@ package vlabs
 import (
 	"fmt"
 	"strings"
+
+	"github.com/Azure/acs-engine/pkg/api/common"
 )
 
 // ResourcePurchasePlan defines resource plan as required by ARM
@@ -37,6 +39,10 @@ type Properties struct {
 	WindowsProfile          *WindowsProfile          `json:"windowsProfile,omitempty"`
 	ServicePrincipalProfile *ServicePrincipalProfile `json:"servicePrincipalProfile,omitempty"`
 	CertificateProfile      *CertificateProfile      `json:"certificateProfile,omitempty"`
+	SecurityRules           []SecurityRule           `json:"securityRules,omitempty"`
+	PrivateRegistryProfile  *PrivateRegistryProfile  `json:"privateRegistryProfile,omitempty"`
+	UniqueStorageNames      *bool                    `json:"uniqueStorageNames,omitempty"`
+	CustomProfile           *CustomProfile           `json:"customProfile,omitempty"`
 }
 
 // ServicePrincipalProfile contains the client and secret used by the cluster for Azure Resource CRUD
@@ -54,6 +60,47 @@ type ServicePrincipalProfile struct {
 	Secret   string `json:"servicePrincipalClientSecret,omitempty"`
 }
 
+// PrivateRegistryProfile contains the credentials of a private docker registry.
+// They are stored in an image pull secret that is added to the default service
+// account of each namespace listed, "default" unless specified.
+// The 'Password' parameter could be either a plain text, or referenced to a secret in a keyvault,
+// in the same format as the service principal secret.
+type PrivateRegistryProfile struct {
+	Server     string   `json:"server"`
+	Username   string   `json:"username"`
+	Password   string   `json:"password"`
+	Namespaces []string `json:"namespaces,omitempty"`
+}
+
+// CustomProfile specifies where the DCOS bootstrap of the cluster is taken from, instead of
+// the DCOS repository of the Azure cloud the cluster is deployed to
+type CustomProfile struct {
+	BootstrapRepositoryURL string `json:"bootstrapRepositoryURL,omitempty"`
+	BootstrapDockerImage   string `json:"bootstrapDockerImage,omitempty"`
+}
+
+// HasCustomBootstrap returns true if the DCOS bootstrap is downloaded from a custom repository
+// or a custom docker image is asked for, which validation rejects
+func (c *CustomProfile) HasCustomBootstrap() bool {
+	return c != nil && (c.BootstrapRepositoryURL != "" || c.BootstrapDockerImage != "")
+}
+
+// SecurityRule represents a network security group rule that is merged into
+// the network security groups generated for the cluster, alongside the rules
+// required by the orchestrator
+type SecurityRule struct {
+	Name                     string `json:"name"`
+	Description              string `json:"description,omitempty"`
+	Priority                 int    `json:"priority"`
+	Direction                string `json:"direction"`
+	Access                   string `json:"access"`
+	Protocol                 string `json:"protocol"`
+	SourceAddressPrefix      string `json:"sourceAddressPrefix,omitempty"`
+	SourcePortRange          string `json:"sourcePortRange,omitempty"`
+	DestinationAddressPrefix string `json:"destinationAddressPrefix,omitempty"`
+	DestinationPortRange     string `json:"destinationPortRange,omitempty"`
+}
+
 // CertificateProfile represents the definition of the master cluster
 // The JSON parameters could be either a plain text, or referenced to a secret in a keyvault.
 // In the latter case, the format of the parameter's value should be
@@ -81,6 +128,11 @@ type CertificateProfile struct {
 	KubeConfigCertificate string `json:"kubeConfigCertificate,omitempty"`
 	// KubeConfigPrivateKey is the client private key used for kubectl cli and signed by the CA
 	KubeConfigPrivateKey string `json:"kubeConfigPrivateKey,omitempty"`
+	// ServiceAccountPrivateKey is the key service account tokens are signed with, the apiserver private key unless specified
+	ServiceAccountPrivateKey string `json:"serviceAccountPrivateKey,omitempty"`
+	// ServiceAccountVerificationKeys are RSA public keys service account tokens are verified with besides
+	// the signing key, such as the next or the previous signing key while it is rotated
+	ServiceAccountVerificationKeys []string `json:"serviceAccountVerificationKeys,omitempty"`
 }
 
 // LinuxProfile represents the linux parameters passed to the cluster
@@ -91,14 +143,25 @@ type LinuxProfile struct {
 			KeyData string `json:"keyData"`
 		} `json:"publicKeys"`
 	} `json:"ssh"`
-	Secrets []KeyVaultSecrets `json:"secrets,omitempty"`
+	Secrets     []KeyVaultSecrets `json:"secrets,omitempty"`
+	HostAliases []HostAlias       `json:"hostAliases,omitempty"`
+}
+
+// HostAlias is an entry added to the hosts file of the nodes, resolving the host names to the IP address
+type HostAlias struct {
+	IP        string   `json:"ip"`
+	Hostnames []string `json:"hostnames"`
 }
 
 // WindowsProfile represents the windows parameters passed to the cluster
 type WindowsProfile struct {
-	AdminUsername string            `json:"adminUsername,omitempty"`
-	AdminPassword string            `json:"adminPassword,omitempty"`
-	Secrets       []KeyVaultSecrets `json:"secrets,omitempty"`
+	AdminUsername  string            `json:"adminUsername,omitempty"`
+	AdminPassword  string            `json:"adminPassword,omitempty"`
+	ImagePublisher string            `json:"imagePublisher,omitempty"`
+	ImageOffer     string            `json:"imageOffer,omitempty"`
+	ImageSku       string            `json:"imageSku,omitempty"`
+	ImageVersion   string            `json:"imageVersion,omitempty"`
+	Secrets        []KeyVaultSecrets `json:"secrets,omitempty"`
 }
 
 // ProvisioningState represents the current state of container service resource.
@@ -130,10 +193,50 @@ type OrchestratorProfile struct {
 // KubernetesConfig contains the Kubernetes config structure, containing
 // Kubernetes specific configuration
 type KubernetesConfig struct {
-	KubernetesImageBase string `json:"kubernetesImageBase,omitempty"`
-	ClusterSubnet       string `json:"clusterSubnet,omitempty"`
-	NetworkPolicy       string `json:"networkPolicy,omitempty"`
-	DockerBridgeSubnet  string `json:"DockerBridgeSubnet,omitempty"`
+	KubernetesImageBase            string           `json:"kubernetesImageBase,omitempty"`
+	ClusterSubnet                  string           `json:"clusterSubnet,omitempty"`
+	NetworkPolicy                  string           `json:"networkPolicy,omitempty"`
+	DockerBridgeSubnet             string           `json:"DockerBridgeSubnet,omitempty"`
+	MasterLBProbeIntervalInSeconds int              `json:"masterLBProbeIntervalInSeconds,omitempty"`
+	MasterLBProbeNumberOfProbes    int              `json:"masterLBProbeNumberOfProbes,omitempty"`
+	ProvisionRetryCount            int              `json:"provisionRetryCount,omitempty"`
+	ProvisionTimeoutInSeconds      int              `json:"provisionTimeoutInSeconds,omitempty"`
+	EvictionHard                   string           `json:"evictionHard,omitempty"`
+	EvictionSoft                   string           `json:"evictionSoft,omitempty"`
+	EvictionSoftGracePeriod        string           `json:"evictionSoftGracePeriod,omitempty"`
+	DisableAnonymousAuth           *bool            `json:"disableAnonymousAuth,omitempty"`
+	APIServerPort                  int              `json:"apiServerPort,omitempty"`
+	AzureCNIChecksum               string           `json:"azureCNIChecksum,omitempty"`
+	WindowsBinariesChecksum        string           `json:"windowsBinariesChecksum,omitempty"`
+	LeaderElectLeaseDuration       string           `json:"leaderElectLeaseDuration,omitempty"`
+	LeaderElectRenewDeadline       string           `json:"leaderElectRenewDeadline,omitempty"`
+	LeaderElectRetryPeriod         string           `json:"leaderElectRetryPeriod,omitempty"`
+	RuntimeReservedMilliCPU        int              `json:"runtimeReservedMilliCPU,omitempty"`
+	ClusterName                    string           `json:"clusterName,omitempty"`
+	BlockPodIMDSAccess             *bool            `json:"blockPodIMDSAccess,omitempty"`
+	DefaultDenyNamespaces          []string         `json:"defaultDenyNamespaces,omitempty"`
+	CloudProviderRateLimitQPS      float64          `json:"cloudProviderRateLimitQPS,omitempty"`
+	CloudProviderRateLimitBucket   int              `json:"cloudProviderRateLimitBucket,omitempty"`
+	AzureCNIVersion                string           `json:"azureCNIVersion,omitempty"`
+	CalicoVersion                  string           `json:"calicoVersion,omitempty"`
+	KubeProxyImage                 string           `json:"kubeProxyImage,omitempty"`
+	KubeProxyConfig                *KubeProxyConfig `json:"kubeProxyConfig,omitempty"`
+	EtcdHeartbeatIntervalMs        *int             `json:"etcdHeartbeatIntervalMs,omitempty"`
+	EtcdElectionTimeoutMs          *int             `json:"etcdElectionTimeoutMs,omitempty"`
+	AddonsReadOnlyRootFilesystem   *bool            `json:"addonsReadOnlyRootFilesystem,omitempty"`
+	PodInfraContainerImage         string           `json:"podInfraContainerImage,omitempty"`
+	RequireImageDigests            *bool            `json:"requireImageDigests,omitempty"`
+	EtcdDeploymentMode             string           `json:"etcdDeploymentMode,omitempty"`
+	TopologyLabels                 *bool            `json:"topologyLabels,omitempty"`
+}
+
+// KubeProxyConfig overrides settings of kube-proxy, which runs as a daemonset on the Linux nodes
+type KubeProxyConfig struct {
+	ClientConnectionQPS            float64 `json:"clientConnectionQPS,omitempty"`
+	ClientConnectionBurst          int     `json:"clientConnectionBurst,omitempty"`
+	ConntrackMaxPerCore            int     `json:"conntrackMaxPerCore,omitempty"`
+	ConntrackMin                   int     `json:"conntrackMin,omitempty"`
+	ConntrackTCPEstablishedTimeout string  `json:"conntrackTCPEstablishedTimeout,omitempty"`
 }
 
 // MasterProfile represents the definition of the master cluster
@@ -143,6 +246,7 @@ type MasterProfile struct {
 	VMSize                   string `json:"vmSize"`
 	OSDiskSizeGB             int    `json:"osDiskSizeGB,omitempty"`
 	VnetSubnetID             string `json:"vnetSubnetID,omitempty"`
+	VnetSubnetCIDR           string `json:"vnetSubnetCIDR,omitempty"`
 	FirstConsecutiveStaticIP string `json:"firstConsecutiveStaticIP,omitempty"`
 	IPAddressCount           int    `json:"ipAddressCount,omitempty"`
 	StorageProfile           string `json:"storageProfile,omitempty"`
@@ -161,18 +265,29 @@ type ClassicAgentPoolProfileType string
 
 // AgentPoolProfile represents an agent pool definition
 type AgentPoolProfile struct {
-	Name                string `json:"name"`
-	Count               int    `json:"count"`
-	VMSize              string `json:"vmSize"`
-	OSDiskSizeGB        int    `json:"osDiskSizeGB,omitempty"`
-	DNSPrefix           string `json:"dnsPrefix,omitempty"`
-	OSType              OSType `json:"osType,omitempty"`
-	Ports               []int  `json:"ports,omitempty"`
-	AvailabilityProfile string `json:"availabilityProfile"`
-	StorageProfile      string `json:"storageProfile"`
-	DiskSizesGB         []int  `json:"diskSizesGB,omitempty"`
-	VnetSubnetID        string `json:"vnetSubnetID,omitempty"`
-	IPAddressCount      int    `json:"ipAddressCount,omitempty"`
+	Name                    string          `json:"name"`
+	Count                   int             `json:"count"`
+	VMSize                  string          `json:"vmSize"`
+	OSDiskSizeGB            int             `json:"osDiskSizeGB,omitempty"`
+	DNSPrefix               string          `json:"dnsPrefix,omitempty"`
+	OSType                  OSType          `json:"osType,omitempty"`
+	Ports                   []int           `json:"ports,omitempty"`
+	AvailabilityProfile     string          `json:"availabilityProfile"`
+	StorageProfile          string          `json:"storageProfile"`
+	StorageAccountType      string          `json:"storageAccountType,omitempty"`
+	StorageAccountsPerPool  int             `json:"storageAccountsPerPool,omitempty"`
+	DiskSizesGB             []int           `json:"diskSizesGB,omitempty"`
+	DataDiskMounts          []DataDiskMount `json:"dataDiskMounts,omitempty"`
+	VnetSubnetID            string          `json:"vnetSubnetID,omitempty"`
+	IPAddressCount          int             `json:"ipAddressCount,omitempty"`
+	HostnamePrefix          string          `json:"hostnamePrefix,omitempty"`
+	EvictionHard            string          `json:"evictionHard,omitempty"`
+	EvictionSoft            string          `json:"evictionSoft,omitempty"`
+	EvictionSoftGracePeriod string          `json:"evictionSoftGracePeriod,omitempty"`
+	PreloadImages           []string        `json:"preloadImages,omitempty"`
+	RuntimeReservedMilliCPU int             `json:"runtimeReservedMilliCPU,omitempty"`
+	TaintGPUNodes           *bool           `json:"taintGPUNodes,omitempty"`
+	AvailabilityZones       []string        `json:"availabilityZones,omitempty"`
 
 	// subnet is internal
 	subnet string
@@ -181,6 +296,14 @@ type AgentPoolProfile struct {
 	CustomNodeLabels map[string]string `json:"customNodeLabels,omitempty"`
 }
 
+// DataDiskMount describes how a data disk of the agents, at the same index in DiskSizesGB, is formatted
+// and mounted. Data disks without a DataDiskMount are attached unformatted.
+type DataDiskMount struct {
+	FSType       string   `json:"fsType,omitempty"`
+	MountOptions []string `json:"mountOptions,omitempty"`
+	MountPoint   string   `json:"mountPoint,omitempty"`
+}
+
 // KeyVaultSecrets specifies certificates to install on the pool
 // of machines from a given key vault
 // the key vault specified must have been granted read permissions to CRP
@@ -230,9 +353,51 @@ func (o *OrchestratorType) UnmarshalText(text []byte) error {
 	return nil
 }
 
+// MarshalText encodes OrchestratorType text with the casing of the defined OrchestratorType
+// constant, so that decoding and re-encoding any casing yields the canonical one. An empty
+// OrchestratorType, as in a profile that is not filled in yet, is encoded as empty.
+func (o OrchestratorType) MarshalText() ([]byte, error) {
+	if o == "" {
+		return []byte{}, nil
+	}
+	var canonical OrchestratorType
+	if err := canonical.UnmarshalText([]byte(o)); err != nil {
+		return nil, err
+	}
+	return []byte(canonical), nil
+}
+
 // OSType represents OS types of agents
 type OSType string
 
+// UnmarshalText decodes OSType text, do a case insensitive comparison with the defined OSType
+// constants and set to it if they equal. An empty OSType is kept empty, it means Linux.
+func (o *OSType) UnmarshalText(text []byte) error {
+	s := string(text)
+	switch {
+	case s == "":
+		*o = ""
+	case strings.EqualFold(s, string(Linux)):
+		*o = Linux
+	case strings.EqualFold(s, string(Windows)):
+		*o = Windows
+	default:
+		return fmt.Errorf("OSType has unknown OS: %s", s)
+	}
+
+	return nil
+}
+
+// MarshalText encodes OSType text with the casing of the defined OSType constant,
+// so that decoding and re-encoding any casing yields the canonical one
+func (o OSType) MarshalText() ([]byte, error) {
+	var canonical OSType
+	if err := canonical.UnmarshalText([]byte(o)); err != nil {
+		return nil, err
+	}
+	return []byte(canonical), nil
+}
+
 // HasWindows returns true if the cluster contains windows
 func (p *Properties) HasWindows() bool {
 	for _, agentPoolProfile := range p.AgentPoolProfiles {
@@ -243,6 +408,16 @@ func (p *Properties) HasWindows() bool {
 	return false
 }
 
+// HasLinux returns true if the cluster contains linux agents, agent pools without an OS type run linux
+func (p *Properties) HasLinux() bool {
+	for _, agentPoolProfile := range p.AgentPoolProfiles {
+		if agentPoolProfile.OSType == Linux || agentPoolProfile.OSType == "" {
+			return true
+		}
+	}
+	return false
+}
+
 // IsCustomVNET returns true if the customer brought their own VNET
 func (m *MasterProfile) IsCustomVNET() bool {
 	return len(m.VnetSubnetID) > 0
@@ -303,6 +478,33 @@ func (a *AgentPoolProfile) HasDisks() bool {
 	return len(a.DiskSizesGB) > 0
 }
 
+// IsDCOSPublicPool returns true if the agent pool exposes ports publicly, which
+// makes it a public agent pool behind a load balancer in a DCOS cluster
+func (a *AgentPoolProfile) IsDCOSPublicPool() bool {
+	return len(a.Ports) > 0
+}
+
+// HasPreloadImages returns true if the customer specified container images to pull while provisioning the agents
+func (a *AgentPoolProfile) HasPreloadImages() bool {
+	return len(a.PreloadImages) > 0
+}
+
+// HasCustomImage returns true if the customer specified an image for the windows agents rather than the default
+// Windows Server 2016 image
+func (w *WindowsProfile) HasCustomImage() bool {
+	return w.ImagePublisher != "" || w.ImageOffer != "" || w.ImageSku != "" || w.ImageVersion != ""
+}
+
+// HasAvailabilityZones returns true if the customer specified availability zones to spread the agents across
+func (a *AgentPoolProfile) HasAvailabilityZones() bool {
+	return len(a.AvailabilityZones) > 0
+}
+
+// IsNSeriesVMSize returns true if the agent pool VM size is of the N-series, whose VMs have GPUs
+func (a *AgentPoolProfile) IsNSeriesVMSize() bool {
+	return common.NSeriesVMSizeRegex.MatchString(a.VMSize)
+}
+
 // GetSubnet returns the read-only subnet for the agent pool
 func (a *AgentPoolProfile) GetSubnet() string {
 	return a.subnet
@@ -317,3 +519,18 @@ func (a *AgentPoolProfile) SetSubnet(subnet string) {
 func (o *OrchestratorProfile) IsSwarmMode() bool {
 	return o.OrchestratorType == SwarmMode
 }
+
+// IsSwarm returns true if this template is for Swarm orchestrator
+func (o *OrchestratorProfile) IsSwarm() bool {
+	return o.OrchestratorType == Swarm
+}
+
+// IsKubernetes returns true if this template is for Kubernetes orchestrator
+func (o *OrchestratorProfile) IsKubernetes() bool {
+	return o.OrchestratorType == Kubernetes
+}
+
+// IsDCOS returns true if this template is for DCOS orchestrator
+func (o *OrchestratorProfile) IsDCOS() bool {
+	return o.OrchestratorType == DCOS
+}
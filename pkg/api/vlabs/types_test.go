@@ -0,0 +1,84 @@
+package vlabs
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func Test_OrchestratorType_RoundTrip(t *testing.T) {
+	cases := map[string]OrchestratorType{
+		"kubernetes": Kubernetes,
+		"dcos":       DCOS,
+		"SWARM":      Swarm,
+		"swarmmode":  SwarmMode,
+	}
+	for input, canonical := range cases {
+		o := &OrchestratorProfile{}
+		if err := json.Unmarshal([]byte(`{"orchestratorType":"`+input+`"}`), o); err != nil {
+			t.Errorf("unmarshaling OrchestratorType %q should not error: %v", input, err)
+			continue
+		}
+		b, err := json.Marshal(o)
+		if err != nil {
+			t.Errorf("marshaling OrchestratorType %q should not error: %v", input, err)
+			continue
+		}
+		decoded := map[string]interface{}{}
+		if err := json.Unmarshal(b, &decoded); err != nil {
+			t.Errorf("unmarshaling %s should not error: %v", b, err)
+			continue
+		}
+		if decoded["orchestratorType"] != string(canonical) {
+			t.Errorf("OrchestratorType %q should be re-marshaled as %q, got %v", input, canonical, decoded["orchestratorType"])
+		}
+	}
+
+	if _, err := json.Marshal(&OrchestratorProfile{OrchestratorType: "Mesos"}); err == nil {
+		t.Errorf("marshaling an unknown OrchestratorType should error")
+	}
+
+	if b, err := json.Marshal(&OrchestratorProfile{}); err != nil || !strings.Contains(string(b), `"orchestratorType":""`) {
+		t.Errorf("an empty OrchestratorType should be marshaled as empty, got %s, %v", b, err)
+	}
+}
+
+func Test_OSType_RoundTrip(t *testing.T) {
+	cases := map[string]OSType{
+		"linux":   Linux,
+		"LINUX":   Linux,
+		"windows": Windows,
+		"WiNdOwS": Windows,
+	}
+	for input, canonical := range cases {
+		a := &AgentPoolProfile{}
+		if err := json.Unmarshal([]byte(`{"osType":"`+input+`"}`), a); err != nil {
+			t.Errorf("unmarshaling OSType %q should not error: %v", input, err)
+			continue
+		}
+		if a.OSType != canonical {
+			t.Errorf("OSType %q should be unmarshaled as %q, got %q", input, canonical, a.OSType)
+		}
+		b, err := json.Marshal(a)
+		if err != nil {
+			t.Errorf("marshaling OSType %q should not error: %v", input, err)
+			continue
+		}
+		decoded := map[string]interface{}{}
+		if err := json.Unmarshal(b, &decoded); err != nil {
+			t.Errorf("unmarshaling %s should not error: %v", b, err)
+			continue
+		}
+		if decoded["osType"] != string(canonical) {
+			t.Errorf("OSType %q should be re-marshaled as %q, got %v", input, canonical, decoded["osType"])
+		}
+	}
+
+	a := &AgentPoolProfile{}
+	if err := json.Unmarshal([]byte(`{"osType":""}`), a); err != nil || a.OSType != "" {
+		t.Errorf("unmarshaling an empty OSType should keep it empty, got %q and %v", a.OSType, err)
+	}
+	if err := json.Unmarshal([]byte(`{"osType":"FreeBSD"}`), a); err == nil {
+		t.Errorf("unmarshaling an unknown OSType should error")
+	}
+}
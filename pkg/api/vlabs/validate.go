@@ -1,55 +1,113 @@
 package vlabs
 
 import (
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/pem"
 	"errors"
 	"fmt"
 	"net"
 	"net/url"
+	"path"
+	"reflect"
 	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/Azure/acs-engine/pkg/api/common"
 )
 
-// Validate implements APIObject
-func (o *OrchestratorProfile) Validate() error {
-	switch o.OrchestratorType {
-	case DCOS:
-		switch o.OrchestratorVersion {
-		case DCOS173:
-		case DCOS184:
-		case DCOS187:
-		case DCOS188:
-		case DCOS190:
-		case "":
-		default:
-			return fmt.Errorf("OrchestratorProfile has unknown orchestrator version: %s", o.OrchestratorVersion)
-		}
+var hostnamePrefixRegex = regexp.MustCompile(`^[a-z][a-z0-9-]*$`)
 
-	case Swarm:
-	case SwarmMode:
+var premiumStorageVMSizeRegex = regexp.MustCompile(`^Standard_(DS\d|GS\d|[FLM]\d+m?s)`)
 
-	case Kubernetes:
-		switch o.OrchestratorVersion {
-		case Kubernetes166:
-		case Kubernetes162:
-		case Kubernetes160:
-		case Kubernetes157:
-		case Kubernetes153:
-		case "":
-		default:
-			return fmt.Errorf("OrchestratorProfile has unknown orchestrator version: %s", o.OrchestratorVersion)
-		}
+var securityRuleNameRegex = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9_.-]{0,78}[a-zA-Z0-9_])?$`)
 
-		if o.KubernetesConfig != nil {
-			err := o.KubernetesConfig.Validate()
-			if err != nil {
-				return err
-			}
+var securityRuleTagRegex = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9]*$`)
+
+// imageReferenceRegex matches [registry[:port]/]repository[:tag][@digest] docker image references
+var imageReferenceRegex = regexp.MustCompile(`^([a-zA-Z0-9.-]+(:[0-9]+)?/)?[a-z0-9]+([._-][a-z0-9]+)*(/[a-z0-9]+([._-][a-z0-9]+)*)*(:[a-zA-Z0-9_][a-zA-Z0-9_.-]{0,127})?(@sha256:[a-f0-9]{64})?$`)
+
+// imageDigestRegex matches the sha256:<64 hex characters> digest an image reference is pinned by
+var imageDigestRegex = regexp.MustCompile(`^sha256:[a-f0-9]{64}$`)
+
+// sha256ChecksumRegex matches a hex encoded SHA256 checksum
+var sha256ChecksumRegex = regexp.MustCompile(`^[a-fA-F0-9]{64}$`)
+
+// registryServerRegex matches a registry host name with an optional port
+var registryServerRegex = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9-]*[a-zA-Z0-9])?(\.[a-zA-Z0-9]([a-zA-Z0-9-]*[a-zA-Z0-9])?)*(:[0-9]+)?$`)
+
+// namespaceRegex matches a Kubernetes namespace name, a DNS label of at most 63 characters
+var namespaceRegex = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]{0,61}[a-z0-9])?$`)
+
+// newValidationError returns the validation failure of the field, with the message formatted from the format and args
+func newValidationError(field string, format string, args ...interface{}) *common.ValidationError {
+	return &common.ValidationError{Field: field, Message: fmt.Sprintf(format, args...)}
+}
+
+// agentPoolField returns the path of the field of the agent pool
+func agentPoolField(poolName string, field string) string {
+	return fmt.Sprintf("agentPoolProfiles[%s].%s", poolName, field)
+}
+
+// azureCNIVersionRegex matches a release tag of the Azure VNET CNI plugin, or latest
+var azureCNIVersionRegex = regexp.MustCompile(`^(latest|v[0-9]+\.[0-9]+(\.[0-9]+)?)$`)
+
+// marketplaceImageNameRegex matches the publisher, offer or sku of an Azure marketplace image
+var marketplaceImageNameRegex = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9._-]{0,63}$`)
+
+// marketplaceImageVersionRegex matches the version of an Azure marketplace image, or latest
+var marketplaceImageVersionRegex = regexp.MustCompile(`^(latest|[0-9]+\.[0-9]+\.[0-9]+)$`)
+
+// hostnameRegex matches a host name made of DNS labels of at most 63 characters
+var hostnameRegex = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?(\.[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)*$`)
+
+// dataDiskMountPointRegex matches an absolute path made of characters safe to use unquoted in the mount script
+var dataDiskMountPointRegex = regexp.MustCompile(`^(/[a-zA-Z0-9._-]+)+$`)
+
+// labelValueRegex matches a Kubernetes label value of at most 63 characters
+var labelValueRegex = regexp.MustCompile(`^[a-zA-Z0-9]([-a-zA-Z0-9_.]{0,61}[a-zA-Z0-9])?$`)
+
+var evictionThresholdValueRegex = regexp.MustCompile(`^([0-9]+(\.[0-9]+)?(Ki|Mi|Gi|Ti|Pi|Ei|k|M|G|T|P|E)?|[0-9]+(\.[0-9]+)?%)$`)
+
+// ValidateOrchestratorVersion checks that the version is one of the SupportedOrchestratorVersions of the
+// orchestrator, or empty to select its default version. Any version is accepted for orchestrators
+// without selectable versions.
+func ValidateOrchestratorVersion(t OrchestratorType, v OrchestratorVersion) error {
+	versions, ok := SupportedOrchestratorVersions[t]
+	if !ok {
+		return fmt.Errorf("OrchestratorProfile has unknown orchestrator: %s", t)
+	}
+	if v == "" || len(versions) == 0 {
+		return nil
+	}
+	supported := []string{}
+	for _, version := range versions {
+		if v == version {
+			return nil
 		}
+		supported = append(supported, string(version))
+	}
+	return fmt.Errorf("OrchestratorProfile has unknown orchestrator version: %s, the supported versions of %s are %s", v, t, strings.Join(supported, ", "))
+}
 
-	default:
-		return fmt.Errorf("OrchestratorProfile has unknown orchestrator: %s", o.OrchestratorType)
+// Validate implements APIObject
+func (o *OrchestratorProfile) Validate() error {
+	if e := ValidateOrchestratorVersion(o.OrchestratorType, o.OrchestratorVersion); e != nil {
+		return e
+	}
+
+	if o.OrchestratorType == Kubernetes && o.KubernetesConfig != nil {
+		if e := o.KubernetesConfig.Validate(); e != nil {
+			return e
+		}
 	}
 
-	if o.OrchestratorType != Kubernetes && o.KubernetesConfig != nil && (*o.KubernetesConfig != KubernetesConfig{}) {
+	if o.OrchestratorType != Kubernetes && o.KubernetesConfig != nil && !reflect.DeepEqual(*o.KubernetesConfig, KubernetesConfig{}) {
 		return fmt.Errorf("KubernetesConfig can be specified only when OrchestratorType is Kubernetes")
 	}
 
@@ -58,9 +116,6 @@ func (o *OrchestratorProfile) Validate() error {
 
 // Validate implements APIObject
 func (m *MasterProfile) Validate() error {
-	if m.Count != 1 && m.Count != 3 && m.Count != 5 {
-		return fmt.Errorf("MasterProfile count needs to be 1, 3, or 5")
-	}
 	if e := validateName(m.DNSPrefix, "MasterProfile.DNSPrefix"); e != nil {
 		return e
 	}
@@ -70,8 +125,8 @@ func (m *MasterProfile) Validate() error {
 	if e := validateName(m.VMSize, "MasterProfile.VMSize"); e != nil {
 		return e
 	}
-	if m.OSDiskSizeGB != 0 && (m.OSDiskSizeGB < MinDiskSizeGB || m.OSDiskSizeGB > MaxDiskSizeGB) {
-		return fmt.Errorf("Invalid master os disk size of %d specified.  The range of valid values are [%d, %d]", m.OSDiskSizeGB, MinDiskSizeGB, MaxDiskSizeGB)
+	if e := m.ValidateOSDiskSize(); e != nil {
+		return e
 	}
 	if m.IPAddressCount != 0 && (m.IPAddressCount < MinIPAddressCount || m.IPAddressCount > MaxIPAddressCount) {
 		return fmt.Errorf("MasterProfile.IPAddressCount needs to be in the range [%d,%d]", MinIPAddressCount, MaxIPAddressCount)
@@ -90,28 +145,26 @@ func (a *AgentPoolProfile) Validate(orchestratorType OrchestratorType) error {
 	if e := validatePoolName(a.Name); e != nil {
 		return e
 	}
-	if a.Count < MinAgentCount || a.Count > MaxAgentCount {
-		return fmt.Errorf("AgentPoolProfile count needs to be in the range [%d,%d]", MinAgentCount, MaxAgentCount)
+	if e := a.ValidateCount(); e != nil {
+		return e
 	}
 	if e := validateName(a.VMSize, "AgentPoolProfile.VMSize"); e != nil {
 		return e
 	}
-	if a.OSDiskSizeGB != 0 && (a.OSDiskSizeGB < MinDiskSizeGB || a.OSDiskSizeGB > MaxDiskSizeGB) {
-		return fmt.Errorf("Invalid os disk size of %d specified.  The range of valid values are [%d, %d]", a.OSDiskSizeGB, MinDiskSizeGB, MaxDiskSizeGB)
+	if e := a.ValidateOSDiskSize(); e != nil {
+		return e
+	}
+	if e := a.ValidateAvailabilityZones(); e != nil {
+		return e
 	}
 	if a.DNSPrefix != "" {
 		if e := validateDNSName(a.DNSPrefix); e != nil {
 			return e
 		}
 		if len(a.Ports) > 0 {
-			if e := validateUniquePorts(a.Ports, a.Name); e != nil {
+			if e := a.ValidatePorts(); e != nil {
 				return e
 			}
-			for _, port := range a.Ports {
-				if port < MinPort || port > MaxPort {
-					return fmt.Errorf("AgentPoolProfile Ports must be in the range[%d, %d]", MinPort, MaxPort)
-				}
-			}
 		} else {
 			a.Ports = []int{80, 443, 8080}
 		}
@@ -156,9 +209,160 @@ func (a *AgentPoolProfile) Validate(orchestratorType OrchestratorType) error {
 	if e := validateStorageProfile(a.StorageProfile); e != nil {
 		return e
 	}
+	if e := a.validateStorageAccountType(); e != nil {
+		return e
+	}
+	if e := a.validateStorageAccountsPerPool(); e != nil {
+		return e
+	}
+	if e := a.validateHostnamePrefix(orchestratorType); e != nil {
+		return e
+	}
+	if e := a.validateEvictionThresholds(orchestratorType); e != nil {
+		return e
+	}
+	if e := a.validatePreloadImages(orchestratorType); e != nil {
+		return e
+	}
+	if e := a.validateDataDiskMounts(orchestratorType); e != nil {
+		return e
+	}
+	return nil
+}
+
+// validateDataDiskMounts checks that each data disk mount refers to a data disk, formats it with a
+// supported filesystem, uses recognized mount options and mounts it on a distinct absolute path
+func (a *AgentPoolProfile) validateDataDiskMounts(orchestratorType OrchestratorType) error {
+	if len(a.DataDiskMounts) == 0 {
+		return nil
+	}
+	if orchestratorType != Kubernetes {
+		return fmt.Errorf("AgentPoolProfile.DataDiskMounts is not supported for agent pool '%s' with Orchestrator %s", a.Name, orchestratorType)
+	}
+	if a.OSType == Windows {
+		return fmt.Errorf("AgentPoolProfile.DataDiskMounts is not supported for Windows agent pool '%s'", a.Name)
+	}
+	if len(a.DataDiskMounts) > len(a.DiskSizesGB) {
+		return fmt.Errorf("AgentPoolProfile.DataDiskMounts of agent pool '%s' has %d mounts but only %d data disks in DiskSizesGB", a.Name, len(a.DataDiskMounts), len(a.DiskSizesGB))
+	}
+	mountPoints := map[string]bool{}
+	for lun, mount := range a.DataDiskMounts {
+		if mount.FSType != "" && !SupportedDataDiskFSTypes[mount.FSType] {
+			return fmt.Errorf("AgentPoolProfile.DataDiskMounts of agent pool '%s' formats lun %d with '%s', which is not one of the supported filesystems ext4 and xfs", a.Name, lun, mount.FSType)
+		}
+		for _, option := range mount.MountOptions {
+			if !RecognizedDataDiskMountOptions[option] {
+				return fmt.Errorf("AgentPoolProfile.DataDiskMounts of agent pool '%s' mounts lun %d with the unrecognized option '%s'", a.Name, lun, option)
+			}
+		}
+		if mount.MountPoint == "" {
+			continue
+		}
+		if !dataDiskMountPointRegex.MatchString(mount.MountPoint) || path.Clean(mount.MountPoint) != mount.MountPoint || mount.MountPoint == "/" {
+			return fmt.Errorf("AgentPoolProfile.DataDiskMounts of agent pool '%s' mounts lun %d on '%s', which is not a clean absolute path other than /", a.Name, lun, mount.MountPoint)
+		}
+		if mountPoints[mount.MountPoint] {
+			return fmt.Errorf("AgentPoolProfile.DataDiskMounts of agent pool '%s' mounts more than one data disk on '%s'", a.Name, mount.MountPoint)
+		}
+		mountPoints[mount.MountPoint] = true
+	}
+	return nil
+}
+
+func (a *AgentPoolProfile) validatePreloadImages(orchestratorType OrchestratorType) error {
+	if !a.HasPreloadImages() {
+		return nil
+	}
+	if orchestratorType != Kubernetes {
+		return fmt.Errorf("AgentPoolProfile.PreloadImages is not supported for agent pool '%s' with Orchestrator %s", a.Name, orchestratorType)
+	}
+	if a.OSType == Windows {
+		return fmt.Errorf("AgentPoolProfile.PreloadImages is not supported for Windows agent pool '%s'", a.Name)
+	}
+	for _, image := range a.PreloadImages {
+		if !imageReferenceRegex.MatchString(image) {
+			return fmt.Errorf("AgentPoolProfile.PreloadImages of agent pool '%s' contains '%s', which is not a valid image reference such as myregistry.azurecr.io/myimage:1.0", a.Name, image)
+		}
+	}
+	return nil
+}
+
+func (a *AgentPoolProfile) validateEvictionThresholds(orchestratorType OrchestratorType) error {
+	if a.EvictionHard == "" && a.EvictionSoft == "" && a.EvictionSoftGracePeriod == "" {
+		return nil
+	}
+	if orchestratorType != Kubernetes {
+		return fmt.Errorf("eviction thresholds are not supported for agent pool '%s' with Orchestrator %s", a.Name, orchestratorType)
+	}
+	if a.OSType == Windows {
+		return fmt.Errorf("eviction thresholds are not supported for Windows agent pool '%s'", a.Name)
+	}
+	return validateEvictionThresholds(a.EvictionHard, a.EvictionSoft, a.EvictionSoftGracePeriod, fmt.Sprintf("AgentPoolProfile '%s'", a.Name))
+}
+
+func (a *AgentPoolProfile) validateHostnamePrefix(orchestratorType OrchestratorType) error {
+	if a.HostnamePrefix == "" {
+		return nil
+	}
+	// the Azure cloud provider requires the node name to match the VM name, so hostnames cannot be customized for Kubernetes
+	if orchestratorType == Kubernetes {
+		return fmt.Errorf("AgentPoolProfile.HostnamePrefix is not supported with Orchestrator %s", orchestratorType)
+	}
+	if !hostnamePrefixRegex.MatchString(a.HostnamePrefix) {
+		return fmt.Errorf("AgentPoolProfile.HostnamePrefix '%s' of agent pool '%s' is invalid. The prefix must start with a lowercase letter and only have characters a-z0-9 and hyphens", a.HostnamePrefix, a.Name)
+	}
+	maxLength := MaxLinuxHostnameLength
+	if a.OSType == Windows {
+		maxLength = MaxWindowsHostnameLength
+	}
+	if len(a.HostnamePrefix)+HostnameSuffixLength > maxLength {
+		return fmt.Errorf("AgentPoolProfile.HostnamePrefix '%s' of agent pool '%s' is too long. The prefix can have at most %d characters since hostnames are limited to %d characters", a.HostnamePrefix, a.Name, maxLength-HostnameSuffixLength, maxLength)
+	}
+	return nil
+}
+
+func (a *AgentPoolProfile) validateStorageAccountsPerPool() error {
+	if a.StorageAccountsPerPool == 0 {
+		return nil
+	}
+	if a.StorageProfile == ManagedDisks {
+		return fmt.Errorf("AgentPoolProfile.StorageAccountsPerPool may only be specified for agent pool '%s' when StorageProfile is '%s'", a.Name, StorageAccount)
+	}
+	if a.AvailabilityProfile != AvailabilitySet {
+		return fmt.Errorf("AgentPoolProfile.StorageAccountsPerPool may only be specified for agent pool '%s' when AvailabilityProfile is '%s'", a.Name, AvailabilitySet)
+	}
+	minStorageAccounts := (a.Count + MaxVMsPerStorageAccount - 1) / MaxVMsPerStorageAccount
+	if a.StorageAccountsPerPool < minStorageAccounts || a.StorageAccountsPerPool > MaxStorageAccountsPerPool {
+		return fmt.Errorf("AgentPoolProfile.StorageAccountsPerPool for agent pool '%s' with count %d needs to be in the range [%d,%d]", a.Name, a.Count, minStorageAccounts, MaxStorageAccountsPerPool)
+	}
+	return nil
+}
+
+func (a *AgentPoolProfile) validateStorageAccountType() error {
+	if a.StorageAccountType == "" {
+		return nil
+	}
+	if a.StorageProfile == ManagedDisks {
+		return fmt.Errorf("AgentPoolProfile.StorageAccountType may only be specified for agent pool '%s' when StorageProfile is '%s'", a.Name, StorageAccount)
+	}
+	switch a.StorageAccountType {
+	case StandardLRS:
+	case PremiumLRS:
+		if !isPremiumStorageVMSize(a.VMSize) {
+			return fmt.Errorf("AgentPoolProfile.StorageAccountType '%s' is not supported by VM size '%s' of agent pool '%s'", a.StorageAccountType, a.VMSize, a.Name)
+		}
+	default:
+		return fmt.Errorf("Unknown AgentPoolProfile.StorageAccountType '%s' for agent pool '%s'. Specify either %s or %s", a.StorageAccountType, a.Name, StandardLRS, PremiumLRS)
+	}
 	return nil
 }
 
+// isPremiumStorageVMSize returns true if the VM size supports premium storage,
+// which is the case for the DS, GS and the 's' variants of the F, L and M series
+func isPremiumStorageVMSize(vmSize string) bool {
+	return premiumStorageVMSizeRegex.MatchString(vmSize)
+}
+
 func validateKeyVaultSecrets(secrets []KeyVaultSecrets, requireCertificateStore bool) error {
 	for _, s := range secrets {
 		if len(s.VaultCertificates) == 0 {
@@ -187,18 +391,200 @@ func (l *LinuxProfile) Validate() error {
 	if e := validateName(l.AdminUsername, "LinuxProfile.AdminUsername"); e != nil {
 		return e
 	}
-	if len(l.SSH.PublicKeys) != 1 {
-		return errors.New("LinuxProfile.PublicKeys requires only 1 SSH Key")
+	if e := l.validatePublicKeys(); e != nil {
+		return e
 	}
-	if e := validateName(l.SSH.PublicKeys[0].KeyData, "LinuxProfile.PublicKeys.KeyData"); e != nil {
+	if e := l.validateHostAliases(); e != nil {
 		return e
 	}
+	if len(l.SSH.PublicKeys) != 1 {
+		return errors.New("LinuxProfile.PublicKeys requires only 1 SSH Key")
+	}
 	if e := validateKeyVaultSecrets(l.Secrets, false); e != nil {
 		return e
 	}
 	return nil
 }
 
+// Validate implements APIObject
+func (w *WindowsProfile) Validate() error {
+	if w.AdminUsername == "" {
+		return newValidationError("windowsProfile.adminUsername", "must be a non-empty value")
+	}
+	for _, forbidden := range ForbiddenWindowsAdminUsernames {
+		if strings.EqualFold(w.AdminUsername, forbidden) {
+			return newValidationError("windowsProfile.adminUsername", "'%s' is not allowed by Azure", w.AdminUsername)
+		}
+	}
+	if w.AdminPassword == "" {
+		return newValidationError("windowsProfile.adminPassword", "must be a non-empty value")
+	}
+	// the complexity of a password kept in a keyvault cannot be checked
+	if strings.HasPrefix(w.AdminPassword, "/subscriptions/") {
+		if !common.KeyVaultSecretRefRegex.MatchString(w.AdminPassword) {
+			return newValidationError("windowsProfile.adminPassword", "'%s' is not a valid keyvault secret reference", w.AdminPassword)
+		}
+		return nil
+	}
+	if len(w.AdminPassword) < MinWindowsPasswordLength || len(w.AdminPassword) > MaxWindowsPasswordLength {
+		return newValidationError("windowsProfile.adminPassword", "must be between %d and %d characters long", MinWindowsPasswordLength, MaxWindowsPasswordLength)
+	}
+	var hasUpper, hasLower, hasDigit, hasSpecial bool
+	for _, c := range w.AdminPassword {
+		switch {
+		case unicode.IsUpper(c):
+			hasUpper = true
+		case unicode.IsLower(c):
+			hasLower = true
+		case unicode.IsDigit(c):
+			hasDigit = true
+		default:
+			hasSpecial = true
+		}
+	}
+	classes := 0
+	for _, has := range []bool{hasUpper, hasLower, hasDigit, hasSpecial} {
+		if has {
+			classes++
+		}
+	}
+	if classes < MinWindowsPasswordCharacterClasses {
+		return newValidationError("windowsProfile.adminPassword", "must contain characters from at least %d of the uppercase, lowercase, digit and special character classes", MinWindowsPasswordCharacterClasses)
+	}
+	return nil
+}
+
+// validateHostAliases checks that the entries added to the hosts file of the nodes have a valid
+// IP address and at least one well-formed host name
+func (l *LinuxProfile) validateHostAliases() error {
+	for i, alias := range l.HostAliases {
+		if net.ParseIP(alias.IP) == nil {
+			return fmt.Errorf("LinuxProfile.HostAliases[%d].IP '%s' is not a valid IP address", i, alias.IP)
+		}
+		if len(alias.Hostnames) == 0 {
+			return fmt.Errorf("LinuxProfile.HostAliases[%d].Hostnames must contain at least one host name", i)
+		}
+		for _, hostname := range alias.Hostnames {
+			if len(hostname) > MaxHostnameLength || !hostnameRegex.MatchString(hostname) {
+				return fmt.Errorf("LinuxProfile.HostAliases[%d].Hostnames entry '%s' is not a valid host name", i, hostname)
+			}
+		}
+	}
+	return nil
+}
+
+// validatePublicKeys checks that every SSH public key is a well-formed OpenSSH public key
+// and that no key is listed twice, whatever its comment
+func (l *LinuxProfile) validatePublicKeys() error {
+	seen := map[string]int{}
+	for i, publicKey := range l.SSH.PublicKeys {
+		label := fmt.Sprintf("LinuxProfile.PublicKeys[%d].KeyData", i)
+		if e := validateName(publicKey.KeyData, label); e != nil {
+			return e
+		}
+		key, e := parseSSHPublicKey(publicKey.KeyData)
+		if e != nil {
+			return fmt.Errorf("%s is not a valid OpenSSH public key: %v", label, e)
+		}
+		if j, ok := seen[key]; ok {
+			return fmt.Errorf("%s is the same key as LinuxProfile.PublicKeys[%d].KeyData", label, j)
+		}
+		seen[key] = i
+	}
+	return nil
+}
+
+// parseSSHPublicKey parses a public key in the OpenSSH authorized_keys format, "<type> <base64 key> [comment]",
+// and returns the key without its comment. The encoded key starts with its own type, which must match.
+func parseSSHPublicKey(keyData string) (string, error) {
+	fields := strings.Fields(keyData)
+	if len(fields) < 2 {
+		return "", errors.New("expected the key type followed by the base64 encoded key")
+	}
+	blob, err := base64.StdEncoding.DecodeString(fields[1])
+	if err != nil {
+		return "", fmt.Errorf("the key is not base64 encoded: %v", err)
+	}
+	if len(blob) < 4 || uint64(len(blob)-4) < uint64(binary.BigEndian.Uint32(blob)) {
+		return "", errors.New("the encoded key is truncated")
+	}
+	if encodedType := string(blob[4 : 4+binary.BigEndian.Uint32(blob)]); encodedType != fields[0] {
+		return "", fmt.Errorf("the key type '%s' does not match the encoded key type '%s'", fields[0], encodedType)
+	}
+	return fields[0] + " " + fields[1], nil
+}
+
+// Validate runs the validations of the profiles of the container service and of the properties, and returns
+// every failure, rather than the first, as ValidationErrors. The validations of a profile are skipped when it
+// is not specified, which the properties validation reports if it is required.
+func (cs *ContainerService) Validate() error {
+	p := cs.Properties
+	if p == nil {
+		return newValidationError("properties", "must be specified")
+	}
+
+	errs := common.ValidationErrors{}
+	reported := map[string]bool{}
+	reportedMessages := map[string]bool{}
+	// add records the failures of a validation. The field of an error that is not a ValidationError is the
+	// profile validated, and it is dropped if the failure was already reported with its field.
+	add := func(field string, e error) {
+		var failures common.ValidationErrors
+		switch err := e.(type) {
+		case nil:
+			return
+		case common.ValidationErrors:
+			failures = err
+		case *common.ValidationError:
+			failures = common.ValidationErrors{err}
+		default:
+			if reportedMessages[err.Error()] {
+				return
+			}
+			failures = common.ValidationErrors{&common.ValidationError{Field: field, Message: err.Error()}}
+		}
+		for _, failure := range failures {
+			if !reported[failure.Error()] {
+				reported[failure.Error()] = true
+				reportedMessages[failure.Message] = true
+				errs = append(errs, failure)
+			}
+		}
+	}
+
+	var orchestratorType OrchestratorType
+	if p.OrchestratorProfile != nil {
+		orchestratorType = p.OrchestratorProfile.OrchestratorType
+		add("orchestratorProfile", p.OrchestratorProfile.Validate())
+	}
+	if p.MasterProfile != nil {
+		add("masterProfile", p.MasterProfile.Validate())
+		if p.OrchestratorProfile != nil {
+			add("masterProfile", p.MasterProfile.ValidateCount(orchestratorType))
+		}
+		add("masterProfile", p.ValidateVNET())
+	}
+	add("agentPoolProfiles", p.ValidateAgentPoolNames())
+	for _, agentPoolProfile := range p.AgentPoolProfiles {
+		add(fmt.Sprintf("agentPoolProfiles[%s]", agentPoolProfile.Name), agentPoolProfile.Validate(orchestratorType))
+	}
+	add("properties", p.ValidateDNSPrefixes())
+	if p.LinuxProfile != nil {
+		add("linuxProfile", p.LinuxProfile.Validate())
+	}
+	if p.WindowsProfile != nil && p.HasWindows() {
+		add("windowsProfile", p.WindowsProfile.Validate())
+		add("windowsProfile", p.WindowsProfile.ValidateCustomImage())
+	}
+	// the checks across profiles stop at the first failure
+	add("properties", p.Validate())
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
 // Validate implements APIObject
 func (a *Properties) Validate() error {
 	if a.OrchestratorProfile == nil {
@@ -216,10 +602,61 @@ func (a *Properties) Validate() error {
 	if e := a.validateNetworkPolicy(); e != nil {
 		return e
 	}
+	if e := a.validateMasterCount(); e != nil {
+		return e
+	}
 	if e := a.MasterProfile.Validate(); e != nil {
 		return e
 	}
-	if e := validateUniqueProfileNames(a.AgentPoolProfiles); e != nil {
+	if e := a.ValidateAgentPoolNames(); e != nil {
+		return e
+	}
+	if e := validateUniqueHostnamePrefixes(a.AgentPoolProfiles); e != nil {
+		return e
+	}
+	if e := a.validateSecurityRules(); e != nil {
+		return e
+	}
+	if e := a.validatePrivateRegistry(); e != nil {
+		return e
+	}
+	if e := a.validateRuntimeReservedMilliCPU(); e != nil {
+		return e
+	}
+	if e := a.validateGPUTaint(); e != nil {
+		return e
+	}
+	if e := a.validateServiceAccountKeys(); e != nil {
+		return e
+	}
+	if e := a.validateBlockPodIMDSAccess(); e != nil {
+		return e
+	}
+	if e := a.validateAddonsReadOnlyRootFilesystem(); e != nil {
+		return e
+	}
+	if e := a.validateDefaultDenyNamespaces(); e != nil {
+		return e
+	}
+	if e := a.validateCloudProviderRateLimit(); e != nil {
+		return e
+	}
+	if e := a.validateCNIVersions(); e != nil {
+		return e
+	}
+	if e := a.validateKubeProxy(); e != nil {
+		return e
+	}
+	if e := a.validateImageDigests(); e != nil {
+		return e
+	}
+	if e := a.validateEtcdDeploymentMode(); e != nil {
+		return e
+	}
+	if e := a.validateTopologyLabels(); e != nil {
+		return e
+	}
+	if e := a.validateCustomBootstrap(); e != nil {
 		return e
 	}
 	if a.OrchestratorProfile.OrchestratorType == Kubernetes && len(a.ServicePrincipalProfile.ClientID) == 0 {
@@ -283,12 +720,8 @@ func (a *Properties) Validate() error {
 			if a.WindowsProfile == nil {
 				return fmt.Errorf("missing WindowsProfile")
 			}
-			switch a.OrchestratorProfile.OrchestratorType {
-			case Swarm:
-			case SwarmMode:
-			case Kubernetes:
-			default:
-				return fmt.Errorf("Orchestrator %s does not support Windows", a.OrchestratorProfile.OrchestratorType)
+			if e := validateWindowsSupport(a.OrchestratorProfile); e != nil {
+				return e
 			}
 
 			if a.WindowsProfile == nil {
@@ -301,15 +734,27 @@ func (a *Properties) Validate() error {
 			if len(a.WindowsProfile.AdminPassword) == 0 {
 				return fmt.Errorf("WindowsProfile.AdminPassword must not be empty since agent pool '%s' specifies windows", agentPoolProfile.Name)
 			}
+			if e := a.WindowsProfile.Validate(); e != nil {
+				return e
+			}
+			if e := a.WindowsProfile.ValidateCustomImage(); e != nil {
+				return e
+			}
 			if e := validateKeyVaultSecrets(a.WindowsProfile.Secrets, true); e != nil {
 				return e
 			}
 		}
 	}
+	if e := a.validateDCOSPublicPools(); e != nil {
+		return e
+	}
 	if e := a.LinuxProfile.Validate(); e != nil {
 		return e
 	}
-	if e := validateVNET(a); e != nil {
+	if len(a.LinuxProfile.HostAliases) > 0 && a.OrchestratorProfile.OrchestratorType != Kubernetes {
+		return fmt.Errorf("LinuxProfile.HostAliases is only supported with Orchestrator %s", Kubernetes)
+	}
+	if e := a.ValidateVNET(); e != nil {
 		return e
 	}
 	return nil
@@ -331,19 +776,178 @@ func (a *KubernetesConfig) Validate() error {
 		}
 	}
 
-	return nil
-}
+	if e := a.validateEtcdTimeouts(); e != nil {
+		return e
+	}
 
-func (a *Properties) validateNetworkPolicy() error {
-	var networkPolicy string
+	if e := a.validateMasterLBProbe(); e != nil {
+		return e
+	}
 
-	switch a.OrchestratorProfile.OrchestratorType {
-	case Kubernetes:
-		if a.OrchestratorProfile.KubernetesConfig != nil {
-			networkPolicy = a.OrchestratorProfile.KubernetesConfig.NetworkPolicy
-		}
-	default:
-		return nil
+	if e := a.validateProvisionRetries(); e != nil {
+		return e
+	}
+
+	if e := validateEvictionThresholds(a.EvictionHard, a.EvictionSoft, a.EvictionSoftGracePeriod, "OrchestratorProfile.KubernetesConfig"); e != nil {
+		return e
+	}
+
+	if e := a.validateAPIServerPort(); e != nil {
+		return e
+	}
+
+	if e := a.validateArtifactChecksums(); e != nil {
+		return e
+	}
+
+	if e := a.validateLeaderElection(); e != nil {
+		return e
+	}
+
+	if a.ClusterName != "" && !labelValueRegex.MatchString(a.ClusterName) {
+		return fmt.Errorf("OrchestratorProfile.KubernetesConfig.ClusterName '%s' is not a valid label value, it must be at most 63 alphanumeric characters, '-', '_' or '.', starting and ending with an alphanumeric character", a.ClusterName)
+	}
+
+	return nil
+}
+
+// validateLeaderElection checks the leader election durations of the controller manager and
+// scheduler. They are set together so that the ordering required by Kubernetes,
+// lease duration > renew deadline > 1.2 * retry period, can be checked.
+func (a *KubernetesConfig) validateLeaderElection() error {
+	if a.LeaderElectLeaseDuration == "" && a.LeaderElectRenewDeadline == "" && a.LeaderElectRetryPeriod == "" {
+		return nil
+	}
+	if a.LeaderElectLeaseDuration == "" || a.LeaderElectRenewDeadline == "" || a.LeaderElectRetryPeriod == "" {
+		return fmt.Errorf("OrchestratorProfile.KubernetesConfig.LeaderElectLeaseDuration, LeaderElectRenewDeadline and LeaderElectRetryPeriod must be specified together")
+	}
+	durations := map[string]time.Duration{}
+	for _, d := range []struct {
+		name  string
+		value string
+	}{
+		{"LeaderElectLeaseDuration", a.LeaderElectLeaseDuration},
+		{"LeaderElectRenewDeadline", a.LeaderElectRenewDeadline},
+		{"LeaderElectRetryPeriod", a.LeaderElectRetryPeriod},
+	} {
+		duration, err := time.ParseDuration(d.value)
+		if err != nil {
+			return fmt.Errorf("OrchestratorProfile.KubernetesConfig.%s '%s' is not a valid duration", d.name, d.value)
+		}
+		if duration <= 0 {
+			return fmt.Errorf("OrchestratorProfile.KubernetesConfig.%s '%s' must be positive", d.name, d.value)
+		}
+		durations[d.name] = duration
+	}
+	if durations["LeaderElectLeaseDuration"] <= durations["LeaderElectRenewDeadline"] {
+		return fmt.Errorf("OrchestratorProfile.KubernetesConfig.LeaderElectLeaseDuration '%s' must be greater than LeaderElectRenewDeadline '%s'", a.LeaderElectLeaseDuration, a.LeaderElectRenewDeadline)
+	}
+	if float64(durations["LeaderElectRenewDeadline"]) <= LeaderElectJitterFactor*float64(durations["LeaderElectRetryPeriod"]) {
+		return fmt.Errorf("OrchestratorProfile.KubernetesConfig.LeaderElectRenewDeadline '%s' must be greater than %v times LeaderElectRetryPeriod '%s'", a.LeaderElectRenewDeadline, LeaderElectJitterFactor, a.LeaderElectRetryPeriod)
+	}
+	return nil
+}
+
+// validateArtifactChecksums checks that the artifact checksums are hex encoded SHA256 checksums, and
+// that the Azure VNET CNI checksum is only set for a pinned release, as the latest tarball changes
+func (a *KubernetesConfig) validateArtifactChecksums() error {
+	for _, c := range []struct {
+		name     string
+		checksum string
+	}{
+		{"AzureCNIChecksum", a.AzureCNIChecksum},
+		{"WindowsBinariesChecksum", a.WindowsBinariesChecksum},
+	} {
+		if c.checksum != "" && !sha256ChecksumRegex.MatchString(c.checksum) {
+			return fmt.Errorf("OrchestratorProfile.KubernetesConfig.%s '%s' is not a hex encoded SHA256 checksum of 64 characters", c.name, c.checksum)
+		}
+	}
+	if a.AzureCNIChecksum != "" && (a.AzureCNIVersion == "" || a.AzureCNIVersion == "latest") {
+		return fmt.Errorf("OrchestratorProfile.KubernetesConfig.AzureCNIChecksum requires AzureCNIVersion to be pinned to a release such as v0.8, as the latest tarball changes with every release")
+	}
+	return nil
+}
+
+// validateAPIServerPort checks that the apiserver port is valid and does not collide with
+// the NodePort range or with ports used by other components on the masters
+func (a *KubernetesConfig) validateAPIServerPort() error {
+	port := a.APIServerPort
+	if port == 0 {
+		return nil
+	}
+	if port < MinPort || port > MaxPort {
+		return fmt.Errorf("OrchestratorProfile.KubernetesConfig.APIServerPort is %d and must be in the range [%d, %d]", port, MinPort, MaxPort)
+	}
+	if port >= MinNodePort && port <= MaxNodePort {
+		return fmt.Errorf("OrchestratorProfile.KubernetesConfig.APIServerPort is %d, which collides with the NodePort range [%d, %d]", port, MinNodePort, MaxNodePort)
+	}
+	for _, reserved := range ReservedMasterPorts {
+		if port == reserved {
+			return fmt.Errorf("OrchestratorProfile.KubernetesConfig.APIServerPort is %d, which is already used on the masters", port)
+		}
+	}
+	return nil
+}
+
+// validateEtcdTimeouts checks that the etcd election timeout, as configured or defaulted, lasts at least
+// MinEtcdElectionTimeoutHeartbeats heartbeat intervals
+func (a *KubernetesConfig) validateEtcdTimeouts() error {
+	heartbeat := common.DefaultEtcdHeartbeatIntervalMs
+	if a.EtcdHeartbeatIntervalMs != nil {
+		heartbeat = *a.EtcdHeartbeatIntervalMs
+		if heartbeat < 1 {
+			return fmt.Errorf("OrchestratorProfile.KubernetesConfig.EtcdHeartbeatIntervalMs is %d and must be positive", heartbeat)
+		}
+	}
+	election := common.DefaultEtcdElectionTimeoutMs
+	if a.EtcdElectionTimeoutMs != nil {
+		election = *a.EtcdElectionTimeoutMs
+		if election < 1 || election > MaxEtcdElectionTimeoutMs {
+			return fmt.Errorf("OrchestratorProfile.KubernetesConfig.EtcdElectionTimeoutMs is %d and must be in the range [1, %d]", election, MaxEtcdElectionTimeoutMs)
+		}
+	}
+	if election < MinEtcdElectionTimeoutHeartbeats*heartbeat {
+		return fmt.Errorf("OrchestratorProfile.KubernetesConfig.EtcdElectionTimeoutMs is %d and must be at least %d times EtcdHeartbeatIntervalMs %d", election, MinEtcdElectionTimeoutHeartbeats, heartbeat)
+	}
+	return nil
+}
+
+// validateMasterLBProbe checks the master load balancer probe settings against the ranges allowed by Azure.
+// The probe itself always targets the apiserver port on the masters.
+func (a *KubernetesConfig) validateMasterLBProbe() error {
+	interval := a.MasterLBProbeIntervalInSeconds
+	if interval != 0 && interval < MinLBProbeIntervalInSeconds {
+		return fmt.Errorf("OrchestratorProfile.KubernetesConfig.MasterLBProbeIntervalInSeconds is %d and must be at least %d", interval, MinLBProbeIntervalInSeconds)
+	}
+	probes := a.MasterLBProbeNumberOfProbes
+	if probes != 0 && probes < MinLBProbeNumberOfProbes {
+		return fmt.Errorf("OrchestratorProfile.KubernetesConfig.MasterLBProbeNumberOfProbes is %d and must be at least %d", probes, MinLBProbeNumberOfProbes)
+	}
+	return nil
+}
+
+// validateProvisionRetries checks the number and timeout of the download attempts made while
+// provisioning. 0, the value of an unset field, selects the default.
+func (a *KubernetesConfig) validateProvisionRetries() error {
+	if a.ProvisionRetryCount < 0 || a.ProvisionRetryCount > MaxProvisionRetryCount {
+		return fmt.Errorf("OrchestratorProfile.KubernetesConfig.ProvisionRetryCount is %d and must be in the range [1, %d], or 0 for the default", a.ProvisionRetryCount, MaxProvisionRetryCount)
+	}
+	if a.ProvisionTimeoutInSeconds < 0 || a.ProvisionTimeoutInSeconds > MaxProvisionTimeoutInSeconds {
+		return fmt.Errorf("OrchestratorProfile.KubernetesConfig.ProvisionTimeoutInSeconds is %d and must be in the range [1, %d], or 0 for the default", a.ProvisionTimeoutInSeconds, MaxProvisionTimeoutInSeconds)
+	}
+	return nil
+}
+
+func (a *Properties) validateNetworkPolicy() error {
+	var networkPolicy string
+
+	switch a.OrchestratorProfile.OrchestratorType {
+	case Kubernetes:
+		if a.OrchestratorProfile.KubernetesConfig != nil {
+			networkPolicy = a.OrchestratorProfile.KubernetesConfig.NetworkPolicy
+		}
+	default:
+		return nil
 	}
 
 	// Check NetworkPolicy has a valid value.
@@ -366,6 +970,47 @@ func (a *Properties) validateNetworkPolicy() error {
 	return nil
 }
 
+// validateWindowsSupport checks the orchestrator type and version against the windowsSupportMatrix
+func validateWindowsSupport(o *OrchestratorProfile) error {
+	versions, ok := windowsSupportMatrix[o.OrchestratorType]
+	if !ok {
+		return fmt.Errorf("Orchestrator %s does not support Windows", o.OrchestratorType)
+	}
+	if len(versions) == 0 || len(o.OrchestratorVersion) == 0 {
+		return nil
+	}
+	for _, version := range versions {
+		if version == o.OrchestratorVersion {
+			return nil
+		}
+	}
+	return fmt.Errorf("Orchestrator %s version %s does not support Windows", o.OrchestratorType, o.OrchestratorVersion)
+}
+
+func (a *Properties) validateMasterCount() error {
+	return a.MasterProfile.ValidateCount(a.OrchestratorProfile.OrchestratorType)
+}
+
+func (a *Properties) validateDCOSPublicPools() error {
+	if a.OrchestratorProfile.OrchestratorType != DCOS {
+		return nil
+	}
+	publicPools := 0
+	for _, agentPoolProfile := range a.AgentPoolProfiles {
+		if !agentPoolProfile.IsDCOSPublicPool() {
+			continue
+		}
+		if len(agentPoolProfile.DNSPrefix) == 0 {
+			return fmt.Errorf("DCOS public agent pool '%s' must specify a DNSPrefix for its load balancer", agentPoolProfile.Name)
+		}
+		publicPools++
+	}
+	if publicPools > MaxDCOSPublicAgentPools {
+		return fmt.Errorf("DCOS clusters support at most %d public agent pool, %d were specified", MaxDCOSPublicAgentPools, publicPools)
+	}
+	return nil
+}
+
 func validateNameEmpty(name string, label string) error {
 	if name != "" {
 		return fmt.Errorf("%s must be an empty value", label)
@@ -406,14 +1051,664 @@ func validateDNSName(dnsName string) error {
 	return nil
 }
 
-func validateUniqueProfileNames(profiles []*AgentPoolProfile) error {
+// ValidateDNSPrefix checks that the prefix of the field is a valid Azure DNS label for a public endpoint:
+// between MinDNSPrefixLength and MaxDNSPrefixLength lowercase letters, digits and hyphens, starting and
+// ending with a letter or a digit
+func ValidateDNSPrefix(field string, prefix string) error {
+	if len(prefix) < MinDNSPrefixLength || len(prefix) > MaxDNSPrefixLength {
+		return newValidationError(field, "DNS prefix '%s' has %d characters and must have between %d and %d", prefix, len(prefix), MinDNSPrefixLength, MaxDNSPrefixLength)
+	}
+	for _, c := range prefix {
+		if !(c >= 'a' && c <= 'z') && !(c >= '0' && c <= '9') && c != '-' {
+			return newValidationError(field, "DNS prefix '%s' contains '%c' and must only contain lowercase letters, digits and hyphens", prefix, c)
+		}
+	}
+	if prefix[0] == '-' || prefix[len(prefix)-1] == '-' {
+		return newValidationError(field, "DNS prefix '%s' must start and end with a lowercase letter or a digit", prefix)
+	}
+	return nil
+}
+
+// ValidateDNSPrefixes checks the DNS prefix of the master and of every agent pool that has one with
+// ValidateDNSPrefix, and returns the failures of all of them
+func (a *Properties) ValidateDNSPrefixes() error {
+	errs := common.ValidationErrors{}
+	if a.MasterProfile != nil {
+		if e := ValidateDNSPrefix("masterProfile.dnsPrefix", a.MasterProfile.DNSPrefix); e != nil {
+			errs = append(errs, e.(*common.ValidationError))
+		}
+	}
+	for _, agentPoolProfile := range a.AgentPoolProfiles {
+		if agentPoolProfile.DNSPrefix == "" {
+			continue
+		}
+		if e := ValidateDNSPrefix(agentPoolField(agentPoolProfile.Name, "dnsPrefix"), agentPoolProfile.DNSPrefix); e != nil {
+			errs = append(errs, e.(*common.ValidationError))
+		}
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// ValidateAgentPoolNames checks that the names of the agent pools, which make up the names of their VMs and scale
+// sets, are valid pool names and are unique across pools
+func (a *Properties) ValidateAgentPoolNames() error {
+	errs := common.ValidationErrors{}
 	profileNames := make(map[string]bool)
-	for _, profile := range profiles {
+	for _, profile := range a.AgentPoolProfiles {
+		if e := validatePoolName(profile.Name); e != nil {
+			errs = append(errs, &common.ValidationError{Field: agentPoolField(profile.Name, "name"), Message: e.Error()})
+		}
 		if _, ok := profileNames[profile.Name]; ok {
-			return fmt.Errorf("profile name '%s' already exists, profile names must be unique across pools", profile.Name)
+			errs = append(errs, newValidationError(agentPoolField(profile.Name, "name"), "profile name '%s' already exists, profile names must be unique across pools", profile.Name))
 		}
 		profileNames[profile.Name] = true
 	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func validateUniqueHostnamePrefixes(profiles []*AgentPoolProfile) error {
+	prefixes := make(map[string]bool)
+	for _, profile := range profiles {
+		if profile.HostnamePrefix == "" {
+			continue
+		}
+		if _, ok := prefixes[profile.HostnamePrefix]; ok {
+			return fmt.Errorf("hostname prefix '%s' already exists, hostname prefixes must be unique across pools", profile.HostnamePrefix)
+		}
+		prefixes[profile.HostnamePrefix] = true
+	}
+	return nil
+}
+
+// validateEvictionThresholds checks kubelet eviction thresholds such as memory.available<100Mi,nodefs.available<10%,
+// and that every soft threshold has a grace period such as memory.available=1m30s
+func validateEvictionThresholds(hard, soft, softGracePeriod, owner string) error {
+	if _, e := parseEvictionThresholds(hard); e != nil {
+		return fmt.Errorf("%s.EvictionHard '%s' is invalid: %v", owner, hard, e)
+	}
+	softSignals, e := parseEvictionThresholds(soft)
+	if e != nil {
+		return fmt.Errorf("%s.EvictionSoft '%s' is invalid: %v", owner, soft, e)
+	}
+	graceSignals := make(map[string]bool)
+	if softGracePeriod != "" {
+		for _, gracePeriod := range strings.Split(softGracePeriod, ",") {
+			parts := strings.SplitN(gracePeriod, "=", 2)
+			if len(parts) != 2 || !isEvictionSignal(parts[0]) {
+				return fmt.Errorf("%s.EvictionSoftGracePeriod '%s' is invalid: '%s' is not of the form signal=duration", owner, softGracePeriod, gracePeriod)
+			}
+			if d, err := time.ParseDuration(parts[1]); err != nil || d < 0 {
+				return fmt.Errorf("%s.EvictionSoftGracePeriod '%s' is invalid: '%s' is not a valid duration", owner, softGracePeriod, parts[1])
+			}
+			graceSignals[parts[0]] = true
+		}
+	}
+	for signal := range softSignals {
+		if !graceSignals[signal] {
+			return fmt.Errorf("%s.EvictionSoft threshold for %s must have a matching grace period in %s.EvictionSoftGracePeriod", owner, signal, owner)
+		}
+	}
+	for signal := range graceSignals {
+		if !softSignals[signal] {
+			return fmt.Errorf("%s.EvictionSoftGracePeriod for %s has no matching threshold in %s.EvictionSoft", owner, signal, owner)
+		}
+	}
+	return nil
+}
+
+// parseEvictionThresholds parses a comma separated list of signal<quantity or signal<percentage thresholds
+// and returns the signals they apply to
+func parseEvictionThresholds(thresholds string) (map[string]bool, error) {
+	signals := make(map[string]bool)
+	if thresholds == "" {
+		return signals, nil
+	}
+	for _, threshold := range strings.Split(thresholds, ",") {
+		parts := strings.SplitN(threshold, "<", 2)
+		if len(parts) != 2 || !isEvictionSignal(parts[0]) {
+			return nil, fmt.Errorf("'%s' is not of the form signal<quantity", threshold)
+		}
+		if !evictionThresholdValueRegex.MatchString(parts[1]) {
+			return nil, fmt.Errorf("'%s' is not a quantity such as 100Mi or a percentage such as 10%%", parts[1])
+		}
+		if signals[parts[0]] {
+			return nil, fmt.Errorf("signal %s has more than one threshold", parts[0])
+		}
+		signals[parts[0]] = true
+	}
+	return signals, nil
+}
+
+func isEvictionSignal(signal string) bool {
+	for _, s := range EvictionSignalValues {
+		if signal == s {
+			return true
+		}
+	}
+	return false
+}
+
+// validateRuntimeReservedMilliCPU checks that the CPU reserved for the container runtime leaves
+// CPU for pods on the masters and on the nodes of each Linux agent pool. The reservation is
+// rejected for VM sizes missing from VMSizeCores, whose vCPUs are unknown.
+func (a *Properties) validateRuntimeReservedMilliCPU() error {
+	var clusterMilliCPU int
+	if a.OrchestratorProfile.KubernetesConfig != nil {
+		clusterMilliCPU = a.OrchestratorProfile.KubernetesConfig.RuntimeReservedMilliCPU
+	}
+	if clusterMilliCPU < 0 {
+		return fmt.Errorf("OrchestratorProfile.KubernetesConfig.RuntimeReservedMilliCPU is %d and must not be negative", clusterMilliCPU)
+	}
+	if e := validateRuntimeReservedMilliCPUFits(clusterMilliCPU, a.MasterProfile.VMSize, "the masters"); e != nil {
+		return e
+	}
+	for _, profile := range a.AgentPoolProfiles {
+		if profile.RuntimeReservedMilliCPU == 0 {
+			if profile.OSType != Windows {
+				if e := validateRuntimeReservedMilliCPUFits(clusterMilliCPU, profile.VMSize, fmt.Sprintf("agent pool '%s'", profile.Name)); e != nil {
+					return e
+				}
+			}
+			continue
+		}
+		if a.OrchestratorProfile.OrchestratorType != Kubernetes || profile.OSType == Windows {
+			return fmt.Errorf("AgentPoolProfile.RuntimeReservedMilliCPU of agent pool '%s' is only supported for Linux agent pools with Orchestrator %s", profile.Name, Kubernetes)
+		}
+		if profile.RuntimeReservedMilliCPU < 0 {
+			return fmt.Errorf("AgentPoolProfile.RuntimeReservedMilliCPU of agent pool '%s' is %d and must not be negative", profile.Name, profile.RuntimeReservedMilliCPU)
+		}
+		if e := validateRuntimeReservedMilliCPUFits(profile.RuntimeReservedMilliCPU, profile.VMSize, fmt.Sprintf("agent pool '%s'", profile.Name)); e != nil {
+			return e
+		}
+	}
+	return nil
+}
+
+// validateGPUTaint checks that the GPU taint is only requested for Linux Kubernetes agent pools with
+// NVIDIA GPUs. The taint is registered through the kubelet --register-with-taints flag, added in Kubernetes 1.6.
+func (a *Properties) validateGPUTaint() error {
+	for _, profile := range a.AgentPoolProfiles {
+		if profile.TaintGPUNodes == nil {
+			continue
+		}
+		if a.OrchestratorProfile.OrchestratorType != Kubernetes {
+			return fmt.Errorf("AgentPoolProfile.TaintGPUNodes of agent pool '%s' is only supported with Orchestrator %s", profile.Name, Kubernetes)
+		}
+		if !*profile.TaintGPUNodes {
+			continue
+		}
+		if profile.OSType == Windows {
+			return fmt.Errorf("AgentPoolProfile.TaintGPUNodes of agent pool '%s' is not supported for Windows agent pools", profile.Name)
+		}
+		if !profile.IsNSeriesVMSize() {
+			return fmt.Errorf("AgentPoolProfile.TaintGPUNodes of agent pool '%s' is only supported for GPU VM sizes, %s is not one", profile.Name, profile.VMSize)
+		}
+		switch a.OrchestratorProfile.OrchestratorVersion {
+		case Kubernetes153, Kubernetes157:
+			return fmt.Errorf("AgentPoolProfile.TaintGPUNodes of agent pool '%s' requires Kubernetes %s or later", profile.Name, Kubernetes160)
+		}
+	}
+	return nil
+}
+
+// validateBlockPodIMDSAccess checks that blocking pods from the instance metadata service is not requested
+// for clusters with Windows nodes, which the iptables rules cannot be applied to
+func (a *Properties) validateBlockPodIMDSAccess() error {
+	k := a.OrchestratorProfile.KubernetesConfig
+	if k == nil || k.BlockPodIMDSAccess == nil || !*k.BlockPodIMDSAccess {
+		return nil
+	}
+	for _, profile := range a.AgentPoolProfiles {
+		if profile.OSType == Windows {
+			return fmt.Errorf("OrchestratorProfile.KubernetesConfig.BlockPodIMDSAccess is not supported for Windows agent pools, agent pool '%s' is one", profile.Name)
+		}
+	}
+	return nil
+}
+
+// validateAddonsReadOnlyRootFilesystem checks that the cluster has a Linux agent pool when the addons run with a
+// read-only root filesystem, since the addons are only scheduled on Linux nodes
+func (a *Properties) validateAddonsReadOnlyRootFilesystem() error {
+	k := a.OrchestratorProfile.KubernetesConfig
+	if k == nil || k.AddonsReadOnlyRootFilesystem == nil || !*k.AddonsReadOnlyRootFilesystem {
+		return nil
+	}
+	for _, profile := range a.AgentPoolProfiles {
+		if profile.OSType != Windows {
+			return nil
+		}
+	}
+	return fmt.Errorf("OrchestratorProfile.KubernetesConfig.AddonsReadOnlyRootFilesystem requires a Linux agent pool, the addons only run on Linux nodes")
+}
+
+// validateCNIVersions checks that the Azure VNET CNI and Calico releases are only pinned with the
+// network policy that installs them, and that the Calico release supports the Kubernetes version
+func (a *Properties) validateCNIVersions() error {
+	k := a.OrchestratorProfile.KubernetesConfig
+	if k == nil {
+		return nil
+	}
+	if k.AzureCNIVersion != "" {
+		if k.NetworkPolicy != "azure" {
+			return fmt.Errorf("OrchestratorProfile.KubernetesConfig.AzureCNIVersion requires networkPolicy 'azure'")
+		}
+		if !azureCNIVersionRegex.MatchString(k.AzureCNIVersion) {
+			return fmt.Errorf("OrchestratorProfile.KubernetesConfig.AzureCNIVersion '%s' is not a release tag such as v0.8, or latest", k.AzureCNIVersion)
+		}
+	}
+	if k.CalicoVersion != "" {
+		if k.NetworkPolicy != "calico" {
+			return fmt.Errorf("OrchestratorProfile.KubernetesConfig.CalicoVersion requires networkPolicy 'calico'")
+		}
+		kubernetesVersions, ok := CalicoKubernetesVersions[k.CalicoVersion]
+		if !ok {
+			return fmt.Errorf("OrchestratorProfile.KubernetesConfig.CalicoVersion '%s' is not supported", k.CalicoVersion)
+		}
+		orchestratorVersion := a.OrchestratorProfile.OrchestratorVersion
+		if orchestratorVersion == "" {
+			orchestratorVersion = KubernetesLatest
+		}
+		for _, version := range kubernetesVersions {
+			if version == orchestratorVersion {
+				return nil
+			}
+		}
+		return fmt.Errorf("OrchestratorProfile.KubernetesConfig.CalicoVersion '%s' does not support Kubernetes %s", k.CalicoVersion, orchestratorVersion)
+	}
+	return nil
+}
+
+// validateEtcdDeploymentMode checks that etcd is run in a known way that supports the Kubernetes version
+func (a *Properties) validateEtcdDeploymentMode() error {
+	k := a.OrchestratorProfile.KubernetesConfig
+	if k == nil || k.EtcdDeploymentMode == "" {
+		return nil
+	}
+	kubernetesVersions, ok := EtcdDeploymentModeKubernetesVersions[k.EtcdDeploymentMode]
+	if !ok {
+		return fmt.Errorf("OrchestratorProfile.KubernetesConfig.EtcdDeploymentMode '%s' is not supported, it must be '%s' or '%s'", k.EtcdDeploymentMode, common.EtcdDeploymentModeSystemd, common.EtcdDeploymentModeStaticPod)
+	}
+	orchestratorVersion := a.OrchestratorProfile.OrchestratorVersion
+	if orchestratorVersion == "" {
+		orchestratorVersion = KubernetesLatest
+	}
+	for _, version := range kubernetesVersions {
+		if version == orchestratorVersion {
+			return nil
+		}
+	}
+	return fmt.Errorf("OrchestratorProfile.KubernetesConfig.EtcdDeploymentMode '%s' does not support Kubernetes %s", k.EtcdDeploymentMode, orchestratorVersion)
+}
+
+// validateKubeProxy checks that the kube-proxy image is a valid image reference and that the
+// settings of the kube-proxy config overlay are sane
+func (a *Properties) validateKubeProxy() error {
+	k := a.OrchestratorProfile.KubernetesConfig
+	if k == nil {
+		return nil
+	}
+	if k.KubeProxyImage != "" && !imageReferenceRegex.MatchString(k.KubeProxyImage) {
+		return fmt.Errorf("OrchestratorProfile.KubernetesConfig.KubeProxyImage '%s' is not a valid image reference such as myregistry.azurecr.io/hyperkube-amd64:v1.6.6", k.KubeProxyImage)
+	}
+	c := k.KubeProxyConfig
+	if c == nil {
+		return nil
+	}
+	if c.ClientConnectionQPS < 0 {
+		return fmt.Errorf("OrchestratorProfile.KubernetesConfig.KubeProxyConfig.ClientConnectionQPS is %v and must be positive", c.ClientConnectionQPS)
+	}
+	if c.ClientConnectionBurst < 0 {
+		return fmt.Errorf("OrchestratorProfile.KubernetesConfig.KubeProxyConfig.ClientConnectionBurst is %d and must be positive", c.ClientConnectionBurst)
+	}
+	if c.ClientConnectionBurst != 0 && float64(c.ClientConnectionBurst) < c.ClientConnectionQPS {
+		return fmt.Errorf("OrchestratorProfile.KubernetesConfig.KubeProxyConfig.ClientConnectionBurst is %d and must not be lower than ClientConnectionQPS %v", c.ClientConnectionBurst, c.ClientConnectionQPS)
+	}
+	if c.ConntrackMaxPerCore < 0 {
+		return fmt.Errorf("OrchestratorProfile.KubernetesConfig.KubeProxyConfig.ConntrackMaxPerCore is %d and must be positive", c.ConntrackMaxPerCore)
+	}
+	if c.ConntrackMin < 0 {
+		return fmt.Errorf("OrchestratorProfile.KubernetesConfig.KubeProxyConfig.ConntrackMin is %d and must be positive", c.ConntrackMin)
+	}
+	if c.ConntrackTCPEstablishedTimeout != "" {
+		timeout, err := time.ParseDuration(c.ConntrackTCPEstablishedTimeout)
+		if err != nil || timeout <= 0 {
+			return fmt.Errorf("OrchestratorProfile.KubernetesConfig.KubeProxyConfig.ConntrackTCPEstablishedTimeout '%s' is not a positive duration such as 24h0m0s", c.ConntrackTCPEstablishedTimeout)
+		}
+	}
+	return nil
+}
+
+// validateImageDigests checks that the pod infra container image is a valid image reference and that
+// the digests the images acs-engine is configured with are pinned by are well-formed. RequireImageDigests
+// is rejected: the hyperkube, addon and Calico images are always referenced by tag.
+func (a *Properties) validateImageDigests() error {
+	k := a.OrchestratorProfile.KubernetesConfig
+	if k == nil {
+		return nil
+	}
+	type configuredImage struct {
+		name  string
+		image string
+	}
+	images := []configuredImage{
+		{"OrchestratorProfile.KubernetesConfig.PodInfraContainerImage", k.PodInfraContainerImage},
+		{"OrchestratorProfile.KubernetesConfig.KubeProxyImage", k.KubeProxyImage},
+	}
+	for _, profile := range a.AgentPoolProfiles {
+		for _, image := range profile.PreloadImages {
+			images = append(images, configuredImage{fmt.Sprintf("AgentPoolProfile.PreloadImages of agent pool '%s'", profile.Name), image})
+		}
+	}
+	for _, i := range images {
+		if i.image == "" {
+			continue
+		}
+		if at := strings.LastIndex(i.image, "@"); at != -1 && !imageDigestRegex.MatchString(i.image[at+1:]) {
+			return fmt.Errorf("%s '%s' has the malformed digest '%s', it must be sha256: followed by 64 lowercase hex characters", i.name, i.image, i.image[at+1:])
+		}
+		if !imageReferenceRegex.MatchString(i.image) {
+			return fmt.Errorf("%s '%s' is not a valid image reference such as myregistry.azurecr.io/pause-amd64@sha256:<64 hex characters>", i.name, i.image)
+		}
+	}
+	if k.RequireImageDigests != nil && *k.RequireImageDigests {
+		return fmt.Errorf("OrchestratorProfile.KubernetesConfig.RequireImageDigests is not supported, the hyperkube, addon and Calico images are referenced by tag")
+	}
+	return nil
+}
+
+// validateTopologyLabels checks that the nodes labeled with their topology can read it from the instance
+// metadata service, which is done by the Linux provisioning scripts only
+func (a *Properties) validateTopologyLabels() error {
+	k := a.OrchestratorProfile.KubernetesConfig
+	if k == nil || k.TopologyLabels == nil || !*k.TopologyLabels {
+		return nil
+	}
+	for _, profile := range a.AgentPoolProfiles {
+		if profile.OSType == Windows {
+			return fmt.Errorf("OrchestratorProfile.KubernetesConfig.TopologyLabels is not supported for Windows agent pools, agent pool '%s' is one", profile.Name)
+		}
+	}
+	return nil
+}
+
+// validateCustomBootstrap checks that a custom DCOS bootstrap is only given for DCOS clusters, which are
+// the only ones bootstrapped from a DCOS repository, and that its repository is an http(s) URL. The
+// templates cannot bootstrap DCOS from a docker image, so bootstrapDockerImage is rejected.
+func (a *Properties) validateCustomBootstrap() error {
+	c := a.CustomProfile
+	if !c.HasCustomBootstrap() {
+		return nil
+	}
+	if a.OrchestratorProfile.OrchestratorType != DCOS {
+		return &common.ValidationError{
+			Field:   "customProfile",
+			Message: fmt.Sprintf("bootstrapRepositoryURL and bootstrapDockerImage are only supported with Orchestrator %s, not %s", DCOS, a.OrchestratorProfile.OrchestratorType),
+		}
+	}
+	if c.BootstrapRepositoryURL != "" {
+		u, err := url.Parse(c.BootstrapRepositoryURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || strings.ContainsAny(c.BootstrapRepositoryURL, "'\" \t") {
+			return newValidationError("customProfile.bootstrapRepositoryURL", "'%s' is not an http or https URL", c.BootstrapRepositoryURL)
+		}
+	}
+	if c.BootstrapDockerImage != "" {
+		return &common.ValidationError{
+			Field:   "customProfile.bootstrapDockerImage",
+			Message: "is not supported, the DCOS bootstrap is only downloaded from bootstrapRepositoryURL",
+		}
+	}
+	return nil
+}
+
+// validateCloudProviderRateLimit checks that the rate limit of the Azure cloud provider is positive
+// and only configured for Kubernetes versions that support it
+func (a *Properties) validateCloudProviderRateLimit() error {
+	k := a.OrchestratorProfile.KubernetesConfig
+	if k == nil || (k.CloudProviderRateLimitQPS == 0 && k.CloudProviderRateLimitBucket == 0) {
+		return nil
+	}
+	if k.CloudProviderRateLimitQPS < 0 {
+		return fmt.Errorf("OrchestratorProfile.KubernetesConfig.CloudProviderRateLimitQPS is %v and must be positive", k.CloudProviderRateLimitQPS)
+	}
+	if k.CloudProviderRateLimitBucket < 0 {
+		return fmt.Errorf("OrchestratorProfile.KubernetesConfig.CloudProviderRateLimitBucket is %d and must be positive", k.CloudProviderRateLimitBucket)
+	}
+	orchestratorVersion := a.OrchestratorProfile.OrchestratorVersion
+	if orchestratorVersion == "" {
+		orchestratorVersion = KubernetesLatest
+	}
+	if !common.IsCloudProviderRateLimitSupported(string(orchestratorVersion)) {
+		return fmt.Errorf("OrchestratorProfile.KubernetesConfig.CloudProviderRateLimitQPS and CloudProviderRateLimitBucket require Kubernetes %s", strings.Join(common.CloudProviderRateLimitKubernetesVersions, " or "))
+	}
+	return nil
+}
+
+// validateDefaultDenyNamespaces checks that the namespaces isolated by default are valid, distinct and not
+// system namespaces, and that the network policy enforces the isolation
+func (a *Properties) validateDefaultDenyNamespaces() error {
+	k := a.OrchestratorProfile.KubernetesConfig
+	if k == nil || len(k.DefaultDenyNamespaces) == 0 {
+		return nil
+	}
+	if k.NetworkPolicy != "calico" {
+		return fmt.Errorf("OrchestratorProfile.KubernetesConfig.DefaultDenyNamespaces requires networkPolicy 'calico', the only network policy that enforces isolation")
+	}
+	seen := map[string]bool{}
+	for _, namespace := range k.DefaultDenyNamespaces {
+		if !namespaceRegex.MatchString(namespace) {
+			return fmt.Errorf("OrchestratorProfile.KubernetesConfig.DefaultDenyNamespaces entry '%s' is not a valid namespace name", namespace)
+		}
+		if namespace == "kube-system" || namespace == "kube-public" {
+			return fmt.Errorf("OrchestratorProfile.KubernetesConfig.DefaultDenyNamespaces must not contain the system namespace '%s'", namespace)
+		}
+		if seen[namespace] {
+			return fmt.Errorf("OrchestratorProfile.KubernetesConfig.DefaultDenyNamespaces contains '%s' more than once", namespace)
+		}
+		seen[namespace] = true
+	}
+	return nil
+}
+
+// validateServiceAccountKeys checks that the service account signing key is an RSA private key, unless
+// it is a keyvault reference, and that the verification keys are RSA public keys. The apiserver reads
+// more than one key from its key file from Kubernetes 1.6 on.
+func (a *Properties) validateServiceAccountKeys() error {
+	c := a.CertificateProfile
+	if c == nil || (len(c.ServiceAccountPrivateKey) == 0 && len(c.ServiceAccountVerificationKeys) == 0) {
+		return nil
+	}
+	if a.OrchestratorProfile.OrchestratorType != Kubernetes {
+		return fmt.Errorf("CertificateProfile.ServiceAccountPrivateKey and ServiceAccountVerificationKeys are only supported with Orchestrator %s", Kubernetes)
+	}
+	if len(c.ServiceAccountPrivateKey) > 0 && !common.KeyVaultSecretRefRegex.MatchString(c.ServiceAccountPrivateKey) {
+		block, _ := pem.Decode([]byte(c.ServiceAccountPrivateKey))
+		if block == nil {
+			return errors.New("CertificateProfile.ServiceAccountPrivateKey is not a PEM encoded key")
+		}
+		if _, err := x509.ParsePKCS1PrivateKey(block.Bytes); err != nil {
+			return fmt.Errorf("CertificateProfile.ServiceAccountPrivateKey is not a PKCS #1 RSA private key: %v", err)
+		}
+	}
+	for i, key := range c.ServiceAccountVerificationKeys {
+		block, _ := pem.Decode([]byte(key))
+		if block == nil {
+			return fmt.Errorf("CertificateProfile.ServiceAccountVerificationKeys entry %d is not a PEM encoded key", i)
+		}
+		publicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return fmt.Errorf("CertificateProfile.ServiceAccountVerificationKeys entry %d is not a public key: %v", i, err)
+		}
+		if _, ok := publicKey.(*rsa.PublicKey); !ok {
+			return fmt.Errorf("CertificateProfile.ServiceAccountVerificationKeys entry %d is not an RSA public key", i)
+		}
+	}
+	if len(c.ServiceAccountVerificationKeys) > 0 {
+		switch a.OrchestratorProfile.OrchestratorVersion {
+		case Kubernetes153, Kubernetes157:
+			return fmt.Errorf("CertificateProfile.ServiceAccountVerificationKeys requires Kubernetes %s or later", Kubernetes160)
+		}
+	}
+	return nil
+}
+
+func validateRuntimeReservedMilliCPUFits(milliCPU int, vmSize string, owner string) error {
+	if milliCPU == 0 {
+		return nil
+	}
+	cores, ok := VMSizeCores[vmSize]
+	if !ok {
+		return fmt.Errorf("the CPU reserved for the container runtime of %s cannot be checked against %s, whose vCPUs are unknown", owner, vmSize)
+	}
+	if milliCPU >= cores*1000 {
+		return fmt.Errorf("the %dm CPU reserved for the container runtime of %s does not fit %s, which has %d vCPUs", milliCPU, owner, vmSize, cores)
+	}
+	return nil
+}
+
+// validatePrivateRegistry checks the private registry credentials. They are passed
+// to the provisioning script of the masters on its command line, like the service
+// principal secret, so none of them may contain whitespace.
+func (a *Properties) validatePrivateRegistry() error {
+	r := a.PrivateRegistryProfile
+	if r == nil {
+		return nil
+	}
+	if a.OrchestratorProfile.OrchestratorType != Kubernetes {
+		return fmt.Errorf("PrivateRegistryProfile is only supported with Orchestrator %s", Kubernetes)
+	}
+	if !registryServerRegex.MatchString(r.Server) {
+		return fmt.Errorf("PrivateRegistryProfile.Server '%s' is not a valid registry host, for example myregistry.azurecr.io or myregistry.example.com:5000", r.Server)
+	}
+	if len(r.Username) == 0 || strings.ContainsAny(r.Username, " \t\n") {
+		return fmt.Errorf("PrivateRegistryProfile.Username '%s' must be specified and must not contain whitespace", r.Username)
+	}
+	if len(r.Password) == 0 || strings.ContainsAny(r.Password, " \t\n") {
+		return fmt.Errorf("PrivateRegistryProfile.Password must be specified and must not contain whitespace")
+	}
+	if strings.HasPrefix(r.Password, "/subscriptions/") && !common.KeyVaultSecretRefRegex.MatchString(r.Password) {
+		return fmt.Errorf("PrivateRegistryProfile.Password '%s' is not a valid keyvault secret reference", r.Password)
+	}
+	for _, namespace := range r.Namespaces {
+		if !namespaceRegex.MatchString(namespace) {
+			return fmt.Errorf("PrivateRegistryProfile.Namespaces entry '%s' is not a valid namespace name", namespace)
+		}
+	}
+	return nil
+}
+
+// validateSecurityRules checks the security rules merged into the generated network security groups.
+// Priorities below MaxReservedSecurityRulePriority are reserved for the rules acs-engine generates.
+func (a *Properties) validateSecurityRules() error {
+	if len(a.SecurityRules) == 0 {
+		return nil
+	}
+	switch a.OrchestratorProfile.OrchestratorType {
+	case DCOS:
+	case Kubernetes:
+	default:
+		return fmt.Errorf("SecurityRules are not supported for Orchestrator %s, which does not generate network security groups", a.OrchestratorProfile.OrchestratorType)
+	}
+
+	names := make(map[string]bool)
+	priorities := make(map[int]string)
+	for _, rule := range a.SecurityRules {
+		if e := rule.Validate(); e != nil {
+			return e
+		}
+		if names[rule.Name] {
+			return fmt.Errorf("security rule name '%s' already exists, security rule names must be unique", rule.Name)
+		}
+		names[rule.Name] = true
+		if name, ok := priorities[rule.Priority]; ok {
+			return fmt.Errorf("security rules '%s' and '%s' both have priority %d, security rule priorities must be unique", name, rule.Name, rule.Priority)
+		}
+		priorities[rule.Priority] = rule.Name
+	}
+	return nil
+}
+
+// Validate implements APIObject
+func (r *SecurityRule) Validate() error {
+	if !securityRuleNameRegex.MatchString(r.Name) {
+		return fmt.Errorf("security rule name '%s' is invalid. The name must start with a letter or number, end with a letter, number or underscore, and may contain only letters, numbers, underscores, periods, or hyphens", r.Name)
+	}
+	if r.Priority < MinSecurityRulePriority || r.Priority > MaxSecurityRulePriority {
+		return fmt.Errorf("security rule '%s' has priority %d, which must be in the range [%d, %d]", r.Name, r.Priority, MinSecurityRulePriority, MaxSecurityRulePriority)
+	}
+	if r.Priority <= MaxReservedSecurityRulePriority {
+		return fmt.Errorf("security rule '%s' has priority %d, but priorities in the range [%d, %d] are reserved for the rules generated by acs-engine", r.Name, r.Priority, MinSecurityRulePriority, MaxReservedSecurityRulePriority)
+	}
+	if r.Direction != Inbound && r.Direction != Outbound {
+		return fmt.Errorf("security rule '%s' has unknown direction '%s'. Specify either %s or %s", r.Name, r.Direction, Inbound, Outbound)
+	}
+	if r.Access != Allow && r.Access != Deny {
+		return fmt.Errorf("security rule '%s' has unknown access '%s'. Specify either %s or %s", r.Name, r.Access, Allow, Deny)
+	}
+	validProtocol := false
+	for _, protocol := range SecurityRuleProtocolValues {
+		if r.Protocol == protocol {
+			validProtocol = true
+			break
+		}
+	}
+	if !validProtocol {
+		return fmt.Errorf("security rule '%s' has unknown protocol '%s'", r.Name, r.Protocol)
+	}
+	if e := validateSecurityRulePortRange(r.SourcePortRange); e != nil {
+		return fmt.Errorf("security rule '%s' has an invalid sourcePortRange: %v", r.Name, e)
+	}
+	if e := validateSecurityRulePortRange(r.DestinationPortRange); e != nil {
+		return fmt.Errorf("security rule '%s' has an invalid destinationPortRange: %v", r.Name, e)
+	}
+	if e := validateSecurityRuleAddressPrefix(r.SourceAddressPrefix); e != nil {
+		return fmt.Errorf("security rule '%s' has an invalid sourceAddressPrefix: %v", r.Name, e)
+	}
+	if e := validateSecurityRuleAddressPrefix(r.DestinationAddressPrefix); e != nil {
+		return fmt.Errorf("security rule '%s' has an invalid destinationAddressPrefix: %v", r.Name, e)
+	}
+	return nil
+}
+
+// validateSecurityRulePortRange checks that the port range is empty, '*', a single port, or a range of ports such as 1000-2000
+func validateSecurityRulePortRange(portRange string) error {
+	if portRange == "" || portRange == "*" {
+		return nil
+	}
+	bounds := strings.Split(portRange, "-")
+	if len(bounds) > 2 {
+		return fmt.Errorf("'%s' is not a port or a range of ports", portRange)
+	}
+	ports := []int{}
+	for _, bound := range bounds {
+		port, err := strconv.Atoi(bound)
+		if err != nil || port < MinPort || port > MaxPort {
+			return fmt.Errorf("'%s' is not a port or a range of ports in the range [%d, %d]", portRange, MinPort, MaxPort)
+		}
+		ports = append(ports, port)
+	}
+	if len(ports) == 2 && ports[0] > ports[1] {
+		return fmt.Errorf("the range '%s' ends before it starts", portRange)
+	}
+	return nil
+}
+
+// validateSecurityRuleAddressPrefix checks that the address prefix is empty, '*', a service tag such as Internet, an IP address or a CIDR
+func validateSecurityRuleAddressPrefix(prefix string) error {
+	if prefix == "" || prefix == "*" || securityRuleTagRegex.MatchString(prefix) {
+		return nil
+	}
+	if net.ParseIP(prefix) != nil {
+		return nil
+	}
+	if _, _, err := net.ParseCIDR(prefix); err != nil {
+		return fmt.Errorf("'%s' is not an IP address, a CIDR or a tag", prefix)
+	}
 	return nil
 }
 
@@ -430,45 +1725,203 @@ func validateStorageProfile(storageProfile string) error {
 	return nil
 }
 
-func validateUniquePorts(ports []int, name string) error {
+// ValidatePorts checks that the public ports of the agent pool are in the range [MinPort, MaxPort] and unique.
+// No ports means the pool has no public ports.
+func (a *AgentPoolProfile) ValidatePorts() error {
 	portMap := make(map[int]bool)
-	for _, port := range ports {
+	for _, port := range a.Ports {
+		if port < MinPort || port > MaxPort {
+			return newValidationError(agentPoolField(a.Name, "ports"), "has port '%d', ports must be in the range [%d, %d]", port, MinPort, MaxPort)
+		}
 		if _, ok := portMap[port]; ok {
-			return fmt.Errorf("agent profile '%s' has duplicate port '%d', ports must be unique", name, port)
+			return newValidationError(agentPoolField(a.Name, "ports"), "has duplicate port '%d', ports must be unique", port)
 		}
 		portMap[port] = true
 	}
 	return nil
 }
 
-func validateVNET(a *Properties) error {
+// ValidateCount checks that the number of masters suits the orchestrator. Kubernetes and DCOS need 1, 3, or 5
+// masters to keep an etcd or ZooKeeper quorum, Swarm and Swarm Mode an odd number no greater than
+// common.MaxSwarmMasterCount for their Consul or Raft quorum.
+func (m *MasterProfile) ValidateCount(orchestratorType OrchestratorType) error {
+	allowed := common.AllowedMasterCounts
+	if orchestratorType == Swarm || orchestratorType == SwarmMode {
+		allowed = common.AllowedSwarmMasterCounts
+	}
+	for _, count := range allowed {
+		if m.Count == count {
+			return nil
+		}
+	}
+	return newValidationError("masterProfile.count", "is %d and needs to be one of %v for Orchestrator %s, to keep a quorum of masters", m.Count, allowed, orchestratorType)
+}
+
+// ValidateCount checks that the number of agents is within the range a pool's availability set or scale set
+// supports. A count of 0 is rejected on its own, since a pool without nodes is almost always a mistake.
+func (a *AgentPoolProfile) ValidateCount() error {
+	if a.Count == 0 {
+		return newValidationError(agentPoolField(a.Name, "count"), "is 0, an agent pool needs at least %d agent", MinAgentCount)
+	}
+	if a.Count < MinAgentCount || a.Count > MaxAgentCount {
+		return newValidationError(agentPoolField(a.Name, "count"), "is %d and needs to be in the range [%d,%d]", a.Count, MinAgentCount, MaxAgentCount)
+	}
+	return nil
+}
+
+// ValidateCustomImage checks that the publisher, offer and sku of a custom windows agent image are all specified,
+// and that they and the version look like those of a marketplace image. The version may be left out for the
+// latest version.
+func (w *WindowsProfile) ValidateCustomImage() error {
+	if !w.HasCustomImage() {
+		return nil
+	}
+	for _, i := range []struct {
+		field string
+		value string
+	}{
+		{"windowsProfile.imagePublisher", w.ImagePublisher},
+		{"windowsProfile.imageOffer", w.ImageOffer},
+		{"windowsProfile.imageSku", w.ImageSku},
+	} {
+		if i.value == "" {
+			return newValidationError(i.field, "must be specified with a custom windows image")
+		}
+		if !marketplaceImageNameRegex.MatchString(i.value) {
+			return newValidationError(i.field, "'%s' must start with a letter or a digit and contain at most 64 letters, digits, periods, underscores and hyphens", i.value)
+		}
+	}
+	if w.ImageVersion != "" && !marketplaceImageVersionRegex.MatchString(w.ImageVersion) {
+		return newValidationError("windowsProfile.imageVersion", "'%s' must be latest or a version such as 2016.127.20170630", w.ImageVersion)
+	}
+	return nil
+}
+
+// ValidateAvailabilityZones rejects availability zones for the agent pool. The agents are deployed with the
+// 2016-03-30 and 2016-04-30-preview compute APIs, which cannot place VMs or scale sets in a zone, so the zones
+// would otherwise be accepted and silently ignored.
+func (a *AgentPoolProfile) ValidateAvailabilityZones() error {
+	if !a.HasAvailabilityZones() {
+		return nil
+	}
+	return newValidationError(agentPoolField(a.Name, "availabilityZones"), "are not supported, the agents are deployed with the 2016-03-30 and 2016-04-30-preview compute APIs, which cannot place VMs or scale sets in a zone")
+}
+
+// ValidateFirstConsecutiveStaticIP checks that the first static IP of the masters is an IPv4 address. It may only
+// be left empty, for the default, when the masters are not deployed into a custom VNET.
+func (m *MasterProfile) ValidateFirstConsecutiveStaticIP() error {
+	if m.FirstConsecutiveStaticIP == "" {
+		if m.IsCustomVNET() {
+			return newValidationError("masterProfile.firstConsecutiveStaticIP", "must be specified with a VNET Subnet specification")
+		}
+		return nil
+	}
+	ip := net.ParseIP(m.FirstConsecutiveStaticIP)
+	if ip == nil || ip.To4() == nil {
+		return newValidationError("masterProfile.firstConsecutiveStaticIP", "'%s' is an invalid IPv4 address", m.FirstConsecutiveStaticIP)
+	}
+	return nil
+}
+
+// ValidateOSDiskSize checks that the OS disk size of the masters is in the range [MinDiskSizeGB, MaxDiskSizeGB].
+// A size of 0 means the OS disk size of the image.
+func (m *MasterProfile) ValidateOSDiskSize() error {
+	if m.OSDiskSizeGB != 0 && (m.OSDiskSizeGB < MinDiskSizeGB || m.OSDiskSizeGB > MaxDiskSizeGB) {
+		return newValidationError("masterProfile.osDiskSizeGB", "invalid os disk size of %d specified.  The range of valid values are [%d, %d]", m.OSDiskSizeGB, MinDiskSizeGB, MaxDiskSizeGB)
+	}
+	return nil
+}
+
+// ValidateOSDiskSize checks that the OS disk size of the agent pool is in the range [MinDiskSizeGB, MaxDiskSizeGB].
+// A size of 0 means the OS disk size of the image.
+func (a *AgentPoolProfile) ValidateOSDiskSize() error {
+	if a.OSDiskSizeGB != 0 && (a.OSDiskSizeGB < MinDiskSizeGB || a.OSDiskSizeGB > MaxDiskSizeGB) {
+		return newValidationError(agentPoolField(a.Name, "osDiskSizeGB"), "invalid os disk size of %d specified.  The range of valid values are [%d, %d]", a.OSDiskSizeGB, MinDiskSizeGB, MaxDiskSizeGB)
+	}
+	return nil
+}
+
+// ValidateVNET checks that either the masters and all agent pools are deployed into subnets of the same custom
+// VNET, with the first static IP of the masters set, or none of them are
+func (a *Properties) ValidateVNET() error {
 	isCustomVNET := a.MasterProfile.IsCustomVNET()
 	for _, agentPool := range a.AgentPoolProfiles {
 		if agentPool.IsCustomVNET() != isCustomVNET {
-			return fmt.Errorf("Multiple VNET Subnet configurations specified.  The master profile and each agent pool profile must all specify a custom VNET Subnet, or none at all")
+			if isCustomVNET {
+				return newValidationError(agentPoolField(agentPool.Name, "vnetSubnetID"), "must be specified since the master profile specifies a custom VNET Subnet, the master profile and each agent pool profile must all specify a custom VNET Subnet, or none at all")
+			}
+			return newValidationError("masterProfile.vnetSubnetID", "must be specified since agent pool '%s' specifies a custom VNET Subnet, the master profile and each agent pool profile must all specify a custom VNET Subnet, or none at all", agentPool.Name)
 		}
 	}
+	if e := a.MasterProfile.ValidateFirstConsecutiveStaticIP(); e != nil {
+		return e
+	}
 	if isCustomVNET {
 		subscription, resourcegroup, vnetname, _, e := GetVNETSubnetIDComponents(a.MasterProfile.VnetSubnetID)
 		if e != nil {
-			return e
+			return &common.ValidationError{Field: "masterProfile.vnetSubnetID", Message: e.Error()}
 		}
 
 		for _, agentPool := range a.AgentPoolProfiles {
 			agentSubID, agentRG, agentVNET, _, err := GetVNETSubnetIDComponents(agentPool.VnetSubnetID)
 			if err != nil {
-				return err
+				return &common.ValidationError{Field: agentPoolField(agentPool.Name, "vnetSubnetID"), Message: err.Error()}
 			}
-			if agentSubID != subscription ||
-				agentRG != resourcegroup ||
-				agentVNET != vnetname {
-				return errors.New("Multiple VNETS specified.  The master profile and each agent pool must reference the same VNET (but it is ok to reference different subnets on that VNET)")
+			if !strings.EqualFold(agentSubID, subscription) ||
+				!strings.EqualFold(agentRG, resourcegroup) ||
+				!strings.EqualFold(agentVNET, vnetname) {
+				return newValidationError(agentPoolField(agentPool.Name, "vnetSubnetID"), "references another VNET than the master profile.  The master profile and each agent pool must reference the same VNET (but it is ok to reference different subnets on that VNET)")
 			}
 		}
 
 		masterFirstIP := net.ParseIP(a.MasterProfile.FirstConsecutiveStaticIP)
-		if masterFirstIP == nil {
-			return fmt.Errorf("MasterProfile.FirstConsecutiveStaticIP (with VNET Subnet specification) '%s' is an invalid IP address", a.MasterProfile.FirstConsecutiveStaticIP)
+		if a.MasterProfile.VnetSubnetCIDR != "" {
+			hasInternalLB := a.OrchestratorProfile.OrchestratorType == Kubernetes && a.MasterProfile.Count > 1
+			if e := validateMasterStaticIPs(masterFirstIP, a.MasterProfile.Count, hasInternalLB, a.MasterProfile.VnetSubnetCIDR); e != nil {
+				return e
+			}
+		}
+	} else if a.MasterProfile.VnetSubnetCIDR != "" {
+		return newValidationError("masterProfile.vnetSubnetCIDR", "may only be specified with masterProfile.vnetSubnetID")
+	}
+	return nil
+}
+
+// validateMasterStaticIPs checks that the consecutive static IPs of the masters, and the static IP of the
+// master internal load balancer when there is one, fall within the master subnet and avoid the addresses
+// Azure reserves: the first four and the last of each subnet
+func validateMasterStaticIPs(firstIP net.IP, count int, hasInternalLB bool, subnetCIDR string) error {
+	_, subnet, err := net.ParseCIDR(subnetCIDR)
+	if err != nil || subnet.IP.To4() == nil {
+		return newValidationError("masterProfile.vnetSubnetCIDR", "'%s' is an invalid IPv4 subnet", subnetCIDR)
+	}
+	first := firstIP.To4()
+	if first == nil {
+		return newValidationError("masterProfile.firstConsecutiveStaticIP", "'%s' is not an IPv4 address", firstIP)
+	}
+
+	ones, bits := subnet.Mask.Size()
+	if ones > MaxSubnetPrefixLength {
+		return newValidationError("masterProfile.vnetSubnetCIDR", "'%s' is smaller than the smallest Azure subnet, a /%d", subnetCIDR, MaxSubnetPrefixLength)
+	}
+	network := binary.BigEndian.Uint32(subnet.IP.To4())
+	lastUsable := network + uint32(1)<<uint(bits-ones) - 2
+	firstUsable := network + AzureReservedSubnetAddresses
+	start := binary.BigEndian.Uint32(first)
+	for i := 0; i < count; i++ {
+		ip := start + uint32(i)
+		if ip < firstUsable || ip > lastUsable {
+			out := make(net.IP, net.IPv4len)
+			binary.BigEndian.PutUint32(out, ip)
+			return newValidationError("masterProfile.firstConsecutiveStaticIP", "the static IP %s of master %d is outside the usable range of masterProfile.vnetSubnetCIDR '%s'. The first %d addresses and the last address of a subnet are reserved by Azure", out, i, subnetCIDR, AzureReservedSubnetAddresses)
+		}
+	}
+	if hasInternalLB {
+		ip := start + common.DefaultInternalLbStaticIPOffset
+		if ip < firstUsable || ip > lastUsable {
+			out := make(net.IP, net.IPv4len)
+			binary.BigEndian.PutUint32(out, ip)
+			return newValidationError("masterProfile.firstConsecutiveStaticIP", "the static IP %s of the master internal load balancer is outside the usable range of masterProfile.vnetSubnetCIDR '%s'. It is %d addresses after the first master, and the last address of a subnet is reserved by Azure", out, subnetCIDR, common.DefaultInternalLbStaticIPOffset)
 		}
 	}
 	return nil
@@ -476,14 +1929,15 @@ func validateVNET(a *Properties) error {
 
 // GetVNETSubnetIDComponents extract subscription, resourcegroup, vnetname, subnetname from the vnetSubnetID
 func GetVNETSubnetIDComponents(vnetSubnetID string) (string, string, string, string, error) {
-	vnetSubnetIDRegex := `^\/subscriptions\/([^\/]*)\/resourceGroups\/([^\/]*)\/providers\/Microsoft.Network\/virtualNetworks\/([^\/]*)\/subnets\/([^\/]*)$`
+	// resource IDs are case insensitive, and Azure often returns them as /resourcegroups/
+	vnetSubnetIDRegex := `(?i)^\/subscriptions\/([^\/]*)\/resourceGroups\/([^\/]*)\/providers\/Microsoft.Network\/virtualNetworks\/([^\/]*)\/subnets\/([^\/]*)$`
 	re, err := regexp.Compile(vnetSubnetIDRegex)
 	if err != nil {
 		return "", "", "", "", err
 	}
 	submatches := re.FindStringSubmatch(vnetSubnetID)
-	if len(submatches) != 4 {
-		return "", "", "", "", err
+	if len(submatches) != 5 {
+		return "", "", "", "", fmt.Errorf("vnetSubnetID %s is invalid, it must be of the form /subscriptions/<SUB_ID>/resourceGroups/<RG_NAME>/providers/Microsoft.Network/virtualNetworks/<VNET_NAME>/subnets/<SUBNET_NAME>", vnetSubnetID)
 	}
 	return submatches[1], submatches[2], submatches[3], submatches[4], nil
 }
@@ -1,7 +1,15 @@
 package vlabs
 
 import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"net"
+	"strings"
 	"testing"
+
+	"github.com/Azure/acs-engine/pkg/api/common"
 )
 
 func Test_OrchestratorProfile_Validate(t *testing.T) {
@@ -20,6 +28,41 @@ func Test_OrchestratorProfile_Validate(t *testing.T) {
 	}
 }
 
+func Test_ValidateOrchestratorVersion(t *testing.T) {
+	cases := []struct {
+		orchestratorType    OrchestratorType
+		orchestratorVersion OrchestratorVersion
+		valid               bool
+	}{
+		{Kubernetes, Kubernetes166, true},
+		{Kubernetes, Kubernetes153, true},
+		{Kubernetes, "", true},
+		{Kubernetes, "1.6.x", false},
+		{Kubernetes, DCOS190, false},
+		{DCOS, DCOS188, true},
+		{DCOS, "", true},
+		{DCOS, Kubernetes166, false},
+		{Swarm, "", true},
+		{Swarm, "1.12", true},
+		{SwarmMode, "", true},
+		{"Mesos", "", false},
+	}
+	for _, c := range cases {
+		err := ValidateOrchestratorVersion(c.orchestratorType, c.orchestratorVersion)
+		if c.valid && err != nil {
+			t.Errorf("should not error on %s version '%s': %v", c.orchestratorType, c.orchestratorVersion, err)
+		}
+		if !c.valid && err == nil {
+			t.Errorf("should error on %s version '%s'", c.orchestratorType, c.orchestratorVersion)
+		}
+	}
+
+	err := ValidateOrchestratorVersion(Kubernetes, "1.7.2")
+	if err == nil || !strings.Contains(err.Error(), string(Kubernetes166)) {
+		t.Errorf("the error should list the supported versions, got: %v", err)
+	}
+}
+
 func Test_KubernetesConfig_Validate(t *testing.T) {
 	c := KubernetesConfig{}
 
@@ -38,6 +81,22 @@ func Test_KubernetesConfig_Validate(t *testing.T) {
 	}
 }
 
+func Test_KubernetesConfig_ValidateClusterName(t *testing.T) {
+	for _, name := range []string{"prod-westus2", "a", "cluster_1.prod"} {
+		c := KubernetesConfig{ClusterName: name}
+		if err := c.Validate(); err != nil {
+			t.Errorf("should not error on valid ClusterName %s: %v", name, err)
+		}
+	}
+
+	for _, name := range []string{"-prod", "prod.", "prod westus2", "prod=westus2", strings.Repeat("a", 64)} {
+		c := KubernetesConfig{ClusterName: name}
+		if err := c.Validate(); err == nil {
+			t.Errorf("should error on invalid ClusterName %s", name)
+		}
+	}
+}
+
 func Test_Properties_ValidateNetworkPolicy(t *testing.T) {
 	p := &Properties{}
 	p.OrchestratorProfile = &OrchestratorProfile{}
@@ -73,3 +132,1351 @@ func Test_Properties_ValidateNetworkPolicy(t *testing.T) {
 		)
 	}
 }
+
+func Test_AgentPoolProfile_ValidateStorageAccountsPerPool(t *testing.T) {
+	a := &AgentPoolProfile{
+		Name:                "agentpool",
+		Count:               30,
+		AvailabilityProfile: AvailabilitySet,
+		StorageProfile:      StorageAccount,
+	}
+
+	if err := a.validateStorageAccountsPerPool(); err != nil {
+		t.Errorf("should not error when StorageAccountsPerPool is not set: %v", err)
+	}
+
+	a.StorageAccountsPerPool = 3
+	if err := a.validateStorageAccountsPerPool(); err != nil {
+		t.Errorf("should not error on valid StorageAccountsPerPool: %v", err)
+	}
+
+	a.StorageAccountsPerPool = 1
+	if err := a.validateStorageAccountsPerPool(); err == nil {
+		t.Error("should error when StorageAccountsPerPool cannot hold the pool count")
+	}
+
+	a.StorageAccountsPerPool = MaxStorageAccountsPerPool + 1
+	if err := a.validateStorageAccountsPerPool(); err == nil {
+		t.Error("should error when StorageAccountsPerPool exceeds the maximum")
+	}
+
+	a.StorageAccountsPerPool = 3
+	a.StorageProfile = ManagedDisks
+	if err := a.validateStorageAccountsPerPool(); err == nil {
+		t.Error("should error when StorageAccountsPerPool is set with managed disks")
+	}
+}
+
+func Test_ValidateWindowsSupport(t *testing.T) {
+	o := &OrchestratorProfile{
+		OrchestratorType: Kubernetes,
+	}
+
+	if err := validateWindowsSupport(o); err != nil {
+		t.Errorf("should not error for Kubernetes without a version: %v", err)
+	}
+
+	o.OrchestratorVersion = Kubernetes166
+	if err := validateWindowsSupport(o); err != nil {
+		t.Errorf("should not error for a supported Kubernetes version: %v", err)
+	}
+
+	o.OrchestratorVersion = "1.4.0"
+	if err := validateWindowsSupport(o); err == nil {
+		t.Error("should error for a Kubernetes version not in the support matrix")
+	}
+
+	for _, version := range []OrchestratorVersion{Kubernetes153, Kubernetes157} {
+		o.OrchestratorVersion = version
+		if err := validateWindowsSupport(o); err == nil {
+			t.Errorf("should error for Kubernetes %s, which does not support Windows", version)
+		}
+	}
+
+	o.OrchestratorType = DCOS
+	o.OrchestratorVersion = DCOS190
+	if err := validateWindowsSupport(o); err == nil {
+		t.Error("should error for DCOS")
+	}
+}
+
+func Test_Properties_ValidateDCOSPublicPools(t *testing.T) {
+	p := &Properties{
+		OrchestratorProfile: &OrchestratorProfile{OrchestratorType: DCOS},
+		AgentPoolProfiles: []*AgentPoolProfile{
+			{Name: "private"},
+			{Name: "public", DNSPrefix: "publicpool", Ports: []int{80, 443}},
+		},
+	}
+
+	if err := p.validateDCOSPublicPools(); err != nil {
+		t.Errorf("should not error with a single public pool: %v", err)
+	}
+
+	p.AgentPoolProfiles = append(p.AgentPoolProfiles, &AgentPoolProfile{Name: "public2", DNSPrefix: "publicpool2", Ports: []int{8080}})
+	if err := p.validateDCOSPublicPools(); err == nil {
+		t.Error("should error when more than the supported number of public pools are specified")
+	}
+}
+
+func Test_Properties_ValidateMasterCount(t *testing.T) {
+	p := &Properties{
+		OrchestratorProfile: &OrchestratorProfile{OrchestratorType: SwarmMode},
+		MasterProfile:       &MasterProfile{},
+	}
+
+	for _, count := range []int{1, 3, 5, 7} {
+		p.MasterProfile.Count = count
+		if err := p.validateMasterCount(); err != nil {
+			t.Errorf("should not error on %d swarm managers: %v", count, err)
+		}
+	}
+
+	for _, count := range []int{0, 2, 4, 9} {
+		p.MasterProfile.Count = count
+		if err := p.validateMasterCount(); err == nil {
+			t.Errorf("should error on %d swarm managers", count)
+		}
+	}
+
+	p.OrchestratorProfile.OrchestratorType = Kubernetes
+	p.MasterProfile.Count = 7
+	if err := p.validateMasterCount(); err == nil {
+		t.Error("should error on 7 Kubernetes masters")
+	}
+}
+
+func Test_MasterProfile_ValidateCount(t *testing.T) {
+	tests := []struct {
+		orchestratorType OrchestratorType
+		count            int
+		valid            bool
+	}{
+		{Kubernetes, 2, false},
+		{Kubernetes, 3, true},
+		{Kubernetes, 7, false},
+		{DCOS, 4, false},
+		{DCOS, 5, true},
+		{Swarm, 2, false},
+		{Swarm, 7, true},
+		{Swarm, 9, false},
+		{Swarm, 0, false},
+		{SwarmMode, 2, false},
+		{SwarmMode, 7, true},
+		{SwarmMode, 8, false},
+	}
+
+	for _, test := range tests {
+		m := &MasterProfile{Count: test.count}
+		err := m.ValidateCount(test.orchestratorType)
+		if test.valid && err != nil {
+			t.Errorf("should not error on %d %s masters: %v", test.count, test.orchestratorType, err)
+		}
+		if !test.valid && err == nil {
+			t.Errorf("should error on %d %s masters", test.count, test.orchestratorType)
+		}
+	}
+}
+
+func Test_KubernetesConfig_ValidateMasterLBProbe(t *testing.T) {
+	c := &KubernetesConfig{}
+	if err := c.validateMasterLBProbe(); err != nil {
+		t.Errorf("should not error on default probe settings: %v", err)
+	}
+
+	c.MasterLBProbeIntervalInSeconds = 10
+	c.MasterLBProbeNumberOfProbes = 3
+	if err := c.validateMasterLBProbe(); err != nil {
+		t.Errorf("should not error on valid probe settings: %v", err)
+	}
+
+	c.MasterLBProbeIntervalInSeconds = 4
+	if err := c.validateMasterLBProbe(); err == nil {
+		t.Error("should error when the probe interval is below the Azure minimum")
+	}
+
+	c.MasterLBProbeIntervalInSeconds = 5
+	c.MasterLBProbeNumberOfProbes = 1
+	if err := c.validateMasterLBProbe(); err == nil {
+		t.Error("should error when the number of probes is below the Azure minimum")
+	}
+}
+
+func Test_KubernetesConfig_ValidateProvisionRetries(t *testing.T) {
+	c := &KubernetesConfig{}
+	if err := c.validateProvisionRetries(); err != nil {
+		t.Errorf("should not error on the default provision retries: %v", err)
+	}
+
+	c.ProvisionRetryCount = MaxProvisionRetryCount
+	c.ProvisionTimeoutInSeconds = MaxProvisionTimeoutInSeconds
+	if err := c.validateProvisionRetries(); err != nil {
+		t.Errorf("should not error on the maximum provision retries: %v", err)
+	}
+
+	c.ProvisionRetryCount = -1
+	if err := c.validateProvisionRetries(); err == nil {
+		t.Error("should error on a negative provision retry count")
+	}
+
+	c.ProvisionRetryCount = MaxProvisionRetryCount + 1
+	if err := c.validateProvisionRetries(); err == nil {
+		t.Error("should error when the provision retry count exceeds the maximum")
+	}
+
+	c.ProvisionRetryCount = 1
+	c.ProvisionTimeoutInSeconds = -1
+	if err := c.validateProvisionRetries(); err == nil {
+		t.Error("should error on a negative provision timeout")
+	}
+
+	c.ProvisionTimeoutInSeconds = MaxProvisionTimeoutInSeconds + 1
+	if err := c.validateProvisionRetries(); err == nil {
+		t.Error("should error when the provision timeout exceeds the maximum")
+	}
+}
+
+func Test_KubernetesConfig_ValidateEtcdTimeouts(t *testing.T) {
+	c := &KubernetesConfig{}
+	if err := c.validateEtcdTimeouts(); err != nil {
+		t.Errorf("should not error on the etcd default timeouts: %v", err)
+	}
+
+	heartbeat, election := 250, 2500
+	c.EtcdHeartbeatIntervalMs = &heartbeat
+	c.EtcdElectionTimeoutMs = &election
+	if err := c.validateEtcdTimeouts(); err != nil {
+		t.Errorf("should not error on valid etcd timeouts: %v", err)
+	}
+
+	election = 1000
+	if err := c.validateEtcdTimeouts(); err == nil {
+		t.Error("should error when the election timeout is shorter than 5 heartbeat intervals")
+	}
+
+	c.EtcdElectionTimeoutMs = nil
+	if err := c.validateEtcdTimeouts(); err == nil {
+		t.Error("should error when the default election timeout is shorter than 5 configured heartbeat intervals")
+	}
+
+	heartbeat = 0
+	if err := c.validateEtcdTimeouts(); err == nil {
+		t.Error("should error on a heartbeat interval of 0")
+	}
+
+	c.EtcdHeartbeatIntervalMs = nil
+	c.EtcdElectionTimeoutMs = &election
+	election = 0
+	if err := c.validateEtcdTimeouts(); err == nil {
+		t.Error("should error on an election timeout of 0")
+	}
+
+	election = 60000
+	if err := c.validateEtcdTimeouts(); err == nil {
+		t.Error("should error when the election timeout exceeds the etcd maximum")
+	}
+}
+
+func Test_Properties_ValidateSecurityRules(t *testing.T) {
+	p := &Properties{
+		OrchestratorProfile: &OrchestratorProfile{OrchestratorType: Kubernetes},
+		SecurityRules: []SecurityRule{
+			{Name: "deny_telnet", Priority: 1000, Direction: Inbound, Access: Deny, Protocol: "Tcp", DestinationPortRange: "23"},
+			{Name: "allow_corp", Priority: 1001, Direction: Inbound, Access: Allow, Protocol: "*", SourceAddressPrefix: "10.0.0.0/8", SourcePortRange: "1000-2000"},
+		},
+	}
+	if err := p.validateSecurityRules(); err != nil {
+		t.Errorf("should not error on valid security rules: %v", err)
+	}
+
+	p.SecurityRules[1].Priority = 1000
+	if err := p.validateSecurityRules(); err == nil {
+		t.Error("should error on duplicate security rule priorities")
+	}
+
+	p.SecurityRules[1].Priority = 500
+	if err := p.validateSecurityRules(); err == nil {
+		t.Error("should error on a security rule priority in the reserved range")
+	}
+
+	p.SecurityRules[1].Priority = 1001
+	p.SecurityRules[1].SourcePortRange = "2000-1000"
+	if err := p.validateSecurityRules(); err == nil {
+		t.Error("should error on an inverted port range")
+	}
+
+	p.SecurityRules[1].SourcePortRange = "*"
+	p.OrchestratorProfile.OrchestratorType = SwarmMode
+	if err := p.validateSecurityRules(); err == nil {
+		t.Error("should error on security rules for an orchestrator without network security groups")
+	}
+}
+
+func Test_ValidateEvictionThresholds(t *testing.T) {
+	if err := validateEvictionThresholds("memory.available<250Mi,nodefs.available<10%", "memory.available<1Gi", "memory.available=1m30s", "test"); err != nil {
+		t.Errorf("should not error on valid eviction thresholds: %v", err)
+	}
+
+	if err := validateEvictionThresholds("memory.available>250Mi", "", "", "test"); err == nil {
+		t.Error("should error on a threshold without the < operator")
+	}
+
+	if err := validateEvictionThresholds("cpu.available<1", "", "", "test"); err == nil {
+		t.Error("should error on an unknown eviction signal")
+	}
+
+	if err := validateEvictionThresholds("memory.available<lots", "", "", "test"); err == nil {
+		t.Error("should error on a threshold that is neither a quantity nor a percentage")
+	}
+
+	if err := validateEvictionThresholds("", "memory.available<1Gi", "", "test"); err == nil {
+		t.Error("should error on a soft threshold without a grace period")
+	}
+
+	if err := validateEvictionThresholds("", "memory.available<1Gi", "memory.available=soon", "test"); err == nil {
+		t.Error("should error on an invalid grace period")
+	}
+}
+
+func Test_AgentPoolProfile_ValidatePreloadImages(t *testing.T) {
+	a := &AgentPoolProfile{
+		Name:          "agentpool",
+		PreloadImages: []string{"nginx", "nginx:1.13", "myregistry.azurecr.io:5000/team/app:v1.0-rc1"},
+	}
+	if err := a.validatePreloadImages(Kubernetes); err != nil {
+		t.Errorf("should not error on valid image references: %v", err)
+	}
+
+	if err := a.validatePreloadImages(DCOS); err == nil {
+		t.Error("should error on preloaded images with Orchestrator DCOS")
+	}
+
+	a.PreloadImages = []string{"Nginx:latest"}
+	if err := a.validatePreloadImages(Kubernetes); err == nil {
+		t.Error("should error on an image reference with an uppercase repository")
+	}
+
+	a.PreloadImages = []string{"nginx:1.13 --all-tags"}
+	if err := a.validatePreloadImages(Kubernetes); err == nil {
+		t.Error("should error on an image reference containing spaces")
+	}
+}
+
+func Test_AgentPoolProfile_ValidateHostnamePrefix(t *testing.T) {
+	cases := []struct {
+		name             string
+		orchestratorType OrchestratorType
+		osType           OSType
+		prefix           string
+		expectedErr      bool
+	}{
+		{name: "no prefix", orchestratorType: Kubernetes},
+		{name: "swarm prefix", orchestratorType: Swarm, prefix: "web-01"},
+		{name: "dcos prefix", orchestratorType: DCOS, prefix: "dcos"},
+		{name: "kubernetes prefix", orchestratorType: Kubernetes, prefix: "web", expectedErr: true},
+		{name: "uppercase", orchestratorType: Swarm, prefix: "Web", expectedErr: true},
+		{name: "leading digit", orchestratorType: Swarm, prefix: "1web", expectedErr: true},
+		{name: "underscore", orchestratorType: Swarm, prefix: "web_01", expectedErr: true},
+		{name: "longest linux prefix", orchestratorType: Swarm, prefix: "a" + strings.Repeat("b", MaxLinuxHostnameLength-HostnameSuffixLength-1)},
+		{name: "linux prefix too long", orchestratorType: Swarm, prefix: "a" + strings.Repeat("b", MaxLinuxHostnameLength-HostnameSuffixLength), expectedErr: true},
+		{name: "longest windows prefix", orchestratorType: Swarm, osType: Windows, prefix: "a" + strings.Repeat("b", MaxWindowsHostnameLength-HostnameSuffixLength-1)},
+		{name: "windows prefix too long", orchestratorType: Swarm, osType: Windows, prefix: "a" + strings.Repeat("b", MaxWindowsHostnameLength-HostnameSuffixLength), expectedErr: true},
+	}
+	for _, c := range cases {
+		a := &AgentPoolProfile{Name: "agentpool", OSType: c.osType, HostnamePrefix: c.prefix}
+		err := a.validateHostnamePrefix(c.orchestratorType)
+		if c.expectedErr && err == nil {
+			t.Errorf("%s: should error on hostname prefix '%s'", c.name, c.prefix)
+		}
+		if !c.expectedErr && err != nil {
+			t.Errorf("%s: should not error on hostname prefix '%s': %v", c.name, c.prefix, err)
+		}
+	}
+}
+
+func Test_AgentPoolProfile_ValidatePorts(t *testing.T) {
+	cases := []struct {
+		name        string
+		ports       []int
+		expectedErr bool
+	}{
+		{name: "no ports", ports: nil},
+		{name: "valid ports", ports: []int{MinPort, 80, 443, MaxPort}},
+		{name: "port 0", ports: []int{80, 0}, expectedErr: true},
+		{name: "port above range", ports: []int{70000}, expectedErr: true},
+		{name: "negative port", ports: []int{-443}, expectedErr: true},
+		{name: "duplicate port", ports: []int{80, 443, 80}, expectedErr: true},
+	}
+	for _, c := range cases {
+		a := &AgentPoolProfile{Name: "agentpool", Ports: c.ports}
+		err := a.ValidatePorts()
+		if e, ok := err.(*common.ValidationError); c.expectedErr && (!ok || e.Field != "agentPoolProfiles[agentpool].ports") {
+			t.Errorf("%s: should error on ports %v, got %v", c.name, c.ports, err)
+		}
+		if !c.expectedErr && err != nil {
+			t.Errorf("%s: should not error on ports %v: %v", c.name, c.ports, err)
+		}
+	}
+}
+
+func Test_ValidateOSDiskSize(t *testing.T) {
+	cases := []struct {
+		name        string
+		size        int
+		expectedErr bool
+	}{
+		{name: "image default", size: 0},
+		{name: "valid size", size: 128},
+		{name: "maximum size", size: MaxDiskSizeGB},
+		{name: "below range", size: -1, expectedErr: true},
+		{name: "above range", size: 10230, expectedErr: true},
+	}
+	for _, c := range cases {
+		m := &MasterProfile{OSDiskSizeGB: c.size}
+		a := &AgentPoolProfile{Name: "agentpool", OSDiskSizeGB: c.size}
+		for profile, err := range map[string]error{"master": m.ValidateOSDiskSize(), "agent pool": a.ValidateOSDiskSize()} {
+			if c.expectedErr && err == nil {
+				t.Errorf("%s: should error on %s os disk size %d", c.name, profile, c.size)
+			}
+			if !c.expectedErr && err != nil {
+				t.Errorf("%s: should not error on %s os disk size %d: %v", c.name, profile, c.size, err)
+			}
+		}
+	}
+}
+
+func Test_ValidateDNSPrefix(t *testing.T) {
+	for _, prefix := range []string{"abc", "my-cluster", "1cluster", strings.Repeat("a", MaxDNSPrefixLength)} {
+		if err := ValidateDNSPrefix("masterProfile.dnsPrefix", prefix); err != nil {
+			t.Errorf("should not error on DNS prefix '%s': %v", prefix, err)
+		}
+	}
+	cases := map[string]string{
+		"ab": "too short",
+		strings.Repeat("a", MaxDNSPrefixLength+1): "too long",
+		"MyCluster":  "uppercase",
+		"my_cluster": "underscore",
+		"-cluster":   "leading hyphen",
+		"cluster-":   "trailing hyphen",
+	}
+	for prefix, rule := range cases {
+		err, ok := ValidateDNSPrefix("masterProfile.dnsPrefix", prefix).(*common.ValidationError)
+		if !ok || err.Field != "masterProfile.dnsPrefix" {
+			t.Errorf("should error on DNS prefix '%s' (%s), got %v", prefix, rule, err)
+		}
+	}
+}
+
+func Test_Properties_ValidateDNSPrefixes(t *testing.T) {
+	p := &Properties{
+		MasterProfile: &MasterProfile{DNSPrefix: "mycluster"},
+		AgentPoolProfiles: []*AgentPoolProfile{
+			{Name: "private"},
+			{Name: "public", DNSPrefix: "mycluster-public"},
+		},
+	}
+	if err := p.ValidateDNSPrefixes(); err != nil {
+		t.Errorf("should not error on valid DNS prefixes: %v", err)
+	}
+
+	p.AgentPoolProfiles[1].DNSPrefix = "mycluster_public"
+	p.MasterProfile.DNSPrefix = "-mycluster"
+	errs, ok := p.ValidateDNSPrefixes().(common.ValidationErrors)
+	if !ok || len(errs) != 2 || errs[0].Field != "masterProfile.dnsPrefix" || errs[1].Field != "agentPoolProfiles[public].dnsPrefix" {
+		t.Errorf("should report the invalid DNS prefixes of the master and of agent pool 'public', got %v", p.ValidateDNSPrefixes())
+	}
+}
+
+func Test_AgentPoolProfile_ValidateDataDiskMounts(t *testing.T) {
+	a := &AgentPoolProfile{
+		Name:        "agentpool",
+		DiskSizesGB: []int{128, 256},
+		DataDiskMounts: []DataDiskMount{
+			{FSType: "xfs", MountOptions: []string{"noatime", "discard"}, MountPoint: "/var/lib/data"},
+			{},
+		},
+	}
+	if err := a.validateDataDiskMounts(Kubernetes); err != nil {
+		t.Errorf("should not error on valid data disk mounts: %v", err)
+	}
+
+	if err := a.validateDataDiskMounts(DCOS); err == nil {
+		t.Error("should error on data disk mounts with Orchestrator DCOS")
+	}
+
+	a.DiskSizesGB = []int{128}
+	if err := a.validateDataDiskMounts(Kubernetes); err == nil {
+		t.Error("should error on more data disk mounts than data disks")
+	}
+	a.DiskSizesGB = []int{128, 256}
+
+	a.DataDiskMounts[1].FSType = "btrfs"
+	if err := a.validateDataDiskMounts(Kubernetes); err == nil {
+		t.Error("should error on an unsupported filesystem")
+	}
+	a.DataDiskMounts[1].FSType = ""
+
+	a.DataDiskMounts[1].MountOptions = []string{"noatime;reboot"}
+	if err := a.validateDataDiskMounts(Kubernetes); err == nil {
+		t.Error("should error on an unrecognized mount option")
+	}
+	a.DataDiskMounts[1].MountOptions = nil
+
+	for _, mountPoint := range []string{"/var/lib/data", "data", "/var/lib/../data", "/", "/var/lib/my data"} {
+		a.DataDiskMounts[1].MountPoint = mountPoint
+		if err := a.validateDataDiskMounts(Kubernetes); err == nil {
+			t.Errorf("should error on the mount point '%s'", mountPoint)
+		}
+	}
+}
+
+func Test_KubernetesConfig_ValidateAPIServerPort(t *testing.T) {
+	for _, port := range []int{0, 443, 6443} {
+		c := &KubernetesConfig{APIServerPort: port}
+		if err := c.validateAPIServerPort(); err != nil {
+			t.Errorf("should not error on apiserver port %d: %v", port, err)
+		}
+	}
+
+	for _, port := range []int{-1, 65536, 30000, 32767, 8080, 4443, 10250} {
+		c := &KubernetesConfig{APIServerPort: port}
+		if err := c.validateAPIServerPort(); err == nil {
+			t.Errorf("should error on apiserver port %d", port)
+		}
+	}
+}
+
+func Test_KubernetesConfig_ValidateArtifactChecksums(t *testing.T) {
+	valid := "9F86D081884C7D659A2FEAA0C55AD015A3BF4F1B2B0B822CD15D6C15B0F00A08"
+	c := &KubernetesConfig{AzureCNIChecksum: valid, AzureCNIVersion: "v0.8", WindowsBinariesChecksum: strings.ToLower(valid)}
+	if err := c.validateArtifactChecksums(); err != nil {
+		t.Errorf("should not error on valid checksums: %v", err)
+	}
+
+	for _, version := range []string{"", "latest"} {
+		c := &KubernetesConfig{AzureCNIChecksum: valid, AzureCNIVersion: version}
+		if err := c.validateArtifactChecksums(); err == nil {
+			t.Errorf("should error on an Azure VNET CNI checksum for version '%s'", version)
+		}
+	}
+
+	for _, checksum := range []string{valid[1:], valid + "0", "sha256:" + valid, strings.Replace(valid, "F", "G", 1)} {
+		c := &KubernetesConfig{WindowsBinariesChecksum: checksum}
+		if err := c.validateArtifactChecksums(); err == nil {
+			t.Errorf("should error on checksum '%s'", checksum)
+		}
+	}
+}
+
+func Test_Properties_ValidatePrivateRegistry(t *testing.T) {
+	p := &Properties{OrchestratorProfile: &OrchestratorProfile{OrchestratorType: Kubernetes}}
+	p.PrivateRegistryProfile = &PrivateRegistryProfile{
+		Server:     "myregistry.example.com:5000",
+		Username:   "puller",
+		Password:   "/subscriptions/SUB_ID/resourceGroups/RG_NAME/providers/Microsoft.KeyVault/vaults/KV_NAME/secrets/registry",
+		Namespaces: []string{"default", "apps"},
+	}
+	if err := p.validatePrivateRegistry(); err != nil {
+		t.Errorf("should not error on a valid private registry: %v", err)
+	}
+
+	for _, r := range []PrivateRegistryProfile{
+		{Server: "https://myregistry.example.com", Username: "puller", Password: "secret"},
+		{Server: "myregistry.example.com", Password: "secret"},
+		{Server: "myregistry.example.com", Username: "puller", Password: "my secret"},
+		{Server: "myregistry.example.com", Username: "puller", Password: "/subscriptions/SUB_ID/secrets/registry"},
+		{Server: "myregistry.example.com", Username: "puller", Password: "secret", Namespaces: []string{"Apps"}},
+	} {
+		p.PrivateRegistryProfile = &r
+		if err := p.validatePrivateRegistry(); err == nil {
+			t.Errorf("should error on private registry %+v", r)
+		}
+	}
+}
+
+func Test_KubernetesConfig_ValidateLeaderElection(t *testing.T) {
+	for _, c := range []KubernetesConfig{
+		{},
+		{LeaderElectLeaseDuration: "30s", LeaderElectRenewDeadline: "20s", LeaderElectRetryPeriod: "5s"},
+		{LeaderElectLeaseDuration: "1m", LeaderElectRenewDeadline: "45s", LeaderElectRetryPeriod: "10s"},
+	} {
+		if err := c.validateLeaderElection(); err != nil {
+			t.Errorf("should not error on leader election %+v: %v", c, err)
+		}
+	}
+
+	for _, c := range []KubernetesConfig{
+		{LeaderElectLeaseDuration: "30s"},
+		{LeaderElectLeaseDuration: "30", LeaderElectRenewDeadline: "20s", LeaderElectRetryPeriod: "5s"},
+		{LeaderElectLeaseDuration: "30s", LeaderElectRenewDeadline: "20s", LeaderElectRetryPeriod: "-5s"},
+		{LeaderElectLeaseDuration: "20s", LeaderElectRenewDeadline: "20s", LeaderElectRetryPeriod: "5s"},
+		{LeaderElectLeaseDuration: "30s", LeaderElectRenewDeadline: "6s", LeaderElectRetryPeriod: "5s"},
+	} {
+		if err := c.validateLeaderElection(); err == nil {
+			t.Errorf("should error on leader election %+v", c)
+		}
+	}
+}
+
+func Test_ValidateMasterStaticIPs(t *testing.T) {
+	if err := validateMasterStaticIPs(net.ParseIP("10.239.255.239"), 5, false, "10.239.0.0/16"); err != nil {
+		t.Errorf("should not error on static IPs within the subnet: %v", err)
+	}
+
+	err := validateMasterStaticIPs(net.ParseIP("10.239.255.251"), 5, false, "10.239.0.0/16")
+	if err == nil || !strings.Contains(err.Error(), "10.239.255.255 of master 4") {
+		t.Errorf("should error on the broadcast address, got %v", err)
+	}
+	if err := validateMasterStaticIPs(net.ParseIP("10.239.0.2"), 3, false, "10.239.0.0/24"); err == nil {
+		t.Errorf("should error on the addresses reserved at the start of the subnet")
+	}
+	if err := validateMasterStaticIPs(net.ParseIP("10.240.0.5"), 1, false, "10.239.0.0/24"); err == nil {
+		t.Errorf("should error on a static IP outside the subnet")
+	}
+	if err := validateMasterStaticIPs(net.ParseIP("10.239.0.4"), 1, false, "10.239.0.0/30"); err == nil {
+		t.Errorf("should error on a subnet smaller than a /29")
+	}
+
+	if err := validateMasterStaticIPs(net.ParseIP("10.239.0.240"), 3, true, "10.239.0.0/24"); err != nil {
+		t.Errorf("should not error on an internal load balancer IP within the subnet: %v", err)
+	}
+	err = validateMasterStaticIPs(net.ParseIP("10.239.0.245"), 3, true, "10.239.0.0/24")
+	if err == nil || !strings.Contains(err.Error(), "10.239.0.255 of the master internal load balancer") {
+		t.Errorf("should error on an internal load balancer IP outside the usable range, got %v", err)
+	}
+}
+
+func Test_Properties_ValidateRuntimeReservedMilliCPU(t *testing.T) {
+	p := &Properties{
+		OrchestratorProfile: &OrchestratorProfile{
+			OrchestratorType: Kubernetes,
+			KubernetesConfig: &KubernetesConfig{RuntimeReservedMilliCPU: 1500},
+		},
+		MasterProfile: &MasterProfile{VMSize: "Standard_D2_v2"},
+		AgentPoolProfiles: []*AgentPoolProfile{
+			{Name: "linuxpool", VMSize: "Standard_D4_v2", RuntimeReservedMilliCPU: 4000},
+			{Name: "windowspool", VMSize: "Standard_A1", OSType: Windows},
+		},
+	}
+	if err := p.validateRuntimeReservedMilliCPU(); err != nil {
+		t.Errorf("should not error on reservations that fit the VM sizes: %v", err)
+	}
+
+	p.AgentPoolProfiles[0].RuntimeReservedMilliCPU = 8000
+	if err := p.validateRuntimeReservedMilliCPU(); err == nil {
+		t.Errorf("should error on a pool reservation of all the vCPUs of the VM size")
+	}
+
+	p.AgentPoolProfiles[0].RuntimeReservedMilliCPU = 0
+	p.AgentPoolProfiles[0].VMSize = "Standard_D1_v2"
+	if err := p.validateRuntimeReservedMilliCPU(); err == nil {
+		t.Errorf("should error on a cluster reservation that does not fit the VM size of a pool")
+	}
+
+	p.AgentPoolProfiles[0].VMSize = "Standard_Unknown_v9"
+	if err := p.validateRuntimeReservedMilliCPU(); err == nil {
+		t.Errorf("should error on a reservation for a VM size whose vCPUs are unknown")
+	}
+
+	p.AgentPoolProfiles[0].VMSize = "Standard_D4_v2"
+	p.AgentPoolProfiles[1].RuntimeReservedMilliCPU = 500
+	if err := p.validateRuntimeReservedMilliCPU(); err == nil {
+		t.Errorf("should error on a reservation for a Windows pool")
+	}
+}
+
+func Test_Properties_ValidateGPUTaint(t *testing.T) {
+	taint := true
+	p := &Properties{
+		OrchestratorProfile: &OrchestratorProfile{
+			OrchestratorType:    Kubernetes,
+			OrchestratorVersion: Kubernetes166,
+		},
+		AgentPoolProfiles: []*AgentPoolProfile{
+			{Name: "gpupool", VMSize: "Standard_NC6", TaintGPUNodes: &taint},
+		},
+	}
+	if err := p.validateGPUTaint(); err != nil {
+		t.Errorf("should not error on a tainted GPU pool: %v", err)
+	}
+
+	p.OrchestratorProfile.OrchestratorVersion = Kubernetes157
+	if err := p.validateGPUTaint(); err == nil {
+		t.Errorf("should error on a tainted GPU pool with Kubernetes 1.5")
+	}
+
+	p.OrchestratorProfile.OrchestratorVersion = Kubernetes166
+	p.AgentPoolProfiles[0].VMSize = "Standard_D2_v2"
+	if err := p.validateGPUTaint(); err == nil {
+		t.Errorf("should error on a tainted pool without GPUs")
+	}
+
+	taint = false
+	if err := p.validateGPUTaint(); err != nil {
+		t.Errorf("should not error on an untainted pool: %v", err)
+	}
+}
+
+func Test_Properties_ValidateServiceAccountKeys(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicKey, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := &Properties{
+		OrchestratorProfile: &OrchestratorProfile{
+			OrchestratorType:    Kubernetes,
+			OrchestratorVersion: Kubernetes166,
+		},
+		CertificateProfile: &CertificateProfile{
+			ServiceAccountPrivateKey:       string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})),
+			ServiceAccountVerificationKeys: []string{string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKey}))},
+		},
+	}
+	if err := p.validateServiceAccountKeys(); err != nil {
+		t.Errorf("should not error on valid service account keys: %v", err)
+	}
+
+	p.OrchestratorProfile.OrchestratorVersion = Kubernetes157
+	if err := p.validateServiceAccountKeys(); err == nil {
+		t.Errorf("should error on verification keys with Kubernetes 1.5")
+	}
+
+	p.OrchestratorProfile.OrchestratorVersion = Kubernetes166
+	p.CertificateProfile.ServiceAccountVerificationKeys = []string{p.CertificateProfile.ServiceAccountPrivateKey}
+	if err := p.validateServiceAccountKeys(); err == nil {
+		t.Errorf("should error on a private key among the verification keys")
+	}
+
+	p.CertificateProfile.ServiceAccountVerificationKeys = nil
+	p.CertificateProfile.ServiceAccountPrivateKey = "not a key"
+	if err := p.validateServiceAccountKeys(); err == nil {
+		t.Errorf("should error on a signing key that is not PEM encoded")
+	}
+}
+
+func Test_Properties_ValidateBlockPodIMDSAccess(t *testing.T) {
+	block := true
+	p := &Properties{
+		OrchestratorProfile: &OrchestratorProfile{
+			OrchestratorType: Kubernetes,
+			KubernetesConfig: &KubernetesConfig{BlockPodIMDSAccess: &block},
+		},
+		AgentPoolProfiles: []*AgentPoolProfile{{Name: "linuxpool"}},
+	}
+	if err := p.validateBlockPodIMDSAccess(); err != nil {
+		t.Errorf("should not error on Linux agent pools: %v", err)
+	}
+
+	p.AgentPoolProfiles = append(p.AgentPoolProfiles, &AgentPoolProfile{Name: "windowspool", OSType: Windows})
+	if err := p.validateBlockPodIMDSAccess(); err == nil {
+		t.Errorf("should error on Windows agent pools")
+	}
+}
+
+func Test_Properties_ValidateAddonsReadOnlyRootFilesystem(t *testing.T) {
+	readOnly := true
+	p := &Properties{
+		OrchestratorProfile: &OrchestratorProfile{
+			OrchestratorType: Kubernetes,
+			KubernetesConfig: &KubernetesConfig{AddonsReadOnlyRootFilesystem: &readOnly},
+		},
+		AgentPoolProfiles: []*AgentPoolProfile{{Name: "windowspool", OSType: Windows}},
+	}
+	if err := p.validateAddonsReadOnlyRootFilesystem(); err == nil {
+		t.Errorf("should error without a Linux agent pool")
+	}
+
+	p.AgentPoolProfiles = append(p.AgentPoolProfiles, &AgentPoolProfile{Name: "linuxpool"})
+	if err := p.validateAddonsReadOnlyRootFilesystem(); err != nil {
+		t.Errorf("should not error with a Linux agent pool: %v", err)
+	}
+
+	readOnly = false
+	p.AgentPoolProfiles = p.AgentPoolProfiles[:1]
+	if err := p.validateAddonsReadOnlyRootFilesystem(); err != nil {
+		t.Errorf("should not error when the addons keep a writable root filesystem: %v", err)
+	}
+}
+
+func Test_Properties_ValidateDefaultDenyNamespaces(t *testing.T) {
+	k := &KubernetesConfig{NetworkPolicy: "calico", DefaultDenyNamespaces: []string{"default", "team-a"}}
+	p := &Properties{
+		OrchestratorProfile: &OrchestratorProfile{OrchestratorType: Kubernetes, KubernetesConfig: k},
+	}
+	if err := p.validateDefaultDenyNamespaces(); err != nil {
+		t.Errorf("should not error on valid namespaces: %v", err)
+	}
+
+	k.NetworkPolicy = "azure"
+	if err := p.validateDefaultDenyNamespaces(); err == nil {
+		t.Errorf("should error when the network policy does not enforce isolation")
+	}
+	k.NetworkPolicy = "calico"
+
+	for _, namespaces := range [][]string{{"Team_A"}, {"kube-system"}, {"default", "default"}} {
+		k.DefaultDenyNamespaces = namespaces
+		if err := p.validateDefaultDenyNamespaces(); err == nil {
+			t.Errorf("should error on namespaces %v", namespaces)
+		}
+	}
+}
+
+func Test_LinuxProfile_ValidatePublicKeys(t *testing.T) {
+	const validKey = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCXwdOfLFK4hN4mFH6ePUYtYGBWLvW5YIv/wJvp6dcg4yc7VubhgHLVXBFWZM8mVl2cA1mLcRmivamG0iTk2DZRNWkoKv5A3xz16g/kDN26oqUR3isONRqfzQrmyE+IXw56zmyyZL4/mZ6dRA6tcGcNl/Uy9G+2dGjeqkF6+CtvTVolOlgMrYN0Qndg+juIy3iEIVwBoRjtv9qNr5eUZH1KVTUg6GHSS1dDC7cShUy+8z6Ky8wUO/sKMxNUAViy06dv7G8vHfhoOlrLqXWHf8UqjtNnwPZhbsFccmQzIr+b5lflWWdvmkHFpXkpch+F5TtXmxGraBJoaG8Zvx4C/1ij azureuser@linuxvm"
+	newLinuxProfile := func(keys ...string) *LinuxProfile {
+		l := &LinuxProfile{AdminUsername: "azureuser"}
+		for _, key := range keys {
+			l.SSH.PublicKeys = append(l.SSH.PublicKeys, struct {
+				KeyData string `json:"keyData"`
+			}{KeyData: key})
+		}
+		return l
+	}
+
+	if err := newLinuxProfile().Validate(); err == nil {
+		t.Errorf("should error when no key is specified")
+	}
+	if err := newLinuxProfile(validKey).Validate(); err != nil {
+		t.Errorf("should not error on a valid key: %v", err)
+	}
+	if err := newLinuxProfile(validKey, strings.Replace(validKey, "azureuser@linuxvm", "other@host", 1)).validatePublicKeys(); err == nil || !strings.Contains(err.Error(), "PublicKeys[1]") {
+		t.Errorf("should error on the duplicate key at index 1, got: %v", err)
+	}
+	for _, key := range []string{"", "garbage", "ssh-rsa not-base64!", "ssh-ed25519 " + strings.Fields(validKey)[1]} {
+		if err := newLinuxProfile(key).validatePublicKeys(); err == nil || !strings.Contains(err.Error(), "PublicKeys[0]") {
+			t.Errorf("should error on the malformed key '%s' at index 0, got: %v", key, err)
+		}
+	}
+}
+
+func Test_WindowsProfile_Validate(t *testing.T) {
+	w := &WindowsProfile{AdminUsername: "azureuser", AdminPassword: "replacepassword1234$"}
+	if err := w.Validate(); err != nil {
+		t.Errorf("should not error on a valid profile: %v", err)
+	}
+
+	w.AdminPassword = "/subscriptions/my-sub/resourceGroups/my-rg/providers/Microsoft.KeyVault/vaults/my-kv/secrets/adminPassword"
+	if err := w.Validate(); err != nil {
+		t.Errorf("should not error on a keyvault reference: %v", err)
+	}
+
+	cases := map[string]WindowsProfile{
+		"must be a non-empty value":                {AdminUsername: "azureuser"},
+		"between 8 and 123 characters":             {AdminUsername: "azureuser", AdminPassword: "Ab1$"},
+		"at least 3 of the uppercase":              {AdminUsername: "azureuser", AdminPassword: "replacepassword"},
+		"is not allowed by Azure":                  {AdminUsername: "Administrator", AdminPassword: "replacepassword1234$"},
+		"is not a valid keyvault secret reference": {AdminUsername: "azureuser", AdminPassword: "/subscriptions/my-sub"},
+	}
+	for expected, profile := range cases {
+		err, ok := profile.Validate().(*common.ValidationError)
+		if !ok || !strings.HasPrefix(err.Field, "windowsProfile.admin") || !strings.Contains(err.Message, expected) {
+			t.Errorf("expected an error of the admin credentials containing '%s' for %+v, got: %v", expected, profile, profile.Validate())
+		}
+	}
+	if err := (&WindowsProfile{AdminUsername: "azureuser", AdminPassword: strings.Repeat("aB1$", 31)}).Validate(); err == nil {
+		t.Errorf("should error on a password longer than %d characters", MaxWindowsPasswordLength)
+	}
+}
+
+func Test_Properties_ValidateCloudProviderRateLimit(t *testing.T) {
+	k := &KubernetesConfig{CloudProviderRateLimitQPS: 5, CloudProviderRateLimitBucket: 20}
+	p := &Properties{
+		OrchestratorProfile: &OrchestratorProfile{OrchestratorType: Kubernetes, OrchestratorVersion: Kubernetes166, KubernetesConfig: k},
+	}
+	if err := p.validateCloudProviderRateLimit(); err != nil {
+		t.Errorf("should not error on a positive rate limit: %v", err)
+	}
+
+	k.CloudProviderRateLimitQPS = -1
+	if err := p.validateCloudProviderRateLimit(); err == nil {
+		t.Errorf("should error on a negative rate")
+	}
+	k.CloudProviderRateLimitQPS = 5
+	k.CloudProviderRateLimitBucket = -1
+	if err := p.validateCloudProviderRateLimit(); err == nil {
+		t.Errorf("should error on a negative burst")
+	}
+	k.CloudProviderRateLimitBucket = 20
+
+	p.OrchestratorProfile.OrchestratorVersion = Kubernetes162
+	if err := p.validateCloudProviderRateLimit(); err == nil {
+		t.Errorf("should error on Kubernetes versions without rate limiting")
+	}
+}
+
+func Test_Properties_ValidateCNIVersions(t *testing.T) {
+	k := &KubernetesConfig{NetworkPolicy: "azure", AzureCNIVersion: "v0.8"}
+	p := &Properties{
+		OrchestratorProfile: &OrchestratorProfile{OrchestratorType: Kubernetes, OrchestratorVersion: Kubernetes157, KubernetesConfig: k},
+	}
+	if err := p.validateCNIVersions(); err != nil {
+		t.Errorf("should not error on a pinned Azure CNI release: %v", err)
+	}
+	k.AzureCNIVersion = "0.8-beta"
+	if err := p.validateCNIVersions(); err == nil {
+		t.Errorf("should error on an invalid Azure CNI release tag")
+	}
+	k.AzureCNIVersion = "v0.8"
+	k.NetworkPolicy = "calico"
+	if err := p.validateCNIVersions(); err == nil {
+		t.Errorf("should error when the Azure CNI plugin is not installed")
+	}
+
+	k.AzureCNIVersion = ""
+	k.CalicoVersion = "v2.3.0"
+	if err := p.validateCNIVersions(); err == nil {
+		t.Errorf("should error on a Calico release that does not support Kubernetes %s", Kubernetes157)
+	}
+	p.OrchestratorProfile.OrchestratorVersion = ""
+	if err := p.validateCNIVersions(); err != nil {
+		t.Errorf("should not error on a Calico release that supports the default Kubernetes version: %v", err)
+	}
+	k.CalicoVersion = "v9.9.9"
+	if err := p.validateCNIVersions(); err == nil {
+		t.Errorf("should error on an unknown Calico release")
+	}
+}
+
+func Test_Properties_ValidateTopologyLabels(t *testing.T) {
+	topologyLabels := true
+	p := &Properties{
+		OrchestratorProfile: &OrchestratorProfile{OrchestratorType: Kubernetes, KubernetesConfig: &KubernetesConfig{TopologyLabels: &topologyLabels}},
+		AgentPoolProfiles:   []*AgentPoolProfile{{Name: "linuxpool", OSType: Linux}},
+	}
+	if err := p.validateTopologyLabels(); err != nil {
+		t.Errorf("should not error on topology labels with Linux agent pools: %v", err)
+	}
+	p.AgentPoolProfiles = append(p.AgentPoolProfiles, &AgentPoolProfile{Name: "windowspool", OSType: Windows})
+	if err := p.validateTopologyLabels(); err == nil {
+		t.Errorf("should error on topology labels with a Windows agent pool")
+	}
+}
+
+func Test_Properties_ValidateEtcdDeploymentMode(t *testing.T) {
+	k := &KubernetesConfig{}
+	p := &Properties{
+		OrchestratorProfile: &OrchestratorProfile{OrchestratorType: Kubernetes, KubernetesConfig: k},
+	}
+	for _, mode := range []string{"", common.EtcdDeploymentModeSystemd, common.EtcdDeploymentModeStaticPod} {
+		k.EtcdDeploymentMode = mode
+		if err := p.validateEtcdDeploymentMode(); err != nil {
+			t.Errorf("should not error on etcd deployment mode '%s': %v", mode, err)
+		}
+	}
+	k.EtcdDeploymentMode = "docker"
+	if err := p.validateEtcdDeploymentMode(); err == nil {
+		t.Errorf("should error on an unknown etcd deployment mode")
+	}
+	k.EtcdDeploymentMode = common.EtcdDeploymentModeStaticPod
+	p.OrchestratorProfile.OrchestratorVersion = "1.4.6"
+	if err := p.validateEtcdDeploymentMode(); err == nil {
+		t.Errorf("should error on a Kubernetes version the etcd deployment mode does not support")
+	}
+	p.OrchestratorProfile.OrchestratorVersion = Kubernetes157
+	if err := p.validateEtcdDeploymentMode(); err == nil {
+		t.Errorf("should error on etcd deployment mode %s with Kubernetes %s", common.EtcdDeploymentModeStaticPod, Kubernetes157)
+	}
+	k.EtcdDeploymentMode = common.EtcdDeploymentModeSystemd
+	if err := p.validateEtcdDeploymentMode(); err != nil {
+		t.Errorf("should not error on etcd deployment mode %s with Kubernetes %s: %v", common.EtcdDeploymentModeSystemd, Kubernetes157, err)
+	}
+}
+
+func Test_Properties_ValidateImageDigests(t *testing.T) {
+	digest := "@sha256:" + strings.Repeat("ab", 32)
+	k := &KubernetesConfig{
+		PodInfraContainerImage: "myregistry.azurecr.io/pause-amd64" + digest,
+	}
+	p := &Properties{
+		OrchestratorProfile: &OrchestratorProfile{OrchestratorType: Kubernetes, KubernetesConfig: k},
+		AgentPoolProfiles: []*AgentPoolProfile{
+			{Name: "agentpool1", PreloadImages: []string{"myregistry.azurecr.io/myimage:1.0"}},
+		},
+	}
+	if err := p.validateImageDigests(); err != nil {
+		t.Errorf("should not error on a pod infra container image pinned by digest: %v", err)
+	}
+
+	k.PodInfraContainerImage = "myregistry.azurecr.io/pause-amd64@sha256:abc"
+	if err := p.validateImageDigests(); err == nil {
+		t.Errorf("should error on a malformed digest")
+	}
+	k.PodInfraContainerImage = "myregistry.azurecr.io/Pause-amd64:3.0"
+	if err := p.validateImageDigests(); err == nil {
+		t.Errorf("should error on an invalid pod infra container image reference")
+	}
+
+	requireImageDigests := true
+	k.RequireImageDigests = &requireImageDigests
+	k.PodInfraContainerImage = "myregistry.azurecr.io/pause-amd64" + digest
+	k.KubeProxyImage = "myregistry.azurecr.io/hyperkube-amd64" + digest
+	p.AgentPoolProfiles[0].PreloadImages = []string{"myregistry.azurecr.io/myimage:1.0" + digest}
+	if err := p.validateImageDigests(); err == nil {
+		t.Errorf("should error when digests are required, as the hyperkube, addon and Calico images are referenced by tag")
+	}
+	requireImageDigests = false
+	if err := p.validateImageDigests(); err != nil {
+		t.Errorf("should not error when digests are not required: %v", err)
+	}
+}
+
+func Test_Properties_ValidateKubeProxy(t *testing.T) {
+	k := &KubernetesConfig{
+		KubeProxyImage: "myregistry.azurecr.io/hyperkube-amd64:v1.6.6",
+		KubeProxyConfig: &KubeProxyConfig{
+			ClientConnectionQPS:            20,
+			ClientConnectionBurst:          40,
+			ConntrackMaxPerCore:            65536,
+			ConntrackTCPEstablishedTimeout: "12h",
+		},
+	}
+	p := &Properties{
+		OrchestratorProfile: &OrchestratorProfile{OrchestratorType: Kubernetes, KubernetesConfig: k},
+	}
+	if err := p.validateKubeProxy(); err != nil {
+		t.Errorf("should not error on a valid kube-proxy image and config: %v", err)
+	}
+
+	k.KubeProxyImage = "myregistry.azurecr.io/Hyperkube:v1.6.6"
+	if err := p.validateKubeProxy(); err == nil {
+		t.Errorf("should error on an invalid kube-proxy image reference")
+	}
+	k.KubeProxyImage = ""
+
+	k.KubeProxyConfig.ClientConnectionBurst = 10
+	if err := p.validateKubeProxy(); err == nil {
+		t.Errorf("should error on a burst lower than the rate")
+	}
+	k.KubeProxyConfig.ClientConnectionBurst = 40
+	k.KubeProxyConfig.ConntrackMin = -1
+	if err := p.validateKubeProxy(); err == nil {
+		t.Errorf("should error on a negative conntrack minimum")
+	}
+	k.KubeProxyConfig.ConntrackMin = 0
+	for _, timeout := range []string{"12", "-1h", "0s"} {
+		k.KubeProxyConfig.ConntrackTCPEstablishedTimeout = timeout
+		if err := p.validateKubeProxy(); err == nil {
+			t.Errorf("should error on the conntrack timeout '%s'", timeout)
+		}
+	}
+}
+
+func Test_LinuxProfile_ValidateHostAliases(t *testing.T) {
+	l := &LinuxProfile{HostAliases: []HostAlias{
+		{IP: "10.1.2.3", Hostnames: []string{"repo.internal", "repo"}},
+		{IP: "fd00::1", Hostnames: []string{"v6.internal"}},
+	}}
+	if err := l.validateHostAliases(); err != nil {
+		t.Errorf("should not error on valid host aliases: %v", err)
+	}
+
+	for _, alias := range []HostAlias{
+		{IP: "10.1.2", Hostnames: []string{"repo"}},
+		{IP: "10.1.2.3"},
+		{IP: "10.1.2.3", Hostnames: []string{"repo_internal"}},
+		{IP: "10.1.2.3", Hostnames: []string{"-repo"}},
+		{IP: "10.1.2.3", Hostnames: []string{strings.Repeat("a.", 127) + "a"}},
+	} {
+		l.HostAliases = []HostAlias{alias}
+		if err := l.validateHostAliases(); err == nil {
+			t.Errorf("should error on host alias %+v", alias)
+		}
+	}
+}
+
+func Test_Properties_ValidateVNET(t *testing.T) {
+	vnetID := "/subscriptions/sub-id/resourceGroups/rg-name/providers/Microsoft.Network/virtualNetworks/vnet-name"
+	p := &Properties{
+		MasterProfile: &MasterProfile{Count: 1},
+		AgentPoolProfiles: []*AgentPoolProfile{
+			{Name: "agentpool1"},
+			{Name: "agentpool2"},
+		},
+	}
+	if err := p.ValidateVNET(); err != nil {
+		t.Errorf("should not error without custom VNETs: %v", err)
+	}
+
+	p.MasterProfile.VnetSubnetID = vnetID + "/subnets/master"
+	p.MasterProfile.FirstConsecutiveStaticIP = "10.239.255.239"
+	p.AgentPoolProfiles[0].VnetSubnetID = vnetID + "/subnets/agentpool1"
+	if err := p.ValidateVNET(); err == nil {
+		t.Error("should error when agentpool2 does not use the custom VNET of the master")
+	}
+
+	p.AgentPoolProfiles[1].VnetSubnetID = vnetID + "/subnets/agentpool2"
+	if err := p.ValidateVNET(); err != nil {
+		t.Errorf("should not error when all profiles use the custom VNET: %v", err)
+	}
+
+	p.AgentPoolProfiles[1].VnetSubnetID = strings.ToUpper(vnetID) + "/subnets/agentpool2"
+	if err := p.ValidateVNET(); err != nil {
+		t.Errorf("should not error when a profile references the custom VNET in another case: %v", err)
+	}
+
+	p.MasterProfile.FirstConsecutiveStaticIP = ""
+	if err := p.ValidateVNET(); err == nil {
+		t.Error("should error on a custom VNET without MasterProfile.FirstConsecutiveStaticIP")
+	}
+
+	p.MasterProfile.FirstConsecutiveStaticIP = "10.239.255.239"
+	p.AgentPoolProfiles[1].VnetSubnetID = "/subscriptions/sub-id/resourceGroups/rg-name/providers/Microsoft.Network/virtualNetworks/other-vnet/subnets/agentpool2"
+	if err := p.ValidateVNET(); err == nil {
+		t.Error("should error when the agent pools use different VNETs")
+	}
+
+	p.MasterProfile.VnetSubnetID = ""
+	p.MasterProfile.FirstConsecutiveStaticIP = ""
+	if err := p.ValidateVNET(); err == nil {
+		t.Error("should error when the agent pools use custom VNETs but the master does not")
+	}
+}
+
+func Test_MasterProfile_ValidateFirstConsecutiveStaticIP(t *testing.T) {
+	m := &MasterProfile{}
+	if err := m.ValidateFirstConsecutiveStaticIP(); err != nil {
+		t.Errorf("should not error on an empty IP without a custom VNET: %v", err)
+	}
+
+	m.VnetSubnetID = "/subscriptions/sub-id/resourceGroups/rg-name/providers/Microsoft.Network/virtualNetworks/vnet-name/subnets/master"
+	if err := m.ValidateFirstConsecutiveStaticIP(); err == nil {
+		t.Error("should error on an empty IP with a custom VNET")
+	}
+
+	m.FirstConsecutiveStaticIP = "10.239.255.239"
+	if err := m.ValidateFirstConsecutiveStaticIP(); err != nil {
+		t.Errorf("should not error on %s: %v", m.FirstConsecutiveStaticIP, err)
+	}
+
+	for _, ip := range []string{"fd00::5", "10.239.255", "not-an-ip"} {
+		m.FirstConsecutiveStaticIP = ip
+		if err := m.ValidateFirstConsecutiveStaticIP(); err == nil {
+			t.Errorf("should error on %s", ip)
+		}
+	}
+}
+
+func Test_AgentPoolProfile_ValidateAvailabilityZones(t *testing.T) {
+	a := &AgentPoolProfile{Name: "agentpool1", StorageProfile: ManagedDisks, AvailabilityProfile: VirtualMachineScaleSets}
+	if err := a.ValidateAvailabilityZones(); err != nil {
+		t.Errorf("should not error without availability zones: %v", err)
+	}
+
+	a.AvailabilityZones = []string{"1", "2", "3"}
+	err, ok := a.ValidateAvailabilityZones().(*common.ValidationError)
+	if !ok || err.Field != "agentPoolProfiles[agentpool1].availabilityZones" || !strings.Contains(err.Message, "not supported") {
+		t.Errorf("should reject availability zones the templates cannot honour, got %v", a.ValidateAvailabilityZones())
+	}
+}
+
+func Test_WindowsProfile_ValidateCustomImage(t *testing.T) {
+	w := &WindowsProfile{AdminUsername: "azureuser", AdminPassword: "replacepassword1234$"}
+	if w.HasCustomImage() {
+		t.Error("should not have a custom image without image fields")
+	}
+	if err := w.ValidateCustomImage(); err != nil {
+		t.Errorf("should not error without a custom image: %v", err)
+	}
+
+	w.ImagePublisher = "MicrosoftWindowsServer"
+	w.ImageOffer = "WindowsServer"
+	if err, ok := w.ValidateCustomImage().(*common.ValidationError); !ok || err.Field != "windowsProfile.imageSku" {
+		t.Errorf("should error on the missing windowsProfile.imageSku, got %v", err)
+	}
+
+	w.ImageSku = "2016-Datacenter-Server-Core"
+	if err := w.ValidateCustomImage(); err != nil {
+		t.Errorf("should not error on a custom image without a version: %v", err)
+	}
+	w.ImageVersion = "2016.127.20170630"
+	if err := w.ValidateCustomImage(); err != nil {
+		t.Errorf("should not error on a fully specified custom image: %v", err)
+	}
+
+	w.ImageVersion = "2016.127"
+	if err, ok := w.ValidateCustomImage().(*common.ValidationError); !ok || err.Field != "windowsProfile.imageVersion" {
+		t.Errorf("should error on a version that is not major.minor.patch, got %v", err)
+	}
+	w.ImageVersion = "latest"
+
+	w.ImageOffer = "WindowsServer', 'x"
+	if err, ok := w.ValidateCustomImage().(*common.ValidationError); !ok || err.Field != "windowsProfile.imageOffer" {
+		t.Errorf("should error on an offer with quotes and spaces, got %v", err)
+	}
+
+	w = &WindowsProfile{ImageVersion: "latest"}
+	if err, ok := w.ValidateCustomImage().(*common.ValidationError); !ok || err.Field != "windowsProfile.imagePublisher" {
+		t.Errorf("should error on the missing windowsProfile.imagePublisher, got %v", err)
+	}
+}
+
+func Test_Properties_ValidateAgentPoolNames(t *testing.T) {
+	p := &Properties{
+		AgentPoolProfiles: []*AgentPoolProfile{
+			{Name: "agentpool1"},
+			{Name: "gpupool"},
+			{Name: "win2"},
+		},
+	}
+	if err := p.ValidateAgentPoolNames(); err != nil {
+		t.Errorf("should not error on valid, unique pool names: %v", err)
+	}
+
+	p.AgentPoolProfiles[2].Name = "agentpool1"
+	if err := p.ValidateAgentPoolNames(); err == nil || !strings.Contains(err.Error(), "agentpool1") {
+		t.Errorf("should error naming the duplicate pool name agentpool1, got %v", err)
+	}
+
+	p.AgentPoolProfiles[1].Name = "GPUPool"
+	errs, ok := p.ValidateAgentPoolNames().(common.ValidationErrors)
+	if !ok || len(errs) != 2 {
+		t.Fatalf("should return the invalid and the duplicate pool names, got %v", errs)
+	}
+	if errs[0].Field != "agentPoolProfiles[GPUPool].name" || errs[1].Field != "agentPoolProfiles[agentpool1].name" {
+		t.Errorf("unexpected fields of the errors: %s and %s", errs[0].Field, errs[1].Field)
+	}
+	if !strings.Contains(errs.Error(), "agentPoolProfiles[GPUPool].name: ") || !strings.Contains(errs.Error(), "; agentPoolProfiles[agentpool1].name: ") {
+		t.Errorf("should join the errors, got %s", errs.Error())
+	}
+
+	p.AgentPoolProfiles[1].Name = "gpupool"
+	for _, name := range []string{"agentpool1234", "AgentPool", "agent-pool", "1pool", ""} {
+		p.AgentPoolProfiles[2].Name = name
+		if err := p.ValidateAgentPoolNames(); err == nil {
+			t.Errorf("should error on pool name '%s'", name)
+		}
+	}
+}
+
+func Test_AgentPoolProfile_ValidateCount(t *testing.T) {
+	tests := []struct {
+		count int
+		valid bool
+	}{
+		{0, false},
+		{1, true},
+		{100, true},
+		{101, false},
+	}
+
+	for _, test := range tests {
+		a := &AgentPoolProfile{Name: "agentpool1", Count: test.count}
+		err := a.ValidateCount()
+		if test.valid && err != nil {
+			t.Errorf("should not error on %d agents: %v", test.count, err)
+		}
+		if !test.valid {
+			if err == nil {
+				t.Errorf("should error on %d agents", test.count)
+			} else if !strings.Contains(err.Error(), "agentPoolProfiles[agentpool1].count") {
+				t.Errorf("should name the count of the agent pool, got %v", err)
+			}
+		}
+	}
+}
+
+func Test_ValidationError(t *testing.T) {
+	err := (&MasterProfile{Count: 2}).ValidateCount(Kubernetes)
+	verr, ok := err.(*common.ValidationError)
+	if !ok {
+		t.Fatalf("should return a common.ValidationError, got %T", err)
+	}
+	if verr.Field != "masterProfile.count" {
+		t.Errorf("unexpected field %s", verr.Field)
+	}
+	if verr.Error() != "masterProfile.count: is 2 and needs to be one of [1 3 5] for Orchestrator Kubernetes, to keep a quorum of masters" {
+		t.Errorf("unexpected error %s", verr.Error())
+	}
+
+	errs := common.ValidationErrors{
+		{Field: "masterProfile.count", Message: "needs to be 1, 3, or 5"},
+		{Field: "agentPoolProfiles[agentpool1].osDiskSizeGB", Message: "is too large"},
+	}
+	if errs.Error() != "masterProfile.count: needs to be 1, 3, or 5; agentPoolProfiles[agentpool1].osDiskSizeGB: is too large" {
+		t.Errorf("unexpected joined errors %s", errs.Error())
+	}
+}
+
+func Test_ContainerService_Validate(t *testing.T) {
+	cs := &ContainerService{
+		Properties: &Properties{
+			OrchestratorProfile: &OrchestratorProfile{OrchestratorType: Kubernetes},
+			MasterProfile:       &MasterProfile{Count: 2, DNSPrefix: "mydns", VMSize: "Standard_D2_v2", OSDiskSizeGB: 2000},
+			AgentPoolProfiles: []*AgentPoolProfile{
+				{Name: "agentpool1", Count: 3, VMSize: "Standard_D2_v2"},
+				{Name: "agentpool1", Count: 3, VMSize: "Standard_D2_v2", OSDiskSizeGB: 2000},
+				{Name: "public", Count: 3, VMSize: "Standard_D2_v2", DNSPrefix: "mydns_public"},
+			},
+			LinuxProfile: &LinuxProfile{AdminUsername: "azureuser"},
+		},
+	}
+	cs.Properties.LinuxProfile.SSH.PublicKeys = []struct {
+		KeyData string `json:"keyData"`
+	}{{KeyData: "not-a-key"}}
+
+	errs, ok := cs.Validate().(common.ValidationErrors)
+	if !ok {
+		t.Fatalf("should return common.ValidationErrors, got %v", cs.Validate())
+	}
+	fields := map[string]bool{}
+	for _, err := range errs {
+		fields[err.Field] = true
+	}
+	for _, field := range []string{
+		"masterProfile.count",
+		"masterProfile.osDiskSizeGB",
+		"agentPoolProfiles[agentpool1].name",
+		"agentPoolProfiles[agentpool1].osDiskSizeGB",
+		"linuxProfile",
+		"agentPoolProfiles[public].dnsPrefix",
+	} {
+		if !fields[field] {
+			t.Errorf("should report a failure of %s, got %v", field, errs)
+		}
+	}
+
+	cs.Properties = &Properties{AgentPoolProfiles: []*AgentPoolProfile{{Name: "agentpool1", Count: 3, VMSize: "Standard_D2_v2"}}}
+	err := cs.Validate()
+	if err == nil || !strings.Contains(err.Error(), "missing OrchestratorProfile") {
+		t.Errorf("should report the missing profiles without validating them, got %v", err)
+	}
+}
+
+func Test_Properties_ValidateCustomBootstrap(t *testing.T) {
+	p := &Properties{OrchestratorProfile: &OrchestratorProfile{OrchestratorType: DCOS}}
+	if err := p.validateCustomBootstrap(); err != nil {
+		t.Errorf("should not error on a DCOS cluster without a custom bootstrap, got %v", err)
+	}
+
+	p.CustomProfile = &CustomProfile{
+		BootstrapRepositoryURL: "https://mirror.contoso.com/dcos/stable",
+	}
+	if !p.CustomProfile.HasCustomBootstrap() {
+		t.Errorf("should have a custom bootstrap")
+	}
+	if err := p.validateCustomBootstrap(); err != nil {
+		t.Errorf("should not error on a DCOS cluster with a custom bootstrap, got %v", err)
+	}
+
+	p.CustomProfile.BootstrapDockerImage = "myregistry.azurecr.io/dcos-bootstrap:1.9.0"
+	if err, ok := p.validateCustomBootstrap().(*common.ValidationError); !ok || err.Field != "customProfile.bootstrapDockerImage" {
+		t.Errorf("should reject a bootstrap docker image, which the templates do not use, got %v", p.validateCustomBootstrap())
+	}
+	p.CustomProfile.BootstrapDockerImage = ""
+
+	p.CustomProfile.BootstrapRepositoryURL = "ftp://mirror.contoso.com/dcos"
+	if err, ok := p.validateCustomBootstrap().(*common.ValidationError); !ok || err.Field != "customProfile.bootstrapRepositoryURL" {
+		t.Errorf("should error on a repository that is not an http(s) URL, got %v", p.validateCustomBootstrap())
+	}
+
+	p.CustomProfile.BootstrapRepositoryURL = "https://mirror.contoso.com/dcos/stable"
+	p.OrchestratorProfile.OrchestratorType = Kubernetes
+	err, ok := p.validateCustomBootstrap().(*common.ValidationError)
+	if !ok || err.Field != "customProfile" || !strings.Contains(err.Message, string(Kubernetes)) {
+		t.Errorf("should reject a custom bootstrap on a Kubernetes cluster, got %v", p.validateCustomBootstrap())
+	}
+}